@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/req-resp/resp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+)
+
+// APITokenController API令牌控制器
+type APITokenController struct {
+	s *service.APITokenService
+}
+
+// NewAPITokenController 创建API令牌控制器
+func NewAPITokenController() *APITokenController {
+	return &APITokenController{s: service.NewAPITokenService()}
+}
+
+// CreatePersonalToken
+// @Tags     api-token
+// @Summary  创建个人API令牌
+// @Description  令牌代表当前用户，权限同时受授权范围和当前用户的角色限制。令牌只在本次返回，使用时放在Authorization: Bearer中
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.CreatePersonalToken  true  "名称、授权范围、限制的产品和有效期"
+// @Success  200    {object}  resp.Response{data=dto.APITokenInfo}  "令牌信息"
+// @Router   /activate/api-token/create-personal [post]
+func (cl *APITokenController) CreatePersonalToken(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.CreatePersonalToken
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.CreatePersonalToken(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// CreateServiceToken
+// @Tags     api-token
+// @Summary  创建服务API令牌
+// @Description  需要产品的管理员管理权限。同时创建服务账号并以access_role加入产品，令牌只能访问该产品。令牌只在本次返回
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.CreateServiceToken  true  "名称、产品、角色、授权范围和有效期"
+// @Success  200    {object}  resp.Response{data=dto.APITokenInfo}  "令牌信息"
+// @Router   /activate/api-token/create-service [post]
+func (cl *APITokenController) CreateServiceToken(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.CreateServiceToken
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.CreateServiceToken(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// ListTokens
+// @Tags     api-token
+// @Summary  获取API令牌列表
+// @Description  不指定产品时返回自己的个人令牌；指定产品时返回该产品的服务令牌，需要管理员管理权限
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    product_id     query     int     false "产品ID"
+// @Param    status         query     string  false "状态(active/revoked/expired)"
+// @Success  200    {object}  resp.Response{data=[]dto.APITokenInfo}  "令牌列表"
+// @Router   /activate/api-token/list [get]
+func (cl *APITokenController) ListTokens(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var query dto.APITokenQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.ListTokens(c, uai.UserID, query)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// RevokeToken
+// @Tags     api-token
+// @Summary  撤销API令牌
+// @Description  立即失效；服务令牌同时停用其服务账号并移出产品
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.APITokenID  true  "令牌ID"
+// @Success  200    {object}  resp.Response  "撤销成功"
+// @Router   /activate/api-token/revoke [post]
+func (cl *APITokenController) RevokeToken(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.APITokenID
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	if code := cl.s.RevokeToken(c, uai.UserID, param.ID); code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
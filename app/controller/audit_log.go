@@ -23,6 +23,8 @@ func NewAuditLogController() *AuditLogController {
 // @Produce  application/json
 // @Param    page     query    int     false  "页码，从1开始"   default(1)
 // @Param    page_size query    int     false  "每页数量"        default(10)
+// @Param    cursor    query    string  false  "上一页返回的next_cursor，传入时忽略页码"
+// @Param    count     query    string  false  "总数统计方式：exact、capped、none"
 // @Param    module    query    string  false  "模块名称"
 // @Param    operation query    string  false  "操作类型"
 // @Param    user_id   query    int     false  "操作用户ID"
@@ -47,7 +49,7 @@ func (cl *AuditLogController) ListLogs(c *gin.Context) {
 	}
 
 	// 调用服务层方法查询日志
-	result, code := cl.s.ListLogs(c, query)
+	result, code := cl.s.ListLogs(c, uai.UserID, query)
 	if code != resource.CODE_SUCCESS {
 		resp.Error(c, code)
 		return
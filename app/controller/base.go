@@ -110,12 +110,37 @@ func (cl *BaseController) UserRegisterByPassword(c *gin.Context) {
 	resp.Success(c)
 }
 
+// RegisterByInvitation
+// @Tags     Base
+// @Summary  使用邀请码注册
+// @Description  仅邀请注册模式下只能通过该接口注册；邀请码指定产品时注册后加入该产品
+// @Produce   application/json
+// @Param    data  body      dto.RegisterByInvitation   true  "参数：邮箱、密码和邀请码"
+// @Success  200   {object}  resp.Response{message=string}  "使用邀请码注册"
+// @Router   /activate/base/registerByInvitation [post]
+func (cl *BaseController) RegisterByInvitation(c *gin.Context) {
+	var param dto.RegisterByInvitation
+	if err := c.ShouldBindBodyWithJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	e := cl.s.RegisterByInvitation(c, param)
+	if e != resource.CODE_SUCCESS {
+		resp.Error(c, e)
+		return
+	}
+
+	resp.Success(c)
+}
+
 // UserLoginByPassword
 // @Tags     Base
 // @Summary  用户登录
+// @Description  开启两步验证或角色要求两步验证时不签发令牌，返回临时令牌，使用verifyTwoFactor完成登录
 // @Produce   application/json
 // @Param    data  body      dto.UserLoginInfo   true  "参数：用户登录"
-// @Success  200   {object}  resp.Response{message=string}  "用户登录"
+// @Success  200   {object}  resp.Response{data=auth.UserAuthInfo}  "用户信息或dto.TwoFactorChallenge"
 // @Router   /activate/base/login [post]
 func (cl *BaseController) UserLoginByPassword(c *gin.Context) {
 	var param dto.UserLoginInfo
@@ -124,15 +149,239 @@ func (cl *BaseController) UserLoginByPassword(c *gin.Context) {
 		resp.Error(c, resource.ERR_INVALID_PARAMETER)
 		return
 	}
-	at, rt, uai, e := cl.s.UserLoginByPassword(c, param)
+	at, rt, uai, challenge, e := cl.s.UserLoginByPassword(c, param)
 	if e != resource.CODE_SUCCESS {
 		resp.Error(c, e)
 		return
 	}
+	if challenge != nil {
+		resp.Success(c, challenge)
+		return
+	}
+
+	setLoginTokens(c, at, rt)
+	resp.Success(c, uai)
+}
 
+// setLoginTokens 登录成功后设置 Authorization 请求头和 refresh_token Cookie
+func setLoginTokens(c *gin.Context, at, rt string) {
 	expire := int(resource.Conf.JwtConfig.RefreshExpire)
 	c.Header("Authorization", "Bearer "+at)                            // 设置 Authorization 请求头
 	domain := strings.Split(c.Request.Host, ":")[0]                    // 获取主机名，不包含端口号
 	c.SetCookie("refresh_token", rt, expire, "/", domain, false, true) // 设置 Cookie
+}
+
+// VerifyTwoFactor
+// @Tags     Base
+// @Summary  登录两步验证
+// @Description  使用登录返回的临时令牌和验证码或恢复码完成登录，临时令牌连续验证失败5次后失效
+// @Produce   application/json
+// @Param    data  body      dto.TwoFactorLogin   true  "参数：临时令牌和验证码或恢复码"
+// @Success  200   {object}  resp.Response{data=auth.UserAuthInfo}  "用户信息"
+// @Router   /activate/base/verifyTwoFactor [post]
+func (cl *BaseController) VerifyTwoFactor(c *gin.Context) {
+	var param dto.TwoFactorLogin
+	if err := c.ShouldBindBodyWithJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	at, rt, uai, e := cl.s.VerifyTwoFactor(c, param)
+	if e != resource.CODE_SUCCESS {
+		resp.Error(c, e)
+		return
+	}
+
+	setLoginTokens(c, at, rt)
 	resp.Success(c, uai)
 }
+
+// EnrollTwoFactor
+// @Tags     Base
+// @Summary  登录时开启两步验证
+// @Description  角色要求两步验证但未开启的用户使用登录返回的临时令牌获取密钥和二维码
+// @Produce   application/json
+// @Param    data  body      dto.TwoFactorPreAuth   true  "参数：临时令牌"
+// @Success  200   {object}  resp.Response{data=dto.TwoFactorEnrollment}  "密钥和二维码"
+// @Router   /activate/base/enrollTwoFactor [post]
+func (cl *BaseController) EnrollTwoFactor(c *gin.Context) {
+	var param dto.TwoFactorPreAuth
+	if err := c.ShouldBindBodyWithJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, e := cl.s.EnrollTwoFactor(c, param.Token)
+	if e != resource.CODE_SUCCESS {
+		resp.Error(c, e)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// ActivateTwoFactor
+// @Tags     Base
+// @Summary  登录时确认开启两步验证
+// @Description  开启成功后直接完成登录，恢复码只在本次返回
+// @Produce   application/json
+// @Param    data  body      dto.TwoFactorActivateByToken   true  "参数：临时令牌和验证码"
+// @Success  200   {object}  resp.Response{data=map[string]interface{}}  "用户信息和恢复码"
+// @Router   /activate/base/activateTwoFactor [post]
+func (cl *BaseController) ActivateTwoFactor(c *gin.Context) {
+	var param dto.TwoFactorActivateByToken
+	if err := c.ShouldBindBodyWithJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	at, rt, uai, codes, e := cl.s.ActivateTwoFactor(c, param)
+	if e != resource.CODE_SUCCESS {
+		resp.Error(c, e)
+		return
+	}
+
+	setLoginTokens(c, at, rt)
+	resp.Success(c, map[string]interface{}{
+		"user_info":      uai,
+		"recovery_codes": codes,
+	})
+}
+
+// OIDCAuthorize
+// @Tags     Base
+// @Summary  发起单点登录
+// @Description  返回身份提供方的授权地址，前端跳转后由身份提供方回调到配置的redirect-url，未开启时返回错误
+// @Produce   application/json
+// @Success  200   {object}  resp.Response{data=dto.OIDCAuthorization}  "授权地址"
+// @Router   /activate/base/oidc/authorize [get]
+func (cl *BaseController) OIDCAuthorize(c *gin.Context) {
+	result, e := cl.s.OIDCAuthorize(c)
+	if e != resource.CODE_SUCCESS {
+		resp.Error(c, e)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// OIDCLogin
+// @Tags     Base
+// @Summary  完成单点登录
+// @Description  回调页面提交授权码和state，按邮箱登录或自动创建用户；需要两步验证时返回临时令牌
+// @Produce   application/json
+// @Param    data  body      dto.OIDCCallback   true  "参数：授权码和state"
+// @Success  200   {object}  resp.Response{data=auth.UserAuthInfo}  "用户信息或dto.TwoFactorChallenge"
+// @Router   /activate/base/oidc/login [post]
+func (cl *BaseController) OIDCLogin(c *gin.Context) {
+	var param dto.OIDCCallback
+	if err := c.ShouldBindBodyWithJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	at, rt, uai, challenge, e := cl.s.OIDCLogin(c, param)
+	if e != resource.CODE_SUCCESS {
+		resp.Error(c, e)
+		return
+	}
+	if challenge != nil {
+		resp.Success(c, challenge)
+		return
+	}
+
+	setLoginTokens(c, at, rt)
+	resp.Success(c, uai)
+}
+
+// SendActivationEmail
+// @Tags     Base
+// @Summary  发送邮箱验证邮件
+// @Produce   application/json
+// @Param    data  body      dto.SendAccountEmail   true  "参数：用户邮箱"
+// @Success  200   {object}  resp.Response{message=string}  "发送邮箱验证邮件"
+// @Router   /activate/base/sendActivationEmail [post]
+func (cl *BaseController) SendActivationEmail(c *gin.Context) {
+	var param dto.SendAccountEmail
+	if err := c.ShouldBindBodyWithJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	e := cl.s.SendActivationEmail(c, param.Email)
+	if e != resource.CODE_SUCCESS {
+		resp.Error(c, e)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// ValidateActivationEmail
+// @Tags     Base
+// @Summary  验证邮箱
+// @Produce   application/json
+// @Param    data  body      dto.ValidateEmail   true  "参数：邮件中的令牌"
+// @Success  200   {object}  resp.Response{message=string}  "验证邮箱"
+// @Router   /activate/base/validateActivationEmail [post]
+func (cl *BaseController) ValidateActivationEmail(c *gin.Context) {
+	var param dto.ValidateEmail
+	if err := c.ShouldBindBodyWithJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	e := cl.s.ValidateActivationEmail(c, param.Token)
+	if e != resource.CODE_SUCCESS {
+		resp.Error(c, e)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// SendResetPasswordEmail
+// @Tags     Base
+// @Summary  发送密码重置邮件
+// @Produce   application/json
+// @Param    data  body      dto.SendAccountEmail   true  "参数：用户邮箱"
+// @Success  200   {object}  resp.Response{message=string}  "发送密码重置邮件"
+// @Router   /activate/base/sendResetPasswordEmail [post]
+func (cl *BaseController) SendResetPasswordEmail(c *gin.Context) {
+	var param dto.SendAccountEmail
+	if err := c.ShouldBindBodyWithJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	e := cl.s.SendResetPasswordEmail(c, param.Email)
+	if e != resource.CODE_SUCCESS {
+		resp.Error(c, e)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// ResetPassword
+// @Tags     Base
+// @Summary  重置密码
+// @Produce   application/json
+// @Param    data  body      dto.ResetPassword   true  "参数：邮件中的令牌和新密码"
+// @Success  200   {object}  resp.Response{message=string}  "重置密码"
+// @Router   /activate/base/resetPassword [post]
+func (cl *BaseController) ResetPassword(c *gin.Context) {
+	var param dto.ResetPassword
+	if err := c.ShouldBindBodyWithJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	e := cl.s.ResetPassword(c, param)
+	if e != resource.CODE_SUCCESS {
+		resp.Error(c, e)
+		return
+	}
+
+	resp.Success(c)
+}
@@ -0,0 +1,138 @@
+package controller
+
+import (
+	"fmt"
+	"io"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/req-resp/resp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+)
+
+// catalogMaxSize 产品目录文档的大小上限
+const catalogMaxSize = 4 << 20
+
+// CatalogController 产品目录控制器
+type CatalogController struct {
+	s *service.CatalogService
+}
+
+// NewCatalogController 创建产品目录控制器
+func NewCatalogController() *CatalogController {
+	return &CatalogController{s: service.NewCatalogService()}
+}
+
+// readCatalog 读取请求体中的YAML文档
+func readCatalog(c *gin.Context) ([]byte, bool) {
+	data, err := io.ReadAll(io.LimitReader(c.Request.Body, catalogMaxSize+1))
+	if err != nil || len(data) == 0 || len(data) > catalogMaxSize {
+		return nil, false
+	}
+	return data, true
+}
+
+// ExportCatalog
+// @Tags     Catalog
+// @Summary  导出产品目录（YAML）
+// @Description  包含功能、许可证类型及其功能、管理员，记录之间按编码关联
+// @Produce  application/x-yaml
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    product_ids    query     []int   true  "产品ID" collectionFormat(multi)
+// @Success  200    {file}  file  "产品目录文件"
+// @Router   /activate/catalog/export [get]
+func (cl *CatalogController) ExportCatalog(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.CatalogExport
+	if err := c.ShouldBindQuery(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, filename, code := cl.s.ExportCatalog(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Header("Content-Length", fmt.Sprint(len(result)))
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Access-Control-Expose-Headers", "Content-Disposition")
+	c.Data(200, "application/x-yaml", result)
+}
+
+// PlanCatalog
+// @Tags     Catalog
+// @Summary  预览产品目录导入的变更
+// @Description  请求体为YAML文档，返回新增、修改、删除的记录，不修改数据库
+// @Accept   application/x-yaml
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      string  true  "产品目录YAML"
+// @Success  200    {object}  resp.Response{data=dto.CatalogPlan}  "导入计划"
+// @Router   /activate/catalog/plan [post]
+func (cl *CatalogController) PlanCatalog(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	data, ok := readCatalog(c)
+	if !ok {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.PlanCatalog(c, uai.UserID, data)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// ApplyCatalog
+// @Tags     Catalog
+// @Summary  执行产品目录导入
+// @Description  在一个事务中执行全部变更，文档有错误时不修改数据库并返回错误列表
+// @Accept   application/x-yaml
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      string  true  "产品目录YAML"
+// @Success  200    {object}  resp.Response{data=dto.CatalogPlan}  "已执行的变更"
+// @Router   /activate/catalog/apply [post]
+func (cl *CatalogController) ApplyCatalog(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	data, ok := readCatalog(c)
+	if !ok {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.ApplyCatalog(c, uai.UserID, data)
+	if code == resource.ERR_CATALOG_INVALID {
+		resp.ErrorWithData(c, code, "", result)
+		return
+	}
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
@@ -0,0 +1,281 @@
+package controller
+
+import (
+	"strconv"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/req-resp/resp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+)
+
+// CustomerController 客户控制器
+type CustomerController struct {
+	s *service.CustomerService
+}
+
+// NewCustomerController 创建客户控制器
+func NewCustomerController() *CustomerController {
+	return &CustomerController{s: service.NewCustomerService()}
+}
+
+// ListCustomers
+// @Tags     Customer
+// @Summary  查询客户列表
+// @Description  设备数量只统计当前用户管理的产品中的设备
+// @Produce  application/json
+// @Param    Authorization  header    string  true   "Authorization"
+// @Param    search         query     string  false  "按名称、联系人模糊搜索"
+// @Param    region         query     string  false  "地区"
+// @Param    oem_tag        query     string  false  "OEM标识"
+// @Param    page           query     int     true   "页码"
+// @Param    page_size      query     int     true   "每页数量"
+// @Success  200    {object}  resp.Response{data=dto.PageResult{list=[]dto.CustomerInfo}}  "客户列表"
+// @Router   /activate/customer/list [get]
+func (cl *CustomerController) ListCustomers(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var query dto.CustomerQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.ListCustomers(c, uai.UserID, query)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// AddCustomer
+// @Tags     Customer
+// @Summary  添加客户
+// @Description  超级管理员和对任一产品有完整权限的管理员可以添加客户
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.AddCustomer  true  "参数：客户信息"
+// @Success  200   {object}  resp.Response{data=dto.CustomerInfo}  "客户信息"
+// @Router   /activate/customer/add [post]
+func (cl *CustomerController) AddCustomer(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.AddCustomer
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	info, code := cl.s.AddCustomer(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, info)
+}
+
+// UpdateCustomer
+// @Tags     Customer
+// @Summary  修改客户
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.ModifyCustomer  true  "参数：客户信息"
+// @Success  200   {object}  resp.Response{message=string}  "修改客户"
+// @Router   /activate/customer/update [post]
+func (cl *CustomerController) UpdateCustomer(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.ModifyCustomer
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.UpdateCustomer(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// DeleteCustomer
+// @Tags     Customer
+// @Summary  删除客户
+// @Description  仅超级管理员可用，客户名下还有设备时不能删除，设备归属历史保留
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    id  query     int     true  "客户ID"
+// @Success  200   {object}  resp.Response{message=string}  "删除客户"
+// @Router   /activate/customer/del [get]
+func (cl *CustomerController) DeleteCustomer(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Query("id"))
+	if err != nil || id <= 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.DeleteCustomer(c, uai.UserID, id)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// AssignCustomer
+// @Tags     Customer
+// @Summary  设置设备归属客户
+// @Description  customer_id为0表示解除归属；未指定保修时间且设备没有保修记录时，已出货的设备按出货时间和产品保修期计算
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.AssignCustomer  true  "参数：设备和客户"
+// @Success  200   {object}  resp.Response{message=string}  "设置设备归属客户"
+// @Router   /activate/customer/assign [post]
+func (cl *CustomerController) AssignCustomer(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.AssignCustomer
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.AssignCustomer(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// ListCustomerDevices
+// @Tags     Customer
+// @Summary  查询客户名下的设备
+// @Produce  application/json
+// @Param    Authorization  header    string  true   "Authorization"
+// @Param    customer_id    query     int     true   "客户ID"
+// @Param    product_id     query     int     false  "产品ID"
+// @Param    page           query     int     false  "页码"
+// @Param    page_size      query     int     true   "每页数量"
+// @Param    cursor         query     string  false  "游标，传入时忽略页码"
+// @Param    count          query     string  false  "总数统计方式：exact、capped、none"
+// @Success  200    {object}  resp.Response{data=dto.PageResult{list=[]dto.DeviceInfo}}  "设备列表"
+// @Router   /activate/customer/devices [get]
+func (cl *CustomerController) ListCustomerDevices(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var query dto.CustomerDeviceQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.ListCustomerDevices(c, uai.UserID, query)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// ListAssignmentHistory
+// @Tags     Customer
+// @Summary  查询设备归属变更历史
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    device_id      query     int     true  "设备ID"
+// @Success  200    {object}  resp.Response{data=[]dto.DeviceAssignmentInfo}  "归属变更历史"
+// @Router   /activate/customer/assignment-history [get]
+func (cl *CustomerController) ListAssignmentHistory(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	deviceID, err := strconv.Atoi(c.Query("device_id"))
+	if err != nil || deviceID <= 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	list, code := cl.s.ListAssignmentHistory(c, uai.UserID, deviceID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, list)
+}
+
+// WarrantyExpiryReport
+// @Tags     Customer
+// @Summary  保修到期报表
+// @Description  列出指定天数内保修到期的设备，按到期时间升序，报废设备不统计
+// @Produce  application/json
+// @Param    Authorization  header    string  true   "Authorization"
+// @Param    product_id     query     int     false  "产品ID"
+// @Param    customer_id    query     int     false  "客户ID"
+// @Param    days           query     int     false  "统计未来多少天内到期，默认30天"
+// @Param    include_expired  query   bool    false  "是否包含已过保的设备"
+// @Param    page           query     int     true   "页码"
+// @Param    page_size      query     int     true   "每页数量"
+// @Success  200    {object}  resp.Response{data=dto.PageResult{list=[]dto.WarrantyExpiryItem}}  "保修到期设备"
+// @Router   /activate/customer/warranty-expiry [get]
+func (cl *CustomerController) WarrantyExpiryReport(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var query dto.WarrantyExpiryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.WarrantyExpiryReport(c, uai.UserID, query)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
@@ -67,8 +67,17 @@ func (c *DeviceController) ListProducts(ctx *gin.Context) {
 // @Param    product_id     query     int     false  "产品ID"
 // @Param    license_type_id query     int     false  "许可证类型ID"
 // @Param    sn             query     string  false  "设备序列号"
+// @Param    state          query     string  false  "生命周期状态"
+// @Param    tag_ids        query     []int   false  "标签ID，需同时包含"
+// @Param    group_id       query     int     false  "设备分组ID"
+// @Param    saved_filter_id query    int     false  "已保存的筛选器ID"
+// @Param    online         query     string  false  "在线状态：online/offline"
+// @Param    software_version query   string  false  "最后上报的软件版本"
+// @Param    firmware_version query   string  false  "最后上报的韧件版本"
 // @Param    page     query    int     false  "页码，从1开始"   default(1)
 // @Param    page_size query    int     false  "每页数量"        default(10)
+// @Param    cursor    query    string  false  "上一页返回的next_cursor，传入时忽略页码"
+// @Param    count     query    string  false  "总数统计方式：exact、capped、none"
 // @Success  200      {object}  resp.Response  "获取设备列表"
 // @Router   /activate/device/list [get]
 func (c *DeviceController) ListDevices(ctx *gin.Context) {
@@ -213,6 +222,53 @@ func (c *DeviceController) BatchAddDevices(ctx *gin.Context) {
 	resp.Success(ctx)
 }
 
+// ImportDevices
+// @Tags     device
+// @Summary  从CSV文件导入设备
+// @Accept   multipart/form-data
+// @Produce  application/json
+// @Param    Authorization   header    string  true   "Authorization"
+// @Param    file            formData  file    true   "CSV文件，第一列为SN"
+// @Param    product_id      formData  int     true   "产品ID"
+// @Param    license_type_id formData  int     true   "许可证类型ID"
+// @Param    oem_tag         formData  string  false  "OEM厂商标记"
+// @Param    remark          formData  string  false  "备注"
+// @Success  200   {object}  resp.Response{message=string}  "导入设备"
+// @Router   /activate/device/import [post]
+func (c *DeviceController) ImportDevices(ctx *gin.Context) {
+	uai := auth.GetUserAuthInfo(ctx)
+	if uai.UserID == 0 {
+		resp.Error(ctx, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.DeviceImport
+	if err := ctx.ShouldBind(&param); err != nil {
+		resp.Error(ctx, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		resp.Error(ctx, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		resp.Error(ctx, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+	defer file.Close()
+
+	code := c.deviceService.ImportDevices(ctx, uai.UserID, param, file)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(ctx, code)
+		return
+	}
+
+	resp.Success(ctx)
+}
+
 // UpdateDevice
 // @Tags     device
 // @Summary  更新设备
@@ -303,6 +359,160 @@ func (c *DeviceController) BatchUpdateLicenseType(ctx *gin.Context) {
 	resp.Success(ctx)
 }
 
+// TransitionDevice
+// @Tags     device
+// @Summary  变更设备生命周期状态
+// @Produce  application/json
+// @Param    Authorization header     string true "Authorization"
+// @Param    data  body      dto.DeviceTransition   true  "参数：目标状态"
+// @Success  200   {object}  resp.Response{data=dto.DeviceTransitionResult}  "变更结果"
+// @Router   /activate/device/transition [post]
+func (c *DeviceController) TransitionDevice(ctx *gin.Context) {
+	uai := auth.GetUserAuthInfo(ctx)
+	if uai.UserID == 0 {
+		resp.Error(ctx, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.DeviceTransition
+	if err := ctx.ShouldBindJSON(&param); err != nil {
+		resp.Error(ctx, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := c.deviceService.TransitionDevice(ctx, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(ctx, code)
+		return
+	}
+
+	resp.Success(ctx, result)
+}
+
+// BatchTransitionDevices
+// @Tags     device
+// @Summary  批量变更设备生命周期状态，不允许变更的设备被跳过
+// @Produce  application/json
+// @Param    Authorization header     string true "Authorization"
+// @Param    data  body      dto.DeviceBatchTransition   true  "参数：设备ID列表和目标状态"
+// @Success  200   {object}  resp.Response{data=[]dto.DeviceTransitionResult}  "逐个设备的变更结果"
+// @Router   /activate/device/batch-transition [post]
+func (c *DeviceController) BatchTransitionDevices(ctx *gin.Context) {
+	uai := auth.GetUserAuthInfo(ctx)
+	if uai.UserID == 0 {
+		resp.Error(ctx, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.DeviceBatchTransition
+	if err := ctx.ShouldBindJSON(&param); err != nil {
+		resp.Error(ctx, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := c.deviceService.BatchTransitionDevices(ctx, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(ctx, code)
+		return
+	}
+
+	resp.Success(ctx, result)
+}
+
+// RegisterHeartbeatKey
+// @Tags     device
+// @Summary  登记设备的心跳签名公钥
+// @Description  设备自行生成Ed25519密钥对，私钥不离开设备，服务端只保存公钥
+// @Accept   application/json
+// @Produce  application/json
+// @Param    Authorization header     string true "Authorization"
+// @Param    data  body      dto.DeviceHeartbeatKey   true  "参数：设备ID和公钥"
+// @Success  200   {object}  resp.Response{message=string}  "登记结果"
+// @Router   /activate/device/signing-key [post]
+func (c *DeviceController) RegisterHeartbeatKey(ctx *gin.Context) {
+	uai := auth.GetUserAuthInfo(ctx)
+	if uai.UserID == 0 {
+		resp.Error(ctx, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.DeviceHeartbeatKey
+	if err := ctx.ShouldBindJSON(&param); err != nil {
+		resp.Error(ctx, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := c.deviceService.RegisterHeartbeatKey(ctx, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(ctx, code)
+		return
+	}
+
+	resp.Success(ctx)
+}
+
+// ReportHeartbeat
+// @Tags     device
+// @Summary  设备心跳上报（设备签名认证）
+// @Description  签名为 hex(Ed25519(timestamp + "\n" + body))，使用设备私钥签名，公钥需先通过 /device/signing-key 登记
+// @Accept   application/json
+// @Produce  application/json
+// @Param    X-Device-SN         header    string  true  "设备序列号"
+// @Param    X-Device-Timestamp  header    string  true  "Unix时间戳（秒）"
+// @Param    X-Device-Signature  header    string  true  "请求签名"
+// @Param    data  body      dto.DeviceHeartbeat   true  "心跳内容"
+// @Success  200   {object}  resp.Response{message=string}  "心跳上报"
+// @Router   /activate/device/heartbeat [post]
+func (c *DeviceController) ReportHeartbeat(ctx *gin.Context) {
+	body, err := ctx.GetRawData()
+	if err != nil {
+		resp.Error(ctx, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := c.deviceService.ReportHeartbeat(ctx,
+		ctx.GetHeader(dto.HeaderDeviceSN),
+		ctx.GetHeader(dto.HeaderDeviceTimestamp),
+		ctx.GetHeader(dto.HeaderDeviceSignature),
+		body)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(ctx, code)
+		return
+	}
+
+	resp.Success(ctx)
+}
+
+// GetVersionDistribution
+// @Tags     device
+// @Summary  获取产品设备的版本分布
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    product_id     query     int     true  "产品ID"
+// @Success  200   {object}  resp.Response{data=dto.VersionDistribution}  "版本分布"
+// @Router   /activate/device/version-distribution [get]
+func (c *DeviceController) GetVersionDistribution(ctx *gin.Context) {
+	uai := auth.GetUserAuthInfo(ctx)
+	if uai.UserID == 0 {
+		resp.Error(ctx, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	productID, err := strconv.Atoi(ctx.Query("product_id"))
+	if err != nil || productID <= 0 {
+		resp.Error(ctx, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := c.deviceService.GetVersionDistribution(ctx, uai.UserID, productID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(ctx, code)
+		return
+	}
+
+	resp.Success(ctx, result)
+}
+
 // GetActivationFile
 // @Tags     device
 // @Summary  获取设备激活文件
@@ -342,3 +552,157 @@ func (c *DeviceController) GetActivationFile(ctx *gin.Context) {
 	// 直接写入文件内容
 	ctx.Data(200, "application/octet-stream", result)
 }
+
+// ExportDevices
+// @Tags     device
+// @Summary  按筛选条件导出设备（CSV），自定义属性按列展开
+// @Produce  application/octet-stream
+// @Param    Authorization header     string true "Authorization"
+// @Param    data  query     dto.DeviceExport   true  "参数：筛选条件"
+// @Success  200   {file}    file  "设备CSV文件"
+// @Router   /activate/device/export [get]
+func (c *DeviceController) ExportDevices(ctx *gin.Context) {
+	uai := auth.GetUserAuthInfo(ctx)
+	if uai.UserID == 0 {
+		resp.Error(ctx, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.DeviceExport
+	if err := ctx.ShouldBindQuery(&param); err != nil {
+		resp.Error(ctx, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, filename, code := c.deviceService.ExportDevices(ctx, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(ctx, code)
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	ctx.Header("Content-Length", fmt.Sprint(len(result)))
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Access-Control-Expose-Headers", "Content-Disposition")
+	ctx.Data(200, "application/octet-stream", result)
+}
+
+// GetAttributeSchema
+// @Tags     device
+// @Summary  获取产品的设备属性定义
+// @Produce  application/json
+// @Param    Authorization header     string true "Authorization"
+// @Param    product_id    query      int    true "产品ID"
+// @Success  200   {object}  resp.Response{data=dto.DeviceAttributeSchemaInfo}  "设备属性定义"
+// @Router   /activate/device/attribute-schema [get]
+func (c *DeviceController) GetAttributeSchema(ctx *gin.Context) {
+	uai := auth.GetUserAuthInfo(ctx)
+	if uai.UserID == 0 {
+		resp.Error(ctx, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	productID, err := strconv.Atoi(ctx.Query("product_id"))
+	if err != nil || productID <= 0 {
+		resp.Error(ctx, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := c.deviceService.GetAttributeSchema(ctx, uai.UserID, productID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(ctx, code)
+		return
+	}
+
+	resp.Success(ctx, result)
+}
+
+// SetAttributeSchema
+// @Tags     device
+// @Summary  设置产品的设备属性定义（JSON Schema），schema为空时取消约束
+// @Produce  application/json
+// @Param    Authorization header     string true "Authorization"
+// @Param    data  body      dto.DeviceAttributeSchema   true  "参数：设备属性定义"
+// @Success  200   {object}  resp.Response{message=string}  "设置设备属性定义"
+// @Router   /activate/device/attribute-schema [post]
+func (c *DeviceController) SetAttributeSchema(ctx *gin.Context) {
+	uai := auth.GetUserAuthInfo(ctx)
+	if uai.UserID == 0 {
+		resp.Error(ctx, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.DeviceAttributeSchema
+	if err := ctx.ShouldBindJSON(&param); err != nil {
+		resp.Error(ctx, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := c.deviceService.SetAttributeSchema(ctx, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(ctx, code)
+		return
+	}
+
+	resp.Success(ctx)
+}
+
+// SetFeatureAddOn
+// @Tags     device
+// @Summary  设置设备的功能附加项（额外授予或禁用许可证类型中的功能）
+// @Produce  application/json
+// @Param    Authorization header     string true "Authorization"
+// @Param    data  body      dto.DeviceFeatureAddOn   true  "参数：功能附加项"
+// @Success  200   {object}  resp.Response{message=string}  "设置功能附加项"
+// @Router   /activate/device/feature-add-on [post]
+func (c *DeviceController) SetFeatureAddOn(ctx *gin.Context) {
+	uai := auth.GetUserAuthInfo(ctx)
+	if uai.UserID == 0 {
+		resp.Error(ctx, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.DeviceFeatureAddOn
+	if err := ctx.ShouldBindJSON(&param); err != nil {
+		resp.Error(ctx, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	violations, code := c.deviceService.SetFeatureAddOn(ctx, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.ErrorWithData(ctx, code, "", violations)
+		return
+	}
+
+	resp.Success(ctx)
+}
+
+// DeleteFeatureAddOn
+// @Tags     device
+// @Summary  删除设备的功能附加项
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    id             path      int     true  "附加项ID"
+// @Success  200    {object}  resp.Response{message=string}  "删除功能附加项"
+// @Router   /activate/device/feature-add-on/{id} [delete]
+func (c *DeviceController) DeleteFeatureAddOn(ctx *gin.Context) {
+	uai := auth.GetUserAuthInfo(ctx)
+	if uai.UserID == 0 {
+		resp.Error(ctx, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	addOnID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || addOnID <= 0 {
+		resp.Error(ctx, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	violations, code := c.deviceService.DeleteFeatureAddOn(ctx, uai.UserID, addOnID)
+	if code != resource.CODE_SUCCESS {
+		resp.ErrorWithData(ctx, code, "", violations)
+		return
+	}
+
+	resp.Success(ctx)
+}
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"strconv"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/req-resp/resp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+)
+
+// DeviceFilterController 设备筛选器控制器
+type DeviceFilterController struct {
+	s *service.DeviceFilterService
+}
+
+// NewDeviceFilterController 创建设备筛选器控制器
+func NewDeviceFilterController() *DeviceFilterController {
+	return &DeviceFilterController{s: service.NewDeviceFilterService()}
+}
+
+// ListDeviceFilters
+// @Tags     DeviceFilter
+// @Summary  获取当前用户保存的设备筛选器
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Success  200   {object}  resp.Response{data=[]dto.DeviceFilterInfo}  "获取当前用户保存的设备筛选器"
+// @Router   /activate/device-filter/list [get]
+func (cl *DeviceFilterController) ListDeviceFilters(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	result, code := cl.s.ListDeviceFilters(c, uai.UserID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// SaveDeviceFilter
+// @Tags     DeviceFilter
+// @Summary  新增或更新设备筛选器
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.SaveDeviceFilter  true  "设备筛选器"
+// @Success  200   {object}  resp.Response{message=string}  "新增或更新设备筛选器"
+// @Router   /activate/device-filter/save [post]
+func (cl *DeviceFilterController) SaveDeviceFilter(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.SaveDeviceFilter
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.SaveDeviceFilter(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// DeleteDeviceFilter
+// @Tags     DeviceFilter
+// @Summary  删除设备筛选器
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    id  query     int     true  "筛选器ID"
+// @Success  200   {object}  resp.Response{message=string}  "删除设备筛选器"
+// @Router   /activate/device-filter/del [get]
+func (cl *DeviceFilterController) DeleteDeviceFilter(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Query("id"))
+	if err != nil || id <= 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.DeleteDeviceFilter(c, uai.UserID, id)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
@@ -0,0 +1,202 @@
+package controller
+
+import (
+	"strconv"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/req-resp/resp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+)
+
+// DeviceGroupController 设备分组控制器
+type DeviceGroupController struct {
+	s *service.DeviceGroupService
+}
+
+// NewDeviceGroupController 创建设备分组控制器
+func NewDeviceGroupController() *DeviceGroupController {
+	return &DeviceGroupController{s: service.NewDeviceGroupService()}
+}
+
+// ListDeviceGroups
+// @Tags     DeviceGroup
+// @Summary  获取产品的设备分组列表
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    product_id  query     int     true  "产品ID"
+// @Success  200   {object}  resp.Response{data=[]dto.DeviceGroupInfo}  "获取产品的设备分组列表"
+// @Router   /activate/device-group/list [get]
+func (cl *DeviceGroupController) ListDeviceGroups(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	productID, err := strconv.Atoi(c.Query("product_id"))
+	if err != nil || productID <= 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.ListDeviceGroups(c, uai.UserID, productID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// AddDeviceGroup
+// @Tags     DeviceGroup
+// @Summary  新增设备分组
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.AddDeviceGroup  true  "设备分组"
+// @Success  200   {object}  resp.Response{message=string}  "新增设备分组"
+// @Router   /activate/device-group/add [post]
+func (cl *DeviceGroupController) AddDeviceGroup(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.AddDeviceGroup
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.AddDeviceGroup(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// ModifyDeviceGroup
+// @Tags     DeviceGroup
+// @Summary  修改设备分组
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.ModifyDeviceGroup  true  "设备分组"
+// @Success  200   {object}  resp.Response{message=string}  "修改设备分组"
+// @Router   /activate/device-group/put [post]
+func (cl *DeviceGroupController) ModifyDeviceGroup(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.ModifyDeviceGroup
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.ModifyDeviceGroup(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// DeleteDeviceGroup
+// @Tags     DeviceGroup
+// @Summary  删除设备分组
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    id  query     int     true  "分组ID"
+// @Success  200   {object}  resp.Response{message=string}  "删除设备分组"
+// @Router   /activate/device-group/del [get]
+func (cl *DeviceGroupController) DeleteDeviceGroup(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Query("id"))
+	if err != nil || id <= 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.DeleteDeviceGroup(c, uai.UserID, id)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// AddDeviceGroupMembers
+// @Tags     DeviceGroup
+// @Summary  向分组添加设备
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.DeviceGroupMembers  true  "分组和设备"
+// @Success  200   {object}  resp.Response{message=string}  "向分组添加设备"
+// @Router   /activate/device-group/add-devices [post]
+func (cl *DeviceGroupController) AddDeviceGroupMembers(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.DeviceGroupMembers
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.UpdateDeviceGroupMembers(c, uai.UserID, param, false)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// RemoveDeviceGroupMembers
+// @Tags     DeviceGroup
+// @Summary  从分组移除设备
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.DeviceGroupMembers  true  "分组和设备"
+// @Success  200   {object}  resp.Response{message=string}  "从分组移除设备"
+// @Router   /activate/device-group/remove-devices [post]
+func (cl *DeviceGroupController) RemoveDeviceGroupMembers(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.DeviceGroupMembers
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.UpdateDeviceGroupMembers(c, uai.UserID, param, true)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
@@ -0,0 +1,202 @@
+package controller
+
+import (
+	"strconv"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/req-resp/resp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+)
+
+// DeviceTagController 设备标签控制器
+type DeviceTagController struct {
+	s *service.DeviceTagService
+}
+
+// NewDeviceTagController 创建设备标签控制器
+func NewDeviceTagController() *DeviceTagController {
+	return &DeviceTagController{s: service.NewDeviceTagService()}
+}
+
+// ListDeviceTags
+// @Tags     DeviceTag
+// @Summary  获取产品的设备标签列表
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    product_id  query     int     true  "产品ID"
+// @Success  200   {object}  resp.Response{data=[]ent.DeviceTag}  "获取产品的设备标签列表"
+// @Router   /activate/device-tag/list [get]
+func (cl *DeviceTagController) ListDeviceTags(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	productID, err := strconv.Atoi(c.Query("product_id"))
+	if err != nil || productID <= 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.ListDeviceTags(c, uai.UserID, productID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// AddDeviceTag
+// @Tags     DeviceTag
+// @Summary  新增设备标签
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.AddDeviceTag  true  "设备标签"
+// @Success  200   {object}  resp.Response{message=string}  "新增设备标签"
+// @Router   /activate/device-tag/add [post]
+func (cl *DeviceTagController) AddDeviceTag(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.AddDeviceTag
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.AddDeviceTag(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// ModifyDeviceTag
+// @Tags     DeviceTag
+// @Summary  修改设备标签
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.ModifyDeviceTag  true  "设备标签"
+// @Success  200   {object}  resp.Response{message=string}  "修改设备标签"
+// @Router   /activate/device-tag/put [post]
+func (cl *DeviceTagController) ModifyDeviceTag(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.ModifyDeviceTag
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.ModifyDeviceTag(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// DeleteDeviceTag
+// @Tags     DeviceTag
+// @Summary  删除设备标签
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    id  query     int     true  "标签ID"
+// @Success  200   {object}  resp.Response{message=string}  "删除设备标签"
+// @Router   /activate/device-tag/del [get]
+func (cl *DeviceTagController) DeleteDeviceTag(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Query("id"))
+	if err != nil || id <= 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.DeleteDeviceTag(c, uai.UserID, id)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// AssignDeviceTags
+// @Tags     DeviceTag
+// @Summary  为设备添加标签
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.AssignDeviceTags  true  "设备和标签"
+// @Success  200   {object}  resp.Response{message=string}  "为设备添加标签"
+// @Router   /activate/device-tag/assign [post]
+func (cl *DeviceTagController) AssignDeviceTags(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.AssignDeviceTags
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.AssignDeviceTags(c, uai.UserID, param, false)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// UnassignDeviceTags
+// @Tags     DeviceTag
+// @Summary  移除设备的标签
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.AssignDeviceTags  true  "设备和标签"
+// @Success  200   {object}  resp.Response{message=string}  "移除设备的标签"
+// @Router   /activate/device-tag/unassign [post]
+func (cl *DeviceTagController) UnassignDeviceTags(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.AssignDeviceTags
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.AssignDeviceTags(c, uai.UserID, param, true)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
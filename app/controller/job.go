@@ -0,0 +1,210 @@
+package controller
+
+import (
+	"strconv"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/req-resp/resp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+)
+
+// JobController 异步任务控制器
+type JobController struct {
+	s *service.JobService
+}
+
+// NewJobController 创建异步任务控制器
+func NewJobController() *JobController {
+	return &JobController{s: service.NewJobService()}
+}
+
+// SubmitDeviceBatchAdd
+// @Tags     Job
+// @Summary  提交异步批量添加设备任务
+// @Description  任务进度通过WebSocket推送给提交人，消息类型为job_progress；不合规或已存在的SN记入失败条目，其余设备正常添加
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.DeviceBatchAdd  true  "参数：批量添加设备"
+// @Success  200   {object}  resp.Response{data=dto.JobInfo}  "任务信息"
+// @Router   /activate/job/device-batch-add [post]
+func (cl *JobController) SubmitDeviceBatchAdd(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.DeviceBatchAdd
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	info, code := cl.s.SubmitDeviceBatchAdd(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, info)
+}
+
+// SubmitDeviceBatchLicense
+// @Tags     Job
+// @Summary  提交异步批量更新许可证类型任务
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.DeviceBatchUpdateLicense  true  "参数：批量更新许可证类型"
+// @Success  200   {object}  resp.Response{data=dto.JobInfo}  "任务信息"
+// @Router   /activate/job/device-batch-update-license [post]
+func (cl *JobController) SubmitDeviceBatchLicense(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.DeviceBatchUpdateLicense
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	info, code := cl.s.SubmitDeviceBatchLicense(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, info)
+}
+
+// ListJobs
+// @Tags     Job
+// @Summary  查询任务列表
+// @Produce  application/json
+// @Param    Authorization  header    string  true   "Authorization"
+// @Param    type           query     string  false  "任务类型：device_batch_add、device_batch_license"
+// @Param    status         query     string  false  "任务状态：pending、running、succeeded、failed、canceled"
+// @Param    page           query     int     false  "页码"
+// @Param    page_size      query     int     false  "每页数量"
+// @Param    cursor         query     string  false  "游标，传入时忽略页码"
+// @Param    count          query     string  false  "总数统计方式：exact、capped、none"
+// @Success  200    {object}  resp.Response{data=dto.PageResult{list=[]dto.JobInfo}}  "任务列表"
+// @Router   /activate/job/list [get]
+func (cl *JobController) ListJobs(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var query dto.JobQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.ListJobs(c, uai.UserID, query)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// GetJob
+// @Tags     Job
+// @Summary  查询任务详情（含失败条目）
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    id             path      int     true  "任务ID"
+// @Success  200    {object}  resp.Response{data=dto.JobInfo}  "任务详情"
+// @Router   /activate/job/{id} [get]
+func (cl *JobController) GetJob(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	info, code := cl.s.GetJob(c, uai.UserID, id)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, info)
+}
+
+// CancelJob
+// @Tags     Job
+// @Summary  取消任务
+// @Description  只能取消待执行或执行中的任务，已处理的条目不会回滚
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.JobID  true  "任务ID"
+// @Success  200   {object}  resp.Response{message=string}  "取消任务"
+// @Router   /activate/job/cancel [post]
+func (cl *JobController) CancelJob(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.JobID
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.CancelJob(c, uai.UserID, param.ID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// RetryJob
+// @Tags     Job
+// @Summary  重试任务
+// @Description  以失败或已取消任务中失败和未处理的条目创建新任务
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.JobID  true  "任务ID"
+// @Success  200   {object}  resp.Response{data=dto.JobInfo}  "新任务信息"
+// @Router   /activate/job/retry [post]
+func (cl *JobController) RetryJob(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.JobID
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	info, code := cl.s.RetryJob(c, uai.UserID, param.ID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, info)
+}
@@ -83,9 +83,9 @@ func (cl *LicenseTypeController) AddLicenseType(c *gin.Context) {
 		return
 	}
 
-	code := cl.s.AddLicenseType(c, uai.UserID, param)
+	violations, code := cl.s.AddLicenseType(c, uai.UserID, param)
 	if code != resource.CODE_SUCCESS {
-		resp.Error(c, code)
+		resp.ErrorWithData(c, code, "", violations)
 		return
 	}
 
@@ -143,11 +143,71 @@ func (cl *LicenseTypeController) UpdateLicenseTypeFeatures(c *gin.Context) {
 		return
 	}
 
-	code := cl.s.UpdateLicenseTypeFeatures(c, uai.UserID, param)
+	violations, code := cl.s.UpdateLicenseTypeFeatures(c, uai.UserID, param)
 	if code != resource.CODE_SUCCESS {
-		resp.Error(c, code)
+		resp.ErrorWithData(c, code, "", violations)
 		return
 	}
 
 	resp.Success(c)
 }
+
+// GetEffectiveFeatures
+// @Tags     LicenseType
+// @Summary  获取许可证类型的继承链和有效功能
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    type_id       query     int     true  "类型ID"
+// @Success  200    {object}  resp.Response{data=dto.LicenseTypeEffectiveFeatures}  "有效功能"
+// @Router   /activate/license-type/effective-features [get]
+func (cl *LicenseTypeController) GetEffectiveFeatures(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	typeID, err := strconv.Atoi(c.Query("type_id"))
+	if err != nil || typeID == 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.GetEffectiveFeatures(c, uai.UserID, typeID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// SetLicenseTypeParent
+// @Tags     LicenseType
+// @Summary  设置许可证类型的父类型，返回有效功能发生变化的许可证类型和设备
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data          body      dto.SetLicenseTypeParent  true  "父类型参数，dry_run时只返回影响报告"
+// @Success  200    {object}  resp.Response{data=dto.LicenseTypeParentResult}  "影响报告"
+// @Router   /activate/license-type/set-parent [post]
+func (cl *LicenseTypeController) SetLicenseTypeParent(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.SetLicenseTypeParent
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, violations, code := cl.s.SetLicenseTypeParent(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.ErrorWithData(c, code, "", violations)
+		return
+	}
+
+	resp.Success(c, result)
+}
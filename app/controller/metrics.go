@@ -25,6 +25,8 @@ func NewMetricsController() *MetricsController {
 // @Produce  application/json
 // @Param    page      query int    false "页码" default(1)
 // @Param    page_size query int    false "每页数量" default(10)
+// @Param    cursor    query string false "上一页返回的next_cursor，传入时忽略页码"
+// @Param    count     query string false "总数统计方式：exact、capped、none"
 // @Param    type      query string false "事件类型"
 // @Param    pageId    query string false "页面ID"
 // @Param    route     query string false "页面"
@@ -55,12 +57,13 @@ func (cl *MetricsController) ListMetrics(c *gin.Context) {
 	}
 
 	q := dto.MetricEventQuery{
-		PageParams: dto.PageParams{Page: page, PageSize: pageSize},
-		Type:       c.Query("type"),
-		Route:      c.Query("route"),
-		PageID:     c.Query("pageId"),
-		StartTS:    startTS,
-		EndTS:      endTS,
+		PageParams:   dto.PageParams{Page: page, PageSize: pageSize},
+		Type:         c.Query("type"),
+		Route:        c.Query("route"),
+		PageID:       c.Query("pageId"),
+		StartTS:      startTS,
+		EndTS:        endTS,
+		CursorParams: dto.CursorParams{Cursor: c.Query("cursor"), Count: c.Query("count")},
 	}
 
 	result, code := cl.s.ListMetrics(c, q)
@@ -0,0 +1,316 @@
+package controller
+
+import (
+	"strconv"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/req-resp/resp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+)
+
+// OrderController 订单和生产批次控制器
+type OrderController struct {
+	s *service.OrderService
+}
+
+// NewOrderController 创建订单和生产批次控制器
+func NewOrderController() *OrderController {
+	return &OrderController{s: service.NewOrderService()}
+}
+
+// ListOrders
+// @Tags     Order
+// @Summary  查询订单列表
+// @Produce  application/json
+// @Param    Authorization  header    string  true   "Authorization"
+// @Param    product_id     query     int     false  "产品ID"
+// @Param    customer_id    query     int     false  "客户ID"
+// @Param    order_no       query     string  false  "订单号，模糊搜索"
+// @Param    page           query     int     false  "页码"
+// @Param    page_size      query     int     true   "每页数量"
+// @Param    cursor         query     string  false  "游标，传入时忽略页码"
+// @Param    count          query     string  false  "总数统计方式：exact、capped、none"
+// @Success  200    {object}  resp.Response{data=dto.PageResult{list=[]dto.OrderInfo}}  "查询订单列表"
+// @Router   /activate/order/list [get]
+func (cl *OrderController) ListOrders(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var query dto.OrderQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.ListOrders(c, uai.UserID, query)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// AddOrder
+// @Tags     Order
+// @Summary  添加订单
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.AddOrder  true  "参数：订单信息"
+// @Success  200   {object}  resp.Response{data=dto.OrderInfo}  "订单信息"
+// @Router   /activate/order/add [post]
+func (cl *OrderController) AddOrder(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.AddOrder
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	info, code := cl.s.AddOrder(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, info)
+}
+
+// UpdateOrder
+// @Tags     Order
+// @Summary  修改订单
+// @Description  已入库设备的许可证类型不受影响
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.ModifyOrder  true  "参数：订单信息"
+// @Success  200   {object}  resp.Response{message=string}  "修改订单"
+// @Router   /activate/order/update [post]
+func (cl *OrderController) UpdateOrder(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.ModifyOrder
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.UpdateOrder(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// DeleteOrder
+// @Tags     Order
+// @Summary  删除订单
+// @Description  订单下还有批次或设备时不能删除
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    id  query     int     true  "订单ID"
+// @Success  200   {object}  resp.Response{message=string}  "删除订单"
+// @Router   /activate/order/del [get]
+func (cl *OrderController) DeleteOrder(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Query("id"))
+	if err != nil || id <= 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.DeleteOrder(c, uai.UserID, id)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// FulfilmentReport
+// @Tags     Order
+// @Summary  订单履约报表
+// @Description  统计每个订单的订购数量、已入库设备数量和已激活过的设备数量，分页方式与设备列表相同
+// @Produce  application/json
+// @Param    Authorization  header    string  true   "Authorization"
+// @Param    product_id     query     int     false  "产品ID"
+// @Param    customer_id    query     int     false  "客户ID"
+// @Param    order_no       query     string  false  "订单号，模糊搜索"
+// @Param    page           query     int     false  "页码"
+// @Param    page_size      query     int     true   "每页数量"
+// @Param    cursor         query     string  false  "游标，传入时忽略页码"
+// @Param    count          query     string  false  "总数统计方式：exact、capped、none"
+// @Success  200    {object}  resp.Response{data=dto.PageResult{list=[]dto.OrderFulfilment}}  "订单履约报表"
+// @Router   /activate/order/fulfilment [get]
+func (cl *OrderController) FulfilmentReport(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var query dto.OrderQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.FulfilmentReport(c, uai.UserID, query)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// ListLots
+// @Tags     Lot
+// @Summary  查询生产批次列表
+// @Produce  application/json
+// @Param    Authorization  header    string  true   "Authorization"
+// @Param    product_id     query     int     false  "产品ID"
+// @Param    order_id       query     int     false  "订单ID"
+// @Param    lot_no         query     string  false  "批次号，模糊搜索"
+// @Param    page           query     int     false  "页码"
+// @Param    page_size      query     int     true   "每页数量"
+// @Param    cursor         query     string  false  "游标，传入时忽略页码"
+// @Param    count          query     string  false  "总数统计方式：exact、capped、none"
+// @Success  200    {object}  resp.Response{data=dto.PageResult{list=[]dto.LotInfo}}  "查询生产批次列表"
+// @Router   /activate/lot/list [get]
+func (cl *OrderController) ListLots(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var query dto.LotQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.ListLots(c, uai.UserID, query)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// AddLot
+// @Tags     Lot
+// @Summary  添加生产批次
+// @Description  批次号在产品内唯一，所属订单必须属于同一产品
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.AddLot  true  "参数：生产批次信息"
+// @Success  200   {object}  resp.Response{data=dto.LotInfo}  "生产批次信息"
+// @Router   /activate/lot/add [post]
+func (cl *OrderController) AddLot(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.AddLot
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	info, code := cl.s.AddLot(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, info)
+}
+
+// UpdateLot
+// @Tags     Lot
+// @Summary  修改生产批次
+// @Description  批次改挂订单时，批次下设备的来源订单同步修改
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.ModifyLot  true  "参数：生产批次信息"
+// @Success  200   {object}  resp.Response{message=string}  "修改生产批次"
+// @Router   /activate/lot/update [post]
+func (cl *OrderController) UpdateLot(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.ModifyLot
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.UpdateLot(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// DeleteLot
+// @Tags     Lot
+// @Summary  删除生产批次
+// @Description  批次下还有设备时不能删除
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    id  query     int     true  "批次ID"
+// @Success  200   {object}  resp.Response{message=string}  "删除生产批次"
+// @Router   /activate/lot/del [get]
+func (cl *OrderController) DeleteLot(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Query("id"))
+	if err != nil || id <= 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.DeleteLot(c, uai.UserID, id)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
@@ -25,6 +25,8 @@ func NewPostController() *PostController {
 // @Produce  application/json
 // @Param    page     query    int     false  "页码，从1开始"   default(1)
 // @Param    page_size query    int     false  "每页数量"        default(10)
+// @Param    cursor    query    string  false  "上一页返回的next_cursor，传入时忽略页码"
+// @Param    count     query    string  false  "总数统计方式：exact、capped、none"
 // @Param    search   query    string  false  "搜索关键字"
 // @Param    status   query    string  false  "文章状态"
 // @Param    category_id query int     false  "类别ID"
@@ -52,10 +54,11 @@ func (cl *PostController) ListPost(c *gin.Context) {
 
 	// 构建查询参数
 	query := dto.PostQuery{
-		Page:     page,
-		PageSize: pageSize,
-		Search:   c.Query("search"),
-		Status:   c.Query("status"),
+		Page:         page,
+		PageSize:     pageSize,
+		Search:       c.Query("search"),
+		Status:       c.Query("status"),
+		CursorParams: dto.CursorParams{Cursor: c.Query("cursor"), Count: c.Query("count")},
 	}
 
 	if categoryID, err := strconv.Atoi(c.Query("category_id")); err == nil {
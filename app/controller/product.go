@@ -213,3 +213,33 @@ func (cl *ProductController) RemoveManager(c *gin.Context) {
 	}
 	resp.Success(c)
 }
+
+// CloneProduct
+// @Tags     Product
+// @Summary  复制产品的功能、许可证类型，可选复制版本，返回新旧ID对应关系
+// @Produce  application/json
+// @Param    Authorization header     string true "Authorization"
+// @Param    data  body      dto.CloneProduct   true  "参数：复制产品"
+// @Success  200   {object}  resp.Response{data=dto.CloneProductResult}  "复制产品"
+// @Router   /activate/product/clone [post]
+func (cl *ProductController) CloneProduct(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.CloneProduct
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.CloneProduct(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
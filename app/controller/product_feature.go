@@ -121,3 +121,93 @@ func (cl *ProductFeatureController) DeleteProductFeature(c *gin.Context) {
 
 	resp.Success(c)
 }
+
+// GetFeatureGraph
+// @Tags     ProductFeature
+// @Summary  获取产品的功能依赖/互斥关系图
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    product_id    query     int     true  "产品ID"
+// @Success  200    {object}  resp.Response{data=dto.FeatureGraph}  "功能关系图"
+// @Router   /activate/product-feature/graph [get]
+func (cl *ProductFeatureController) GetFeatureGraph(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	productID, err := strconv.Atoi(c.Query("product_id"))
+	if err != nil || productID == 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.GetFeatureGraph(c, uai.UserID, productID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// AddFeatureRelation
+// @Tags     ProductFeature
+// @Summary  添加功能之间的依赖或互斥关系
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data          body      dto.FeatureRelation  true  "功能关系"
+// @Success  200    {object}  resp.Response  "添加功能关系，校验失败时data为dto.FeatureViolation列表"
+// @Router   /activate/product-feature/relation/add [post]
+func (cl *ProductFeatureController) AddFeatureRelation(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.FeatureRelation
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	violations, code := cl.s.AddFeatureRelation(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.ErrorWithData(c, code, "", violations)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// RemoveFeatureRelation
+// @Tags     ProductFeature
+// @Summary  删除功能之间的依赖或互斥关系
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data          body      dto.FeatureRelation  true  "功能关系"
+// @Success  200    {object}  resp.Response  "删除功能关系"
+// @Router   /activate/product-feature/relation/del [post]
+func (cl *ProductFeatureController) RemoveFeatureRelation(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.FeatureRelation
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.RemoveFeatureRelation(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
@@ -0,0 +1,194 @@
+package controller
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/req-resp/resp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+)
+
+// ProductInvitationController 产品邀请控制器
+type ProductInvitationController struct {
+	s *service.ProductInvitationService
+}
+
+// NewProductInvitationController 创建产品邀请控制器
+func NewProductInvitationController() *ProductInvitationController {
+	return &ProductInvitationController{s: service.NewProductInvitationService()}
+}
+
+// InviteManager
+// @Tags     invitation
+// @Summary  邀请产品副管理员
+// @Description  被邀请人接受后加入，邮箱未注册时在注册后自动加入
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.InviteManager  true  "产品、邮箱和角色"
+// @Success  200    {object}  resp.Response{data=dto.InvitationInfo}  "邀请信息"
+// @Router   /activate/invitation/manager [post]
+func (cl *ProductInvitationController) InviteManager(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.InviteManager
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.InviteManager(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// TransferOwnership
+// @Tags     invitation
+// @Summary  发起产品主管理员转让
+// @Description  只有主管理员和系统管理员可以发起，新主管理员接受后生效，原主管理员降为只读副管理员
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.TransferOwnership  true  "产品和新主管理员邮箱"
+// @Success  200    {object}  resp.Response{data=dto.InvitationInfo}  "邀请信息"
+// @Router   /activate/invitation/transfer [post]
+func (cl *ProductInvitationController) TransferOwnership(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.TransferOwnership
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.TransferOwnership(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// ListInvitations
+// @Tags     invitation
+// @Summary  获取产品的邀请列表
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    product_id     query     int     true  "产品ID"
+// @Param    status         query     string  false "状态(pending/accepted/declined/revoked/expired)"
+// @Success  200    {object}  resp.Response{data=[]dto.InvitationInfo}  "邀请列表"
+// @Router   /activate/invitation/list [get]
+func (cl *ProductInvitationController) ListInvitations(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var query dto.InvitationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.ListInvitations(c, uai.UserID, query)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// ListMyInvitations
+// @Tags     invitation
+// @Summary  获取当前用户收到的待处理邀请
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Success  200    {object}  resp.Response{data=[]dto.InvitationInfo}  "邀请列表"
+// @Router   /activate/invitation/mine [get]
+func (cl *ProductInvitationController) ListMyInvitations(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	result, code := cl.s.ListMyInvitations(c, uai.UserID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// AcceptInvitation
+// @Tags     invitation
+// @Summary  接受邀请
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.InvitationID  true  "邀请ID"
+// @Success  200    {object}  resp.Response  "接受成功"
+// @Router   /activate/invitation/accept [post]
+func (cl *ProductInvitationController) AcceptInvitation(c *gin.Context) {
+	cl.respond(c, cl.s.AcceptInvitation)
+}
+
+// DeclineInvitation
+// @Tags     invitation
+// @Summary  拒绝邀请
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.InvitationID  true  "邀请ID"
+// @Success  200    {object}  resp.Response  "拒绝成功"
+// @Router   /activate/invitation/decline [post]
+func (cl *ProductInvitationController) DeclineInvitation(c *gin.Context) {
+	cl.respond(c, cl.s.DeclineInvitation)
+}
+
+// RevokeInvitation
+// @Tags     invitation
+// @Summary  撤销待处理的邀请
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.InvitationID  true  "邀请ID"
+// @Success  200    {object}  resp.Response  "撤销成功"
+// @Router   /activate/invitation/revoke [post]
+func (cl *ProductInvitationController) RevokeInvitation(c *gin.Context) {
+	cl.respond(c, cl.s.RevokeInvitation)
+}
+
+// respond 处理按邀请ID操作的请求
+func (cl *ProductInvitationController) respond(c *gin.Context, fn func(*gin.Context, int, int) resource.RspCode) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.InvitationID
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	if code := fn(c, uai.UserID, param.ID); code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
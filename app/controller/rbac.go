@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"strconv"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/req-resp/resp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+)
+
+// RBACController 角色权限控制器
+type RBACController struct {
+	s *service.RBACService
+}
+
+// NewRBACController 创建角色权限控制器
+func NewRBACController() *RBACController {
+	return &RBACController{s: service.NewRBACService()}
+}
+
+// GetRoleMatrix
+// @Tags     RBAC
+// @Summary  获取全部角色及其权限
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Success  200    {object}  resp.Response{data=[]dto.RolePermissions}  "权限矩阵"
+// @Router   /activate/rbac/roles [get]
+func (cl *RBACController) GetRoleMatrix(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	resp.Success(c, cl.s.GetRoleMatrix())
+}
+
+// GetMyPermissions
+// @Tags     RBAC
+// @Summary  获取当前用户在产品中的角色和权限
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    product_id    query     int     true  "产品ID"
+// @Success  200    {object}  resp.Response{data=dto.ProductPermissions}  "角色和权限"
+// @Router   /activate/rbac/my-permissions [get]
+func (cl *RBACController) GetMyPermissions(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	productID, err := strconv.Atoi(c.Query("product_id"))
+	if err != nil || productID == 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.GetMyPermissions(c, uai.UserID, productID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// SetManagerRole
+// @Tags     RBAC
+// @Summary  设置产品副管理员的角色
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.SetManagerRole  true  "管理员ID和角色"
+// @Success  200    {object}  resp.Response  "设置成功"
+// @Router   /activate/rbac/manager-role [post]
+func (cl *RBACController) SetManagerRole(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.SetManagerRole
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	if code := cl.s.SetManagerRole(c, uai.UserID, param); code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// SetSystemAdmin
+// @Tags     RBAC
+// @Summary  授予或撤销系统管理员
+// @Description  只有超级管理员可以操作
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.SetSystemAdmin  true  "用户ID和是否启用"
+// @Success  200    {object}  resp.Response  "设置成功"
+// @Router   /activate/rbac/system-admin [post]
+func (cl *RBACController) SetSystemAdmin(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.SetSystemAdmin
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	if code := cl.s.SetSystemAdmin(c, uai.UserID, param); code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
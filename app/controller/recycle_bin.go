@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/req-resp/resp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+)
+
+// RecycleBinController 回收站控制器
+type RecycleBinController struct {
+	s *service.RecycleBinService
+}
+
+// NewRecycleBinController 创建回收站控制器
+func NewRecycleBinController() *RecycleBinController {
+	return &RecycleBinController{s: service.NewRecycleBinService()}
+}
+
+// ListRecycleBin
+// @Tags     RecycleBin
+// @Summary  查询产品回收站
+// @Produce  application/json
+// @Param    Authorization  header    string  true   "Authorization"
+// @Param    product_id     query     int     false  "产品ID，type为product时不需要"
+// @Param    type           query     string  true   "记录类型：product、device、license_type、feature、firmware_version、software_version"
+// @Param    page           query     int     true   "页码"
+// @Param    page_size      query     int     true   "每页数量"
+// @Success  200    {object}  resp.Response{data=dto.PageResult{list=[]dto.RecycleItem}}  "回收站记录"
+// @Router   /activate/recycle-bin/list [get]
+func (cl *RecycleBinController) ListRecycleBin(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var query dto.RecycleBinQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.ListRecycleBin(c, uai.UserID, query)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// RestoreRecycled
+// @Tags     RecycleBin
+// @Summary  从回收站恢复记录
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.RecycleRestore  true  "恢复的记录"
+// @Success  200   {object}  resp.Response{message=string}  "从回收站恢复记录"
+// @Router   /activate/recycle-bin/restore [post]
+func (cl *RecycleBinController) RestoreRecycled(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.RecycleRestore
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.RestoreRecycled(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
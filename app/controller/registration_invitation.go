@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/req-resp/resp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+)
+
+// RegistrationInvitationController 注册邀请码控制器
+type RegistrationInvitationController struct {
+	s *service.RegistrationInvitationService
+}
+
+// NewRegistrationInvitationController 创建注册邀请码控制器
+func NewRegistrationInvitationController() *RegistrationInvitationController {
+	return &RegistrationInvitationController{s: service.NewRegistrationInvitationService()}
+}
+
+// CreateInvitation
+// @Tags     registration-invitation
+// @Summary  创建注册邀请码
+// @Description  不指定产品时只有系统管理员可以创建；指定产品时需要该产品的管理员管理权限，注册后以access_role加入产品。邀请码只在本次返回
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.CreateRegistrationInvitation  true  "绑定邮箱、使用次数、产品和角色"
+// @Success  200    {object}  resp.Response{data=dto.RegistrationInvitationInfo}  "邀请码信息"
+// @Router   /activate/registration-invitation/create [post]
+func (cl *RegistrationInvitationController) CreateInvitation(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.CreateRegistrationInvitation
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.CreateInvitation(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// ListInvitations
+// @Tags     registration-invitation
+// @Summary  获取注册邀请码列表
+// @Description  系统管理员可查看全部，产品管理员需指定产品
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    product_id     query     int     false "产品ID"
+// @Param    status         query     string  false "状态(active/used_up/revoked/expired)"
+// @Success  200    {object}  resp.Response{data=[]dto.RegistrationInvitationInfo}  "邀请码列表"
+// @Router   /activate/registration-invitation/list [get]
+func (cl *RegistrationInvitationController) ListInvitations(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var query dto.RegistrationInvitationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.ListInvitations(c, uai.UserID, query)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// RevokeInvitation
+// @Tags     registration-invitation
+// @Summary  撤销注册邀请码
+// @Description  已注册的用户不受影响
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.InvitationID  true  "邀请码ID"
+// @Success  200    {object}  resp.Response  "撤销成功"
+// @Router   /activate/registration-invitation/revoke [post]
+func (cl *RegistrationInvitationController) RevokeInvitation(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.InvitationID
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	if code := cl.s.RevokeInvitation(c, uai.UserID, param.ID); code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
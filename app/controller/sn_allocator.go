@@ -0,0 +1,216 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/req-resp/resp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+)
+
+// SnAllocatorController SN分配控制器
+type SnAllocatorController struct {
+	s *service.SnAllocatorService
+}
+
+// NewSnAllocatorController 创建SN分配控制器
+func NewSnAllocatorController() *SnAllocatorController {
+	return &SnAllocatorController{s: service.NewSnAllocatorService()}
+}
+
+// ListSnAllocators
+// @Tags     SnAllocator
+// @Summary  获取产品的SN分配器列表
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    product_id     query     int     true  "产品ID"
+// @Success  200    {object}  resp.Response  "SN分配器列表"
+// @Router   /activate/sn-allocator/list [get]
+func (cl *SnAllocatorController) ListSnAllocators(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	productID, err := strconv.Atoi(c.Query("product_id"))
+	if err != nil || productID <= 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.ListSnAllocators(c, uai.UserID, productID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// AddSnAllocator
+// @Tags     SnAllocator
+// @Summary  新增SN分配器
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.AddSnAllocator  true  "SN分配器"
+// @Success  200   {object}  resp.Response{message=string}  "新增SN分配器"
+// @Router   /activate/sn-allocator/add [post]
+func (cl *SnAllocatorController) AddSnAllocator(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.AddSnAllocator
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.AddSnAllocator(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// ModifySnAllocator
+// @Tags     SnAllocator
+// @Summary  修改SN分配器
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.ModifySnAllocator  true  "SN分配器"
+// @Success  200   {object}  resp.Response{message=string}  "修改SN分配器"
+// @Router   /activate/sn-allocator/put [post]
+func (cl *SnAllocatorController) ModifySnAllocator(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.ModifySnAllocator
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.ModifySnAllocator(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// AllocateSnBlock
+// @Tags     SnAllocator
+// @Summary  分配一段连续的SN，可选预创建设备
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.AllocateSnBlock  true  "分配参数"
+// @Success  200   {object}  resp.Response{data=dto.SnBlockInfo}  "分配记录"
+// @Router   /activate/sn-allocator/allocate [post]
+func (cl *SnAllocatorController) AllocateSnBlock(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.AllocateSnBlock
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.AllocateSnBlock(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// ListSnBlocks
+// @Tags     SnAllocator
+// @Summary  查询SN分配台账
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    product_id     query     int     true   "产品ID"
+// @Param    allocator_id   query     int     false  "分配器ID"
+// @Param    page           query     int     false  "页码"
+// @Param    page_size      query     int     false  "每页数量"
+// @Success  200    {object}  resp.Response{data=dto.PageResult}  "分配台账"
+// @Router   /activate/sn-allocator/blocks [get]
+func (cl *SnAllocatorController) ListSnBlocks(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var query dto.SnBlockQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.ListSnBlocks(c, uai.UserID, query)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// ExportSnBlock
+// @Tags     SnAllocator
+// @Summary  导出分配记录中的SN（csv或zpl标签）
+// @Produce  application/octet-stream
+// @Param    Authorization  header    string  true   "Authorization"
+// @Param    id             path      int     true   "分配记录ID"
+// @Param    format         query     string  false  "导出格式：csv（默认）或zpl"
+// @Success  200    {file}  file  "SN文件"
+// @Router   /activate/sn-allocator/blocks/{id}/export [get]
+func (cl *SnAllocatorController) ExportSnBlock(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+	format := c.DefaultQuery("format", service.SnExportCSV)
+	if format != service.SnExportCSV && format != service.SnExportZPL {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, filename, code := cl.s.ExportSnBlock(c, uai.UserID, id, format)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Header("Content-Length", fmt.Sprint(len(result)))
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Access-Control-Expose-Headers", "Content-Disposition")
+	c.Data(200, "application/octet-stream", result)
+}
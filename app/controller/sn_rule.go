@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"strconv"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/req-resp/resp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+)
+
+// SnRuleController 序列号规则控制器
+type SnRuleController struct {
+	s *service.SnRuleService
+}
+
+// NewSnRuleController 创建序列号规则控制器
+func NewSnRuleController() *SnRuleController {
+	return &SnRuleController{s: service.NewSnRuleService()}
+}
+
+// GetSnRule
+// @Tags     SnRule
+// @Summary  获取产品的序列号规则
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    product_id     query     int     true  "产品ID"
+// @Success  200    {object}  resp.Response  "序列号规则，未配置时为空"
+// @Router   /activate/sn-rule/get [get]
+func (cl *SnRuleController) GetSnRule(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	productID, err := strconv.Atoi(c.Query("product_id"))
+	if err != nil || productID <= 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.GetSnRule(c, uai.UserID, productID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// SaveSnRule
+// @Tags     SnRule
+// @Summary  新增或更新产品的序列号规则
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.SaveSnRule  true  "序列号规则"
+// @Success  200   {object}  resp.Response{message=string}  "保存序列号规则"
+// @Router   /activate/sn-rule/save [post]
+func (cl *SnRuleController) SaveSnRule(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.SaveSnRule
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.SaveSnRule(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// DeleteSnRule
+// @Tags     SnRule
+// @Summary  删除产品的序列号规则
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    product_id     query     int     true  "产品ID"
+// @Success  200    {object}  resp.Response{message=string}  "删除序列号规则"
+// @Router   /activate/sn-rule/del [get]
+func (cl *SnRuleController) DeleteSnRule(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	productID, err := strconv.Atoi(c.Query("product_id"))
+	if err != nil || productID <= 0 {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	code := cl.s.DeleteSnRule(c, uai.UserID, productID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// ValidateSNs
+// @Tags     SnRule
+// @Summary  按产品规则预校验序列号
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data  body      dto.ValidateSNs  true  "待校验的序列号"
+// @Success  200   {object}  resp.Response{data=[]dto.SNCheckResult}  "逐个SN的校验结果"
+// @Router   /activate/sn-rule/validate [post]
+func (cl *SnRuleController) ValidateSNs(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.ValidateSNs
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.ValidateSNs(c, uai.UserID, param)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
@@ -0,0 +1,242 @@
+package controller
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/req-resp/resp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+)
+
+// TwoFactorController 两步验证控制器
+type TwoFactorController struct {
+	s *service.TwoFactorService
+}
+
+// NewTwoFactorController 创建两步验证控制器
+func NewTwoFactorController() *TwoFactorController {
+	return &TwoFactorController{s: service.NewTwoFactorService()}
+}
+
+// Status
+// @Tags     two-factor
+// @Summary  获取当前用户的两步验证状态
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Success  200    {object}  resp.Response{data=dto.TwoFactorStatus}  "两步验证状态"
+// @Router   /activate/two-factor/status [get]
+func (cl *TwoFactorController) Status(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	result, code := cl.s.Status(c, uai.UserID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// Enroll
+// @Tags     two-factor
+// @Summary  获取开启两步验证的密钥和二维码
+// @Description  密钥10分钟内有效，使用activate确认后生效
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Success  200    {object}  resp.Response{data=dto.TwoFactorEnrollment}  "密钥和二维码"
+// @Router   /activate/two-factor/enroll [post]
+func (cl *TwoFactorController) Enroll(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	result, code := cl.s.Enroll(c, uai.UserID)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// Activate
+// @Tags     two-factor
+// @Summary  确认开启两步验证
+// @Description  恢复码只在本次返回
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.TwoFactorCode  true  "验证码"
+// @Success  200    {object}  resp.Response{data=dto.TwoFactorRecoveryCodes}  "恢复码"
+// @Router   /activate/two-factor/activate [post]
+func (cl *TwoFactorController) Activate(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.TwoFactorCode
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.Activate(c, uai.UserID, param.Code)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// Disable
+// @Tags     two-factor
+// @Summary  关闭两步验证
+// @Description  角色要求两步验证时不能关闭
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.TwoFactorDisable  true  "验证码或恢复码"
+// @Success  200    {object}  resp.Response  "关闭成功"
+// @Router   /activate/two-factor/disable [post]
+func (cl *TwoFactorController) Disable(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.TwoFactorDisable
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	if code := cl.s.Disable(c, uai.UserID, param); code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// RegenerateRecoveryCodes
+// @Tags     two-factor
+// @Summary  重新生成恢复码
+// @Description  原恢复码全部失效，新恢复码只在本次返回
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.TwoFactorCode  true  "验证码"
+// @Success  200    {object}  resp.Response{data=dto.TwoFactorRecoveryCodes}  "恢复码"
+// @Router   /activate/two-factor/recovery-codes [post]
+func (cl *TwoFactorController) RegenerateRecoveryCodes(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.TwoFactorCode
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	result, code := cl.s.RegenerateRecoveryCodes(c, uai.UserID, param.Code)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// Reset
+// @Tags     two-factor
+// @Summary  重置用户的两步验证
+// @Description  只有系统管理员可以操作，用于用户丢失设备和恢复码的情况
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.TwoFactorReset  true  "用户ID"
+// @Success  200    {object}  resp.Response  "重置成功"
+// @Router   /activate/two-factor/reset [post]
+func (cl *TwoFactorController) Reset(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.TwoFactorReset
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	if code := cl.s.Reset(c, uai.UserID, param.UserID); code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
+
+// GetPolicy
+// @Tags     two-factor
+// @Summary  获取必须开启两步验证的角色
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Success  200    {object}  resp.Response{data=dto.TwoFactorPolicy}  "角色列表"
+// @Router   /activate/two-factor/policy [get]
+func (cl *TwoFactorController) GetPolicy(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	result, code := cl.s.GetPolicy(c)
+	if code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c, result)
+}
+
+// SetPolicy
+// @Tags     two-factor
+// @Summary  设置必须开启两步验证的角色
+// @Description  只有系统管理员可以操作，整体替换原设置；用户在任一产品中拥有其中的角色即必须开启
+// @Produce  application/json
+// @Param    Authorization  header    string  true  "Authorization"
+// @Param    data           body      dto.TwoFactorPolicy  true  "角色列表"
+// @Success  200    {object}  resp.Response  "设置成功"
+// @Router   /activate/two-factor/policy [post]
+func (cl *TwoFactorController) SetPolicy(c *gin.Context) {
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID == 0 {
+		resp.Error(c, resource.ERR_TOKEN_EXPIRED)
+		return
+	}
+
+	var param dto.TwoFactorPolicy
+	if err := c.ShouldBindJSON(&param); err != nil {
+		resp.Error(c, resource.ERR_INVALID_PARAMETER)
+		return
+	}
+
+	if code := cl.s.SetPolicy(c, uai.UserID, param); code != resource.CODE_SUCCESS {
+		resp.Error(c, code)
+		return
+	}
+
+	resp.Success(c)
+}
@@ -333,9 +333,14 @@ func (cl *VersionController) GetProductFirmwareVersions(c *gin.Context) {
 		resp.Error(c, resource.ERR_INVALID_PARAMETER)
 		return
 	}
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID <= 0 {
+		resp.Error(c, resource.ERR_NO_PERMISSION)
+		return
+	}
 
 	// 调用服务层方法
-	result, code := cl.s.GetProductFirmwareVersions(c, productID)
+	result, code := cl.s.GetProductFirmwareVersions(c, uai.UserID, productID)
 	if code != resource.CODE_SUCCESS {
 		resp.Error(c, code)
 		return
@@ -360,9 +365,14 @@ func (cl *VersionController) GetProductFeatures(c *gin.Context) {
 		resp.Error(c, resource.ERR_INVALID_PARAMETER)
 		return
 	}
+	uai := auth.GetUserAuthInfo(c)
+	if uai.UserID <= 0 {
+		resp.Error(c, resource.ERR_NO_PERMISSION)
+		return
+	}
 
 	// 调用服务层方法
-	result, code := cl.s.GetProductFeatures(c, productID)
+	result, code := cl.s.GetProductFeatures(c, uai.UserID, productID)
 	if code != resource.CODE_SUCCESS {
 		resp.Error(c, code)
 		return
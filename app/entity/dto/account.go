@@ -0,0 +1,17 @@
+package dto
+
+// SendAccountEmail 发送邮箱验证或密码重置邮件请求参数
+type SendAccountEmail struct {
+	Email string `json:"email" binding:"required,email"` // 用户邮箱
+}
+
+// ValidateEmail 验证邮箱请求参数
+type ValidateEmail struct {
+	Token string `json:"token" binding:"required"` // 邮件链接中的令牌
+}
+
+// ResetPassword 重置密码请求参数
+type ResetPassword struct {
+	Token    string `json:"token" binding:"required"`          // 邮件链接中的令牌
+	Password string `json:"password" binding:"required,min=6"` // 新密码
+}
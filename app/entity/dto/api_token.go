@@ -0,0 +1,79 @@
+package dto
+
+import (
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+)
+
+// API令牌的授权范围，每个范围对应若干产品内的权限，令牌的权限同时受其代表用户的角色限制
+const (
+	ScopeProductsRead  = "products:read"  // 查看产品及其配置
+	ScopeDevicesRead   = "devices:read"   // 查看设备、客户、订单、任务和心跳
+	ScopeDevicesWrite  = "devices:write"  // 添加、修改和删除设备及其客户、订单
+	ScopeSNAllocate    = "sn:allocate"    // 分配SN号段
+	ScopeVersionsRead  = "versions:read"  // 查看韧件和软件版本
+	ScopeVersionsWrite = "versions:write" // 发布、修改和删除韧件和软件版本
+	ScopeAuditRead     = "audit:read"     // 查看审计日志
+)
+
+const (
+	// APITokenPersonal 个人令牌，代表创建人
+	APITokenPersonal = "personal"
+	// APITokenService 服务令牌，代表为其创建的服务账号
+	APITokenService = "service"
+	// APITokenStatusActive 未撤销且未过期的令牌
+	APITokenStatusActive = "active"
+	// APITokenStatusRevoked 已撤销的令牌
+	APITokenStatusRevoked = "revoked"
+	// APITokenStatusExpired 未撤销但已超过有效期的令牌
+	APITokenStatusExpired = "expired"
+)
+
+// CreatePersonalToken 创建个人API令牌请求参数
+type CreatePersonalToken struct {
+	Name       string   `json:"name" binding:"required,max=64"`                 // 令牌名称
+	Scopes     []string `json:"scopes" binding:"required,min=1,dive,required"`  // 授权范围
+	ProductIDs []int    `json:"product_ids" binding:"omitempty,dive,min=1"`     // 限制可访问的产品，为空时不限制
+	ExpireDays int      `json:"expire_days" binding:"omitempty,min=1,max=3650"` // 有效期（天），为空时不过期
+}
+
+// CreateServiceToken 创建服务API令牌请求参数，同时创建服务账号并以指定角色加入产品
+type CreateServiceToken struct {
+	Name       string                    `json:"name" binding:"required,max=64"`                                                             // 令牌名称
+	ProductID  int                       `json:"product_id" binding:"required"`                                                              // 产品ID
+	AccessRole productmanager.AccessRole `json:"access_role" binding:"omitempty,oneof=viewer device_operator release_manager product_admin"` // 服务账号的角色，默认viewer
+	Scopes     []string                  `json:"scopes" binding:"required,min=1,dive,required"`                                              // 授权范围
+	ExpireDays int                       `json:"expire_days" binding:"omitempty,min=1,max=3650"`                                             // 有效期（天），为空时不过期
+}
+
+// APITokenQuery API令牌列表查询参数，不指定产品时返回自己的个人令牌
+type APITokenQuery struct {
+	ProductID int    `form:"product_id"`                                              // 产品ID，返回该产品的服务令牌
+	Status    string `form:"status" binding:"omitempty,oneof=active revoked expired"` // 状态
+}
+
+// APITokenID 撤销API令牌请求参数
+type APITokenID struct {
+	ID int `json:"id" binding:"required"` // 令牌ID
+}
+
+// APITokenInfo API令牌信息
+type APITokenInfo struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Type        string     `json:"type"`            // personal, service
+	Token       string     `json:"token,omitempty"` // 完整令牌，只在创建时返回一次
+	TokenPrefix string     `json:"token_prefix"`
+	UserID      int        `json:"user_id"`
+	UserEmail   string     `json:"user_email,omitempty"`
+	Scopes      []string   `json:"scopes"`
+	ProductIDs  []int      `json:"product_ids,omitempty"`
+	Status      string     `json:"status"` // active, revoked, expired
+	CreatedBy   int        `json:"created_by"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	LastUsedIP  string     `json:"last_used_ip,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
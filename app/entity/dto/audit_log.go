@@ -14,17 +14,28 @@ const (
 	ModuleFirmwareVersion AuditLogModule = "firmware_version"
 	ModuleSoftwareVersion AuditLogModule = "software_version"
 	ModuleDevice          AuditLogModule = "device"
+	ModuleSnRule          AuditLogModule = "sn_rule"
+	ModuleSnAllocator     AuditLogModule = "sn_allocator"
+	ModuleDeviceTag       AuditLogModule = "device_tag"
+	ModuleDeviceGroup     AuditLogModule = "device_group"
+	ModuleCustomer        AuditLogModule = "customer"
+	ModuleOrder           AuditLogModule = "order"
+	ModuleLot             AuditLogModule = "lot"
 )
 
 // 定义操作类型常量
 type AuditLogAction string
 
 const (
-	ActionCreate   AuditLogAction = "create"
-	ActionUpdate   AuditLogAction = "update"
-	ActionDelete   AuditLogAction = "delete"
-	ActionLogin    AuditLogAction = "login"
-	ActionRegister AuditLogAction = "register"
+	ActionCreate        AuditLogAction = "create"
+	ActionUpdate        AuditLogAction = "update"
+	ActionDelete        AuditLogAction = "delete"
+	ActionRestore       AuditLogAction = "restore"
+	ActionLogin         AuditLogAction = "login"
+	ActionRegister      AuditLogAction = "register"
+	ActionVerifyEmail   AuditLogAction = "verify_email"
+	ActionResetPassword AuditLogAction = "reset_password"
+	ActionTwoFactor     AuditLogAction = "two_factor"
 )
 
 type AuditLogData struct {
@@ -45,6 +56,7 @@ type OperationLogQuery struct {
 	ProductID int       `form:"product_id"`
 	StartTime time.Time `form:"start_time"`
 	EndTime   time.Time `form:"end_time"`
+	CursorParams
 }
 
 // OperationLogResponse 操作日志响应
@@ -0,0 +1,71 @@
+package dto
+
+// CatalogVersion 产品目录文档的格式版本
+const CatalogVersion = 1
+
+// CatalogDocument 产品目录文档（YAML），记录之间按编码关联而不是ID，便于在不同环境之间迁移
+type CatalogDocument struct {
+	Version  int              `yaml:"version" json:"version"`
+	Products []CatalogProduct `yaml:"products" json:"products"`
+}
+
+// CatalogProduct 产品定义，按code匹配已有产品
+type CatalogProduct struct {
+	Code                  string               `yaml:"code" json:"code"`
+	ProductName           string               `yaml:"product_name" json:"product_name"`
+	ProductType           string               `yaml:"product_type,omitempty" json:"product_type"`
+	WarrantyMonths        *int                 `yaml:"warranty_months,omitempty" json:"warranty_months"` // 为空表示默认值
+	DeviceAttributeSchema string               `yaml:"device_attribute_schema,omitempty" json:"device_attribute_schema"`
+	Features              []CatalogFeature     `yaml:"features" json:"features"`
+	LicenseTypes          []CatalogLicenseType `yaml:"license_types" json:"license_types"`
+	Managers              []CatalogManager     `yaml:"managers,omitempty" json:"managers"` // 不写表示不管理管理员
+}
+
+// CatalogFeature 功能定义，按feature_code匹配
+type CatalogFeature struct {
+	FeatureCode string `yaml:"feature_code" json:"feature_code"`
+	FeatureName string `yaml:"feature_name" json:"feature_name"`
+}
+
+// CatalogLicenseType 许可证类型定义，按license_type匹配，features为功能编码列表（不含继承的功能）
+type CatalogLicenseType struct {
+	LicenseType string   `yaml:"license_type" json:"license_type"`
+	TypeName    string   `yaml:"type_name" json:"type_name"`
+	Parent      string   `yaml:"parent,omitempty" json:"parent,omitempty"` // 父许可证编码
+	Features    []string `yaml:"features" json:"features"`
+}
+
+// CatalogManager 产品管理员，按用户邮箱匹配
+type CatalogManager struct {
+	Email       string `yaml:"email" json:"email"`
+	Role        string `yaml:"role" json:"role"`                                   // main或assistant
+	Permissions string `yaml:"permissions,omitempty" json:"permissions,omitempty"` // read或full，默认read
+	AccessRole  string `yaml:"access_role,omitempty" json:"access_role,omitempty"` // 副管理员的角色，设置后覆盖permissions
+}
+
+// 目录变更类型
+const (
+	CatalogCreate = "create"
+	CatalogUpdate = "update"
+	CatalogDelete = "delete"
+)
+
+// CatalogChange 导入目录时的一项变更
+type CatalogChange struct {
+	Action  string   `json:"action"`           // create、update、delete
+	Kind    string   `json:"kind"`             // product、feature、license_type、manager
+	Product string   `json:"product"`          // 产品代号
+	Key     string   `json:"key"`              // 产品代号、功能编码、许可证编码或管理员邮箱
+	Fields  []string `json:"fields,omitempty"` // 更新的字段
+}
+
+// CatalogPlan 导入计划，errors不为空时不能执行
+type CatalogPlan struct {
+	Changes []CatalogChange `json:"changes"`
+	Errors  []string        `json:"errors"`
+}
+
+// CatalogExport 导出产品目录请求
+type CatalogExport struct {
+	ProductIDs []int `form:"product_ids" binding:"required,min=1"`
+}
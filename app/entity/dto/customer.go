@@ -0,0 +1,101 @@
+package dto
+
+import "time"
+
+// CustomerQuery 客户列表查询参数
+type CustomerQuery struct {
+	Search   string `form:"search"` // 按名称、联系人模糊搜索
+	Region   string `form:"region"`
+	OEMTag   string `form:"oem_tag"`
+	Page     int    `form:"page" binding:"required,min=1"`
+	PageSize int    `form:"page_size" binding:"required,min=1,max=100"`
+}
+
+// AddCustomer 添加客户请求
+type AddCustomer struct {
+	Name         string `json:"name" binding:"required"`
+	ContactName  string `json:"contact_name"`
+	ContactPhone string `json:"contact_phone"`
+	ContactEmail string `json:"contact_email" binding:"omitempty,email"`
+	Region       string `json:"region"`
+	OEMTag       string `json:"oem_tag"`
+	Remark       string `json:"remark"`
+}
+
+// ModifyCustomer 修改客户请求
+type ModifyCustomer struct {
+	ID int `json:"id" binding:"required"`
+	AddCustomer
+}
+
+// CustomerInfo 客户信息
+type CustomerInfo struct {
+	ID           int       `json:"id"`
+	Name         string    `json:"name"`
+	ContactName  string    `json:"contact_name"`
+	ContactPhone string    `json:"contact_phone"`
+	ContactEmail string    `json:"contact_email"`
+	Region       string    `json:"region"`
+	OEMTag       string    `json:"oem_tag"`
+	Remark       string    `json:"remark"`
+	DeviceCount  int       `json:"device_count"` // 当前用户可见的设备数量
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// AssignCustomer 设置设备归属客户请求，customer_id为0表示解除归属；未指定保修时间时，已出货的设备按出货时间和产品保修期计算
+type AssignCustomer struct {
+	DeviceIDs       []int      `json:"device_ids"`
+	GroupID         int        `json:"group_id"` // 以设备分组作为目标，与device_ids合并
+	CustomerID      int        `json:"customer_id"`
+	WarrantyStartAt *time.Time `json:"warranty_start_at"` // 指定保修开始时间
+	WarrantyEndAt   *time.Time `json:"warranty_end_at"`   // 指定保修截止时间
+	Remark          string     `json:"remark"`
+}
+
+// CustomerDeviceQuery 客户设备列表查询参数
+type CustomerDeviceQuery struct {
+	CustomerID int `form:"customer_id" binding:"required"`
+	ProductID  int `form:"product_id"`
+	Page       int `form:"page" binding:"omitempty,min=1"`
+	PageSize   int `form:"page_size" binding:"required,min=1,max=100"`
+	CursorParams
+}
+
+// DeviceAssignmentInfo 设备归属变更记录
+type DeviceAssignmentInfo struct {
+	ID                   int        `json:"id"`
+	DeviceID             int        `json:"device_id"`
+	CustomerID           int        `json:"customer_id"`
+	CustomerName         string     `json:"customer_name"`
+	PreviousCustomerID   int        `json:"previous_customer_id"`
+	PreviousCustomerName string     `json:"previous_customer_name"`
+	WarrantyStartAt      *time.Time `json:"warranty_start_at,omitempty"`
+	WarrantyEndAt        *time.Time `json:"warranty_end_at,omitempty"`
+	Remark               string     `json:"remark"`
+	AssignedBy           int        `json:"assigned_by"`
+	AssignedAt           time.Time  `json:"assigned_at"`
+}
+
+// WarrantyExpiryQuery 保修到期报表查询参数
+type WarrantyExpiryQuery struct {
+	ProductID      int  `form:"product_id"`
+	CustomerID     int  `form:"customer_id"`
+	Days           int  `form:"days" binding:"omitempty,min=1,max=3650"` // 统计未来多少天内到期，默认30天
+	IncludeExpired bool `form:"include_expired"`                         // 是否包含已过保的设备
+	Page           int  `form:"page" binding:"required,min=1"`
+	PageSize       int  `form:"page_size" binding:"required,min=1,max=100"`
+}
+
+// WarrantyExpiryItem 保修到期报表条目
+type WarrantyExpiryItem struct {
+	DeviceID      int       `json:"device_id"`
+	SN            string    `json:"sn"`
+	ProductID     int       `json:"product_id"`
+	ProductName   string    `json:"product_name"`
+	CustomerID    int       `json:"customer_id"`
+	CustomerName  string    `json:"customer_name"`
+	State         string    `json:"state"`
+	WarrantyEndAt time.Time `json:"warranty_end_at"`
+	DaysLeft      int       `json:"days_left"` // 剩余天数，已过保为负数
+}
@@ -1,78 +1,168 @@
 package dto
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
+
+// DeviceCondition 设备筛选条件，可作为筛选器保存
+type DeviceCondition struct {
+	ProductID       int      `json:"product_id" form:"product_id"`
+	LicenseTypeID   int      `json:"license_type_id" form:"license_type_id"`
+	SN              string   `json:"sn" form:"sn"`
+	OEMTag          string   `json:"oem_tag" form:"oem_tag"`
+	State           string   `json:"state" form:"state" binding:"omitempty,oneof=manufactured shipped activated suspended rma scrapped"` // 生命周期状态
+	TagIDs          []int    `json:"tag_ids" form:"tag_ids"`                                                                             // 标签ID，设备需包含全部标签
+	GroupID         int      `json:"group_id" form:"group_id"`                                                                           // 设备分组ID
+	Online          string   `json:"online" form:"online" binding:"omitempty,oneof=online offline"`                                      // 在线状态
+	SoftwareVersion string   `json:"software_version" form:"software_version"`                                                           // 最后上报的软件版本
+	FirmwareVersion string   `json:"firmware_version" form:"firmware_version"`                                                           // 最后上报的韧件版本
+	CustomerID      int      `json:"customer_id" form:"customer_id"`                                                                     // 所属客户ID
+	OrderID         int      `json:"order_id" form:"order_id"`                                                                           // 来源订单ID
+	LotID           int      `json:"lot_id" form:"lot_id"`                                                                               // 来源生产批次ID
+	Attrs           []string `json:"attrs" form:"attrs"`                                                                                 // 自定义属性条件，如mac=00:11:22:33:44:55、board_rev>=2
+}
 
 // DeviceFilter 设备查询过滤条件
 type DeviceFilter struct {
-	ProductID     int    `json:"product_id" form:"product_id"`
-	LicenseTypeID int    `json:"license_type_id" form:"license_type_id"`
-	SN            string `json:"sn" form:"sn"`
-	OEMTag        string `json:"oem_tag" form:"oem_tag"`
-	Page          int    `json:"page" form:"page" binding:"required,min=1"`
-	PageSize      int    `json:"page_size" form:"page_size" binding:"required,min=1,max=100"`
+	DeviceCondition
+	CursorParams
+	SavedFilterID int `json:"saved_filter_id" form:"saved_filter_id"`     // 使用已保存的筛选器，忽略其它筛选条件
+	Page          int `json:"page" form:"page" binding:"omitempty,min=1"` // 页码，传入游标时忽略
+	PageSize      int `json:"page_size" form:"page_size" binding:"required,min=1,max=100"`
 }
 
 // DeviceAdd 添加设备请求
 type DeviceAdd struct {
-	ProductID     int    `json:"product_id" binding:"required"`
-	SN            string `json:"sn" binding:"required"`
-	LicenseTypeID int    `json:"license_type_id" binding:"required"`
-	OEMTag        string `json:"oem_tag"`
-	Remark        string `json:"remark"`
+	ProductID     int                    `json:"product_id" binding:"required"`
+	SN            string                 `json:"sn" binding:"required"`
+	LicenseTypeID int                    `json:"license_type_id" binding:"required"`
+	OEMTag        string                 `json:"oem_tag"`
+	Remark        string                 `json:"remark"`
+	Attributes    map[string]interface{} `json:"attributes"` // 自定义属性
 }
 
 // DeviceBatchAdd 批量添加设备请求
 type DeviceBatchAdd struct {
-	ProductID     int      `json:"product_id" binding:"required"`
-	SNs           []string `json:"sns" binding:"required"`
-	LicenseTypeID int      `json:"license_type_id" binding:"required"`
-	OEMTag        string   `json:"oem_tag"`
-	Remark        string   `json:"remark"`
+	ProductID      int                               `json:"product_id" binding:"required"`
+	SNs            []string                          `json:"sns" binding:"required"`
+	LicenseTypeID  int                               `json:"license_type_id"` // 未指定时使用批次或订单的许可证类型
+	OrderID        int                               `json:"order_id"`        // 来源订单，指定批次时可省略
+	LotID          int                               `json:"lot_id"`          // 来源生产批次
+	OEMTag         string                            `json:"oem_tag"`
+	Remark         string                            `json:"remark"`
+	Attributes     map[string]interface{}            `json:"attributes"`                // 所有设备共用的自定义属性
+	ItemAttributes map[string]map[string]interface{} `json:"item_attributes,omitempty"` // 按SN指定的自定义属性，覆盖attributes中的同名属性
+}
+
+// DeviceImport 导入设备请求（multipart表单，文件字段为file），CSV表头中SN之后的列为自定义属性
+type DeviceImport struct {
+	ProductID     int    `form:"product_id" binding:"required"`
+	LicenseTypeID int    `form:"license_type_id"` // 未指定时使用批次或订单的许可证类型
+	OrderID       int    `form:"order_id"`
+	LotID         int    `form:"lot_id"`
+	OEMTag        string `form:"oem_tag"`
+	Remark        string `form:"remark"`
 }
 
 // DeviceUpdate 更新设备请求
 type DeviceUpdate struct {
-	ID            int    `json:"id" binding:"required"`
-	LicenseTypeID int    `json:"license_type_id" binding:"required"`
-	OEMTag        string `json:"oem_tag"`
-	Remark        string `json:"remark"`
+	ID            int                    `json:"id" binding:"required"`
+	LicenseTypeID int                    `json:"license_type_id" binding:"required"`
+	OEMTag        string                 `json:"oem_tag"`
+	Remark        string                 `json:"remark"`
+	Attributes    map[string]interface{} `json:"attributes"` // 自定义属性，不传表示不修改，传入时整体替换
 }
 
 // DeviceInfo 设备信息
 type DeviceInfo struct {
-	ID              int       `json:"id"`
-	SN              string    `json:"sn"`
-	SNEncrypted     string    `json:"sn_encrypted"` // 序列号AES加密字段
-	ProductID       int       `json:"product_id"`
-	ProductName     string    `json:"product_name"`
-	ProductCode     string    `json:"product_code"`
-	LicenseTypeID   int       `json:"license_type_id"`
-	LicenseTypeName string    `json:"license_type_name"`
-	LicenseTypeCode string    `json:"license_type_code"`
-	OEMTag          string    `json:"oem_tag"`
-	Remark          string    `json:"remark"`
-	CreatedAt       time.Time `json:"created_at"`
-	CreatedBy       int       `json:"created_by"`
-	CreatedByEmail  string    `json:"created_by_email"`
-	UpdatedAt       time.Time `json:"updated_at"`
-	UpdatedBy       int       `json:"updated_by"`
-	UpdatedByEmail  string    `json:"updated_by_email"`
-}
-
-// DeviceSummary 设备简要信息
+	ID              int                      `json:"id"`
+	SN              string                   `json:"sn"`
+	SNEncrypted     string                   `json:"sn_encrypted"` // 序列号AES加密字段
+	ProductID       int                      `json:"product_id"`
+	ProductName     string                   `json:"product_name"`
+	ProductCode     string                   `json:"product_code"`
+	LicenseTypeID   int                      `json:"license_type_id"`
+	LicenseTypeName string                   `json:"license_type_name"`
+	LicenseTypeCode string                   `json:"license_type_code"`
+	OEMTag          string                   `json:"oem_tag"`
+	Remark          string                   `json:"remark"`
+	State           string                   `json:"state"`
+	ShippedAt       *time.Time               `json:"shipped_at,omitempty"`
+	ActivatedAt     *time.Time               `json:"activated_at,omitempty"`
+	SuspendedAt     *time.Time               `json:"suspended_at,omitempty"`
+	RmaAt           *time.Time               `json:"rma_at,omitempty"`
+	ScrappedAt      *time.Time               `json:"scrapped_at,omitempty"`
+	LastSeenAt      *time.Time               `json:"last_seen_at,omitempty"`
+	CustomerID      int                      `json:"customer_id"`
+	CustomerName    string                   `json:"customer_name"`
+	WarrantyStartAt *time.Time               `json:"warranty_start_at,omitempty"`
+	WarrantyEndAt   *time.Time               `json:"warranty_end_at,omitempty"`
+	OrderID         int                      `json:"order_id"`
+	LotID           int                      `json:"lot_id"`
+	Attributes      map[string]interface{}   `json:"attributes"`
+	Online          bool                     `json:"online"`
+	SoftwareVersion string                   `json:"software_version"` // 最后上报的软件版本
+	FirmwareVersion string                   `json:"firmware_version"` // 最后上报的韧件版本
+	Uptime          int64                    `json:"uptime"`           // 最后上报的运行时长（秒）
+	CreatedAt       time.Time                `json:"created_at"`
+	CreatedBy       int                      `json:"created_by"`
+	CreatedByEmail  string                   `json:"created_by_email"`
+	UpdatedAt       time.Time                `json:"updated_at"`
+	UpdatedBy       int                      `json:"updated_by"`
+	UpdatedByEmail  string                   `json:"updated_by_email"`
+	Tags            []DeviceTagInfo          `json:"tags"`
+	Features        []DeviceFeature          `json:"features,omitempty"` // 设备详情中返回，包含功能来源
+	AddOns          []DeviceFeatureAddOnInfo `json:"add_ons,omitempty"`  // 设备详情中返回，包含已过期的附加项
+}
+
+// DeviceSummary 产品设备统计
 type DeviceSummary struct {
-	ProductID   int    `json:"product_id"`
-	ProductName string `json:"product_name"`
-	Count       int    `json:"count"`
+	ProductID    int                `json:"product_id"`
+	ProductName  string             `json:"product_name"`
+	Count        int                `json:"count"`
+	LicenseTypes []LicenseTypeCount `json:"license_types"` // 按许可证类型统计
+	States       map[string]int     `json:"states"`        // 按生命周期状态统计
+}
+
+// LicenseTypeCount 许可证类型设备数量，license_type_id为0表示未分配许可证类型
+type LicenseTypeCount struct {
+	LicenseTypeID int `json:"license_type_id"`
+	Count         int `json:"count"`
 }
 
 // DeviceBatchUpdateLicense 批量更新许可证类型请求
 type DeviceBatchUpdateLicense struct {
-	DeviceIDs     []int  `json:"device_ids" binding:"required"`
+	DeviceIDs     []int  `json:"device_ids"`
+	GroupID       int    `json:"group_id"` // 以设备分组作为目标，与device_ids合并
 	LicenseTypeID int    `json:"license_type_id" binding:"required"`
 	Remark        string `json:"remark"`
 }
 
+// DeviceTransition 设备状态变更请求
+type DeviceTransition struct {
+	ID     int    `json:"id" binding:"required"`
+	State  string `json:"state" binding:"required,oneof=manufactured shipped activated suspended rma scrapped"`
+	Remark string `json:"remark"`
+}
+
+// DeviceBatchTransition 批量设备状态变更请求
+type DeviceBatchTransition struct {
+	DeviceIDs []int  `json:"device_ids"`
+	GroupID   int    `json:"group_id"` // 以设备分组作为目标，与device_ids合并
+	State     string `json:"state" binding:"required,oneof=manufactured shipped activated suspended rma scrapped"`
+	Remark    string `json:"remark"`
+}
+
+// DeviceTransitionResult 单个设备的状态变更结果
+type DeviceTransitionResult struct {
+	ID      int    `json:"id"`
+	SN      string `json:"sn"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Success bool   `json:"success"`
+}
+
 // ActivationData 激活数据
 type ActivationData struct {
 	SN           string   `json:"sn"`            // 设备序列号
@@ -88,3 +178,98 @@ type ActivationFile struct {
 	Data      ActivationData `json:"data"`      // 激活数据
 	Signature []byte         `json:"signature"` // RSA签名
 }
+
+// SaveSnRule 保存产品序列号规则请求
+type SaveSnRule struct {
+	ProductID      int    `json:"product_id" binding:"required"`                             // 产品ID
+	Prefix         string `json:"prefix"`                                                    // SN前缀
+	Pattern        string `json:"pattern"`                                                   // SN正则表达式
+	MinLength      int    `json:"min_length" binding:"min=0"`                                // 最小长度，0不限制
+	MaxLength      int    `json:"max_length" binding:"min=0"`                                // 最大长度，0不限制
+	CheckAlgorithm string `json:"check_algorithm" binding:"omitempty,oneof=none luhn mod37"` // 校验位算法
+}
+
+// ValidateSNs 序列号预校验请求
+type ValidateSNs struct {
+	ProductID int      `json:"product_id" binding:"required"` // 产品ID
+	SNs       []string `json:"sns" binding:"required"`        // 待校验的SN列表
+}
+
+// SN校验失败原因
+const (
+	SNReasonLength    = "length"    // 长度不符
+	SNReasonPrefix    = "prefix"    // 前缀不符
+	SNReasonPattern   = "pattern"   // 不匹配正则
+	SNReasonChecksum  = "checksum"  // 校验位错误
+	SNReasonExist     = "exist"     // SN已存在
+	SNReasonDuplicate = "duplicate" // 请求中重复
+)
+
+// SNCheckResult 单个SN的校验结果
+type SNCheckResult struct {
+	SN     string `json:"sn"`
+	Valid  bool   `json:"valid"`
+	Reason string `json:"reason,omitempty"` // 失败原因
+}
+
+// DeviceExport 设备导出条件，与设备列表使用相同的筛选条件
+type DeviceExport struct {
+	DeviceCondition
+	SavedFilterID int `json:"saved_filter_id" form:"saved_filter_id"` // 使用已保存的筛选器，忽略其它筛选条件
+}
+
+// DeviceAttributeSchema 设置产品的设备属性定义
+type DeviceAttributeSchema struct {
+	ProductID int             `json:"product_id" binding:"required"`
+	Schema    json.RawMessage `json:"schema"` // JSON Schema，为空或null表示取消限制
+}
+
+// DeviceAttributeSchemaInfo 产品的设备属性定义
+type DeviceAttributeSchemaInfo struct {
+	ProductID  int             `json:"product_id"`
+	Schema     json.RawMessage `json:"schema"`
+	Properties []string        `json:"properties"` // 已定义的属性名，按名称排序
+}
+
+// 设备功能来源
+const (
+	FeatureSourceLicenseType = "license_type" // 来自许可证类型
+	FeatureSourceAddOn       = "add_on"       // 设备单独授予
+)
+
+// DeviceFeatureAddOn 设置设备的功能附加项，同一设备的同一功能重复设置时覆盖
+type DeviceFeatureAddOn struct {
+	DeviceID  int        `json:"device_id" binding:"required"`
+	FeatureID int        `json:"feature_id" binding:"required"`
+	Effect    string     `json:"effect" binding:"required,oneof=grant deny"` // grant额外授予，deny禁用许可证类型中的功能
+	ExpiresAt *time.Time `json:"expires_at"`                                 // 为空表示长期有效
+	Remark    string     `json:"remark"`
+}
+
+// DeviceFeatureAddOnInfo 设备的功能附加项
+type DeviceFeatureAddOnInfo struct {
+	ID          int        `json:"id"`
+	DeviceID    int        `json:"device_id"`
+	FeatureID   int        `json:"feature_id"`
+	FeatureCode string     `json:"feature_code"`
+	FeatureName string     `json:"feature_name"`
+	Effect      string     `json:"effect"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	Expired     bool       `json:"expired"`
+	Remark      string     `json:"remark"`
+	CreatedBy   int        `json:"created_by"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedBy   int        `json:"updated_by"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// DeviceFeature 设备的功能及其来源，被禁用的许可证类型功能active为false
+type DeviceFeature struct {
+	FeatureID   int        `json:"feature_id"`
+	FeatureCode string     `json:"feature_code"`
+	FeatureName string     `json:"feature_name"`
+	Source      string     `json:"source"`               // license_type或add_on
+	Active      bool       `json:"active"`               // 是否写入激活文件
+	AddOnID     int        `json:"add_on_id,omitempty"`  // 生效的附加项ID
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"` // 附加项的到期时间
+}
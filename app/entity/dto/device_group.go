@@ -0,0 +1,78 @@
+package dto
+
+import "time"
+
+// AddDeviceTag 新增设备标签请求
+type AddDeviceTag struct {
+	ProductID int    `json:"product_id" binding:"required"` // 产品ID
+	Name      string `json:"name" binding:"required"`       // 标签名称
+	Color     string `json:"color"`                         // 标签颜色
+}
+
+// ModifyDeviceTag 修改设备标签请求
+type ModifyDeviceTag struct {
+	ID    int    `json:"id" binding:"required"`   // 标签ID
+	Name  string `json:"name" binding:"required"` // 标签名称
+	Color string `json:"color"`                   // 标签颜色
+}
+
+// DeviceTagInfo 设备标签信息
+type DeviceTagInfo struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// AssignDeviceTags 为设备添加或移除标签请求
+type AssignDeviceTags struct {
+	DeviceIDs []int `json:"device_ids" binding:"required"` // 设备ID列表
+	TagIDs    []int `json:"tag_ids" binding:"required"`    // 标签ID列表
+}
+
+// AddDeviceGroup 新增设备分组请求
+type AddDeviceGroup struct {
+	ProductID   int    `json:"product_id" binding:"required"` // 产品ID
+	Name        string `json:"name" binding:"required"`       // 分组名称
+	Description string `json:"description"`                   // 分组描述
+}
+
+// ModifyDeviceGroup 修改设备分组请求
+type ModifyDeviceGroup struct {
+	ID          int    `json:"id" binding:"required"`   // 分组ID
+	Name        string `json:"name" binding:"required"` // 分组名称
+	Description string `json:"description"`             // 分组描述
+}
+
+// DeviceGroupMembers 分组成员变更请求
+type DeviceGroupMembers struct {
+	GroupID   int   `json:"group_id" binding:"required"`   // 分组ID
+	DeviceIDs []int `json:"device_ids" binding:"required"` // 设备ID列表
+}
+
+// DeviceGroupInfo 设备分组信息
+type DeviceGroupInfo struct {
+	ID          int       `json:"id"`
+	ProductID   int       `json:"product_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	DeviceCount int       `json:"device_count"`
+	CreatedBy   int       `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// SaveDeviceFilter 保存设备筛选器请求，ID为空时新增
+type SaveDeviceFilter struct {
+	ID        int             `json:"id"`                      // 筛选器ID
+	Name      string          `json:"name" binding:"required"` // 筛选器名称
+	Condition DeviceCondition `json:"condition"`               // 筛选条件
+}
+
+// DeviceFilterInfo 已保存的设备筛选器
+type DeviceFilterInfo struct {
+	ID        int             `json:"id"`
+	Name      string          `json:"name"`
+	Condition DeviceCondition `json:"condition"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
@@ -0,0 +1,50 @@
+package dto
+
+// 功能关系类型
+const (
+	FeatureRelationRequires  = "requires"  // 依赖：启用feature时必须同时启用related
+	FeatureRelationConflicts = "conflicts" // 互斥：feature和related不能同时启用
+)
+
+// FeatureRelation 添加或删除功能之间的依赖/互斥关系
+type FeatureRelation struct {
+	FeatureID int    `json:"feature_id" binding:"required"`                        // 功能ID
+	RelatedID int    `json:"related_id" binding:"required"`                        // 关联功能ID，需与功能属于同一产品
+	Relation  string `json:"relation" binding:"required,oneof=requires conflicts"` // 关系类型
+}
+
+// FeatureGraphNode 功能关系图中的节点
+type FeatureGraphNode struct {
+	ID          int    `json:"id"`
+	FeatureCode string `json:"feature_code"`
+	FeatureName string `json:"feature_name"`
+}
+
+// FeatureGraphEdge 功能关系图中的边，互斥关系只返回一条（from < to）
+type FeatureGraphEdge struct {
+	From     int    `json:"from"`
+	To       int    `json:"to"`
+	Relation string `json:"relation"`
+}
+
+// FeatureGraph 产品的功能依赖/互斥关系图
+type FeatureGraph struct {
+	ProductID int                `json:"product_id"`
+	Nodes     []FeatureGraphNode `json:"nodes"`
+	Edges     []FeatureGraphEdge `json:"edges"`
+}
+
+// 功能组合校验失败的原因
+const (
+	FeatureViolationMissing  = "missing_dependency"
+	FeatureViolationConflict = "conflict"
+	FeatureViolationCycle    = "dependency_cycle"
+)
+
+// FeatureViolation 功能组合校验失败的一项，作为错误响应的data返回
+type FeatureViolation struct {
+	Rule        string `json:"rule"`         // missing_dependency、conflict或dependency_cycle
+	FeatureCode string `json:"feature_code"` // 功能编码
+	RelatedCode string `json:"related_code"` // 缺少的依赖或互斥的功能编码
+	Message     string `json:"message"`
+}
@@ -29,24 +29,27 @@ type UserLoginInfo struct {
 
 type AddProduct struct {
 	//ID          int    `json:"id,omitempty"`                    //指定产品id
-	Code        string `json:"code" binding:"required"`         // 产品代号
-	ProductName string `json:"product_name" binding:"required"` // 产品名称
-	ProductType string `json:"product_type" binding:"required"` // 产品名称
+	Code           string `json:"code" binding:"required"`                           // 产品代号
+	ProductName    string `json:"product_name" binding:"required"`                   // 产品名称
+	ProductType    string `json:"product_type" binding:"required"`                   // 产品名称
+	WarrantyMonths *int   `json:"warranty_months" binding:"omitempty,min=0,max=240"` // 保修期（月），不传默认12个月
 }
 
 type productAssistant struct {
 	UserID     int                        `json:"user_id"`
 	Permission productmanager.Permissions `json:"permission"`
+	AccessRole productmanager.AccessRole  `json:"access_role"` // 角色，设置后覆盖permission
 	Remark     string                     `json:"remark"`
 }
 
 type ModifyProduct struct { //also used in add_product
 	ID int `json:"id" binding:"required"` // 产品id不可空
 	//Code        string `json:"code" binding:"required"`         // 产品代号不允许修改
-	ProductName      string             `json:"product_name,omitempty"`      // 产品名称
-	ProductType      string             `json:"product_type,omitempty"`      // 产品类别
-	ManagerMain      int                `json:"manager_main,omitempty"`      // 主管理员
-	ManagerAssistant []productAssistant `json:"manager_assistant,omitempty"` // 副管理员
+	ProductName      string             `json:"product_name,omitempty"`                                      // 产品名称
+	ProductType      string             `json:"product_type,omitempty"`                                      // 产品类别
+	WarrantyMonths   *int               `json:"warranty_months,omitempty" binding:"omitempty,min=0,max=240"` // 保修期（月）
+	ManagerMain      int                `json:"manager_main,omitempty"`                                      // 主管理员，变更需通过转让邀请
+	ManagerAssistant []productAssistant `json:"manager_assistant,omitempty"`                                 // 副管理员
 }
 
 // AddManager 添加产品管理员请求参数
@@ -54,6 +57,7 @@ type AddManager struct {
 	ProductID   int                        `json:"product_id" binding:"required"`  // 产品ID
 	Email       string                     `json:"email" binding:"required,email"` // 用户邮箱
 	Permissions productmanager.Permissions `json:"permissions"`                    // 权限 (read/full)
+	AccessRole  productmanager.AccessRole  `json:"access_role"`                    // 角色 (viewer/device_operator/release_manager/product_admin)，设置后覆盖permissions
 	Remark      string                     `json:"remark"`                         // 备注
 }
 
@@ -63,6 +67,8 @@ type AddLicenseType struct {
 	TypeName    string `json:"type_name" binding:"required"`    // 许可证类型名称
 	LicenseType string `json:"license_type" binding:"required"` // 许可证编码
 	FeatureIDs  []int  `json:"feature_ids"`                     // 功能ID列表
+	AutoInclude bool   `json:"auto_include"`                    // 自动加入依赖的功能
+	ParentID    int    `json:"parent_id"`                       // 父许可证类型ID，继承其功能，0表示无
 }
 
 // AddProductFeature 添加产品功能请求参数
@@ -74,8 +80,9 @@ type AddProductFeature struct {
 
 // UpdateLicenseTypeFeatures 更新许可证类型功能列表请求参数
 type UpdateLicenseTypeFeatures struct {
-	TypeID     int   `json:"type_id" binding:"required"` // 许可证类型ID
-	FeatureIDs []int `json:"feature_ids"`                // 功能ID列表
+	TypeID      int   `json:"type_id" binding:"required"` // 许可证类型ID
+	FeatureIDs  []int `json:"feature_ids"`                // 功能ID列表
+	AutoInclude bool  `json:"auto_include"`               // 自动加入依赖的功能
 }
 
 // PageParams 分页参数
@@ -84,13 +91,29 @@ type PageParams struct {
 	PageSize int `form:"page_size" json:"page_size"` // 每页数量
 }
 
+// 总数统计方式
+const (
+	CountExact    = "exact"    // 精确统计
+	CountCapped   = "capped"   // 统计到上限为止，超过上限时返回上限并标记capped
+	CountNone     = "none"     // 不统计，total为-1
+)
+
+// CursorParams 游标分页参数，传入游标时按(created_at, id)倒序游标分页并忽略页码
+type CursorParams struct {
+	Cursor string `form:"cursor" json:"cursor"`                                             // 上一页返回的next_cursor
+	Count  string `form:"count" json:"count" binding:"omitempty,oneof=exact capped none"` // 总数统计方式，页码分页默认exact，游标分页默认none
+}
+
 // PageResult 分页结果
 type PageResult struct {
-	Total    int64                  `json:"total"`     // 总记录数
-	Page     int                    `json:"page"`      // 当前页码
-	PageSize int                    `json:"page_size"` // 每页数量
-	List     interface{}            `json:"list"`      // 数据列表
-	Extra    map[string]interface{} `json:"extra,omitempty"` // 额外数据
+	Total      int64                  `json:"total"`                 // 总记录数，未统计时为-1
+	Page       int                    `json:"page"`                  // 当前页码，游标分页时为0
+	PageSize   int                    `json:"page_size"`             // 每页数量
+	List       interface{}            `json:"list"`                  // 数据列表
+	Extra      map[string]interface{} `json:"extra,omitempty"`       // 额外数据
+	NextCursor string                 `json:"next_cursor,omitempty"` // 下一页游标，没有更多数据时为空
+	HasMore    bool                   `json:"has_more,omitempty"`    // 是否还有更多数据
+	Capped     bool                   `json:"capped,omitempty"`      // total是否达到统计上限，实际总数可能更多
 }
 
 //// OperationLogQuery 操作日志查询参数
@@ -0,0 +1,39 @@
+package dto
+
+// 设备心跳签名请求头，签名为 hex(Ed25519(timestamp + "\n" + body))，使用设备自行生成的私钥
+const (
+	HeaderDeviceSN        = "X-Device-SN"
+	HeaderDeviceTimestamp = "X-Device-Timestamp"
+	HeaderDeviceSignature = "X-Device-Signature"
+)
+
+// DeviceHeartbeat 设备心跳请求
+type DeviceHeartbeat struct {
+	SN              string                 `json:"sn" binding:"required"`  // 设备序列号，需与请求头一致
+	SoftwareVersion string                 `json:"software_version"`       // 当前软件版本
+	FirmwareVersion string                 `json:"firmware_version"`       // 当前韧件版本
+	Uptime          int64                  `json:"uptime" binding:"min=0"` // 运行时长（秒）
+	Status          map[string]interface{} `json:"status"`                 // 状态信息，键数量和大小有限制
+}
+
+// DeviceHeartbeatKey 登记设备的心跳签名公钥。设备自行生成Ed25519密钥对，
+// 由有设备写权限的人员在产线或运维环节提交公钥，重新登记会替换旧公钥
+type DeviceHeartbeatKey struct {
+	ID        int    `json:"id" binding:"required"`         // 设备ID
+	PublicKey string `json:"public_key" binding:"required"` // Ed25519公钥，标准base64编码
+}
+
+// VersionCount 版本及设备数量
+type VersionCount struct {
+	Version string `json:"version"`
+	Count   int    `json:"count"`
+}
+
+// VersionDistribution 产品的设备版本分布
+type VersionDistribution struct {
+	ProductID int            `json:"product_id"`
+	Total     int            `json:"total"`    // 设备总数
+	Online    int            `json:"online"`   // 在线设备数
+	Software  []VersionCount `json:"software"` // 软件版本分布，未上报的版本为空字符串
+	Firmware  []VersionCount `json:"firmware"` // 韧件版本分布
+}
@@ -0,0 +1,58 @@
+package dto
+
+import "time"
+
+// JobMessageProgress WebSocket推送的任务进度消息类型
+const JobMessageProgress = "job_progress"
+
+// JobQuery 任务列表查询参数，普通用户只能查看自己提交的任务
+type JobQuery struct {
+	Type     string `form:"type" binding:"omitempty,oneof=device_batch_add device_batch_license"`
+	Status   string `form:"status" binding:"omitempty,oneof=pending running succeeded failed canceled"`
+	Page     int    `form:"page" binding:"omitempty,min=1"`
+	PageSize int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+	CursorParams
+}
+
+// JobID 取消或重试任务请求
+type JobID struct {
+	ID int `json:"id" binding:"required"`
+}
+
+// JobItemError 任务中处理失败的条目
+type JobItemError struct {
+	Item   string `json:"item"`   // 设备SN或设备ID
+	Reason string `json:"reason"` // 失败原因
+}
+
+// JobResult 任务结果
+type JobResult struct {
+	Succeeded  int   `json:"succeeded"`
+	Failed     int   `json:"failed"`
+	ProductIDs []int `json:"product_ids"` // 涉及的产品
+}
+
+// JobInfo 任务信息
+type JobInfo struct {
+	ID          int            `json:"id"`
+	Type        string         `json:"type"`
+	Status      string         `json:"status"`
+	Total       int            `json:"total"`
+	Processed   int            `json:"processed"`
+	Succeeded   int            `json:"succeeded"`
+	Failed      int            `json:"failed"`
+	Progress    int            `json:"progress"` // 进度百分比
+	Result      *JobResult     `json:"result,omitempty"`
+	ErrorReport []JobItemError `json:"error_report,omitempty"` // 仅任务详情返回
+	RetryOf     int            `json:"retry_of,omitempty"`
+	CreatedBy   int            `json:"created_by"`
+	CreatedAt   time.Time      `json:"created_at"`
+	StartedAt   *time.Time     `json:"started_at,omitempty"`
+	FinishedAt  *time.Time     `json:"finished_at,omitempty"`
+}
+
+// JobProgressMessage 推送给任务提交人的进度消息
+type JobProgressMessage struct {
+	Type string  `json:"type"`
+	Job  JobInfo `json:"job"`
+}
@@ -0,0 +1,62 @@
+package dto
+
+// SetLicenseTypeParent 设置或清除许可证类型的父类型
+type SetLicenseTypeParent struct {
+	TypeID   int  `json:"type_id" binding:"required"` // 许可证类型ID
+	ParentID int  `json:"parent_id"`                  // 父类型ID，0表示清除
+	DryRun   bool `json:"dry_run"`                    // 只返回影响报告，不修改
+}
+
+// LicenseTypeRef 许可证类型的简要信息
+type LicenseTypeRef struct {
+	ID          int    `json:"id"`
+	LicenseType string `json:"license_type"`
+	TypeName    string `json:"type_name"`
+}
+
+// EffectiveFeature 许可证类型的有效功能及其来源
+type EffectiveFeature struct {
+	FeatureID    int    `json:"feature_id"`
+	FeatureCode  string `json:"feature_code"`
+	FeatureName  string `json:"feature_name"`
+	SourceTypeID int    `json:"source_type_id"` // 提供该功能的许可证类型，等于本类型时表示非继承
+	SourceType   string `json:"source_type"`    // 提供该功能的许可证编码
+	Inherited    bool   `json:"inherited"`
+}
+
+// LicenseTypeEffectiveFeatures 许可证类型的继承链和有效功能
+type LicenseTypeEffectiveFeatures struct {
+	TypeID   int                `json:"type_id"`
+	Chain    []LicenseTypeRef   `json:"chain"` // 从本类型到最上层父类型
+	Features []EffectiveFeature `json:"features"`
+}
+
+// LicenseTypeFeatureChange 修改父类型后某个许可证类型的有效功能变化
+type LicenseTypeFeatureChange struct {
+	TypeID      int      `json:"type_id"`
+	LicenseType string   `json:"license_type"`
+	Added       []string `json:"added"`
+	Removed     []string `json:"removed"`
+	DeviceCount int      `json:"device_count"` // 有效功能发生变化的设备数
+}
+
+// DeviceFeatureChange 修改父类型后设备的有效功能变化，已考虑设备的功能附加项
+type DeviceFeatureChange struct {
+	DeviceID    int      `json:"device_id"`
+	SN          string   `json:"sn"`
+	LicenseType string   `json:"license_type"`
+	Added       []string `json:"added"`
+	Removed     []string `json:"removed"`
+}
+
+// LicenseTypeParentResult 修改父类型的影响报告
+type LicenseTypeParentResult struct {
+	TypeID       int                        `json:"type_id"`
+	OldParentID  int                        `json:"old_parent_id"`
+	ParentID     int                        `json:"parent_id"`
+	DryRun       bool                       `json:"dry_run"`
+	LicenseTypes []LicenseTypeFeatureChange `json:"license_types"` // 有效功能发生变化的许可证类型（本类型及继承它的类型）
+	Devices      []DeviceFeatureChange      `json:"devices"`
+	DeviceTotal  int                        `json:"device_total"`
+	Truncated    bool                       `json:"truncated"` // 设备列表超过上限时只返回前一部分
+}
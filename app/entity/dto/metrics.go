@@ -30,6 +30,7 @@ type MetricEventQuery struct {
 	PageID  string `form:"pageId" json:"pageId"`
 	StartTS int64  `form:"startTs" json:"startTs"` // 开始时间戳(ms)
 	EndTS   int64  `form:"endTs" json:"endTs"`     // 结束时间戳(ms)
+	CursorParams
 }
 
 type MetricEventResponse struct {
@@ -0,0 +1,13 @@
+package dto
+
+// OIDCAuthorization 单点登录的授权地址，前端跳转到该地址，身份提供方登录后回调到配置的redirect-url
+type OIDCAuthorization struct {
+	AuthURL string `json:"auth_url"`
+	State   string `json:"state"`
+}
+
+// OIDCCallback 回调页面提交的授权码和state
+type OIDCCallback struct {
+	Code  string `json:"code" binding:"required"`
+	State string `json:"state" binding:"required"`
+}
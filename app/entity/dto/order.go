@@ -0,0 +1,119 @@
+package dto
+
+import "time"
+
+// OrderQuery 订单列表查询参数
+type OrderQuery struct {
+	ProductID  int    `form:"product_id"`
+	CustomerID int    `form:"customer_id"`
+	OrderNo    string `form:"order_no"` // 按订单号模糊搜索
+	Page       int    `form:"page" binding:"omitempty,min=1"`
+	PageSize   int    `form:"page_size" binding:"required,min=1,max=100"`
+	CursorParams
+}
+
+// AddOrder 添加订单请求
+type AddOrder struct {
+	OrderNo       string     `json:"order_no" binding:"required"`
+	ProductID     int        `json:"product_id" binding:"required"`
+	CustomerID    int        `json:"customer_id"`
+	LicenseTypeID int        `json:"license_type_id"`
+	Quantity      int        `json:"quantity" binding:"min=0"`
+	ShipDate      *time.Time `json:"ship_date"`
+	Remark        string     `json:"remark"`
+}
+
+// ModifyOrder 修改订单请求，订单所属产品不可修改
+type ModifyOrder struct {
+	ID            int        `json:"id" binding:"required"`
+	OrderNo       string     `json:"order_no" binding:"required"`
+	CustomerID    int        `json:"customer_id"`
+	LicenseTypeID int        `json:"license_type_id"`
+	Quantity      int        `json:"quantity" binding:"min=0"`
+	ShipDate      *time.Time `json:"ship_date"`
+	Remark        string     `json:"remark"`
+}
+
+// OrderInfo 订单信息
+type OrderInfo struct {
+	ID            int        `json:"id"`
+	OrderNo       string     `json:"order_no"`
+	ProductID     int        `json:"product_id"`
+	ProductName   string     `json:"product_name"`
+	CustomerID    int        `json:"customer_id"`
+	CustomerName  string     `json:"customer_name"`
+	LicenseTypeID int        `json:"license_type_id"`
+	Quantity      int        `json:"quantity"`
+	ShipDate      *time.Time `json:"ship_date,omitempty"`
+	Remark        string     `json:"remark"`
+	CreatedAt     time.Time  `json:"created_at"`
+	CreatedBy     int        `json:"created_by"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	UpdatedBy     int        `json:"updated_by"`
+}
+
+// LotQuery 生产批次列表查询参数
+type LotQuery struct {
+	ProductID int    `form:"product_id"`
+	OrderID   int    `form:"order_id"`
+	LotNo     string `form:"lot_no"` // 按批次号模糊搜索
+	Page      int    `form:"page" binding:"omitempty,min=1"`
+	PageSize  int    `form:"page_size" binding:"required,min=1,max=100"`
+	CursorParams
+}
+
+// AddLot 添加生产批次请求
+type AddLot struct {
+	LotNo          string     `json:"lot_no" binding:"required"`
+	ProductID      int        `json:"product_id" binding:"required"`
+	OrderID        int        `json:"order_id"`
+	LicenseTypeID  int        `json:"license_type_id"`
+	Quantity       int        `json:"quantity" binding:"min=0"`
+	ManufacturedAt *time.Time `json:"manufactured_at"`
+	ShipDate       *time.Time `json:"ship_date"`
+	Remark         string     `json:"remark"`
+}
+
+// ModifyLot 修改生产批次请求，批次所属产品不可修改
+type ModifyLot struct {
+	ID             int        `json:"id" binding:"required"`
+	LotNo          string     `json:"lot_no" binding:"required"`
+	OrderID        int        `json:"order_id"`
+	LicenseTypeID  int        `json:"license_type_id"`
+	Quantity       int        `json:"quantity" binding:"min=0"`
+	ManufacturedAt *time.Time `json:"manufactured_at"`
+	ShipDate       *time.Time `json:"ship_date"`
+	Remark         string     `json:"remark"`
+}
+
+// LotInfo 生产批次信息
+type LotInfo struct {
+	ID             int        `json:"id"`
+	LotNo          string     `json:"lot_no"`
+	ProductID      int        `json:"product_id"`
+	OrderID        int        `json:"order_id"`
+	LicenseTypeID  int        `json:"license_type_id"`
+	Quantity       int        `json:"quantity"`
+	ManufacturedAt *time.Time `json:"manufactured_at,omitempty"`
+	ShipDate       *time.Time `json:"ship_date,omitempty"`
+	Remark         string     `json:"remark"`
+	CreatedAt      time.Time  `json:"created_at"`
+	CreatedBy      int        `json:"created_by"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	UpdatedBy      int        `json:"updated_by"`
+}
+
+// OrderFulfilment 订单履约统计
+type OrderFulfilment struct {
+	OrderID      int        `json:"order_id"`
+	OrderNo      string     `json:"order_no"`
+	ProductID    int        `json:"product_id"`
+	ProductName  string     `json:"product_name"`
+	CustomerID   int        `json:"customer_id"`
+	CustomerName string     `json:"customer_name"`
+	ShipDate     *time.Time `json:"ship_date,omitempty"`
+	Ordered      int        `json:"ordered"`     // 订购数量
+	Registered   int        `json:"registered"`  // 已入库设备数量
+	Activated    int        `json:"activated"`   // 已激活过的设备数量
+	Outstanding  int        `json:"outstanding"` // 尚未入库的数量
+}
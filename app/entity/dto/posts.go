@@ -34,6 +34,7 @@ type PostQuery struct {
 	CategoryID int    `form:"category_id"` // 类别ID
 	TagID      int    `form:"tag_id"`      // 标签ID
 	AuthorID   int    `form:"author_id"`   // 作者ID
+	CursorParams
 }
 
 // PostResponse 文章响应
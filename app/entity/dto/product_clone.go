@@ -0,0 +1,26 @@
+package dto
+
+// CloneProduct 复制产品请求
+type CloneProduct struct {
+	SourceProductID int    `json:"source_product_id" binding:"required"` // 源产品ID
+	Code            string `json:"code" binding:"required"`              // 新产品代号
+	ProductName     string `json:"product_name" binding:"required"`      // 新产品名称
+	WithVersions    bool   `json:"with_versions"`                        // 是否复制韧件和软件版本及其兼容关系
+}
+
+// CloneMapping 复制前后的记录ID对应关系，key为功能编码、许可证编码或版本号
+type CloneMapping struct {
+	OldID int    `json:"old_id"`
+	NewID int    `json:"new_id"`
+	Key   string `json:"key"`
+}
+
+// CloneProductResult 复制产品结果
+type CloneProductResult struct {
+	SourceProductID  int            `json:"source_product_id"`
+	ProductID        int            `json:"product_id"`
+	Features         []CloneMapping `json:"features"`
+	LicenseTypes     []CloneMapping `json:"license_types"`
+	FirmwareVersions []CloneMapping `json:"firmware_versions"`
+	SoftwareVersions []CloneMapping `json:"software_versions"`
+}
@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+)
+
+const (
+	// DefaultInvitationExpireDays 邀请默认有效期（天）
+	DefaultInvitationExpireDays = 7
+	// InvitationStatusExpired 未处理且已超过有效期的邀请，只在返回结果中出现
+	InvitationStatusExpired = "expired"
+)
+
+// InviteManager 邀请产品副管理员请求参数，邮箱未注册时在注册后自动加入
+type InviteManager struct {
+	ProductID  int                       `json:"product_id" binding:"required"`                                                              // 产品ID
+	Email      string                    `json:"email" binding:"required,email"`                                                             // 被邀请人邮箱
+	AccessRole productmanager.AccessRole `json:"access_role" binding:"omitempty,oneof=viewer device_operator release_manager product_admin"` // 加入后的角色，默认viewer
+	Remark     string                    `json:"remark"`                                                                                     // 备注
+	ExpireDays int                       `json:"expire_days" binding:"omitempty,min=1,max=30"`                                               // 有效期（天），默认7天
+}
+
+// TransferOwnership 转让产品主管理员请求参数，新主管理员接受后生效
+type TransferOwnership struct {
+	ProductID  int    `json:"product_id" binding:"required"`                // 产品ID
+	Email      string `json:"email" binding:"required,email"`               // 新主管理员邮箱
+	ExpireDays int    `json:"expire_days" binding:"omitempty,min=1,max=30"` // 有效期（天），默认7天
+}
+
+// InvitationQuery 产品邀请列表查询参数
+type InvitationQuery struct {
+	ProductID int    `form:"product_id" binding:"required"`                                              // 产品ID
+	Status    string `form:"status" binding:"omitempty,oneof=pending accepted declined revoked expired"` // 状态
+}
+
+// InvitationID 接受、拒绝或撤销邀请请求参数
+type InvitationID struct {
+	ID int `json:"id" binding:"required"` // 邀请ID
+}
+
+// InvitationInfo 邀请信息
+type InvitationInfo struct {
+	ID           int        `json:"id"`
+	ProductID    int        `json:"product_id"`
+	ProductCode  string     `json:"product_code,omitempty"`
+	ProductName  string     `json:"product_name,omitempty"`
+	Type         string     `json:"type"` // manager: 副管理员, transfer: 主管理员转让
+	Email        string     `json:"email"`
+	AccessRole   string     `json:"access_role,omitempty"`
+	Remark       string     `json:"remark,omitempty"`
+	Status       string     `json:"status"` // pending, accepted, declined, revoked, expired
+	InvitedBy    int        `json:"invited_by"`
+	InviterEmail string     `json:"inviter_email,omitempty"`
+	ExpiresAt    time.Time  `json:"expires_at"`
+	RespondedAt  *time.Time `json:"responded_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
@@ -0,0 +1,55 @@
+package dto
+
+// 角色：viewer、device_operator、release_manager、product_admin保存在产品管理员的access_role中，
+// owner为产品主管理员，system_admin为系统管理员（超级管理员或is_system_admin的用户）
+const (
+	RoleViewer         = "viewer"
+	RoleDeviceOperator = "device_operator"
+	RoleReleaseManager = "release_manager"
+	RoleProductAdmin   = "product_admin"
+	RoleOwner          = "owner"
+	RoleSystemAdmin    = "system_admin"
+)
+
+// Permission 产品内的操作权限，每个服务方法对应其中一项
+type Permission string
+
+const (
+	PermProductView   Permission = "product:view"   // 查看产品及其功能、许可证类型、SN规则等配置
+	PermProductEdit   Permission = "product:edit"   // 修改产品信息、功能、许可证类型、SN规则和设备属性定义
+	PermProductDelete Permission = "product:delete" // 删除产品
+	PermManagerManage Permission = "manager:manage" // 管理产品管理员及其角色
+	PermDeviceView    Permission = "device:view"    // 查看设备、客户、订单、任务和心跳
+	PermDeviceWrite   Permission = "device:write"   // 添加、修改设备及其状态、标签、分组、附加项、客户、订单
+	PermDeviceDelete  Permission = "device:delete"  // 删除设备
+	PermSNAllocate    Permission = "sn:allocate"    // 分配SN号段
+	PermReleaseView   Permission = "release:view"   // 查看韧件和软件版本
+	PermReleaseWrite  Permission = "release:write"  // 发布、修改和删除韧件和软件版本
+	PermAuditView     Permission = "audit:view"     // 查看审计日志
+	PermRecycleManage Permission = "recycle:manage" // 查看和恢复回收站中的记录
+)
+
+// RolePermissions 角色及其权限
+type RolePermissions struct {
+	Role        string       `json:"role"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// ProductPermissions 当前用户在产品中的角色和权限
+type ProductPermissions struct {
+	ProductID   int          `json:"product_id"`
+	Role        string       `json:"role"` // 不是产品管理员时为空
+	Permissions []Permission `json:"permissions"`
+}
+
+// SetManagerRole 设置产品管理员的角色
+type SetManagerRole struct {
+	ManagerID  int    `json:"manager_id" binding:"required"`
+	AccessRole string `json:"access_role" binding:"required,oneof=viewer device_operator release_manager product_admin"`
+}
+
+// SetSystemAdmin 授予或撤销系统管理员
+type SetSystemAdmin struct {
+	UserID  int  `json:"user_id" binding:"required"`
+	Enabled bool `json:"enabled"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+import "time"
+
+// 回收站记录类型
+const (
+	RecycleProduct         = "product"
+	RecycleDevice          = "device"
+	RecycleLicenseType     = "license_type"
+	RecycleFeature         = "feature"
+	RecycleFirmwareVersion = "firmware_version"
+	RecycleSoftwareVersion = "software_version"
+)
+
+// RecycleBinQuery 回收站查询参数，type为product时列出已删除的产品，仅超级管理员可用
+type RecycleBinQuery struct {
+	ProductID int    `form:"product_id"`
+	Type      string `form:"type" binding:"required,oneof=product device license_type feature firmware_version software_version"`
+	Page      int    `form:"page" binding:"required,min=1"`
+	PageSize  int    `form:"page_size" binding:"required,min=1,max=100"`
+}
+
+// RecycleItem 回收站记录
+type RecycleItem struct {
+	Type      string    `json:"type"`
+	ID        int       `json:"id"`
+	ProductID int       `json:"product_id"`
+	Name      string    `json:"name"` // 设备SN、产品代号、许可证编码、功能编码或版本号
+	DeletedAt time.Time `json:"deleted_at"`
+	PurgeAt   time.Time `json:"purge_at"` // 预计永久清理时间
+}
+
+// RecycleRestore 从回收站恢复请求
+type RecycleRestore struct {
+	Type string `json:"type" binding:"required,oneof=product device license_type feature firmware_version software_version"`
+	ID   int    `json:"id" binding:"required"`
+}
@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+)
+
+const (
+	// RegistrationModeOpen 开放注册
+	RegistrationModeOpen = "open"
+	// RegistrationModeInvite 只能使用邀请码注册
+	RegistrationModeInvite = "invite"
+
+	// RegistrationInvitationActive 未撤销、未过期且未用完的邀请码
+	RegistrationInvitationActive = "active"
+	// RegistrationInvitationUsedUp 已达到使用次数的邀请码
+	RegistrationInvitationUsedUp = "used_up"
+	// RegistrationInvitationRevoked 已撤销的邀请码
+	RegistrationInvitationRevoked = "revoked"
+)
+
+// RegisterByInvitation 使用邀请码注册请求参数
+type RegisterByInvitation struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=6"`
+	Code     string `json:"code" binding:"required"` // 邀请码
+}
+
+// CreateRegistrationInvitation 创建注册邀请码请求参数，指定产品时注册后以access_role加入该产品
+type CreateRegistrationInvitation struct {
+	Email      string                    `json:"email" binding:"omitempty,email"`                                                            // 绑定邮箱，为空时任何邮箱都可以使用
+	MaxUses    int                       `json:"max_uses" binding:"omitempty,min=1,max=1000"`                                                // 可注册的用户数，默认1
+	ProductID  int                       `json:"product_id"`                                                                                 // 注册后加入的产品
+	AccessRole productmanager.AccessRole `json:"access_role" binding:"omitempty,oneof=viewer device_operator release_manager product_admin"` // 加入产品后的角色，默认viewer
+	Remark     string                    `json:"remark"`                                                                                     // 备注
+	ExpireDays int                       `json:"expire_days" binding:"omitempty,min=1,max=90"`                                               // 有效期（天），默认7天
+}
+
+// RegistrationInvitationQuery 注册邀请码列表查询参数，产品管理员必须指定产品
+type RegistrationInvitationQuery struct {
+	ProductID int    `form:"product_id"`                                                      // 产品ID
+	Status    string `form:"status" binding:"omitempty,oneof=active used_up revoked expired"` // 状态
+}
+
+// RegistrationInvitationInfo 注册邀请码信息，邀请码只在创建时返回
+type RegistrationInvitationInfo struct {
+	ID           int        `json:"id"`
+	Code         string     `json:"code,omitempty"`
+	CodePrefix   string     `json:"code_prefix"`
+	Email        string     `json:"email,omitempty"`
+	MaxUses      int        `json:"max_uses"`
+	UsedCount    int        `json:"used_count"`
+	ProductID    int        `json:"product_id,omitempty"`
+	ProductCode  string     `json:"product_code,omitempty"`
+	ProductName  string     `json:"product_name,omitempty"`
+	AccessRole   string     `json:"access_role,omitempty"`
+	Remark       string     `json:"remark,omitempty"`
+	Status       string     `json:"status"` // active, used_up, revoked, expired
+	CreatedBy    int        `json:"created_by"`
+	CreatorEmail string     `json:"creator_email,omitempty"`
+	ExpiresAt    time.Time  `json:"expires_at"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
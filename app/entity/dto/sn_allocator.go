@@ -0,0 +1,61 @@
+package dto
+
+import "time"
+
+// AddSnAllocator 新增SN分配器请求
+type AddSnAllocator struct {
+	ProductID            int    `json:"product_id" binding:"required"`                             // 产品ID
+	Name                 string `json:"name" binding:"required"`                                   // 分配器名称
+	Prefix               string `json:"prefix"`                                                    // SN前缀
+	DateFormat           string `json:"date_format"`                                               // 日期码格式，支持YYYY、YY、MM、DD、WW
+	CounterWidth         int    `json:"counter_width" binding:"required,min=1,max=18"`             // 计数器位数
+	CheckAlgorithm       string `json:"check_algorithm" binding:"omitempty,oneof=none luhn mod37"` // 校验位算法
+	StartCounter         int64  `json:"start_counter" binding:"min=0"`                             // 起始计数器，默认为1
+	DefaultLicenseTypeID int    `json:"default_license_type_id"`                                   // 预创建设备的默认许可证类型
+}
+
+// ModifySnAllocator 修改SN分配器请求，next_counter只能调大
+type ModifySnAllocator struct {
+	ID                   int    `json:"id" binding:"required"`                                     // 分配器ID
+	Name                 string `json:"name,omitempty"`                                            // 分配器名称
+	Prefix               string `json:"prefix"`                                                    // SN前缀
+	DateFormat           string `json:"date_format"`                                               // 日期码格式
+	CounterWidth         int    `json:"counter_width" binding:"required,min=1,max=18"`             // 计数器位数
+	CheckAlgorithm       string `json:"check_algorithm" binding:"omitempty,oneof=none luhn mod37"` // 校验位算法
+	NextCounter          int64  `json:"next_counter,omitempty"`                                    // 下一个计数器值
+	DefaultLicenseTypeID int    `json:"default_license_type_id"`                                   // 预创建设备的默认许可证类型
+}
+
+// AllocateSnBlock 分配SN段请求
+type AllocateSnBlock struct {
+	AllocatorID   int    `json:"allocator_id" binding:"required"`          // 分配器ID
+	Count         int    `json:"count" binding:"required,min=1,max=10000"` // 分配数量
+	CreateDevices bool   `json:"create_devices"`                           // 是否预创建设备
+	LicenseTypeID int    `json:"license_type_id"`                          // 预创建设备的许可证类型，为空使用分配器默认值
+	Remark        string `json:"remark"`                                   // 备注，如工单号
+}
+
+// SnBlockQuery SN分配台账查询参数
+type SnBlockQuery struct {
+	ProductID   int `form:"product_id" binding:"required"`
+	AllocatorID int `form:"allocator_id"`
+	Page        int `form:"page"`
+	PageSize    int `form:"page_size"`
+}
+
+// SnBlockInfo SN分配台账信息
+type SnBlockInfo struct {
+	ID               int       `json:"id"`
+	AllocatorID      int       `json:"allocator_id"`
+	ProductID        int       `json:"product_id"`
+	StartCounter     int64     `json:"start_counter"`
+	Count            int       `json:"count"`
+	FirstSN          string    `json:"first_sn"`
+	LastSN           string    `json:"last_sn"`
+	DevicesCreated   bool      `json:"devices_created"`
+	LicenseTypeID    int       `json:"license_type_id,omitempty"`
+	Remark           string    `json:"remark"`
+	RequestedBy      int       `json:"requested_by"`
+	RequestedByEmail string    `json:"requested_by_email"`
+	CreatedAt        time.Time `json:"created_at"`
+}
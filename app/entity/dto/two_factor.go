@@ -0,0 +1,67 @@
+package dto
+
+// TwoFactorChallenge 密码正确但需要两步验证时的登录结果，使用临时令牌完成第二步
+type TwoFactorChallenge struct {
+	TwoFactorRequired  bool   `json:"two_factor_required"`
+	EnrollmentRequired bool   `json:"enrollment_required"` // 角色要求两步验证但尚未开启，需要先使用临时令牌开启
+	PreAuthToken       string `json:"pre_auth_token"`      // 临时令牌
+	ExpiresIn          int    `json:"expires_in"`          // 临时令牌有效期（秒）
+}
+
+// TwoFactorLogin 登录第二步请求参数，验证码和恢复码二选一
+type TwoFactorLogin struct {
+	Token        string `json:"token" binding:"required"`                     // 临时令牌
+	Code         string `json:"code" binding:"required_without=RecoveryCode"` // 身份验证器App中的验证码
+	RecoveryCode string `json:"recovery_code"`                                // 恢复码，使用后失效
+}
+
+// TwoFactorPreAuth 使用临时令牌开启两步验证的请求参数
+type TwoFactorPreAuth struct {
+	Token string `json:"token" binding:"required"` // 临时令牌
+}
+
+// TwoFactorActivateByToken 使用临时令牌确认开启两步验证的请求参数
+type TwoFactorActivateByToken struct {
+	Token string `json:"token" binding:"required"` // 临时令牌
+	Code  string `json:"code" binding:"required"`  // 身份验证器App中的验证码
+}
+
+// TwoFactorCode 需要验证码确认的请求参数
+type TwoFactorCode struct {
+	Code string `json:"code" binding:"required"` // 身份验证器App中的验证码
+}
+
+// TwoFactorDisable 关闭两步验证请求参数，验证码和恢复码二选一
+type TwoFactorDisable struct {
+	Code         string `json:"code" binding:"required_without=RecoveryCode"`
+	RecoveryCode string `json:"recovery_code"`
+}
+
+// TwoFactorReset 管理员重置用户两步验证请求参数
+type TwoFactorReset struct {
+	UserID int `json:"user_id" binding:"required"` // 用户ID
+}
+
+// TwoFactorEnrollment 开启两步验证的密钥，确认前有效期10分钟
+type TwoFactorEnrollment struct {
+	Secret string `json:"secret"`  // base32密钥，用于手动输入
+	URI    string `json:"uri"`     // otpauth URI
+	QRCode string `json:"qr_code"` // otpauth URI的二维码，PNG的data URI
+}
+
+// TwoFactorRecoveryCodes 恢复码，只在生成时返回
+type TwoFactorRecoveryCodes struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// TwoFactorStatus 当前用户的两步验证状态
+type TwoFactorStatus struct {
+	Enabled           bool `json:"enabled"`
+	Required          bool `json:"required"`            // 角色是否要求两步验证
+	RecoveryCodesLeft int  `json:"recovery_codes_left"` // 剩余可用的恢复码数量
+}
+
+// TwoFactorPolicy 必须开启两步验证的角色
+type TwoFactorPolicy struct {
+	Roles []string `json:"roles" binding:"dive,oneof=viewer device_operator release_manager product_admin owner system_admin"`
+}
@@ -5,6 +5,7 @@ package auditlog
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -72,7 +73,15 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "cambridge-hit.com/gin-base/activateserver/app/entity/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	Policy       ent.Policy
 	// ModuleValidator is a validator for the "module" field. It is called by the builders before save.
 	ModuleValidator func(string) error
 	// ActionTypeValidator is a validator for the "action_type" field. It is called by the builders before save.
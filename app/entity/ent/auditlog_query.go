@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -11,6 +12,7 @@ import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -25,6 +27,7 @@ type AuditLogQuery struct {
 	predicates   []predicate.AuditLog
 	withOperator *UserQuery
 	withProduct  *ProductQuery
+	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -398,6 +401,12 @@ func (alq *AuditLogQuery) prepareQuery(ctx context.Context) error {
 		}
 		alq.sql = prev
 	}
+	if auditlog.Policy == nil {
+		return errors.New("ent: uninitialized auditlog.Policy (forgotten import ent/runtime?)")
+	}
+	if err := auditlog.Policy.EvalQuery(ctx, alq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -419,6 +428,9 @@ func (alq *AuditLogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Au
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(alq.modifiers) > 0 {
+		_spec.Modifiers = alq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -504,6 +516,9 @@ func (alq *AuditLogQuery) loadProduct(ctx context.Context, query *ProductQuery,
 
 func (alq *AuditLogQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := alq.querySpec()
+	if len(alq.modifiers) > 0 {
+		_spec.Modifiers = alq.modifiers
+	}
 	_spec.Node.Columns = alq.ctx.Fields
 	if len(alq.ctx.Fields) > 0 {
 		_spec.Unique = alq.ctx.Unique != nil && *alq.ctx.Unique
@@ -572,6 +587,9 @@ func (alq *AuditLogQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if alq.ctx.Unique != nil && *alq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range alq.modifiers {
+		m(selector)
+	}
 	for _, p := range alq.predicates {
 		p(selector)
 	}
@@ -589,6 +607,32 @@ func (alq *AuditLogQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (alq *AuditLogQuery) ForUpdate(opts ...sql.LockOption) *AuditLogQuery {
+	if alq.driver.Dialect() == dialect.Postgres {
+		alq.Unique(false)
+	}
+	alq.modifiers = append(alq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return alq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (alq *AuditLogQuery) ForShare(opts ...sql.LockOption) *AuditLogQuery {
+	if alq.driver.Dialect() == dialect.Postgres {
+		alq.Unique(false)
+	}
+	alq.modifiers = append(alq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return alq
+}
+
 // AuditLogGroupBy is the group-by builder for AuditLog entities.
 type AuditLogGroupBy struct {
 	selector
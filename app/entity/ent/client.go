@@ -11,20 +11,37 @@ import (
 
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/migrate"
 
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/apitoken"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/auditlog"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/customer"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/deviceassignment"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicefeatureoverride"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicegroup"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/deviceheartbeat"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicesavedfilter"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicetag"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/firmwareversion"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/job"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetypefeatures"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/lot"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/metricevent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/post"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/postcategory"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/posttag"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/posttagrelation"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productinvitation"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/registrationinvitation"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snallocator"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snblock"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snrule"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/softwareversion"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/twofactorpolicy"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -37,18 +54,40 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIToken is the client for interacting with the APIToken builders.
+	APIToken *APITokenClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// Customer is the client for interacting with the Customer builders.
+	Customer *CustomerClient
 	// Device is the client for interacting with the Device builders.
 	Device *DeviceClient
+	// DeviceAssignment is the client for interacting with the DeviceAssignment builders.
+	DeviceAssignment *DeviceAssignmentClient
+	// DeviceFeatureOverride is the client for interacting with the DeviceFeatureOverride builders.
+	DeviceFeatureOverride *DeviceFeatureOverrideClient
+	// DeviceGroup is the client for interacting with the DeviceGroup builders.
+	DeviceGroup *DeviceGroupClient
+	// DeviceHeartbeat is the client for interacting with the DeviceHeartbeat builders.
+	DeviceHeartbeat *DeviceHeartbeatClient
+	// DeviceSavedFilter is the client for interacting with the DeviceSavedFilter builders.
+	DeviceSavedFilter *DeviceSavedFilterClient
+	// DeviceTag is the client for interacting with the DeviceTag builders.
+	DeviceTag *DeviceTagClient
 	// FirmwareVersion is the client for interacting with the FirmwareVersion builders.
 	FirmwareVersion *FirmwareVersionClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
 	// LicenseType is the client for interacting with the LicenseType builders.
 	LicenseType *LicenseTypeClient
 	// LicenseTypeFeatures is the client for interacting with the LicenseTypeFeatures builders.
 	LicenseTypeFeatures *LicenseTypeFeaturesClient
+	// Lot is the client for interacting with the Lot builders.
+	Lot *LotClient
 	// MetricEvent is the client for interacting with the MetricEvent builders.
 	MetricEvent *MetricEventClient
+	// Order is the client for interacting with the Order builders.
+	Order *OrderClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
 	// PostCategory is the client for interacting with the PostCategory builders.
@@ -61,10 +100,22 @@ type Client struct {
 	Product *ProductClient
 	// ProductFeature is the client for interacting with the ProductFeature builders.
 	ProductFeature *ProductFeatureClient
+	// ProductInvitation is the client for interacting with the ProductInvitation builders.
+	ProductInvitation *ProductInvitationClient
 	// ProductManager is the client for interacting with the ProductManager builders.
 	ProductManager *ProductManagerClient
+	// RegistrationInvitation is the client for interacting with the RegistrationInvitation builders.
+	RegistrationInvitation *RegistrationInvitationClient
+	// SnAllocator is the client for interacting with the SnAllocator builders.
+	SnAllocator *SnAllocatorClient
+	// SnBlock is the client for interacting with the SnBlock builders.
+	SnBlock *SnBlockClient
+	// SnRule is the client for interacting with the SnRule builders.
+	SnRule *SnRuleClient
 	// SoftwareVersion is the client for interacting with the SoftwareVersion builders.
 	SoftwareVersion *SoftwareVersionClient
+	// TwoFactorPolicy is the client for interacting with the TwoFactorPolicy builders.
+	TwoFactorPolicy *TwoFactorPolicyClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -80,20 +131,37 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIToken = NewAPITokenClient(c.config)
 	c.AuditLog = NewAuditLogClient(c.config)
+	c.Customer = NewCustomerClient(c.config)
 	c.Device = NewDeviceClient(c.config)
+	c.DeviceAssignment = NewDeviceAssignmentClient(c.config)
+	c.DeviceFeatureOverride = NewDeviceFeatureOverrideClient(c.config)
+	c.DeviceGroup = NewDeviceGroupClient(c.config)
+	c.DeviceHeartbeat = NewDeviceHeartbeatClient(c.config)
+	c.DeviceSavedFilter = NewDeviceSavedFilterClient(c.config)
+	c.DeviceTag = NewDeviceTagClient(c.config)
 	c.FirmwareVersion = NewFirmwareVersionClient(c.config)
+	c.Job = NewJobClient(c.config)
 	c.LicenseType = NewLicenseTypeClient(c.config)
 	c.LicenseTypeFeatures = NewLicenseTypeFeaturesClient(c.config)
+	c.Lot = NewLotClient(c.config)
 	c.MetricEvent = NewMetricEventClient(c.config)
+	c.Order = NewOrderClient(c.config)
 	c.Post = NewPostClient(c.config)
 	c.PostCategory = NewPostCategoryClient(c.config)
 	c.PostTag = NewPostTagClient(c.config)
 	c.PostTagRelation = NewPostTagRelationClient(c.config)
 	c.Product = NewProductClient(c.config)
 	c.ProductFeature = NewProductFeatureClient(c.config)
+	c.ProductInvitation = NewProductInvitationClient(c.config)
 	c.ProductManager = NewProductManagerClient(c.config)
+	c.RegistrationInvitation = NewRegistrationInvitationClient(c.config)
+	c.SnAllocator = NewSnAllocatorClient(c.config)
+	c.SnBlock = NewSnBlockClient(c.config)
+	c.SnRule = NewSnRuleClient(c.config)
 	c.SoftwareVersion = NewSoftwareVersionClient(c.config)
+	c.TwoFactorPolicy = NewTwoFactorPolicyClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -178,23 +246,40 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                 ctx,
-		config:              cfg,
-		AuditLog:            NewAuditLogClient(cfg),
-		Device:              NewDeviceClient(cfg),
-		FirmwareVersion:     NewFirmwareVersionClient(cfg),
-		LicenseType:         NewLicenseTypeClient(cfg),
-		LicenseTypeFeatures: NewLicenseTypeFeaturesClient(cfg),
-		MetricEvent:         NewMetricEventClient(cfg),
-		Post:                NewPostClient(cfg),
-		PostCategory:        NewPostCategoryClient(cfg),
-		PostTag:             NewPostTagClient(cfg),
-		PostTagRelation:     NewPostTagRelationClient(cfg),
-		Product:             NewProductClient(cfg),
-		ProductFeature:      NewProductFeatureClient(cfg),
-		ProductManager:      NewProductManagerClient(cfg),
-		SoftwareVersion:     NewSoftwareVersionClient(cfg),
-		User:                NewUserClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		APIToken:               NewAPITokenClient(cfg),
+		AuditLog:               NewAuditLogClient(cfg),
+		Customer:               NewCustomerClient(cfg),
+		Device:                 NewDeviceClient(cfg),
+		DeviceAssignment:       NewDeviceAssignmentClient(cfg),
+		DeviceFeatureOverride:  NewDeviceFeatureOverrideClient(cfg),
+		DeviceGroup:            NewDeviceGroupClient(cfg),
+		DeviceHeartbeat:        NewDeviceHeartbeatClient(cfg),
+		DeviceSavedFilter:      NewDeviceSavedFilterClient(cfg),
+		DeviceTag:              NewDeviceTagClient(cfg),
+		FirmwareVersion:        NewFirmwareVersionClient(cfg),
+		Job:                    NewJobClient(cfg),
+		LicenseType:            NewLicenseTypeClient(cfg),
+		LicenseTypeFeatures:    NewLicenseTypeFeaturesClient(cfg),
+		Lot:                    NewLotClient(cfg),
+		MetricEvent:            NewMetricEventClient(cfg),
+		Order:                  NewOrderClient(cfg),
+		Post:                   NewPostClient(cfg),
+		PostCategory:           NewPostCategoryClient(cfg),
+		PostTag:                NewPostTagClient(cfg),
+		PostTagRelation:        NewPostTagRelationClient(cfg),
+		Product:                NewProductClient(cfg),
+		ProductFeature:         NewProductFeatureClient(cfg),
+		ProductInvitation:      NewProductInvitationClient(cfg),
+		ProductManager:         NewProductManagerClient(cfg),
+		RegistrationInvitation: NewRegistrationInvitationClient(cfg),
+		SnAllocator:            NewSnAllocatorClient(cfg),
+		SnBlock:                NewSnBlockClient(cfg),
+		SnRule:                 NewSnRuleClient(cfg),
+		SoftwareVersion:        NewSoftwareVersionClient(cfg),
+		TwoFactorPolicy:        NewTwoFactorPolicyClient(cfg),
+		User:                   NewUserClient(cfg),
 	}, nil
 }
 
@@ -212,30 +297,47 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                 ctx,
-		config:              cfg,
-		AuditLog:            NewAuditLogClient(cfg),
-		Device:              NewDeviceClient(cfg),
-		FirmwareVersion:     NewFirmwareVersionClient(cfg),
-		LicenseType:         NewLicenseTypeClient(cfg),
-		LicenseTypeFeatures: NewLicenseTypeFeaturesClient(cfg),
-		MetricEvent:         NewMetricEventClient(cfg),
-		Post:                NewPostClient(cfg),
-		PostCategory:        NewPostCategoryClient(cfg),
-		PostTag:             NewPostTagClient(cfg),
-		PostTagRelation:     NewPostTagRelationClient(cfg),
-		Product:             NewProductClient(cfg),
-		ProductFeature:      NewProductFeatureClient(cfg),
-		ProductManager:      NewProductManagerClient(cfg),
-		SoftwareVersion:     NewSoftwareVersionClient(cfg),
-		User:                NewUserClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		APIToken:               NewAPITokenClient(cfg),
+		AuditLog:               NewAuditLogClient(cfg),
+		Customer:               NewCustomerClient(cfg),
+		Device:                 NewDeviceClient(cfg),
+		DeviceAssignment:       NewDeviceAssignmentClient(cfg),
+		DeviceFeatureOverride:  NewDeviceFeatureOverrideClient(cfg),
+		DeviceGroup:            NewDeviceGroupClient(cfg),
+		DeviceHeartbeat:        NewDeviceHeartbeatClient(cfg),
+		DeviceSavedFilter:      NewDeviceSavedFilterClient(cfg),
+		DeviceTag:              NewDeviceTagClient(cfg),
+		FirmwareVersion:        NewFirmwareVersionClient(cfg),
+		Job:                    NewJobClient(cfg),
+		LicenseType:            NewLicenseTypeClient(cfg),
+		LicenseTypeFeatures:    NewLicenseTypeFeaturesClient(cfg),
+		Lot:                    NewLotClient(cfg),
+		MetricEvent:            NewMetricEventClient(cfg),
+		Order:                  NewOrderClient(cfg),
+		Post:                   NewPostClient(cfg),
+		PostCategory:           NewPostCategoryClient(cfg),
+		PostTag:                NewPostTagClient(cfg),
+		PostTagRelation:        NewPostTagRelationClient(cfg),
+		Product:                NewProductClient(cfg),
+		ProductFeature:         NewProductFeatureClient(cfg),
+		ProductInvitation:      NewProductInvitationClient(cfg),
+		ProductManager:         NewProductManagerClient(cfg),
+		RegistrationInvitation: NewRegistrationInvitationClient(cfg),
+		SnAllocator:            NewSnAllocatorClient(cfg),
+		SnBlock:                NewSnBlockClient(cfg),
+		SnRule:                 NewSnRuleClient(cfg),
+		SoftwareVersion:        NewSoftwareVersionClient(cfg),
+		TwoFactorPolicy:        NewTwoFactorPolicyClient(cfg),
+		User:                   NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		AuditLog.
+//		APIToken.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -258,9 +360,13 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AuditLog, c.Device, c.FirmwareVersion, c.LicenseType, c.LicenseTypeFeatures,
-		c.MetricEvent, c.Post, c.PostCategory, c.PostTag, c.PostTagRelation, c.Product,
-		c.ProductFeature, c.ProductManager, c.SoftwareVersion, c.User,
+		c.APIToken, c.AuditLog, c.Customer, c.Device, c.DeviceAssignment,
+		c.DeviceFeatureOverride, c.DeviceGroup, c.DeviceHeartbeat, c.DeviceSavedFilter,
+		c.DeviceTag, c.FirmwareVersion, c.Job, c.LicenseType, c.LicenseTypeFeatures,
+		c.Lot, c.MetricEvent, c.Order, c.Post, c.PostCategory, c.PostTag,
+		c.PostTagRelation, c.Product, c.ProductFeature, c.ProductInvitation,
+		c.ProductManager, c.RegistrationInvitation, c.SnAllocator, c.SnBlock, c.SnRule,
+		c.SoftwareVersion, c.TwoFactorPolicy, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -270,9 +376,13 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AuditLog, c.Device, c.FirmwareVersion, c.LicenseType, c.LicenseTypeFeatures,
-		c.MetricEvent, c.Post, c.PostCategory, c.PostTag, c.PostTagRelation, c.Product,
-		c.ProductFeature, c.ProductManager, c.SoftwareVersion, c.User,
+		c.APIToken, c.AuditLog, c.Customer, c.Device, c.DeviceAssignment,
+		c.DeviceFeatureOverride, c.DeviceGroup, c.DeviceHeartbeat, c.DeviceSavedFilter,
+		c.DeviceTag, c.FirmwareVersion, c.Job, c.LicenseType, c.LicenseTypeFeatures,
+		c.Lot, c.MetricEvent, c.Order, c.Post, c.PostCategory, c.PostTag,
+		c.PostTagRelation, c.Product, c.ProductFeature, c.ProductInvitation,
+		c.ProductManager, c.RegistrationInvitation, c.SnAllocator, c.SnBlock, c.SnRule,
+		c.SoftwareVersion, c.TwoFactorPolicy, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -281,18 +391,40 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APITokenMutation:
+		return c.APIToken.mutate(ctx, m)
 	case *AuditLogMutation:
 		return c.AuditLog.mutate(ctx, m)
+	case *CustomerMutation:
+		return c.Customer.mutate(ctx, m)
 	case *DeviceMutation:
 		return c.Device.mutate(ctx, m)
+	case *DeviceAssignmentMutation:
+		return c.DeviceAssignment.mutate(ctx, m)
+	case *DeviceFeatureOverrideMutation:
+		return c.DeviceFeatureOverride.mutate(ctx, m)
+	case *DeviceGroupMutation:
+		return c.DeviceGroup.mutate(ctx, m)
+	case *DeviceHeartbeatMutation:
+		return c.DeviceHeartbeat.mutate(ctx, m)
+	case *DeviceSavedFilterMutation:
+		return c.DeviceSavedFilter.mutate(ctx, m)
+	case *DeviceTagMutation:
+		return c.DeviceTag.mutate(ctx, m)
 	case *FirmwareVersionMutation:
 		return c.FirmwareVersion.mutate(ctx, m)
+	case *JobMutation:
+		return c.Job.mutate(ctx, m)
 	case *LicenseTypeMutation:
 		return c.LicenseType.mutate(ctx, m)
 	case *LicenseTypeFeaturesMutation:
 		return c.LicenseTypeFeatures.mutate(ctx, m)
+	case *LotMutation:
+		return c.Lot.mutate(ctx, m)
 	case *MetricEventMutation:
 		return c.MetricEvent.mutate(ctx, m)
+	case *OrderMutation:
+		return c.Order.mutate(ctx, m)
 	case *PostMutation:
 		return c.Post.mutate(ctx, m)
 	case *PostCategoryMutation:
@@ -305,10 +437,22 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Product.mutate(ctx, m)
 	case *ProductFeatureMutation:
 		return c.ProductFeature.mutate(ctx, m)
+	case *ProductInvitationMutation:
+		return c.ProductInvitation.mutate(ctx, m)
 	case *ProductManagerMutation:
 		return c.ProductManager.mutate(ctx, m)
+	case *RegistrationInvitationMutation:
+		return c.RegistrationInvitation.mutate(ctx, m)
+	case *SnAllocatorMutation:
+		return c.SnAllocator.mutate(ctx, m)
+	case *SnBlockMutation:
+		return c.SnBlock.mutate(ctx, m)
+	case *SnRuleMutation:
+		return c.SnRule.mutate(ctx, m)
 	case *SoftwareVersionMutation:
 		return c.SoftwareVersion.mutate(ctx, m)
+	case *TwoFactorPolicyMutation:
+		return c.TwoFactorPolicy.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -316,6 +460,155 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// APITokenClient is a client for the APIToken schema.
+type APITokenClient struct {
+	config
+}
+
+// NewAPITokenClient returns a client for the APIToken from the given config.
+func NewAPITokenClient(c config) *APITokenClient {
+	return &APITokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apitoken.Hooks(f(g(h())))`.
+func (c *APITokenClient) Use(hooks ...Hook) {
+	c.hooks.APIToken = append(c.hooks.APIToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apitoken.Intercept(f(g(h())))`.
+func (c *APITokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIToken = append(c.inters.APIToken, interceptors...)
+}
+
+// Create returns a builder for creating a APIToken entity.
+func (c *APITokenClient) Create() *APITokenCreate {
+	mutation := newAPITokenMutation(c.config, OpCreate)
+	return &APITokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of APIToken entities.
+func (c *APITokenClient) CreateBulk(builders ...*APITokenCreate) *APITokenCreateBulk {
+	return &APITokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APITokenClient) MapCreateBulk(slice any, setFunc func(*APITokenCreate, int)) *APITokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APITokenCreateBulk{err: fmt.Errorf("calling to APITokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APITokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APITokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for APIToken.
+func (c *APITokenClient) Update() *APITokenUpdate {
+	mutation := newAPITokenMutation(c.config, OpUpdate)
+	return &APITokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *APITokenClient) UpdateOne(at *APIToken) *APITokenUpdateOne {
+	mutation := newAPITokenMutation(c.config, OpUpdateOne, withAPIToken(at))
+	return &APITokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APITokenClient) UpdateOneID(id int) *APITokenUpdateOne {
+	mutation := newAPITokenMutation(c.config, OpUpdateOne, withAPITokenID(id))
+	return &APITokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIToken.
+func (c *APITokenClient) Delete() *APITokenDelete {
+	mutation := newAPITokenMutation(c.config, OpDelete)
+	return &APITokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APITokenClient) DeleteOne(at *APIToken) *APITokenDeleteOne {
+	return c.DeleteOneID(at.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APITokenClient) DeleteOneID(id int) *APITokenDeleteOne {
+	builder := c.Delete().Where(apitoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APITokenDeleteOne{builder}
+}
+
+// Query returns a query builder for APIToken.
+func (c *APITokenClient) Query() *APITokenQuery {
+	return &APITokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIToken entity by its id.
+func (c *APITokenClient) Get(ctx context.Context, id int) (*APIToken, error) {
+	return c.Query().Where(apitoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APITokenClient) GetX(ctx context.Context, id int) *APIToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a APIToken.
+func (c *APITokenClient) QueryUser(at *APIToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := at.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apitoken.Table, apitoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apitoken.UserTable, apitoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(at.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *APITokenClient) Hooks() []Hook {
+	return c.hooks.APIToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *APITokenClient) Interceptors() []Interceptor {
+	return c.inters.APIToken
+}
+
+func (c *APITokenClient) mutate(ctx context.Context, m *APITokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APITokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APITokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APITokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APITokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown APIToken mutation op: %q", m.Op())
+	}
+}
+
 // AuditLogClient is a client for the AuditLog schema.
 type AuditLogClient struct {
 	config
@@ -458,12 +751,14 @@ func (c *AuditLogClient) QueryProduct(al *AuditLog) *ProductQuery {
 
 // Hooks returns the client hooks.
 func (c *AuditLogClient) Hooks() []Hook {
-	return c.hooks.AuditLog
+	hooks := c.hooks.AuditLog
+	return append(hooks[:len(hooks):len(hooks)], auditlog.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *AuditLogClient) Interceptors() []Interceptor {
-	return c.inters.AuditLog
+	inters := c.inters.AuditLog
+	return append(inters[:len(inters):len(inters)], auditlog.Interceptors[:]...)
 }
 
 func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
@@ -481,6 +776,171 @@ func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value
 	}
 }
 
+// CustomerClient is a client for the Customer schema.
+type CustomerClient struct {
+	config
+}
+
+// NewCustomerClient returns a client for the Customer from the given config.
+func NewCustomerClient(c config) *CustomerClient {
+	return &CustomerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `customer.Hooks(f(g(h())))`.
+func (c *CustomerClient) Use(hooks ...Hook) {
+	c.hooks.Customer = append(c.hooks.Customer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `customer.Intercept(f(g(h())))`.
+func (c *CustomerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Customer = append(c.inters.Customer, interceptors...)
+}
+
+// Create returns a builder for creating a Customer entity.
+func (c *CustomerClient) Create() *CustomerCreate {
+	mutation := newCustomerMutation(c.config, OpCreate)
+	return &CustomerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Customer entities.
+func (c *CustomerClient) CreateBulk(builders ...*CustomerCreate) *CustomerCreateBulk {
+	return &CustomerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CustomerClient) MapCreateBulk(slice any, setFunc func(*CustomerCreate, int)) *CustomerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CustomerCreateBulk{err: fmt.Errorf("calling to CustomerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CustomerCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CustomerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Customer.
+func (c *CustomerClient) Update() *CustomerUpdate {
+	mutation := newCustomerMutation(c.config, OpUpdate)
+	return &CustomerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CustomerClient) UpdateOne(cu *Customer) *CustomerUpdateOne {
+	mutation := newCustomerMutation(c.config, OpUpdateOne, withCustomer(cu))
+	return &CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CustomerClient) UpdateOneID(id int) *CustomerUpdateOne {
+	mutation := newCustomerMutation(c.config, OpUpdateOne, withCustomerID(id))
+	return &CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Customer.
+func (c *CustomerClient) Delete() *CustomerDelete {
+	mutation := newCustomerMutation(c.config, OpDelete)
+	return &CustomerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CustomerClient) DeleteOne(cu *Customer) *CustomerDeleteOne {
+	return c.DeleteOneID(cu.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CustomerClient) DeleteOneID(id int) *CustomerDeleteOne {
+	builder := c.Delete().Where(customer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CustomerDeleteOne{builder}
+}
+
+// Query returns a query builder for Customer.
+func (c *CustomerClient) Query() *CustomerQuery {
+	return &CustomerQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCustomer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Customer entity by its id.
+func (c *CustomerClient) Get(ctx context.Context, id int) (*Customer, error) {
+	return c.Query().Where(customer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CustomerClient) GetX(ctx context.Context, id int) *Customer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDevices queries the devices edge of a Customer.
+func (c *CustomerClient) QueryDevices(cu *Customer) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cu.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customer.Table, customer.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, customer.DevicesTable, customer.DevicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(cu.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrders queries the orders edge of a Customer.
+func (c *CustomerClient) QueryOrders(cu *Customer) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cu.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customer.Table, customer.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, customer.OrdersTable, customer.OrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(cu.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CustomerClient) Hooks() []Hook {
+	return c.hooks.Customer
+}
+
+// Interceptors returns the client interceptors.
+func (c *CustomerClient) Interceptors() []Interceptor {
+	return c.inters.Customer
+}
+
+func (c *CustomerClient) mutate(ctx context.Context, m *CustomerMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CustomerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CustomerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CustomerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CustomerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Customer mutation op: %q", m.Op())
+	}
+}
+
 // DeviceClient is a client for the Device schema.
 type DeviceClient struct {
 	config
@@ -653,132 +1113,262 @@ func (c *DeviceClient) QueryUpdater(d *Device) *UserQuery {
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *DeviceClient) Hooks() []Hook {
-	return c.hooks.Device
+// QueryTags queries the tags edge of a Device.
+func (c *DeviceClient) QueryTags(d *Device) *DeviceTagQuery {
+	query := (&DeviceTagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(devicetag.Table, devicetag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, device.TagsTable, device.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Interceptors returns the client interceptors.
-func (c *DeviceClient) Interceptors() []Interceptor {
-	return c.inters.Device
+// QueryGroups queries the groups edge of a Device.
+func (c *DeviceClient) QueryGroups(d *Device) *DeviceGroupQuery {
+	query := (&DeviceGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(devicegroup.Table, devicegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, device.GroupsTable, device.GroupsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-func (c *DeviceClient) mutate(ctx context.Context, m *DeviceMutation) (Value, error) {
-	switch m.Op() {
-	case OpCreate:
-		return (&DeviceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdate:
-		return (&DeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdateOne:
-		return (&DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpDelete, OpDeleteOne:
-		return (&DeviceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
-	default:
-		return nil, fmt.Errorf("ent: unknown Device mutation op: %q", m.Op())
+// QueryHeartbeats queries the heartbeats edge of a Device.
+func (c *DeviceClient) QueryHeartbeats(d *Device) *DeviceHeartbeatQuery {
+	query := (&DeviceHeartbeatClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(deviceheartbeat.Table, deviceheartbeat.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, device.HeartbeatsTable, device.HeartbeatsColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
 	}
+	return query
 }
 
-// FirmwareVersionClient is a client for the FirmwareVersion schema.
-type FirmwareVersionClient struct {
-	config
+// QueryCustomer queries the customer edge of a Device.
+func (c *DeviceClient) QueryCustomer(d *Device) *CustomerQuery {
+	query := (&CustomerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(customer.Table, customer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, device.CustomerTable, device.CustomerColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// NewFirmwareVersionClient returns a client for the FirmwareVersion from the given config.
-func NewFirmwareVersionClient(c config) *FirmwareVersionClient {
-	return &FirmwareVersionClient{config: c}
+// QueryAssignments queries the assignments edge of a Device.
+func (c *DeviceClient) QueryAssignments(d *Device) *DeviceAssignmentQuery {
+	query := (&DeviceAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(deviceassignment.Table, deviceassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, device.AssignmentsTable, device.AssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `firmwareversion.Hooks(f(g(h())))`.
-func (c *FirmwareVersionClient) Use(hooks ...Hook) {
-	c.hooks.FirmwareVersion = append(c.hooks.FirmwareVersion, hooks...)
+// QueryFeatureOverrides queries the feature_overrides edge of a Device.
+func (c *DeviceClient) QueryFeatureOverrides(d *Device) *DeviceFeatureOverrideQuery {
+	query := (&DeviceFeatureOverrideClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(devicefeatureoverride.Table, devicefeatureoverride.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, device.FeatureOverridesTable, device.FeatureOverridesColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `firmwareversion.Intercept(f(g(h())))`.
-func (c *FirmwareVersionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.FirmwareVersion = append(c.inters.FirmwareVersion, interceptors...)
+// QueryOrder queries the order edge of a Device.
+func (c *DeviceClient) QueryOrder(d *Device) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, device.OrderTable, device.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Create returns a builder for creating a FirmwareVersion entity.
-func (c *FirmwareVersionClient) Create() *FirmwareVersionCreate {
-	mutation := newFirmwareVersionMutation(c.config, OpCreate)
-	return &FirmwareVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// QueryLot queries the lot edge of a Device.
+func (c *DeviceClient) QueryLot(d *Device) *LotQuery {
+	query := (&LotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(lot.Table, lot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, device.LotTable, device.LotColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// CreateBulk returns a builder for creating a bulk of FirmwareVersion entities.
-func (c *FirmwareVersionClient) CreateBulk(builders ...*FirmwareVersionCreate) *FirmwareVersionCreateBulk {
-	return &FirmwareVersionCreateBulk{config: c.config, builders: builders}
+// Hooks returns the client hooks.
+func (c *DeviceClient) Hooks() []Hook {
+	hooks := c.hooks.Device
+	return append(hooks[:len(hooks):len(hooks)], device.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeviceClient) Interceptors() []Interceptor {
+	inters := c.inters.Device
+	return append(inters[:len(inters):len(inters)], device.Interceptors[:]...)
+}
+
+func (c *DeviceClient) mutate(ctx context.Context, m *DeviceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeviceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeviceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Device mutation op: %q", m.Op())
+	}
+}
+
+// DeviceAssignmentClient is a client for the DeviceAssignment schema.
+type DeviceAssignmentClient struct {
+	config
+}
+
+// NewDeviceAssignmentClient returns a client for the DeviceAssignment from the given config.
+func NewDeviceAssignmentClient(c config) *DeviceAssignmentClient {
+	return &DeviceAssignmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deviceassignment.Hooks(f(g(h())))`.
+func (c *DeviceAssignmentClient) Use(hooks ...Hook) {
+	c.hooks.DeviceAssignment = append(c.hooks.DeviceAssignment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deviceassignment.Intercept(f(g(h())))`.
+func (c *DeviceAssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceAssignment = append(c.inters.DeviceAssignment, interceptors...)
+}
+
+// Create returns a builder for creating a DeviceAssignment entity.
+func (c *DeviceAssignmentClient) Create() *DeviceAssignmentCreate {
+	mutation := newDeviceAssignmentMutation(c.config, OpCreate)
+	return &DeviceAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeviceAssignment entities.
+func (c *DeviceAssignmentClient) CreateBulk(builders ...*DeviceAssignmentCreate) *DeviceAssignmentCreateBulk {
+	return &DeviceAssignmentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FirmwareVersionClient) MapCreateBulk(slice any, setFunc func(*FirmwareVersionCreate, int)) *FirmwareVersionCreateBulk {
+func (c *DeviceAssignmentClient) MapCreateBulk(slice any, setFunc func(*DeviceAssignmentCreate, int)) *DeviceAssignmentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FirmwareVersionCreateBulk{err: fmt.Errorf("calling to FirmwareVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeviceAssignmentCreateBulk{err: fmt.Errorf("calling to DeviceAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FirmwareVersionCreate, rv.Len())
+	builders := make([]*DeviceAssignmentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FirmwareVersionCreateBulk{config: c.config, builders: builders}
+	return &DeviceAssignmentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for FirmwareVersion.
-func (c *FirmwareVersionClient) Update() *FirmwareVersionUpdate {
-	mutation := newFirmwareVersionMutation(c.config, OpUpdate)
-	return &FirmwareVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DeviceAssignment.
+func (c *DeviceAssignmentClient) Update() *DeviceAssignmentUpdate {
+	mutation := newDeviceAssignmentMutation(c.config, OpUpdate)
+	return &DeviceAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FirmwareVersionClient) UpdateOne(fv *FirmwareVersion) *FirmwareVersionUpdateOne {
-	mutation := newFirmwareVersionMutation(c.config, OpUpdateOne, withFirmwareVersion(fv))
-	return &FirmwareVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceAssignmentClient) UpdateOne(da *DeviceAssignment) *DeviceAssignmentUpdateOne {
+	mutation := newDeviceAssignmentMutation(c.config, OpUpdateOne, withDeviceAssignment(da))
+	return &DeviceAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FirmwareVersionClient) UpdateOneID(id int) *FirmwareVersionUpdateOne {
-	mutation := newFirmwareVersionMutation(c.config, OpUpdateOne, withFirmwareVersionID(id))
-	return &FirmwareVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceAssignmentClient) UpdateOneID(id int) *DeviceAssignmentUpdateOne {
+	mutation := newDeviceAssignmentMutation(c.config, OpUpdateOne, withDeviceAssignmentID(id))
+	return &DeviceAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for FirmwareVersion.
-func (c *FirmwareVersionClient) Delete() *FirmwareVersionDelete {
-	mutation := newFirmwareVersionMutation(c.config, OpDelete)
-	return &FirmwareVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DeviceAssignment.
+func (c *DeviceAssignmentClient) Delete() *DeviceAssignmentDelete {
+	mutation := newDeviceAssignmentMutation(c.config, OpDelete)
+	return &DeviceAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FirmwareVersionClient) DeleteOne(fv *FirmwareVersion) *FirmwareVersionDeleteOne {
-	return c.DeleteOneID(fv.ID)
+func (c *DeviceAssignmentClient) DeleteOne(da *DeviceAssignment) *DeviceAssignmentDeleteOne {
+	return c.DeleteOneID(da.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FirmwareVersionClient) DeleteOneID(id int) *FirmwareVersionDeleteOne {
-	builder := c.Delete().Where(firmwareversion.ID(id))
+func (c *DeviceAssignmentClient) DeleteOneID(id int) *DeviceAssignmentDeleteOne {
+	builder := c.Delete().Where(deviceassignment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FirmwareVersionDeleteOne{builder}
+	return &DeviceAssignmentDeleteOne{builder}
 }
 
-// Query returns a query builder for FirmwareVersion.
-func (c *FirmwareVersionClient) Query() *FirmwareVersionQuery {
-	return &FirmwareVersionQuery{
+// Query returns a query builder for DeviceAssignment.
+func (c *DeviceAssignmentClient) Query() *DeviceAssignmentQuery {
+	return &DeviceAssignmentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFirmwareVersion},
+		ctx:    &QueryContext{Type: TypeDeviceAssignment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a FirmwareVersion entity by its id.
-func (c *FirmwareVersionClient) Get(ctx context.Context, id int) (*FirmwareVersion, error) {
-	return c.Query().Where(firmwareversion.ID(id)).Only(ctx)
+// Get returns a DeviceAssignment entity by its id.
+func (c *DeviceAssignmentClient) Get(ctx context.Context, id int) (*DeviceAssignment, error) {
+	return c.Query().Where(deviceassignment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FirmwareVersionClient) GetX(ctx context.Context, id int) *FirmwareVersion {
+func (c *DeviceAssignmentClient) GetX(ctx context.Context, id int) *DeviceAssignment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -786,180 +1376,148 @@ func (c *FirmwareVersionClient) GetX(ctx context.Context, id int) *FirmwareVersi
 	return obj
 }
 
-// QuerySoftwareVersions queries the software_versions edge of a FirmwareVersion.
-func (c *FirmwareVersionClient) QuerySoftwareVersions(fv *FirmwareVersion) *SoftwareVersionQuery {
-	query := (&SoftwareVersionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := fv.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(firmwareversion.Table, firmwareversion.FieldID, id),
-			sqlgraph.To(softwareversion.Table, softwareversion.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, firmwareversion.SoftwareVersionsTable, firmwareversion.SoftwareVersionsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(fv.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProduct queries the product edge of a FirmwareVersion.
-func (c *FirmwareVersionClient) QueryProduct(fv *FirmwareVersion) *ProductQuery {
-	query := (&ProductClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := fv.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(firmwareversion.Table, firmwareversion.FieldID, id),
-			sqlgraph.To(product.Table, product.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, firmwareversion.ProductTable, firmwareversion.ProductColumn),
-		)
-		fromV = sqlgraph.Neighbors(fv.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCreator queries the creator edge of a FirmwareVersion.
-func (c *FirmwareVersionClient) QueryCreator(fv *FirmwareVersion) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryDevice queries the device edge of a DeviceAssignment.
+func (c *DeviceAssignmentClient) QueryDevice(da *DeviceAssignment) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := fv.ID
+		id := da.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(firmwareversion.Table, firmwareversion.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, firmwareversion.CreatorTable, firmwareversion.CreatorColumn),
+			sqlgraph.From(deviceassignment.Table, deviceassignment.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deviceassignment.DeviceTable, deviceassignment.DeviceColumn),
 		)
-		fromV = sqlgraph.Neighbors(fv.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(da.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *FirmwareVersionClient) Hooks() []Hook {
-	return c.hooks.FirmwareVersion
+func (c *DeviceAssignmentClient) Hooks() []Hook {
+	return c.hooks.DeviceAssignment
 }
 
 // Interceptors returns the client interceptors.
-func (c *FirmwareVersionClient) Interceptors() []Interceptor {
-	return c.inters.FirmwareVersion
+func (c *DeviceAssignmentClient) Interceptors() []Interceptor {
+	return c.inters.DeviceAssignment
 }
 
-func (c *FirmwareVersionClient) mutate(ctx context.Context, m *FirmwareVersionMutation) (Value, error) {
+func (c *DeviceAssignmentClient) mutate(ctx context.Context, m *DeviceAssignmentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FirmwareVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FirmwareVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FirmwareVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FirmwareVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DeviceAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown FirmwareVersion mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DeviceAssignment mutation op: %q", m.Op())
 	}
 }
 
-// LicenseTypeClient is a client for the LicenseType schema.
-type LicenseTypeClient struct {
+// DeviceFeatureOverrideClient is a client for the DeviceFeatureOverride schema.
+type DeviceFeatureOverrideClient struct {
 	config
 }
 
-// NewLicenseTypeClient returns a client for the LicenseType from the given config.
-func NewLicenseTypeClient(c config) *LicenseTypeClient {
-	return &LicenseTypeClient{config: c}
+// NewDeviceFeatureOverrideClient returns a client for the DeviceFeatureOverride from the given config.
+func NewDeviceFeatureOverrideClient(c config) *DeviceFeatureOverrideClient {
+	return &DeviceFeatureOverrideClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `licensetype.Hooks(f(g(h())))`.
-func (c *LicenseTypeClient) Use(hooks ...Hook) {
-	c.hooks.LicenseType = append(c.hooks.LicenseType, hooks...)
+// A call to `Use(f, g, h)` equals to `devicefeatureoverride.Hooks(f(g(h())))`.
+func (c *DeviceFeatureOverrideClient) Use(hooks ...Hook) {
+	c.hooks.DeviceFeatureOverride = append(c.hooks.DeviceFeatureOverride, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `licensetype.Intercept(f(g(h())))`.
-func (c *LicenseTypeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LicenseType = append(c.inters.LicenseType, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `devicefeatureoverride.Intercept(f(g(h())))`.
+func (c *DeviceFeatureOverrideClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceFeatureOverride = append(c.inters.DeviceFeatureOverride, interceptors...)
 }
 
-// Create returns a builder for creating a LicenseType entity.
-func (c *LicenseTypeClient) Create() *LicenseTypeCreate {
-	mutation := newLicenseTypeMutation(c.config, OpCreate)
-	return &LicenseTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DeviceFeatureOverride entity.
+func (c *DeviceFeatureOverrideClient) Create() *DeviceFeatureOverrideCreate {
+	mutation := newDeviceFeatureOverrideMutation(c.config, OpCreate)
+	return &DeviceFeatureOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LicenseType entities.
-func (c *LicenseTypeClient) CreateBulk(builders ...*LicenseTypeCreate) *LicenseTypeCreateBulk {
-	return &LicenseTypeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DeviceFeatureOverride entities.
+func (c *DeviceFeatureOverrideClient) CreateBulk(builders ...*DeviceFeatureOverrideCreate) *DeviceFeatureOverrideCreateBulk {
+	return &DeviceFeatureOverrideCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LicenseTypeClient) MapCreateBulk(slice any, setFunc func(*LicenseTypeCreate, int)) *LicenseTypeCreateBulk {
+func (c *DeviceFeatureOverrideClient) MapCreateBulk(slice any, setFunc func(*DeviceFeatureOverrideCreate, int)) *DeviceFeatureOverrideCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LicenseTypeCreateBulk{err: fmt.Errorf("calling to LicenseTypeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeviceFeatureOverrideCreateBulk{err: fmt.Errorf("calling to DeviceFeatureOverrideClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LicenseTypeCreate, rv.Len())
+	builders := make([]*DeviceFeatureOverrideCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LicenseTypeCreateBulk{config: c.config, builders: builders}
+	return &DeviceFeatureOverrideCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LicenseType.
-func (c *LicenseTypeClient) Update() *LicenseTypeUpdate {
-	mutation := newLicenseTypeMutation(c.config, OpUpdate)
-	return &LicenseTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DeviceFeatureOverride.
+func (c *DeviceFeatureOverrideClient) Update() *DeviceFeatureOverrideUpdate {
+	mutation := newDeviceFeatureOverrideMutation(c.config, OpUpdate)
+	return &DeviceFeatureOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LicenseTypeClient) UpdateOne(lt *LicenseType) *LicenseTypeUpdateOne {
-	mutation := newLicenseTypeMutation(c.config, OpUpdateOne, withLicenseType(lt))
-	return &LicenseTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceFeatureOverrideClient) UpdateOne(dfo *DeviceFeatureOverride) *DeviceFeatureOverrideUpdateOne {
+	mutation := newDeviceFeatureOverrideMutation(c.config, OpUpdateOne, withDeviceFeatureOverride(dfo))
+	return &DeviceFeatureOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LicenseTypeClient) UpdateOneID(id int) *LicenseTypeUpdateOne {
-	mutation := newLicenseTypeMutation(c.config, OpUpdateOne, withLicenseTypeID(id))
-	return &LicenseTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceFeatureOverrideClient) UpdateOneID(id int) *DeviceFeatureOverrideUpdateOne {
+	mutation := newDeviceFeatureOverrideMutation(c.config, OpUpdateOne, withDeviceFeatureOverrideID(id))
+	return &DeviceFeatureOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LicenseType.
-func (c *LicenseTypeClient) Delete() *LicenseTypeDelete {
-	mutation := newLicenseTypeMutation(c.config, OpDelete)
-	return &LicenseTypeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DeviceFeatureOverride.
+func (c *DeviceFeatureOverrideClient) Delete() *DeviceFeatureOverrideDelete {
+	mutation := newDeviceFeatureOverrideMutation(c.config, OpDelete)
+	return &DeviceFeatureOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LicenseTypeClient) DeleteOne(lt *LicenseType) *LicenseTypeDeleteOne {
-	return c.DeleteOneID(lt.ID)
+func (c *DeviceFeatureOverrideClient) DeleteOne(dfo *DeviceFeatureOverride) *DeviceFeatureOverrideDeleteOne {
+	return c.DeleteOneID(dfo.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LicenseTypeClient) DeleteOneID(id int) *LicenseTypeDeleteOne {
-	builder := c.Delete().Where(licensetype.ID(id))
+func (c *DeviceFeatureOverrideClient) DeleteOneID(id int) *DeviceFeatureOverrideDeleteOne {
+	builder := c.Delete().Where(devicefeatureoverride.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LicenseTypeDeleteOne{builder}
+	return &DeviceFeatureOverrideDeleteOne{builder}
 }
 
-// Query returns a query builder for LicenseType.
-func (c *LicenseTypeClient) Query() *LicenseTypeQuery {
-	return &LicenseTypeQuery{
+// Query returns a query builder for DeviceFeatureOverride.
+func (c *DeviceFeatureOverrideClient) Query() *DeviceFeatureOverrideQuery {
+	return &DeviceFeatureOverrideQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLicenseType},
+		ctx:    &QueryContext{Type: TypeDeviceFeatureOverride},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LicenseType entity by its id.
-func (c *LicenseTypeClient) Get(ctx context.Context, id int) (*LicenseType, error) {
-	return c.Query().Where(licensetype.ID(id)).Only(ctx)
+// Get returns a DeviceFeatureOverride entity by its id.
+func (c *DeviceFeatureOverrideClient) Get(ctx context.Context, id int) (*DeviceFeatureOverride, error) {
+	return c.Query().Where(devicefeatureoverride.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LicenseTypeClient) GetX(ctx context.Context, id int) *LicenseType {
+func (c *DeviceFeatureOverrideClient) GetX(ctx context.Context, id int) *DeviceFeatureOverride {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -967,196 +1525,164 @@ func (c *LicenseTypeClient) GetX(ctx context.Context, id int) *LicenseType {
 	return obj
 }
 
-// QueryProduct queries the product edge of a LicenseType.
-func (c *LicenseTypeClient) QueryProduct(lt *LicenseType) *ProductQuery {
-	query := (&ProductClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lt.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(licensetype.Table, licensetype.FieldID, id),
-			sqlgraph.To(product.Table, product.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, licensetype.ProductTable, licensetype.ProductColumn),
-		)
-		fromV = sqlgraph.Neighbors(lt.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryFeatures queries the features edge of a LicenseType.
-func (c *LicenseTypeClient) QueryFeatures(lt *LicenseType) *ProductFeatureQuery {
-	query := (&ProductFeatureClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lt.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(licensetype.Table, licensetype.FieldID, id),
-			sqlgraph.To(productfeature.Table, productfeature.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, licensetype.FeaturesTable, licensetype.FeaturesPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(lt.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryDevices queries the devices edge of a LicenseType.
-func (c *LicenseTypeClient) QueryDevices(lt *LicenseType) *DeviceQuery {
+// QueryDevice queries the device edge of a DeviceFeatureOverride.
+func (c *DeviceFeatureOverrideClient) QueryDevice(dfo *DeviceFeatureOverride) *DeviceQuery {
 	query := (&DeviceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lt.ID
+		id := dfo.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(licensetype.Table, licensetype.FieldID, id),
+			sqlgraph.From(devicefeatureoverride.Table, devicefeatureoverride.FieldID, id),
 			sqlgraph.To(device.Table, device.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, licensetype.DevicesTable, licensetype.DevicesColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, devicefeatureoverride.DeviceTable, devicefeatureoverride.DeviceColumn),
 		)
-		fromV = sqlgraph.Neighbors(lt.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(dfo.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryLicenseTypeFeatures queries the license_type_features edge of a LicenseType.
-func (c *LicenseTypeClient) QueryLicenseTypeFeatures(lt *LicenseType) *LicenseTypeFeaturesQuery {
-	query := (&LicenseTypeFeaturesClient{config: c.config}).Query()
+// QueryFeature queries the feature edge of a DeviceFeatureOverride.
+func (c *DeviceFeatureOverrideClient) QueryFeature(dfo *DeviceFeatureOverride) *ProductFeatureQuery {
+	query := (&ProductFeatureClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lt.ID
+		id := dfo.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(licensetype.Table, licensetype.FieldID, id),
-			sqlgraph.To(licensetypefeatures.Table, licensetypefeatures.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, licensetype.LicenseTypeFeaturesTable, licensetype.LicenseTypeFeaturesColumn),
+			sqlgraph.From(devicefeatureoverride.Table, devicefeatureoverride.FieldID, id),
+			sqlgraph.To(productfeature.Table, productfeature.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, devicefeatureoverride.FeatureTable, devicefeatureoverride.FeatureColumn),
 		)
-		fromV = sqlgraph.Neighbors(lt.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(dfo.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *LicenseTypeClient) Hooks() []Hook {
-	return c.hooks.LicenseType
+func (c *DeviceFeatureOverrideClient) Hooks() []Hook {
+	return c.hooks.DeviceFeatureOverride
 }
 
 // Interceptors returns the client interceptors.
-func (c *LicenseTypeClient) Interceptors() []Interceptor {
-	return c.inters.LicenseType
+func (c *DeviceFeatureOverrideClient) Interceptors() []Interceptor {
+	return c.inters.DeviceFeatureOverride
 }
 
-func (c *LicenseTypeClient) mutate(ctx context.Context, m *LicenseTypeMutation) (Value, error) {
+func (c *DeviceFeatureOverrideClient) mutate(ctx context.Context, m *DeviceFeatureOverrideMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LicenseTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceFeatureOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LicenseTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceFeatureOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LicenseTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceFeatureOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LicenseTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DeviceFeatureOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LicenseType mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DeviceFeatureOverride mutation op: %q", m.Op())
 	}
 }
 
-// LicenseTypeFeaturesClient is a client for the LicenseTypeFeatures schema.
-type LicenseTypeFeaturesClient struct {
+// DeviceGroupClient is a client for the DeviceGroup schema.
+type DeviceGroupClient struct {
 	config
 }
 
-// NewLicenseTypeFeaturesClient returns a client for the LicenseTypeFeatures from the given config.
-func NewLicenseTypeFeaturesClient(c config) *LicenseTypeFeaturesClient {
-	return &LicenseTypeFeaturesClient{config: c}
+// NewDeviceGroupClient returns a client for the DeviceGroup from the given config.
+func NewDeviceGroupClient(c config) *DeviceGroupClient {
+	return &DeviceGroupClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `licensetypefeatures.Hooks(f(g(h())))`.
-func (c *LicenseTypeFeaturesClient) Use(hooks ...Hook) {
-	c.hooks.LicenseTypeFeatures = append(c.hooks.LicenseTypeFeatures, hooks...)
+// A call to `Use(f, g, h)` equals to `devicegroup.Hooks(f(g(h())))`.
+func (c *DeviceGroupClient) Use(hooks ...Hook) {
+	c.hooks.DeviceGroup = append(c.hooks.DeviceGroup, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `licensetypefeatures.Intercept(f(g(h())))`.
-func (c *LicenseTypeFeaturesClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LicenseTypeFeatures = append(c.inters.LicenseTypeFeatures, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `devicegroup.Intercept(f(g(h())))`.
+func (c *DeviceGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceGroup = append(c.inters.DeviceGroup, interceptors...)
 }
 
-// Create returns a builder for creating a LicenseTypeFeatures entity.
-func (c *LicenseTypeFeaturesClient) Create() *LicenseTypeFeaturesCreate {
-	mutation := newLicenseTypeFeaturesMutation(c.config, OpCreate)
-	return &LicenseTypeFeaturesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DeviceGroup entity.
+func (c *DeviceGroupClient) Create() *DeviceGroupCreate {
+	mutation := newDeviceGroupMutation(c.config, OpCreate)
+	return &DeviceGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LicenseTypeFeatures entities.
-func (c *LicenseTypeFeaturesClient) CreateBulk(builders ...*LicenseTypeFeaturesCreate) *LicenseTypeFeaturesCreateBulk {
-	return &LicenseTypeFeaturesCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DeviceGroup entities.
+func (c *DeviceGroupClient) CreateBulk(builders ...*DeviceGroupCreate) *DeviceGroupCreateBulk {
+	return &DeviceGroupCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LicenseTypeFeaturesClient) MapCreateBulk(slice any, setFunc func(*LicenseTypeFeaturesCreate, int)) *LicenseTypeFeaturesCreateBulk {
+func (c *DeviceGroupClient) MapCreateBulk(slice any, setFunc func(*DeviceGroupCreate, int)) *DeviceGroupCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LicenseTypeFeaturesCreateBulk{err: fmt.Errorf("calling to LicenseTypeFeaturesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeviceGroupCreateBulk{err: fmt.Errorf("calling to DeviceGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LicenseTypeFeaturesCreate, rv.Len())
+	builders := make([]*DeviceGroupCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LicenseTypeFeaturesCreateBulk{config: c.config, builders: builders}
+	return &DeviceGroupCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LicenseTypeFeatures.
-func (c *LicenseTypeFeaturesClient) Update() *LicenseTypeFeaturesUpdate {
-	mutation := newLicenseTypeFeaturesMutation(c.config, OpUpdate)
-	return &LicenseTypeFeaturesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DeviceGroup.
+func (c *DeviceGroupClient) Update() *DeviceGroupUpdate {
+	mutation := newDeviceGroupMutation(c.config, OpUpdate)
+	return &DeviceGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LicenseTypeFeaturesClient) UpdateOne(ltf *LicenseTypeFeatures) *LicenseTypeFeaturesUpdateOne {
-	mutation := newLicenseTypeFeaturesMutation(c.config, OpUpdateOne, withLicenseTypeFeatures(ltf))
-	return &LicenseTypeFeaturesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceGroupClient) UpdateOne(dg *DeviceGroup) *DeviceGroupUpdateOne {
+	mutation := newDeviceGroupMutation(c.config, OpUpdateOne, withDeviceGroup(dg))
+	return &DeviceGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LicenseTypeFeaturesClient) UpdateOneID(id int) *LicenseTypeFeaturesUpdateOne {
-	mutation := newLicenseTypeFeaturesMutation(c.config, OpUpdateOne, withLicenseTypeFeaturesID(id))
-	return &LicenseTypeFeaturesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceGroupClient) UpdateOneID(id int) *DeviceGroupUpdateOne {
+	mutation := newDeviceGroupMutation(c.config, OpUpdateOne, withDeviceGroupID(id))
+	return &DeviceGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LicenseTypeFeatures.
-func (c *LicenseTypeFeaturesClient) Delete() *LicenseTypeFeaturesDelete {
-	mutation := newLicenseTypeFeaturesMutation(c.config, OpDelete)
-	return &LicenseTypeFeaturesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DeviceGroup.
+func (c *DeviceGroupClient) Delete() *DeviceGroupDelete {
+	mutation := newDeviceGroupMutation(c.config, OpDelete)
+	return &DeviceGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LicenseTypeFeaturesClient) DeleteOne(ltf *LicenseTypeFeatures) *LicenseTypeFeaturesDeleteOne {
-	return c.DeleteOneID(ltf.ID)
+func (c *DeviceGroupClient) DeleteOne(dg *DeviceGroup) *DeviceGroupDeleteOne {
+	return c.DeleteOneID(dg.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LicenseTypeFeaturesClient) DeleteOneID(id int) *LicenseTypeFeaturesDeleteOne {
-	builder := c.Delete().Where(licensetypefeatures.ID(id))
+func (c *DeviceGroupClient) DeleteOneID(id int) *DeviceGroupDeleteOne {
+	builder := c.Delete().Where(devicegroup.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LicenseTypeFeaturesDeleteOne{builder}
+	return &DeviceGroupDeleteOne{builder}
 }
 
-// Query returns a query builder for LicenseTypeFeatures.
-func (c *LicenseTypeFeaturesClient) Query() *LicenseTypeFeaturesQuery {
-	return &LicenseTypeFeaturesQuery{
+// Query returns a query builder for DeviceGroup.
+func (c *DeviceGroupClient) Query() *DeviceGroupQuery {
+	return &DeviceGroupQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLicenseTypeFeatures},
+		ctx:    &QueryContext{Type: TypeDeviceGroup},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LicenseTypeFeatures entity by its id.
-func (c *LicenseTypeFeaturesClient) Get(ctx context.Context, id int) (*LicenseTypeFeatures, error) {
-	return c.Query().Where(licensetypefeatures.ID(id)).Only(ctx)
+// Get returns a DeviceGroup entity by its id.
+func (c *DeviceGroupClient) Get(ctx context.Context, id int) (*DeviceGroup, error) {
+	return c.Query().Where(devicegroup.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LicenseTypeFeaturesClient) GetX(ctx context.Context, id int) *LicenseTypeFeatures {
+func (c *DeviceGroupClient) GetX(ctx context.Context, id int) *DeviceGroup {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1164,164 +1690,164 @@ func (c *LicenseTypeFeaturesClient) GetX(ctx context.Context, id int) *LicenseTy
 	return obj
 }
 
-// QueryLicenseType queries the license_type edge of a LicenseTypeFeatures.
-func (c *LicenseTypeFeaturesClient) QueryLicenseType(ltf *LicenseTypeFeatures) *LicenseTypeQuery {
-	query := (&LicenseTypeClient{config: c.config}).Query()
+// QueryProduct queries the product edge of a DeviceGroup.
+func (c *DeviceGroupClient) QueryProduct(dg *DeviceGroup) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ltf.ID
+		id := dg.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(licensetypefeatures.Table, licensetypefeatures.FieldID, id),
-			sqlgraph.To(licensetype.Table, licensetype.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, licensetypefeatures.LicenseTypeTable, licensetypefeatures.LicenseTypeColumn),
+			sqlgraph.From(devicegroup.Table, devicegroup.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, devicegroup.ProductTable, devicegroup.ProductColumn),
 		)
-		fromV = sqlgraph.Neighbors(ltf.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(dg.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryFeature queries the feature edge of a LicenseTypeFeatures.
-func (c *LicenseTypeFeaturesClient) QueryFeature(ltf *LicenseTypeFeatures) *ProductFeatureQuery {
-	query := (&ProductFeatureClient{config: c.config}).Query()
+// QueryDevices queries the devices edge of a DeviceGroup.
+func (c *DeviceGroupClient) QueryDevices(dg *DeviceGroup) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ltf.ID
+		id := dg.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(licensetypefeatures.Table, licensetypefeatures.FieldID, id),
-			sqlgraph.To(productfeature.Table, productfeature.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, licensetypefeatures.FeatureTable, licensetypefeatures.FeatureColumn),
+			sqlgraph.From(devicegroup.Table, devicegroup.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, devicegroup.DevicesTable, devicegroup.DevicesPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(ltf.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(dg.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *LicenseTypeFeaturesClient) Hooks() []Hook {
-	return c.hooks.LicenseTypeFeatures
+func (c *DeviceGroupClient) Hooks() []Hook {
+	return c.hooks.DeviceGroup
 }
 
 // Interceptors returns the client interceptors.
-func (c *LicenseTypeFeaturesClient) Interceptors() []Interceptor {
-	return c.inters.LicenseTypeFeatures
+func (c *DeviceGroupClient) Interceptors() []Interceptor {
+	return c.inters.DeviceGroup
 }
 
-func (c *LicenseTypeFeaturesClient) mutate(ctx context.Context, m *LicenseTypeFeaturesMutation) (Value, error) {
+func (c *DeviceGroupClient) mutate(ctx context.Context, m *DeviceGroupMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LicenseTypeFeaturesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LicenseTypeFeaturesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LicenseTypeFeaturesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LicenseTypeFeaturesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DeviceGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LicenseTypeFeatures mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DeviceGroup mutation op: %q", m.Op())
 	}
 }
 
-// MetricEventClient is a client for the MetricEvent schema.
-type MetricEventClient struct {
+// DeviceHeartbeatClient is a client for the DeviceHeartbeat schema.
+type DeviceHeartbeatClient struct {
 	config
 }
 
-// NewMetricEventClient returns a client for the MetricEvent from the given config.
-func NewMetricEventClient(c config) *MetricEventClient {
-	return &MetricEventClient{config: c}
+// NewDeviceHeartbeatClient returns a client for the DeviceHeartbeat from the given config.
+func NewDeviceHeartbeatClient(c config) *DeviceHeartbeatClient {
+	return &DeviceHeartbeatClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `metricevent.Hooks(f(g(h())))`.
-func (c *MetricEventClient) Use(hooks ...Hook) {
-	c.hooks.MetricEvent = append(c.hooks.MetricEvent, hooks...)
+// A call to `Use(f, g, h)` equals to `deviceheartbeat.Hooks(f(g(h())))`.
+func (c *DeviceHeartbeatClient) Use(hooks ...Hook) {
+	c.hooks.DeviceHeartbeat = append(c.hooks.DeviceHeartbeat, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `metricevent.Intercept(f(g(h())))`.
-func (c *MetricEventClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MetricEvent = append(c.inters.MetricEvent, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `deviceheartbeat.Intercept(f(g(h())))`.
+func (c *DeviceHeartbeatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceHeartbeat = append(c.inters.DeviceHeartbeat, interceptors...)
 }
 
-// Create returns a builder for creating a MetricEvent entity.
-func (c *MetricEventClient) Create() *MetricEventCreate {
-	mutation := newMetricEventMutation(c.config, OpCreate)
-	return &MetricEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DeviceHeartbeat entity.
+func (c *DeviceHeartbeatClient) Create() *DeviceHeartbeatCreate {
+	mutation := newDeviceHeartbeatMutation(c.config, OpCreate)
+	return &DeviceHeartbeatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MetricEvent entities.
-func (c *MetricEventClient) CreateBulk(builders ...*MetricEventCreate) *MetricEventCreateBulk {
-	return &MetricEventCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DeviceHeartbeat entities.
+func (c *DeviceHeartbeatClient) CreateBulk(builders ...*DeviceHeartbeatCreate) *DeviceHeartbeatCreateBulk {
+	return &DeviceHeartbeatCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MetricEventClient) MapCreateBulk(slice any, setFunc func(*MetricEventCreate, int)) *MetricEventCreateBulk {
+func (c *DeviceHeartbeatClient) MapCreateBulk(slice any, setFunc func(*DeviceHeartbeatCreate, int)) *DeviceHeartbeatCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MetricEventCreateBulk{err: fmt.Errorf("calling to MetricEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeviceHeartbeatCreateBulk{err: fmt.Errorf("calling to DeviceHeartbeatClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MetricEventCreate, rv.Len())
+	builders := make([]*DeviceHeartbeatCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MetricEventCreateBulk{config: c.config, builders: builders}
+	return &DeviceHeartbeatCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MetricEvent.
-func (c *MetricEventClient) Update() *MetricEventUpdate {
-	mutation := newMetricEventMutation(c.config, OpUpdate)
-	return &MetricEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DeviceHeartbeat.
+func (c *DeviceHeartbeatClient) Update() *DeviceHeartbeatUpdate {
+	mutation := newDeviceHeartbeatMutation(c.config, OpUpdate)
+	return &DeviceHeartbeatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MetricEventClient) UpdateOne(me *MetricEvent) *MetricEventUpdateOne {
-	mutation := newMetricEventMutation(c.config, OpUpdateOne, withMetricEvent(me))
-	return &MetricEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceHeartbeatClient) UpdateOne(dh *DeviceHeartbeat) *DeviceHeartbeatUpdateOne {
+	mutation := newDeviceHeartbeatMutation(c.config, OpUpdateOne, withDeviceHeartbeat(dh))
+	return &DeviceHeartbeatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MetricEventClient) UpdateOneID(id int) *MetricEventUpdateOne {
-	mutation := newMetricEventMutation(c.config, OpUpdateOne, withMetricEventID(id))
-	return &MetricEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceHeartbeatClient) UpdateOneID(id int) *DeviceHeartbeatUpdateOne {
+	mutation := newDeviceHeartbeatMutation(c.config, OpUpdateOne, withDeviceHeartbeatID(id))
+	return &DeviceHeartbeatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MetricEvent.
-func (c *MetricEventClient) Delete() *MetricEventDelete {
-	mutation := newMetricEventMutation(c.config, OpDelete)
-	return &MetricEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DeviceHeartbeat.
+func (c *DeviceHeartbeatClient) Delete() *DeviceHeartbeatDelete {
+	mutation := newDeviceHeartbeatMutation(c.config, OpDelete)
+	return &DeviceHeartbeatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MetricEventClient) DeleteOne(me *MetricEvent) *MetricEventDeleteOne {
-	return c.DeleteOneID(me.ID)
+func (c *DeviceHeartbeatClient) DeleteOne(dh *DeviceHeartbeat) *DeviceHeartbeatDeleteOne {
+	return c.DeleteOneID(dh.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MetricEventClient) DeleteOneID(id int) *MetricEventDeleteOne {
-	builder := c.Delete().Where(metricevent.ID(id))
+func (c *DeviceHeartbeatClient) DeleteOneID(id int) *DeviceHeartbeatDeleteOne {
+	builder := c.Delete().Where(deviceheartbeat.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MetricEventDeleteOne{builder}
+	return &DeviceHeartbeatDeleteOne{builder}
 }
 
-// Query returns a query builder for MetricEvent.
-func (c *MetricEventClient) Query() *MetricEventQuery {
-	return &MetricEventQuery{
+// Query returns a query builder for DeviceHeartbeat.
+func (c *DeviceHeartbeatClient) Query() *DeviceHeartbeatQuery {
+	return &DeviceHeartbeatQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMetricEvent},
+		ctx:    &QueryContext{Type: TypeDeviceHeartbeat},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MetricEvent entity by its id.
-func (c *MetricEventClient) Get(ctx context.Context, id int) (*MetricEvent, error) {
-	return c.Query().Where(metricevent.ID(id)).Only(ctx)
+// Get returns a DeviceHeartbeat entity by its id.
+func (c *DeviceHeartbeatClient) Get(ctx context.Context, id int) (*DeviceHeartbeat, error) {
+	return c.Query().Where(deviceheartbeat.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MetricEventClient) GetX(ctx context.Context, id int) *MetricEvent {
+func (c *DeviceHeartbeatClient) GetX(ctx context.Context, id int) *DeviceHeartbeat {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1329,132 +1855,148 @@ func (c *MetricEventClient) GetX(ctx context.Context, id int) *MetricEvent {
 	return obj
 }
 
+// QueryDevice queries the device edge of a DeviceHeartbeat.
+func (c *DeviceHeartbeatClient) QueryDevice(dh *DeviceHeartbeat) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := dh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deviceheartbeat.Table, deviceheartbeat.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deviceheartbeat.DeviceTable, deviceheartbeat.DeviceColumn),
+		)
+		fromV = sqlgraph.Neighbors(dh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *MetricEventClient) Hooks() []Hook {
-	return c.hooks.MetricEvent
+func (c *DeviceHeartbeatClient) Hooks() []Hook {
+	return c.hooks.DeviceHeartbeat
 }
 
 // Interceptors returns the client interceptors.
-func (c *MetricEventClient) Interceptors() []Interceptor {
-	return c.inters.MetricEvent
+func (c *DeviceHeartbeatClient) Interceptors() []Interceptor {
+	return c.inters.DeviceHeartbeat
 }
 
-func (c *MetricEventClient) mutate(ctx context.Context, m *MetricEventMutation) (Value, error) {
+func (c *DeviceHeartbeatClient) mutate(ctx context.Context, m *DeviceHeartbeatMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MetricEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceHeartbeatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MetricEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceHeartbeatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MetricEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceHeartbeatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MetricEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DeviceHeartbeatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MetricEvent mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DeviceHeartbeat mutation op: %q", m.Op())
 	}
 }
 
-// PostClient is a client for the Post schema.
-type PostClient struct {
+// DeviceSavedFilterClient is a client for the DeviceSavedFilter schema.
+type DeviceSavedFilterClient struct {
 	config
 }
 
-// NewPostClient returns a client for the Post from the given config.
-func NewPostClient(c config) *PostClient {
-	return &PostClient{config: c}
+// NewDeviceSavedFilterClient returns a client for the DeviceSavedFilter from the given config.
+func NewDeviceSavedFilterClient(c config) *DeviceSavedFilterClient {
+	return &DeviceSavedFilterClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `post.Hooks(f(g(h())))`.
-func (c *PostClient) Use(hooks ...Hook) {
-	c.hooks.Post = append(c.hooks.Post, hooks...)
+// A call to `Use(f, g, h)` equals to `devicesavedfilter.Hooks(f(g(h())))`.
+func (c *DeviceSavedFilterClient) Use(hooks ...Hook) {
+	c.hooks.DeviceSavedFilter = append(c.hooks.DeviceSavedFilter, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `post.Intercept(f(g(h())))`.
-func (c *PostClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Post = append(c.inters.Post, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `devicesavedfilter.Intercept(f(g(h())))`.
+func (c *DeviceSavedFilterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceSavedFilter = append(c.inters.DeviceSavedFilter, interceptors...)
 }
 
-// Create returns a builder for creating a Post entity.
-func (c *PostClient) Create() *PostCreate {
-	mutation := newPostMutation(c.config, OpCreate)
-	return &PostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DeviceSavedFilter entity.
+func (c *DeviceSavedFilterClient) Create() *DeviceSavedFilterCreate {
+	mutation := newDeviceSavedFilterMutation(c.config, OpCreate)
+	return &DeviceSavedFilterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Post entities.
-func (c *PostClient) CreateBulk(builders ...*PostCreate) *PostCreateBulk {
-	return &PostCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DeviceSavedFilter entities.
+func (c *DeviceSavedFilterClient) CreateBulk(builders ...*DeviceSavedFilterCreate) *DeviceSavedFilterCreateBulk {
+	return &DeviceSavedFilterCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PostClient) MapCreateBulk(slice any, setFunc func(*PostCreate, int)) *PostCreateBulk {
+func (c *DeviceSavedFilterClient) MapCreateBulk(slice any, setFunc func(*DeviceSavedFilterCreate, int)) *DeviceSavedFilterCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PostCreateBulk{err: fmt.Errorf("calling to PostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeviceSavedFilterCreateBulk{err: fmt.Errorf("calling to DeviceSavedFilterClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PostCreate, rv.Len())
+	builders := make([]*DeviceSavedFilterCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PostCreateBulk{config: c.config, builders: builders}
+	return &DeviceSavedFilterCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Post.
-func (c *PostClient) Update() *PostUpdate {
-	mutation := newPostMutation(c.config, OpUpdate)
-	return &PostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DeviceSavedFilter.
+func (c *DeviceSavedFilterClient) Update() *DeviceSavedFilterUpdate {
+	mutation := newDeviceSavedFilterMutation(c.config, OpUpdate)
+	return &DeviceSavedFilterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PostClient) UpdateOne(po *Post) *PostUpdateOne {
-	mutation := newPostMutation(c.config, OpUpdateOne, withPost(po))
-	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceSavedFilterClient) UpdateOne(dsf *DeviceSavedFilter) *DeviceSavedFilterUpdateOne {
+	mutation := newDeviceSavedFilterMutation(c.config, OpUpdateOne, withDeviceSavedFilter(dsf))
+	return &DeviceSavedFilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PostClient) UpdateOneID(id int) *PostUpdateOne {
-	mutation := newPostMutation(c.config, OpUpdateOne, withPostID(id))
-	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceSavedFilterClient) UpdateOneID(id int) *DeviceSavedFilterUpdateOne {
+	mutation := newDeviceSavedFilterMutation(c.config, OpUpdateOne, withDeviceSavedFilterID(id))
+	return &DeviceSavedFilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Post.
-func (c *PostClient) Delete() *PostDelete {
-	mutation := newPostMutation(c.config, OpDelete)
-	return &PostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DeviceSavedFilter.
+func (c *DeviceSavedFilterClient) Delete() *DeviceSavedFilterDelete {
+	mutation := newDeviceSavedFilterMutation(c.config, OpDelete)
+	return &DeviceSavedFilterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PostClient) DeleteOne(po *Post) *PostDeleteOne {
-	return c.DeleteOneID(po.ID)
+func (c *DeviceSavedFilterClient) DeleteOne(dsf *DeviceSavedFilter) *DeviceSavedFilterDeleteOne {
+	return c.DeleteOneID(dsf.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PostClient) DeleteOneID(id int) *PostDeleteOne {
-	builder := c.Delete().Where(post.ID(id))
+func (c *DeviceSavedFilterClient) DeleteOneID(id int) *DeviceSavedFilterDeleteOne {
+	builder := c.Delete().Where(devicesavedfilter.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PostDeleteOne{builder}
+	return &DeviceSavedFilterDeleteOne{builder}
 }
 
-// Query returns a query builder for Post.
-func (c *PostClient) Query() *PostQuery {
-	return &PostQuery{
+// Query returns a query builder for DeviceSavedFilter.
+func (c *DeviceSavedFilterClient) Query() *DeviceSavedFilterQuery {
+	return &DeviceSavedFilterQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePost},
+		ctx:    &QueryContext{Type: TypeDeviceSavedFilter},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Post entity by its id.
-func (c *PostClient) Get(ctx context.Context, id int) (*Post, error) {
-	return c.Query().Where(post.ID(id)).Only(ctx)
+// Get returns a DeviceSavedFilter entity by its id.
+func (c *DeviceSavedFilterClient) Get(ctx context.Context, id int) (*DeviceSavedFilter, error) {
+	return c.Query().Where(devicesavedfilter.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PostClient) GetX(ctx context.Context, id int) *Post {
+func (c *DeviceSavedFilterClient) GetX(ctx context.Context, id int) *DeviceSavedFilter {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1462,180 +2004,148 @@ func (c *PostClient) GetX(ctx context.Context, id int) *Post {
 	return obj
 }
 
-// QueryCategory queries the category edge of a Post.
-func (c *PostClient) QueryCategory(po *Post) *PostCategoryQuery {
-	query := (&PostCategoryClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(postcategory.Table, postcategory.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, post.CategoryTable, post.CategoryColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTagRelations queries the tag_relations edge of a Post.
-func (c *PostClient) QueryTagRelations(po *Post) *PostTagRelationQuery {
-	query := (&PostTagRelationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(posttagrelation.Table, posttagrelation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, post.TagRelationsTable, post.TagRelationsColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAuthor queries the author edge of a Post.
-func (c *PostClient) QueryAuthor(po *Post) *UserQuery {
+// QueryUser queries the user edge of a DeviceSavedFilter.
+func (c *DeviceSavedFilterClient) QueryUser(dsf *DeviceSavedFilter) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
+		id := dsf.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.From(devicesavedfilter.Table, devicesavedfilter.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, post.AuthorTable, post.AuthorColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, devicesavedfilter.UserTable, devicesavedfilter.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(dsf.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PostClient) Hooks() []Hook {
-	return c.hooks.Post
+func (c *DeviceSavedFilterClient) Hooks() []Hook {
+	return c.hooks.DeviceSavedFilter
 }
 
 // Interceptors returns the client interceptors.
-func (c *PostClient) Interceptors() []Interceptor {
-	return c.inters.Post
+func (c *DeviceSavedFilterClient) Interceptors() []Interceptor {
+	return c.inters.DeviceSavedFilter
 }
 
-func (c *PostClient) mutate(ctx context.Context, m *PostMutation) (Value, error) {
+func (c *DeviceSavedFilterClient) mutate(ctx context.Context, m *DeviceSavedFilterMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceSavedFilterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceSavedFilterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceSavedFilterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DeviceSavedFilterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Post mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DeviceSavedFilter mutation op: %q", m.Op())
 	}
 }
 
-// PostCategoryClient is a client for the PostCategory schema.
-type PostCategoryClient struct {
+// DeviceTagClient is a client for the DeviceTag schema.
+type DeviceTagClient struct {
 	config
 }
 
-// NewPostCategoryClient returns a client for the PostCategory from the given config.
-func NewPostCategoryClient(c config) *PostCategoryClient {
-	return &PostCategoryClient{config: c}
+// NewDeviceTagClient returns a client for the DeviceTag from the given config.
+func NewDeviceTagClient(c config) *DeviceTagClient {
+	return &DeviceTagClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `postcategory.Hooks(f(g(h())))`.
-func (c *PostCategoryClient) Use(hooks ...Hook) {
-	c.hooks.PostCategory = append(c.hooks.PostCategory, hooks...)
+// A call to `Use(f, g, h)` equals to `devicetag.Hooks(f(g(h())))`.
+func (c *DeviceTagClient) Use(hooks ...Hook) {
+	c.hooks.DeviceTag = append(c.hooks.DeviceTag, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `postcategory.Intercept(f(g(h())))`.
-func (c *PostCategoryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PostCategory = append(c.inters.PostCategory, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `devicetag.Intercept(f(g(h())))`.
+func (c *DeviceTagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceTag = append(c.inters.DeviceTag, interceptors...)
 }
 
-// Create returns a builder for creating a PostCategory entity.
-func (c *PostCategoryClient) Create() *PostCategoryCreate {
-	mutation := newPostCategoryMutation(c.config, OpCreate)
-	return &PostCategoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DeviceTag entity.
+func (c *DeviceTagClient) Create() *DeviceTagCreate {
+	mutation := newDeviceTagMutation(c.config, OpCreate)
+	return &DeviceTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PostCategory entities.
-func (c *PostCategoryClient) CreateBulk(builders ...*PostCategoryCreate) *PostCategoryCreateBulk {
-	return &PostCategoryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DeviceTag entities.
+func (c *DeviceTagClient) CreateBulk(builders ...*DeviceTagCreate) *DeviceTagCreateBulk {
+	return &DeviceTagCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PostCategoryClient) MapCreateBulk(slice any, setFunc func(*PostCategoryCreate, int)) *PostCategoryCreateBulk {
+func (c *DeviceTagClient) MapCreateBulk(slice any, setFunc func(*DeviceTagCreate, int)) *DeviceTagCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PostCategoryCreateBulk{err: fmt.Errorf("calling to PostCategoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeviceTagCreateBulk{err: fmt.Errorf("calling to DeviceTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PostCategoryCreate, rv.Len())
+	builders := make([]*DeviceTagCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PostCategoryCreateBulk{config: c.config, builders: builders}
+	return &DeviceTagCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PostCategory.
-func (c *PostCategoryClient) Update() *PostCategoryUpdate {
-	mutation := newPostCategoryMutation(c.config, OpUpdate)
-	return &PostCategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DeviceTag.
+func (c *DeviceTagClient) Update() *DeviceTagUpdate {
+	mutation := newDeviceTagMutation(c.config, OpUpdate)
+	return &DeviceTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PostCategoryClient) UpdateOne(pc *PostCategory) *PostCategoryUpdateOne {
-	mutation := newPostCategoryMutation(c.config, OpUpdateOne, withPostCategory(pc))
-	return &PostCategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceTagClient) UpdateOne(dt *DeviceTag) *DeviceTagUpdateOne {
+	mutation := newDeviceTagMutation(c.config, OpUpdateOne, withDeviceTag(dt))
+	return &DeviceTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PostCategoryClient) UpdateOneID(id int) *PostCategoryUpdateOne {
-	mutation := newPostCategoryMutation(c.config, OpUpdateOne, withPostCategoryID(id))
-	return &PostCategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceTagClient) UpdateOneID(id int) *DeviceTagUpdateOne {
+	mutation := newDeviceTagMutation(c.config, OpUpdateOne, withDeviceTagID(id))
+	return &DeviceTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PostCategory.
-func (c *PostCategoryClient) Delete() *PostCategoryDelete {
-	mutation := newPostCategoryMutation(c.config, OpDelete)
-	return &PostCategoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DeviceTag.
+func (c *DeviceTagClient) Delete() *DeviceTagDelete {
+	mutation := newDeviceTagMutation(c.config, OpDelete)
+	return &DeviceTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PostCategoryClient) DeleteOne(pc *PostCategory) *PostCategoryDeleteOne {
-	return c.DeleteOneID(pc.ID)
+func (c *DeviceTagClient) DeleteOne(dt *DeviceTag) *DeviceTagDeleteOne {
+	return c.DeleteOneID(dt.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PostCategoryClient) DeleteOneID(id int) *PostCategoryDeleteOne {
-	builder := c.Delete().Where(postcategory.ID(id))
+func (c *DeviceTagClient) DeleteOneID(id int) *DeviceTagDeleteOne {
+	builder := c.Delete().Where(devicetag.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PostCategoryDeleteOne{builder}
+	return &DeviceTagDeleteOne{builder}
 }
 
-// Query returns a query builder for PostCategory.
-func (c *PostCategoryClient) Query() *PostCategoryQuery {
-	return &PostCategoryQuery{
+// Query returns a query builder for DeviceTag.
+func (c *DeviceTagClient) Query() *DeviceTagQuery {
+	return &DeviceTagQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePostCategory},
+		ctx:    &QueryContext{Type: TypeDeviceTag},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PostCategory entity by its id.
-func (c *PostCategoryClient) Get(ctx context.Context, id int) (*PostCategory, error) {
-	return c.Query().Where(postcategory.ID(id)).Only(ctx)
+// Get returns a DeviceTag entity by its id.
+func (c *DeviceTagClient) Get(ctx context.Context, id int) (*DeviceTag, error) {
+	return c.Query().Where(devicetag.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PostCategoryClient) GetX(ctx context.Context, id int) *PostCategory {
+func (c *DeviceTagClient) GetX(ctx context.Context, id int) *DeviceTag {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1643,148 +2153,164 @@ func (c *PostCategoryClient) GetX(ctx context.Context, id int) *PostCategory {
 	return obj
 }
 
-// QueryPosts queries the posts edge of a PostCategory.
-func (c *PostCategoryClient) QueryPosts(pc *PostCategory) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
+// QueryProduct queries the product edge of a DeviceTag.
+func (c *DeviceTagClient) QueryProduct(dt *DeviceTag) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pc.ID
+		id := dt.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(postcategory.Table, postcategory.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, postcategory.PostsTable, postcategory.PostsColumn),
+			sqlgraph.From(devicetag.Table, devicetag.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, devicetag.ProductTable, devicetag.ProductColumn),
 		)
-		fromV = sqlgraph.Neighbors(pc.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(dt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDevices queries the devices edge of a DeviceTag.
+func (c *DeviceTagClient) QueryDevices(dt *DeviceTag) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := dt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(devicetag.Table, devicetag.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, devicetag.DevicesTable, devicetag.DevicesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(dt.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PostCategoryClient) Hooks() []Hook {
-	return c.hooks.PostCategory
+func (c *DeviceTagClient) Hooks() []Hook {
+	return c.hooks.DeviceTag
 }
 
 // Interceptors returns the client interceptors.
-func (c *PostCategoryClient) Interceptors() []Interceptor {
-	return c.inters.PostCategory
+func (c *DeviceTagClient) Interceptors() []Interceptor {
+	return c.inters.DeviceTag
 }
 
-func (c *PostCategoryClient) mutate(ctx context.Context, m *PostCategoryMutation) (Value, error) {
+func (c *DeviceTagClient) mutate(ctx context.Context, m *DeviceTagMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PostCategoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PostCategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PostCategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeviceTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PostCategoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DeviceTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PostCategory mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DeviceTag mutation op: %q", m.Op())
 	}
 }
 
-// PostTagClient is a client for the PostTag schema.
-type PostTagClient struct {
+// FirmwareVersionClient is a client for the FirmwareVersion schema.
+type FirmwareVersionClient struct {
 	config
 }
 
-// NewPostTagClient returns a client for the PostTag from the given config.
-func NewPostTagClient(c config) *PostTagClient {
-	return &PostTagClient{config: c}
+// NewFirmwareVersionClient returns a client for the FirmwareVersion from the given config.
+func NewFirmwareVersionClient(c config) *FirmwareVersionClient {
+	return &FirmwareVersionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `posttag.Hooks(f(g(h())))`.
-func (c *PostTagClient) Use(hooks ...Hook) {
-	c.hooks.PostTag = append(c.hooks.PostTag, hooks...)
+// A call to `Use(f, g, h)` equals to `firmwareversion.Hooks(f(g(h())))`.
+func (c *FirmwareVersionClient) Use(hooks ...Hook) {
+	c.hooks.FirmwareVersion = append(c.hooks.FirmwareVersion, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `posttag.Intercept(f(g(h())))`.
-func (c *PostTagClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PostTag = append(c.inters.PostTag, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `firmwareversion.Intercept(f(g(h())))`.
+func (c *FirmwareVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FirmwareVersion = append(c.inters.FirmwareVersion, interceptors...)
 }
 
-// Create returns a builder for creating a PostTag entity.
-func (c *PostTagClient) Create() *PostTagCreate {
-	mutation := newPostTagMutation(c.config, OpCreate)
-	return &PostTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a FirmwareVersion entity.
+func (c *FirmwareVersionClient) Create() *FirmwareVersionCreate {
+	mutation := newFirmwareVersionMutation(c.config, OpCreate)
+	return &FirmwareVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PostTag entities.
-func (c *PostTagClient) CreateBulk(builders ...*PostTagCreate) *PostTagCreateBulk {
-	return &PostTagCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of FirmwareVersion entities.
+func (c *FirmwareVersionClient) CreateBulk(builders ...*FirmwareVersionCreate) *FirmwareVersionCreateBulk {
+	return &FirmwareVersionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PostTagClient) MapCreateBulk(slice any, setFunc func(*PostTagCreate, int)) *PostTagCreateBulk {
+func (c *FirmwareVersionClient) MapCreateBulk(slice any, setFunc func(*FirmwareVersionCreate, int)) *FirmwareVersionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PostTagCreateBulk{err: fmt.Errorf("calling to PostTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FirmwareVersionCreateBulk{err: fmt.Errorf("calling to FirmwareVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PostTagCreate, rv.Len())
+	builders := make([]*FirmwareVersionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PostTagCreateBulk{config: c.config, builders: builders}
+	return &FirmwareVersionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PostTag.
-func (c *PostTagClient) Update() *PostTagUpdate {
-	mutation := newPostTagMutation(c.config, OpUpdate)
-	return &PostTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for FirmwareVersion.
+func (c *FirmwareVersionClient) Update() *FirmwareVersionUpdate {
+	mutation := newFirmwareVersionMutation(c.config, OpUpdate)
+	return &FirmwareVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PostTagClient) UpdateOne(pt *PostTag) *PostTagUpdateOne {
-	mutation := newPostTagMutation(c.config, OpUpdateOne, withPostTag(pt))
-	return &PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FirmwareVersionClient) UpdateOne(fv *FirmwareVersion) *FirmwareVersionUpdateOne {
+	mutation := newFirmwareVersionMutation(c.config, OpUpdateOne, withFirmwareVersion(fv))
+	return &FirmwareVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PostTagClient) UpdateOneID(id int) *PostTagUpdateOne {
-	mutation := newPostTagMutation(c.config, OpUpdateOne, withPostTagID(id))
-	return &PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FirmwareVersionClient) UpdateOneID(id int) *FirmwareVersionUpdateOne {
+	mutation := newFirmwareVersionMutation(c.config, OpUpdateOne, withFirmwareVersionID(id))
+	return &FirmwareVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PostTag.
-func (c *PostTagClient) Delete() *PostTagDelete {
-	mutation := newPostTagMutation(c.config, OpDelete)
-	return &PostTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for FirmwareVersion.
+func (c *FirmwareVersionClient) Delete() *FirmwareVersionDelete {
+	mutation := newFirmwareVersionMutation(c.config, OpDelete)
+	return &FirmwareVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PostTagClient) DeleteOne(pt *PostTag) *PostTagDeleteOne {
-	return c.DeleteOneID(pt.ID)
+func (c *FirmwareVersionClient) DeleteOne(fv *FirmwareVersion) *FirmwareVersionDeleteOne {
+	return c.DeleteOneID(fv.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PostTagClient) DeleteOneID(id int) *PostTagDeleteOne {
-	builder := c.Delete().Where(posttag.ID(id))
+func (c *FirmwareVersionClient) DeleteOneID(id int) *FirmwareVersionDeleteOne {
+	builder := c.Delete().Where(firmwareversion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PostTagDeleteOne{builder}
+	return &FirmwareVersionDeleteOne{builder}
 }
 
-// Query returns a query builder for PostTag.
-func (c *PostTagClient) Query() *PostTagQuery {
-	return &PostTagQuery{
+// Query returns a query builder for FirmwareVersion.
+func (c *FirmwareVersionClient) Query() *FirmwareVersionQuery {
+	return &FirmwareVersionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePostTag},
+		ctx:    &QueryContext{Type: TypeFirmwareVersion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PostTag entity by its id.
-func (c *PostTagClient) Get(ctx context.Context, id int) (*PostTag, error) {
-	return c.Query().Where(posttag.ID(id)).Only(ctx)
+// Get returns a FirmwareVersion entity by its id.
+func (c *FirmwareVersionClient) Get(ctx context.Context, id int) (*FirmwareVersion, error) {
+	return c.Query().Where(firmwareversion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PostTagClient) GetX(ctx context.Context, id int) *PostTag {
+func (c *FirmwareVersionClient) GetX(ctx context.Context, id int) *FirmwareVersion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1792,148 +2318,2749 @@ func (c *PostTagClient) GetX(ctx context.Context, id int) *PostTag {
 	return obj
 }
 
-// QueryPostRelations queries the post_relations edge of a PostTag.
-func (c *PostTagClient) QueryPostRelations(pt *PostTag) *PostTagRelationQuery {
-	query := (&PostTagRelationClient{config: c.config}).Query()
+// QuerySoftwareVersions queries the software_versions edge of a FirmwareVersion.
+func (c *FirmwareVersionClient) QuerySoftwareVersions(fv *FirmwareVersion) *SoftwareVersionQuery {
+	query := (&SoftwareVersionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pt.ID
+		id := fv.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(posttag.Table, posttag.FieldID, id),
-			sqlgraph.To(posttagrelation.Table, posttagrelation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, posttag.PostRelationsTable, posttag.PostRelationsColumn),
+			sqlgraph.From(firmwareversion.Table, firmwareversion.FieldID, id),
+			sqlgraph.To(softwareversion.Table, softwareversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, firmwareversion.SoftwareVersionsTable, firmwareversion.SoftwareVersionsPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(pt.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(fv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProduct queries the product edge of a FirmwareVersion.
+func (c *FirmwareVersionClient) QueryProduct(fv *FirmwareVersion) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(firmwareversion.Table, firmwareversion.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, firmwareversion.ProductTable, firmwareversion.ProductColumn),
+		)
+		fromV = sqlgraph.Neighbors(fv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreator queries the creator edge of a FirmwareVersion.
+func (c *FirmwareVersionClient) QueryCreator(fv *FirmwareVersion) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(firmwareversion.Table, firmwareversion.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, firmwareversion.CreatorTable, firmwareversion.CreatorColumn),
+		)
+		fromV = sqlgraph.Neighbors(fv.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PostTagClient) Hooks() []Hook {
-	return c.hooks.PostTag
+func (c *FirmwareVersionClient) Hooks() []Hook {
+	hooks := c.hooks.FirmwareVersion
+	return append(hooks[:len(hooks):len(hooks)], firmwareversion.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *PostTagClient) Interceptors() []Interceptor {
-	return c.inters.PostTag
+func (c *FirmwareVersionClient) Interceptors() []Interceptor {
+	inters := c.inters.FirmwareVersion
+	return append(inters[:len(inters):len(inters)], firmwareversion.Interceptors[:]...)
 }
 
-func (c *PostTagClient) mutate(ctx context.Context, m *PostTagMutation) (Value, error) {
+func (c *FirmwareVersionClient) mutate(ctx context.Context, m *FirmwareVersionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PostTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FirmwareVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PostTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FirmwareVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FirmwareVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PostTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&FirmwareVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PostTag mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown FirmwareVersion mutation op: %q", m.Op())
 	}
 }
 
-// PostTagRelationClient is a client for the PostTagRelation schema.
-type PostTagRelationClient struct {
+// JobClient is a client for the Job schema.
+type JobClient struct {
 	config
 }
 
-// NewPostTagRelationClient returns a client for the PostTagRelation from the given config.
-func NewPostTagRelationClient(c config) *PostTagRelationClient {
-	return &PostTagRelationClient{config: c}
+// NewJobClient returns a client for the Job from the given config.
+func NewJobClient(c config) *JobClient {
+	return &JobClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `posttagrelation.Hooks(f(g(h())))`.
-func (c *PostTagRelationClient) Use(hooks ...Hook) {
-	c.hooks.PostTagRelation = append(c.hooks.PostTagRelation, hooks...)
+// A call to `Use(f, g, h)` equals to `job.Hooks(f(g(h())))`.
+func (c *JobClient) Use(hooks ...Hook) {
+	c.hooks.Job = append(c.hooks.Job, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `posttagrelation.Intercept(f(g(h())))`.
-func (c *PostTagRelationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PostTagRelation = append(c.inters.PostTagRelation, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `job.Intercept(f(g(h())))`.
+func (c *JobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Job = append(c.inters.Job, interceptors...)
 }
 
-// Create returns a builder for creating a PostTagRelation entity.
-func (c *PostTagRelationClient) Create() *PostTagRelationCreate {
-	mutation := newPostTagRelationMutation(c.config, OpCreate)
-	return &PostTagRelationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Job entity.
+func (c *JobClient) Create() *JobCreate {
+	mutation := newJobMutation(c.config, OpCreate)
+	return &JobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PostTagRelation entities.
-func (c *PostTagRelationClient) CreateBulk(builders ...*PostTagRelationCreate) *PostTagRelationCreateBulk {
-	return &PostTagRelationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Job entities.
+func (c *JobClient) CreateBulk(builders ...*JobCreate) *JobCreateBulk {
+	return &JobCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PostTagRelationClient) MapCreateBulk(slice any, setFunc func(*PostTagRelationCreate, int)) *PostTagRelationCreateBulk {
+func (c *JobClient) MapCreateBulk(slice any, setFunc func(*JobCreate, int)) *JobCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PostTagRelationCreateBulk{err: fmt.Errorf("calling to PostTagRelationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &JobCreateBulk{err: fmt.Errorf("calling to JobClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PostTagRelationCreate, rv.Len())
+	builders := make([]*JobCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PostTagRelationCreateBulk{config: c.config, builders: builders}
+	return &JobCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PostTagRelation.
-func (c *PostTagRelationClient) Update() *PostTagRelationUpdate {
-	mutation := newPostTagRelationMutation(c.config, OpUpdate)
-	return &PostTagRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Job.
+func (c *JobClient) Update() *JobUpdate {
+	mutation := newJobMutation(c.config, OpUpdate)
+	return &JobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PostTagRelationClient) UpdateOne(ptr *PostTagRelation) *PostTagRelationUpdateOne {
-	mutation := newPostTagRelationMutation(c.config, OpUpdateOne, withPostTagRelation(ptr))
-	return &PostTagRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *JobClient) UpdateOne(j *Job) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJob(j))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PostTagRelationClient) UpdateOneID(id int) *PostTagRelationUpdateOne {
+func (c *JobClient) UpdateOneID(id int) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJobID(id))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Job.
+func (c *JobClient) Delete() *JobDelete {
+	mutation := newJobMutation(c.config, OpDelete)
+	return &JobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobClient) DeleteOne(j *Job) *JobDeleteOne {
+	return c.DeleteOneID(j.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobClient) DeleteOneID(id int) *JobDeleteOne {
+	builder := c.Delete().Where(job.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobDeleteOne{builder}
+}
+
+// Query returns a query builder for Job.
+func (c *JobClient) Query() *JobQuery {
+	return &JobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Job entity by its id.
+func (c *JobClient) Get(ctx context.Context, id int) (*Job, error) {
+	return c.Query().Where(job.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobClient) GetX(ctx context.Context, id int) *Job {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCreator queries the creator edge of a Job.
+func (c *JobClient) QueryCreator(j *Job) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := j.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(job.Table, job.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, job.CreatorTable, job.CreatorColumn),
+		)
+		fromV = sqlgraph.Neighbors(j.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *JobClient) Hooks() []Hook {
+	return c.hooks.Job
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobClient) Interceptors() []Interceptor {
+	return c.inters.Job
+}
+
+func (c *JobClient) mutate(ctx context.Context, m *JobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Job mutation op: %q", m.Op())
+	}
+}
+
+// LicenseTypeClient is a client for the LicenseType schema.
+type LicenseTypeClient struct {
+	config
+}
+
+// NewLicenseTypeClient returns a client for the LicenseType from the given config.
+func NewLicenseTypeClient(c config) *LicenseTypeClient {
+	return &LicenseTypeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `licensetype.Hooks(f(g(h())))`.
+func (c *LicenseTypeClient) Use(hooks ...Hook) {
+	c.hooks.LicenseType = append(c.hooks.LicenseType, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `licensetype.Intercept(f(g(h())))`.
+func (c *LicenseTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LicenseType = append(c.inters.LicenseType, interceptors...)
+}
+
+// Create returns a builder for creating a LicenseType entity.
+func (c *LicenseTypeClient) Create() *LicenseTypeCreate {
+	mutation := newLicenseTypeMutation(c.config, OpCreate)
+	return &LicenseTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LicenseType entities.
+func (c *LicenseTypeClient) CreateBulk(builders ...*LicenseTypeCreate) *LicenseTypeCreateBulk {
+	return &LicenseTypeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LicenseTypeClient) MapCreateBulk(slice any, setFunc func(*LicenseTypeCreate, int)) *LicenseTypeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LicenseTypeCreateBulk{err: fmt.Errorf("calling to LicenseTypeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LicenseTypeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LicenseTypeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LicenseType.
+func (c *LicenseTypeClient) Update() *LicenseTypeUpdate {
+	mutation := newLicenseTypeMutation(c.config, OpUpdate)
+	return &LicenseTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LicenseTypeClient) UpdateOne(lt *LicenseType) *LicenseTypeUpdateOne {
+	mutation := newLicenseTypeMutation(c.config, OpUpdateOne, withLicenseType(lt))
+	return &LicenseTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LicenseTypeClient) UpdateOneID(id int) *LicenseTypeUpdateOne {
+	mutation := newLicenseTypeMutation(c.config, OpUpdateOne, withLicenseTypeID(id))
+	return &LicenseTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LicenseType.
+func (c *LicenseTypeClient) Delete() *LicenseTypeDelete {
+	mutation := newLicenseTypeMutation(c.config, OpDelete)
+	return &LicenseTypeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LicenseTypeClient) DeleteOne(lt *LicenseType) *LicenseTypeDeleteOne {
+	return c.DeleteOneID(lt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LicenseTypeClient) DeleteOneID(id int) *LicenseTypeDeleteOne {
+	builder := c.Delete().Where(licensetype.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LicenseTypeDeleteOne{builder}
+}
+
+// Query returns a query builder for LicenseType.
+func (c *LicenseTypeClient) Query() *LicenseTypeQuery {
+	return &LicenseTypeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLicenseType},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LicenseType entity by its id.
+func (c *LicenseTypeClient) Get(ctx context.Context, id int) (*LicenseType, error) {
+	return c.Query().Where(licensetype.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LicenseTypeClient) GetX(ctx context.Context, id int) *LicenseType {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProduct queries the product edge of a LicenseType.
+func (c *LicenseTypeClient) QueryProduct(lt *LicenseType) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(licensetype.Table, licensetype.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, licensetype.ProductTable, licensetype.ProductColumn),
+		)
+		fromV = sqlgraph.Neighbors(lt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFeatures queries the features edge of a LicenseType.
+func (c *LicenseTypeClient) QueryFeatures(lt *LicenseType) *ProductFeatureQuery {
+	query := (&ProductFeatureClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(licensetype.Table, licensetype.FieldID, id),
+			sqlgraph.To(productfeature.Table, productfeature.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, licensetype.FeaturesTable, licensetype.FeaturesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(lt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDevices queries the devices edge of a LicenseType.
+func (c *LicenseTypeClient) QueryDevices(lt *LicenseType) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(licensetype.Table, licensetype.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, licensetype.DevicesTable, licensetype.DevicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(lt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrders queries the orders edge of a LicenseType.
+func (c *LicenseTypeClient) QueryOrders(lt *LicenseType) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(licensetype.Table, licensetype.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, licensetype.OrdersTable, licensetype.OrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(lt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLots queries the lots edge of a LicenseType.
+func (c *LicenseTypeClient) QueryLots(lt *LicenseType) *LotQuery {
+	query := (&LotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(licensetype.Table, licensetype.FieldID, id),
+			sqlgraph.To(lot.Table, lot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, licensetype.LotsTable, licensetype.LotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(lt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a LicenseType.
+func (c *LicenseTypeClient) QueryParent(lt *LicenseType) *LicenseTypeQuery {
+	query := (&LicenseTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(licensetype.Table, licensetype.FieldID, id),
+			sqlgraph.To(licensetype.Table, licensetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, licensetype.ParentTable, licensetype.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(lt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a LicenseType.
+func (c *LicenseTypeClient) QueryChildren(lt *LicenseType) *LicenseTypeQuery {
+	query := (&LicenseTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(licensetype.Table, licensetype.FieldID, id),
+			sqlgraph.To(licensetype.Table, licensetype.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, licensetype.ChildrenTable, licensetype.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(lt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLicenseTypeFeatures queries the license_type_features edge of a LicenseType.
+func (c *LicenseTypeClient) QueryLicenseTypeFeatures(lt *LicenseType) *LicenseTypeFeaturesQuery {
+	query := (&LicenseTypeFeaturesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(licensetype.Table, licensetype.FieldID, id),
+			sqlgraph.To(licensetypefeatures.Table, licensetypefeatures.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, licensetype.LicenseTypeFeaturesTable, licensetype.LicenseTypeFeaturesColumn),
+		)
+		fromV = sqlgraph.Neighbors(lt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LicenseTypeClient) Hooks() []Hook {
+	hooks := c.hooks.LicenseType
+	return append(hooks[:len(hooks):len(hooks)], licensetype.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LicenseTypeClient) Interceptors() []Interceptor {
+	inters := c.inters.LicenseType
+	return append(inters[:len(inters):len(inters)], licensetype.Interceptors[:]...)
+}
+
+func (c *LicenseTypeClient) mutate(ctx context.Context, m *LicenseTypeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LicenseTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LicenseTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LicenseTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LicenseTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LicenseType mutation op: %q", m.Op())
+	}
+}
+
+// LicenseTypeFeaturesClient is a client for the LicenseTypeFeatures schema.
+type LicenseTypeFeaturesClient struct {
+	config
+}
+
+// NewLicenseTypeFeaturesClient returns a client for the LicenseTypeFeatures from the given config.
+func NewLicenseTypeFeaturesClient(c config) *LicenseTypeFeaturesClient {
+	return &LicenseTypeFeaturesClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `licensetypefeatures.Hooks(f(g(h())))`.
+func (c *LicenseTypeFeaturesClient) Use(hooks ...Hook) {
+	c.hooks.LicenseTypeFeatures = append(c.hooks.LicenseTypeFeatures, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `licensetypefeatures.Intercept(f(g(h())))`.
+func (c *LicenseTypeFeaturesClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LicenseTypeFeatures = append(c.inters.LicenseTypeFeatures, interceptors...)
+}
+
+// Create returns a builder for creating a LicenseTypeFeatures entity.
+func (c *LicenseTypeFeaturesClient) Create() *LicenseTypeFeaturesCreate {
+	mutation := newLicenseTypeFeaturesMutation(c.config, OpCreate)
+	return &LicenseTypeFeaturesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LicenseTypeFeatures entities.
+func (c *LicenseTypeFeaturesClient) CreateBulk(builders ...*LicenseTypeFeaturesCreate) *LicenseTypeFeaturesCreateBulk {
+	return &LicenseTypeFeaturesCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LicenseTypeFeaturesClient) MapCreateBulk(slice any, setFunc func(*LicenseTypeFeaturesCreate, int)) *LicenseTypeFeaturesCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LicenseTypeFeaturesCreateBulk{err: fmt.Errorf("calling to LicenseTypeFeaturesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LicenseTypeFeaturesCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LicenseTypeFeaturesCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LicenseTypeFeatures.
+func (c *LicenseTypeFeaturesClient) Update() *LicenseTypeFeaturesUpdate {
+	mutation := newLicenseTypeFeaturesMutation(c.config, OpUpdate)
+	return &LicenseTypeFeaturesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LicenseTypeFeaturesClient) UpdateOne(ltf *LicenseTypeFeatures) *LicenseTypeFeaturesUpdateOne {
+	mutation := newLicenseTypeFeaturesMutation(c.config, OpUpdateOne, withLicenseTypeFeatures(ltf))
+	return &LicenseTypeFeaturesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LicenseTypeFeaturesClient) UpdateOneID(id int) *LicenseTypeFeaturesUpdateOne {
+	mutation := newLicenseTypeFeaturesMutation(c.config, OpUpdateOne, withLicenseTypeFeaturesID(id))
+	return &LicenseTypeFeaturesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LicenseTypeFeatures.
+func (c *LicenseTypeFeaturesClient) Delete() *LicenseTypeFeaturesDelete {
+	mutation := newLicenseTypeFeaturesMutation(c.config, OpDelete)
+	return &LicenseTypeFeaturesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LicenseTypeFeaturesClient) DeleteOne(ltf *LicenseTypeFeatures) *LicenseTypeFeaturesDeleteOne {
+	return c.DeleteOneID(ltf.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LicenseTypeFeaturesClient) DeleteOneID(id int) *LicenseTypeFeaturesDeleteOne {
+	builder := c.Delete().Where(licensetypefeatures.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LicenseTypeFeaturesDeleteOne{builder}
+}
+
+// Query returns a query builder for LicenseTypeFeatures.
+func (c *LicenseTypeFeaturesClient) Query() *LicenseTypeFeaturesQuery {
+	return &LicenseTypeFeaturesQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLicenseTypeFeatures},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LicenseTypeFeatures entity by its id.
+func (c *LicenseTypeFeaturesClient) Get(ctx context.Context, id int) (*LicenseTypeFeatures, error) {
+	return c.Query().Where(licensetypefeatures.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LicenseTypeFeaturesClient) GetX(ctx context.Context, id int) *LicenseTypeFeatures {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLicenseType queries the license_type edge of a LicenseTypeFeatures.
+func (c *LicenseTypeFeaturesClient) QueryLicenseType(ltf *LicenseTypeFeatures) *LicenseTypeQuery {
+	query := (&LicenseTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ltf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(licensetypefeatures.Table, licensetypefeatures.FieldID, id),
+			sqlgraph.To(licensetype.Table, licensetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, licensetypefeatures.LicenseTypeTable, licensetypefeatures.LicenseTypeColumn),
+		)
+		fromV = sqlgraph.Neighbors(ltf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFeature queries the feature edge of a LicenseTypeFeatures.
+func (c *LicenseTypeFeaturesClient) QueryFeature(ltf *LicenseTypeFeatures) *ProductFeatureQuery {
+	query := (&ProductFeatureClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ltf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(licensetypefeatures.Table, licensetypefeatures.FieldID, id),
+			sqlgraph.To(productfeature.Table, productfeature.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, licensetypefeatures.FeatureTable, licensetypefeatures.FeatureColumn),
+		)
+		fromV = sqlgraph.Neighbors(ltf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LicenseTypeFeaturesClient) Hooks() []Hook {
+	return c.hooks.LicenseTypeFeatures
+}
+
+// Interceptors returns the client interceptors.
+func (c *LicenseTypeFeaturesClient) Interceptors() []Interceptor {
+	return c.inters.LicenseTypeFeatures
+}
+
+func (c *LicenseTypeFeaturesClient) mutate(ctx context.Context, m *LicenseTypeFeaturesMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LicenseTypeFeaturesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LicenseTypeFeaturesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LicenseTypeFeaturesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LicenseTypeFeaturesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LicenseTypeFeatures mutation op: %q", m.Op())
+	}
+}
+
+// LotClient is a client for the Lot schema.
+type LotClient struct {
+	config
+}
+
+// NewLotClient returns a client for the Lot from the given config.
+func NewLotClient(c config) *LotClient {
+	return &LotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `lot.Hooks(f(g(h())))`.
+func (c *LotClient) Use(hooks ...Hook) {
+	c.hooks.Lot = append(c.hooks.Lot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `lot.Intercept(f(g(h())))`.
+func (c *LotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Lot = append(c.inters.Lot, interceptors...)
+}
+
+// Create returns a builder for creating a Lot entity.
+func (c *LotClient) Create() *LotCreate {
+	mutation := newLotMutation(c.config, OpCreate)
+	return &LotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Lot entities.
+func (c *LotClient) CreateBulk(builders ...*LotCreate) *LotCreateBulk {
+	return &LotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LotClient) MapCreateBulk(slice any, setFunc func(*LotCreate, int)) *LotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LotCreateBulk{err: fmt.Errorf("calling to LotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Lot.
+func (c *LotClient) Update() *LotUpdate {
+	mutation := newLotMutation(c.config, OpUpdate)
+	return &LotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LotClient) UpdateOne(l *Lot) *LotUpdateOne {
+	mutation := newLotMutation(c.config, OpUpdateOne, withLot(l))
+	return &LotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LotClient) UpdateOneID(id int) *LotUpdateOne {
+	mutation := newLotMutation(c.config, OpUpdateOne, withLotID(id))
+	return &LotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Lot.
+func (c *LotClient) Delete() *LotDelete {
+	mutation := newLotMutation(c.config, OpDelete)
+	return &LotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LotClient) DeleteOne(l *Lot) *LotDeleteOne {
+	return c.DeleteOneID(l.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LotClient) DeleteOneID(id int) *LotDeleteOne {
+	builder := c.Delete().Where(lot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LotDeleteOne{builder}
+}
+
+// Query returns a query builder for Lot.
+func (c *LotClient) Query() *LotQuery {
+	return &LotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Lot entity by its id.
+func (c *LotClient) Get(ctx context.Context, id int) (*Lot, error) {
+	return c.Query().Where(lot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LotClient) GetX(ctx context.Context, id int) *Lot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProduct queries the product edge of a Lot.
+func (c *LotClient) QueryProduct(l *Lot) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lot.Table, lot.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lot.ProductTable, lot.ProductColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrder queries the order edge of a Lot.
+func (c *LotClient) QueryOrder(l *Lot) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lot.Table, lot.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lot.OrderTable, lot.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLicenseType queries the license_type edge of a Lot.
+func (c *LotClient) QueryLicenseType(l *Lot) *LicenseTypeQuery {
+	query := (&LicenseTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lot.Table, lot.FieldID, id),
+			sqlgraph.To(licensetype.Table, licensetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lot.LicenseTypeTable, lot.LicenseTypeColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDevices queries the devices edge of a Lot.
+func (c *LotClient) QueryDevices(l *Lot) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lot.Table, lot.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lot.DevicesTable, lot.DevicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LotClient) Hooks() []Hook {
+	return c.hooks.Lot
+}
+
+// Interceptors returns the client interceptors.
+func (c *LotClient) Interceptors() []Interceptor {
+	return c.inters.Lot
+}
+
+func (c *LotClient) mutate(ctx context.Context, m *LotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Lot mutation op: %q", m.Op())
+	}
+}
+
+// MetricEventClient is a client for the MetricEvent schema.
+type MetricEventClient struct {
+	config
+}
+
+// NewMetricEventClient returns a client for the MetricEvent from the given config.
+func NewMetricEventClient(c config) *MetricEventClient {
+	return &MetricEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `metricevent.Hooks(f(g(h())))`.
+func (c *MetricEventClient) Use(hooks ...Hook) {
+	c.hooks.MetricEvent = append(c.hooks.MetricEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `metricevent.Intercept(f(g(h())))`.
+func (c *MetricEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MetricEvent = append(c.inters.MetricEvent, interceptors...)
+}
+
+// Create returns a builder for creating a MetricEvent entity.
+func (c *MetricEventClient) Create() *MetricEventCreate {
+	mutation := newMetricEventMutation(c.config, OpCreate)
+	return &MetricEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MetricEvent entities.
+func (c *MetricEventClient) CreateBulk(builders ...*MetricEventCreate) *MetricEventCreateBulk {
+	return &MetricEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MetricEventClient) MapCreateBulk(slice any, setFunc func(*MetricEventCreate, int)) *MetricEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MetricEventCreateBulk{err: fmt.Errorf("calling to MetricEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MetricEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MetricEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MetricEvent.
+func (c *MetricEventClient) Update() *MetricEventUpdate {
+	mutation := newMetricEventMutation(c.config, OpUpdate)
+	return &MetricEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MetricEventClient) UpdateOne(me *MetricEvent) *MetricEventUpdateOne {
+	mutation := newMetricEventMutation(c.config, OpUpdateOne, withMetricEvent(me))
+	return &MetricEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MetricEventClient) UpdateOneID(id int) *MetricEventUpdateOne {
+	mutation := newMetricEventMutation(c.config, OpUpdateOne, withMetricEventID(id))
+	return &MetricEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MetricEvent.
+func (c *MetricEventClient) Delete() *MetricEventDelete {
+	mutation := newMetricEventMutation(c.config, OpDelete)
+	return &MetricEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MetricEventClient) DeleteOne(me *MetricEvent) *MetricEventDeleteOne {
+	return c.DeleteOneID(me.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MetricEventClient) DeleteOneID(id int) *MetricEventDeleteOne {
+	builder := c.Delete().Where(metricevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MetricEventDeleteOne{builder}
+}
+
+// Query returns a query builder for MetricEvent.
+func (c *MetricEventClient) Query() *MetricEventQuery {
+	return &MetricEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMetricEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MetricEvent entity by its id.
+func (c *MetricEventClient) Get(ctx context.Context, id int) (*MetricEvent, error) {
+	return c.Query().Where(metricevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MetricEventClient) GetX(ctx context.Context, id int) *MetricEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MetricEventClient) Hooks() []Hook {
+	return c.hooks.MetricEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *MetricEventClient) Interceptors() []Interceptor {
+	return c.inters.MetricEvent
+}
+
+func (c *MetricEventClient) mutate(ctx context.Context, m *MetricEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MetricEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MetricEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MetricEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MetricEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MetricEvent mutation op: %q", m.Op())
+	}
+}
+
+// OrderClient is a client for the Order schema.
+type OrderClient struct {
+	config
+}
+
+// NewOrderClient returns a client for the Order from the given config.
+func NewOrderClient(c config) *OrderClient {
+	return &OrderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `order.Hooks(f(g(h())))`.
+func (c *OrderClient) Use(hooks ...Hook) {
+	c.hooks.Order = append(c.hooks.Order, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `order.Intercept(f(g(h())))`.
+func (c *OrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Order = append(c.inters.Order, interceptors...)
+}
+
+// Create returns a builder for creating a Order entity.
+func (c *OrderClient) Create() *OrderCreate {
+	mutation := newOrderMutation(c.config, OpCreate)
+	return &OrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Order entities.
+func (c *OrderClient) CreateBulk(builders ...*OrderCreate) *OrderCreateBulk {
+	return &OrderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderClient) MapCreateBulk(slice any, setFunc func(*OrderCreate, int)) *OrderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderCreateBulk{err: fmt.Errorf("calling to OrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Order.
+func (c *OrderClient) Update() *OrderUpdate {
+	mutation := newOrderMutation(c.config, OpUpdate)
+	return &OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderClient) UpdateOne(o *Order) *OrderUpdateOne {
+	mutation := newOrderMutation(c.config, OpUpdateOne, withOrder(o))
+	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderClient) UpdateOneID(id int) *OrderUpdateOne {
+	mutation := newOrderMutation(c.config, OpUpdateOne, withOrderID(id))
+	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Order.
+func (c *OrderClient) Delete() *OrderDelete {
+	mutation := newOrderMutation(c.config, OpDelete)
+	return &OrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderClient) DeleteOne(o *Order) *OrderDeleteOne {
+	return c.DeleteOneID(o.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderClient) DeleteOneID(id int) *OrderDeleteOne {
+	builder := c.Delete().Where(order.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderDeleteOne{builder}
+}
+
+// Query returns a query builder for Order.
+func (c *OrderClient) Query() *OrderQuery {
+	return &OrderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Order entity by its id.
+func (c *OrderClient) Get(ctx context.Context, id int) (*Order, error) {
+	return c.Query().Where(order.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderClient) GetX(ctx context.Context, id int) *Order {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProduct queries the product edge of a Order.
+func (c *OrderClient) QueryProduct(o *Order) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.ProductTable, order.ProductColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCustomer queries the customer edge of a Order.
+func (c *OrderClient) QueryCustomer(o *Order) *CustomerQuery {
+	query := (&CustomerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(customer.Table, customer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.CustomerTable, order.CustomerColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLicenseType queries the license_type edge of a Order.
+func (c *OrderClient) QueryLicenseType(o *Order) *LicenseTypeQuery {
+	query := (&LicenseTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(licensetype.Table, licensetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.LicenseTypeTable, order.LicenseTypeColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLots queries the lots edge of a Order.
+func (c *OrderClient) QueryLots(o *Order) *LotQuery {
+	query := (&LotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(lot.Table, lot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.LotsTable, order.LotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDevices queries the devices edge of a Order.
+func (c *OrderClient) QueryDevices(o *Order) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.DevicesTable, order.DevicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrderClient) Hooks() []Hook {
+	return c.hooks.Order
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderClient) Interceptors() []Interceptor {
+	return c.inters.Order
+}
+
+func (c *OrderClient) mutate(ctx context.Context, m *OrderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Order mutation op: %q", m.Op())
+	}
+}
+
+// PostClient is a client for the Post schema.
+type PostClient struct {
+	config
+}
+
+// NewPostClient returns a client for the Post from the given config.
+func NewPostClient(c config) *PostClient {
+	return &PostClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `post.Hooks(f(g(h())))`.
+func (c *PostClient) Use(hooks ...Hook) {
+	c.hooks.Post = append(c.hooks.Post, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `post.Intercept(f(g(h())))`.
+func (c *PostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Post = append(c.inters.Post, interceptors...)
+}
+
+// Create returns a builder for creating a Post entity.
+func (c *PostClient) Create() *PostCreate {
+	mutation := newPostMutation(c.config, OpCreate)
+	return &PostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Post entities.
+func (c *PostClient) CreateBulk(builders ...*PostCreate) *PostCreateBulk {
+	return &PostCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostClient) MapCreateBulk(slice any, setFunc func(*PostCreate, int)) *PostCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostCreateBulk{err: fmt.Errorf("calling to PostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Post.
+func (c *PostClient) Update() *PostUpdate {
+	mutation := newPostMutation(c.config, OpUpdate)
+	return &PostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostClient) UpdateOne(po *Post) *PostUpdateOne {
+	mutation := newPostMutation(c.config, OpUpdateOne, withPost(po))
+	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostClient) UpdateOneID(id int) *PostUpdateOne {
+	mutation := newPostMutation(c.config, OpUpdateOne, withPostID(id))
+	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Post.
+func (c *PostClient) Delete() *PostDelete {
+	mutation := newPostMutation(c.config, OpDelete)
+	return &PostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostClient) DeleteOne(po *Post) *PostDeleteOne {
+	return c.DeleteOneID(po.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostClient) DeleteOneID(id int) *PostDeleteOne {
+	builder := c.Delete().Where(post.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostDeleteOne{builder}
+}
+
+// Query returns a query builder for Post.
+func (c *PostClient) Query() *PostQuery {
+	return &PostQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePost},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Post entity by its id.
+func (c *PostClient) Get(ctx context.Context, id int) (*Post, error) {
+	return c.Query().Where(post.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostClient) GetX(ctx context.Context, id int) *Post {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCategory queries the category edge of a Post.
+func (c *PostClient) QueryCategory(po *Post) *PostCategoryQuery {
+	query := (&PostCategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(postcategory.Table, postcategory.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, post.CategoryTable, post.CategoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTagRelations queries the tag_relations edge of a Post.
+func (c *PostClient) QueryTagRelations(po *Post) *PostTagRelationQuery {
+	query := (&PostTagRelationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(posttagrelation.Table, posttagrelation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.TagRelationsTable, post.TagRelationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAuthor queries the author edge of a Post.
+func (c *PostClient) QueryAuthor(po *Post) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, post.AuthorTable, post.AuthorColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PostClient) Hooks() []Hook {
+	return c.hooks.Post
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostClient) Interceptors() []Interceptor {
+	return c.inters.Post
+}
+
+func (c *PostClient) mutate(ctx context.Context, m *PostMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Post mutation op: %q", m.Op())
+	}
+}
+
+// PostCategoryClient is a client for the PostCategory schema.
+type PostCategoryClient struct {
+	config
+}
+
+// NewPostCategoryClient returns a client for the PostCategory from the given config.
+func NewPostCategoryClient(c config) *PostCategoryClient {
+	return &PostCategoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `postcategory.Hooks(f(g(h())))`.
+func (c *PostCategoryClient) Use(hooks ...Hook) {
+	c.hooks.PostCategory = append(c.hooks.PostCategory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `postcategory.Intercept(f(g(h())))`.
+func (c *PostCategoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PostCategory = append(c.inters.PostCategory, interceptors...)
+}
+
+// Create returns a builder for creating a PostCategory entity.
+func (c *PostCategoryClient) Create() *PostCategoryCreate {
+	mutation := newPostCategoryMutation(c.config, OpCreate)
+	return &PostCategoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PostCategory entities.
+func (c *PostCategoryClient) CreateBulk(builders ...*PostCategoryCreate) *PostCategoryCreateBulk {
+	return &PostCategoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostCategoryClient) MapCreateBulk(slice any, setFunc func(*PostCategoryCreate, int)) *PostCategoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostCategoryCreateBulk{err: fmt.Errorf("calling to PostCategoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostCategoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostCategoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PostCategory.
+func (c *PostCategoryClient) Update() *PostCategoryUpdate {
+	mutation := newPostCategoryMutation(c.config, OpUpdate)
+	return &PostCategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostCategoryClient) UpdateOne(pc *PostCategory) *PostCategoryUpdateOne {
+	mutation := newPostCategoryMutation(c.config, OpUpdateOne, withPostCategory(pc))
+	return &PostCategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostCategoryClient) UpdateOneID(id int) *PostCategoryUpdateOne {
+	mutation := newPostCategoryMutation(c.config, OpUpdateOne, withPostCategoryID(id))
+	return &PostCategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PostCategory.
+func (c *PostCategoryClient) Delete() *PostCategoryDelete {
+	mutation := newPostCategoryMutation(c.config, OpDelete)
+	return &PostCategoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostCategoryClient) DeleteOne(pc *PostCategory) *PostCategoryDeleteOne {
+	return c.DeleteOneID(pc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostCategoryClient) DeleteOneID(id int) *PostCategoryDeleteOne {
+	builder := c.Delete().Where(postcategory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostCategoryDeleteOne{builder}
+}
+
+// Query returns a query builder for PostCategory.
+func (c *PostCategoryClient) Query() *PostCategoryQuery {
+	return &PostCategoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePostCategory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PostCategory entity by its id.
+func (c *PostCategoryClient) Get(ctx context.Context, id int) (*PostCategory, error) {
+	return c.Query().Where(postcategory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostCategoryClient) GetX(ctx context.Context, id int) *PostCategory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPosts queries the posts edge of a PostCategory.
+func (c *PostCategoryClient) QueryPosts(pc *PostCategory) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(postcategory.Table, postcategory.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, postcategory.PostsTable, postcategory.PostsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PostCategoryClient) Hooks() []Hook {
+	return c.hooks.PostCategory
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostCategoryClient) Interceptors() []Interceptor {
+	return c.inters.PostCategory
+}
+
+func (c *PostCategoryClient) mutate(ctx context.Context, m *PostCategoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostCategoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostCategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostCategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostCategoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PostCategory mutation op: %q", m.Op())
+	}
+}
+
+// PostTagClient is a client for the PostTag schema.
+type PostTagClient struct {
+	config
+}
+
+// NewPostTagClient returns a client for the PostTag from the given config.
+func NewPostTagClient(c config) *PostTagClient {
+	return &PostTagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `posttag.Hooks(f(g(h())))`.
+func (c *PostTagClient) Use(hooks ...Hook) {
+	c.hooks.PostTag = append(c.hooks.PostTag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `posttag.Intercept(f(g(h())))`.
+func (c *PostTagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PostTag = append(c.inters.PostTag, interceptors...)
+}
+
+// Create returns a builder for creating a PostTag entity.
+func (c *PostTagClient) Create() *PostTagCreate {
+	mutation := newPostTagMutation(c.config, OpCreate)
+	return &PostTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PostTag entities.
+func (c *PostTagClient) CreateBulk(builders ...*PostTagCreate) *PostTagCreateBulk {
+	return &PostTagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostTagClient) MapCreateBulk(slice any, setFunc func(*PostTagCreate, int)) *PostTagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostTagCreateBulk{err: fmt.Errorf("calling to PostTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostTagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostTagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PostTag.
+func (c *PostTagClient) Update() *PostTagUpdate {
+	mutation := newPostTagMutation(c.config, OpUpdate)
+	return &PostTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostTagClient) UpdateOne(pt *PostTag) *PostTagUpdateOne {
+	mutation := newPostTagMutation(c.config, OpUpdateOne, withPostTag(pt))
+	return &PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostTagClient) UpdateOneID(id int) *PostTagUpdateOne {
+	mutation := newPostTagMutation(c.config, OpUpdateOne, withPostTagID(id))
+	return &PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PostTag.
+func (c *PostTagClient) Delete() *PostTagDelete {
+	mutation := newPostTagMutation(c.config, OpDelete)
+	return &PostTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostTagClient) DeleteOne(pt *PostTag) *PostTagDeleteOne {
+	return c.DeleteOneID(pt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostTagClient) DeleteOneID(id int) *PostTagDeleteOne {
+	builder := c.Delete().Where(posttag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostTagDeleteOne{builder}
+}
+
+// Query returns a query builder for PostTag.
+func (c *PostTagClient) Query() *PostTagQuery {
+	return &PostTagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePostTag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PostTag entity by its id.
+func (c *PostTagClient) Get(ctx context.Context, id int) (*PostTag, error) {
+	return c.Query().Where(posttag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostTagClient) GetX(ctx context.Context, id int) *PostTag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPostRelations queries the post_relations edge of a PostTag.
+func (c *PostTagClient) QueryPostRelations(pt *PostTag) *PostTagRelationQuery {
+	query := (&PostTagRelationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(posttag.Table, posttag.FieldID, id),
+			sqlgraph.To(posttagrelation.Table, posttagrelation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, posttag.PostRelationsTable, posttag.PostRelationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PostTagClient) Hooks() []Hook {
+	return c.hooks.PostTag
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostTagClient) Interceptors() []Interceptor {
+	return c.inters.PostTag
+}
+
+func (c *PostTagClient) mutate(ctx context.Context, m *PostTagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PostTag mutation op: %q", m.Op())
+	}
+}
+
+// PostTagRelationClient is a client for the PostTagRelation schema.
+type PostTagRelationClient struct {
+	config
+}
+
+// NewPostTagRelationClient returns a client for the PostTagRelation from the given config.
+func NewPostTagRelationClient(c config) *PostTagRelationClient {
+	return &PostTagRelationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `posttagrelation.Hooks(f(g(h())))`.
+func (c *PostTagRelationClient) Use(hooks ...Hook) {
+	c.hooks.PostTagRelation = append(c.hooks.PostTagRelation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `posttagrelation.Intercept(f(g(h())))`.
+func (c *PostTagRelationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PostTagRelation = append(c.inters.PostTagRelation, interceptors...)
+}
+
+// Create returns a builder for creating a PostTagRelation entity.
+func (c *PostTagRelationClient) Create() *PostTagRelationCreate {
+	mutation := newPostTagRelationMutation(c.config, OpCreate)
+	return &PostTagRelationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PostTagRelation entities.
+func (c *PostTagRelationClient) CreateBulk(builders ...*PostTagRelationCreate) *PostTagRelationCreateBulk {
+	return &PostTagRelationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostTagRelationClient) MapCreateBulk(slice any, setFunc func(*PostTagRelationCreate, int)) *PostTagRelationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostTagRelationCreateBulk{err: fmt.Errorf("calling to PostTagRelationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostTagRelationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostTagRelationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PostTagRelation.
+func (c *PostTagRelationClient) Update() *PostTagRelationUpdate {
+	mutation := newPostTagRelationMutation(c.config, OpUpdate)
+	return &PostTagRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostTagRelationClient) UpdateOne(ptr *PostTagRelation) *PostTagRelationUpdateOne {
+	mutation := newPostTagRelationMutation(c.config, OpUpdateOne, withPostTagRelation(ptr))
+	return &PostTagRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostTagRelationClient) UpdateOneID(id int) *PostTagRelationUpdateOne {
 	mutation := newPostTagRelationMutation(c.config, OpUpdateOne, withPostTagRelationID(id))
 	return &PostTagRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PostTagRelation.
-func (c *PostTagRelationClient) Delete() *PostTagRelationDelete {
-	mutation := newPostTagRelationMutation(c.config, OpDelete)
-	return &PostTagRelationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PostTagRelation.
+func (c *PostTagRelationClient) Delete() *PostTagRelationDelete {
+	mutation := newPostTagRelationMutation(c.config, OpDelete)
+	return &PostTagRelationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostTagRelationClient) DeleteOne(ptr *PostTagRelation) *PostTagRelationDeleteOne {
+	return c.DeleteOneID(ptr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostTagRelationClient) DeleteOneID(id int) *PostTagRelationDeleteOne {
+	builder := c.Delete().Where(posttagrelation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostTagRelationDeleteOne{builder}
+}
+
+// Query returns a query builder for PostTagRelation.
+func (c *PostTagRelationClient) Query() *PostTagRelationQuery {
+	return &PostTagRelationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePostTagRelation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PostTagRelation entity by its id.
+func (c *PostTagRelationClient) Get(ctx context.Context, id int) (*PostTagRelation, error) {
+	return c.Query().Where(posttagrelation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostTagRelationClient) GetX(ctx context.Context, id int) *PostTagRelation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPost queries the post edge of a PostTagRelation.
+func (c *PostTagRelationClient) QueryPost(ptr *PostTagRelation) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ptr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(posttagrelation.Table, posttagrelation.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, posttagrelation.PostTable, posttagrelation.PostColumn),
+		)
+		fromV = sqlgraph.Neighbors(ptr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPostTag queries the post_tag edge of a PostTagRelation.
+func (c *PostTagRelationClient) QueryPostTag(ptr *PostTagRelation) *PostTagQuery {
+	query := (&PostTagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ptr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(posttagrelation.Table, posttagrelation.FieldID, id),
+			sqlgraph.To(posttag.Table, posttag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, posttagrelation.PostTagTable, posttagrelation.PostTagColumn),
+		)
+		fromV = sqlgraph.Neighbors(ptr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PostTagRelationClient) Hooks() []Hook {
+	return c.hooks.PostTagRelation
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostTagRelationClient) Interceptors() []Interceptor {
+	return c.inters.PostTagRelation
+}
+
+func (c *PostTagRelationClient) mutate(ctx context.Context, m *PostTagRelationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostTagRelationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostTagRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostTagRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostTagRelationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PostTagRelation mutation op: %q", m.Op())
+	}
+}
+
+// ProductClient is a client for the Product schema.
+type ProductClient struct {
+	config
+}
+
+// NewProductClient returns a client for the Product from the given config.
+func NewProductClient(c config) *ProductClient {
+	return &ProductClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `product.Hooks(f(g(h())))`.
+func (c *ProductClient) Use(hooks ...Hook) {
+	c.hooks.Product = append(c.hooks.Product, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `product.Intercept(f(g(h())))`.
+func (c *ProductClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Product = append(c.inters.Product, interceptors...)
+}
+
+// Create returns a builder for creating a Product entity.
+func (c *ProductClient) Create() *ProductCreate {
+	mutation := newProductMutation(c.config, OpCreate)
+	return &ProductCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Product entities.
+func (c *ProductClient) CreateBulk(builders ...*ProductCreate) *ProductCreateBulk {
+	return &ProductCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProductClient) MapCreateBulk(slice any, setFunc func(*ProductCreate, int)) *ProductCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProductCreateBulk{err: fmt.Errorf("calling to ProductClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProductCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProductCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Product.
+func (c *ProductClient) Update() *ProductUpdate {
+	mutation := newProductMutation(c.config, OpUpdate)
+	return &ProductUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProductClient) UpdateOne(pr *Product) *ProductUpdateOne {
+	mutation := newProductMutation(c.config, OpUpdateOne, withProduct(pr))
+	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProductClient) UpdateOneID(id int) *ProductUpdateOne {
+	mutation := newProductMutation(c.config, OpUpdateOne, withProductID(id))
+	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Product.
+func (c *ProductClient) Delete() *ProductDelete {
+	mutation := newProductMutation(c.config, OpDelete)
+	return &ProductDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProductClient) DeleteOne(pr *Product) *ProductDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProductClient) DeleteOneID(id int) *ProductDeleteOne {
+	builder := c.Delete().Where(product.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProductDeleteOne{builder}
+}
+
+// Query returns a query builder for Product.
+func (c *ProductClient) Query() *ProductQuery {
+	return &ProductQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProduct},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Product entity by its id.
+func (c *ProductClient) Get(ctx context.Context, id int) (*Product, error) {
+	return c.Query().Where(product.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductClient) GetX(ctx context.Context, id int) *Product {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryManagers queries the managers edge of a Product.
+func (c *ProductClient) QueryManagers(pr *Product) *ProductManagerQuery {
+	query := (&ProductManagerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(productmanager.Table, productmanager.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.ManagersTable, product.ManagersColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLicenseTypes queries the license_types edge of a Product.
+func (c *ProductClient) QueryLicenseTypes(pr *Product) *LicenseTypeQuery {
+	query := (&LicenseTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(licensetype.Table, licensetype.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.LicenseTypesTable, product.LicenseTypesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFeatures queries the features edge of a Product.
+func (c *ProductClient) QueryFeatures(pr *Product) *ProductFeatureQuery {
+	query := (&ProductFeatureClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(productfeature.Table, productfeature.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.FeaturesTable, product.FeaturesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFirmwareVersions queries the firmware_versions edge of a Product.
+func (c *ProductClient) QueryFirmwareVersions(pr *Product) *FirmwareVersionQuery {
+	query := (&FirmwareVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(firmwareversion.Table, firmwareversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.FirmwareVersionsTable, product.FirmwareVersionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySoftwareVersions queries the software_versions edge of a Product.
+func (c *ProductClient) QuerySoftwareVersions(pr *Product) *SoftwareVersionQuery {
+	query := (&SoftwareVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(softwareversion.Table, softwareversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.SoftwareVersionsTable, product.SoftwareVersionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDevices queries the devices edge of a Product.
+func (c *ProductClient) QueryDevices(pr *Product) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.DevicesTable, product.DevicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAuditLogs queries the audit_logs edge of a Product.
+func (c *ProductClient) QueryAuditLogs(pr *Product) *AuditLogQuery {
+	query := (&AuditLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(auditlog.Table, auditlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.AuditLogsTable, product.AuditLogsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySnRule queries the sn_rule edge of a Product.
+func (c *ProductClient) QuerySnRule(pr *Product) *SnRuleQuery {
+	query := (&SnRuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(snrule.Table, snrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, product.SnRuleTable, product.SnRuleColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySnAllocators queries the sn_allocators edge of a Product.
+func (c *ProductClient) QuerySnAllocators(pr *Product) *SnAllocatorQuery {
+	query := (&SnAllocatorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(snallocator.Table, snallocator.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.SnAllocatorsTable, product.SnAllocatorsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeviceTags queries the device_tags edge of a Product.
+func (c *ProductClient) QueryDeviceTags(pr *Product) *DeviceTagQuery {
+	query := (&DeviceTagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(devicetag.Table, devicetag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.DeviceTagsTable, product.DeviceTagsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeviceGroups queries the device_groups edge of a Product.
+func (c *ProductClient) QueryDeviceGroups(pr *Product) *DeviceGroupQuery {
+	query := (&DeviceGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(devicegroup.Table, devicegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.DeviceGroupsTable, product.DeviceGroupsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrders queries the orders edge of a Product.
+func (c *ProductClient) QueryOrders(pr *Product) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.OrdersTable, product.OrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLots queries the lots edge of a Product.
+func (c *ProductClient) QueryLots(pr *Product) *LotQuery {
+	query := (&LotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(lot.Table, lot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.LotsTable, product.LotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvitations queries the invitations edge of a Product.
+func (c *ProductClient) QueryInvitations(pr *Product) *ProductInvitationQuery {
+	query := (&ProductInvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(productinvitation.Table, productinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.InvitationsTable, product.InvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRegistrationInvitations queries the registration_invitations edge of a Product.
+func (c *ProductClient) QueryRegistrationInvitations(pr *Product) *RegistrationInvitationQuery {
+	query := (&RegistrationInvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, id),
+			sqlgraph.To(registrationinvitation.Table, registrationinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.RegistrationInvitationsTable, product.RegistrationInvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProductClient) Hooks() []Hook {
+	hooks := c.hooks.Product
+	return append(hooks[:len(hooks):len(hooks)], product.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProductClient) Interceptors() []Interceptor {
+	inters := c.inters.Product
+	return append(inters[:len(inters):len(inters)], product.Interceptors[:]...)
+}
+
+func (c *ProductClient) mutate(ctx context.Context, m *ProductMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProductCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProductUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProductDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Product mutation op: %q", m.Op())
+	}
+}
+
+// ProductFeatureClient is a client for the ProductFeature schema.
+type ProductFeatureClient struct {
+	config
+}
+
+// NewProductFeatureClient returns a client for the ProductFeature from the given config.
+func NewProductFeatureClient(c config) *ProductFeatureClient {
+	return &ProductFeatureClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `productfeature.Hooks(f(g(h())))`.
+func (c *ProductFeatureClient) Use(hooks ...Hook) {
+	c.hooks.ProductFeature = append(c.hooks.ProductFeature, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `productfeature.Intercept(f(g(h())))`.
+func (c *ProductFeatureClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProductFeature = append(c.inters.ProductFeature, interceptors...)
+}
+
+// Create returns a builder for creating a ProductFeature entity.
+func (c *ProductFeatureClient) Create() *ProductFeatureCreate {
+	mutation := newProductFeatureMutation(c.config, OpCreate)
+	return &ProductFeatureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProductFeature entities.
+func (c *ProductFeatureClient) CreateBulk(builders ...*ProductFeatureCreate) *ProductFeatureCreateBulk {
+	return &ProductFeatureCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProductFeatureClient) MapCreateBulk(slice any, setFunc func(*ProductFeatureCreate, int)) *ProductFeatureCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProductFeatureCreateBulk{err: fmt.Errorf("calling to ProductFeatureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProductFeatureCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProductFeatureCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProductFeature.
+func (c *ProductFeatureClient) Update() *ProductFeatureUpdate {
+	mutation := newProductFeatureMutation(c.config, OpUpdate)
+	return &ProductFeatureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProductFeatureClient) UpdateOne(pf *ProductFeature) *ProductFeatureUpdateOne {
+	mutation := newProductFeatureMutation(c.config, OpUpdateOne, withProductFeature(pf))
+	return &ProductFeatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProductFeatureClient) UpdateOneID(id int) *ProductFeatureUpdateOne {
+	mutation := newProductFeatureMutation(c.config, OpUpdateOne, withProductFeatureID(id))
+	return &ProductFeatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProductFeature.
+func (c *ProductFeatureClient) Delete() *ProductFeatureDelete {
+	mutation := newProductFeatureMutation(c.config, OpDelete)
+	return &ProductFeatureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProductFeatureClient) DeleteOne(pf *ProductFeature) *ProductFeatureDeleteOne {
+	return c.DeleteOneID(pf.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProductFeatureClient) DeleteOneID(id int) *ProductFeatureDeleteOne {
+	builder := c.Delete().Where(productfeature.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProductFeatureDeleteOne{builder}
+}
+
+// Query returns a query builder for ProductFeature.
+func (c *ProductFeatureClient) Query() *ProductFeatureQuery {
+	return &ProductFeatureQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProductFeature},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProductFeature entity by its id.
+func (c *ProductFeatureClient) Get(ctx context.Context, id int) (*ProductFeature, error) {
+	return c.Query().Where(productfeature.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductFeatureClient) GetX(ctx context.Context, id int) *ProductFeature {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProduct queries the product edge of a ProductFeature.
+func (c *ProductFeatureClient) QueryProduct(pf *ProductFeature) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productfeature.Table, productfeature.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productfeature.ProductTable, productfeature.ProductColumn),
+		)
+		fromV = sqlgraph.Neighbors(pf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLicenseTypes queries the license_types edge of a ProductFeature.
+func (c *ProductFeatureClient) QueryLicenseTypes(pf *ProductFeature) *LicenseTypeQuery {
+	query := (&LicenseTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productfeature.Table, productfeature.FieldID, id),
+			sqlgraph.To(licensetype.Table, licensetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, productfeature.LicenseTypesTable, productfeature.LicenseTypesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySoftwareVersions queries the software_versions edge of a ProductFeature.
+func (c *ProductFeatureClient) QuerySoftwareVersions(pf *ProductFeature) *SoftwareVersionQuery {
+	query := (&SoftwareVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productfeature.Table, productfeature.FieldID, id),
+			sqlgraph.To(softwareversion.Table, softwareversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, productfeature.SoftwareVersionsTable, productfeature.SoftwareVersionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeviceOverrides queries the device_overrides edge of a ProductFeature.
+func (c *ProductFeatureClient) QueryDeviceOverrides(pf *ProductFeature) *DeviceFeatureOverrideQuery {
+	query := (&DeviceFeatureOverrideClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productfeature.Table, productfeature.FieldID, id),
+			sqlgraph.To(devicefeatureoverride.Table, devicefeatureoverride.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, productfeature.DeviceOverridesTable, productfeature.DeviceOverridesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRequiredBy queries the required_by edge of a ProductFeature.
+func (c *ProductFeatureClient) QueryRequiredBy(pf *ProductFeature) *ProductFeatureQuery {
+	query := (&ProductFeatureClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productfeature.Table, productfeature.FieldID, id),
+			sqlgraph.To(productfeature.Table, productfeature.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, productfeature.RequiredByTable, productfeature.RequiredByPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRequires queries the requires edge of a ProductFeature.
+func (c *ProductFeatureClient) QueryRequires(pf *ProductFeature) *ProductFeatureQuery {
+	query := (&ProductFeatureClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productfeature.Table, productfeature.FieldID, id),
+			sqlgraph.To(productfeature.Table, productfeature.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, productfeature.RequiresTable, productfeature.RequiresPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryConflicts queries the conflicts edge of a ProductFeature.
+func (c *ProductFeatureClient) QueryConflicts(pf *ProductFeature) *ProductFeatureQuery {
+	query := (&ProductFeatureClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productfeature.Table, productfeature.FieldID, id),
+			sqlgraph.To(productfeature.Table, productfeature.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, productfeature.ConflictsTable, productfeature.ConflictsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLicenseTypeFeatures queries the license_type_features edge of a ProductFeature.
+func (c *ProductFeatureClient) QueryLicenseTypeFeatures(pf *ProductFeature) *LicenseTypeFeaturesQuery {
+	query := (&LicenseTypeFeaturesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productfeature.Table, productfeature.FieldID, id),
+			sqlgraph.To(licensetypefeatures.Table, licensetypefeatures.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, productfeature.LicenseTypeFeaturesTable, productfeature.LicenseTypeFeaturesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProductFeatureClient) Hooks() []Hook {
+	hooks := c.hooks.ProductFeature
+	return append(hooks[:len(hooks):len(hooks)], productfeature.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProductFeatureClient) Interceptors() []Interceptor {
+	inters := c.inters.ProductFeature
+	return append(inters[:len(inters):len(inters)], productfeature.Interceptors[:]...)
+}
+
+func (c *ProductFeatureClient) mutate(ctx context.Context, m *ProductFeatureMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProductFeatureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProductFeatureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProductFeatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProductFeatureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProductFeature mutation op: %q", m.Op())
+	}
+}
+
+// ProductInvitationClient is a client for the ProductInvitation schema.
+type ProductInvitationClient struct {
+	config
+}
+
+// NewProductInvitationClient returns a client for the ProductInvitation from the given config.
+func NewProductInvitationClient(c config) *ProductInvitationClient {
+	return &ProductInvitationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `productinvitation.Hooks(f(g(h())))`.
+func (c *ProductInvitationClient) Use(hooks ...Hook) {
+	c.hooks.ProductInvitation = append(c.hooks.ProductInvitation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `productinvitation.Intercept(f(g(h())))`.
+func (c *ProductInvitationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProductInvitation = append(c.inters.ProductInvitation, interceptors...)
+}
+
+// Create returns a builder for creating a ProductInvitation entity.
+func (c *ProductInvitationClient) Create() *ProductInvitationCreate {
+	mutation := newProductInvitationMutation(c.config, OpCreate)
+	return &ProductInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProductInvitation entities.
+func (c *ProductInvitationClient) CreateBulk(builders ...*ProductInvitationCreate) *ProductInvitationCreateBulk {
+	return &ProductInvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProductInvitationClient) MapCreateBulk(slice any, setFunc func(*ProductInvitationCreate, int)) *ProductInvitationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProductInvitationCreateBulk{err: fmt.Errorf("calling to ProductInvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProductInvitationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProductInvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProductInvitation.
+func (c *ProductInvitationClient) Update() *ProductInvitationUpdate {
+	mutation := newProductInvitationMutation(c.config, OpUpdate)
+	return &ProductInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProductInvitationClient) UpdateOne(pi *ProductInvitation) *ProductInvitationUpdateOne {
+	mutation := newProductInvitationMutation(c.config, OpUpdateOne, withProductInvitation(pi))
+	return &ProductInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProductInvitationClient) UpdateOneID(id int) *ProductInvitationUpdateOne {
+	mutation := newProductInvitationMutation(c.config, OpUpdateOne, withProductInvitationID(id))
+	return &ProductInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProductInvitation.
+func (c *ProductInvitationClient) Delete() *ProductInvitationDelete {
+	mutation := newProductInvitationMutation(c.config, OpDelete)
+	return &ProductInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProductInvitationClient) DeleteOne(pi *ProductInvitation) *ProductInvitationDeleteOne {
+	return c.DeleteOneID(pi.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProductInvitationClient) DeleteOneID(id int) *ProductInvitationDeleteOne {
+	builder := c.Delete().Where(productinvitation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProductInvitationDeleteOne{builder}
+}
+
+// Query returns a query builder for ProductInvitation.
+func (c *ProductInvitationClient) Query() *ProductInvitationQuery {
+	return &ProductInvitationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProductInvitation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProductInvitation entity by its id.
+func (c *ProductInvitationClient) Get(ctx context.Context, id int) (*ProductInvitation, error) {
+	return c.Query().Where(productinvitation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProductInvitationClient) GetX(ctx context.Context, id int) *ProductInvitation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProduct queries the product edge of a ProductInvitation.
+func (c *ProductInvitationClient) QueryProduct(pi *ProductInvitation) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productinvitation.Table, productinvitation.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productinvitation.ProductTable, productinvitation.ProductColumn),
+		)
+		fromV = sqlgraph.Neighbors(pi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInviter queries the inviter edge of a ProductInvitation.
+func (c *ProductInvitationClient) QueryInviter(pi *ProductInvitation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productinvitation.Table, productinvitation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productinvitation.InviterTable, productinvitation.InviterColumn),
+		)
+		fromV = sqlgraph.Neighbors(pi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProductInvitationClient) Hooks() []Hook {
+	return c.hooks.ProductInvitation
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProductInvitationClient) Interceptors() []Interceptor {
+	return c.inters.ProductInvitation
+}
+
+func (c *ProductInvitationClient) mutate(ctx context.Context, m *ProductInvitationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProductInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProductInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProductInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProductInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProductInvitation mutation op: %q", m.Op())
+	}
+}
+
+// ProductManagerClient is a client for the ProductManager schema.
+type ProductManagerClient struct {
+	config
+}
+
+// NewProductManagerClient returns a client for the ProductManager from the given config.
+func NewProductManagerClient(c config) *ProductManagerClient {
+	return &ProductManagerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `productmanager.Hooks(f(g(h())))`.
+func (c *ProductManagerClient) Use(hooks ...Hook) {
+	c.hooks.ProductManager = append(c.hooks.ProductManager, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `productmanager.Intercept(f(g(h())))`.
+func (c *ProductManagerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProductManager = append(c.inters.ProductManager, interceptors...)
+}
+
+// Create returns a builder for creating a ProductManager entity.
+func (c *ProductManagerClient) Create() *ProductManagerCreate {
+	mutation := newProductManagerMutation(c.config, OpCreate)
+	return &ProductManagerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProductManager entities.
+func (c *ProductManagerClient) CreateBulk(builders ...*ProductManagerCreate) *ProductManagerCreateBulk {
+	return &ProductManagerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProductManagerClient) MapCreateBulk(slice any, setFunc func(*ProductManagerCreate, int)) *ProductManagerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProductManagerCreateBulk{err: fmt.Errorf("calling to ProductManagerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProductManagerCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProductManagerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProductManager.
+func (c *ProductManagerClient) Update() *ProductManagerUpdate {
+	mutation := newProductManagerMutation(c.config, OpUpdate)
+	return &ProductManagerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProductManagerClient) UpdateOne(pm *ProductManager) *ProductManagerUpdateOne {
+	mutation := newProductManagerMutation(c.config, OpUpdateOne, withProductManager(pm))
+	return &ProductManagerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProductManagerClient) UpdateOneID(id int) *ProductManagerUpdateOne {
+	mutation := newProductManagerMutation(c.config, OpUpdateOne, withProductManagerID(id))
+	return &ProductManagerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProductManager.
+func (c *ProductManagerClient) Delete() *ProductManagerDelete {
+	mutation := newProductManagerMutation(c.config, OpDelete)
+	return &ProductManagerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PostTagRelationClient) DeleteOne(ptr *PostTagRelation) *PostTagRelationDeleteOne {
-	return c.DeleteOneID(ptr.ID)
+func (c *ProductManagerClient) DeleteOne(pm *ProductManager) *ProductManagerDeleteOne {
+	return c.DeleteOneID(pm.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PostTagRelationClient) DeleteOneID(id int) *PostTagRelationDeleteOne {
-	builder := c.Delete().Where(posttagrelation.ID(id))
+func (c *ProductManagerClient) DeleteOneID(id int) *ProductManagerDeleteOne {
+	builder := c.Delete().Where(productmanager.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PostTagRelationDeleteOne{builder}
+	return &ProductManagerDeleteOne{builder}
 }
 
-// Query returns a query builder for PostTagRelation.
-func (c *PostTagRelationClient) Query() *PostTagRelationQuery {
-	return &PostTagRelationQuery{
+// Query returns a query builder for ProductManager.
+func (c *ProductManagerClient) Query() *ProductManagerQuery {
+	return &ProductManagerQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePostTagRelation},
+		ctx:    &QueryContext{Type: TypeProductManager},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PostTagRelation entity by its id.
-func (c *PostTagRelationClient) Get(ctx context.Context, id int) (*PostTagRelation, error) {
-	return c.Query().Where(posttagrelation.ID(id)).Only(ctx)
+// Get returns a ProductManager entity by its id.
+func (c *ProductManagerClient) Get(ctx context.Context, id int) (*ProductManager, error) {
+	return c.Query().Where(productmanager.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PostTagRelationClient) GetX(ctx context.Context, id int) *PostTagRelation {
+func (c *ProductManagerClient) GetX(ctx context.Context, id int) *ProductManager {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1941,164 +5068,329 @@ func (c *PostTagRelationClient) GetX(ctx context.Context, id int) *PostTagRelati
 	return obj
 }
 
-// QueryPost queries the post edge of a PostTagRelation.
-func (c *PostTagRelationClient) QueryPost(ptr *PostTagRelation) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
+// QueryUser queries the user edge of a ProductManager.
+func (c *ProductManagerClient) QueryUser(pm *ProductManager) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productmanager.Table, productmanager.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productmanager.UserTable, productmanager.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProduct queries the product edge of a ProductManager.
+func (c *ProductManagerClient) QueryProduct(pm *ProductManager) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productmanager.Table, productmanager.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productmanager.ProductTable, productmanager.ProductColumn),
+		)
+		fromV = sqlgraph.Neighbors(pm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProductManagerClient) Hooks() []Hook {
+	return c.hooks.ProductManager
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProductManagerClient) Interceptors() []Interceptor {
+	return c.inters.ProductManager
+}
+
+func (c *ProductManagerClient) mutate(ctx context.Context, m *ProductManagerMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProductManagerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProductManagerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProductManagerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProductManagerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProductManager mutation op: %q", m.Op())
+	}
+}
+
+// RegistrationInvitationClient is a client for the RegistrationInvitation schema.
+type RegistrationInvitationClient struct {
+	config
+}
+
+// NewRegistrationInvitationClient returns a client for the RegistrationInvitation from the given config.
+func NewRegistrationInvitationClient(c config) *RegistrationInvitationClient {
+	return &RegistrationInvitationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `registrationinvitation.Hooks(f(g(h())))`.
+func (c *RegistrationInvitationClient) Use(hooks ...Hook) {
+	c.hooks.RegistrationInvitation = append(c.hooks.RegistrationInvitation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `registrationinvitation.Intercept(f(g(h())))`.
+func (c *RegistrationInvitationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RegistrationInvitation = append(c.inters.RegistrationInvitation, interceptors...)
+}
+
+// Create returns a builder for creating a RegistrationInvitation entity.
+func (c *RegistrationInvitationClient) Create() *RegistrationInvitationCreate {
+	mutation := newRegistrationInvitationMutation(c.config, OpCreate)
+	return &RegistrationInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RegistrationInvitation entities.
+func (c *RegistrationInvitationClient) CreateBulk(builders ...*RegistrationInvitationCreate) *RegistrationInvitationCreateBulk {
+	return &RegistrationInvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RegistrationInvitationClient) MapCreateBulk(slice any, setFunc func(*RegistrationInvitationCreate, int)) *RegistrationInvitationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RegistrationInvitationCreateBulk{err: fmt.Errorf("calling to RegistrationInvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RegistrationInvitationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RegistrationInvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RegistrationInvitation.
+func (c *RegistrationInvitationClient) Update() *RegistrationInvitationUpdate {
+	mutation := newRegistrationInvitationMutation(c.config, OpUpdate)
+	return &RegistrationInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RegistrationInvitationClient) UpdateOne(ri *RegistrationInvitation) *RegistrationInvitationUpdateOne {
+	mutation := newRegistrationInvitationMutation(c.config, OpUpdateOne, withRegistrationInvitation(ri))
+	return &RegistrationInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RegistrationInvitationClient) UpdateOneID(id int) *RegistrationInvitationUpdateOne {
+	mutation := newRegistrationInvitationMutation(c.config, OpUpdateOne, withRegistrationInvitationID(id))
+	return &RegistrationInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RegistrationInvitation.
+func (c *RegistrationInvitationClient) Delete() *RegistrationInvitationDelete {
+	mutation := newRegistrationInvitationMutation(c.config, OpDelete)
+	return &RegistrationInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RegistrationInvitationClient) DeleteOne(ri *RegistrationInvitation) *RegistrationInvitationDeleteOne {
+	return c.DeleteOneID(ri.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RegistrationInvitationClient) DeleteOneID(id int) *RegistrationInvitationDeleteOne {
+	builder := c.Delete().Where(registrationinvitation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RegistrationInvitationDeleteOne{builder}
+}
+
+// Query returns a query builder for RegistrationInvitation.
+func (c *RegistrationInvitationClient) Query() *RegistrationInvitationQuery {
+	return &RegistrationInvitationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRegistrationInvitation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RegistrationInvitation entity by its id.
+func (c *RegistrationInvitationClient) Get(ctx context.Context, id int) (*RegistrationInvitation, error) {
+	return c.Query().Where(registrationinvitation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RegistrationInvitationClient) GetX(ctx context.Context, id int) *RegistrationInvitation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProduct queries the product edge of a RegistrationInvitation.
+func (c *RegistrationInvitationClient) QueryProduct(ri *RegistrationInvitation) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ptr.ID
+		id := ri.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(posttagrelation.Table, posttagrelation.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, posttagrelation.PostTable, posttagrelation.PostColumn),
+			sqlgraph.From(registrationinvitation.Table, registrationinvitation.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, registrationinvitation.ProductTable, registrationinvitation.ProductColumn),
 		)
-		fromV = sqlgraph.Neighbors(ptr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ri.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryPostTag queries the post_tag edge of a PostTagRelation.
-func (c *PostTagRelationClient) QueryPostTag(ptr *PostTagRelation) *PostTagQuery {
-	query := (&PostTagClient{config: c.config}).Query()
+// QueryCreator queries the creator edge of a RegistrationInvitation.
+func (c *RegistrationInvitationClient) QueryCreator(ri *RegistrationInvitation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ptr.ID
+		id := ri.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(posttagrelation.Table, posttagrelation.FieldID, id),
-			sqlgraph.To(posttag.Table, posttag.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, posttagrelation.PostTagTable, posttagrelation.PostTagColumn),
+			sqlgraph.From(registrationinvitation.Table, registrationinvitation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, registrationinvitation.CreatorTable, registrationinvitation.CreatorColumn),
 		)
-		fromV = sqlgraph.Neighbors(ptr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ri.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PostTagRelationClient) Hooks() []Hook {
-	return c.hooks.PostTagRelation
+func (c *RegistrationInvitationClient) Hooks() []Hook {
+	return c.hooks.RegistrationInvitation
 }
 
 // Interceptors returns the client interceptors.
-func (c *PostTagRelationClient) Interceptors() []Interceptor {
-	return c.inters.PostTagRelation
+func (c *RegistrationInvitationClient) Interceptors() []Interceptor {
+	return c.inters.RegistrationInvitation
 }
 
-func (c *PostTagRelationClient) mutate(ctx context.Context, m *PostTagRelationMutation) (Value, error) {
+func (c *RegistrationInvitationClient) mutate(ctx context.Context, m *RegistrationInvitationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PostTagRelationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RegistrationInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PostTagRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RegistrationInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PostTagRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RegistrationInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PostTagRelationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RegistrationInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PostTagRelation mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RegistrationInvitation mutation op: %q", m.Op())
 	}
 }
 
-// ProductClient is a client for the Product schema.
-type ProductClient struct {
+// SnAllocatorClient is a client for the SnAllocator schema.
+type SnAllocatorClient struct {
 	config
 }
 
-// NewProductClient returns a client for the Product from the given config.
-func NewProductClient(c config) *ProductClient {
-	return &ProductClient{config: c}
+// NewSnAllocatorClient returns a client for the SnAllocator from the given config.
+func NewSnAllocatorClient(c config) *SnAllocatorClient {
+	return &SnAllocatorClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `product.Hooks(f(g(h())))`.
-func (c *ProductClient) Use(hooks ...Hook) {
-	c.hooks.Product = append(c.hooks.Product, hooks...)
+// A call to `Use(f, g, h)` equals to `snallocator.Hooks(f(g(h())))`.
+func (c *SnAllocatorClient) Use(hooks ...Hook) {
+	c.hooks.SnAllocator = append(c.hooks.SnAllocator, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `product.Intercept(f(g(h())))`.
-func (c *ProductClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Product = append(c.inters.Product, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `snallocator.Intercept(f(g(h())))`.
+func (c *SnAllocatorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SnAllocator = append(c.inters.SnAllocator, interceptors...)
 }
 
-// Create returns a builder for creating a Product entity.
-func (c *ProductClient) Create() *ProductCreate {
-	mutation := newProductMutation(c.config, OpCreate)
-	return &ProductCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SnAllocator entity.
+func (c *SnAllocatorClient) Create() *SnAllocatorCreate {
+	mutation := newSnAllocatorMutation(c.config, OpCreate)
+	return &SnAllocatorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Product entities.
-func (c *ProductClient) CreateBulk(builders ...*ProductCreate) *ProductCreateBulk {
-	return &ProductCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SnAllocator entities.
+func (c *SnAllocatorClient) CreateBulk(builders ...*SnAllocatorCreate) *SnAllocatorCreateBulk {
+	return &SnAllocatorCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProductClient) MapCreateBulk(slice any, setFunc func(*ProductCreate, int)) *ProductCreateBulk {
+func (c *SnAllocatorClient) MapCreateBulk(slice any, setFunc func(*SnAllocatorCreate, int)) *SnAllocatorCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProductCreateBulk{err: fmt.Errorf("calling to ProductClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SnAllocatorCreateBulk{err: fmt.Errorf("calling to SnAllocatorClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProductCreate, rv.Len())
+	builders := make([]*SnAllocatorCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProductCreateBulk{config: c.config, builders: builders}
+	return &SnAllocatorCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Product.
-func (c *ProductClient) Update() *ProductUpdate {
-	mutation := newProductMutation(c.config, OpUpdate)
-	return &ProductUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SnAllocator.
+func (c *SnAllocatorClient) Update() *SnAllocatorUpdate {
+	mutation := newSnAllocatorMutation(c.config, OpUpdate)
+	return &SnAllocatorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProductClient) UpdateOne(pr *Product) *ProductUpdateOne {
-	mutation := newProductMutation(c.config, OpUpdateOne, withProduct(pr))
-	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SnAllocatorClient) UpdateOne(sa *SnAllocator) *SnAllocatorUpdateOne {
+	mutation := newSnAllocatorMutation(c.config, OpUpdateOne, withSnAllocator(sa))
+	return &SnAllocatorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProductClient) UpdateOneID(id int) *ProductUpdateOne {
-	mutation := newProductMutation(c.config, OpUpdateOne, withProductID(id))
-	return &ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SnAllocatorClient) UpdateOneID(id int) *SnAllocatorUpdateOne {
+	mutation := newSnAllocatorMutation(c.config, OpUpdateOne, withSnAllocatorID(id))
+	return &SnAllocatorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Product.
-func (c *ProductClient) Delete() *ProductDelete {
-	mutation := newProductMutation(c.config, OpDelete)
-	return &ProductDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SnAllocator.
+func (c *SnAllocatorClient) Delete() *SnAllocatorDelete {
+	mutation := newSnAllocatorMutation(c.config, OpDelete)
+	return &SnAllocatorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProductClient) DeleteOne(pr *Product) *ProductDeleteOne {
-	return c.DeleteOneID(pr.ID)
+func (c *SnAllocatorClient) DeleteOne(sa *SnAllocator) *SnAllocatorDeleteOne {
+	return c.DeleteOneID(sa.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProductClient) DeleteOneID(id int) *ProductDeleteOne {
-	builder := c.Delete().Where(product.ID(id))
+func (c *SnAllocatorClient) DeleteOneID(id int) *SnAllocatorDeleteOne {
+	builder := c.Delete().Where(snallocator.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProductDeleteOne{builder}
+	return &SnAllocatorDeleteOne{builder}
 }
 
-// Query returns a query builder for Product.
-func (c *ProductClient) Query() *ProductQuery {
-	return &ProductQuery{
+// Query returns a query builder for SnAllocator.
+func (c *SnAllocatorClient) Query() *SnAllocatorQuery {
+	return &SnAllocatorQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProduct},
+		ctx:    &QueryContext{Type: TypeSnAllocator},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Product entity by its id.
-func (c *ProductClient) Get(ctx context.Context, id int) (*Product, error) {
-	return c.Query().Where(product.ID(id)).Only(ctx)
+// Get returns a SnAllocator entity by its id.
+func (c *SnAllocatorClient) Get(ctx context.Context, id int) (*SnAllocator, error) {
+	return c.Query().Where(snallocator.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProductClient) GetX(ctx context.Context, id int) *Product {
+func (c *SnAllocatorClient) GetX(ctx context.Context, id int) *SnAllocator {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2106,441 +5398,329 @@ func (c *ProductClient) GetX(ctx context.Context, id int) *Product {
 	return obj
 }
 
-// QueryManagers queries the managers edge of a Product.
-func (c *ProductClient) QueryManagers(pr *Product) *ProductManagerQuery {
-	query := (&ProductManagerClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(productmanager.Table, productmanager.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.ManagersTable, product.ManagersColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLicenseTypes queries the license_types edge of a Product.
-func (c *ProductClient) QueryLicenseTypes(pr *Product) *LicenseTypeQuery {
-	query := (&LicenseTypeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(licensetype.Table, licensetype.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.LicenseTypesTable, product.LicenseTypesColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryFeatures queries the features edge of a Product.
-func (c *ProductClient) QueryFeatures(pr *Product) *ProductFeatureQuery {
-	query := (&ProductFeatureClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(productfeature.Table, productfeature.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.FeaturesTable, product.FeaturesColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryFirmwareVersions queries the firmware_versions edge of a Product.
-func (c *ProductClient) QueryFirmwareVersions(pr *Product) *FirmwareVersionQuery {
-	query := (&FirmwareVersionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(firmwareversion.Table, firmwareversion.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.FirmwareVersionsTable, product.FirmwareVersionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QuerySoftwareVersions queries the software_versions edge of a Product.
-func (c *ProductClient) QuerySoftwareVersions(pr *Product) *SoftwareVersionQuery {
-	query := (&SoftwareVersionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(softwareversion.Table, softwareversion.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.SoftwareVersionsTable, product.SoftwareVersionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryDevices queries the devices edge of a Product.
-func (c *ProductClient) QueryDevices(pr *Product) *DeviceQuery {
-	query := (&DeviceClient{config: c.config}).Query()
+// QueryProduct queries the product edge of a SnAllocator.
+func (c *SnAllocatorClient) QueryProduct(sa *SnAllocator) *ProductQuery {
+	query := (&ProductClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := sa.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(device.Table, device.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.DevicesTable, product.DevicesColumn),
+			sqlgraph.From(snallocator.Table, snallocator.FieldID, id),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, snallocator.ProductTable, snallocator.ProductColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sa.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryAuditLogs queries the audit_logs edge of a Product.
-func (c *ProductClient) QueryAuditLogs(pr *Product) *AuditLogQuery {
-	query := (&AuditLogClient{config: c.config}).Query()
+// QueryBlocks queries the blocks edge of a SnAllocator.
+func (c *SnAllocatorClient) QueryBlocks(sa *SnAllocator) *SnBlockQuery {
+	query := (&SnBlockClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := sa.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(product.Table, product.FieldID, id),
-			sqlgraph.To(auditlog.Table, auditlog.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, product.AuditLogsTable, product.AuditLogsColumn),
+			sqlgraph.From(snallocator.Table, snallocator.FieldID, id),
+			sqlgraph.To(snblock.Table, snblock.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, snallocator.BlocksTable, snallocator.BlocksColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sa.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProductClient) Hooks() []Hook {
-	return c.hooks.Product
+func (c *SnAllocatorClient) Hooks() []Hook {
+	return c.hooks.SnAllocator
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProductClient) Interceptors() []Interceptor {
-	return c.inters.Product
+func (c *SnAllocatorClient) Interceptors() []Interceptor {
+	return c.inters.SnAllocator
 }
 
-func (c *ProductClient) mutate(ctx context.Context, m *ProductMutation) (Value, error) {
+func (c *SnAllocatorClient) mutate(ctx context.Context, m *SnAllocatorMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProductCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SnAllocatorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProductUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SnAllocatorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProductUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SnAllocatorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProductDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SnAllocatorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Product mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SnAllocator mutation op: %q", m.Op())
 	}
 }
 
-// ProductFeatureClient is a client for the ProductFeature schema.
-type ProductFeatureClient struct {
+// SnBlockClient is a client for the SnBlock schema.
+type SnBlockClient struct {
 	config
 }
 
-// NewProductFeatureClient returns a client for the ProductFeature from the given config.
-func NewProductFeatureClient(c config) *ProductFeatureClient {
-	return &ProductFeatureClient{config: c}
+// NewSnBlockClient returns a client for the SnBlock from the given config.
+func NewSnBlockClient(c config) *SnBlockClient {
+	return &SnBlockClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `productfeature.Hooks(f(g(h())))`.
-func (c *ProductFeatureClient) Use(hooks ...Hook) {
-	c.hooks.ProductFeature = append(c.hooks.ProductFeature, hooks...)
+// A call to `Use(f, g, h)` equals to `snblock.Hooks(f(g(h())))`.
+func (c *SnBlockClient) Use(hooks ...Hook) {
+	c.hooks.SnBlock = append(c.hooks.SnBlock, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `productfeature.Intercept(f(g(h())))`.
-func (c *ProductFeatureClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProductFeature = append(c.inters.ProductFeature, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `snblock.Intercept(f(g(h())))`.
+func (c *SnBlockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SnBlock = append(c.inters.SnBlock, interceptors...)
 }
 
-// Create returns a builder for creating a ProductFeature entity.
-func (c *ProductFeatureClient) Create() *ProductFeatureCreate {
-	mutation := newProductFeatureMutation(c.config, OpCreate)
-	return &ProductFeatureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SnBlock entity.
+func (c *SnBlockClient) Create() *SnBlockCreate {
+	mutation := newSnBlockMutation(c.config, OpCreate)
+	return &SnBlockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProductFeature entities.
-func (c *ProductFeatureClient) CreateBulk(builders ...*ProductFeatureCreate) *ProductFeatureCreateBulk {
-	return &ProductFeatureCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SnBlock entities.
+func (c *SnBlockClient) CreateBulk(builders ...*SnBlockCreate) *SnBlockCreateBulk {
+	return &SnBlockCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProductFeatureClient) MapCreateBulk(slice any, setFunc func(*ProductFeatureCreate, int)) *ProductFeatureCreateBulk {
+func (c *SnBlockClient) MapCreateBulk(slice any, setFunc func(*SnBlockCreate, int)) *SnBlockCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProductFeatureCreateBulk{err: fmt.Errorf("calling to ProductFeatureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SnBlockCreateBulk{err: fmt.Errorf("calling to SnBlockClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProductFeatureCreate, rv.Len())
+	builders := make([]*SnBlockCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProductFeatureCreateBulk{config: c.config, builders: builders}
+	return &SnBlockCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProductFeature.
-func (c *ProductFeatureClient) Update() *ProductFeatureUpdate {
-	mutation := newProductFeatureMutation(c.config, OpUpdate)
-	return &ProductFeatureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SnBlock.
+func (c *SnBlockClient) Update() *SnBlockUpdate {
+	mutation := newSnBlockMutation(c.config, OpUpdate)
+	return &SnBlockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProductFeatureClient) UpdateOne(pf *ProductFeature) *ProductFeatureUpdateOne {
-	mutation := newProductFeatureMutation(c.config, OpUpdateOne, withProductFeature(pf))
-	return &ProductFeatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SnBlockClient) UpdateOne(sb *SnBlock) *SnBlockUpdateOne {
+	mutation := newSnBlockMutation(c.config, OpUpdateOne, withSnBlock(sb))
+	return &SnBlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProductFeatureClient) UpdateOneID(id int) *ProductFeatureUpdateOne {
-	mutation := newProductFeatureMutation(c.config, OpUpdateOne, withProductFeatureID(id))
-	return &ProductFeatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SnBlockClient) UpdateOneID(id int) *SnBlockUpdateOne {
+	mutation := newSnBlockMutation(c.config, OpUpdateOne, withSnBlockID(id))
+	return &SnBlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProductFeature.
-func (c *ProductFeatureClient) Delete() *ProductFeatureDelete {
-	mutation := newProductFeatureMutation(c.config, OpDelete)
-	return &ProductFeatureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SnBlock.
+func (c *SnBlockClient) Delete() *SnBlockDelete {
+	mutation := newSnBlockMutation(c.config, OpDelete)
+	return &SnBlockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProductFeatureClient) DeleteOne(pf *ProductFeature) *ProductFeatureDeleteOne {
-	return c.DeleteOneID(pf.ID)
+func (c *SnBlockClient) DeleteOne(sb *SnBlock) *SnBlockDeleteOne {
+	return c.DeleteOneID(sb.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProductFeatureClient) DeleteOneID(id int) *ProductFeatureDeleteOne {
-	builder := c.Delete().Where(productfeature.ID(id))
+func (c *SnBlockClient) DeleteOneID(id int) *SnBlockDeleteOne {
+	builder := c.Delete().Where(snblock.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProductFeatureDeleteOne{builder}
+	return &SnBlockDeleteOne{builder}
 }
 
-// Query returns a query builder for ProductFeature.
-func (c *ProductFeatureClient) Query() *ProductFeatureQuery {
-	return &ProductFeatureQuery{
+// Query returns a query builder for SnBlock.
+func (c *SnBlockClient) Query() *SnBlockQuery {
+	return &SnBlockQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProductFeature},
+		ctx:    &QueryContext{Type: TypeSnBlock},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProductFeature entity by its id.
-func (c *ProductFeatureClient) Get(ctx context.Context, id int) (*ProductFeature, error) {
-	return c.Query().Where(productfeature.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *ProductFeatureClient) GetX(ctx context.Context, id int) *ProductFeature {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
-	}
-	return obj
-}
-
-// QueryProduct queries the product edge of a ProductFeature.
-func (c *ProductFeatureClient) QueryProduct(pf *ProductFeature) *ProductQuery {
-	query := (&ProductClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pf.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(productfeature.Table, productfeature.FieldID, id),
-			sqlgraph.To(product.Table, product.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, productfeature.ProductTable, productfeature.ProductColumn),
-		)
-		fromV = sqlgraph.Neighbors(pf.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLicenseTypes queries the license_types edge of a ProductFeature.
-func (c *ProductFeatureClient) QueryLicenseTypes(pf *ProductFeature) *LicenseTypeQuery {
-	query := (&LicenseTypeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pf.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(productfeature.Table, productfeature.FieldID, id),
-			sqlgraph.To(licensetype.Table, licensetype.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, productfeature.LicenseTypesTable, productfeature.LicenseTypesPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(pf.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a SnBlock entity by its id.
+func (c *SnBlockClient) Get(ctx context.Context, id int) (*SnBlock, error) {
+	return c.Query().Where(snblock.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SnBlockClient) GetX(ctx context.Context, id int) *SnBlock {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QuerySoftwareVersions queries the software_versions edge of a ProductFeature.
-func (c *ProductFeatureClient) QuerySoftwareVersions(pf *ProductFeature) *SoftwareVersionQuery {
-	query := (&SoftwareVersionClient{config: c.config}).Query()
+// QueryAllocator queries the allocator edge of a SnBlock.
+func (c *SnBlockClient) QueryAllocator(sb *SnBlock) *SnAllocatorQuery {
+	query := (&SnAllocatorClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pf.ID
+		id := sb.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(productfeature.Table, productfeature.FieldID, id),
-			sqlgraph.To(softwareversion.Table, softwareversion.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, productfeature.SoftwareVersionsTable, productfeature.SoftwareVersionsPrimaryKey...),
+			sqlgraph.From(snblock.Table, snblock.FieldID, id),
+			sqlgraph.To(snallocator.Table, snallocator.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, snblock.AllocatorTable, snblock.AllocatorColumn),
 		)
-		fromV = sqlgraph.Neighbors(pf.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sb.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryLicenseTypeFeatures queries the license_type_features edge of a ProductFeature.
-func (c *ProductFeatureClient) QueryLicenseTypeFeatures(pf *ProductFeature) *LicenseTypeFeaturesQuery {
-	query := (&LicenseTypeFeaturesClient{config: c.config}).Query()
+// QueryRequester queries the requester edge of a SnBlock.
+func (c *SnBlockClient) QueryRequester(sb *SnBlock) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pf.ID
+		id := sb.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(productfeature.Table, productfeature.FieldID, id),
-			sqlgraph.To(licensetypefeatures.Table, licensetypefeatures.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, productfeature.LicenseTypeFeaturesTable, productfeature.LicenseTypeFeaturesColumn),
+			sqlgraph.From(snblock.Table, snblock.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, snblock.RequesterTable, snblock.RequesterColumn),
 		)
-		fromV = sqlgraph.Neighbors(pf.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sb.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProductFeatureClient) Hooks() []Hook {
-	return c.hooks.ProductFeature
+func (c *SnBlockClient) Hooks() []Hook {
+	return c.hooks.SnBlock
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProductFeatureClient) Interceptors() []Interceptor {
-	return c.inters.ProductFeature
+func (c *SnBlockClient) Interceptors() []Interceptor {
+	return c.inters.SnBlock
 }
 
-func (c *ProductFeatureClient) mutate(ctx context.Context, m *ProductFeatureMutation) (Value, error) {
+func (c *SnBlockClient) mutate(ctx context.Context, m *SnBlockMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProductFeatureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SnBlockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProductFeatureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SnBlockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProductFeatureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SnBlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProductFeatureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SnBlockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProductFeature mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SnBlock mutation op: %q", m.Op())
 	}
 }
 
-// ProductManagerClient is a client for the ProductManager schema.
-type ProductManagerClient struct {
+// SnRuleClient is a client for the SnRule schema.
+type SnRuleClient struct {
 	config
 }
 
-// NewProductManagerClient returns a client for the ProductManager from the given config.
-func NewProductManagerClient(c config) *ProductManagerClient {
-	return &ProductManagerClient{config: c}
+// NewSnRuleClient returns a client for the SnRule from the given config.
+func NewSnRuleClient(c config) *SnRuleClient {
+	return &SnRuleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `productmanager.Hooks(f(g(h())))`.
-func (c *ProductManagerClient) Use(hooks ...Hook) {
-	c.hooks.ProductManager = append(c.hooks.ProductManager, hooks...)
+// A call to `Use(f, g, h)` equals to `snrule.Hooks(f(g(h())))`.
+func (c *SnRuleClient) Use(hooks ...Hook) {
+	c.hooks.SnRule = append(c.hooks.SnRule, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `productmanager.Intercept(f(g(h())))`.
-func (c *ProductManagerClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProductManager = append(c.inters.ProductManager, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `snrule.Intercept(f(g(h())))`.
+func (c *SnRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SnRule = append(c.inters.SnRule, interceptors...)
 }
 
-// Create returns a builder for creating a ProductManager entity.
-func (c *ProductManagerClient) Create() *ProductManagerCreate {
-	mutation := newProductManagerMutation(c.config, OpCreate)
-	return &ProductManagerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SnRule entity.
+func (c *SnRuleClient) Create() *SnRuleCreate {
+	mutation := newSnRuleMutation(c.config, OpCreate)
+	return &SnRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProductManager entities.
-func (c *ProductManagerClient) CreateBulk(builders ...*ProductManagerCreate) *ProductManagerCreateBulk {
-	return &ProductManagerCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SnRule entities.
+func (c *SnRuleClient) CreateBulk(builders ...*SnRuleCreate) *SnRuleCreateBulk {
+	return &SnRuleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProductManagerClient) MapCreateBulk(slice any, setFunc func(*ProductManagerCreate, int)) *ProductManagerCreateBulk {
+func (c *SnRuleClient) MapCreateBulk(slice any, setFunc func(*SnRuleCreate, int)) *SnRuleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProductManagerCreateBulk{err: fmt.Errorf("calling to ProductManagerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SnRuleCreateBulk{err: fmt.Errorf("calling to SnRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProductManagerCreate, rv.Len())
+	builders := make([]*SnRuleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProductManagerCreateBulk{config: c.config, builders: builders}
+	return &SnRuleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProductManager.
-func (c *ProductManagerClient) Update() *ProductManagerUpdate {
-	mutation := newProductManagerMutation(c.config, OpUpdate)
-	return &ProductManagerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SnRule.
+func (c *SnRuleClient) Update() *SnRuleUpdate {
+	mutation := newSnRuleMutation(c.config, OpUpdate)
+	return &SnRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProductManagerClient) UpdateOne(pm *ProductManager) *ProductManagerUpdateOne {
-	mutation := newProductManagerMutation(c.config, OpUpdateOne, withProductManager(pm))
-	return &ProductManagerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SnRuleClient) UpdateOne(sr *SnRule) *SnRuleUpdateOne {
+	mutation := newSnRuleMutation(c.config, OpUpdateOne, withSnRule(sr))
+	return &SnRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProductManagerClient) UpdateOneID(id int) *ProductManagerUpdateOne {
-	mutation := newProductManagerMutation(c.config, OpUpdateOne, withProductManagerID(id))
-	return &ProductManagerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SnRuleClient) UpdateOneID(id int) *SnRuleUpdateOne {
+	mutation := newSnRuleMutation(c.config, OpUpdateOne, withSnRuleID(id))
+	return &SnRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProductManager.
-func (c *ProductManagerClient) Delete() *ProductManagerDelete {
-	mutation := newProductManagerMutation(c.config, OpDelete)
-	return &ProductManagerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SnRule.
+func (c *SnRuleClient) Delete() *SnRuleDelete {
+	mutation := newSnRuleMutation(c.config, OpDelete)
+	return &SnRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProductManagerClient) DeleteOne(pm *ProductManager) *ProductManagerDeleteOne {
-	return c.DeleteOneID(pm.ID)
+func (c *SnRuleClient) DeleteOne(sr *SnRule) *SnRuleDeleteOne {
+	return c.DeleteOneID(sr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProductManagerClient) DeleteOneID(id int) *ProductManagerDeleteOne {
-	builder := c.Delete().Where(productmanager.ID(id))
+func (c *SnRuleClient) DeleteOneID(id int) *SnRuleDeleteOne {
+	builder := c.Delete().Where(snrule.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProductManagerDeleteOne{builder}
+	return &SnRuleDeleteOne{builder}
 }
 
-// Query returns a query builder for ProductManager.
-func (c *ProductManagerClient) Query() *ProductManagerQuery {
-	return &ProductManagerQuery{
+// Query returns a query builder for SnRule.
+func (c *SnRuleClient) Query() *SnRuleQuery {
+	return &SnRuleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProductManager},
+		ctx:    &QueryContext{Type: TypeSnRule},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProductManager entity by its id.
-func (c *ProductManagerClient) Get(ctx context.Context, id int) (*ProductManager, error) {
-	return c.Query().Where(productmanager.ID(id)).Only(ctx)
+// Get returns a SnRule entity by its id.
+func (c *SnRuleClient) Get(ctx context.Context, id int) (*SnRule, error) {
+	return c.Query().Where(snrule.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProductManagerClient) GetX(ctx context.Context, id int) *ProductManager {
+func (c *SnRuleClient) GetX(ctx context.Context, id int) *SnRule {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2548,60 +5728,44 @@ func (c *ProductManagerClient) GetX(ctx context.Context, id int) *ProductManager
 	return obj
 }
 
-// QueryUser queries the user edge of a ProductManager.
-func (c *ProductManagerClient) QueryUser(pm *ProductManager) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pm.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(productmanager.Table, productmanager.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, productmanager.UserTable, productmanager.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(pm.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProduct queries the product edge of a ProductManager.
-func (c *ProductManagerClient) QueryProduct(pm *ProductManager) *ProductQuery {
+// QueryProduct queries the product edge of a SnRule.
+func (c *SnRuleClient) QueryProduct(sr *SnRule) *ProductQuery {
 	query := (&ProductClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pm.ID
+		id := sr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(productmanager.Table, productmanager.FieldID, id),
+			sqlgraph.From(snrule.Table, snrule.FieldID, id),
 			sqlgraph.To(product.Table, product.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, productmanager.ProductTable, productmanager.ProductColumn),
+			sqlgraph.Edge(sqlgraph.O2O, true, snrule.ProductTable, snrule.ProductColumn),
 		)
-		fromV = sqlgraph.Neighbors(pm.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProductManagerClient) Hooks() []Hook {
-	return c.hooks.ProductManager
+func (c *SnRuleClient) Hooks() []Hook {
+	return c.hooks.SnRule
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProductManagerClient) Interceptors() []Interceptor {
-	return c.inters.ProductManager
+func (c *SnRuleClient) Interceptors() []Interceptor {
+	return c.inters.SnRule
 }
 
-func (c *ProductManagerClient) mutate(ctx context.Context, m *ProductManagerMutation) (Value, error) {
+func (c *SnRuleClient) mutate(ctx context.Context, m *SnRuleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProductManagerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SnRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProductManagerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SnRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProductManagerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SnRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProductManagerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SnRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProductManager mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SnRule mutation op: %q", m.Op())
 	}
 }
 
@@ -2779,12 +5943,14 @@ func (c *SoftwareVersionClient) QueryCreator(sv *SoftwareVersion) *UserQuery {
 
 // Hooks returns the client hooks.
 func (c *SoftwareVersionClient) Hooks() []Hook {
-	return c.hooks.SoftwareVersion
+	hooks := c.hooks.SoftwareVersion
+	return append(hooks[:len(hooks):len(hooks)], softwareversion.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *SoftwareVersionClient) Interceptors() []Interceptor {
-	return c.inters.SoftwareVersion
+	inters := c.inters.SoftwareVersion
+	return append(inters[:len(inters):len(inters)], softwareversion.Interceptors[:]...)
 }
 
 func (c *SoftwareVersionClient) mutate(ctx context.Context, m *SoftwareVersionMutation) (Value, error) {
@@ -2802,6 +5968,139 @@ func (c *SoftwareVersionClient) mutate(ctx context.Context, m *SoftwareVersionMu
 	}
 }
 
+// TwoFactorPolicyClient is a client for the TwoFactorPolicy schema.
+type TwoFactorPolicyClient struct {
+	config
+}
+
+// NewTwoFactorPolicyClient returns a client for the TwoFactorPolicy from the given config.
+func NewTwoFactorPolicyClient(c config) *TwoFactorPolicyClient {
+	return &TwoFactorPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `twofactorpolicy.Hooks(f(g(h())))`.
+func (c *TwoFactorPolicyClient) Use(hooks ...Hook) {
+	c.hooks.TwoFactorPolicy = append(c.hooks.TwoFactorPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `twofactorpolicy.Intercept(f(g(h())))`.
+func (c *TwoFactorPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TwoFactorPolicy = append(c.inters.TwoFactorPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a TwoFactorPolicy entity.
+func (c *TwoFactorPolicyClient) Create() *TwoFactorPolicyCreate {
+	mutation := newTwoFactorPolicyMutation(c.config, OpCreate)
+	return &TwoFactorPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TwoFactorPolicy entities.
+func (c *TwoFactorPolicyClient) CreateBulk(builders ...*TwoFactorPolicyCreate) *TwoFactorPolicyCreateBulk {
+	return &TwoFactorPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TwoFactorPolicyClient) MapCreateBulk(slice any, setFunc func(*TwoFactorPolicyCreate, int)) *TwoFactorPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TwoFactorPolicyCreateBulk{err: fmt.Errorf("calling to TwoFactorPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TwoFactorPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TwoFactorPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TwoFactorPolicy.
+func (c *TwoFactorPolicyClient) Update() *TwoFactorPolicyUpdate {
+	mutation := newTwoFactorPolicyMutation(c.config, OpUpdate)
+	return &TwoFactorPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TwoFactorPolicyClient) UpdateOne(tfp *TwoFactorPolicy) *TwoFactorPolicyUpdateOne {
+	mutation := newTwoFactorPolicyMutation(c.config, OpUpdateOne, withTwoFactorPolicy(tfp))
+	return &TwoFactorPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TwoFactorPolicyClient) UpdateOneID(id int) *TwoFactorPolicyUpdateOne {
+	mutation := newTwoFactorPolicyMutation(c.config, OpUpdateOne, withTwoFactorPolicyID(id))
+	return &TwoFactorPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TwoFactorPolicy.
+func (c *TwoFactorPolicyClient) Delete() *TwoFactorPolicyDelete {
+	mutation := newTwoFactorPolicyMutation(c.config, OpDelete)
+	return &TwoFactorPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TwoFactorPolicyClient) DeleteOne(tfp *TwoFactorPolicy) *TwoFactorPolicyDeleteOne {
+	return c.DeleteOneID(tfp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TwoFactorPolicyClient) DeleteOneID(id int) *TwoFactorPolicyDeleteOne {
+	builder := c.Delete().Where(twofactorpolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TwoFactorPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for TwoFactorPolicy.
+func (c *TwoFactorPolicyClient) Query() *TwoFactorPolicyQuery {
+	return &TwoFactorPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTwoFactorPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TwoFactorPolicy entity by its id.
+func (c *TwoFactorPolicyClient) Get(ctx context.Context, id int) (*TwoFactorPolicy, error) {
+	return c.Query().Where(twofactorpolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TwoFactorPolicyClient) GetX(ctx context.Context, id int) *TwoFactorPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TwoFactorPolicyClient) Hooks() []Hook {
+	return c.hooks.TwoFactorPolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *TwoFactorPolicyClient) Interceptors() []Interceptor {
+	return c.inters.TwoFactorPolicy
+}
+
+func (c *TwoFactorPolicyClient) mutate(ctx context.Context, m *TwoFactorPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TwoFactorPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TwoFactorPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TwoFactorPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TwoFactorPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TwoFactorPolicy mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -2990,6 +6289,86 @@ func (c *UserClient) QueryPosts(u *User) *PostQuery {
 	return query
 }
 
+// QueryDeviceFilters queries the device_filters edge of a User.
+func (c *UserClient) QueryDeviceFilters(u *User) *DeviceSavedFilterQuery {
+	query := (&DeviceSavedFilterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(devicesavedfilter.Table, devicesavedfilter.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.DeviceFiltersTable, user.DeviceFiltersColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryJobs queries the jobs edge of a User.
+func (c *UserClient) QueryJobs(u *User) *JobQuery {
+	query := (&JobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.JobsTable, user.JobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvitations queries the invitations edge of a User.
+func (c *UserClient) QueryInvitations(u *User) *ProductInvitationQuery {
+	query := (&ProductInvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(productinvitation.Table, productinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.InvitationsTable, user.InvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRegistrationInvitations queries the registration_invitations edge of a User.
+func (c *UserClient) QueryRegistrationInvitations(u *User) *RegistrationInvitationQuery {
+	query := (&RegistrationInvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(registrationinvitation.Table, registrationinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RegistrationInvitationsTable, user.RegistrationInvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPITokens queries the api_tokens edge of a User.
+func (c *UserClient) QueryAPITokens(u *User) *APITokenQuery {
+	query := (&APITokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(apitoken.Table, apitoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.APITokensTable, user.APITokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -3018,13 +6397,19 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AuditLog, Device, FirmwareVersion, LicenseType, LicenseTypeFeatures,
-		MetricEvent, Post, PostCategory, PostTag, PostTagRelation, Product,
-		ProductFeature, ProductManager, SoftwareVersion, User []ent.Hook
+		APIToken, AuditLog, Customer, Device, DeviceAssignment, DeviceFeatureOverride,
+		DeviceGroup, DeviceHeartbeat, DeviceSavedFilter, DeviceTag, FirmwareVersion,
+		Job, LicenseType, LicenseTypeFeatures, Lot, MetricEvent, Order, Post,
+		PostCategory, PostTag, PostTagRelation, Product, ProductFeature,
+		ProductInvitation, ProductManager, RegistrationInvitation, SnAllocator,
+		SnBlock, SnRule, SoftwareVersion, TwoFactorPolicy, User []ent.Hook
 	}
 	inters struct {
-		AuditLog, Device, FirmwareVersion, LicenseType, LicenseTypeFeatures,
-		MetricEvent, Post, PostCategory, PostTag, PostTagRelation, Product,
-		ProductFeature, ProductManager, SoftwareVersion, User []ent.Interceptor
+		APIToken, AuditLog, Customer, Device, DeviceAssignment, DeviceFeatureOverride,
+		DeviceGroup, DeviceHeartbeat, DeviceSavedFilter, DeviceTag, FirmwareVersion,
+		Job, LicenseType, LicenseTypeFeatures, Lot, MetricEvent, Order, Post,
+		PostCategory, PostTag, PostTagRelation, Product, ProductFeature,
+		ProductInvitation, ProductManager, RegistrationInvitation, SnAllocator,
+		SnBlock, SnRule, SoftwareVersion, TwoFactorPolicy, User []ent.Interceptor
 	}
 )
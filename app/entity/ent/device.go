@@ -3,12 +3,16 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/customer"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/lot"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
 	"entgo.io/ent"
@@ -20,6 +24,10 @@ type Device struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 删除标记，正常记录为0，删除后为记录ID，用于唯一索引
+	DeletedID int `json:"deleted_id,omitempty"`
 	// 设备序列号
 	Sn string `json:"sn,omitempty"`
 	// 所属产品ID
@@ -30,6 +38,40 @@ type Device struct {
 	OemTag string `json:"oem_tag,omitempty"`
 	// 备注
 	Remark string `json:"remark,omitempty"`
+	// 生命周期状态
+	State device.State `json:"state,omitempty"`
+	// 最近一次出货时间
+	ShippedAt *time.Time `json:"shipped_at,omitempty"`
+	// 最近一次激活时间
+	ActivatedAt *time.Time `json:"activated_at,omitempty"`
+	// 最近一次停用时间
+	SuspendedAt *time.Time `json:"suspended_at,omitempty"`
+	// 最近一次返修时间
+	RmaAt *time.Time `json:"rma_at,omitempty"`
+	// 报废时间
+	ScrappedAt *time.Time `json:"scrapped_at,omitempty"`
+	// 最后心跳时间
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	// 所属客户ID
+	CustomerID int `json:"customer_id,omitempty"`
+	// 保修开始时间，默认为出货时间
+	WarrantyStartAt *time.Time `json:"warranty_start_at,omitempty"`
+	// 保修截止时间，默认按产品保修期计算
+	WarrantyEndAt *time.Time `json:"warranty_end_at,omitempty"`
+	// 来源订单ID
+	OrderID int `json:"order_id,omitempty"`
+	// 来源生产批次ID
+	LotID int `json:"lot_id,omitempty"`
+	// 自定义属性，按产品的属性Schema校验
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	// 最后上报的软件版本
+	LastSoftwareVersion string `json:"last_software_version,omitempty"`
+	// 最后上报的韧件版本
+	LastFirmwareVersion string `json:"last_firmware_version,omitempty"`
+	// 最后上报的运行时长（秒）
+	LastUptime int64 `json:"last_uptime,omitempty"`
+	// 心跳签名公钥（Ed25519，base64），设备自行生成密钥对，私钥不离开设备
+	HeartbeatPublicKey string `json:"heartbeat_public_key,omitempty"`
 	// 创建时间
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 创建人ID
@@ -54,9 +96,25 @@ type DeviceEdges struct {
 	Creator *User `json:"creator,omitempty"`
 	// Updater holds the value of the updater edge.
 	Updater *User `json:"updater,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*DeviceTag `json:"tags,omitempty"`
+	// Groups holds the value of the groups edge.
+	Groups []*DeviceGroup `json:"groups,omitempty"`
+	// Heartbeats holds the value of the heartbeats edge.
+	Heartbeats []*DeviceHeartbeat `json:"heartbeats,omitempty"`
+	// Customer holds the value of the customer edge.
+	Customer *Customer `json:"customer,omitempty"`
+	// Assignments holds the value of the assignments edge.
+	Assignments []*DeviceAssignment `json:"assignments,omitempty"`
+	// FeatureOverrides holds the value of the feature_overrides edge.
+	FeatureOverrides []*DeviceFeatureOverride `json:"feature_overrides,omitempty"`
+	// Order holds the value of the order edge.
+	Order *Order `json:"order,omitempty"`
+	// Lot holds the value of the lot edge.
+	Lot *Lot `json:"lot,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [12]bool
 }
 
 // ProductOrErr returns the Product value or an error if the edge
@@ -111,16 +169,102 @@ func (e DeviceEdges) UpdaterOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "updater"}
 }
 
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeviceEdges) TagsOrErr() ([]*DeviceTag, error) {
+	if e.loadedTypes[4] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// GroupsOrErr returns the Groups value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeviceEdges) GroupsOrErr() ([]*DeviceGroup, error) {
+	if e.loadedTypes[5] {
+		return e.Groups, nil
+	}
+	return nil, &NotLoadedError{edge: "groups"}
+}
+
+// HeartbeatsOrErr returns the Heartbeats value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeviceEdges) HeartbeatsOrErr() ([]*DeviceHeartbeat, error) {
+	if e.loadedTypes[6] {
+		return e.Heartbeats, nil
+	}
+	return nil, &NotLoadedError{edge: "heartbeats"}
+}
+
+// CustomerOrErr returns the Customer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeviceEdges) CustomerOrErr() (*Customer, error) {
+	if e.loadedTypes[7] {
+		if e.Customer == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: customer.Label}
+		}
+		return e.Customer, nil
+	}
+	return nil, &NotLoadedError{edge: "customer"}
+}
+
+// AssignmentsOrErr returns the Assignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeviceEdges) AssignmentsOrErr() ([]*DeviceAssignment, error) {
+	if e.loadedTypes[8] {
+		return e.Assignments, nil
+	}
+	return nil, &NotLoadedError{edge: "assignments"}
+}
+
+// FeatureOverridesOrErr returns the FeatureOverrides value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeviceEdges) FeatureOverridesOrErr() ([]*DeviceFeatureOverride, error) {
+	if e.loadedTypes[9] {
+		return e.FeatureOverrides, nil
+	}
+	return nil, &NotLoadedError{edge: "feature_overrides"}
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeviceEdges) OrderOrErr() (*Order, error) {
+	if e.loadedTypes[10] {
+		if e.Order == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: order.Label}
+		}
+		return e.Order, nil
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// LotOrErr returns the Lot value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeviceEdges) LotOrErr() (*Lot, error) {
+	if e.loadedTypes[11] {
+		if e.Lot == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: lot.Label}
+		}
+		return e.Lot, nil
+	}
+	return nil, &NotLoadedError{edge: "lot"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Device) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case device.FieldID, device.FieldProductID, device.FieldLicenseTypeID, device.FieldCreatedBy, device.FieldUpdatedBy:
+		case device.FieldAttributes:
+			values[i] = new([]byte)
+		case device.FieldID, device.FieldDeletedID, device.FieldProductID, device.FieldLicenseTypeID, device.FieldCustomerID, device.FieldOrderID, device.FieldLotID, device.FieldLastUptime, device.FieldCreatedBy, device.FieldUpdatedBy:
 			values[i] = new(sql.NullInt64)
-		case device.FieldSn, device.FieldOemTag, device.FieldRemark:
+		case device.FieldSn, device.FieldOemTag, device.FieldRemark, device.FieldState, device.FieldLastSoftwareVersion, device.FieldLastFirmwareVersion, device.FieldHeartbeatPublicKey:
 			values[i] = new(sql.NullString)
-		case device.FieldCreatedAt, device.FieldUpdatedAt:
+		case device.FieldDeletedAt, device.FieldShippedAt, device.FieldActivatedAt, device.FieldSuspendedAt, device.FieldRmaAt, device.FieldScrappedAt, device.FieldLastSeenAt, device.FieldWarrantyStartAt, device.FieldWarrantyEndAt, device.FieldCreatedAt, device.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -143,6 +287,19 @@ func (d *Device) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			d.ID = int(value.Int64)
+		case device.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				d.DeletedAt = new(time.Time)
+				*d.DeletedAt = value.Time
+			}
+		case device.FieldDeletedID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_id", values[i])
+			} else if value.Valid {
+				d.DeletedID = int(value.Int64)
+			}
 		case device.FieldSn:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field sn", values[i])
@@ -173,6 +330,118 @@ func (d *Device) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.Remark = value.String
 			}
+		case device.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				d.State = device.State(value.String)
+			}
+		case device.FieldShippedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field shipped_at", values[i])
+			} else if value.Valid {
+				d.ShippedAt = new(time.Time)
+				*d.ShippedAt = value.Time
+			}
+		case device.FieldActivatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field activated_at", values[i])
+			} else if value.Valid {
+				d.ActivatedAt = new(time.Time)
+				*d.ActivatedAt = value.Time
+			}
+		case device.FieldSuspendedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended_at", values[i])
+			} else if value.Valid {
+				d.SuspendedAt = new(time.Time)
+				*d.SuspendedAt = value.Time
+			}
+		case device.FieldRmaAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rma_at", values[i])
+			} else if value.Valid {
+				d.RmaAt = new(time.Time)
+				*d.RmaAt = value.Time
+			}
+		case device.FieldScrappedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scrapped_at", values[i])
+			} else if value.Valid {
+				d.ScrappedAt = new(time.Time)
+				*d.ScrappedAt = value.Time
+			}
+		case device.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				d.LastSeenAt = new(time.Time)
+				*d.LastSeenAt = value.Time
+			}
+		case device.FieldCustomerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value.Valid {
+				d.CustomerID = int(value.Int64)
+			}
+		case device.FieldWarrantyStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field warranty_start_at", values[i])
+			} else if value.Valid {
+				d.WarrantyStartAt = new(time.Time)
+				*d.WarrantyStartAt = value.Time
+			}
+		case device.FieldWarrantyEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field warranty_end_at", values[i])
+			} else if value.Valid {
+				d.WarrantyEndAt = new(time.Time)
+				*d.WarrantyEndAt = value.Time
+			}
+		case device.FieldOrderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				d.OrderID = int(value.Int64)
+			}
+		case device.FieldLotID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lot_id", values[i])
+			} else if value.Valid {
+				d.LotID = int(value.Int64)
+			}
+		case device.FieldAttributes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attributes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &d.Attributes); err != nil {
+					return fmt.Errorf("unmarshal field attributes: %w", err)
+				}
+			}
+		case device.FieldLastSoftwareVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_software_version", values[i])
+			} else if value.Valid {
+				d.LastSoftwareVersion = value.String
+			}
+		case device.FieldLastFirmwareVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_firmware_version", values[i])
+			} else if value.Valid {
+				d.LastFirmwareVersion = value.String
+			}
+		case device.FieldLastUptime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_uptime", values[i])
+			} else if value.Valid {
+				d.LastUptime = value.Int64
+			}
+		case device.FieldHeartbeatPublicKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field heartbeat_public_key", values[i])
+			} else if value.Valid {
+				d.HeartbeatPublicKey = value.String
+			}
 		case device.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -230,6 +499,46 @@ func (d *Device) QueryUpdater() *UserQuery {
 	return NewDeviceClient(d.config).QueryUpdater(d)
 }
 
+// QueryTags queries the "tags" edge of the Device entity.
+func (d *Device) QueryTags() *DeviceTagQuery {
+	return NewDeviceClient(d.config).QueryTags(d)
+}
+
+// QueryGroups queries the "groups" edge of the Device entity.
+func (d *Device) QueryGroups() *DeviceGroupQuery {
+	return NewDeviceClient(d.config).QueryGroups(d)
+}
+
+// QueryHeartbeats queries the "heartbeats" edge of the Device entity.
+func (d *Device) QueryHeartbeats() *DeviceHeartbeatQuery {
+	return NewDeviceClient(d.config).QueryHeartbeats(d)
+}
+
+// QueryCustomer queries the "customer" edge of the Device entity.
+func (d *Device) QueryCustomer() *CustomerQuery {
+	return NewDeviceClient(d.config).QueryCustomer(d)
+}
+
+// QueryAssignments queries the "assignments" edge of the Device entity.
+func (d *Device) QueryAssignments() *DeviceAssignmentQuery {
+	return NewDeviceClient(d.config).QueryAssignments(d)
+}
+
+// QueryFeatureOverrides queries the "feature_overrides" edge of the Device entity.
+func (d *Device) QueryFeatureOverrides() *DeviceFeatureOverrideQuery {
+	return NewDeviceClient(d.config).QueryFeatureOverrides(d)
+}
+
+// QueryOrder queries the "order" edge of the Device entity.
+func (d *Device) QueryOrder() *OrderQuery {
+	return NewDeviceClient(d.config).QueryOrder(d)
+}
+
+// QueryLot queries the "lot" edge of the Device entity.
+func (d *Device) QueryLot() *LotQuery {
+	return NewDeviceClient(d.config).QueryLot(d)
+}
+
 // Update returns a builder for updating this Device.
 // Note that you need to call Device.Unwrap() before calling this method if this Device
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -253,6 +562,14 @@ func (d *Device) String() string {
 	var builder strings.Builder
 	builder.WriteString("Device(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	if v := d.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deleted_id=")
+	builder.WriteString(fmt.Sprintf("%v", d.DeletedID))
+	builder.WriteString(", ")
 	builder.WriteString("sn=")
 	builder.WriteString(d.Sn)
 	builder.WriteString(", ")
@@ -268,6 +585,73 @@ func (d *Device) String() string {
 	builder.WriteString("remark=")
 	builder.WriteString(d.Remark)
 	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", d.State))
+	builder.WriteString(", ")
+	if v := d.ShippedAt; v != nil {
+		builder.WriteString("shipped_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := d.ActivatedAt; v != nil {
+		builder.WriteString("activated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := d.SuspendedAt; v != nil {
+		builder.WriteString("suspended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := d.RmaAt; v != nil {
+		builder.WriteString("rma_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := d.ScrappedAt; v != nil {
+		builder.WriteString("scrapped_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := d.LastSeenAt; v != nil {
+		builder.WriteString("last_seen_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("customer_id=")
+	builder.WriteString(fmt.Sprintf("%v", d.CustomerID))
+	builder.WriteString(", ")
+	if v := d.WarrantyStartAt; v != nil {
+		builder.WriteString("warranty_start_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := d.WarrantyEndAt; v != nil {
+		builder.WriteString("warranty_end_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", d.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("lot_id=")
+	builder.WriteString(fmt.Sprintf("%v", d.LotID))
+	builder.WriteString(", ")
+	builder.WriteString("attributes=")
+	builder.WriteString(fmt.Sprintf("%v", d.Attributes))
+	builder.WriteString(", ")
+	builder.WriteString("last_software_version=")
+	builder.WriteString(d.LastSoftwareVersion)
+	builder.WriteString(", ")
+	builder.WriteString("last_firmware_version=")
+	builder.WriteString(d.LastFirmwareVersion)
+	builder.WriteString(", ")
+	builder.WriteString("last_uptime=")
+	builder.WriteString(fmt.Sprintf("%v", d.LastUptime))
+	builder.WriteString(", ")
+	builder.WriteString("heartbeat_public_key=")
+	builder.WriteString(d.HeartbeatPublicKey)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(d.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
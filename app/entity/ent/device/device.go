@@ -3,6 +3,9 @@
 package device
 
 import (
+	"fmt"
+
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -12,6 +15,10 @@ const (
 	Label = "device"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldDeletedID holds the string denoting the deleted_id field in the database.
+	FieldDeletedID = "deleted_id"
 	// FieldSn holds the string denoting the sn field in the database.
 	FieldSn = "sn"
 	// FieldProductID holds the string denoting the product_id field in the database.
@@ -22,6 +29,40 @@ const (
 	FieldOemTag = "oem_tag"
 	// FieldRemark holds the string denoting the remark field in the database.
 	FieldRemark = "remark"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldShippedAt holds the string denoting the shipped_at field in the database.
+	FieldShippedAt = "shipped_at"
+	// FieldActivatedAt holds the string denoting the activated_at field in the database.
+	FieldActivatedAt = "activated_at"
+	// FieldSuspendedAt holds the string denoting the suspended_at field in the database.
+	FieldSuspendedAt = "suspended_at"
+	// FieldRmaAt holds the string denoting the rma_at field in the database.
+	FieldRmaAt = "rma_at"
+	// FieldScrappedAt holds the string denoting the scrapped_at field in the database.
+	FieldScrappedAt = "scrapped_at"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// FieldCustomerID holds the string denoting the customer_id field in the database.
+	FieldCustomerID = "customer_id"
+	// FieldWarrantyStartAt holds the string denoting the warranty_start_at field in the database.
+	FieldWarrantyStartAt = "warranty_start_at"
+	// FieldWarrantyEndAt holds the string denoting the warranty_end_at field in the database.
+	FieldWarrantyEndAt = "warranty_end_at"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldLotID holds the string denoting the lot_id field in the database.
+	FieldLotID = "lot_id"
+	// FieldAttributes holds the string denoting the attributes field in the database.
+	FieldAttributes = "attributes"
+	// FieldLastSoftwareVersion holds the string denoting the last_software_version field in the database.
+	FieldLastSoftwareVersion = "last_software_version"
+	// FieldLastFirmwareVersion holds the string denoting the last_firmware_version field in the database.
+	FieldLastFirmwareVersion = "last_firmware_version"
+	// FieldLastUptime holds the string denoting the last_uptime field in the database.
+	FieldLastUptime = "last_uptime"
+	// FieldHeartbeatPublicKey holds the string denoting the heartbeat_public_key field in the database.
+	FieldHeartbeatPublicKey = "heartbeat_public_key"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldCreatedBy holds the string denoting the created_by field in the database.
@@ -38,6 +79,22 @@ const (
 	EdgeCreator = "creator"
 	// EdgeUpdater holds the string denoting the updater edge name in mutations.
 	EdgeUpdater = "updater"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeGroups holds the string denoting the groups edge name in mutations.
+	EdgeGroups = "groups"
+	// EdgeHeartbeats holds the string denoting the heartbeats edge name in mutations.
+	EdgeHeartbeats = "heartbeats"
+	// EdgeCustomer holds the string denoting the customer edge name in mutations.
+	EdgeCustomer = "customer"
+	// EdgeAssignments holds the string denoting the assignments edge name in mutations.
+	EdgeAssignments = "assignments"
+	// EdgeFeatureOverrides holds the string denoting the feature_overrides edge name in mutations.
+	EdgeFeatureOverrides = "feature_overrides"
+	// EdgeOrder holds the string denoting the order edge name in mutations.
+	EdgeOrder = "order"
+	// EdgeLot holds the string denoting the lot edge name in mutations.
+	EdgeLot = "lot"
 	// Table holds the table name of the device in the database.
 	Table = "devices"
 	// ProductTable is the table that holds the product relation/edge.
@@ -68,22 +125,102 @@ const (
 	UpdaterInverseTable = "users"
 	// UpdaterColumn is the table column denoting the updater relation/edge.
 	UpdaterColumn = "updated_by"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "device_tag_relations"
+	// TagsInverseTable is the table name for the DeviceTag entity.
+	// It exists in this package in order to avoid circular dependency with the "devicetag" package.
+	TagsInverseTable = "device_tags"
+	// GroupsTable is the table that holds the groups relation/edge. The primary key declared below.
+	GroupsTable = "device_group_devices"
+	// GroupsInverseTable is the table name for the DeviceGroup entity.
+	// It exists in this package in order to avoid circular dependency with the "devicegroup" package.
+	GroupsInverseTable = "device_groups"
+	// HeartbeatsTable is the table that holds the heartbeats relation/edge.
+	HeartbeatsTable = "device_heartbeats"
+	// HeartbeatsInverseTable is the table name for the DeviceHeartbeat entity.
+	// It exists in this package in order to avoid circular dependency with the "deviceheartbeat" package.
+	HeartbeatsInverseTable = "device_heartbeats"
+	// HeartbeatsColumn is the table column denoting the heartbeats relation/edge.
+	HeartbeatsColumn = "device_id"
+	// CustomerTable is the table that holds the customer relation/edge.
+	CustomerTable = "devices"
+	// CustomerInverseTable is the table name for the Customer entity.
+	// It exists in this package in order to avoid circular dependency with the "customer" package.
+	CustomerInverseTable = "customers"
+	// CustomerColumn is the table column denoting the customer relation/edge.
+	CustomerColumn = "customer_id"
+	// AssignmentsTable is the table that holds the assignments relation/edge.
+	AssignmentsTable = "device_assignments"
+	// AssignmentsInverseTable is the table name for the DeviceAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "deviceassignment" package.
+	AssignmentsInverseTable = "device_assignments"
+	// AssignmentsColumn is the table column denoting the assignments relation/edge.
+	AssignmentsColumn = "device_id"
+	// FeatureOverridesTable is the table that holds the feature_overrides relation/edge.
+	FeatureOverridesTable = "device_feature_overrides"
+	// FeatureOverridesInverseTable is the table name for the DeviceFeatureOverride entity.
+	// It exists in this package in order to avoid circular dependency with the "devicefeatureoverride" package.
+	FeatureOverridesInverseTable = "device_feature_overrides"
+	// FeatureOverridesColumn is the table column denoting the feature_overrides relation/edge.
+	FeatureOverridesColumn = "device_id"
+	// OrderTable is the table that holds the order relation/edge.
+	OrderTable = "devices"
+	// OrderInverseTable is the table name for the Order entity.
+	// It exists in this package in order to avoid circular dependency with the "order" package.
+	OrderInverseTable = "orders"
+	// OrderColumn is the table column denoting the order relation/edge.
+	OrderColumn = "order_id"
+	// LotTable is the table that holds the lot relation/edge.
+	LotTable = "devices"
+	// LotInverseTable is the table name for the Lot entity.
+	// It exists in this package in order to avoid circular dependency with the "lot" package.
+	LotInverseTable = "lots"
+	// LotColumn is the table column denoting the lot relation/edge.
+	LotColumn = "lot_id"
 )
 
 // Columns holds all SQL columns for device fields.
 var Columns = []string{
 	FieldID,
+	FieldDeletedAt,
+	FieldDeletedID,
 	FieldSn,
 	FieldProductID,
 	FieldLicenseTypeID,
 	FieldOemTag,
 	FieldRemark,
+	FieldState,
+	FieldShippedAt,
+	FieldActivatedAt,
+	FieldSuspendedAt,
+	FieldRmaAt,
+	FieldScrappedAt,
+	FieldLastSeenAt,
+	FieldCustomerID,
+	FieldWarrantyStartAt,
+	FieldWarrantyEndAt,
+	FieldOrderID,
+	FieldLotID,
+	FieldAttributes,
+	FieldLastSoftwareVersion,
+	FieldLastFirmwareVersion,
+	FieldLastUptime,
+	FieldHeartbeatPublicKey,
 	FieldCreatedAt,
 	FieldCreatedBy,
 	FieldUpdatedAt,
 	FieldUpdatedBy,
 }
 
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"device_id", "device_tag_id"}
+	// GroupsPrimaryKey and GroupsColumn2 are the table columns denoting the
+	// primary key for the groups relation (M2M).
+	GroupsPrimaryKey = []string{"device_group_id", "device_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -94,13 +231,61 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "cambridge-hit.com/gin-base/activateserver/app/entity/ent/runtime"
 var (
+	Hooks        [2]ent.Hook
+	Interceptors [2]ent.Interceptor
+	Policy       ent.Policy
+	// DefaultDeletedID holds the default value on creation for the "deleted_id" field.
+	DefaultDeletedID int
 	// DefaultOemTag holds the default value on creation for the "oem_tag" field.
 	DefaultOemTag string
 	// DefaultRemark holds the default value on creation for the "remark" field.
 	DefaultRemark string
+	// DefaultLastSoftwareVersion holds the default value on creation for the "last_software_version" field.
+	DefaultLastSoftwareVersion string
+	// DefaultLastFirmwareVersion holds the default value on creation for the "last_firmware_version" field.
+	DefaultLastFirmwareVersion string
+	// DefaultLastUptime holds the default value on creation for the "last_uptime" field.
+	DefaultLastUptime int64
+	// DefaultHeartbeatPublicKey holds the default value on creation for the "heartbeat_public_key" field.
+	DefaultHeartbeatPublicKey string
+)
+
+// State defines the type for the "state" enum field.
+type State string
+
+// StateManufactured is the default value of the State enum.
+const DefaultState = StateManufactured
+
+// State values.
+const (
+	StateManufactured State = "manufactured"
+	StateShipped      State = "shipped"
+	StateActivated    State = "activated"
+	StateSuspended    State = "suspended"
+	StateRma          State = "rma"
+	StateScrapped     State = "scrapped"
 )
 
+func (s State) String() string {
+	return string(s)
+}
+
+// StateValidator is a validator for the "state" field enum values. It is called by the builders before save.
+func StateValidator(s State) error {
+	switch s {
+	case StateManufactured, StateShipped, StateActivated, StateSuspended, StateRma, StateScrapped:
+		return nil
+	default:
+		return fmt.Errorf("device: invalid enum value for state field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Device queries.
 type OrderOption func(*sql.Selector)
 
@@ -109,6 +294,16 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByDeletedID orders the results by the deleted_id field.
+func ByDeletedID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedID, opts...).ToFunc()
+}
+
 // BySn orders the results by the sn field.
 func BySn(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSn, opts...).ToFunc()
@@ -134,6 +329,86 @@ func ByRemark(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRemark, opts...).ToFunc()
 }
 
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByShippedAt orders the results by the shipped_at field.
+func ByShippedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShippedAt, opts...).ToFunc()
+}
+
+// ByActivatedAt orders the results by the activated_at field.
+func ByActivatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActivatedAt, opts...).ToFunc()
+}
+
+// BySuspendedAt orders the results by the suspended_at field.
+func BySuspendedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuspendedAt, opts...).ToFunc()
+}
+
+// ByRmaAt orders the results by the rma_at field.
+func ByRmaAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRmaAt, opts...).ToFunc()
+}
+
+// ByScrappedAt orders the results by the scrapped_at field.
+func ByScrappedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScrappedAt, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
+// ByCustomerID orders the results by the customer_id field.
+func ByCustomerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCustomerID, opts...).ToFunc()
+}
+
+// ByWarrantyStartAt orders the results by the warranty_start_at field.
+func ByWarrantyStartAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWarrantyStartAt, opts...).ToFunc()
+}
+
+// ByWarrantyEndAt orders the results by the warranty_end_at field.
+func ByWarrantyEndAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWarrantyEndAt, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByLotID orders the results by the lot_id field.
+func ByLotID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLotID, opts...).ToFunc()
+}
+
+// ByLastSoftwareVersion orders the results by the last_software_version field.
+func ByLastSoftwareVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSoftwareVersion, opts...).ToFunc()
+}
+
+// ByLastFirmwareVersion orders the results by the last_firmware_version field.
+func ByLastFirmwareVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastFirmwareVersion, opts...).ToFunc()
+}
+
+// ByLastUptime orders the results by the last_uptime field.
+func ByLastUptime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUptime, opts...).ToFunc()
+}
+
+// ByHeartbeatPublicKey orders the results by the heartbeat_public_key field.
+func ByHeartbeatPublicKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeartbeatPublicKey, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -181,6 +456,97 @@ func ByUpdaterField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUpdaterStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGroupsCount orders the results by groups count.
+func ByGroupsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGroupsStep(), opts...)
+	}
+}
+
+// ByGroups orders the results by groups terms.
+func ByGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHeartbeatsCount orders the results by heartbeats count.
+func ByHeartbeatsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHeartbeatsStep(), opts...)
+	}
+}
+
+// ByHeartbeats orders the results by heartbeats terms.
+func ByHeartbeats(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHeartbeatsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCustomerField orders the results by customer field.
+func ByCustomerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCustomerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAssignmentsCount orders the results by assignments count.
+func ByAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAssignmentsStep(), opts...)
+	}
+}
+
+// ByAssignments orders the results by assignments terms.
+func ByAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFeatureOverridesCount orders the results by feature_overrides count.
+func ByFeatureOverridesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFeatureOverridesStep(), opts...)
+	}
+}
+
+// ByFeatureOverrides orders the results by feature_overrides terms.
+func ByFeatureOverrides(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFeatureOverridesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOrderField orders the results by order field.
+func ByOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLotField orders the results by lot field.
+func ByLotField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLotStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newProductStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -209,3 +575,59 @@ func newUpdaterStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, UpdaterTable, UpdaterColumn),
 	)
 }
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
+	)
+}
+func newGroupsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, GroupsTable, GroupsPrimaryKey...),
+	)
+}
+func newHeartbeatsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HeartbeatsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HeartbeatsTable, HeartbeatsColumn),
+	)
+}
+func newCustomerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CustomerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CustomerTable, CustomerColumn),
+	)
+}
+func newAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AssignmentsTable, AssignmentsColumn),
+	)
+}
+func newFeatureOverridesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FeatureOverridesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FeatureOverridesTable, FeatureOverridesColumn),
+	)
+}
+func newOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrderTable, OrderColumn),
+	)
+}
+func newLotStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LotInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LotTable, LotColumn),
+	)
+}
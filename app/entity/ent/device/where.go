@@ -55,6 +55,16 @@ func IDLTE(id int) predicate.Device {
 	return predicate.Device(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedID applies equality check predicate on the "deleted_id" field. It's identical to DeletedIDEQ.
+func DeletedID(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDeletedID, v))
+}
+
 // Sn applies equality check predicate on the "sn" field. It's identical to SnEQ.
 func Sn(v string) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldSn, v))
@@ -80,6 +90,81 @@ func Remark(v string) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldRemark, v))
 }
 
+// ShippedAt applies equality check predicate on the "shipped_at" field. It's identical to ShippedAtEQ.
+func ShippedAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldShippedAt, v))
+}
+
+// ActivatedAt applies equality check predicate on the "activated_at" field. It's identical to ActivatedAtEQ.
+func ActivatedAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldActivatedAt, v))
+}
+
+// SuspendedAt applies equality check predicate on the "suspended_at" field. It's identical to SuspendedAtEQ.
+func SuspendedAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldSuspendedAt, v))
+}
+
+// RmaAt applies equality check predicate on the "rma_at" field. It's identical to RmaAtEQ.
+func RmaAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldRmaAt, v))
+}
+
+// ScrappedAt applies equality check predicate on the "scrapped_at" field. It's identical to ScrappedAtEQ.
+func ScrappedAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldScrappedAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// WarrantyStartAt applies equality check predicate on the "warranty_start_at" field. It's identical to WarrantyStartAtEQ.
+func WarrantyStartAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldWarrantyStartAt, v))
+}
+
+// WarrantyEndAt applies equality check predicate on the "warranty_end_at" field. It's identical to WarrantyEndAtEQ.
+func WarrantyEndAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldWarrantyEndAt, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldOrderID, v))
+}
+
+// LotID applies equality check predicate on the "lot_id" field. It's identical to LotIDEQ.
+func LotID(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLotID, v))
+}
+
+// LastSoftwareVersion applies equality check predicate on the "last_software_version" field. It's identical to LastSoftwareVersionEQ.
+func LastSoftwareVersion(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastSoftwareVersion, v))
+}
+
+// LastFirmwareVersion applies equality check predicate on the "last_firmware_version" field. It's identical to LastFirmwareVersionEQ.
+func LastFirmwareVersion(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastFirmwareVersion, v))
+}
+
+// LastUptime applies equality check predicate on the "last_uptime" field. It's identical to LastUptimeEQ.
+func LastUptime(v int64) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastUptime, v))
+}
+
+// HeartbeatPublicKey applies equality check predicate on the "heartbeat_public_key" field. It's identical to HeartbeatPublicKeyEQ.
+func HeartbeatPublicKey(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldHeartbeatPublicKey, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldCreatedAt, v))
@@ -100,6 +185,96 @@ func UpdatedBy(v int) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldUpdatedBy, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DeletedIDEQ applies the EQ predicate on the "deleted_id" field.
+func DeletedIDEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDeletedID, v))
+}
+
+// DeletedIDNEQ applies the NEQ predicate on the "deleted_id" field.
+func DeletedIDNEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldDeletedID, v))
+}
+
+// DeletedIDIn applies the In predicate on the "deleted_id" field.
+func DeletedIDIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldDeletedID, vs...))
+}
+
+// DeletedIDNotIn applies the NotIn predicate on the "deleted_id" field.
+func DeletedIDNotIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldDeletedID, vs...))
+}
+
+// DeletedIDGT applies the GT predicate on the "deleted_id" field.
+func DeletedIDGT(v int) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldDeletedID, v))
+}
+
+// DeletedIDGTE applies the GTE predicate on the "deleted_id" field.
+func DeletedIDGTE(v int) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldDeletedID, v))
+}
+
+// DeletedIDLT applies the LT predicate on the "deleted_id" field.
+func DeletedIDLT(v int) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldDeletedID, v))
+}
+
+// DeletedIDLTE applies the LTE predicate on the "deleted_id" field.
+func DeletedIDLTE(v int) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldDeletedID, v))
+}
+
 // SnEQ applies the EQ predicate on the "sn" field.
 func SnEQ(v string) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldSn, v))
@@ -365,230 +540,1209 @@ func RemarkContainsFold(v string) predicate.Device {
 	return predicate.Device(sql.FieldContainsFold(FieldRemark, v))
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.Device {
-	return predicate.Device(sql.FieldEQ(FieldCreatedAt, v))
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v State) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldState, v))
 }
 
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.Device {
-	return predicate.Device(sql.FieldNEQ(FieldCreatedAt, v))
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v State) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldState, v))
 }
 
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.Device {
-	return predicate.Device(sql.FieldIn(FieldCreatedAt, vs...))
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...State) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldState, vs...))
 }
 
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.Device {
-	return predicate.Device(sql.FieldNotIn(FieldCreatedAt, vs...))
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...State) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldState, vs...))
 }
 
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.Device {
-	return predicate.Device(sql.FieldGT(FieldCreatedAt, v))
+// ShippedAtEQ applies the EQ predicate on the "shipped_at" field.
+func ShippedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldShippedAt, v))
 }
 
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.Device {
-	return predicate.Device(sql.FieldGTE(FieldCreatedAt, v))
+// ShippedAtNEQ applies the NEQ predicate on the "shipped_at" field.
+func ShippedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldShippedAt, v))
 }
 
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.Device {
-	return predicate.Device(sql.FieldLT(FieldCreatedAt, v))
+// ShippedAtIn applies the In predicate on the "shipped_at" field.
+func ShippedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldShippedAt, vs...))
 }
 
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.Device {
-	return predicate.Device(sql.FieldLTE(FieldCreatedAt, v))
+// ShippedAtNotIn applies the NotIn predicate on the "shipped_at" field.
+func ShippedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldShippedAt, vs...))
 }
 
-// CreatedByEQ applies the EQ predicate on the "created_by" field.
-func CreatedByEQ(v int) predicate.Device {
-	return predicate.Device(sql.FieldEQ(FieldCreatedBy, v))
+// ShippedAtGT applies the GT predicate on the "shipped_at" field.
+func ShippedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldShippedAt, v))
 }
 
-// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
-func CreatedByNEQ(v int) predicate.Device {
-	return predicate.Device(sql.FieldNEQ(FieldCreatedBy, v))
+// ShippedAtGTE applies the GTE predicate on the "shipped_at" field.
+func ShippedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldShippedAt, v))
 }
 
-// CreatedByIn applies the In predicate on the "created_by" field.
-func CreatedByIn(vs ...int) predicate.Device {
-	return predicate.Device(sql.FieldIn(FieldCreatedBy, vs...))
+// ShippedAtLT applies the LT predicate on the "shipped_at" field.
+func ShippedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldShippedAt, v))
 }
 
-// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
-func CreatedByNotIn(vs ...int) predicate.Device {
-	return predicate.Device(sql.FieldNotIn(FieldCreatedBy, vs...))
+// ShippedAtLTE applies the LTE predicate on the "shipped_at" field.
+func ShippedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldShippedAt, v))
 }
 
-// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
-func CreatedByIsNil() predicate.Device {
-	return predicate.Device(sql.FieldIsNull(FieldCreatedBy))
+// ShippedAtIsNil applies the IsNil predicate on the "shipped_at" field.
+func ShippedAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldShippedAt))
 }
 
-// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
-func CreatedByNotNil() predicate.Device {
-	return predicate.Device(sql.FieldNotNull(FieldCreatedBy))
+// ShippedAtNotNil applies the NotNil predicate on the "shipped_at" field.
+func ShippedAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldShippedAt))
 }
 
-// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
-func UpdatedAtEQ(v time.Time) predicate.Device {
-	return predicate.Device(sql.FieldEQ(FieldUpdatedAt, v))
+// ActivatedAtEQ applies the EQ predicate on the "activated_at" field.
+func ActivatedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldActivatedAt, v))
 }
 
-// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
-func UpdatedAtNEQ(v time.Time) predicate.Device {
-	return predicate.Device(sql.FieldNEQ(FieldUpdatedAt, v))
+// ActivatedAtNEQ applies the NEQ predicate on the "activated_at" field.
+func ActivatedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldActivatedAt, v))
 }
 
-// UpdatedAtIn applies the In predicate on the "updated_at" field.
-func UpdatedAtIn(vs ...time.Time) predicate.Device {
-	return predicate.Device(sql.FieldIn(FieldUpdatedAt, vs...))
+// ActivatedAtIn applies the In predicate on the "activated_at" field.
+func ActivatedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldActivatedAt, vs...))
 }
 
-// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
-func UpdatedAtNotIn(vs ...time.Time) predicate.Device {
-	return predicate.Device(sql.FieldNotIn(FieldUpdatedAt, vs...))
+// ActivatedAtNotIn applies the NotIn predicate on the "activated_at" field.
+func ActivatedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldActivatedAt, vs...))
 }
 
-// UpdatedAtGT applies the GT predicate on the "updated_at" field.
-func UpdatedAtGT(v time.Time) predicate.Device {
-	return predicate.Device(sql.FieldGT(FieldUpdatedAt, v))
+// ActivatedAtGT applies the GT predicate on the "activated_at" field.
+func ActivatedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldActivatedAt, v))
 }
 
-// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
-func UpdatedAtGTE(v time.Time) predicate.Device {
-	return predicate.Device(sql.FieldGTE(FieldUpdatedAt, v))
+// ActivatedAtGTE applies the GTE predicate on the "activated_at" field.
+func ActivatedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldActivatedAt, v))
 }
 
-// UpdatedAtLT applies the LT predicate on the "updated_at" field.
-func UpdatedAtLT(v time.Time) predicate.Device {
-	return predicate.Device(sql.FieldLT(FieldUpdatedAt, v))
+// ActivatedAtLT applies the LT predicate on the "activated_at" field.
+func ActivatedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldActivatedAt, v))
 }
 
-// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
-func UpdatedAtLTE(v time.Time) predicate.Device {
-	return predicate.Device(sql.FieldLTE(FieldUpdatedAt, v))
+// ActivatedAtLTE applies the LTE predicate on the "activated_at" field.
+func ActivatedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldActivatedAt, v))
 }
 
-// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
-func UpdatedByEQ(v int) predicate.Device {
-	return predicate.Device(sql.FieldEQ(FieldUpdatedBy, v))
+// ActivatedAtIsNil applies the IsNil predicate on the "activated_at" field.
+func ActivatedAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldActivatedAt))
 }
 
-// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
-func UpdatedByNEQ(v int) predicate.Device {
-	return predicate.Device(sql.FieldNEQ(FieldUpdatedBy, v))
+// ActivatedAtNotNil applies the NotNil predicate on the "activated_at" field.
+func ActivatedAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldActivatedAt))
 }
 
-// UpdatedByIn applies the In predicate on the "updated_by" field.
-func UpdatedByIn(vs ...int) predicate.Device {
-	return predicate.Device(sql.FieldIn(FieldUpdatedBy, vs...))
+// SuspendedAtEQ applies the EQ predicate on the "suspended_at" field.
+func SuspendedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldSuspendedAt, v))
 }
 
-// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
-func UpdatedByNotIn(vs ...int) predicate.Device {
-	return predicate.Device(sql.FieldNotIn(FieldUpdatedBy, vs...))
+// SuspendedAtNEQ applies the NEQ predicate on the "suspended_at" field.
+func SuspendedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldSuspendedAt, v))
 }
 
-// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
-func UpdatedByIsNil() predicate.Device {
-	return predicate.Device(sql.FieldIsNull(FieldUpdatedBy))
+// SuspendedAtIn applies the In predicate on the "suspended_at" field.
+func SuspendedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldSuspendedAt, vs...))
 }
 
-// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
-func UpdatedByNotNil() predicate.Device {
-	return predicate.Device(sql.FieldNotNull(FieldUpdatedBy))
+// SuspendedAtNotIn applies the NotIn predicate on the "suspended_at" field.
+func SuspendedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldSuspendedAt, vs...))
 }
 
-// HasProduct applies the HasEdge predicate on the "product" edge.
-func HasProduct() predicate.Device {
-	return predicate.Device(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
-	})
+// SuspendedAtGT applies the GT predicate on the "suspended_at" field.
+func SuspendedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldSuspendedAt, v))
 }
 
-// HasProductWith applies the HasEdge predicate on the "product" edge with a given conditions (other predicates).
-func HasProductWith(preds ...predicate.Product) predicate.Device {
-	return predicate.Device(func(s *sql.Selector) {
-		step := newProductStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
-			for _, p := range preds {
-				p(s)
-			}
-		})
-	})
+// SuspendedAtGTE applies the GTE predicate on the "suspended_at" field.
+func SuspendedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldSuspendedAt, v))
 }
 
-// HasLicenseType applies the HasEdge predicate on the "license_type" edge.
-func HasLicenseType() predicate.Device {
-	return predicate.Device(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, LicenseTypeTable, LicenseTypeColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
-	})
+// SuspendedAtLT applies the LT predicate on the "suspended_at" field.
+func SuspendedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldSuspendedAt, v))
 }
 
-// HasLicenseTypeWith applies the HasEdge predicate on the "license_type" edge with a given conditions (other predicates).
-func HasLicenseTypeWith(preds ...predicate.LicenseType) predicate.Device {
-	return predicate.Device(func(s *sql.Selector) {
-		step := newLicenseTypeStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
-			for _, p := range preds {
-				p(s)
-			}
-		})
-	})
+// SuspendedAtLTE applies the LTE predicate on the "suspended_at" field.
+func SuspendedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldSuspendedAt, v))
 }
 
-// HasCreator applies the HasEdge predicate on the "creator" edge.
-func HasCreator() predicate.Device {
-	return predicate.Device(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, CreatorTable, CreatorColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
-	})
+// SuspendedAtIsNil applies the IsNil predicate on the "suspended_at" field.
+func SuspendedAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldSuspendedAt))
 }
 
-// HasCreatorWith applies the HasEdge predicate on the "creator" edge with a given conditions (other predicates).
-func HasCreatorWith(preds ...predicate.User) predicate.Device {
-	return predicate.Device(func(s *sql.Selector) {
-		step := newCreatorStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
-			for _, p := range preds {
-				p(s)
-			}
-		})
-	})
+// SuspendedAtNotNil applies the NotNil predicate on the "suspended_at" field.
+func SuspendedAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldSuspendedAt))
 }
 
-// HasUpdater applies the HasEdge predicate on the "updater" edge.
-func HasUpdater() predicate.Device {
-	return predicate.Device(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, UpdaterTable, UpdaterColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
-	})
+// RmaAtEQ applies the EQ predicate on the "rma_at" field.
+func RmaAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldRmaAt, v))
 }
 
-// HasUpdaterWith applies the HasEdge predicate on the "updater" edge with a given conditions (other predicates).
-func HasUpdaterWith(preds ...predicate.User) predicate.Device {
-	return predicate.Device(func(s *sql.Selector) {
-		step := newUpdaterStep()
+// RmaAtNEQ applies the NEQ predicate on the "rma_at" field.
+func RmaAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldRmaAt, v))
+}
+
+// RmaAtIn applies the In predicate on the "rma_at" field.
+func RmaAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldRmaAt, vs...))
+}
+
+// RmaAtNotIn applies the NotIn predicate on the "rma_at" field.
+func RmaAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldRmaAt, vs...))
+}
+
+// RmaAtGT applies the GT predicate on the "rma_at" field.
+func RmaAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldRmaAt, v))
+}
+
+// RmaAtGTE applies the GTE predicate on the "rma_at" field.
+func RmaAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldRmaAt, v))
+}
+
+// RmaAtLT applies the LT predicate on the "rma_at" field.
+func RmaAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldRmaAt, v))
+}
+
+// RmaAtLTE applies the LTE predicate on the "rma_at" field.
+func RmaAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldRmaAt, v))
+}
+
+// RmaAtIsNil applies the IsNil predicate on the "rma_at" field.
+func RmaAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldRmaAt))
+}
+
+// RmaAtNotNil applies the NotNil predicate on the "rma_at" field.
+func RmaAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldRmaAt))
+}
+
+// ScrappedAtEQ applies the EQ predicate on the "scrapped_at" field.
+func ScrappedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldScrappedAt, v))
+}
+
+// ScrappedAtNEQ applies the NEQ predicate on the "scrapped_at" field.
+func ScrappedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldScrappedAt, v))
+}
+
+// ScrappedAtIn applies the In predicate on the "scrapped_at" field.
+func ScrappedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldScrappedAt, vs...))
+}
+
+// ScrappedAtNotIn applies the NotIn predicate on the "scrapped_at" field.
+func ScrappedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldScrappedAt, vs...))
+}
+
+// ScrappedAtGT applies the GT predicate on the "scrapped_at" field.
+func ScrappedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldScrappedAt, v))
+}
+
+// ScrappedAtGTE applies the GTE predicate on the "scrapped_at" field.
+func ScrappedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldScrappedAt, v))
+}
+
+// ScrappedAtLT applies the LT predicate on the "scrapped_at" field.
+func ScrappedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldScrappedAt, v))
+}
+
+// ScrappedAtLTE applies the LTE predicate on the "scrapped_at" field.
+func ScrappedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldScrappedAt, v))
+}
+
+// ScrappedAtIsNil applies the IsNil predicate on the "scrapped_at" field.
+func ScrappedAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldScrappedAt))
+}
+
+// ScrappedAtNotNil applies the NotNil predicate on the "scrapped_at" field.
+func ScrappedAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldScrappedAt))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIsNil applies the IsNil predicate on the "last_seen_at" field.
+func LastSeenAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldLastSeenAt))
+}
+
+// LastSeenAtNotNil applies the NotNil predicate on the "last_seen_at" field.
+func LastSeenAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldLastSeenAt))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDIsNil applies the IsNil predicate on the "customer_id" field.
+func CustomerIDIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldCustomerID))
+}
+
+// CustomerIDNotNil applies the NotNil predicate on the "customer_id" field.
+func CustomerIDNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldCustomerID))
+}
+
+// WarrantyStartAtEQ applies the EQ predicate on the "warranty_start_at" field.
+func WarrantyStartAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldWarrantyStartAt, v))
+}
+
+// WarrantyStartAtNEQ applies the NEQ predicate on the "warranty_start_at" field.
+func WarrantyStartAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldWarrantyStartAt, v))
+}
+
+// WarrantyStartAtIn applies the In predicate on the "warranty_start_at" field.
+func WarrantyStartAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldWarrantyStartAt, vs...))
+}
+
+// WarrantyStartAtNotIn applies the NotIn predicate on the "warranty_start_at" field.
+func WarrantyStartAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldWarrantyStartAt, vs...))
+}
+
+// WarrantyStartAtGT applies the GT predicate on the "warranty_start_at" field.
+func WarrantyStartAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldWarrantyStartAt, v))
+}
+
+// WarrantyStartAtGTE applies the GTE predicate on the "warranty_start_at" field.
+func WarrantyStartAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldWarrantyStartAt, v))
+}
+
+// WarrantyStartAtLT applies the LT predicate on the "warranty_start_at" field.
+func WarrantyStartAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldWarrantyStartAt, v))
+}
+
+// WarrantyStartAtLTE applies the LTE predicate on the "warranty_start_at" field.
+func WarrantyStartAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldWarrantyStartAt, v))
+}
+
+// WarrantyStartAtIsNil applies the IsNil predicate on the "warranty_start_at" field.
+func WarrantyStartAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldWarrantyStartAt))
+}
+
+// WarrantyStartAtNotNil applies the NotNil predicate on the "warranty_start_at" field.
+func WarrantyStartAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldWarrantyStartAt))
+}
+
+// WarrantyEndAtEQ applies the EQ predicate on the "warranty_end_at" field.
+func WarrantyEndAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldWarrantyEndAt, v))
+}
+
+// WarrantyEndAtNEQ applies the NEQ predicate on the "warranty_end_at" field.
+func WarrantyEndAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldWarrantyEndAt, v))
+}
+
+// WarrantyEndAtIn applies the In predicate on the "warranty_end_at" field.
+func WarrantyEndAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldWarrantyEndAt, vs...))
+}
+
+// WarrantyEndAtNotIn applies the NotIn predicate on the "warranty_end_at" field.
+func WarrantyEndAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldWarrantyEndAt, vs...))
+}
+
+// WarrantyEndAtGT applies the GT predicate on the "warranty_end_at" field.
+func WarrantyEndAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldWarrantyEndAt, v))
+}
+
+// WarrantyEndAtGTE applies the GTE predicate on the "warranty_end_at" field.
+func WarrantyEndAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldWarrantyEndAt, v))
+}
+
+// WarrantyEndAtLT applies the LT predicate on the "warranty_end_at" field.
+func WarrantyEndAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldWarrantyEndAt, v))
+}
+
+// WarrantyEndAtLTE applies the LTE predicate on the "warranty_end_at" field.
+func WarrantyEndAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldWarrantyEndAt, v))
+}
+
+// WarrantyEndAtIsNil applies the IsNil predicate on the "warranty_end_at" field.
+func WarrantyEndAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldWarrantyEndAt))
+}
+
+// WarrantyEndAtNotNil applies the NotNil predicate on the "warranty_end_at" field.
+func WarrantyEndAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldWarrantyEndAt))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDIsNil applies the IsNil predicate on the "order_id" field.
+func OrderIDIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldOrderID))
+}
+
+// OrderIDNotNil applies the NotNil predicate on the "order_id" field.
+func OrderIDNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldOrderID))
+}
+
+// LotIDEQ applies the EQ predicate on the "lot_id" field.
+func LotIDEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLotID, v))
+}
+
+// LotIDNEQ applies the NEQ predicate on the "lot_id" field.
+func LotIDNEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLotID, v))
+}
+
+// LotIDIn applies the In predicate on the "lot_id" field.
+func LotIDIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldLotID, vs...))
+}
+
+// LotIDNotIn applies the NotIn predicate on the "lot_id" field.
+func LotIDNotIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldLotID, vs...))
+}
+
+// LotIDIsNil applies the IsNil predicate on the "lot_id" field.
+func LotIDIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldLotID))
+}
+
+// LotIDNotNil applies the NotNil predicate on the "lot_id" field.
+func LotIDNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldLotID))
+}
+
+// AttributesIsNil applies the IsNil predicate on the "attributes" field.
+func AttributesIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldAttributes))
+}
+
+// AttributesNotNil applies the NotNil predicate on the "attributes" field.
+func AttributesNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldAttributes))
+}
+
+// LastSoftwareVersionEQ applies the EQ predicate on the "last_software_version" field.
+func LastSoftwareVersionEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastSoftwareVersion, v))
+}
+
+// LastSoftwareVersionNEQ applies the NEQ predicate on the "last_software_version" field.
+func LastSoftwareVersionNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLastSoftwareVersion, v))
+}
+
+// LastSoftwareVersionIn applies the In predicate on the "last_software_version" field.
+func LastSoftwareVersionIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldLastSoftwareVersion, vs...))
+}
+
+// LastSoftwareVersionNotIn applies the NotIn predicate on the "last_software_version" field.
+func LastSoftwareVersionNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldLastSoftwareVersion, vs...))
+}
+
+// LastSoftwareVersionGT applies the GT predicate on the "last_software_version" field.
+func LastSoftwareVersionGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldLastSoftwareVersion, v))
+}
+
+// LastSoftwareVersionGTE applies the GTE predicate on the "last_software_version" field.
+func LastSoftwareVersionGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldLastSoftwareVersion, v))
+}
+
+// LastSoftwareVersionLT applies the LT predicate on the "last_software_version" field.
+func LastSoftwareVersionLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldLastSoftwareVersion, v))
+}
+
+// LastSoftwareVersionLTE applies the LTE predicate on the "last_software_version" field.
+func LastSoftwareVersionLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldLastSoftwareVersion, v))
+}
+
+// LastSoftwareVersionContains applies the Contains predicate on the "last_software_version" field.
+func LastSoftwareVersionContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldLastSoftwareVersion, v))
+}
+
+// LastSoftwareVersionHasPrefix applies the HasPrefix predicate on the "last_software_version" field.
+func LastSoftwareVersionHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldLastSoftwareVersion, v))
+}
+
+// LastSoftwareVersionHasSuffix applies the HasSuffix predicate on the "last_software_version" field.
+func LastSoftwareVersionHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldLastSoftwareVersion, v))
+}
+
+// LastSoftwareVersionIsNil applies the IsNil predicate on the "last_software_version" field.
+func LastSoftwareVersionIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldLastSoftwareVersion))
+}
+
+// LastSoftwareVersionNotNil applies the NotNil predicate on the "last_software_version" field.
+func LastSoftwareVersionNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldLastSoftwareVersion))
+}
+
+// LastSoftwareVersionEqualFold applies the EqualFold predicate on the "last_software_version" field.
+func LastSoftwareVersionEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldLastSoftwareVersion, v))
+}
+
+// LastSoftwareVersionContainsFold applies the ContainsFold predicate on the "last_software_version" field.
+func LastSoftwareVersionContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldLastSoftwareVersion, v))
+}
+
+// LastFirmwareVersionEQ applies the EQ predicate on the "last_firmware_version" field.
+func LastFirmwareVersionEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastFirmwareVersion, v))
+}
+
+// LastFirmwareVersionNEQ applies the NEQ predicate on the "last_firmware_version" field.
+func LastFirmwareVersionNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLastFirmwareVersion, v))
+}
+
+// LastFirmwareVersionIn applies the In predicate on the "last_firmware_version" field.
+func LastFirmwareVersionIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldLastFirmwareVersion, vs...))
+}
+
+// LastFirmwareVersionNotIn applies the NotIn predicate on the "last_firmware_version" field.
+func LastFirmwareVersionNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldLastFirmwareVersion, vs...))
+}
+
+// LastFirmwareVersionGT applies the GT predicate on the "last_firmware_version" field.
+func LastFirmwareVersionGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldLastFirmwareVersion, v))
+}
+
+// LastFirmwareVersionGTE applies the GTE predicate on the "last_firmware_version" field.
+func LastFirmwareVersionGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldLastFirmwareVersion, v))
+}
+
+// LastFirmwareVersionLT applies the LT predicate on the "last_firmware_version" field.
+func LastFirmwareVersionLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldLastFirmwareVersion, v))
+}
+
+// LastFirmwareVersionLTE applies the LTE predicate on the "last_firmware_version" field.
+func LastFirmwareVersionLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldLastFirmwareVersion, v))
+}
+
+// LastFirmwareVersionContains applies the Contains predicate on the "last_firmware_version" field.
+func LastFirmwareVersionContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldLastFirmwareVersion, v))
+}
+
+// LastFirmwareVersionHasPrefix applies the HasPrefix predicate on the "last_firmware_version" field.
+func LastFirmwareVersionHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldLastFirmwareVersion, v))
+}
+
+// LastFirmwareVersionHasSuffix applies the HasSuffix predicate on the "last_firmware_version" field.
+func LastFirmwareVersionHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldLastFirmwareVersion, v))
+}
+
+// LastFirmwareVersionIsNil applies the IsNil predicate on the "last_firmware_version" field.
+func LastFirmwareVersionIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldLastFirmwareVersion))
+}
+
+// LastFirmwareVersionNotNil applies the NotNil predicate on the "last_firmware_version" field.
+func LastFirmwareVersionNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldLastFirmwareVersion))
+}
+
+// LastFirmwareVersionEqualFold applies the EqualFold predicate on the "last_firmware_version" field.
+func LastFirmwareVersionEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldLastFirmwareVersion, v))
+}
+
+// LastFirmwareVersionContainsFold applies the ContainsFold predicate on the "last_firmware_version" field.
+func LastFirmwareVersionContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldLastFirmwareVersion, v))
+}
+
+// LastUptimeEQ applies the EQ predicate on the "last_uptime" field.
+func LastUptimeEQ(v int64) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastUptime, v))
+}
+
+// LastUptimeNEQ applies the NEQ predicate on the "last_uptime" field.
+func LastUptimeNEQ(v int64) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLastUptime, v))
+}
+
+// LastUptimeIn applies the In predicate on the "last_uptime" field.
+func LastUptimeIn(vs ...int64) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldLastUptime, vs...))
+}
+
+// LastUptimeNotIn applies the NotIn predicate on the "last_uptime" field.
+func LastUptimeNotIn(vs ...int64) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldLastUptime, vs...))
+}
+
+// LastUptimeGT applies the GT predicate on the "last_uptime" field.
+func LastUptimeGT(v int64) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldLastUptime, v))
+}
+
+// LastUptimeGTE applies the GTE predicate on the "last_uptime" field.
+func LastUptimeGTE(v int64) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldLastUptime, v))
+}
+
+// LastUptimeLT applies the LT predicate on the "last_uptime" field.
+func LastUptimeLT(v int64) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldLastUptime, v))
+}
+
+// LastUptimeLTE applies the LTE predicate on the "last_uptime" field.
+func LastUptimeLTE(v int64) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldLastUptime, v))
+}
+
+// LastUptimeIsNil applies the IsNil predicate on the "last_uptime" field.
+func LastUptimeIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldLastUptime))
+}
+
+// LastUptimeNotNil applies the NotNil predicate on the "last_uptime" field.
+func LastUptimeNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldLastUptime))
+}
+
+// HeartbeatPublicKeyEQ applies the EQ predicate on the "heartbeat_public_key" field.
+func HeartbeatPublicKeyEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldHeartbeatPublicKey, v))
+}
+
+// HeartbeatPublicKeyNEQ applies the NEQ predicate on the "heartbeat_public_key" field.
+func HeartbeatPublicKeyNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldHeartbeatPublicKey, v))
+}
+
+// HeartbeatPublicKeyIn applies the In predicate on the "heartbeat_public_key" field.
+func HeartbeatPublicKeyIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldHeartbeatPublicKey, vs...))
+}
+
+// HeartbeatPublicKeyNotIn applies the NotIn predicate on the "heartbeat_public_key" field.
+func HeartbeatPublicKeyNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldHeartbeatPublicKey, vs...))
+}
+
+// HeartbeatPublicKeyGT applies the GT predicate on the "heartbeat_public_key" field.
+func HeartbeatPublicKeyGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldHeartbeatPublicKey, v))
+}
+
+// HeartbeatPublicKeyGTE applies the GTE predicate on the "heartbeat_public_key" field.
+func HeartbeatPublicKeyGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldHeartbeatPublicKey, v))
+}
+
+// HeartbeatPublicKeyLT applies the LT predicate on the "heartbeat_public_key" field.
+func HeartbeatPublicKeyLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldHeartbeatPublicKey, v))
+}
+
+// HeartbeatPublicKeyLTE applies the LTE predicate on the "heartbeat_public_key" field.
+func HeartbeatPublicKeyLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldHeartbeatPublicKey, v))
+}
+
+// HeartbeatPublicKeyContains applies the Contains predicate on the "heartbeat_public_key" field.
+func HeartbeatPublicKeyContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldHeartbeatPublicKey, v))
+}
+
+// HeartbeatPublicKeyHasPrefix applies the HasPrefix predicate on the "heartbeat_public_key" field.
+func HeartbeatPublicKeyHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldHeartbeatPublicKey, v))
+}
+
+// HeartbeatPublicKeyHasSuffix applies the HasSuffix predicate on the "heartbeat_public_key" field.
+func HeartbeatPublicKeyHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldHeartbeatPublicKey, v))
+}
+
+// HeartbeatPublicKeyIsNil applies the IsNil predicate on the "heartbeat_public_key" field.
+func HeartbeatPublicKeyIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldHeartbeatPublicKey))
+}
+
+// HeartbeatPublicKeyNotNil applies the NotNil predicate on the "heartbeat_public_key" field.
+func HeartbeatPublicKeyNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldHeartbeatPublicKey))
+}
+
+// HeartbeatPublicKeyEqualFold applies the EqualFold predicate on the "heartbeat_public_key" field.
+func HeartbeatPublicKeyEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldHeartbeatPublicKey, v))
+}
+
+// HeartbeatPublicKeyContainsFold applies the ContainsFold predicate on the "heartbeat_public_key" field.
+func HeartbeatPublicKeyContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldHeartbeatPublicKey, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// HasProduct applies the HasEdge predicate on the "product" edge.
+func HasProduct() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProductWith applies the HasEdge predicate on the "product" edge with a given conditions (other predicates).
+func HasProductWith(preds ...predicate.Product) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newProductStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLicenseType applies the HasEdge predicate on the "license_type" edge.
+func HasLicenseType() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LicenseTypeTable, LicenseTypeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLicenseTypeWith applies the HasEdge predicate on the "license_type" edge with a given conditions (other predicates).
+func HasLicenseTypeWith(preds ...predicate.LicenseType) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newLicenseTypeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCreator applies the HasEdge predicate on the "creator" edge.
+func HasCreator() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CreatorTable, CreatorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreatorWith applies the HasEdge predicate on the "creator" edge with a given conditions (other predicates).
+func HasCreatorWith(preds ...predicate.User) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newCreatorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUpdater applies the HasEdge predicate on the "updater" edge.
+func HasUpdater() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UpdaterTable, UpdaterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUpdaterWith applies the HasEdge predicate on the "updater" edge with a given conditions (other predicates).
+func HasUpdaterWith(preds ...predicate.User) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newUpdaterStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.DeviceTag) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGroups applies the HasEdge predicate on the "groups" edge.
+func HasGroups() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, GroupsTable, GroupsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupsWith applies the HasEdge predicate on the "groups" edge with a given conditions (other predicates).
+func HasGroupsWith(preds ...predicate.DeviceGroup) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newGroupsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHeartbeats applies the HasEdge predicate on the "heartbeats" edge.
+func HasHeartbeats() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HeartbeatsTable, HeartbeatsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHeartbeatsWith applies the HasEdge predicate on the "heartbeats" edge with a given conditions (other predicates).
+func HasHeartbeatsWith(preds ...predicate.DeviceHeartbeat) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newHeartbeatsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCustomer applies the HasEdge predicate on the "customer" edge.
+func HasCustomer() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CustomerTable, CustomerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCustomerWith applies the HasEdge predicate on the "customer" edge with a given conditions (other predicates).
+func HasCustomerWith(preds ...predicate.Customer) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newCustomerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAssignments applies the HasEdge predicate on the "assignments" edge.
+func HasAssignments() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AssignmentsTable, AssignmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssignmentsWith applies the HasEdge predicate on the "assignments" edge with a given conditions (other predicates).
+func HasAssignmentsWith(preds ...predicate.DeviceAssignment) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newAssignmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFeatureOverrides applies the HasEdge predicate on the "feature_overrides" edge.
+func HasFeatureOverrides() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FeatureOverridesTable, FeatureOverridesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFeatureOverridesWith applies the HasEdge predicate on the "feature_overrides" edge with a given conditions (other predicates).
+func HasFeatureOverridesWith(preds ...predicate.DeviceFeatureOverride) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newFeatureOverridesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrder applies the HasEdge predicate on the "order" edge.
+func HasOrder() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrderTable, OrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderWith applies the HasEdge predicate on the "order" edge with a given conditions (other predicates).
+func HasOrderWith(preds ...predicate.Order) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLot applies the HasEdge predicate on the "lot" edge.
+func HasLot() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LotTable, LotColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLotWith applies the HasEdge predicate on the "lot" edge with a given conditions (other predicates).
+func HasLotWith(preds ...predicate.Lot) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newLotStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
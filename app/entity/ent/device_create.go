@@ -8,8 +8,16 @@ import (
 	"fmt"
 	"time"
 
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/customer"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/deviceassignment"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicefeatureoverride"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicegroup"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/deviceheartbeat"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicetag"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/lot"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -23,6 +31,34 @@ type DeviceCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (dc *DeviceCreate) SetDeletedAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetDeletedAt(t)
+	return dc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableDeletedAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetDeletedAt(*t)
+	}
+	return dc
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (dc *DeviceCreate) SetDeletedID(i int) *DeviceCreate {
+	dc.mutation.SetDeletedID(i)
+	return dc
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableDeletedID(i *int) *DeviceCreate {
+	if i != nil {
+		dc.SetDeletedID(*i)
+	}
+	return dc
+}
+
 // SetSn sets the "sn" field.
 func (dc *DeviceCreate) SetSn(s string) *DeviceCreate {
 	dc.mutation.SetSn(s)
@@ -77,6 +113,236 @@ func (dc *DeviceCreate) SetNillableRemark(s *string) *DeviceCreate {
 	return dc
 }
 
+// SetState sets the "state" field.
+func (dc *DeviceCreate) SetState(d device.State) *DeviceCreate {
+	dc.mutation.SetState(d)
+	return dc
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableState(d *device.State) *DeviceCreate {
+	if d != nil {
+		dc.SetState(*d)
+	}
+	return dc
+}
+
+// SetShippedAt sets the "shipped_at" field.
+func (dc *DeviceCreate) SetShippedAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetShippedAt(t)
+	return dc
+}
+
+// SetNillableShippedAt sets the "shipped_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableShippedAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetShippedAt(*t)
+	}
+	return dc
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (dc *DeviceCreate) SetActivatedAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetActivatedAt(t)
+	return dc
+}
+
+// SetNillableActivatedAt sets the "activated_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableActivatedAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetActivatedAt(*t)
+	}
+	return dc
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (dc *DeviceCreate) SetSuspendedAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetSuspendedAt(t)
+	return dc
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableSuspendedAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetSuspendedAt(*t)
+	}
+	return dc
+}
+
+// SetRmaAt sets the "rma_at" field.
+func (dc *DeviceCreate) SetRmaAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetRmaAt(t)
+	return dc
+}
+
+// SetNillableRmaAt sets the "rma_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableRmaAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetRmaAt(*t)
+	}
+	return dc
+}
+
+// SetScrappedAt sets the "scrapped_at" field.
+func (dc *DeviceCreate) SetScrappedAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetScrappedAt(t)
+	return dc
+}
+
+// SetNillableScrappedAt sets the "scrapped_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableScrappedAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetScrappedAt(*t)
+	}
+	return dc
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (dc *DeviceCreate) SetLastSeenAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetLastSeenAt(t)
+	return dc
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableLastSeenAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetLastSeenAt(*t)
+	}
+	return dc
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (dc *DeviceCreate) SetCustomerID(i int) *DeviceCreate {
+	dc.mutation.SetCustomerID(i)
+	return dc
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableCustomerID(i *int) *DeviceCreate {
+	if i != nil {
+		dc.SetCustomerID(*i)
+	}
+	return dc
+}
+
+// SetWarrantyStartAt sets the "warranty_start_at" field.
+func (dc *DeviceCreate) SetWarrantyStartAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetWarrantyStartAt(t)
+	return dc
+}
+
+// SetNillableWarrantyStartAt sets the "warranty_start_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableWarrantyStartAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetWarrantyStartAt(*t)
+	}
+	return dc
+}
+
+// SetWarrantyEndAt sets the "warranty_end_at" field.
+func (dc *DeviceCreate) SetWarrantyEndAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetWarrantyEndAt(t)
+	return dc
+}
+
+// SetNillableWarrantyEndAt sets the "warranty_end_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableWarrantyEndAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetWarrantyEndAt(*t)
+	}
+	return dc
+}
+
+// SetOrderID sets the "order_id" field.
+func (dc *DeviceCreate) SetOrderID(i int) *DeviceCreate {
+	dc.mutation.SetOrderID(i)
+	return dc
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableOrderID(i *int) *DeviceCreate {
+	if i != nil {
+		dc.SetOrderID(*i)
+	}
+	return dc
+}
+
+// SetLotID sets the "lot_id" field.
+func (dc *DeviceCreate) SetLotID(i int) *DeviceCreate {
+	dc.mutation.SetLotID(i)
+	return dc
+}
+
+// SetNillableLotID sets the "lot_id" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableLotID(i *int) *DeviceCreate {
+	if i != nil {
+		dc.SetLotID(*i)
+	}
+	return dc
+}
+
+// SetAttributes sets the "attributes" field.
+func (dc *DeviceCreate) SetAttributes(m map[string]interface{}) *DeviceCreate {
+	dc.mutation.SetAttributes(m)
+	return dc
+}
+
+// SetLastSoftwareVersion sets the "last_software_version" field.
+func (dc *DeviceCreate) SetLastSoftwareVersion(s string) *DeviceCreate {
+	dc.mutation.SetLastSoftwareVersion(s)
+	return dc
+}
+
+// SetNillableLastSoftwareVersion sets the "last_software_version" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableLastSoftwareVersion(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetLastSoftwareVersion(*s)
+	}
+	return dc
+}
+
+// SetLastFirmwareVersion sets the "last_firmware_version" field.
+func (dc *DeviceCreate) SetLastFirmwareVersion(s string) *DeviceCreate {
+	dc.mutation.SetLastFirmwareVersion(s)
+	return dc
+}
+
+// SetNillableLastFirmwareVersion sets the "last_firmware_version" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableLastFirmwareVersion(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetLastFirmwareVersion(*s)
+	}
+	return dc
+}
+
+// SetLastUptime sets the "last_uptime" field.
+func (dc *DeviceCreate) SetLastUptime(i int64) *DeviceCreate {
+	dc.mutation.SetLastUptime(i)
+	return dc
+}
+
+// SetNillableLastUptime sets the "last_uptime" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableLastUptime(i *int64) *DeviceCreate {
+	if i != nil {
+		dc.SetLastUptime(*i)
+	}
+	return dc
+}
+
+// SetHeartbeatPublicKey sets the "heartbeat_public_key" field.
+func (dc *DeviceCreate) SetHeartbeatPublicKey(s string) *DeviceCreate {
+	dc.mutation.SetHeartbeatPublicKey(s)
+	return dc
+}
+
+// SetNillableHeartbeatPublicKey sets the "heartbeat_public_key" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableHeartbeatPublicKey(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetHeartbeatPublicKey(*s)
+	}
+	return dc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (dc *DeviceCreate) SetCreatedAt(t time.Time) *DeviceCreate {
 	dc.mutation.SetCreatedAt(t)
@@ -171,6 +437,96 @@ func (dc *DeviceCreate) SetUpdater(u *User) *DeviceCreate {
 	return dc.SetUpdaterID(u.ID)
 }
 
+// AddTagIDs adds the "tags" edge to the DeviceTag entity by IDs.
+func (dc *DeviceCreate) AddTagIDs(ids ...int) *DeviceCreate {
+	dc.mutation.AddTagIDs(ids...)
+	return dc
+}
+
+// AddTags adds the "tags" edges to the DeviceTag entity.
+func (dc *DeviceCreate) AddTags(d ...*DeviceTag) *DeviceCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return dc.AddTagIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the DeviceGroup entity by IDs.
+func (dc *DeviceCreate) AddGroupIDs(ids ...int) *DeviceCreate {
+	dc.mutation.AddGroupIDs(ids...)
+	return dc
+}
+
+// AddGroups adds the "groups" edges to the DeviceGroup entity.
+func (dc *DeviceCreate) AddGroups(d ...*DeviceGroup) *DeviceCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return dc.AddGroupIDs(ids...)
+}
+
+// AddHeartbeatIDs adds the "heartbeats" edge to the DeviceHeartbeat entity by IDs.
+func (dc *DeviceCreate) AddHeartbeatIDs(ids ...int) *DeviceCreate {
+	dc.mutation.AddHeartbeatIDs(ids...)
+	return dc
+}
+
+// AddHeartbeats adds the "heartbeats" edges to the DeviceHeartbeat entity.
+func (dc *DeviceCreate) AddHeartbeats(d ...*DeviceHeartbeat) *DeviceCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return dc.AddHeartbeatIDs(ids...)
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (dc *DeviceCreate) SetCustomer(c *Customer) *DeviceCreate {
+	return dc.SetCustomerID(c.ID)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the DeviceAssignment entity by IDs.
+func (dc *DeviceCreate) AddAssignmentIDs(ids ...int) *DeviceCreate {
+	dc.mutation.AddAssignmentIDs(ids...)
+	return dc
+}
+
+// AddAssignments adds the "assignments" edges to the DeviceAssignment entity.
+func (dc *DeviceCreate) AddAssignments(d ...*DeviceAssignment) *DeviceCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return dc.AddAssignmentIDs(ids...)
+}
+
+// AddFeatureOverrideIDs adds the "feature_overrides" edge to the DeviceFeatureOverride entity by IDs.
+func (dc *DeviceCreate) AddFeatureOverrideIDs(ids ...int) *DeviceCreate {
+	dc.mutation.AddFeatureOverrideIDs(ids...)
+	return dc
+}
+
+// AddFeatureOverrides adds the "feature_overrides" edges to the DeviceFeatureOverride entity.
+func (dc *DeviceCreate) AddFeatureOverrides(d ...*DeviceFeatureOverride) *DeviceCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return dc.AddFeatureOverrideIDs(ids...)
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (dc *DeviceCreate) SetOrder(o *Order) *DeviceCreate {
+	return dc.SetOrderID(o.ID)
+}
+
+// SetLot sets the "lot" edge to the Lot entity.
+func (dc *DeviceCreate) SetLot(l *Lot) *DeviceCreate {
+	return dc.SetLotID(l.ID)
+}
+
 // Mutation returns the DeviceMutation object of the builder.
 func (dc *DeviceCreate) Mutation() *DeviceMutation {
 	return dc.mutation
@@ -178,7 +534,9 @@ func (dc *DeviceCreate) Mutation() *DeviceMutation {
 
 // Save creates the Device in the database.
 func (dc *DeviceCreate) Save(ctx context.Context) (*Device, error) {
-	dc.defaults()
+	if err := dc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
 }
 
@@ -205,7 +563,11 @@ func (dc *DeviceCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (dc *DeviceCreate) defaults() {
+func (dc *DeviceCreate) defaults() error {
+	if _, ok := dc.mutation.DeletedID(); !ok {
+		v := device.DefaultDeletedID
+		dc.mutation.SetDeletedID(v)
+	}
 	if _, ok := dc.mutation.OemTag(); !ok {
 		v := device.DefaultOemTag
 		dc.mutation.SetOemTag(v)
@@ -214,16 +576,48 @@ func (dc *DeviceCreate) defaults() {
 		v := device.DefaultRemark
 		dc.mutation.SetRemark(v)
 	}
+	if _, ok := dc.mutation.State(); !ok {
+		v := device.DefaultState
+		dc.mutation.SetState(v)
+	}
+	if _, ok := dc.mutation.LastSoftwareVersion(); !ok {
+		v := device.DefaultLastSoftwareVersion
+		dc.mutation.SetLastSoftwareVersion(v)
+	}
+	if _, ok := dc.mutation.LastFirmwareVersion(); !ok {
+		v := device.DefaultLastFirmwareVersion
+		dc.mutation.SetLastFirmwareVersion(v)
+	}
+	if _, ok := dc.mutation.LastUptime(); !ok {
+		v := device.DefaultLastUptime
+		dc.mutation.SetLastUptime(v)
+	}
+	if _, ok := dc.mutation.HeartbeatPublicKey(); !ok {
+		v := device.DefaultHeartbeatPublicKey
+		dc.mutation.SetHeartbeatPublicKey(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (dc *DeviceCreate) check() error {
+	if _, ok := dc.mutation.DeletedID(); !ok {
+		return &ValidationError{Name: "deleted_id", err: errors.New(`ent: missing required field "Device.deleted_id"`)}
+	}
 	if _, ok := dc.mutation.Sn(); !ok {
 		return &ValidationError{Name: "sn", err: errors.New(`ent: missing required field "Device.sn"`)}
 	}
 	if _, ok := dc.mutation.ProductID(); !ok {
 		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "Device.product_id"`)}
 	}
+	if _, ok := dc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "Device.state"`)}
+	}
+	if v, ok := dc.mutation.State(); ok {
+		if err := device.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Device.state": %w`, err)}
+		}
+	}
 	if _, ok := dc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Device.created_at"`)}
 	}
@@ -265,6 +659,14 @@ func (dc *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := dc.mutation.DeletedAt(); ok {
+		_spec.SetField(device.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := dc.mutation.DeletedID(); ok {
+		_spec.SetField(device.FieldDeletedID, field.TypeInt, value)
+		_node.DeletedID = value
+	}
 	if value, ok := dc.mutation.Sn(); ok {
 		_spec.SetField(device.FieldSn, field.TypeString, value)
 		_node.Sn = value
@@ -277,6 +679,62 @@ func (dc *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
 		_spec.SetField(device.FieldRemark, field.TypeString, value)
 		_node.Remark = value
 	}
+	if value, ok := dc.mutation.State(); ok {
+		_spec.SetField(device.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	if value, ok := dc.mutation.ShippedAt(); ok {
+		_spec.SetField(device.FieldShippedAt, field.TypeTime, value)
+		_node.ShippedAt = &value
+	}
+	if value, ok := dc.mutation.ActivatedAt(); ok {
+		_spec.SetField(device.FieldActivatedAt, field.TypeTime, value)
+		_node.ActivatedAt = &value
+	}
+	if value, ok := dc.mutation.SuspendedAt(); ok {
+		_spec.SetField(device.FieldSuspendedAt, field.TypeTime, value)
+		_node.SuspendedAt = &value
+	}
+	if value, ok := dc.mutation.RmaAt(); ok {
+		_spec.SetField(device.FieldRmaAt, field.TypeTime, value)
+		_node.RmaAt = &value
+	}
+	if value, ok := dc.mutation.ScrappedAt(); ok {
+		_spec.SetField(device.FieldScrappedAt, field.TypeTime, value)
+		_node.ScrappedAt = &value
+	}
+	if value, ok := dc.mutation.LastSeenAt(); ok {
+		_spec.SetField(device.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = &value
+	}
+	if value, ok := dc.mutation.WarrantyStartAt(); ok {
+		_spec.SetField(device.FieldWarrantyStartAt, field.TypeTime, value)
+		_node.WarrantyStartAt = &value
+	}
+	if value, ok := dc.mutation.WarrantyEndAt(); ok {
+		_spec.SetField(device.FieldWarrantyEndAt, field.TypeTime, value)
+		_node.WarrantyEndAt = &value
+	}
+	if value, ok := dc.mutation.Attributes(); ok {
+		_spec.SetField(device.FieldAttributes, field.TypeJSON, value)
+		_node.Attributes = value
+	}
+	if value, ok := dc.mutation.LastSoftwareVersion(); ok {
+		_spec.SetField(device.FieldLastSoftwareVersion, field.TypeString, value)
+		_node.LastSoftwareVersion = value
+	}
+	if value, ok := dc.mutation.LastFirmwareVersion(); ok {
+		_spec.SetField(device.FieldLastFirmwareVersion, field.TypeString, value)
+		_node.LastFirmwareVersion = value
+	}
+	if value, ok := dc.mutation.LastUptime(); ok {
+		_spec.SetField(device.FieldLastUptime, field.TypeInt64, value)
+		_node.LastUptime = value
+	}
+	if value, ok := dc.mutation.HeartbeatPublicKey(); ok {
+		_spec.SetField(device.FieldHeartbeatPublicKey, field.TypeString, value)
+		_node.HeartbeatPublicKey = value
+	}
 	if value, ok := dc.mutation.CreatedAt(); ok {
 		_spec.SetField(device.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -353,6 +811,137 @@ func (dc *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
 		_node.UpdatedBy = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := dc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   device.TagsTable,
+			Columns: device.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   device.GroupsTable,
+			Columns: device.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.HeartbeatsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.HeartbeatsTable,
+			Columns: []string{device.HeartbeatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceheartbeat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.CustomerTable,
+			Columns: []string{device.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CustomerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.AssignmentsTable,
+			Columns: []string{device.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceassignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.FeatureOverridesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.FeatureOverridesTable,
+			Columns: []string{device.FeatureOverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicefeatureoverride.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.OrderTable,
+			Columns: []string{device.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.LotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.LotTable,
+			Columns: []string{device.LotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LotID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
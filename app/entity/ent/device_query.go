@@ -4,14 +4,25 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/customer"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/deviceassignment"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicefeatureoverride"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicegroup"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/deviceheartbeat"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicetag"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/lot"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -20,14 +31,23 @@ import (
 // DeviceQuery is the builder for querying Device entities.
 type DeviceQuery struct {
 	config
-	ctx             *QueryContext
-	order           []device.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.Device
-	withProduct     *ProductQuery
-	withLicenseType *LicenseTypeQuery
-	withCreator     *UserQuery
-	withUpdater     *UserQuery
+	ctx                  *QueryContext
+	order                []device.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.Device
+	withProduct          *ProductQuery
+	withLicenseType      *LicenseTypeQuery
+	withCreator          *UserQuery
+	withUpdater          *UserQuery
+	withTags             *DeviceTagQuery
+	withGroups           *DeviceGroupQuery
+	withHeartbeats       *DeviceHeartbeatQuery
+	withCustomer         *CustomerQuery
+	withAssignments      *DeviceAssignmentQuery
+	withFeatureOverrides *DeviceFeatureOverrideQuery
+	withOrder            *OrderQuery
+	withLot              *LotQuery
+	modifiers            []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -152,6 +172,182 @@ func (dq *DeviceQuery) QueryUpdater() *UserQuery {
 	return query
 }
 
+// QueryTags chains the current query on the "tags" edge.
+func (dq *DeviceQuery) QueryTags() *DeviceTagQuery {
+	query := (&DeviceTagClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(devicetag.Table, devicetag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, device.TagsTable, device.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroups chains the current query on the "groups" edge.
+func (dq *DeviceQuery) QueryGroups() *DeviceGroupQuery {
+	query := (&DeviceGroupClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(devicegroup.Table, devicegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, device.GroupsTable, device.GroupsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHeartbeats chains the current query on the "heartbeats" edge.
+func (dq *DeviceQuery) QueryHeartbeats() *DeviceHeartbeatQuery {
+	query := (&DeviceHeartbeatClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(deviceheartbeat.Table, deviceheartbeat.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, device.HeartbeatsTable, device.HeartbeatsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCustomer chains the current query on the "customer" edge.
+func (dq *DeviceQuery) QueryCustomer() *CustomerQuery {
+	query := (&CustomerClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(customer.Table, customer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, device.CustomerTable, device.CustomerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAssignments chains the current query on the "assignments" edge.
+func (dq *DeviceQuery) QueryAssignments() *DeviceAssignmentQuery {
+	query := (&DeviceAssignmentClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(deviceassignment.Table, deviceassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, device.AssignmentsTable, device.AssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFeatureOverrides chains the current query on the "feature_overrides" edge.
+func (dq *DeviceQuery) QueryFeatureOverrides() *DeviceFeatureOverrideQuery {
+	query := (&DeviceFeatureOverrideClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(devicefeatureoverride.Table, devicefeatureoverride.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, device.FeatureOverridesTable, device.FeatureOverridesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOrder chains the current query on the "order" edge.
+func (dq *DeviceQuery) QueryOrder() *OrderQuery {
+	query := (&OrderClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, device.OrderTable, device.OrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLot chains the current query on the "lot" edge.
+func (dq *DeviceQuery) QueryLot() *LotQuery {
+	query := (&LotClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(lot.Table, lot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, device.LotTable, device.LotColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Device entity from the query.
 // Returns a *NotFoundError when no Device was found.
 func (dq *DeviceQuery) First(ctx context.Context) (*Device, error) {
@@ -339,15 +535,23 @@ func (dq *DeviceQuery) Clone() *DeviceQuery {
 		return nil
 	}
 	return &DeviceQuery{
-		config:          dq.config,
-		ctx:             dq.ctx.Clone(),
-		order:           append([]device.OrderOption{}, dq.order...),
-		inters:          append([]Interceptor{}, dq.inters...),
-		predicates:      append([]predicate.Device{}, dq.predicates...),
-		withProduct:     dq.withProduct.Clone(),
-		withLicenseType: dq.withLicenseType.Clone(),
-		withCreator:     dq.withCreator.Clone(),
-		withUpdater:     dq.withUpdater.Clone(),
+		config:               dq.config,
+		ctx:                  dq.ctx.Clone(),
+		order:                append([]device.OrderOption{}, dq.order...),
+		inters:               append([]Interceptor{}, dq.inters...),
+		predicates:           append([]predicate.Device{}, dq.predicates...),
+		withProduct:          dq.withProduct.Clone(),
+		withLicenseType:      dq.withLicenseType.Clone(),
+		withCreator:          dq.withCreator.Clone(),
+		withUpdater:          dq.withUpdater.Clone(),
+		withTags:             dq.withTags.Clone(),
+		withGroups:           dq.withGroups.Clone(),
+		withHeartbeats:       dq.withHeartbeats.Clone(),
+		withCustomer:         dq.withCustomer.Clone(),
+		withAssignments:      dq.withAssignments.Clone(),
+		withFeatureOverrides: dq.withFeatureOverrides.Clone(),
+		withOrder:            dq.withOrder.Clone(),
+		withLot:              dq.withLot.Clone(),
 		// clone intermediate query.
 		sql:  dq.sql.Clone(),
 		path: dq.path,
@@ -398,18 +602,106 @@ func (dq *DeviceQuery) WithUpdater(opts ...func(*UserQuery)) *DeviceQuery {
 	return dq
 }
 
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithTags(opts ...func(*DeviceTagQuery)) *DeviceQuery {
+	query := (&DeviceTagClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withTags = query
+	return dq
+}
+
+// WithGroups tells the query-builder to eager-load the nodes that are connected to
+// the "groups" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithGroups(opts ...func(*DeviceGroupQuery)) *DeviceQuery {
+	query := (&DeviceGroupClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withGroups = query
+	return dq
+}
+
+// WithHeartbeats tells the query-builder to eager-load the nodes that are connected to
+// the "heartbeats" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithHeartbeats(opts ...func(*DeviceHeartbeatQuery)) *DeviceQuery {
+	query := (&DeviceHeartbeatClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withHeartbeats = query
+	return dq
+}
+
+// WithCustomer tells the query-builder to eager-load the nodes that are connected to
+// the "customer" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithCustomer(opts ...func(*CustomerQuery)) *DeviceQuery {
+	query := (&CustomerClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withCustomer = query
+	return dq
+}
+
+// WithAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithAssignments(opts ...func(*DeviceAssignmentQuery)) *DeviceQuery {
+	query := (&DeviceAssignmentClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withAssignments = query
+	return dq
+}
+
+// WithFeatureOverrides tells the query-builder to eager-load the nodes that are connected to
+// the "feature_overrides" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithFeatureOverrides(opts ...func(*DeviceFeatureOverrideQuery)) *DeviceQuery {
+	query := (&DeviceFeatureOverrideClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withFeatureOverrides = query
+	return dq
+}
+
+// WithOrder tells the query-builder to eager-load the nodes that are connected to
+// the "order" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithOrder(opts ...func(*OrderQuery)) *DeviceQuery {
+	query := (&OrderClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withOrder = query
+	return dq
+}
+
+// WithLot tells the query-builder to eager-load the nodes that are connected to
+// the "lot" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithLot(opts ...func(*LotQuery)) *DeviceQuery {
+	query := (&LotClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withLot = query
+	return dq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Sn string `json:"sn,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Device.Query().
-//		GroupBy(device.FieldSn).
+//		GroupBy(device.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (dq *DeviceQuery) GroupBy(field string, fields ...string) *DeviceGroupBy {
@@ -427,11 +719,11 @@ func (dq *DeviceQuery) GroupBy(field string, fields ...string) *DeviceGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Sn string `json:"sn,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Device.Query().
-//		Select(device.FieldSn).
+//		Select(device.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (dq *DeviceQuery) Select(fields ...string) *DeviceSelect {
 	dq.ctx.Fields = append(dq.ctx.Fields, fields...)
@@ -469,6 +761,12 @@ func (dq *DeviceQuery) prepareQuery(ctx context.Context) error {
 		}
 		dq.sql = prev
 	}
+	if device.Policy == nil {
+		return errors.New("ent: uninitialized device.Policy (forgotten import ent/runtime?)")
+	}
+	if err := device.Policy.EvalQuery(ctx, dq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -476,11 +774,19 @@ func (dq *DeviceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Devic
 	var (
 		nodes       = []*Device{}
 		_spec       = dq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [12]bool{
 			dq.withProduct != nil,
 			dq.withLicenseType != nil,
 			dq.withCreator != nil,
 			dq.withUpdater != nil,
+			dq.withTags != nil,
+			dq.withGroups != nil,
+			dq.withHeartbeats != nil,
+			dq.withCustomer != nil,
+			dq.withAssignments != nil,
+			dq.withFeatureOverrides != nil,
+			dq.withOrder != nil,
+			dq.withLot != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -492,6 +798,9 @@ func (dq *DeviceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Devic
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(dq.modifiers) > 0 {
+		_spec.Modifiers = dq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -525,6 +834,61 @@ func (dq *DeviceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Devic
 			return nil, err
 		}
 	}
+	if query := dq.withTags; query != nil {
+		if err := dq.loadTags(ctx, query, nodes,
+			func(n *Device) { n.Edges.Tags = []*DeviceTag{} },
+			func(n *Device, e *DeviceTag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withGroups; query != nil {
+		if err := dq.loadGroups(ctx, query, nodes,
+			func(n *Device) { n.Edges.Groups = []*DeviceGroup{} },
+			func(n *Device, e *DeviceGroup) { n.Edges.Groups = append(n.Edges.Groups, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withHeartbeats; query != nil {
+		if err := dq.loadHeartbeats(ctx, query, nodes,
+			func(n *Device) { n.Edges.Heartbeats = []*DeviceHeartbeat{} },
+			func(n *Device, e *DeviceHeartbeat) { n.Edges.Heartbeats = append(n.Edges.Heartbeats, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withCustomer; query != nil {
+		if err := dq.loadCustomer(ctx, query, nodes, nil,
+			func(n *Device, e *Customer) { n.Edges.Customer = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withAssignments; query != nil {
+		if err := dq.loadAssignments(ctx, query, nodes,
+			func(n *Device) { n.Edges.Assignments = []*DeviceAssignment{} },
+			func(n *Device, e *DeviceAssignment) { n.Edges.Assignments = append(n.Edges.Assignments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withFeatureOverrides; query != nil {
+		if err := dq.loadFeatureOverrides(ctx, query, nodes,
+			func(n *Device) { n.Edges.FeatureOverrides = []*DeviceFeatureOverride{} },
+			func(n *Device, e *DeviceFeatureOverride) {
+				n.Edges.FeatureOverrides = append(n.Edges.FeatureOverrides, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withOrder; query != nil {
+		if err := dq.loadOrder(ctx, query, nodes, nil,
+			func(n *Device, e *Order) { n.Edges.Order = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withLot; query != nil {
+		if err := dq.loadLot(ctx, query, nodes, nil,
+			func(n *Device, e *Lot) { n.Edges.Lot = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -644,9 +1008,311 @@ func (dq *DeviceQuery) loadUpdater(ctx context.Context, query *UserQuery, nodes
 	}
 	return nil
 }
+func (dq *DeviceQuery) loadTags(ctx context.Context, query *DeviceTagQuery, nodes []*Device, init func(*Device), assign func(*Device, *DeviceTag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Device)
+	nids := make(map[int]map[*Device]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(device.TagsTable)
+		s.Join(joinT).On(s.C(devicetag.FieldID), joinT.C(device.TagsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(device.TagsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(device.TagsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Device]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*DeviceTag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (dq *DeviceQuery) loadGroups(ctx context.Context, query *DeviceGroupQuery, nodes []*Device, init func(*Device), assign func(*Device, *DeviceGroup)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Device)
+	nids := make(map[int]map[*Device]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(device.GroupsTable)
+		s.Join(joinT).On(s.C(devicegroup.FieldID), joinT.C(device.GroupsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(device.GroupsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(device.GroupsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Device]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*DeviceGroup](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "groups" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (dq *DeviceQuery) loadHeartbeats(ctx context.Context, query *DeviceHeartbeatQuery, nodes []*Device, init func(*Device), assign func(*Device, *DeviceHeartbeat)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Device)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(deviceheartbeat.FieldDeviceID)
+	}
+	query.Where(predicate.DeviceHeartbeat(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(device.HeartbeatsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DeviceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "device_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (dq *DeviceQuery) loadCustomer(ctx context.Context, query *CustomerQuery, nodes []*Device, init func(*Device), assign func(*Device, *Customer)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Device)
+	for i := range nodes {
+		fk := nodes[i].CustomerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(customer.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "customer_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (dq *DeviceQuery) loadAssignments(ctx context.Context, query *DeviceAssignmentQuery, nodes []*Device, init func(*Device), assign func(*Device, *DeviceAssignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Device)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(deviceassignment.FieldDeviceID)
+	}
+	query.Where(predicate.DeviceAssignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(device.AssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DeviceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "device_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (dq *DeviceQuery) loadFeatureOverrides(ctx context.Context, query *DeviceFeatureOverrideQuery, nodes []*Device, init func(*Device), assign func(*Device, *DeviceFeatureOverride)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Device)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(devicefeatureoverride.FieldDeviceID)
+	}
+	query.Where(predicate.DeviceFeatureOverride(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(device.FeatureOverridesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DeviceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "device_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (dq *DeviceQuery) loadOrder(ctx context.Context, query *OrderQuery, nodes []*Device, init func(*Device), assign func(*Device, *Order)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Device)
+	for i := range nodes {
+		fk := nodes[i].OrderID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(order.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "order_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (dq *DeviceQuery) loadLot(ctx context.Context, query *LotQuery, nodes []*Device, init func(*Device), assign func(*Device, *Lot)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Device)
+	for i := range nodes {
+		fk := nodes[i].LotID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(lot.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "lot_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (dq *DeviceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := dq.querySpec()
+	if len(dq.modifiers) > 0 {
+		_spec.Modifiers = dq.modifiers
+	}
 	_spec.Node.Columns = dq.ctx.Fields
 	if len(dq.ctx.Fields) > 0 {
 		_spec.Unique = dq.ctx.Unique != nil && *dq.ctx.Unique
@@ -682,6 +1348,15 @@ func (dq *DeviceQuery) querySpec() *sqlgraph.QuerySpec {
 		if dq.withUpdater != nil {
 			_spec.Node.AddColumnOnce(device.FieldUpdatedBy)
 		}
+		if dq.withCustomer != nil {
+			_spec.Node.AddColumnOnce(device.FieldCustomerID)
+		}
+		if dq.withOrder != nil {
+			_spec.Node.AddColumnOnce(device.FieldOrderID)
+		}
+		if dq.withLot != nil {
+			_spec.Node.AddColumnOnce(device.FieldLotID)
+		}
 	}
 	if ps := dq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -721,6 +1396,9 @@ func (dq *DeviceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if dq.ctx.Unique != nil && *dq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range dq.modifiers {
+		m(selector)
+	}
 	for _, p := range dq.predicates {
 		p(selector)
 	}
@@ -738,6 +1416,32 @@ func (dq *DeviceQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (dq *DeviceQuery) ForUpdate(opts ...sql.LockOption) *DeviceQuery {
+	if dq.driver.Dialect() == dialect.Postgres {
+		dq.Unique(false)
+	}
+	dq.modifiers = append(dq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return dq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (dq *DeviceQuery) ForShare(opts ...sql.LockOption) *DeviceQuery {
+	if dq.driver.Dialect() == dialect.Postgres {
+		dq.Unique(false)
+	}
+	dq.modifiers = append(dq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return dq
+}
+
 // DeviceGroupBy is the group-by builder for Device entities.
 type DeviceGroupBy struct {
 	selector
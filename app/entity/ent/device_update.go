@@ -8,8 +8,16 @@ import (
 	"fmt"
 	"time"
 
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/customer"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/deviceassignment"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicefeatureoverride"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicegroup"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/deviceheartbeat"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicetag"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/lot"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
@@ -31,6 +39,47 @@ func (du *DeviceUpdate) Where(ps ...predicate.Device) *DeviceUpdate {
 	return du
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (du *DeviceUpdate) SetDeletedAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetDeletedAt(t)
+	return du
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableDeletedAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetDeletedAt(*t)
+	}
+	return du
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (du *DeviceUpdate) ClearDeletedAt() *DeviceUpdate {
+	du.mutation.ClearDeletedAt()
+	return du
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (du *DeviceUpdate) SetDeletedID(i int) *DeviceUpdate {
+	du.mutation.ResetDeletedID()
+	du.mutation.SetDeletedID(i)
+	return du
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableDeletedID(i *int) *DeviceUpdate {
+	if i != nil {
+		du.SetDeletedID(*i)
+	}
+	return du
+}
+
+// AddDeletedID adds i to the "deleted_id" field.
+func (du *DeviceUpdate) AddDeletedID(i int) *DeviceUpdate {
+	du.mutation.AddDeletedID(i)
+	return du
+}
+
 // SetSn sets the "sn" field.
 func (du *DeviceUpdate) SetSn(s string) *DeviceUpdate {
 	du.mutation.SetSn(s)
@@ -103,6 +152,339 @@ func (du *DeviceUpdate) ClearRemark() *DeviceUpdate {
 	return du
 }
 
+// SetState sets the "state" field.
+func (du *DeviceUpdate) SetState(d device.State) *DeviceUpdate {
+	du.mutation.SetState(d)
+	return du
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableState(d *device.State) *DeviceUpdate {
+	if d != nil {
+		du.SetState(*d)
+	}
+	return du
+}
+
+// SetShippedAt sets the "shipped_at" field.
+func (du *DeviceUpdate) SetShippedAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetShippedAt(t)
+	return du
+}
+
+// SetNillableShippedAt sets the "shipped_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableShippedAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetShippedAt(*t)
+	}
+	return du
+}
+
+// ClearShippedAt clears the value of the "shipped_at" field.
+func (du *DeviceUpdate) ClearShippedAt() *DeviceUpdate {
+	du.mutation.ClearShippedAt()
+	return du
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (du *DeviceUpdate) SetActivatedAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetActivatedAt(t)
+	return du
+}
+
+// SetNillableActivatedAt sets the "activated_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableActivatedAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetActivatedAt(*t)
+	}
+	return du
+}
+
+// ClearActivatedAt clears the value of the "activated_at" field.
+func (du *DeviceUpdate) ClearActivatedAt() *DeviceUpdate {
+	du.mutation.ClearActivatedAt()
+	return du
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (du *DeviceUpdate) SetSuspendedAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetSuspendedAt(t)
+	return du
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableSuspendedAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetSuspendedAt(*t)
+	}
+	return du
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (du *DeviceUpdate) ClearSuspendedAt() *DeviceUpdate {
+	du.mutation.ClearSuspendedAt()
+	return du
+}
+
+// SetRmaAt sets the "rma_at" field.
+func (du *DeviceUpdate) SetRmaAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetRmaAt(t)
+	return du
+}
+
+// SetNillableRmaAt sets the "rma_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableRmaAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetRmaAt(*t)
+	}
+	return du
+}
+
+// ClearRmaAt clears the value of the "rma_at" field.
+func (du *DeviceUpdate) ClearRmaAt() *DeviceUpdate {
+	du.mutation.ClearRmaAt()
+	return du
+}
+
+// SetScrappedAt sets the "scrapped_at" field.
+func (du *DeviceUpdate) SetScrappedAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetScrappedAt(t)
+	return du
+}
+
+// SetNillableScrappedAt sets the "scrapped_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableScrappedAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetScrappedAt(*t)
+	}
+	return du
+}
+
+// ClearScrappedAt clears the value of the "scrapped_at" field.
+func (du *DeviceUpdate) ClearScrappedAt() *DeviceUpdate {
+	du.mutation.ClearScrappedAt()
+	return du
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (du *DeviceUpdate) SetLastSeenAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetLastSeenAt(t)
+	return du
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableLastSeenAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetLastSeenAt(*t)
+	}
+	return du
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (du *DeviceUpdate) ClearLastSeenAt() *DeviceUpdate {
+	du.mutation.ClearLastSeenAt()
+	return du
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (du *DeviceUpdate) SetCustomerID(i int) *DeviceUpdate {
+	du.mutation.SetCustomerID(i)
+	return du
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableCustomerID(i *int) *DeviceUpdate {
+	if i != nil {
+		du.SetCustomerID(*i)
+	}
+	return du
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (du *DeviceUpdate) ClearCustomerID() *DeviceUpdate {
+	du.mutation.ClearCustomerID()
+	return du
+}
+
+// SetWarrantyStartAt sets the "warranty_start_at" field.
+func (du *DeviceUpdate) SetWarrantyStartAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetWarrantyStartAt(t)
+	return du
+}
+
+// SetNillableWarrantyStartAt sets the "warranty_start_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableWarrantyStartAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetWarrantyStartAt(*t)
+	}
+	return du
+}
+
+// ClearWarrantyStartAt clears the value of the "warranty_start_at" field.
+func (du *DeviceUpdate) ClearWarrantyStartAt() *DeviceUpdate {
+	du.mutation.ClearWarrantyStartAt()
+	return du
+}
+
+// SetWarrantyEndAt sets the "warranty_end_at" field.
+func (du *DeviceUpdate) SetWarrantyEndAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetWarrantyEndAt(t)
+	return du
+}
+
+// SetNillableWarrantyEndAt sets the "warranty_end_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableWarrantyEndAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetWarrantyEndAt(*t)
+	}
+	return du
+}
+
+// ClearWarrantyEndAt clears the value of the "warranty_end_at" field.
+func (du *DeviceUpdate) ClearWarrantyEndAt() *DeviceUpdate {
+	du.mutation.ClearWarrantyEndAt()
+	return du
+}
+
+// SetOrderID sets the "order_id" field.
+func (du *DeviceUpdate) SetOrderID(i int) *DeviceUpdate {
+	du.mutation.SetOrderID(i)
+	return du
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableOrderID(i *int) *DeviceUpdate {
+	if i != nil {
+		du.SetOrderID(*i)
+	}
+	return du
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (du *DeviceUpdate) ClearOrderID() *DeviceUpdate {
+	du.mutation.ClearOrderID()
+	return du
+}
+
+// SetLotID sets the "lot_id" field.
+func (du *DeviceUpdate) SetLotID(i int) *DeviceUpdate {
+	du.mutation.SetLotID(i)
+	return du
+}
+
+// SetNillableLotID sets the "lot_id" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableLotID(i *int) *DeviceUpdate {
+	if i != nil {
+		du.SetLotID(*i)
+	}
+	return du
+}
+
+// ClearLotID clears the value of the "lot_id" field.
+func (du *DeviceUpdate) ClearLotID() *DeviceUpdate {
+	du.mutation.ClearLotID()
+	return du
+}
+
+// SetAttributes sets the "attributes" field.
+func (du *DeviceUpdate) SetAttributes(m map[string]interface{}) *DeviceUpdate {
+	du.mutation.SetAttributes(m)
+	return du
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (du *DeviceUpdate) ClearAttributes() *DeviceUpdate {
+	du.mutation.ClearAttributes()
+	return du
+}
+
+// SetLastSoftwareVersion sets the "last_software_version" field.
+func (du *DeviceUpdate) SetLastSoftwareVersion(s string) *DeviceUpdate {
+	du.mutation.SetLastSoftwareVersion(s)
+	return du
+}
+
+// SetNillableLastSoftwareVersion sets the "last_software_version" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableLastSoftwareVersion(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetLastSoftwareVersion(*s)
+	}
+	return du
+}
+
+// ClearLastSoftwareVersion clears the value of the "last_software_version" field.
+func (du *DeviceUpdate) ClearLastSoftwareVersion() *DeviceUpdate {
+	du.mutation.ClearLastSoftwareVersion()
+	return du
+}
+
+// SetLastFirmwareVersion sets the "last_firmware_version" field.
+func (du *DeviceUpdate) SetLastFirmwareVersion(s string) *DeviceUpdate {
+	du.mutation.SetLastFirmwareVersion(s)
+	return du
+}
+
+// SetNillableLastFirmwareVersion sets the "last_firmware_version" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableLastFirmwareVersion(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetLastFirmwareVersion(*s)
+	}
+	return du
+}
+
+// ClearLastFirmwareVersion clears the value of the "last_firmware_version" field.
+func (du *DeviceUpdate) ClearLastFirmwareVersion() *DeviceUpdate {
+	du.mutation.ClearLastFirmwareVersion()
+	return du
+}
+
+// SetLastUptime sets the "last_uptime" field.
+func (du *DeviceUpdate) SetLastUptime(i int64) *DeviceUpdate {
+	du.mutation.ResetLastUptime()
+	du.mutation.SetLastUptime(i)
+	return du
+}
+
+// SetNillableLastUptime sets the "last_uptime" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableLastUptime(i *int64) *DeviceUpdate {
+	if i != nil {
+		du.SetLastUptime(*i)
+	}
+	return du
+}
+
+// AddLastUptime adds i to the "last_uptime" field.
+func (du *DeviceUpdate) AddLastUptime(i int64) *DeviceUpdate {
+	du.mutation.AddLastUptime(i)
+	return du
+}
+
+// ClearLastUptime clears the value of the "last_uptime" field.
+func (du *DeviceUpdate) ClearLastUptime() *DeviceUpdate {
+	du.mutation.ClearLastUptime()
+	return du
+}
+
+// SetHeartbeatPublicKey sets the "heartbeat_public_key" field.
+func (du *DeviceUpdate) SetHeartbeatPublicKey(s string) *DeviceUpdate {
+	du.mutation.SetHeartbeatPublicKey(s)
+	return du
+}
+
+// SetNillableHeartbeatPublicKey sets the "heartbeat_public_key" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableHeartbeatPublicKey(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetHeartbeatPublicKey(*s)
+	}
+	return du
+}
+
+// ClearHeartbeatPublicKey clears the value of the "heartbeat_public_key" field.
+func (du *DeviceUpdate) ClearHeartbeatPublicKey() *DeviceUpdate {
+	du.mutation.ClearHeartbeatPublicKey()
+	return du
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (du *DeviceUpdate) SetCreatedAt(t time.Time) *DeviceUpdate {
 	du.mutation.SetCreatedAt(t)
@@ -203,6 +585,96 @@ func (du *DeviceUpdate) SetUpdater(u *User) *DeviceUpdate {
 	return du.SetUpdaterID(u.ID)
 }
 
+// AddTagIDs adds the "tags" edge to the DeviceTag entity by IDs.
+func (du *DeviceUpdate) AddTagIDs(ids ...int) *DeviceUpdate {
+	du.mutation.AddTagIDs(ids...)
+	return du
+}
+
+// AddTags adds the "tags" edges to the DeviceTag entity.
+func (du *DeviceUpdate) AddTags(d ...*DeviceTag) *DeviceUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.AddTagIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the DeviceGroup entity by IDs.
+func (du *DeviceUpdate) AddGroupIDs(ids ...int) *DeviceUpdate {
+	du.mutation.AddGroupIDs(ids...)
+	return du
+}
+
+// AddGroups adds the "groups" edges to the DeviceGroup entity.
+func (du *DeviceUpdate) AddGroups(d ...*DeviceGroup) *DeviceUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.AddGroupIDs(ids...)
+}
+
+// AddHeartbeatIDs adds the "heartbeats" edge to the DeviceHeartbeat entity by IDs.
+func (du *DeviceUpdate) AddHeartbeatIDs(ids ...int) *DeviceUpdate {
+	du.mutation.AddHeartbeatIDs(ids...)
+	return du
+}
+
+// AddHeartbeats adds the "heartbeats" edges to the DeviceHeartbeat entity.
+func (du *DeviceUpdate) AddHeartbeats(d ...*DeviceHeartbeat) *DeviceUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.AddHeartbeatIDs(ids...)
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (du *DeviceUpdate) SetCustomer(c *Customer) *DeviceUpdate {
+	return du.SetCustomerID(c.ID)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the DeviceAssignment entity by IDs.
+func (du *DeviceUpdate) AddAssignmentIDs(ids ...int) *DeviceUpdate {
+	du.mutation.AddAssignmentIDs(ids...)
+	return du
+}
+
+// AddAssignments adds the "assignments" edges to the DeviceAssignment entity.
+func (du *DeviceUpdate) AddAssignments(d ...*DeviceAssignment) *DeviceUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.AddAssignmentIDs(ids...)
+}
+
+// AddFeatureOverrideIDs adds the "feature_overrides" edge to the DeviceFeatureOverride entity by IDs.
+func (du *DeviceUpdate) AddFeatureOverrideIDs(ids ...int) *DeviceUpdate {
+	du.mutation.AddFeatureOverrideIDs(ids...)
+	return du
+}
+
+// AddFeatureOverrides adds the "feature_overrides" edges to the DeviceFeatureOverride entity.
+func (du *DeviceUpdate) AddFeatureOverrides(d ...*DeviceFeatureOverride) *DeviceUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.AddFeatureOverrideIDs(ids...)
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (du *DeviceUpdate) SetOrder(o *Order) *DeviceUpdate {
+	return du.SetOrderID(o.ID)
+}
+
+// SetLot sets the "lot" edge to the Lot entity.
+func (du *DeviceUpdate) SetLot(l *Lot) *DeviceUpdate {
+	return du.SetLotID(l.ID)
+}
+
 // Mutation returns the DeviceMutation object of the builder.
 func (du *DeviceUpdate) Mutation() *DeviceMutation {
 	return du.mutation
@@ -232,35 +704,163 @@ func (du *DeviceUpdate) ClearUpdater() *DeviceUpdate {
 	return du
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (du *DeviceUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+// ClearTags clears all "tags" edges to the DeviceTag entity.
+func (du *DeviceUpdate) ClearTags() *DeviceUpdate {
+	du.mutation.ClearTags()
+	return du
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (du *DeviceUpdate) SaveX(ctx context.Context) int {
-	affected, err := du.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemoveTagIDs removes the "tags" edge to DeviceTag entities by IDs.
+func (du *DeviceUpdate) RemoveTagIDs(ids ...int) *DeviceUpdate {
+	du.mutation.RemoveTagIDs(ids...)
+	return du
+}
+
+// RemoveTags removes "tags" edges to DeviceTag entities.
+func (du *DeviceUpdate) RemoveTags(d ...*DeviceTag) *DeviceUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
 	}
-	return affected
+	return du.RemoveTagIDs(ids...)
 }
 
-// Exec executes the query.
-func (du *DeviceUpdate) Exec(ctx context.Context) error {
-	_, err := du.Save(ctx)
-	return err
+// ClearGroups clears all "groups" edges to the DeviceGroup entity.
+func (du *DeviceUpdate) ClearGroups() *DeviceUpdate {
+	du.mutation.ClearGroups()
+	return du
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (du *DeviceUpdate) ExecX(ctx context.Context) {
-	if err := du.Exec(ctx); err != nil {
-		panic(err)
+// RemoveGroupIDs removes the "groups" edge to DeviceGroup entities by IDs.
+func (du *DeviceUpdate) RemoveGroupIDs(ids ...int) *DeviceUpdate {
+	du.mutation.RemoveGroupIDs(ids...)
+	return du
+}
+
+// RemoveGroups removes "groups" edges to DeviceGroup entities.
+func (du *DeviceUpdate) RemoveGroups(d ...*DeviceGroup) *DeviceUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
 	}
+	return du.RemoveGroupIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
+// ClearHeartbeats clears all "heartbeats" edges to the DeviceHeartbeat entity.
+func (du *DeviceUpdate) ClearHeartbeats() *DeviceUpdate {
+	du.mutation.ClearHeartbeats()
+	return du
+}
+
+// RemoveHeartbeatIDs removes the "heartbeats" edge to DeviceHeartbeat entities by IDs.
+func (du *DeviceUpdate) RemoveHeartbeatIDs(ids ...int) *DeviceUpdate {
+	du.mutation.RemoveHeartbeatIDs(ids...)
+	return du
+}
+
+// RemoveHeartbeats removes "heartbeats" edges to DeviceHeartbeat entities.
+func (du *DeviceUpdate) RemoveHeartbeats(d ...*DeviceHeartbeat) *DeviceUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.RemoveHeartbeatIDs(ids...)
+}
+
+// ClearCustomer clears the "customer" edge to the Customer entity.
+func (du *DeviceUpdate) ClearCustomer() *DeviceUpdate {
+	du.mutation.ClearCustomer()
+	return du
+}
+
+// ClearAssignments clears all "assignments" edges to the DeviceAssignment entity.
+func (du *DeviceUpdate) ClearAssignments() *DeviceUpdate {
+	du.mutation.ClearAssignments()
+	return du
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to DeviceAssignment entities by IDs.
+func (du *DeviceUpdate) RemoveAssignmentIDs(ids ...int) *DeviceUpdate {
+	du.mutation.RemoveAssignmentIDs(ids...)
+	return du
+}
+
+// RemoveAssignments removes "assignments" edges to DeviceAssignment entities.
+func (du *DeviceUpdate) RemoveAssignments(d ...*DeviceAssignment) *DeviceUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.RemoveAssignmentIDs(ids...)
+}
+
+// ClearFeatureOverrides clears all "feature_overrides" edges to the DeviceFeatureOverride entity.
+func (du *DeviceUpdate) ClearFeatureOverrides() *DeviceUpdate {
+	du.mutation.ClearFeatureOverrides()
+	return du
+}
+
+// RemoveFeatureOverrideIDs removes the "feature_overrides" edge to DeviceFeatureOverride entities by IDs.
+func (du *DeviceUpdate) RemoveFeatureOverrideIDs(ids ...int) *DeviceUpdate {
+	du.mutation.RemoveFeatureOverrideIDs(ids...)
+	return du
+}
+
+// RemoveFeatureOverrides removes "feature_overrides" edges to DeviceFeatureOverride entities.
+func (du *DeviceUpdate) RemoveFeatureOverrides(d ...*DeviceFeatureOverride) *DeviceUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.RemoveFeatureOverrideIDs(ids...)
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (du *DeviceUpdate) ClearOrder() *DeviceUpdate {
+	du.mutation.ClearOrder()
+	return du
+}
+
+// ClearLot clears the "lot" edge to the Lot entity.
+func (du *DeviceUpdate) ClearLot() *DeviceUpdate {
+	du.mutation.ClearLot()
+	return du
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DeviceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DeviceUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DeviceUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DeviceUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
 func (du *DeviceUpdate) check() error {
+	if v, ok := du.mutation.State(); ok {
+		if err := device.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Device.state": %w`, err)}
+		}
+	}
 	if _, ok := du.mutation.ProductID(); du.mutation.ProductCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Device.product"`)
 	}
@@ -279,6 +879,18 @@ func (du *DeviceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := du.mutation.DeletedAt(); ok {
+		_spec.SetField(device.FieldDeletedAt, field.TypeTime, value)
+	}
+	if du.mutation.DeletedAtCleared() {
+		_spec.ClearField(device.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.DeletedID(); ok {
+		_spec.SetField(device.FieldDeletedID, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.AddedDeletedID(); ok {
+		_spec.AddField(device.FieldDeletedID, field.TypeInt, value)
+	}
 	if value, ok := du.mutation.Sn(); ok {
 		_spec.SetField(device.FieldSn, field.TypeString, value)
 	}
@@ -294,6 +906,90 @@ func (du *DeviceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if du.mutation.RemarkCleared() {
 		_spec.ClearField(device.FieldRemark, field.TypeString)
 	}
+	if value, ok := du.mutation.State(); ok {
+		_spec.SetField(device.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := du.mutation.ShippedAt(); ok {
+		_spec.SetField(device.FieldShippedAt, field.TypeTime, value)
+	}
+	if du.mutation.ShippedAtCleared() {
+		_spec.ClearField(device.FieldShippedAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.ActivatedAt(); ok {
+		_spec.SetField(device.FieldActivatedAt, field.TypeTime, value)
+	}
+	if du.mutation.ActivatedAtCleared() {
+		_spec.ClearField(device.FieldActivatedAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.SuspendedAt(); ok {
+		_spec.SetField(device.FieldSuspendedAt, field.TypeTime, value)
+	}
+	if du.mutation.SuspendedAtCleared() {
+		_spec.ClearField(device.FieldSuspendedAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.RmaAt(); ok {
+		_spec.SetField(device.FieldRmaAt, field.TypeTime, value)
+	}
+	if du.mutation.RmaAtCleared() {
+		_spec.ClearField(device.FieldRmaAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.ScrappedAt(); ok {
+		_spec.SetField(device.FieldScrappedAt, field.TypeTime, value)
+	}
+	if du.mutation.ScrappedAtCleared() {
+		_spec.ClearField(device.FieldScrappedAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.LastSeenAt(); ok {
+		_spec.SetField(device.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if du.mutation.LastSeenAtCleared() {
+		_spec.ClearField(device.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.WarrantyStartAt(); ok {
+		_spec.SetField(device.FieldWarrantyStartAt, field.TypeTime, value)
+	}
+	if du.mutation.WarrantyStartAtCleared() {
+		_spec.ClearField(device.FieldWarrantyStartAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.WarrantyEndAt(); ok {
+		_spec.SetField(device.FieldWarrantyEndAt, field.TypeTime, value)
+	}
+	if du.mutation.WarrantyEndAtCleared() {
+		_spec.ClearField(device.FieldWarrantyEndAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.Attributes(); ok {
+		_spec.SetField(device.FieldAttributes, field.TypeJSON, value)
+	}
+	if du.mutation.AttributesCleared() {
+		_spec.ClearField(device.FieldAttributes, field.TypeJSON)
+	}
+	if value, ok := du.mutation.LastSoftwareVersion(); ok {
+		_spec.SetField(device.FieldLastSoftwareVersion, field.TypeString, value)
+	}
+	if du.mutation.LastSoftwareVersionCleared() {
+		_spec.ClearField(device.FieldLastSoftwareVersion, field.TypeString)
+	}
+	if value, ok := du.mutation.LastFirmwareVersion(); ok {
+		_spec.SetField(device.FieldLastFirmwareVersion, field.TypeString, value)
+	}
+	if du.mutation.LastFirmwareVersionCleared() {
+		_spec.ClearField(device.FieldLastFirmwareVersion, field.TypeString)
+	}
+	if value, ok := du.mutation.LastUptime(); ok {
+		_spec.SetField(device.FieldLastUptime, field.TypeInt64, value)
+	}
+	if value, ok := du.mutation.AddedLastUptime(); ok {
+		_spec.AddField(device.FieldLastUptime, field.TypeInt64, value)
+	}
+	if du.mutation.LastUptimeCleared() {
+		_spec.ClearField(device.FieldLastUptime, field.TypeInt64)
+	}
+	if value, ok := du.mutation.HeartbeatPublicKey(); ok {
+		_spec.SetField(device.FieldHeartbeatPublicKey, field.TypeString, value)
+	}
+	if du.mutation.HeartbeatPublicKeyCleared() {
+		_spec.ClearField(device.FieldHeartbeatPublicKey, field.TypeString)
+	}
 	if value, ok := du.mutation.CreatedAt(); ok {
 		_spec.SetField(device.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -416,130 +1112,816 @@ func (du *DeviceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{device.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if du.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   device.TagsTable,
+			Columns: device.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetag.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	du.mutation.done = true
-	return n, nil
-}
-
-// DeviceUpdateOne is the builder for updating a single Device entity.
-type DeviceUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *DeviceMutation
-}
-
-// SetSn sets the "sn" field.
-func (duo *DeviceUpdateOne) SetSn(s string) *DeviceUpdateOne {
-	duo.mutation.SetSn(s)
-	return duo
-}
-
-// SetProductID sets the "product_id" field.
-func (duo *DeviceUpdateOne) SetProductID(i int) *DeviceUpdateOne {
-	duo.mutation.SetProductID(i)
-	return duo
-}
-
-// SetLicenseTypeID sets the "license_type_id" field.
-func (duo *DeviceUpdateOne) SetLicenseTypeID(i int) *DeviceUpdateOne {
-	duo.mutation.SetLicenseTypeID(i)
-	return duo
-}
-
-// SetNillableLicenseTypeID sets the "license_type_id" field if the given value is not nil.
-func (duo *DeviceUpdateOne) SetNillableLicenseTypeID(i *int) *DeviceUpdateOne {
-	if i != nil {
-		duo.SetLicenseTypeID(*i)
+	if nodes := du.mutation.RemovedTagsIDs(); len(nodes) > 0 && !du.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   device.TagsTable,
+			Columns: device.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return duo
-}
-
-// ClearLicenseTypeID clears the value of the "license_type_id" field.
-func (duo *DeviceUpdateOne) ClearLicenseTypeID() *DeviceUpdateOne {
-	duo.mutation.ClearLicenseTypeID()
-	return duo
-}
-
-// SetOemTag sets the "oem_tag" field.
-func (duo *DeviceUpdateOne) SetOemTag(s string) *DeviceUpdateOne {
-	duo.mutation.SetOemTag(s)
-	return duo
-}
-
-// SetNillableOemTag sets the "oem_tag" field if the given value is not nil.
-func (duo *DeviceUpdateOne) SetNillableOemTag(s *string) *DeviceUpdateOne {
-	if s != nil {
-		duo.SetOemTag(*s)
+	if nodes := du.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   device.TagsTable,
+			Columns: device.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return duo
-}
-
-// ClearOemTag clears the value of the "oem_tag" field.
-func (duo *DeviceUpdateOne) ClearOemTag() *DeviceUpdateOne {
-	duo.mutation.ClearOemTag()
-	return duo
-}
-
-// SetRemark sets the "remark" field.
-func (duo *DeviceUpdateOne) SetRemark(s string) *DeviceUpdateOne {
-	duo.mutation.SetRemark(s)
-	return duo
-}
-
-// SetNillableRemark sets the "remark" field if the given value is not nil.
-func (duo *DeviceUpdateOne) SetNillableRemark(s *string) *DeviceUpdateOne {
-	if s != nil {
-		duo.SetRemark(*s)
+	if du.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   device.GroupsTable,
+			Columns: device.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return duo
-}
-
-// ClearRemark clears the value of the "remark" field.
-func (duo *DeviceUpdateOne) ClearRemark() *DeviceUpdateOne {
-	duo.mutation.ClearRemark()
-	return duo
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (duo *DeviceUpdateOne) SetCreatedAt(t time.Time) *DeviceUpdateOne {
-	duo.mutation.SetCreatedAt(t)
-	return duo
-}
-
-// SetCreatedBy sets the "created_by" field.
-func (duo *DeviceUpdateOne) SetCreatedBy(i int) *DeviceUpdateOne {
-	duo.mutation.SetCreatedBy(i)
-	return duo
-}
-
-// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
-func (duo *DeviceUpdateOne) SetNillableCreatedBy(i *int) *DeviceUpdateOne {
-	if i != nil {
-		duo.SetCreatedBy(*i)
+	if nodes := du.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !du.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   device.GroupsTable,
+			Columns: device.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   device.GroupsTable,
+			Columns: device.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.HeartbeatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.HeartbeatsTable,
+			Columns: []string{device.HeartbeatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceheartbeat.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedHeartbeatsIDs(); len(nodes) > 0 && !du.mutation.HeartbeatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.HeartbeatsTable,
+			Columns: []string{device.HeartbeatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceheartbeat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.HeartbeatsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.HeartbeatsTable,
+			Columns: []string{device.HeartbeatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceheartbeat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.CustomerTable,
+			Columns: []string{device.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.CustomerTable,
+			Columns: []string{device.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.AssignmentsTable,
+			Columns: []string{device.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceassignment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !du.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.AssignmentsTable,
+			Columns: []string{device.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceassignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.AssignmentsTable,
+			Columns: []string{device.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceassignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.FeatureOverridesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.FeatureOverridesTable,
+			Columns: []string{device.FeatureOverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicefeatureoverride.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedFeatureOverridesIDs(); len(nodes) > 0 && !du.mutation.FeatureOverridesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.FeatureOverridesTable,
+			Columns: []string{device.FeatureOverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicefeatureoverride.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.FeatureOverridesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.FeatureOverridesTable,
+			Columns: []string{device.FeatureOverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicefeatureoverride.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.OrderTable,
+			Columns: []string{device.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.OrderTable,
+			Columns: []string{device.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.LotCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.LotTable,
+			Columns: []string{device.LotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.LotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.LotTable,
+			Columns: []string{device.LotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{device.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DeviceUpdateOne is the builder for updating a single Device entity.
+type DeviceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeviceMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (duo *DeviceUpdateOne) SetDeletedAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetDeletedAt(t)
+	return duo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableDeletedAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetDeletedAt(*t)
+	}
+	return duo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (duo *DeviceUpdateOne) ClearDeletedAt() *DeviceUpdateOne {
+	duo.mutation.ClearDeletedAt()
+	return duo
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (duo *DeviceUpdateOne) SetDeletedID(i int) *DeviceUpdateOne {
+	duo.mutation.ResetDeletedID()
+	duo.mutation.SetDeletedID(i)
+	return duo
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableDeletedID(i *int) *DeviceUpdateOne {
+	if i != nil {
+		duo.SetDeletedID(*i)
+	}
+	return duo
+}
+
+// AddDeletedID adds i to the "deleted_id" field.
+func (duo *DeviceUpdateOne) AddDeletedID(i int) *DeviceUpdateOne {
+	duo.mutation.AddDeletedID(i)
+	return duo
+}
+
+// SetSn sets the "sn" field.
+func (duo *DeviceUpdateOne) SetSn(s string) *DeviceUpdateOne {
+	duo.mutation.SetSn(s)
+	return duo
+}
+
+// SetProductID sets the "product_id" field.
+func (duo *DeviceUpdateOne) SetProductID(i int) *DeviceUpdateOne {
+	duo.mutation.SetProductID(i)
+	return duo
+}
+
+// SetLicenseTypeID sets the "license_type_id" field.
+func (duo *DeviceUpdateOne) SetLicenseTypeID(i int) *DeviceUpdateOne {
+	duo.mutation.SetLicenseTypeID(i)
+	return duo
+}
+
+// SetNillableLicenseTypeID sets the "license_type_id" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableLicenseTypeID(i *int) *DeviceUpdateOne {
+	if i != nil {
+		duo.SetLicenseTypeID(*i)
+	}
+	return duo
+}
+
+// ClearLicenseTypeID clears the value of the "license_type_id" field.
+func (duo *DeviceUpdateOne) ClearLicenseTypeID() *DeviceUpdateOne {
+	duo.mutation.ClearLicenseTypeID()
+	return duo
+}
+
+// SetOemTag sets the "oem_tag" field.
+func (duo *DeviceUpdateOne) SetOemTag(s string) *DeviceUpdateOne {
+	duo.mutation.SetOemTag(s)
+	return duo
+}
+
+// SetNillableOemTag sets the "oem_tag" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableOemTag(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetOemTag(*s)
+	}
+	return duo
+}
+
+// ClearOemTag clears the value of the "oem_tag" field.
+func (duo *DeviceUpdateOne) ClearOemTag() *DeviceUpdateOne {
+	duo.mutation.ClearOemTag()
+	return duo
+}
+
+// SetRemark sets the "remark" field.
+func (duo *DeviceUpdateOne) SetRemark(s string) *DeviceUpdateOne {
+	duo.mutation.SetRemark(s)
+	return duo
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableRemark(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetRemark(*s)
+	}
+	return duo
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (duo *DeviceUpdateOne) ClearRemark() *DeviceUpdateOne {
+	duo.mutation.ClearRemark()
+	return duo
+}
+
+// SetState sets the "state" field.
+func (duo *DeviceUpdateOne) SetState(d device.State) *DeviceUpdateOne {
+	duo.mutation.SetState(d)
+	return duo
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableState(d *device.State) *DeviceUpdateOne {
+	if d != nil {
+		duo.SetState(*d)
+	}
+	return duo
+}
+
+// SetShippedAt sets the "shipped_at" field.
+func (duo *DeviceUpdateOne) SetShippedAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetShippedAt(t)
+	return duo
+}
+
+// SetNillableShippedAt sets the "shipped_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableShippedAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetShippedAt(*t)
+	}
+	return duo
+}
+
+// ClearShippedAt clears the value of the "shipped_at" field.
+func (duo *DeviceUpdateOne) ClearShippedAt() *DeviceUpdateOne {
+	duo.mutation.ClearShippedAt()
+	return duo
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (duo *DeviceUpdateOne) SetActivatedAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetActivatedAt(t)
+	return duo
+}
+
+// SetNillableActivatedAt sets the "activated_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableActivatedAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetActivatedAt(*t)
+	}
+	return duo
+}
+
+// ClearActivatedAt clears the value of the "activated_at" field.
+func (duo *DeviceUpdateOne) ClearActivatedAt() *DeviceUpdateOne {
+	duo.mutation.ClearActivatedAt()
+	return duo
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (duo *DeviceUpdateOne) SetSuspendedAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetSuspendedAt(t)
+	return duo
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableSuspendedAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetSuspendedAt(*t)
+	}
+	return duo
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (duo *DeviceUpdateOne) ClearSuspendedAt() *DeviceUpdateOne {
+	duo.mutation.ClearSuspendedAt()
+	return duo
+}
+
+// SetRmaAt sets the "rma_at" field.
+func (duo *DeviceUpdateOne) SetRmaAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetRmaAt(t)
+	return duo
+}
+
+// SetNillableRmaAt sets the "rma_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableRmaAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetRmaAt(*t)
+	}
+	return duo
+}
+
+// ClearRmaAt clears the value of the "rma_at" field.
+func (duo *DeviceUpdateOne) ClearRmaAt() *DeviceUpdateOne {
+	duo.mutation.ClearRmaAt()
+	return duo
+}
+
+// SetScrappedAt sets the "scrapped_at" field.
+func (duo *DeviceUpdateOne) SetScrappedAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetScrappedAt(t)
+	return duo
+}
+
+// SetNillableScrappedAt sets the "scrapped_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableScrappedAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetScrappedAt(*t)
+	}
+	return duo
+}
+
+// ClearScrappedAt clears the value of the "scrapped_at" field.
+func (duo *DeviceUpdateOne) ClearScrappedAt() *DeviceUpdateOne {
+	duo.mutation.ClearScrappedAt()
+	return duo
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (duo *DeviceUpdateOne) SetLastSeenAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetLastSeenAt(t)
+	return duo
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableLastSeenAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetLastSeenAt(*t)
+	}
+	return duo
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (duo *DeviceUpdateOne) ClearLastSeenAt() *DeviceUpdateOne {
+	duo.mutation.ClearLastSeenAt()
+	return duo
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (duo *DeviceUpdateOne) SetCustomerID(i int) *DeviceUpdateOne {
+	duo.mutation.SetCustomerID(i)
+	return duo
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableCustomerID(i *int) *DeviceUpdateOne {
+	if i != nil {
+		duo.SetCustomerID(*i)
+	}
+	return duo
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (duo *DeviceUpdateOne) ClearCustomerID() *DeviceUpdateOne {
+	duo.mutation.ClearCustomerID()
+	return duo
+}
+
+// SetWarrantyStartAt sets the "warranty_start_at" field.
+func (duo *DeviceUpdateOne) SetWarrantyStartAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetWarrantyStartAt(t)
+	return duo
+}
+
+// SetNillableWarrantyStartAt sets the "warranty_start_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableWarrantyStartAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetWarrantyStartAt(*t)
+	}
+	return duo
+}
+
+// ClearWarrantyStartAt clears the value of the "warranty_start_at" field.
+func (duo *DeviceUpdateOne) ClearWarrantyStartAt() *DeviceUpdateOne {
+	duo.mutation.ClearWarrantyStartAt()
+	return duo
+}
+
+// SetWarrantyEndAt sets the "warranty_end_at" field.
+func (duo *DeviceUpdateOne) SetWarrantyEndAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetWarrantyEndAt(t)
+	return duo
+}
+
+// SetNillableWarrantyEndAt sets the "warranty_end_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableWarrantyEndAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetWarrantyEndAt(*t)
+	}
+	return duo
+}
+
+// ClearWarrantyEndAt clears the value of the "warranty_end_at" field.
+func (duo *DeviceUpdateOne) ClearWarrantyEndAt() *DeviceUpdateOne {
+	duo.mutation.ClearWarrantyEndAt()
+	return duo
+}
+
+// SetOrderID sets the "order_id" field.
+func (duo *DeviceUpdateOne) SetOrderID(i int) *DeviceUpdateOne {
+	duo.mutation.SetOrderID(i)
+	return duo
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableOrderID(i *int) *DeviceUpdateOne {
+	if i != nil {
+		duo.SetOrderID(*i)
+	}
+	return duo
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (duo *DeviceUpdateOne) ClearOrderID() *DeviceUpdateOne {
+	duo.mutation.ClearOrderID()
+	return duo
+}
+
+// SetLotID sets the "lot_id" field.
+func (duo *DeviceUpdateOne) SetLotID(i int) *DeviceUpdateOne {
+	duo.mutation.SetLotID(i)
+	return duo
+}
+
+// SetNillableLotID sets the "lot_id" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableLotID(i *int) *DeviceUpdateOne {
+	if i != nil {
+		duo.SetLotID(*i)
+	}
+	return duo
+}
+
+// ClearLotID clears the value of the "lot_id" field.
+func (duo *DeviceUpdateOne) ClearLotID() *DeviceUpdateOne {
+	duo.mutation.ClearLotID()
+	return duo
+}
+
+// SetAttributes sets the "attributes" field.
+func (duo *DeviceUpdateOne) SetAttributes(m map[string]interface{}) *DeviceUpdateOne {
+	duo.mutation.SetAttributes(m)
+	return duo
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (duo *DeviceUpdateOne) ClearAttributes() *DeviceUpdateOne {
+	duo.mutation.ClearAttributes()
+	return duo
+}
+
+// SetLastSoftwareVersion sets the "last_software_version" field.
+func (duo *DeviceUpdateOne) SetLastSoftwareVersion(s string) *DeviceUpdateOne {
+	duo.mutation.SetLastSoftwareVersion(s)
+	return duo
+}
+
+// SetNillableLastSoftwareVersion sets the "last_software_version" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableLastSoftwareVersion(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetLastSoftwareVersion(*s)
+	}
+	return duo
+}
+
+// ClearLastSoftwareVersion clears the value of the "last_software_version" field.
+func (duo *DeviceUpdateOne) ClearLastSoftwareVersion() *DeviceUpdateOne {
+	duo.mutation.ClearLastSoftwareVersion()
+	return duo
+}
+
+// SetLastFirmwareVersion sets the "last_firmware_version" field.
+func (duo *DeviceUpdateOne) SetLastFirmwareVersion(s string) *DeviceUpdateOne {
+	duo.mutation.SetLastFirmwareVersion(s)
+	return duo
+}
+
+// SetNillableLastFirmwareVersion sets the "last_firmware_version" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableLastFirmwareVersion(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetLastFirmwareVersion(*s)
+	}
+	return duo
+}
+
+// ClearLastFirmwareVersion clears the value of the "last_firmware_version" field.
+func (duo *DeviceUpdateOne) ClearLastFirmwareVersion() *DeviceUpdateOne {
+	duo.mutation.ClearLastFirmwareVersion()
+	return duo
+}
+
+// SetLastUptime sets the "last_uptime" field.
+func (duo *DeviceUpdateOne) SetLastUptime(i int64) *DeviceUpdateOne {
+	duo.mutation.ResetLastUptime()
+	duo.mutation.SetLastUptime(i)
+	return duo
+}
+
+// SetNillableLastUptime sets the "last_uptime" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableLastUptime(i *int64) *DeviceUpdateOne {
+	if i != nil {
+		duo.SetLastUptime(*i)
+	}
+	return duo
+}
+
+// AddLastUptime adds i to the "last_uptime" field.
+func (duo *DeviceUpdateOne) AddLastUptime(i int64) *DeviceUpdateOne {
+	duo.mutation.AddLastUptime(i)
+	return duo
+}
+
+// ClearLastUptime clears the value of the "last_uptime" field.
+func (duo *DeviceUpdateOne) ClearLastUptime() *DeviceUpdateOne {
+	duo.mutation.ClearLastUptime()
+	return duo
+}
+
+// SetHeartbeatPublicKey sets the "heartbeat_public_key" field.
+func (duo *DeviceUpdateOne) SetHeartbeatPublicKey(s string) *DeviceUpdateOne {
+	duo.mutation.SetHeartbeatPublicKey(s)
+	return duo
+}
+
+// SetNillableHeartbeatPublicKey sets the "heartbeat_public_key" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableHeartbeatPublicKey(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetHeartbeatPublicKey(*s)
 	}
 	return duo
 }
 
-// ClearCreatedBy clears the value of the "created_by" field.
-func (duo *DeviceUpdateOne) ClearCreatedBy() *DeviceUpdateOne {
-	duo.mutation.ClearCreatedBy()
-	return duo
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (duo *DeviceUpdateOne) SetUpdatedAt(t time.Time) *DeviceUpdateOne {
-	duo.mutation.SetUpdatedAt(t)
-	return duo
-}
-
+// ClearHeartbeatPublicKey clears the value of the "heartbeat_public_key" field.
+func (duo *DeviceUpdateOne) ClearHeartbeatPublicKey() *DeviceUpdateOne {
+	duo.mutation.ClearHeartbeatPublicKey()
+	return duo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (duo *DeviceUpdateOne) SetCreatedAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetCreatedAt(t)
+	return duo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (duo *DeviceUpdateOne) SetCreatedBy(i int) *DeviceUpdateOne {
+	duo.mutation.SetCreatedBy(i)
+	return duo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableCreatedBy(i *int) *DeviceUpdateOne {
+	if i != nil {
+		duo.SetCreatedBy(*i)
+	}
+	return duo
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (duo *DeviceUpdateOne) ClearCreatedBy() *DeviceUpdateOne {
+	duo.mutation.ClearCreatedBy()
+	return duo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (duo *DeviceUpdateOne) SetUpdatedAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetUpdatedAt(t)
+	return duo
+}
+
 // SetUpdatedBy sets the "updated_by" field.
 func (duo *DeviceUpdateOne) SetUpdatedBy(i int) *DeviceUpdateOne {
 	duo.mutation.SetUpdatedBy(i)
@@ -608,6 +1990,96 @@ func (duo *DeviceUpdateOne) SetUpdater(u *User) *DeviceUpdateOne {
 	return duo.SetUpdaterID(u.ID)
 }
 
+// AddTagIDs adds the "tags" edge to the DeviceTag entity by IDs.
+func (duo *DeviceUpdateOne) AddTagIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.AddTagIDs(ids...)
+	return duo
+}
+
+// AddTags adds the "tags" edges to the DeviceTag entity.
+func (duo *DeviceUpdateOne) AddTags(d ...*DeviceTag) *DeviceUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.AddTagIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the DeviceGroup entity by IDs.
+func (duo *DeviceUpdateOne) AddGroupIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.AddGroupIDs(ids...)
+	return duo
+}
+
+// AddGroups adds the "groups" edges to the DeviceGroup entity.
+func (duo *DeviceUpdateOne) AddGroups(d ...*DeviceGroup) *DeviceUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.AddGroupIDs(ids...)
+}
+
+// AddHeartbeatIDs adds the "heartbeats" edge to the DeviceHeartbeat entity by IDs.
+func (duo *DeviceUpdateOne) AddHeartbeatIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.AddHeartbeatIDs(ids...)
+	return duo
+}
+
+// AddHeartbeats adds the "heartbeats" edges to the DeviceHeartbeat entity.
+func (duo *DeviceUpdateOne) AddHeartbeats(d ...*DeviceHeartbeat) *DeviceUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.AddHeartbeatIDs(ids...)
+}
+
+// SetCustomer sets the "customer" edge to the Customer entity.
+func (duo *DeviceUpdateOne) SetCustomer(c *Customer) *DeviceUpdateOne {
+	return duo.SetCustomerID(c.ID)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the DeviceAssignment entity by IDs.
+func (duo *DeviceUpdateOne) AddAssignmentIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.AddAssignmentIDs(ids...)
+	return duo
+}
+
+// AddAssignments adds the "assignments" edges to the DeviceAssignment entity.
+func (duo *DeviceUpdateOne) AddAssignments(d ...*DeviceAssignment) *DeviceUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.AddAssignmentIDs(ids...)
+}
+
+// AddFeatureOverrideIDs adds the "feature_overrides" edge to the DeviceFeatureOverride entity by IDs.
+func (duo *DeviceUpdateOne) AddFeatureOverrideIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.AddFeatureOverrideIDs(ids...)
+	return duo
+}
+
+// AddFeatureOverrides adds the "feature_overrides" edges to the DeviceFeatureOverride entity.
+func (duo *DeviceUpdateOne) AddFeatureOverrides(d ...*DeviceFeatureOverride) *DeviceUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.AddFeatureOverrideIDs(ids...)
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (duo *DeviceUpdateOne) SetOrder(o *Order) *DeviceUpdateOne {
+	return duo.SetOrderID(o.ID)
+}
+
+// SetLot sets the "lot" edge to the Lot entity.
+func (duo *DeviceUpdateOne) SetLot(l *Lot) *DeviceUpdateOne {
+	return duo.SetLotID(l.ID)
+}
+
 // Mutation returns the DeviceMutation object of the builder.
 func (duo *DeviceUpdateOne) Mutation() *DeviceMutation {
 	return duo.mutation
@@ -625,15 +2097,138 @@ func (duo *DeviceUpdateOne) ClearLicenseType() *DeviceUpdateOne {
 	return duo
 }
 
-// ClearCreator clears the "creator" edge to the User entity.
-func (duo *DeviceUpdateOne) ClearCreator() *DeviceUpdateOne {
-	duo.mutation.ClearCreator()
+// ClearCreator clears the "creator" edge to the User entity.
+func (duo *DeviceUpdateOne) ClearCreator() *DeviceUpdateOne {
+	duo.mutation.ClearCreator()
+	return duo
+}
+
+// ClearUpdater clears the "updater" edge to the User entity.
+func (duo *DeviceUpdateOne) ClearUpdater() *DeviceUpdateOne {
+	duo.mutation.ClearUpdater()
+	return duo
+}
+
+// ClearTags clears all "tags" edges to the DeviceTag entity.
+func (duo *DeviceUpdateOne) ClearTags() *DeviceUpdateOne {
+	duo.mutation.ClearTags()
+	return duo
+}
+
+// RemoveTagIDs removes the "tags" edge to DeviceTag entities by IDs.
+func (duo *DeviceUpdateOne) RemoveTagIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.RemoveTagIDs(ids...)
+	return duo
+}
+
+// RemoveTags removes "tags" edges to DeviceTag entities.
+func (duo *DeviceUpdateOne) RemoveTags(d ...*DeviceTag) *DeviceUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.RemoveTagIDs(ids...)
+}
+
+// ClearGroups clears all "groups" edges to the DeviceGroup entity.
+func (duo *DeviceUpdateOne) ClearGroups() *DeviceUpdateOne {
+	duo.mutation.ClearGroups()
+	return duo
+}
+
+// RemoveGroupIDs removes the "groups" edge to DeviceGroup entities by IDs.
+func (duo *DeviceUpdateOne) RemoveGroupIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.RemoveGroupIDs(ids...)
+	return duo
+}
+
+// RemoveGroups removes "groups" edges to DeviceGroup entities.
+func (duo *DeviceUpdateOne) RemoveGroups(d ...*DeviceGroup) *DeviceUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.RemoveGroupIDs(ids...)
+}
+
+// ClearHeartbeats clears all "heartbeats" edges to the DeviceHeartbeat entity.
+func (duo *DeviceUpdateOne) ClearHeartbeats() *DeviceUpdateOne {
+	duo.mutation.ClearHeartbeats()
+	return duo
+}
+
+// RemoveHeartbeatIDs removes the "heartbeats" edge to DeviceHeartbeat entities by IDs.
+func (duo *DeviceUpdateOne) RemoveHeartbeatIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.RemoveHeartbeatIDs(ids...)
+	return duo
+}
+
+// RemoveHeartbeats removes "heartbeats" edges to DeviceHeartbeat entities.
+func (duo *DeviceUpdateOne) RemoveHeartbeats(d ...*DeviceHeartbeat) *DeviceUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.RemoveHeartbeatIDs(ids...)
+}
+
+// ClearCustomer clears the "customer" edge to the Customer entity.
+func (duo *DeviceUpdateOne) ClearCustomer() *DeviceUpdateOne {
+	duo.mutation.ClearCustomer()
+	return duo
+}
+
+// ClearAssignments clears all "assignments" edges to the DeviceAssignment entity.
+func (duo *DeviceUpdateOne) ClearAssignments() *DeviceUpdateOne {
+	duo.mutation.ClearAssignments()
+	return duo
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to DeviceAssignment entities by IDs.
+func (duo *DeviceUpdateOne) RemoveAssignmentIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.RemoveAssignmentIDs(ids...)
+	return duo
+}
+
+// RemoveAssignments removes "assignments" edges to DeviceAssignment entities.
+func (duo *DeviceUpdateOne) RemoveAssignments(d ...*DeviceAssignment) *DeviceUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.RemoveAssignmentIDs(ids...)
+}
+
+// ClearFeatureOverrides clears all "feature_overrides" edges to the DeviceFeatureOverride entity.
+func (duo *DeviceUpdateOne) ClearFeatureOverrides() *DeviceUpdateOne {
+	duo.mutation.ClearFeatureOverrides()
+	return duo
+}
+
+// RemoveFeatureOverrideIDs removes the "feature_overrides" edge to DeviceFeatureOverride entities by IDs.
+func (duo *DeviceUpdateOne) RemoveFeatureOverrideIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.RemoveFeatureOverrideIDs(ids...)
+	return duo
+}
+
+// RemoveFeatureOverrides removes "feature_overrides" edges to DeviceFeatureOverride entities.
+func (duo *DeviceUpdateOne) RemoveFeatureOverrides(d ...*DeviceFeatureOverride) *DeviceUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.RemoveFeatureOverrideIDs(ids...)
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (duo *DeviceUpdateOne) ClearOrder() *DeviceUpdateOne {
+	duo.mutation.ClearOrder()
 	return duo
 }
 
-// ClearUpdater clears the "updater" edge to the User entity.
-func (duo *DeviceUpdateOne) ClearUpdater() *DeviceUpdateOne {
-	duo.mutation.ClearUpdater()
+// ClearLot clears the "lot" edge to the Lot entity.
+func (duo *DeviceUpdateOne) ClearLot() *DeviceUpdateOne {
+	duo.mutation.ClearLot()
 	return duo
 }
 
@@ -679,6 +2274,11 @@ func (duo *DeviceUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (duo *DeviceUpdateOne) check() error {
+	if v, ok := duo.mutation.State(); ok {
+		if err := device.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Device.state": %w`, err)}
+		}
+	}
 	if _, ok := duo.mutation.ProductID(); duo.mutation.ProductCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Device.product"`)
 	}
@@ -714,6 +2314,18 @@ func (duo *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err err
 			}
 		}
 	}
+	if value, ok := duo.mutation.DeletedAt(); ok {
+		_spec.SetField(device.FieldDeletedAt, field.TypeTime, value)
+	}
+	if duo.mutation.DeletedAtCleared() {
+		_spec.ClearField(device.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.DeletedID(); ok {
+		_spec.SetField(device.FieldDeletedID, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.AddedDeletedID(); ok {
+		_spec.AddField(device.FieldDeletedID, field.TypeInt, value)
+	}
 	if value, ok := duo.mutation.Sn(); ok {
 		_spec.SetField(device.FieldSn, field.TypeString, value)
 	}
@@ -729,6 +2341,90 @@ func (duo *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err err
 	if duo.mutation.RemarkCleared() {
 		_spec.ClearField(device.FieldRemark, field.TypeString)
 	}
+	if value, ok := duo.mutation.State(); ok {
+		_spec.SetField(device.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := duo.mutation.ShippedAt(); ok {
+		_spec.SetField(device.FieldShippedAt, field.TypeTime, value)
+	}
+	if duo.mutation.ShippedAtCleared() {
+		_spec.ClearField(device.FieldShippedAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.ActivatedAt(); ok {
+		_spec.SetField(device.FieldActivatedAt, field.TypeTime, value)
+	}
+	if duo.mutation.ActivatedAtCleared() {
+		_spec.ClearField(device.FieldActivatedAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.SuspendedAt(); ok {
+		_spec.SetField(device.FieldSuspendedAt, field.TypeTime, value)
+	}
+	if duo.mutation.SuspendedAtCleared() {
+		_spec.ClearField(device.FieldSuspendedAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.RmaAt(); ok {
+		_spec.SetField(device.FieldRmaAt, field.TypeTime, value)
+	}
+	if duo.mutation.RmaAtCleared() {
+		_spec.ClearField(device.FieldRmaAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.ScrappedAt(); ok {
+		_spec.SetField(device.FieldScrappedAt, field.TypeTime, value)
+	}
+	if duo.mutation.ScrappedAtCleared() {
+		_spec.ClearField(device.FieldScrappedAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.LastSeenAt(); ok {
+		_spec.SetField(device.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if duo.mutation.LastSeenAtCleared() {
+		_spec.ClearField(device.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.WarrantyStartAt(); ok {
+		_spec.SetField(device.FieldWarrantyStartAt, field.TypeTime, value)
+	}
+	if duo.mutation.WarrantyStartAtCleared() {
+		_spec.ClearField(device.FieldWarrantyStartAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.WarrantyEndAt(); ok {
+		_spec.SetField(device.FieldWarrantyEndAt, field.TypeTime, value)
+	}
+	if duo.mutation.WarrantyEndAtCleared() {
+		_spec.ClearField(device.FieldWarrantyEndAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.Attributes(); ok {
+		_spec.SetField(device.FieldAttributes, field.TypeJSON, value)
+	}
+	if duo.mutation.AttributesCleared() {
+		_spec.ClearField(device.FieldAttributes, field.TypeJSON)
+	}
+	if value, ok := duo.mutation.LastSoftwareVersion(); ok {
+		_spec.SetField(device.FieldLastSoftwareVersion, field.TypeString, value)
+	}
+	if duo.mutation.LastSoftwareVersionCleared() {
+		_spec.ClearField(device.FieldLastSoftwareVersion, field.TypeString)
+	}
+	if value, ok := duo.mutation.LastFirmwareVersion(); ok {
+		_spec.SetField(device.FieldLastFirmwareVersion, field.TypeString, value)
+	}
+	if duo.mutation.LastFirmwareVersionCleared() {
+		_spec.ClearField(device.FieldLastFirmwareVersion, field.TypeString)
+	}
+	if value, ok := duo.mutation.LastUptime(); ok {
+		_spec.SetField(device.FieldLastUptime, field.TypeInt64, value)
+	}
+	if value, ok := duo.mutation.AddedLastUptime(); ok {
+		_spec.AddField(device.FieldLastUptime, field.TypeInt64, value)
+	}
+	if duo.mutation.LastUptimeCleared() {
+		_spec.ClearField(device.FieldLastUptime, field.TypeInt64)
+	}
+	if value, ok := duo.mutation.HeartbeatPublicKey(); ok {
+		_spec.SetField(device.FieldHeartbeatPublicKey, field.TypeString, value)
+	}
+	if duo.mutation.HeartbeatPublicKeyCleared() {
+		_spec.ClearField(device.FieldHeartbeatPublicKey, field.TypeString)
+	}
 	if value, ok := duo.mutation.CreatedAt(); ok {
 		_spec.SetField(device.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -851,6 +2547,318 @@ func (duo *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if duo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   device.TagsTable,
+			Columns: device.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !duo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   device.TagsTable,
+			Columns: device.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   device.TagsTable,
+			Columns: device.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   device.GroupsTable,
+			Columns: device.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !duo.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   device.GroupsTable,
+			Columns: device.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   device.GroupsTable,
+			Columns: device.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.HeartbeatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.HeartbeatsTable,
+			Columns: []string{device.HeartbeatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceheartbeat.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedHeartbeatsIDs(); len(nodes) > 0 && !duo.mutation.HeartbeatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.HeartbeatsTable,
+			Columns: []string{device.HeartbeatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceheartbeat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.HeartbeatsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.HeartbeatsTable,
+			Columns: []string{device.HeartbeatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceheartbeat.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.CustomerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.CustomerTable,
+			Columns: []string{device.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.CustomerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.CustomerTable,
+			Columns: []string{device.CustomerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.AssignmentsTable,
+			Columns: []string{device.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceassignment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !duo.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.AssignmentsTable,
+			Columns: []string{device.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceassignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.AssignmentsTable,
+			Columns: []string{device.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deviceassignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.FeatureOverridesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.FeatureOverridesTable,
+			Columns: []string{device.FeatureOverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicefeatureoverride.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedFeatureOverridesIDs(); len(nodes) > 0 && !duo.mutation.FeatureOverridesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.FeatureOverridesTable,
+			Columns: []string{device.FeatureOverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicefeatureoverride.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.FeatureOverridesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.FeatureOverridesTable,
+			Columns: []string{device.FeatureOverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicefeatureoverride.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.OrderTable,
+			Columns: []string{device.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.OrderTable,
+			Columns: []string{device.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.LotCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.LotTable,
+			Columns: []string{device.LotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.LotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.LotTable,
+			Columns: []string{device.LotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Device{config: duo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
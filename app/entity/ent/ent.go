@@ -9,20 +9,37 @@ import (
 	"reflect"
 	"sync"
 
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/apitoken"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/auditlog"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/customer"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/deviceassignment"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicefeatureoverride"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicegroup"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/deviceheartbeat"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicesavedfilter"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicetag"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/firmwareversion"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/job"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetypefeatures"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/lot"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/metricevent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/post"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/postcategory"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/posttag"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/posttagrelation"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productinvitation"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/registrationinvitation"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snallocator"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snblock"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snrule"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/softwareversion"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/twofactorpolicy"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -87,21 +104,38 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			auditlog.Table:            auditlog.ValidColumn,
-			device.Table:              device.ValidColumn,
-			firmwareversion.Table:     firmwareversion.ValidColumn,
-			licensetype.Table:         licensetype.ValidColumn,
-			licensetypefeatures.Table: licensetypefeatures.ValidColumn,
-			metricevent.Table:         metricevent.ValidColumn,
-			post.Table:                post.ValidColumn,
-			postcategory.Table:        postcategory.ValidColumn,
-			posttag.Table:             posttag.ValidColumn,
-			posttagrelation.Table:     posttagrelation.ValidColumn,
-			product.Table:             product.ValidColumn,
-			productfeature.Table:      productfeature.ValidColumn,
-			productmanager.Table:      productmanager.ValidColumn,
-			softwareversion.Table:     softwareversion.ValidColumn,
-			user.Table:                user.ValidColumn,
+			apitoken.Table:               apitoken.ValidColumn,
+			auditlog.Table:               auditlog.ValidColumn,
+			customer.Table:               customer.ValidColumn,
+			device.Table:                 device.ValidColumn,
+			deviceassignment.Table:       deviceassignment.ValidColumn,
+			devicefeatureoverride.Table:  devicefeatureoverride.ValidColumn,
+			devicegroup.Table:            devicegroup.ValidColumn,
+			deviceheartbeat.Table:        deviceheartbeat.ValidColumn,
+			devicesavedfilter.Table:      devicesavedfilter.ValidColumn,
+			devicetag.Table:              devicetag.ValidColumn,
+			firmwareversion.Table:        firmwareversion.ValidColumn,
+			job.Table:                    job.ValidColumn,
+			licensetype.Table:            licensetype.ValidColumn,
+			licensetypefeatures.Table:    licensetypefeatures.ValidColumn,
+			lot.Table:                    lot.ValidColumn,
+			metricevent.Table:            metricevent.ValidColumn,
+			order.Table:                  order.ValidColumn,
+			post.Table:                   post.ValidColumn,
+			postcategory.Table:           postcategory.ValidColumn,
+			posttag.Table:                posttag.ValidColumn,
+			posttagrelation.Table:        posttagrelation.ValidColumn,
+			product.Table:                product.ValidColumn,
+			productfeature.Table:         productfeature.ValidColumn,
+			productinvitation.Table:      productinvitation.ValidColumn,
+			productmanager.Table:         productmanager.ValidColumn,
+			registrationinvitation.Table: registrationinvitation.ValidColumn,
+			snallocator.Table:            snallocator.ValidColumn,
+			snblock.Table:                snblock.ValidColumn,
+			snrule.Table:                 snrule.ValidColumn,
+			softwareversion.Table:        softwareversion.ValidColumn,
+			twofactorpolicy.Table:        twofactorpolicy.ValidColumn,
+			user.Table:                   user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
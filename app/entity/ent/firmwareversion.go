@@ -19,6 +19,10 @@ type FirmwareVersion struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 删除标记，正常记录为0，删除后为记录ID，用于唯一索引
+	DeletedID int `json:"deleted_id,omitempty"`
 	// 产品ID
 	ProductID int `json:"product_id,omitempty"`
 	// 版本号
@@ -92,11 +96,11 @@ func (*FirmwareVersion) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case firmwareversion.FieldID, firmwareversion.FieldProductID, firmwareversion.FieldCreatedBy:
+		case firmwareversion.FieldID, firmwareversion.FieldDeletedID, firmwareversion.FieldProductID, firmwareversion.FieldCreatedBy:
 			values[i] = new(sql.NullInt64)
 		case firmwareversion.FieldVersion, firmwareversion.FieldRemark:
 			values[i] = new(sql.NullString)
-		case firmwareversion.FieldReleaseDate, firmwareversion.FieldCreatedAt, firmwareversion.FieldUpdatedAt:
+		case firmwareversion.FieldDeletedAt, firmwareversion.FieldReleaseDate, firmwareversion.FieldCreatedAt, firmwareversion.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -119,6 +123,19 @@ func (fv *FirmwareVersion) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			fv.ID = int(value.Int64)
+		case firmwareversion.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				fv.DeletedAt = new(time.Time)
+				*fv.DeletedAt = value.Time
+			}
+		case firmwareversion.FieldDeletedID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_id", values[i])
+			} else if value.Valid {
+				fv.DeletedID = int(value.Int64)
+			}
 		case firmwareversion.FieldProductID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field product_id", values[i])
@@ -212,6 +229,14 @@ func (fv *FirmwareVersion) String() string {
 	var builder strings.Builder
 	builder.WriteString("FirmwareVersion(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", fv.ID))
+	if v := fv.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deleted_id=")
+	builder.WriteString(fmt.Sprintf("%v", fv.DeletedID))
+	builder.WriteString(", ")
 	builder.WriteString("product_id=")
 	builder.WriteString(fmt.Sprintf("%v", fv.ProductID))
 	builder.WriteString(", ")
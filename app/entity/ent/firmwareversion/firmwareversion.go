@@ -5,6 +5,7 @@ package firmwareversion
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -14,6 +15,10 @@ const (
 	Label = "firmware_version"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldDeletedID holds the string denoting the deleted_id field in the database.
+	FieldDeletedID = "deleted_id"
 	// FieldProductID holds the string denoting the product_id field in the database.
 	FieldProductID = "product_id"
 	// FieldVersion holds the string denoting the version field in the database.
@@ -60,6 +65,8 @@ const (
 // Columns holds all SQL columns for firmwareversion fields.
 var Columns = []string{
 	FieldID,
+	FieldDeletedAt,
+	FieldDeletedID,
 	FieldProductID,
 	FieldVersion,
 	FieldReleaseDate,
@@ -85,7 +92,17 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "cambridge-hit.com/gin-base/activateserver/app/entity/ent/runtime"
 var (
+	Hooks        [2]ent.Hook
+	Interceptors [2]ent.Interceptor
+	Policy       ent.Policy
+	// DefaultDeletedID holds the default value on creation for the "deleted_id" field.
+	DefaultDeletedID int
 	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
 	VersionValidator func(string) error
 	// DefaultReleaseDate holds the default value on creation for the "release_date" field.
@@ -106,6 +123,16 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByDeletedID orders the results by the deleted_id field.
+func ByDeletedID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedID, opts...).ToFunc()
+}
+
 // ByProductID orders the results by the product_id field.
 func ByProductID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProductID, opts...).ToFunc()
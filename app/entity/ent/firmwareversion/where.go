@@ -55,6 +55,16 @@ func IDLTE(id int) predicate.FirmwareVersion {
 	return predicate.FirmwareVersion(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedID applies equality check predicate on the "deleted_id" field. It's identical to DeletedIDEQ.
+func DeletedID(v int) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldEQ(FieldDeletedID, v))
+}
+
 // ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
 func ProductID(v int) predicate.FirmwareVersion {
 	return predicate.FirmwareVersion(sql.FieldEQ(FieldProductID, v))
@@ -90,6 +100,96 @@ func UpdatedAt(v time.Time) predicate.FirmwareVersion {
 	return predicate.FirmwareVersion(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DeletedIDEQ applies the EQ predicate on the "deleted_id" field.
+func DeletedIDEQ(v int) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldEQ(FieldDeletedID, v))
+}
+
+// DeletedIDNEQ applies the NEQ predicate on the "deleted_id" field.
+func DeletedIDNEQ(v int) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldNEQ(FieldDeletedID, v))
+}
+
+// DeletedIDIn applies the In predicate on the "deleted_id" field.
+func DeletedIDIn(vs ...int) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldIn(FieldDeletedID, vs...))
+}
+
+// DeletedIDNotIn applies the NotIn predicate on the "deleted_id" field.
+func DeletedIDNotIn(vs ...int) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldNotIn(FieldDeletedID, vs...))
+}
+
+// DeletedIDGT applies the GT predicate on the "deleted_id" field.
+func DeletedIDGT(v int) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldGT(FieldDeletedID, v))
+}
+
+// DeletedIDGTE applies the GTE predicate on the "deleted_id" field.
+func DeletedIDGTE(v int) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldGTE(FieldDeletedID, v))
+}
+
+// DeletedIDLT applies the LT predicate on the "deleted_id" field.
+func DeletedIDLT(v int) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldLT(FieldDeletedID, v))
+}
+
+// DeletedIDLTE applies the LTE predicate on the "deleted_id" field.
+func DeletedIDLTE(v int) predicate.FirmwareVersion {
+	return predicate.FirmwareVersion(sql.FieldLTE(FieldDeletedID, v))
+}
+
 // ProductIDEQ applies the EQ predicate on the "product_id" field.
 func ProductIDEQ(v int) predicate.FirmwareVersion {
 	return predicate.FirmwareVersion(sql.FieldEQ(FieldProductID, v))
@@ -23,6 +23,34 @@ type FirmwareVersionCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (fvc *FirmwareVersionCreate) SetDeletedAt(t time.Time) *FirmwareVersionCreate {
+	fvc.mutation.SetDeletedAt(t)
+	return fvc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (fvc *FirmwareVersionCreate) SetNillableDeletedAt(t *time.Time) *FirmwareVersionCreate {
+	if t != nil {
+		fvc.SetDeletedAt(*t)
+	}
+	return fvc
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (fvc *FirmwareVersionCreate) SetDeletedID(i int) *FirmwareVersionCreate {
+	fvc.mutation.SetDeletedID(i)
+	return fvc
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (fvc *FirmwareVersionCreate) SetNillableDeletedID(i *int) *FirmwareVersionCreate {
+	if i != nil {
+		fvc.SetDeletedID(*i)
+	}
+	return fvc
+}
+
 // SetProductID sets the "product_id" field.
 func (fvc *FirmwareVersionCreate) SetProductID(i int) *FirmwareVersionCreate {
 	fvc.mutation.SetProductID(i)
@@ -135,7 +163,9 @@ func (fvc *FirmwareVersionCreate) Mutation() *FirmwareVersionMutation {
 
 // Save creates the FirmwareVersion in the database.
 func (fvc *FirmwareVersionCreate) Save(ctx context.Context) (*FirmwareVersion, error) {
-	fvc.defaults()
+	if err := fvc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, fvc.sqlSave, fvc.mutation, fvc.hooks)
 }
 
@@ -162,23 +192,40 @@ func (fvc *FirmwareVersionCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (fvc *FirmwareVersionCreate) defaults() {
+func (fvc *FirmwareVersionCreate) defaults() error {
+	if _, ok := fvc.mutation.DeletedID(); !ok {
+		v := firmwareversion.DefaultDeletedID
+		fvc.mutation.SetDeletedID(v)
+	}
 	if _, ok := fvc.mutation.ReleaseDate(); !ok {
+		if firmwareversion.DefaultReleaseDate == nil {
+			return fmt.Errorf("ent: uninitialized firmwareversion.DefaultReleaseDate (forgotten import ent/runtime?)")
+		}
 		v := firmwareversion.DefaultReleaseDate()
 		fvc.mutation.SetReleaseDate(v)
 	}
 	if _, ok := fvc.mutation.CreatedAt(); !ok {
+		if firmwareversion.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized firmwareversion.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := firmwareversion.DefaultCreatedAt()
 		fvc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := fvc.mutation.UpdatedAt(); !ok {
+		if firmwareversion.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized firmwareversion.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := firmwareversion.DefaultUpdatedAt()
 		fvc.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (fvc *FirmwareVersionCreate) check() error {
+	if _, ok := fvc.mutation.DeletedID(); !ok {
+		return &ValidationError{Name: "deleted_id", err: errors.New(`ent: missing required field "FirmwareVersion.deleted_id"`)}
+	}
 	if _, ok := fvc.mutation.ProductID(); !ok {
 		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "FirmwareVersion.product_id"`)}
 	}
@@ -234,6 +281,14 @@ func (fvc *FirmwareVersionCreate) createSpec() (*FirmwareVersion, *sqlgraph.Crea
 		_node = &FirmwareVersion{config: fvc.config}
 		_spec = sqlgraph.NewCreateSpec(firmwareversion.Table, sqlgraph.NewFieldSpec(firmwareversion.FieldID, field.TypeInt))
 	)
+	if value, ok := fvc.mutation.DeletedAt(); ok {
+		_spec.SetField(firmwareversion.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := fvc.mutation.DeletedID(); ok {
+		_spec.SetField(firmwareversion.FieldDeletedID, field.TypeInt, value)
+		_node.DeletedID = value
+	}
 	if value, ok := fvc.mutation.Version(); ok {
 		_spec.SetField(firmwareversion.FieldVersion, field.TypeString, value)
 		_node.Version = value
@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
@@ -13,6 +14,7 @@ import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/softwareversion"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -28,6 +30,7 @@ type FirmwareVersionQuery struct {
 	withSoftwareVersions *SoftwareVersionQuery
 	withProduct          *ProductQuery
 	withCreator          *UserQuery
+	modifiers            []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -370,12 +373,12 @@ func (fvq *FirmwareVersionQuery) WithCreator(opts ...func(*UserQuery)) *Firmware
 // Example:
 //
 //	var v []struct {
-//		ProductID int `json:"product_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.FirmwareVersion.Query().
-//		GroupBy(firmwareversion.FieldProductID).
+//		GroupBy(firmwareversion.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (fvq *FirmwareVersionQuery) GroupBy(field string, fields ...string) *FirmwareVersionGroupBy {
@@ -393,11 +396,11 @@ func (fvq *FirmwareVersionQuery) GroupBy(field string, fields ...string) *Firmwa
 // Example:
 //
 //	var v []struct {
-//		ProductID int `json:"product_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.FirmwareVersion.Query().
-//		Select(firmwareversion.FieldProductID).
+//		Select(firmwareversion.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (fvq *FirmwareVersionQuery) Select(fields ...string) *FirmwareVersionSelect {
 	fvq.ctx.Fields = append(fvq.ctx.Fields, fields...)
@@ -435,6 +438,12 @@ func (fvq *FirmwareVersionQuery) prepareQuery(ctx context.Context) error {
 		}
 		fvq.sql = prev
 	}
+	if firmwareversion.Policy == nil {
+		return errors.New("ent: uninitialized firmwareversion.Policy (forgotten import ent/runtime?)")
+	}
+	if err := firmwareversion.Policy.EvalQuery(ctx, fvq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -457,6 +466,9 @@ func (fvq *FirmwareVersionQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(fvq.modifiers) > 0 {
+		_spec.Modifiers = fvq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -612,6 +624,9 @@ func (fvq *FirmwareVersionQuery) loadCreator(ctx context.Context, query *UserQue
 
 func (fvq *FirmwareVersionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := fvq.querySpec()
+	if len(fvq.modifiers) > 0 {
+		_spec.Modifiers = fvq.modifiers
+	}
 	_spec.Node.Columns = fvq.ctx.Fields
 	if len(fvq.ctx.Fields) > 0 {
 		_spec.Unique = fvq.ctx.Unique != nil && *fvq.ctx.Unique
@@ -680,6 +695,9 @@ func (fvq *FirmwareVersionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if fvq.ctx.Unique != nil && *fvq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range fvq.modifiers {
+		m(selector)
+	}
 	for _, p := range fvq.predicates {
 		p(selector)
 	}
@@ -697,6 +715,32 @@ func (fvq *FirmwareVersionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (fvq *FirmwareVersionQuery) ForUpdate(opts ...sql.LockOption) *FirmwareVersionQuery {
+	if fvq.driver.Dialect() == dialect.Postgres {
+		fvq.Unique(false)
+	}
+	fvq.modifiers = append(fvq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return fvq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (fvq *FirmwareVersionQuery) ForShare(opts ...sql.LockOption) *FirmwareVersionQuery {
+	if fvq.driver.Dialect() == dialect.Postgres {
+		fvq.Unique(false)
+	}
+	fvq.modifiers = append(fvq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return fvq
+}
+
 // FirmwareVersionGroupBy is the group-by builder for FirmwareVersion entities.
 type FirmwareVersionGroupBy struct {
 	selector
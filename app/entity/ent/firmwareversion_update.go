@@ -31,6 +31,47 @@ func (fvu *FirmwareVersionUpdate) Where(ps ...predicate.FirmwareVersion) *Firmwa
 	return fvu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (fvu *FirmwareVersionUpdate) SetDeletedAt(t time.Time) *FirmwareVersionUpdate {
+	fvu.mutation.SetDeletedAt(t)
+	return fvu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (fvu *FirmwareVersionUpdate) SetNillableDeletedAt(t *time.Time) *FirmwareVersionUpdate {
+	if t != nil {
+		fvu.SetDeletedAt(*t)
+	}
+	return fvu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (fvu *FirmwareVersionUpdate) ClearDeletedAt() *FirmwareVersionUpdate {
+	fvu.mutation.ClearDeletedAt()
+	return fvu
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (fvu *FirmwareVersionUpdate) SetDeletedID(i int) *FirmwareVersionUpdate {
+	fvu.mutation.ResetDeletedID()
+	fvu.mutation.SetDeletedID(i)
+	return fvu
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (fvu *FirmwareVersionUpdate) SetNillableDeletedID(i *int) *FirmwareVersionUpdate {
+	if i != nil {
+		fvu.SetDeletedID(*i)
+	}
+	return fvu
+}
+
+// AddDeletedID adds i to the "deleted_id" field.
+func (fvu *FirmwareVersionUpdate) AddDeletedID(i int) *FirmwareVersionUpdate {
+	fvu.mutation.AddDeletedID(i)
+	return fvu
+}
+
 // SetProductID sets the "product_id" field.
 func (fvu *FirmwareVersionUpdate) SetProductID(i int) *FirmwareVersionUpdate {
 	fvu.mutation.SetProductID(i)
@@ -174,7 +215,9 @@ func (fvu *FirmwareVersionUpdate) ClearCreator() *FirmwareVersionUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (fvu *FirmwareVersionUpdate) Save(ctx context.Context) (int, error) {
-	fvu.defaults()
+	if err := fvu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, fvu.sqlSave, fvu.mutation, fvu.hooks)
 }
 
@@ -201,11 +244,15 @@ func (fvu *FirmwareVersionUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (fvu *FirmwareVersionUpdate) defaults() {
+func (fvu *FirmwareVersionUpdate) defaults() error {
 	if _, ok := fvu.mutation.UpdatedAt(); !ok {
+		if firmwareversion.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized firmwareversion.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := firmwareversion.UpdateDefaultUpdatedAt()
 		fvu.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -236,6 +283,18 @@ func (fvu *FirmwareVersionUpdate) sqlSave(ctx context.Context) (n int, err error
 			}
 		}
 	}
+	if value, ok := fvu.mutation.DeletedAt(); ok {
+		_spec.SetField(firmwareversion.FieldDeletedAt, field.TypeTime, value)
+	}
+	if fvu.mutation.DeletedAtCleared() {
+		_spec.ClearField(firmwareversion.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := fvu.mutation.DeletedID(); ok {
+		_spec.SetField(firmwareversion.FieldDeletedID, field.TypeInt, value)
+	}
+	if value, ok := fvu.mutation.AddedDeletedID(); ok {
+		_spec.AddField(firmwareversion.FieldDeletedID, field.TypeInt, value)
+	}
 	if value, ok := fvu.mutation.Version(); ok {
 		_spec.SetField(firmwareversion.FieldVersion, field.TypeString, value)
 	}
@@ -377,6 +436,47 @@ type FirmwareVersionUpdateOne struct {
 	mutation *FirmwareVersionMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (fvuo *FirmwareVersionUpdateOne) SetDeletedAt(t time.Time) *FirmwareVersionUpdateOne {
+	fvuo.mutation.SetDeletedAt(t)
+	return fvuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (fvuo *FirmwareVersionUpdateOne) SetNillableDeletedAt(t *time.Time) *FirmwareVersionUpdateOne {
+	if t != nil {
+		fvuo.SetDeletedAt(*t)
+	}
+	return fvuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (fvuo *FirmwareVersionUpdateOne) ClearDeletedAt() *FirmwareVersionUpdateOne {
+	fvuo.mutation.ClearDeletedAt()
+	return fvuo
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (fvuo *FirmwareVersionUpdateOne) SetDeletedID(i int) *FirmwareVersionUpdateOne {
+	fvuo.mutation.ResetDeletedID()
+	fvuo.mutation.SetDeletedID(i)
+	return fvuo
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (fvuo *FirmwareVersionUpdateOne) SetNillableDeletedID(i *int) *FirmwareVersionUpdateOne {
+	if i != nil {
+		fvuo.SetDeletedID(*i)
+	}
+	return fvuo
+}
+
+// AddDeletedID adds i to the "deleted_id" field.
+func (fvuo *FirmwareVersionUpdateOne) AddDeletedID(i int) *FirmwareVersionUpdateOne {
+	fvuo.mutation.AddDeletedID(i)
+	return fvuo
+}
+
 // SetProductID sets the "product_id" field.
 func (fvuo *FirmwareVersionUpdateOne) SetProductID(i int) *FirmwareVersionUpdateOne {
 	fvuo.mutation.SetProductID(i)
@@ -533,7 +633,9 @@ func (fvuo *FirmwareVersionUpdateOne) Select(field string, fields ...string) *Fi
 
 // Save executes the query and returns the updated FirmwareVersion entity.
 func (fvuo *FirmwareVersionUpdateOne) Save(ctx context.Context) (*FirmwareVersion, error) {
-	fvuo.defaults()
+	if err := fvuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, fvuo.sqlSave, fvuo.mutation, fvuo.hooks)
 }
 
@@ -560,11 +662,15 @@ func (fvuo *FirmwareVersionUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (fvuo *FirmwareVersionUpdateOne) defaults() {
+func (fvuo *FirmwareVersionUpdateOne) defaults() error {
 	if _, ok := fvuo.mutation.UpdatedAt(); !ok {
+		if firmwareversion.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized firmwareversion.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := firmwareversion.UpdateDefaultUpdatedAt()
 		fvuo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -612,6 +718,18 @@ func (fvuo *FirmwareVersionUpdateOne) sqlSave(ctx context.Context) (_node *Firmw
 			}
 		}
 	}
+	if value, ok := fvuo.mutation.DeletedAt(); ok {
+		_spec.SetField(firmwareversion.FieldDeletedAt, field.TypeTime, value)
+	}
+	if fvuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(firmwareversion.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := fvuo.mutation.DeletedID(); ok {
+		_spec.SetField(firmwareversion.FieldDeletedID, field.TypeInt, value)
+	}
+	if value, ok := fvuo.mutation.AddedDeletedID(); ok {
+		_spec.AddField(firmwareversion.FieldDeletedID, field.TypeInt, value)
+	}
 	if value, ok := fvuo.mutation.Version(); ok {
 		_spec.SetField(firmwareversion.FieldVersion, field.TypeString, value)
 	}
@@ -9,6 +9,18 @@ import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
 )
 
+// The APITokenFunc type is an adapter to allow the use of ordinary
+// function as APIToken mutator.
+type APITokenFunc func(context.Context, *ent.APITokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f APITokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.APITokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APITokenMutation", m)
+}
+
 // The AuditLogFunc type is an adapter to allow the use of ordinary
 // function as AuditLog mutator.
 type AuditLogFunc func(context.Context, *ent.AuditLogMutation) (ent.Value, error)
@@ -21,6 +33,18 @@ func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
 }
 
+// The CustomerFunc type is an adapter to allow the use of ordinary
+// function as Customer mutator.
+type CustomerFunc func(context.Context, *ent.CustomerMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CustomerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CustomerMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CustomerMutation", m)
+}
+
 // The DeviceFunc type is an adapter to allow the use of ordinary
 // function as Device mutator.
 type DeviceFunc func(context.Context, *ent.DeviceMutation) (ent.Value, error)
@@ -33,6 +57,78 @@ func (f DeviceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceMutation", m)
 }
 
+// The DeviceAssignmentFunc type is an adapter to allow the use of ordinary
+// function as DeviceAssignment mutator.
+type DeviceAssignmentFunc func(context.Context, *ent.DeviceAssignmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeviceAssignmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeviceAssignmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceAssignmentMutation", m)
+}
+
+// The DeviceFeatureOverrideFunc type is an adapter to allow the use of ordinary
+// function as DeviceFeatureOverride mutator.
+type DeviceFeatureOverrideFunc func(context.Context, *ent.DeviceFeatureOverrideMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeviceFeatureOverrideFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeviceFeatureOverrideMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceFeatureOverrideMutation", m)
+}
+
+// The DeviceGroupFunc type is an adapter to allow the use of ordinary
+// function as DeviceGroup mutator.
+type DeviceGroupFunc func(context.Context, *ent.DeviceGroupMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeviceGroupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeviceGroupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceGroupMutation", m)
+}
+
+// The DeviceHeartbeatFunc type is an adapter to allow the use of ordinary
+// function as DeviceHeartbeat mutator.
+type DeviceHeartbeatFunc func(context.Context, *ent.DeviceHeartbeatMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeviceHeartbeatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeviceHeartbeatMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceHeartbeatMutation", m)
+}
+
+// The DeviceSavedFilterFunc type is an adapter to allow the use of ordinary
+// function as DeviceSavedFilter mutator.
+type DeviceSavedFilterFunc func(context.Context, *ent.DeviceSavedFilterMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeviceSavedFilterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeviceSavedFilterMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceSavedFilterMutation", m)
+}
+
+// The DeviceTagFunc type is an adapter to allow the use of ordinary
+// function as DeviceTag mutator.
+type DeviceTagFunc func(context.Context, *ent.DeviceTagMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeviceTagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeviceTagMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceTagMutation", m)
+}
+
 // The FirmwareVersionFunc type is an adapter to allow the use of ordinary
 // function as FirmwareVersion mutator.
 type FirmwareVersionFunc func(context.Context, *ent.FirmwareVersionMutation) (ent.Value, error)
@@ -45,6 +141,18 @@ func (f FirmwareVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FirmwareVersionMutation", m)
 }
 
+// The JobFunc type is an adapter to allow the use of ordinary
+// function as Job mutator.
+type JobFunc func(context.Context, *ent.JobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.JobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JobMutation", m)
+}
+
 // The LicenseTypeFunc type is an adapter to allow the use of ordinary
 // function as LicenseType mutator.
 type LicenseTypeFunc func(context.Context, *ent.LicenseTypeMutation) (ent.Value, error)
@@ -69,6 +177,18 @@ func (f LicenseTypeFeaturesFunc) Mutate(ctx context.Context, m ent.Mutation) (en
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LicenseTypeFeaturesMutation", m)
 }
 
+// The LotFunc type is an adapter to allow the use of ordinary
+// function as Lot mutator.
+type LotFunc func(context.Context, *ent.LotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LotMutation", m)
+}
+
 // The MetricEventFunc type is an adapter to allow the use of ordinary
 // function as MetricEvent mutator.
 type MetricEventFunc func(context.Context, *ent.MetricEventMutation) (ent.Value, error)
@@ -81,6 +201,18 @@ func (f MetricEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MetricEventMutation", m)
 }
 
+// The OrderFunc type is an adapter to allow the use of ordinary
+// function as Order mutator.
+type OrderFunc func(context.Context, *ent.OrderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OrderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OrderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrderMutation", m)
+}
+
 // The PostFunc type is an adapter to allow the use of ordinary
 // function as Post mutator.
 type PostFunc func(context.Context, *ent.PostMutation) (ent.Value, error)
@@ -153,6 +285,18 @@ func (f ProductFeatureFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductFeatureMutation", m)
 }
 
+// The ProductInvitationFunc type is an adapter to allow the use of ordinary
+// function as ProductInvitation mutator.
+type ProductInvitationFunc func(context.Context, *ent.ProductInvitationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProductInvitationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProductInvitationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductInvitationMutation", m)
+}
+
 // The ProductManagerFunc type is an adapter to allow the use of ordinary
 // function as ProductManager mutator.
 type ProductManagerFunc func(context.Context, *ent.ProductManagerMutation) (ent.Value, error)
@@ -165,6 +309,54 @@ func (f ProductManagerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProductManagerMutation", m)
 }
 
+// The RegistrationInvitationFunc type is an adapter to allow the use of ordinary
+// function as RegistrationInvitation mutator.
+type RegistrationInvitationFunc func(context.Context, *ent.RegistrationInvitationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RegistrationInvitationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RegistrationInvitationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RegistrationInvitationMutation", m)
+}
+
+// The SnAllocatorFunc type is an adapter to allow the use of ordinary
+// function as SnAllocator mutator.
+type SnAllocatorFunc func(context.Context, *ent.SnAllocatorMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SnAllocatorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SnAllocatorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SnAllocatorMutation", m)
+}
+
+// The SnBlockFunc type is an adapter to allow the use of ordinary
+// function as SnBlock mutator.
+type SnBlockFunc func(context.Context, *ent.SnBlockMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SnBlockFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SnBlockMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SnBlockMutation", m)
+}
+
+// The SnRuleFunc type is an adapter to allow the use of ordinary
+// function as SnRule mutator.
+type SnRuleFunc func(context.Context, *ent.SnRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SnRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SnRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SnRuleMutation", m)
+}
+
 // The SoftwareVersionFunc type is an adapter to allow the use of ordinary
 // function as SoftwareVersion mutator.
 type SoftwareVersionFunc func(context.Context, *ent.SoftwareVersionMutation) (ent.Value, error)
@@ -177,6 +369,18 @@ func (f SoftwareVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SoftwareVersionMutation", m)
 }
 
+// The TwoFactorPolicyFunc type is an adapter to allow the use of ordinary
+// function as TwoFactorPolicy mutator.
+type TwoFactorPolicyFunc func(context.Context, *ent.TwoFactorPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TwoFactorPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TwoFactorPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TwoFactorPolicyMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
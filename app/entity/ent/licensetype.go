@@ -18,12 +18,18 @@ type LicenseType struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 删除标记，正常记录为0，删除后为记录ID，用于唯一索引
+	DeletedID int `json:"deleted_id,omitempty"`
 	// 许可证类型名称
 	TypeName string `json:"type_name,omitempty"`
 	// 许可证编码
 	LicenseType string `json:"license_type,omitempty"`
 	// 所属产品ID
 	ProductID int `json:"product_id,omitempty"`
+	// 父许可证类型ID，继承父类型的全部功能
+	ParentID *int `json:"parent_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -42,11 +48,19 @@ type LicenseTypeEdges struct {
 	Features []*ProductFeature `json:"features,omitempty"`
 	// Devices holds the value of the devices edge.
 	Devices []*Device `json:"devices,omitempty"`
+	// Orders holds the value of the orders edge.
+	Orders []*Order `json:"orders,omitempty"`
+	// Lots holds the value of the lots edge.
+	Lots []*Lot `json:"lots,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *LicenseType `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*LicenseType `json:"children,omitempty"`
 	// LicenseTypeFeatures holds the value of the license_type_features edge.
 	LicenseTypeFeatures []*LicenseTypeFeatures `json:"license_type_features,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [8]bool
 }
 
 // ProductOrErr returns the Product value or an error if the edge
@@ -80,10 +94,50 @@ func (e LicenseTypeEdges) DevicesOrErr() ([]*Device, error) {
 	return nil, &NotLoadedError{edge: "devices"}
 }
 
+// OrdersOrErr returns the Orders value or an error if the edge
+// was not loaded in eager-loading.
+func (e LicenseTypeEdges) OrdersOrErr() ([]*Order, error) {
+	if e.loadedTypes[3] {
+		return e.Orders, nil
+	}
+	return nil, &NotLoadedError{edge: "orders"}
+}
+
+// LotsOrErr returns the Lots value or an error if the edge
+// was not loaded in eager-loading.
+func (e LicenseTypeEdges) LotsOrErr() ([]*Lot, error) {
+	if e.loadedTypes[4] {
+		return e.Lots, nil
+	}
+	return nil, &NotLoadedError{edge: "lots"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LicenseTypeEdges) ParentOrErr() (*LicenseType, error) {
+	if e.loadedTypes[5] {
+		if e.Parent == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: licensetype.Label}
+		}
+		return e.Parent, nil
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e LicenseTypeEdges) ChildrenOrErr() ([]*LicenseType, error) {
+	if e.loadedTypes[6] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
 // LicenseTypeFeaturesOrErr returns the LicenseTypeFeatures value or an error if the edge
 // was not loaded in eager-loading.
 func (e LicenseTypeEdges) LicenseTypeFeaturesOrErr() ([]*LicenseTypeFeatures, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[7] {
 		return e.LicenseTypeFeatures, nil
 	}
 	return nil, &NotLoadedError{edge: "license_type_features"}
@@ -94,11 +148,11 @@ func (*LicenseType) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case licensetype.FieldID, licensetype.FieldProductID:
+		case licensetype.FieldID, licensetype.FieldDeletedID, licensetype.FieldProductID, licensetype.FieldParentID:
 			values[i] = new(sql.NullInt64)
 		case licensetype.FieldTypeName, licensetype.FieldLicenseType:
 			values[i] = new(sql.NullString)
-		case licensetype.FieldCreatedAt, licensetype.FieldUpdatedAt:
+		case licensetype.FieldDeletedAt, licensetype.FieldCreatedAt, licensetype.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -121,6 +175,19 @@ func (lt *LicenseType) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			lt.ID = int(value.Int64)
+		case licensetype.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				lt.DeletedAt = new(time.Time)
+				*lt.DeletedAt = value.Time
+			}
+		case licensetype.FieldDeletedID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_id", values[i])
+			} else if value.Valid {
+				lt.DeletedID = int(value.Int64)
+			}
 		case licensetype.FieldTypeName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type_name", values[i])
@@ -139,6 +206,13 @@ func (lt *LicenseType) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				lt.ProductID = int(value.Int64)
 			}
+		case licensetype.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				lt.ParentID = new(int)
+				*lt.ParentID = int(value.Int64)
+			}
 		case licensetype.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -179,6 +253,26 @@ func (lt *LicenseType) QueryDevices() *DeviceQuery {
 	return NewLicenseTypeClient(lt.config).QueryDevices(lt)
 }
 
+// QueryOrders queries the "orders" edge of the LicenseType entity.
+func (lt *LicenseType) QueryOrders() *OrderQuery {
+	return NewLicenseTypeClient(lt.config).QueryOrders(lt)
+}
+
+// QueryLots queries the "lots" edge of the LicenseType entity.
+func (lt *LicenseType) QueryLots() *LotQuery {
+	return NewLicenseTypeClient(lt.config).QueryLots(lt)
+}
+
+// QueryParent queries the "parent" edge of the LicenseType entity.
+func (lt *LicenseType) QueryParent() *LicenseTypeQuery {
+	return NewLicenseTypeClient(lt.config).QueryParent(lt)
+}
+
+// QueryChildren queries the "children" edge of the LicenseType entity.
+func (lt *LicenseType) QueryChildren() *LicenseTypeQuery {
+	return NewLicenseTypeClient(lt.config).QueryChildren(lt)
+}
+
 // QueryLicenseTypeFeatures queries the "license_type_features" edge of the LicenseType entity.
 func (lt *LicenseType) QueryLicenseTypeFeatures() *LicenseTypeFeaturesQuery {
 	return NewLicenseTypeClient(lt.config).QueryLicenseTypeFeatures(lt)
@@ -207,6 +301,14 @@ func (lt *LicenseType) String() string {
 	var builder strings.Builder
 	builder.WriteString("LicenseType(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", lt.ID))
+	if v := lt.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deleted_id=")
+	builder.WriteString(fmt.Sprintf("%v", lt.DeletedID))
+	builder.WriteString(", ")
 	builder.WriteString("type_name=")
 	builder.WriteString(lt.TypeName)
 	builder.WriteString(", ")
@@ -216,6 +318,11 @@ func (lt *LicenseType) String() string {
 	builder.WriteString("product_id=")
 	builder.WriteString(fmt.Sprintf("%v", lt.ProductID))
 	builder.WriteString(", ")
+	if v := lt.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(lt.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
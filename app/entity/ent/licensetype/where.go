@@ -55,6 +55,16 @@ func IDLTE(id int) predicate.LicenseType {
 	return predicate.LicenseType(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedID applies equality check predicate on the "deleted_id" field. It's identical to DeletedIDEQ.
+func DeletedID(v int) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldEQ(FieldDeletedID, v))
+}
+
 // TypeName applies equality check predicate on the "type_name" field. It's identical to TypeNameEQ.
 func TypeName(v string) predicate.LicenseType {
 	return predicate.LicenseType(sql.FieldEQ(FieldTypeName, v))
@@ -70,6 +80,11 @@ func ProductID(v int) predicate.LicenseType {
 	return predicate.LicenseType(sql.FieldEQ(FieldProductID, v))
 }
 
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v int) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldEQ(FieldParentID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.LicenseType {
 	return predicate.LicenseType(sql.FieldEQ(FieldCreatedAt, v))
@@ -80,6 +95,96 @@ func UpdatedAt(v time.Time) predicate.LicenseType {
 	return predicate.LicenseType(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DeletedIDEQ applies the EQ predicate on the "deleted_id" field.
+func DeletedIDEQ(v int) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldEQ(FieldDeletedID, v))
+}
+
+// DeletedIDNEQ applies the NEQ predicate on the "deleted_id" field.
+func DeletedIDNEQ(v int) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldNEQ(FieldDeletedID, v))
+}
+
+// DeletedIDIn applies the In predicate on the "deleted_id" field.
+func DeletedIDIn(vs ...int) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldIn(FieldDeletedID, vs...))
+}
+
+// DeletedIDNotIn applies the NotIn predicate on the "deleted_id" field.
+func DeletedIDNotIn(vs ...int) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldNotIn(FieldDeletedID, vs...))
+}
+
+// DeletedIDGT applies the GT predicate on the "deleted_id" field.
+func DeletedIDGT(v int) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldGT(FieldDeletedID, v))
+}
+
+// DeletedIDGTE applies the GTE predicate on the "deleted_id" field.
+func DeletedIDGTE(v int) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldGTE(FieldDeletedID, v))
+}
+
+// DeletedIDLT applies the LT predicate on the "deleted_id" field.
+func DeletedIDLT(v int) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldLT(FieldDeletedID, v))
+}
+
+// DeletedIDLTE applies the LTE predicate on the "deleted_id" field.
+func DeletedIDLTE(v int) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldLTE(FieldDeletedID, v))
+}
+
 // TypeNameEQ applies the EQ predicate on the "type_name" field.
 func TypeNameEQ(v string) predicate.LicenseType {
 	return predicate.LicenseType(sql.FieldEQ(FieldTypeName, v))
@@ -230,6 +335,36 @@ func ProductIDNotIn(vs ...int) predicate.LicenseType {
 	return predicate.LicenseType(sql.FieldNotIn(FieldProductID, vs...))
 }
 
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v int) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v int) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...int) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...int) predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.LicenseType {
+	return predicate.LicenseType(sql.FieldNotNull(FieldParentID))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.LicenseType {
 	return predicate.LicenseType(sql.FieldEQ(FieldCreatedAt, v))
@@ -379,6 +514,98 @@ func HasDevicesWith(preds ...predicate.Device) predicate.LicenseType {
 	})
 }
 
+// HasOrders applies the HasEdge predicate on the "orders" edge.
+func HasOrders() predicate.LicenseType {
+	return predicate.LicenseType(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OrdersTable, OrdersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrdersWith applies the HasEdge predicate on the "orders" edge with a given conditions (other predicates).
+func HasOrdersWith(preds ...predicate.Order) predicate.LicenseType {
+	return predicate.LicenseType(func(s *sql.Selector) {
+		step := newOrdersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLots applies the HasEdge predicate on the "lots" edge.
+func HasLots() predicate.LicenseType {
+	return predicate.LicenseType(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LotsTable, LotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLotsWith applies the HasEdge predicate on the "lots" edge with a given conditions (other predicates).
+func HasLotsWith(preds ...predicate.Lot) predicate.LicenseType {
+	return predicate.LicenseType(func(s *sql.Selector) {
+		step := newLotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.LicenseType {
+	return predicate.LicenseType(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.LicenseType) predicate.LicenseType {
+	return predicate.LicenseType(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.LicenseType {
+	return predicate.LicenseType(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.LicenseType) predicate.LicenseType {
+	return predicate.LicenseType(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasLicenseTypeFeatures applies the HasEdge predicate on the "license_type_features" edge.
 func HasLicenseTypeFeatures() predicate.LicenseType {
 	return predicate.LicenseType(func(s *sql.Selector) {
@@ -11,6 +11,8 @@ import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetypefeatures"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/lot"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -24,6 +26,34 @@ type LicenseTypeCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ltc *LicenseTypeCreate) SetDeletedAt(t time.Time) *LicenseTypeCreate {
+	ltc.mutation.SetDeletedAt(t)
+	return ltc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ltc *LicenseTypeCreate) SetNillableDeletedAt(t *time.Time) *LicenseTypeCreate {
+	if t != nil {
+		ltc.SetDeletedAt(*t)
+	}
+	return ltc
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (ltc *LicenseTypeCreate) SetDeletedID(i int) *LicenseTypeCreate {
+	ltc.mutation.SetDeletedID(i)
+	return ltc
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (ltc *LicenseTypeCreate) SetNillableDeletedID(i *int) *LicenseTypeCreate {
+	if i != nil {
+		ltc.SetDeletedID(*i)
+	}
+	return ltc
+}
+
 // SetTypeName sets the "type_name" field.
 func (ltc *LicenseTypeCreate) SetTypeName(s string) *LicenseTypeCreate {
 	ltc.mutation.SetTypeName(s)
@@ -42,6 +72,20 @@ func (ltc *LicenseTypeCreate) SetProductID(i int) *LicenseTypeCreate {
 	return ltc
 }
 
+// SetParentID sets the "parent_id" field.
+func (ltc *LicenseTypeCreate) SetParentID(i int) *LicenseTypeCreate {
+	ltc.mutation.SetParentID(i)
+	return ltc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (ltc *LicenseTypeCreate) SetNillableParentID(i *int) *LicenseTypeCreate {
+	if i != nil {
+		ltc.SetParentID(*i)
+	}
+	return ltc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ltc *LicenseTypeCreate) SetCreatedAt(t time.Time) *LicenseTypeCreate {
 	ltc.mutation.SetCreatedAt(t)
@@ -111,6 +155,56 @@ func (ltc *LicenseTypeCreate) AddDevices(d ...*Device) *LicenseTypeCreate {
 	return ltc.AddDeviceIDs(ids...)
 }
 
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (ltc *LicenseTypeCreate) AddOrderIDs(ids ...int) *LicenseTypeCreate {
+	ltc.mutation.AddOrderIDs(ids...)
+	return ltc
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (ltc *LicenseTypeCreate) AddOrders(o ...*Order) *LicenseTypeCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ltc.AddOrderIDs(ids...)
+}
+
+// AddLotIDs adds the "lots" edge to the Lot entity by IDs.
+func (ltc *LicenseTypeCreate) AddLotIDs(ids ...int) *LicenseTypeCreate {
+	ltc.mutation.AddLotIDs(ids...)
+	return ltc
+}
+
+// AddLots adds the "lots" edges to the Lot entity.
+func (ltc *LicenseTypeCreate) AddLots(l ...*Lot) *LicenseTypeCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltc.AddLotIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the LicenseType entity.
+func (ltc *LicenseTypeCreate) SetParent(l *LicenseType) *LicenseTypeCreate {
+	return ltc.SetParentID(l.ID)
+}
+
+// AddChildIDs adds the "children" edge to the LicenseType entity by IDs.
+func (ltc *LicenseTypeCreate) AddChildIDs(ids ...int) *LicenseTypeCreate {
+	ltc.mutation.AddChildIDs(ids...)
+	return ltc
+}
+
+// AddChildren adds the "children" edges to the LicenseType entity.
+func (ltc *LicenseTypeCreate) AddChildren(l ...*LicenseType) *LicenseTypeCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltc.AddChildIDs(ids...)
+}
+
 // AddLicenseTypeFeatureIDs adds the "license_type_features" edge to the LicenseTypeFeatures entity by IDs.
 func (ltc *LicenseTypeCreate) AddLicenseTypeFeatureIDs(ids ...int) *LicenseTypeCreate {
 	ltc.mutation.AddLicenseTypeFeatureIDs(ids...)
@@ -133,7 +227,9 @@ func (ltc *LicenseTypeCreate) Mutation() *LicenseTypeMutation {
 
 // Save creates the LicenseType in the database.
 func (ltc *LicenseTypeCreate) Save(ctx context.Context) (*LicenseType, error) {
-	ltc.defaults()
+	if err := ltc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, ltc.sqlSave, ltc.mutation, ltc.hooks)
 }
 
@@ -160,19 +256,33 @@ func (ltc *LicenseTypeCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (ltc *LicenseTypeCreate) defaults() {
+func (ltc *LicenseTypeCreate) defaults() error {
+	if _, ok := ltc.mutation.DeletedID(); !ok {
+		v := licensetype.DefaultDeletedID
+		ltc.mutation.SetDeletedID(v)
+	}
 	if _, ok := ltc.mutation.CreatedAt(); !ok {
+		if licensetype.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized licensetype.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := licensetype.DefaultCreatedAt()
 		ltc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := ltc.mutation.UpdatedAt(); !ok {
+		if licensetype.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized licensetype.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := licensetype.DefaultUpdatedAt()
 		ltc.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (ltc *LicenseTypeCreate) check() error {
+	if _, ok := ltc.mutation.DeletedID(); !ok {
+		return &ValidationError{Name: "deleted_id", err: errors.New(`ent: missing required field "LicenseType.deleted_id"`)}
+	}
 	if _, ok := ltc.mutation.TypeName(); !ok {
 		return &ValidationError{Name: "type_name", err: errors.New(`ent: missing required field "LicenseType.type_name"`)}
 	}
@@ -238,6 +348,14 @@ func (ltc *LicenseTypeCreate) createSpec() (*LicenseType, *sqlgraph.CreateSpec)
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := ltc.mutation.DeletedAt(); ok {
+		_spec.SetField(licensetype.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := ltc.mutation.DeletedID(); ok {
+		_spec.SetField(licensetype.FieldDeletedID, field.TypeInt, value)
+		_node.DeletedID = value
+	}
 	if value, ok := ltc.mutation.TypeName(); ok {
 		_spec.SetField(licensetype.FieldTypeName, field.TypeString, value)
 		_node.TypeName = value
@@ -307,6 +425,71 @@ func (ltc *LicenseTypeCreate) createSpec() (*LicenseType, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ltc.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.OrdersTable,
+			Columns: []string{licensetype.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ltc.mutation.LotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.LotsTable,
+			Columns: []string{licensetype.LotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ltc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   licensetype.ParentTable,
+			Columns: []string{licensetype.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(licensetype.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ltc.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.ChildrenTable,
+			Columns: []string{licensetype.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(licensetype.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := ltc.mutation.LicenseTypeFeaturesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
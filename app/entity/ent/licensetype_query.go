@@ -5,15 +5,19 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetypefeatures"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/lot"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -29,7 +33,12 @@ type LicenseTypeQuery struct {
 	withProduct             *ProductQuery
 	withFeatures            *ProductFeatureQuery
 	withDevices             *DeviceQuery
+	withOrders              *OrderQuery
+	withLots                *LotQuery
+	withParent              *LicenseTypeQuery
+	withChildren            *LicenseTypeQuery
 	withLicenseTypeFeatures *LicenseTypeFeaturesQuery
+	modifiers               []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -132,6 +141,94 @@ func (ltq *LicenseTypeQuery) QueryDevices() *DeviceQuery {
 	return query
 }
 
+// QueryOrders chains the current query on the "orders" edge.
+func (ltq *LicenseTypeQuery) QueryOrders() *OrderQuery {
+	query := (&OrderClient{config: ltq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ltq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ltq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(licensetype.Table, licensetype.FieldID, selector),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, licensetype.OrdersTable, licensetype.OrdersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ltq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLots chains the current query on the "lots" edge.
+func (ltq *LicenseTypeQuery) QueryLots() *LotQuery {
+	query := (&LotClient{config: ltq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ltq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ltq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(licensetype.Table, licensetype.FieldID, selector),
+			sqlgraph.To(lot.Table, lot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, licensetype.LotsTable, licensetype.LotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ltq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (ltq *LicenseTypeQuery) QueryParent() *LicenseTypeQuery {
+	query := (&LicenseTypeClient{config: ltq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ltq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ltq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(licensetype.Table, licensetype.FieldID, selector),
+			sqlgraph.To(licensetype.Table, licensetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, licensetype.ParentTable, licensetype.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ltq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the "children" edge.
+func (ltq *LicenseTypeQuery) QueryChildren() *LicenseTypeQuery {
+	query := (&LicenseTypeClient{config: ltq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ltq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ltq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(licensetype.Table, licensetype.FieldID, selector),
+			sqlgraph.To(licensetype.Table, licensetype.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, licensetype.ChildrenTable, licensetype.ChildrenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ltq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryLicenseTypeFeatures chains the current query on the "license_type_features" edge.
 func (ltq *LicenseTypeQuery) QueryLicenseTypeFeatures() *LicenseTypeFeaturesQuery {
 	query := (&LicenseTypeFeaturesClient{config: ltq.config}).Query()
@@ -349,6 +446,10 @@ func (ltq *LicenseTypeQuery) Clone() *LicenseTypeQuery {
 		withProduct:             ltq.withProduct.Clone(),
 		withFeatures:            ltq.withFeatures.Clone(),
 		withDevices:             ltq.withDevices.Clone(),
+		withOrders:              ltq.withOrders.Clone(),
+		withLots:                ltq.withLots.Clone(),
+		withParent:              ltq.withParent.Clone(),
+		withChildren:            ltq.withChildren.Clone(),
 		withLicenseTypeFeatures: ltq.withLicenseTypeFeatures.Clone(),
 		// clone intermediate query.
 		sql:  ltq.sql.Clone(),
@@ -389,6 +490,50 @@ func (ltq *LicenseTypeQuery) WithDevices(opts ...func(*DeviceQuery)) *LicenseTyp
 	return ltq
 }
 
+// WithOrders tells the query-builder to eager-load the nodes that are connected to
+// the "orders" edge. The optional arguments are used to configure the query builder of the edge.
+func (ltq *LicenseTypeQuery) WithOrders(opts ...func(*OrderQuery)) *LicenseTypeQuery {
+	query := (&OrderClient{config: ltq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ltq.withOrders = query
+	return ltq
+}
+
+// WithLots tells the query-builder to eager-load the nodes that are connected to
+// the "lots" edge. The optional arguments are used to configure the query builder of the edge.
+func (ltq *LicenseTypeQuery) WithLots(opts ...func(*LotQuery)) *LicenseTypeQuery {
+	query := (&LotClient{config: ltq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ltq.withLots = query
+	return ltq
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (ltq *LicenseTypeQuery) WithParent(opts ...func(*LicenseTypeQuery)) *LicenseTypeQuery {
+	query := (&LicenseTypeClient{config: ltq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ltq.withParent = query
+	return ltq
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (ltq *LicenseTypeQuery) WithChildren(opts ...func(*LicenseTypeQuery)) *LicenseTypeQuery {
+	query := (&LicenseTypeClient{config: ltq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ltq.withChildren = query
+	return ltq
+}
+
 // WithLicenseTypeFeatures tells the query-builder to eager-load the nodes that are connected to
 // the "license_type_features" edge. The optional arguments are used to configure the query builder of the edge.
 func (ltq *LicenseTypeQuery) WithLicenseTypeFeatures(opts ...func(*LicenseTypeFeaturesQuery)) *LicenseTypeQuery {
@@ -406,12 +551,12 @@ func (ltq *LicenseTypeQuery) WithLicenseTypeFeatures(opts ...func(*LicenseTypeFe
 // Example:
 //
 //	var v []struct {
-//		TypeName string `json:"type_name,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.LicenseType.Query().
-//		GroupBy(licensetype.FieldTypeName).
+//		GroupBy(licensetype.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (ltq *LicenseTypeQuery) GroupBy(field string, fields ...string) *LicenseTypeGroupBy {
@@ -429,11 +574,11 @@ func (ltq *LicenseTypeQuery) GroupBy(field string, fields ...string) *LicenseTyp
 // Example:
 //
 //	var v []struct {
-//		TypeName string `json:"type_name,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.LicenseType.Query().
-//		Select(licensetype.FieldTypeName).
+//		Select(licensetype.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (ltq *LicenseTypeQuery) Select(fields ...string) *LicenseTypeSelect {
 	ltq.ctx.Fields = append(ltq.ctx.Fields, fields...)
@@ -471,6 +616,12 @@ func (ltq *LicenseTypeQuery) prepareQuery(ctx context.Context) error {
 		}
 		ltq.sql = prev
 	}
+	if licensetype.Policy == nil {
+		return errors.New("ent: uninitialized licensetype.Policy (forgotten import ent/runtime?)")
+	}
+	if err := licensetype.Policy.EvalQuery(ctx, ltq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -478,10 +629,14 @@ func (ltq *LicenseTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*LicenseType{}
 		_spec       = ltq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [8]bool{
 			ltq.withProduct != nil,
 			ltq.withFeatures != nil,
 			ltq.withDevices != nil,
+			ltq.withOrders != nil,
+			ltq.withLots != nil,
+			ltq.withParent != nil,
+			ltq.withChildren != nil,
 			ltq.withLicenseTypeFeatures != nil,
 		}
 	)
@@ -494,6 +649,9 @@ func (ltq *LicenseTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(ltq.modifiers) > 0 {
+		_spec.Modifiers = ltq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -523,6 +681,33 @@ func (ltq *LicenseTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := ltq.withOrders; query != nil {
+		if err := ltq.loadOrders(ctx, query, nodes,
+			func(n *LicenseType) { n.Edges.Orders = []*Order{} },
+			func(n *LicenseType, e *Order) { n.Edges.Orders = append(n.Edges.Orders, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ltq.withLots; query != nil {
+		if err := ltq.loadLots(ctx, query, nodes,
+			func(n *LicenseType) { n.Edges.Lots = []*Lot{} },
+			func(n *LicenseType, e *Lot) { n.Edges.Lots = append(n.Edges.Lots, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ltq.withParent; query != nil {
+		if err := ltq.loadParent(ctx, query, nodes, nil,
+			func(n *LicenseType, e *LicenseType) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ltq.withChildren; query != nil {
+		if err := ltq.loadChildren(ctx, query, nodes,
+			func(n *LicenseType) { n.Edges.Children = []*LicenseType{} },
+			func(n *LicenseType, e *LicenseType) { n.Edges.Children = append(n.Edges.Children, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := ltq.withLicenseTypeFeatures; query != nil {
 		if err := ltq.loadLicenseTypeFeatures(ctx, query, nodes,
 			func(n *LicenseType) { n.Edges.LicenseTypeFeatures = []*LicenseTypeFeatures{} },
@@ -655,6 +840,131 @@ func (ltq *LicenseTypeQuery) loadDevices(ctx context.Context, query *DeviceQuery
 	}
 	return nil
 }
+func (ltq *LicenseTypeQuery) loadOrders(ctx context.Context, query *OrderQuery, nodes []*LicenseType, init func(*LicenseType), assign func(*LicenseType, *Order)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*LicenseType)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(order.FieldLicenseTypeID)
+	}
+	query.Where(predicate.Order(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(licensetype.OrdersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LicenseTypeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "license_type_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (ltq *LicenseTypeQuery) loadLots(ctx context.Context, query *LotQuery, nodes []*LicenseType, init func(*LicenseType), assign func(*LicenseType, *Lot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*LicenseType)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(lot.FieldLicenseTypeID)
+	}
+	query.Where(predicate.Lot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(licensetype.LotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LicenseTypeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "license_type_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (ltq *LicenseTypeQuery) loadParent(ctx context.Context, query *LicenseTypeQuery, nodes []*LicenseType, init func(*LicenseType), assign func(*LicenseType, *LicenseType)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*LicenseType)
+	for i := range nodes {
+		if nodes[i].ParentID == nil {
+			continue
+		}
+		fk := *nodes[i].ParentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(licensetype.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "parent_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (ltq *LicenseTypeQuery) loadChildren(ctx context.Context, query *LicenseTypeQuery, nodes []*LicenseType, init func(*LicenseType), assign func(*LicenseType, *LicenseType)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*LicenseType)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(licensetype.FieldParentID)
+	}
+	query.Where(predicate.LicenseType(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(licensetype.ChildrenColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ParentID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "parent_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (ltq *LicenseTypeQuery) loadLicenseTypeFeatures(ctx context.Context, query *LicenseTypeFeaturesQuery, nodes []*LicenseType, init func(*LicenseType), assign func(*LicenseType, *LicenseTypeFeatures)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*LicenseType)
@@ -688,6 +998,9 @@ func (ltq *LicenseTypeQuery) loadLicenseTypeFeatures(ctx context.Context, query
 
 func (ltq *LicenseTypeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ltq.querySpec()
+	if len(ltq.modifiers) > 0 {
+		_spec.Modifiers = ltq.modifiers
+	}
 	_spec.Node.Columns = ltq.ctx.Fields
 	if len(ltq.ctx.Fields) > 0 {
 		_spec.Unique = ltq.ctx.Unique != nil && *ltq.ctx.Unique
@@ -714,6 +1027,9 @@ func (ltq *LicenseTypeQuery) querySpec() *sqlgraph.QuerySpec {
 		if ltq.withProduct != nil {
 			_spec.Node.AddColumnOnce(licensetype.FieldProductID)
 		}
+		if ltq.withParent != nil {
+			_spec.Node.AddColumnOnce(licensetype.FieldParentID)
+		}
 	}
 	if ps := ltq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -753,6 +1069,9 @@ func (ltq *LicenseTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if ltq.ctx.Unique != nil && *ltq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range ltq.modifiers {
+		m(selector)
+	}
 	for _, p := range ltq.predicates {
 		p(selector)
 	}
@@ -770,6 +1089,32 @@ func (ltq *LicenseTypeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ltq *LicenseTypeQuery) ForUpdate(opts ...sql.LockOption) *LicenseTypeQuery {
+	if ltq.driver.Dialect() == dialect.Postgres {
+		ltq.Unique(false)
+	}
+	ltq.modifiers = append(ltq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ltq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ltq *LicenseTypeQuery) ForShare(opts ...sql.LockOption) *LicenseTypeQuery {
+	if ltq.driver.Dialect() == dialect.Postgres {
+		ltq.Unique(false)
+	}
+	ltq.modifiers = append(ltq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ltq
+}
+
 // LicenseTypeGroupBy is the group-by builder for LicenseType entities.
 type LicenseTypeGroupBy struct {
 	selector
@@ -11,6 +11,8 @@ import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetypefeatures"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/lot"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
@@ -32,6 +34,47 @@ func (ltu *LicenseTypeUpdate) Where(ps ...predicate.LicenseType) *LicenseTypeUpd
 	return ltu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ltu *LicenseTypeUpdate) SetDeletedAt(t time.Time) *LicenseTypeUpdate {
+	ltu.mutation.SetDeletedAt(t)
+	return ltu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ltu *LicenseTypeUpdate) SetNillableDeletedAt(t *time.Time) *LicenseTypeUpdate {
+	if t != nil {
+		ltu.SetDeletedAt(*t)
+	}
+	return ltu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ltu *LicenseTypeUpdate) ClearDeletedAt() *LicenseTypeUpdate {
+	ltu.mutation.ClearDeletedAt()
+	return ltu
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (ltu *LicenseTypeUpdate) SetDeletedID(i int) *LicenseTypeUpdate {
+	ltu.mutation.ResetDeletedID()
+	ltu.mutation.SetDeletedID(i)
+	return ltu
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (ltu *LicenseTypeUpdate) SetNillableDeletedID(i *int) *LicenseTypeUpdate {
+	if i != nil {
+		ltu.SetDeletedID(*i)
+	}
+	return ltu
+}
+
+// AddDeletedID adds i to the "deleted_id" field.
+func (ltu *LicenseTypeUpdate) AddDeletedID(i int) *LicenseTypeUpdate {
+	ltu.mutation.AddDeletedID(i)
+	return ltu
+}
+
 // SetTypeName sets the "type_name" field.
 func (ltu *LicenseTypeUpdate) SetTypeName(s string) *LicenseTypeUpdate {
 	ltu.mutation.SetTypeName(s)
@@ -44,6 +87,26 @@ func (ltu *LicenseTypeUpdate) SetProductID(i int) *LicenseTypeUpdate {
 	return ltu
 }
 
+// SetParentID sets the "parent_id" field.
+func (ltu *LicenseTypeUpdate) SetParentID(i int) *LicenseTypeUpdate {
+	ltu.mutation.SetParentID(i)
+	return ltu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (ltu *LicenseTypeUpdate) SetNillableParentID(i *int) *LicenseTypeUpdate {
+	if i != nil {
+		ltu.SetParentID(*i)
+	}
+	return ltu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (ltu *LicenseTypeUpdate) ClearParentID() *LicenseTypeUpdate {
+	ltu.mutation.ClearParentID()
+	return ltu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (ltu *LicenseTypeUpdate) SetUpdatedAt(t time.Time) *LicenseTypeUpdate {
 	ltu.mutation.SetUpdatedAt(t)
@@ -85,6 +148,56 @@ func (ltu *LicenseTypeUpdate) AddDevices(d ...*Device) *LicenseTypeUpdate {
 	return ltu.AddDeviceIDs(ids...)
 }
 
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (ltu *LicenseTypeUpdate) AddOrderIDs(ids ...int) *LicenseTypeUpdate {
+	ltu.mutation.AddOrderIDs(ids...)
+	return ltu
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (ltu *LicenseTypeUpdate) AddOrders(o ...*Order) *LicenseTypeUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ltu.AddOrderIDs(ids...)
+}
+
+// AddLotIDs adds the "lots" edge to the Lot entity by IDs.
+func (ltu *LicenseTypeUpdate) AddLotIDs(ids ...int) *LicenseTypeUpdate {
+	ltu.mutation.AddLotIDs(ids...)
+	return ltu
+}
+
+// AddLots adds the "lots" edges to the Lot entity.
+func (ltu *LicenseTypeUpdate) AddLots(l ...*Lot) *LicenseTypeUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltu.AddLotIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the LicenseType entity.
+func (ltu *LicenseTypeUpdate) SetParent(l *LicenseType) *LicenseTypeUpdate {
+	return ltu.SetParentID(l.ID)
+}
+
+// AddChildIDs adds the "children" edge to the LicenseType entity by IDs.
+func (ltu *LicenseTypeUpdate) AddChildIDs(ids ...int) *LicenseTypeUpdate {
+	ltu.mutation.AddChildIDs(ids...)
+	return ltu
+}
+
+// AddChildren adds the "children" edges to the LicenseType entity.
+func (ltu *LicenseTypeUpdate) AddChildren(l ...*LicenseType) *LicenseTypeUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltu.AddChildIDs(ids...)
+}
+
 // AddLicenseTypeFeatureIDs adds the "license_type_features" edge to the LicenseTypeFeatures entity by IDs.
 func (ltu *LicenseTypeUpdate) AddLicenseTypeFeatureIDs(ids ...int) *LicenseTypeUpdate {
 	ltu.mutation.AddLicenseTypeFeatureIDs(ids...)
@@ -153,6 +266,75 @@ func (ltu *LicenseTypeUpdate) RemoveDevices(d ...*Device) *LicenseTypeUpdate {
 	return ltu.RemoveDeviceIDs(ids...)
 }
 
+// ClearOrders clears all "orders" edges to the Order entity.
+func (ltu *LicenseTypeUpdate) ClearOrders() *LicenseTypeUpdate {
+	ltu.mutation.ClearOrders()
+	return ltu
+}
+
+// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
+func (ltu *LicenseTypeUpdate) RemoveOrderIDs(ids ...int) *LicenseTypeUpdate {
+	ltu.mutation.RemoveOrderIDs(ids...)
+	return ltu
+}
+
+// RemoveOrders removes "orders" edges to Order entities.
+func (ltu *LicenseTypeUpdate) RemoveOrders(o ...*Order) *LicenseTypeUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ltu.RemoveOrderIDs(ids...)
+}
+
+// ClearLots clears all "lots" edges to the Lot entity.
+func (ltu *LicenseTypeUpdate) ClearLots() *LicenseTypeUpdate {
+	ltu.mutation.ClearLots()
+	return ltu
+}
+
+// RemoveLotIDs removes the "lots" edge to Lot entities by IDs.
+func (ltu *LicenseTypeUpdate) RemoveLotIDs(ids ...int) *LicenseTypeUpdate {
+	ltu.mutation.RemoveLotIDs(ids...)
+	return ltu
+}
+
+// RemoveLots removes "lots" edges to Lot entities.
+func (ltu *LicenseTypeUpdate) RemoveLots(l ...*Lot) *LicenseTypeUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltu.RemoveLotIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the LicenseType entity.
+func (ltu *LicenseTypeUpdate) ClearParent() *LicenseTypeUpdate {
+	ltu.mutation.ClearParent()
+	return ltu
+}
+
+// ClearChildren clears all "children" edges to the LicenseType entity.
+func (ltu *LicenseTypeUpdate) ClearChildren() *LicenseTypeUpdate {
+	ltu.mutation.ClearChildren()
+	return ltu
+}
+
+// RemoveChildIDs removes the "children" edge to LicenseType entities by IDs.
+func (ltu *LicenseTypeUpdate) RemoveChildIDs(ids ...int) *LicenseTypeUpdate {
+	ltu.mutation.RemoveChildIDs(ids...)
+	return ltu
+}
+
+// RemoveChildren removes "children" edges to LicenseType entities.
+func (ltu *LicenseTypeUpdate) RemoveChildren(l ...*LicenseType) *LicenseTypeUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltu.RemoveChildIDs(ids...)
+}
+
 // ClearLicenseTypeFeatures clears all "license_type_features" edges to the LicenseTypeFeatures entity.
 func (ltu *LicenseTypeUpdate) ClearLicenseTypeFeatures() *LicenseTypeUpdate {
 	ltu.mutation.ClearLicenseTypeFeatures()
@@ -176,7 +358,9 @@ func (ltu *LicenseTypeUpdate) RemoveLicenseTypeFeatures(l ...*LicenseTypeFeature
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ltu *LicenseTypeUpdate) Save(ctx context.Context) (int, error) {
-	ltu.defaults()
+	if err := ltu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, ltu.sqlSave, ltu.mutation, ltu.hooks)
 }
 
@@ -203,11 +387,15 @@ func (ltu *LicenseTypeUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (ltu *LicenseTypeUpdate) defaults() {
+func (ltu *LicenseTypeUpdate) defaults() error {
 	if _, ok := ltu.mutation.UpdatedAt(); !ok {
+		if licensetype.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized licensetype.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := licensetype.UpdateDefaultUpdatedAt()
 		ltu.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -235,6 +423,18 @@ func (ltu *LicenseTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := ltu.mutation.DeletedAt(); ok {
+		_spec.SetField(licensetype.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ltu.mutation.DeletedAtCleared() {
+		_spec.ClearField(licensetype.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ltu.mutation.DeletedID(); ok {
+		_spec.SetField(licensetype.FieldDeletedID, field.TypeInt, value)
+	}
+	if value, ok := ltu.mutation.AddedDeletedID(); ok {
+		_spec.AddField(licensetype.FieldDeletedID, field.TypeInt, value)
+	}
 	if value, ok := ltu.mutation.TypeName(); ok {
 		_spec.SetField(licensetype.FieldTypeName, field.TypeString, value)
 	}
@@ -372,6 +572,170 @@ func (ltu *LicenseTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ltu.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.OrdersTable,
+			Columns: []string{licensetype.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltu.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !ltu.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.OrdersTable,
+			Columns: []string{licensetype.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltu.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.OrdersTable,
+			Columns: []string{licensetype.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ltu.mutation.LotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.LotsTable,
+			Columns: []string{licensetype.LotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltu.mutation.RemovedLotsIDs(); len(nodes) > 0 && !ltu.mutation.LotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.LotsTable,
+			Columns: []string{licensetype.LotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltu.mutation.LotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.LotsTable,
+			Columns: []string{licensetype.LotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ltu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   licensetype.ParentTable,
+			Columns: []string{licensetype.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(licensetype.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   licensetype.ParentTable,
+			Columns: []string{licensetype.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(licensetype.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ltu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.ChildrenTable,
+			Columns: []string{licensetype.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(licensetype.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !ltu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.ChildrenTable,
+			Columns: []string{licensetype.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(licensetype.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.ChildrenTable,
+			Columns: []string{licensetype.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(licensetype.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ltu.mutation.LicenseTypeFeaturesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -437,6 +801,47 @@ type LicenseTypeUpdateOne struct {
 	mutation *LicenseTypeMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ltuo *LicenseTypeUpdateOne) SetDeletedAt(t time.Time) *LicenseTypeUpdateOne {
+	ltuo.mutation.SetDeletedAt(t)
+	return ltuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ltuo *LicenseTypeUpdateOne) SetNillableDeletedAt(t *time.Time) *LicenseTypeUpdateOne {
+	if t != nil {
+		ltuo.SetDeletedAt(*t)
+	}
+	return ltuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ltuo *LicenseTypeUpdateOne) ClearDeletedAt() *LicenseTypeUpdateOne {
+	ltuo.mutation.ClearDeletedAt()
+	return ltuo
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (ltuo *LicenseTypeUpdateOne) SetDeletedID(i int) *LicenseTypeUpdateOne {
+	ltuo.mutation.ResetDeletedID()
+	ltuo.mutation.SetDeletedID(i)
+	return ltuo
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (ltuo *LicenseTypeUpdateOne) SetNillableDeletedID(i *int) *LicenseTypeUpdateOne {
+	if i != nil {
+		ltuo.SetDeletedID(*i)
+	}
+	return ltuo
+}
+
+// AddDeletedID adds i to the "deleted_id" field.
+func (ltuo *LicenseTypeUpdateOne) AddDeletedID(i int) *LicenseTypeUpdateOne {
+	ltuo.mutation.AddDeletedID(i)
+	return ltuo
+}
+
 // SetTypeName sets the "type_name" field.
 func (ltuo *LicenseTypeUpdateOne) SetTypeName(s string) *LicenseTypeUpdateOne {
 	ltuo.mutation.SetTypeName(s)
@@ -449,6 +854,26 @@ func (ltuo *LicenseTypeUpdateOne) SetProductID(i int) *LicenseTypeUpdateOne {
 	return ltuo
 }
 
+// SetParentID sets the "parent_id" field.
+func (ltuo *LicenseTypeUpdateOne) SetParentID(i int) *LicenseTypeUpdateOne {
+	ltuo.mutation.SetParentID(i)
+	return ltuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (ltuo *LicenseTypeUpdateOne) SetNillableParentID(i *int) *LicenseTypeUpdateOne {
+	if i != nil {
+		ltuo.SetParentID(*i)
+	}
+	return ltuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (ltuo *LicenseTypeUpdateOne) ClearParentID() *LicenseTypeUpdateOne {
+	ltuo.mutation.ClearParentID()
+	return ltuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (ltuo *LicenseTypeUpdateOne) SetUpdatedAt(t time.Time) *LicenseTypeUpdateOne {
 	ltuo.mutation.SetUpdatedAt(t)
@@ -490,6 +915,56 @@ func (ltuo *LicenseTypeUpdateOne) AddDevices(d ...*Device) *LicenseTypeUpdateOne
 	return ltuo.AddDeviceIDs(ids...)
 }
 
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (ltuo *LicenseTypeUpdateOne) AddOrderIDs(ids ...int) *LicenseTypeUpdateOne {
+	ltuo.mutation.AddOrderIDs(ids...)
+	return ltuo
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (ltuo *LicenseTypeUpdateOne) AddOrders(o ...*Order) *LicenseTypeUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ltuo.AddOrderIDs(ids...)
+}
+
+// AddLotIDs adds the "lots" edge to the Lot entity by IDs.
+func (ltuo *LicenseTypeUpdateOne) AddLotIDs(ids ...int) *LicenseTypeUpdateOne {
+	ltuo.mutation.AddLotIDs(ids...)
+	return ltuo
+}
+
+// AddLots adds the "lots" edges to the Lot entity.
+func (ltuo *LicenseTypeUpdateOne) AddLots(l ...*Lot) *LicenseTypeUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltuo.AddLotIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the LicenseType entity.
+func (ltuo *LicenseTypeUpdateOne) SetParent(l *LicenseType) *LicenseTypeUpdateOne {
+	return ltuo.SetParentID(l.ID)
+}
+
+// AddChildIDs adds the "children" edge to the LicenseType entity by IDs.
+func (ltuo *LicenseTypeUpdateOne) AddChildIDs(ids ...int) *LicenseTypeUpdateOne {
+	ltuo.mutation.AddChildIDs(ids...)
+	return ltuo
+}
+
+// AddChildren adds the "children" edges to the LicenseType entity.
+func (ltuo *LicenseTypeUpdateOne) AddChildren(l ...*LicenseType) *LicenseTypeUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltuo.AddChildIDs(ids...)
+}
+
 // AddLicenseTypeFeatureIDs adds the "license_type_features" edge to the LicenseTypeFeatures entity by IDs.
 func (ltuo *LicenseTypeUpdateOne) AddLicenseTypeFeatureIDs(ids ...int) *LicenseTypeUpdateOne {
 	ltuo.mutation.AddLicenseTypeFeatureIDs(ids...)
@@ -558,6 +1033,75 @@ func (ltuo *LicenseTypeUpdateOne) RemoveDevices(d ...*Device) *LicenseTypeUpdate
 	return ltuo.RemoveDeviceIDs(ids...)
 }
 
+// ClearOrders clears all "orders" edges to the Order entity.
+func (ltuo *LicenseTypeUpdateOne) ClearOrders() *LicenseTypeUpdateOne {
+	ltuo.mutation.ClearOrders()
+	return ltuo
+}
+
+// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
+func (ltuo *LicenseTypeUpdateOne) RemoveOrderIDs(ids ...int) *LicenseTypeUpdateOne {
+	ltuo.mutation.RemoveOrderIDs(ids...)
+	return ltuo
+}
+
+// RemoveOrders removes "orders" edges to Order entities.
+func (ltuo *LicenseTypeUpdateOne) RemoveOrders(o ...*Order) *LicenseTypeUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ltuo.RemoveOrderIDs(ids...)
+}
+
+// ClearLots clears all "lots" edges to the Lot entity.
+func (ltuo *LicenseTypeUpdateOne) ClearLots() *LicenseTypeUpdateOne {
+	ltuo.mutation.ClearLots()
+	return ltuo
+}
+
+// RemoveLotIDs removes the "lots" edge to Lot entities by IDs.
+func (ltuo *LicenseTypeUpdateOne) RemoveLotIDs(ids ...int) *LicenseTypeUpdateOne {
+	ltuo.mutation.RemoveLotIDs(ids...)
+	return ltuo
+}
+
+// RemoveLots removes "lots" edges to Lot entities.
+func (ltuo *LicenseTypeUpdateOne) RemoveLots(l ...*Lot) *LicenseTypeUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltuo.RemoveLotIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the LicenseType entity.
+func (ltuo *LicenseTypeUpdateOne) ClearParent() *LicenseTypeUpdateOne {
+	ltuo.mutation.ClearParent()
+	return ltuo
+}
+
+// ClearChildren clears all "children" edges to the LicenseType entity.
+func (ltuo *LicenseTypeUpdateOne) ClearChildren() *LicenseTypeUpdateOne {
+	ltuo.mutation.ClearChildren()
+	return ltuo
+}
+
+// RemoveChildIDs removes the "children" edge to LicenseType entities by IDs.
+func (ltuo *LicenseTypeUpdateOne) RemoveChildIDs(ids ...int) *LicenseTypeUpdateOne {
+	ltuo.mutation.RemoveChildIDs(ids...)
+	return ltuo
+}
+
+// RemoveChildren removes "children" edges to LicenseType entities.
+func (ltuo *LicenseTypeUpdateOne) RemoveChildren(l ...*LicenseType) *LicenseTypeUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ltuo.RemoveChildIDs(ids...)
+}
+
 // ClearLicenseTypeFeatures clears all "license_type_features" edges to the LicenseTypeFeatures entity.
 func (ltuo *LicenseTypeUpdateOne) ClearLicenseTypeFeatures() *LicenseTypeUpdateOne {
 	ltuo.mutation.ClearLicenseTypeFeatures()
@@ -594,7 +1138,9 @@ func (ltuo *LicenseTypeUpdateOne) Select(field string, fields ...string) *Licens
 
 // Save executes the query and returns the updated LicenseType entity.
 func (ltuo *LicenseTypeUpdateOne) Save(ctx context.Context) (*LicenseType, error) {
-	ltuo.defaults()
+	if err := ltuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, ltuo.sqlSave, ltuo.mutation, ltuo.hooks)
 }
 
@@ -621,11 +1167,15 @@ func (ltuo *LicenseTypeUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (ltuo *LicenseTypeUpdateOne) defaults() {
+func (ltuo *LicenseTypeUpdateOne) defaults() error {
 	if _, ok := ltuo.mutation.UpdatedAt(); !ok {
+		if licensetype.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized licensetype.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := licensetype.UpdateDefaultUpdatedAt()
 		ltuo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -670,6 +1220,18 @@ func (ltuo *LicenseTypeUpdateOne) sqlSave(ctx context.Context) (_node *LicenseTy
 			}
 		}
 	}
+	if value, ok := ltuo.mutation.DeletedAt(); ok {
+		_spec.SetField(licensetype.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ltuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(licensetype.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ltuo.mutation.DeletedID(); ok {
+		_spec.SetField(licensetype.FieldDeletedID, field.TypeInt, value)
+	}
+	if value, ok := ltuo.mutation.AddedDeletedID(); ok {
+		_spec.AddField(licensetype.FieldDeletedID, field.TypeInt, value)
+	}
 	if value, ok := ltuo.mutation.TypeName(); ok {
 		_spec.SetField(licensetype.FieldTypeName, field.TypeString, value)
 	}
@@ -807,6 +1369,170 @@ func (ltuo *LicenseTypeUpdateOne) sqlSave(ctx context.Context) (_node *LicenseTy
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ltuo.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.OrdersTable,
+			Columns: []string{licensetype.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltuo.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !ltuo.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.OrdersTable,
+			Columns: []string{licensetype.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltuo.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.OrdersTable,
+			Columns: []string{licensetype.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ltuo.mutation.LotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.LotsTable,
+			Columns: []string{licensetype.LotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltuo.mutation.RemovedLotsIDs(); len(nodes) > 0 && !ltuo.mutation.LotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.LotsTable,
+			Columns: []string{licensetype.LotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltuo.mutation.LotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.LotsTable,
+			Columns: []string{licensetype.LotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ltuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   licensetype.ParentTable,
+			Columns: []string{licensetype.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(licensetype.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   licensetype.ParentTable,
+			Columns: []string{licensetype.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(licensetype.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ltuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.ChildrenTable,
+			Columns: []string{licensetype.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(licensetype.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltuo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !ltuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.ChildrenTable,
+			Columns: []string{licensetype.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(licensetype.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ltuo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   licensetype.ChildrenTable,
+			Columns: []string{licensetype.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(licensetype.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ltuo.mutation.LicenseTypeFeaturesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -11,6 +11,7 @@ import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetypefeatures"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -25,6 +26,7 @@ type LicenseTypeFeaturesQuery struct {
 	predicates      []predicate.LicenseTypeFeatures
 	withLicenseType *LicenseTypeQuery
 	withFeature     *ProductFeatureQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -419,6 +421,9 @@ func (ltfq *LicenseTypeFeaturesQuery) sqlAll(ctx context.Context, hooks ...query
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(ltfq.modifiers) > 0 {
+		_spec.Modifiers = ltfq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -504,6 +509,9 @@ func (ltfq *LicenseTypeFeaturesQuery) loadFeature(ctx context.Context, query *Pr
 
 func (ltfq *LicenseTypeFeaturesQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ltfq.querySpec()
+	if len(ltfq.modifiers) > 0 {
+		_spec.Modifiers = ltfq.modifiers
+	}
 	_spec.Node.Columns = ltfq.ctx.Fields
 	if len(ltfq.ctx.Fields) > 0 {
 		_spec.Unique = ltfq.ctx.Unique != nil && *ltfq.ctx.Unique
@@ -572,6 +580,9 @@ func (ltfq *LicenseTypeFeaturesQuery) sqlQuery(ctx context.Context) *sql.Selecto
 	if ltfq.ctx.Unique != nil && *ltfq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range ltfq.modifiers {
+		m(selector)
+	}
 	for _, p := range ltfq.predicates {
 		p(selector)
 	}
@@ -589,6 +600,32 @@ func (ltfq *LicenseTypeFeaturesQuery) sqlQuery(ctx context.Context) *sql.Selecto
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ltfq *LicenseTypeFeaturesQuery) ForUpdate(opts ...sql.LockOption) *LicenseTypeFeaturesQuery {
+	if ltfq.driver.Dialect() == dialect.Postgres {
+		ltfq.Unique(false)
+	}
+	ltfq.modifiers = append(ltfq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ltfq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ltfq *LicenseTypeFeaturesQuery) ForShare(opts ...sql.LockOption) *LicenseTypeFeaturesQuery {
+	if ltfq.driver.Dialect() == dialect.Postgres {
+		ltfq.Unique(false)
+	}
+	ltfq.modifiers = append(ltfq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ltfq
+}
+
 // LicenseTypeFeaturesGroupBy is the group-by builder for LicenseTypeFeatures entities.
 type LicenseTypeFeaturesGroupBy struct {
 	selector
@@ -9,6 +9,7 @@ import (
 
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/metricevent"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -21,6 +22,7 @@ type MetricEventQuery struct {
 	order      []metricevent.OrderOption
 	inters     []Interceptor
 	predicates []predicate.MetricEvent
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -342,6 +344,9 @@ func (meq *MetricEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		nodes = append(nodes, node)
 		return node.assignValues(columns, values)
 	}
+	if len(meq.modifiers) > 0 {
+		_spec.Modifiers = meq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -356,6 +361,9 @@ func (meq *MetricEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 
 func (meq *MetricEventQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := meq.querySpec()
+	if len(meq.modifiers) > 0 {
+		_spec.Modifiers = meq.modifiers
+	}
 	_spec.Node.Columns = meq.ctx.Fields
 	if len(meq.ctx.Fields) > 0 {
 		_spec.Unique = meq.ctx.Unique != nil && *meq.ctx.Unique
@@ -418,6 +426,9 @@ func (meq *MetricEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if meq.ctx.Unique != nil && *meq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range meq.modifiers {
+		m(selector)
+	}
 	for _, p := range meq.predicates {
 		p(selector)
 	}
@@ -435,6 +446,32 @@ func (meq *MetricEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (meq *MetricEventQuery) ForUpdate(opts ...sql.LockOption) *MetricEventQuery {
+	if meq.driver.Dialect() == dialect.Postgres {
+		meq.Unique(false)
+	}
+	meq.modifiers = append(meq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return meq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (meq *MetricEventQuery) ForShare(opts ...sql.LockOption) *MetricEventQuery {
+	if meq.driver.Dialect() == dialect.Postgres {
+		meq.Unique(false)
+	}
+	meq.modifiers = append(meq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return meq
+}
+
 // MetricEventGroupBy is the group-by builder for MetricEvent entities.
 type MetricEventGroupBy struct {
 	selector
@@ -8,6 +8,51 @@ import (
 )
 
 var (
+	// APITokensColumns holds the columns for the "api_tokens" table.
+	APITokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"personal", "service"}},
+		{Name: "token_prefix", Type: field.TypeString, Unique: true},
+		{Name: "token_hash", Type: field.TypeString},
+		{Name: "created_by", Type: field.TypeInt},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "product_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_ip", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "revoked_by", Type: field.TypeInt, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// APITokensTable holds the schema information for the "api_tokens" table.
+	APITokensTable = &schema.Table{
+		Name:       "api_tokens",
+		Columns:    APITokensColumns,
+		PrimaryKey: []*schema.Column{APITokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_tokens_users_api_tokens",
+				Columns:    []*schema.Column{APITokensColumns[15]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apitoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{APITokensColumns[15]},
+			},
+			{
+				Name:    "apitoken_created_by",
+				Unique:  false,
+				Columns: []*schema.Column{APITokensColumns[5]},
+			},
+		},
+	}
 	// AuditLogsColumns holds the columns for the "audit_logs" table.
 	AuditLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -38,18 +83,86 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[5]},
+			},
+			{
+				Name:    "auditlog_product_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[6], AuditLogsColumns[5]},
+			},
+		},
+	}
+	// CustomersColumns holds the columns for the "customers" table.
+	CustomersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "contact_name", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "contact_phone", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "contact_email", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "region", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "oem_tag", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CustomersTable holds the schema information for the "customers" table.
+	CustomersTable = &schema.Table{
+		Name:       "customers",
+		Columns:    CustomersColumns,
+		PrimaryKey: []*schema.Column{CustomersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "customer_name",
+				Unique:  true,
+				Columns: []*schema.Column{CustomersColumns[1]},
+			},
+			{
+				Name:    "customer_region",
+				Unique:  false,
+				Columns: []*schema.Column{CustomersColumns[5]},
+			},
+			{
+				Name:    "customer_oem_tag",
+				Unique:  false,
+				Columns: []*schema.Column{CustomersColumns[6]},
+			},
+		},
 	}
 	// DevicesColumns holds the columns for the "devices" table.
 	DevicesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "sn", Type: field.TypeString, Unique: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_id", Type: field.TypeInt, Default: 0},
+		{Name: "sn", Type: field.TypeString},
 		{Name: "oem_tag", Type: field.TypeString, Nullable: true, Default: ""},
 		{Name: "remark", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"manufactured", "shipped", "activated", "suspended", "rma", "scrapped"}, Default: "manufactured"},
+		{Name: "shipped_at", Type: field.TypeTime, Nullable: true},
+		{Name: "activated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "suspended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "rma_at", Type: field.TypeTime, Nullable: true},
+		{Name: "scrapped_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "warranty_start_at", Type: field.TypeTime, Nullable: true},
+		{Name: "warranty_end_at", Type: field.TypeTime, Nullable: true},
+		{Name: "attributes", Type: field.TypeJSON, Nullable: true},
+		{Name: "last_software_version", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "last_firmware_version", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "last_uptime", Type: field.TypeInt64, Nullable: true, Default: 0},
+		{Name: "heartbeat_public_key", Type: field.TypeString, Nullable: true, Default: ""},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "customer_id", Type: field.TypeInt, Nullable: true},
 		{Name: "created_by", Type: field.TypeInt, Nullable: true},
 		{Name: "updated_by", Type: field.TypeInt, Nullable: true},
 		{Name: "license_type_id", Type: field.TypeInt, Nullable: true},
+		{Name: "lot_id", Type: field.TypeInt, Nullable: true},
+		{Name: "order_id", Type: field.TypeInt, Nullable: true},
 		{Name: "product_id", Type: field.TypeInt},
 	}
 	// DevicesTable holds the schema information for the "devices" table.
@@ -58,52 +171,332 @@ var (
 		Columns:    DevicesColumns,
 		PrimaryKey: []*schema.Column{DevicesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "devices_customers_devices",
+				Columns:    []*schema.Column{DevicesColumns[22]},
+				RefColumns: []*schema.Column{CustomersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "devices_users_creator",
-				Columns:    []*schema.Column{DevicesColumns[6]},
+				Columns:    []*schema.Column{DevicesColumns[23]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "devices_users_updater",
-				Columns:    []*schema.Column{DevicesColumns[7]},
+				Columns:    []*schema.Column{DevicesColumns[24]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "devices_license_types_devices",
-				Columns:    []*schema.Column{DevicesColumns[8]},
+				Columns:    []*schema.Column{DevicesColumns[25]},
 				RefColumns: []*schema.Column{LicenseTypesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "devices_lots_devices",
+				Columns:    []*schema.Column{DevicesColumns[26]},
+				RefColumns: []*schema.Column{LotsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "devices_orders_devices",
+				Columns:    []*schema.Column{DevicesColumns[27]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "devices_products_devices",
-				Columns:    []*schema.Column{DevicesColumns[9]},
+				Columns:    []*schema.Column{DevicesColumns[28]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "device_sn",
+				Name:    "device_sn_deleted_id",
 				Unique:  true,
-				Columns: []*schema.Column{DevicesColumns[1]},
+				Columns: []*schema.Column{DevicesColumns[3], DevicesColumns[2]},
 			},
 			{
 				Name:    "device_product_id",
 				Unique:  false,
-				Columns: []*schema.Column{DevicesColumns[9]},
+				Columns: []*schema.Column{DevicesColumns[28]},
 			},
 			{
 				Name:    "device_license_type_id",
 				Unique:  false,
-				Columns: []*schema.Column{DevicesColumns[8]},
+				Columns: []*schema.Column{DevicesColumns[25]},
+			},
+			{
+				Name:    "device_product_id_state",
+				Unique:  false,
+				Columns: []*schema.Column{DevicesColumns[28], DevicesColumns[6]},
+			},
+			{
+				Name:    "device_product_id_last_seen_at",
+				Unique:  false,
+				Columns: []*schema.Column{DevicesColumns[28], DevicesColumns[12]},
+			},
+			{
+				Name:    "device_product_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DevicesColumns[28], DevicesColumns[20]},
+			},
+			{
+				Name:    "device_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DevicesColumns[20]},
+			},
+			{
+				Name:    "device_customer_id",
+				Unique:  false,
+				Columns: []*schema.Column{DevicesColumns[22]},
+			},
+			{
+				Name:    "device_warranty_end_at",
+				Unique:  false,
+				Columns: []*schema.Column{DevicesColumns[14]},
+			},
+			{
+				Name:    "device_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{DevicesColumns[27]},
+			},
+			{
+				Name:    "device_lot_id",
+				Unique:  false,
+				Columns: []*schema.Column{DevicesColumns[26]},
+			},
+		},
+	}
+	// DeviceAssignmentsColumns holds the columns for the "device_assignments" table.
+	DeviceAssignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "customer_id", Type: field.TypeInt, Nullable: true},
+		{Name: "customer_name", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "previous_customer_id", Type: field.TypeInt, Nullable: true},
+		{Name: "previous_customer_name", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "warranty_start_at", Type: field.TypeTime, Nullable: true},
+		{Name: "warranty_end_at", Type: field.TypeTime, Nullable: true},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "assigned_by", Type: field.TypeInt},
+		{Name: "assigned_at", Type: field.TypeTime},
+		{Name: "device_id", Type: field.TypeInt},
+	}
+	// DeviceAssignmentsTable holds the schema information for the "device_assignments" table.
+	DeviceAssignmentsTable = &schema.Table{
+		Name:       "device_assignments",
+		Columns:    DeviceAssignmentsColumns,
+		PrimaryKey: []*schema.Column{DeviceAssignmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "device_assignments_devices_assignments",
+				Columns:    []*schema.Column{DeviceAssignmentsColumns[10]},
+				RefColumns: []*schema.Column{DevicesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deviceassignment_device_id_assigned_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceAssignmentsColumns[10], DeviceAssignmentsColumns[9]},
+			},
+			{
+				Name:    "deviceassignment_customer_id_assigned_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceAssignmentsColumns[1], DeviceAssignmentsColumns[9]},
+			},
+		},
+	}
+	// DeviceFeatureOverridesColumns holds the columns for the "device_feature_overrides" table.
+	DeviceFeatureOverridesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "effect", Type: field.TypeEnum, Enums: []string{"grant", "deny"}},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "created_by", Type: field.TypeInt},
+		{Name: "updated_by", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "device_id", Type: field.TypeInt},
+		{Name: "feature_id", Type: field.TypeInt},
+	}
+	// DeviceFeatureOverridesTable holds the schema information for the "device_feature_overrides" table.
+	DeviceFeatureOverridesTable = &schema.Table{
+		Name:       "device_feature_overrides",
+		Columns:    DeviceFeatureOverridesColumns,
+		PrimaryKey: []*schema.Column{DeviceFeatureOverridesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "device_feature_overrides_devices_feature_overrides",
+				Columns:    []*schema.Column{DeviceFeatureOverridesColumns[8]},
+				RefColumns: []*schema.Column{DevicesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "device_feature_overrides_product_features_device_overrides",
+				Columns:    []*schema.Column{DeviceFeatureOverridesColumns[9]},
+				RefColumns: []*schema.Column{ProductFeaturesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "devicefeatureoverride_device_id_feature_id",
+				Unique:  true,
+				Columns: []*schema.Column{DeviceFeatureOverridesColumns[8], DeviceFeatureOverridesColumns[9]},
+			},
+			{
+				Name:    "devicefeatureoverride_feature_id",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceFeatureOverridesColumns[9]},
+			},
+			{
+				Name:    "devicefeatureoverride_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceFeatureOverridesColumns[2]},
+			},
+		},
+	}
+	// DeviceGroupsColumns holds the columns for the "device_groups" table.
+	DeviceGroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "product_id", Type: field.TypeInt},
+	}
+	// DeviceGroupsTable holds the schema information for the "device_groups" table.
+	DeviceGroupsTable = &schema.Table{
+		Name:       "device_groups",
+		Columns:    DeviceGroupsColumns,
+		PrimaryKey: []*schema.Column{DeviceGroupsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "device_groups_products_device_groups",
+				Columns:    []*schema.Column{DeviceGroupsColumns[6]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "devicegroup_product_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{DeviceGroupsColumns[6], DeviceGroupsColumns[1]},
+			},
+		},
+	}
+	// DeviceHeartbeatsColumns holds the columns for the "device_heartbeats" table.
+	DeviceHeartbeatsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "software_version", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "firmware_version", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "uptime", Type: field.TypeInt64, Default: 0},
+		{Name: "status", Type: field.TypeJSON, Nullable: true},
+		{Name: "remote_ip", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "device_id", Type: field.TypeInt},
+	}
+	// DeviceHeartbeatsTable holds the schema information for the "device_heartbeats" table.
+	DeviceHeartbeatsTable = &schema.Table{
+		Name:       "device_heartbeats",
+		Columns:    DeviceHeartbeatsColumns,
+		PrimaryKey: []*schema.Column{DeviceHeartbeatsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "device_heartbeats_devices_heartbeats",
+				Columns:    []*schema.Column{DeviceHeartbeatsColumns[8]},
+				RefColumns: []*schema.Column{DevicesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deviceheartbeat_device_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceHeartbeatsColumns[8], DeviceHeartbeatsColumns[7]},
+			},
+			{
+				Name:    "deviceheartbeat_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceHeartbeatsColumns[7]},
+			},
+		},
+	}
+	// DeviceSavedFiltersColumns holds the columns for the "device_saved_filters" table.
+	DeviceSavedFiltersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "condition", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// DeviceSavedFiltersTable holds the schema information for the "device_saved_filters" table.
+	DeviceSavedFiltersTable = &schema.Table{
+		Name:       "device_saved_filters",
+		Columns:    DeviceSavedFiltersColumns,
+		PrimaryKey: []*schema.Column{DeviceSavedFiltersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "device_saved_filters_users_device_filters",
+				Columns:    []*schema.Column{DeviceSavedFiltersColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "devicesavedfilter_user_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{DeviceSavedFiltersColumns[5], DeviceSavedFiltersColumns[1]},
+			},
+		},
+	}
+	// DeviceTagsColumns holds the columns for the "device_tags" table.
+	DeviceTagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "color", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "product_id", Type: field.TypeInt},
+	}
+	// DeviceTagsTable holds the schema information for the "device_tags" table.
+	DeviceTagsTable = &schema.Table{
+		Name:       "device_tags",
+		Columns:    DeviceTagsColumns,
+		PrimaryKey: []*schema.Column{DeviceTagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "device_tags_products_device_tags",
+				Columns:    []*schema.Column{DeviceTagsColumns[6]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "devicetag_product_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{DeviceTagsColumns[6], DeviceTagsColumns[1]},
 			},
 		},
 	}
 	// FirmwareVersionsColumns holds the columns for the "firmware_versions" table.
 	FirmwareVersionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_id", Type: field.TypeInt, Default: 0},
 		{Name: "version", Type: field.TypeString},
 		{Name: "release_date", Type: field.TypeTime},
 		{Name: "remark", Type: field.TypeString, Nullable: true},
@@ -120,32 +513,81 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "firmware_versions_users_creator",
-				Columns:    []*schema.Column{FirmwareVersionsColumns[6]},
+				Columns:    []*schema.Column{FirmwareVersionsColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "firmware_versions_products_firmware_versions",
-				Columns:    []*schema.Column{FirmwareVersionsColumns[7]},
+				Columns:    []*schema.Column{FirmwareVersionsColumns[9]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "firmwareversion_product_id_version",
+				Name:    "firmwareversion_product_id_version_deleted_id",
 				Unique:  true,
-				Columns: []*schema.Column{FirmwareVersionsColumns[7], FirmwareVersionsColumns[1]},
+				Columns: []*schema.Column{FirmwareVersionsColumns[9], FirmwareVersionsColumns[3], FirmwareVersionsColumns[2]},
+			},
+		},
+	}
+	// JobsColumns holds the columns for the "jobs" table.
+	JobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"device_batch_add", "device_batch_license"}},
+		{Name: "params", Type: field.TypeString, SchemaType: map[string]string{"mysql": "LONGTEXT", "postgres": "TEXT", "sqlite3": "TEXT"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "succeeded", "failed", "canceled"}, Default: "pending"},
+		{Name: "total", Type: field.TypeInt, Default: 0},
+		{Name: "processed", Type: field.TypeInt, Default: 0},
+		{Name: "succeeded", Type: field.TypeInt, Default: 0},
+		{Name: "failed", Type: field.TypeInt, Default: 0},
+		{Name: "result", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"mysql": "TEXT", "postgres": "TEXT", "sqlite3": "TEXT"}},
+		{Name: "error_report", Type: field.TypeString, Nullable: true, Default: "", SchemaType: map[string]string{"mysql": "LONGTEXT", "postgres": "TEXT", "sqlite3": "TEXT"}},
+		{Name: "retry_of", Type: field.TypeInt, Nullable: true},
+		{Name: "api_token_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeInt},
+	}
+	// JobsTable holds the schema information for the "jobs" table.
+	JobsTable = &schema.Table{
+		Name:       "jobs",
+		Columns:    JobsColumns,
+		PrimaryKey: []*schema.Column{JobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "jobs_users_jobs",
+				Columns:    []*schema.Column{JobsColumns[16]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "job_created_by_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[16], JobsColumns[12]},
+			},
+			{
+				Name:    "job_status",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[3]},
 			},
 		},
 	}
 	// LicenseTypesColumns holds the columns for the "license_types" table.
 	LicenseTypesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_id", Type: field.TypeInt, Default: 0},
 		{Name: "type_name", Type: field.TypeString},
 		{Name: "license_type", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
 		{Name: "product_id", Type: field.TypeInt},
 	}
 	// LicenseTypesTable holds the schema information for the "license_types" table.
@@ -154,9 +596,15 @@ var (
 		Columns:    LicenseTypesColumns,
 		PrimaryKey: []*schema.Column{LicenseTypesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "license_types_license_types_children",
+				Columns:    []*schema.Column{LicenseTypesColumns[7]},
+				RefColumns: []*schema.Column{LicenseTypesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "license_types_products_license_types",
-				Columns:    []*schema.Column{LicenseTypesColumns[5]},
+				Columns:    []*schema.Column{LicenseTypesColumns[8]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -196,6 +644,65 @@ var (
 			},
 		},
 	}
+	// LotsColumns holds the columns for the "lots" table.
+	LotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "lot_no", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "manufactured_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ship_date", Type: field.TypeTime, Nullable: true},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+		{Name: "updated_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "license_type_id", Type: field.TypeInt, Nullable: true},
+		{Name: "order_id", Type: field.TypeInt, Nullable: true},
+		{Name: "product_id", Type: field.TypeInt},
+	}
+	// LotsTable holds the schema information for the "lots" table.
+	LotsTable = &schema.Table{
+		Name:       "lots",
+		Columns:    LotsColumns,
+		PrimaryKey: []*schema.Column{LotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "lots_license_types_lots",
+				Columns:    []*schema.Column{LotsColumns[10]},
+				RefColumns: []*schema.Column{LicenseTypesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "lots_orders_lots",
+				Columns:    []*schema.Column{LotsColumns[11]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "lots_products_lots",
+				Columns:    []*schema.Column{LotsColumns[12]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "lot_product_id_lot_no",
+				Unique:  true,
+				Columns: []*schema.Column{LotsColumns[12], LotsColumns[1]},
+			},
+			{
+				Name:    "lot_product_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LotsColumns[12], LotsColumns[8]},
+			},
+			{
+				Name:    "lot_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{LotsColumns[11]},
+			},
+		},
+	}
 	// MetricEventsColumns holds the columns for the "metric_events" table.
 	MetricEventsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -242,6 +749,64 @@ var (
 			},
 		},
 	}
+	// OrdersColumns holds the columns for the "orders" table.
+	OrdersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "order_no", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "ship_date", Type: field.TypeTime, Nullable: true},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+		{Name: "updated_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "customer_id", Type: field.TypeInt, Nullable: true},
+		{Name: "license_type_id", Type: field.TypeInt, Nullable: true},
+		{Name: "product_id", Type: field.TypeInt},
+	}
+	// OrdersTable holds the schema information for the "orders" table.
+	OrdersTable = &schema.Table{
+		Name:       "orders",
+		Columns:    OrdersColumns,
+		PrimaryKey: []*schema.Column{OrdersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "orders_customers_orders",
+				Columns:    []*schema.Column{OrdersColumns[9]},
+				RefColumns: []*schema.Column{CustomersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "orders_license_types_orders",
+				Columns:    []*schema.Column{OrdersColumns[10]},
+				RefColumns: []*schema.Column{LicenseTypesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "orders_products_orders",
+				Columns:    []*schema.Column{OrdersColumns[11]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "order_order_no",
+				Unique:  true,
+				Columns: []*schema.Column{OrdersColumns[1]},
+			},
+			{
+				Name:    "order_product_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[11], OrdersColumns[7]},
+			},
+			{
+				Name:    "order_customer_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[9]},
+			},
+		},
+	}
 	// PostsColumns holds the columns for the "posts" table.
 	PostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -416,9 +981,13 @@ var (
 	// ProductsColumns holds the columns for the "products" table.
 	ProductsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_id", Type: field.TypeInt, Default: 0},
+		{Name: "code", Type: field.TypeString},
 		{Name: "product_type", Type: field.TypeString, Nullable: true, Default: "default"},
-		{Name: "product_name", Type: field.TypeString, Unique: true},
+		{Name: "product_name", Type: field.TypeString},
+		{Name: "warranty_months", Type: field.TypeInt, Default: 12},
+		{Name: "device_attribute_schema", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -429,15 +998,22 @@ var (
 		PrimaryKey: []*schema.Column{ProductsColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "product_code",
+				Name:    "product_code_deleted_id",
+				Unique:  true,
+				Columns: []*schema.Column{ProductsColumns[3], ProductsColumns[2]},
+			},
+			{
+				Name:    "product_product_name_deleted_id",
 				Unique:  true,
-				Columns: []*schema.Column{ProductsColumns[1]},
+				Columns: []*schema.Column{ProductsColumns[5], ProductsColumns[2]},
 			},
 		},
 	}
 	// ProductFeaturesColumns holds the columns for the "product_features" table.
 	ProductFeaturesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_id", Type: field.TypeInt, Default: 0},
 		{Name: "feature_name", Type: field.TypeString},
 		{Name: "feature_code", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
@@ -452,17 +1028,66 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "product_features_products_features",
-				Columns:    []*schema.Column{ProductFeaturesColumns[5]},
+				Columns:    []*schema.Column{ProductFeaturesColumns[7]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// ProductInvitationsColumns holds the columns for the "product_invitations" table.
+	ProductInvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"manager", "transfer"}},
+		{Name: "email", Type: field.TypeString},
+		{Name: "access_role", Type: field.TypeEnum, Nullable: true, Enums: []string{"viewer", "device_operator", "release_manager", "product_admin"}},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "declined", "revoked"}, Default: "pending"},
+		{Name: "responded_by", Type: field.TypeInt, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "invited_by", Type: field.TypeInt},
+	}
+	// ProductInvitationsTable holds the schema information for the "product_invitations" table.
+	ProductInvitationsTable = &schema.Table{
+		Name:       "product_invitations",
+		Columns:    ProductInvitationsColumns,
+		PrimaryKey: []*schema.Column{ProductInvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "product_invitations_products_invitations",
+				Columns:    []*schema.Column{ProductInvitationsColumns[11]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "product_invitations_users_invitations",
+				Columns:    []*schema.Column{ProductInvitationsColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productinvitation_product_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ProductInvitationsColumns[11], ProductInvitationsColumns[5]},
+			},
+			{
+				Name:    "productinvitation_email_status",
+				Unique:  false,
+				Columns: []*schema.Column{ProductInvitationsColumns[2], ProductInvitationsColumns[5]},
+			},
+		},
+	}
 	// ProductManagersColumns holds the columns for the "product_managers" table.
 	ProductManagersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "role", Type: field.TypeEnum, Enums: []string{"main", "assistant"}},
 		{Name: "permissions", Type: field.TypeEnum, Nullable: true, Enums: []string{"read", "full"}, Default: "read"},
+		{Name: "access_role", Type: field.TypeEnum, Nullable: true, Enums: []string{"viewer", "device_operator", "release_manager", "product_admin"}},
 		{Name: "remark", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -477,21 +1102,182 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "product_managers_products_managers",
-				Columns:    []*schema.Column{ProductManagersColumns[6]},
+				Columns:    []*schema.Column{ProductManagersColumns[7]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "product_managers_users_products",
-				Columns:    []*schema.Column{ProductManagersColumns[7]},
+				Columns:    []*schema.Column{ProductManagersColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// RegistrationInvitationsColumns holds the columns for the "registration_invitations" table.
+	RegistrationInvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code_hash", Type: field.TypeString, Unique: true},
+		{Name: "code_prefix", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "max_uses", Type: field.TypeInt, Default: 1},
+		{Name: "used_count", Type: field.TypeInt, Default: 0},
+		{Name: "access_role", Type: field.TypeEnum, Nullable: true, Enums: []string{"viewer", "device_operator", "release_manager", "product_admin"}},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_by", Type: field.TypeInt, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "product_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_by", Type: field.TypeInt},
+	}
+	// RegistrationInvitationsTable holds the schema information for the "registration_invitations" table.
+	RegistrationInvitationsTable = &schema.Table{
+		Name:       "registration_invitations",
+		Columns:    RegistrationInvitationsColumns,
+		PrimaryKey: []*schema.Column{RegistrationInvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "registration_invitations_products_registration_invitations",
+				Columns:    []*schema.Column{RegistrationInvitationsColumns[13]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "registration_invitations_users_registration_invitations",
+				Columns:    []*schema.Column{RegistrationInvitationsColumns[14]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "registrationinvitation_product_id",
+				Unique:  false,
+				Columns: []*schema.Column{RegistrationInvitationsColumns[13]},
+			},
+			{
+				Name:    "registrationinvitation_created_by",
+				Unique:  false,
+				Columns: []*schema.Column{RegistrationInvitationsColumns[14]},
+			},
+		},
+	}
+	// SnAllocatorsColumns holds the columns for the "sn_allocators" table.
+	SnAllocatorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "prefix", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "date_format", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "counter_width", Type: field.TypeInt, Default: 6},
+		{Name: "check_algorithm", Type: field.TypeEnum, Enums: []string{"none", "luhn", "mod37"}, Default: "none"},
+		{Name: "next_counter", Type: field.TypeInt64, Default: 1},
+		{Name: "default_license_type_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "product_id", Type: field.TypeInt},
+	}
+	// SnAllocatorsTable holds the schema information for the "sn_allocators" table.
+	SnAllocatorsTable = &schema.Table{
+		Name:       "sn_allocators",
+		Columns:    SnAllocatorsColumns,
+		PrimaryKey: []*schema.Column{SnAllocatorsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sn_allocators_products_sn_allocators",
+				Columns:    []*schema.Column{SnAllocatorsColumns[11]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "snallocator_product_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{SnAllocatorsColumns[11], SnAllocatorsColumns[1]},
+			},
+		},
+	}
+	// SnBlocksColumns holds the columns for the "sn_blocks" table.
+	SnBlocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "product_id", Type: field.TypeInt},
+		{Name: "prefix", Type: field.TypeString, Default: ""},
+		{Name: "date_code", Type: field.TypeString, Default: ""},
+		{Name: "counter_width", Type: field.TypeInt},
+		{Name: "check_algorithm", Type: field.TypeString, Default: "none"},
+		{Name: "start_counter", Type: field.TypeInt64},
+		{Name: "count", Type: field.TypeInt},
+		{Name: "first_sn", Type: field.TypeString},
+		{Name: "last_sn", Type: field.TypeString},
+		{Name: "devices_created", Type: field.TypeBool, Default: false},
+		{Name: "license_type_id", Type: field.TypeInt, Nullable: true},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "allocator_id", Type: field.TypeInt},
+		{Name: "requested_by", Type: field.TypeInt},
+	}
+	// SnBlocksTable holds the schema information for the "sn_blocks" table.
+	SnBlocksTable = &schema.Table{
+		Name:       "sn_blocks",
+		Columns:    SnBlocksColumns,
+		PrimaryKey: []*schema.Column{SnBlocksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sn_blocks_sn_allocators_blocks",
+				Columns:    []*schema.Column{SnBlocksColumns[14]},
+				RefColumns: []*schema.Column{SnAllocatorsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "sn_blocks_users_requester",
+				Columns:    []*schema.Column{SnBlocksColumns[15]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "snblock_product_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SnBlocksColumns[1], SnBlocksColumns[13]},
+			},
+		},
+	}
+	// SnRulesColumns holds the columns for the "sn_rules" table.
+	SnRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "prefix", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "pattern", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "min_length", Type: field.TypeInt, Default: 0},
+		{Name: "max_length", Type: field.TypeInt, Default: 0},
+		{Name: "check_algorithm", Type: field.TypeEnum, Enums: []string{"none", "luhn", "mod37"}, Default: "none"},
+		{Name: "updated_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "product_id", Type: field.TypeInt, Unique: true},
+	}
+	// SnRulesTable holds the schema information for the "sn_rules" table.
+	SnRulesTable = &schema.Table{
+		Name:       "sn_rules",
+		Columns:    SnRulesColumns,
+		PrimaryKey: []*schema.Column{SnRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sn_rules_products_sn_rule",
+				Columns:    []*schema.Column{SnRulesColumns[9]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
 	}
 	// SoftwareVersionsColumns holds the columns for the "software_versions" table.
 	SoftwareVersionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_id", Type: field.TypeInt, Default: 0},
 		{Name: "version", Type: field.TypeString},
 		{Name: "release_date", Type: field.TypeTime},
 		{Name: "update_log", Type: field.TypeString, Nullable: true},
@@ -509,32 +1295,54 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "software_versions_products_software_versions",
-				Columns:    []*schema.Column{SoftwareVersionsColumns[7]},
+				Columns:    []*schema.Column{SoftwareVersionsColumns[9]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "software_versions_users_creator",
-				Columns:    []*schema.Column{SoftwareVersionsColumns[8]},
+				Columns:    []*schema.Column{SoftwareVersionsColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "softwareversion_product_id_version",
+				Name:    "softwareversion_product_id_version_deleted_id",
 				Unique:  true,
-				Columns: []*schema.Column{SoftwareVersionsColumns[7], SoftwareVersionsColumns[1]},
+				Columns: []*schema.Column{SoftwareVersionsColumns[9], SoftwareVersionsColumns[3], SoftwareVersionsColumns[2]},
 			},
 		},
 	}
+	// TwoFactorPoliciesColumns holds the columns for the "two_factor_policies" table.
+	TwoFactorPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role", Type: field.TypeString, Unique: true},
+		{Name: "created_by", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TwoFactorPoliciesTable holds the schema information for the "two_factor_policies" table.
+	TwoFactorPoliciesTable = &schema.Table{
+		Name:       "two_factor_policies",
+		Columns:    TwoFactorPoliciesColumns,
+		PrimaryKey: []*schema.Column{TwoFactorPoliciesColumns[0]},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "password", Type: field.TypeString},
 		{Name: "is_enabled", Type: field.TypeBool, Default: true},
+		{Name: "email_verified", Type: field.TypeBool, Default: true},
+		{Name: "is_system_admin", Type: field.TypeBool, Default: false},
+		{Name: "is_service", Type: field.TypeBool, Default: false},
 		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_enabled", Type: field.TypeBool, Default: false},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true},
+		{Name: "totp_last_step", Type: field.TypeInt64, Default: 0},
+		{Name: "totp_recovery_codes", Type: field.TypeJSON, Nullable: true},
+		{Name: "oidc_issuer", Type: field.TypeString, Nullable: true},
+		{Name: "oidc_subject", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -549,6 +1357,111 @@ var (
 				Unique:  true,
 				Columns: []*schema.Column{UsersColumns[1]},
 			},
+			{
+				Name:    "user_oidc_issuer_oidc_subject",
+				Unique:  true,
+				Columns: []*schema.Column{UsersColumns[12], UsersColumns[13]},
+			},
+		},
+	}
+	// DeviceTagRelationsColumns holds the columns for the "device_tag_relations" table.
+	DeviceTagRelationsColumns = []*schema.Column{
+		{Name: "device_id", Type: field.TypeInt},
+		{Name: "device_tag_id", Type: field.TypeInt},
+	}
+	// DeviceTagRelationsTable holds the schema information for the "device_tag_relations" table.
+	DeviceTagRelationsTable = &schema.Table{
+		Name:       "device_tag_relations",
+		Columns:    DeviceTagRelationsColumns,
+		PrimaryKey: []*schema.Column{DeviceTagRelationsColumns[0], DeviceTagRelationsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "device_tag_relations_device_id",
+				Columns:    []*schema.Column{DeviceTagRelationsColumns[0]},
+				RefColumns: []*schema.Column{DevicesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "device_tag_relations_device_tag_id",
+				Columns:    []*schema.Column{DeviceTagRelationsColumns[1]},
+				RefColumns: []*schema.Column{DeviceTagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// DeviceGroupDevicesColumns holds the columns for the "device_group_devices" table.
+	DeviceGroupDevicesColumns = []*schema.Column{
+		{Name: "device_group_id", Type: field.TypeInt},
+		{Name: "device_id", Type: field.TypeInt},
+	}
+	// DeviceGroupDevicesTable holds the schema information for the "device_group_devices" table.
+	DeviceGroupDevicesTable = &schema.Table{
+		Name:       "device_group_devices",
+		Columns:    DeviceGroupDevicesColumns,
+		PrimaryKey: []*schema.Column{DeviceGroupDevicesColumns[0], DeviceGroupDevicesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "device_group_devices_device_group_id",
+				Columns:    []*schema.Column{DeviceGroupDevicesColumns[0]},
+				RefColumns: []*schema.Column{DeviceGroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "device_group_devices_device_id",
+				Columns:    []*schema.Column{DeviceGroupDevicesColumns[1]},
+				RefColumns: []*schema.Column{DevicesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ProductFeatureRequiresColumns holds the columns for the "product_feature_requires" table.
+	ProductFeatureRequiresColumns = []*schema.Column{
+		{Name: "product_feature_id", Type: field.TypeInt},
+		{Name: "required_by_id", Type: field.TypeInt},
+	}
+	// ProductFeatureRequiresTable holds the schema information for the "product_feature_requires" table.
+	ProductFeatureRequiresTable = &schema.Table{
+		Name:       "product_feature_requires",
+		Columns:    ProductFeatureRequiresColumns,
+		PrimaryKey: []*schema.Column{ProductFeatureRequiresColumns[0], ProductFeatureRequiresColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "product_feature_requires_product_feature_id",
+				Columns:    []*schema.Column{ProductFeatureRequiresColumns[0]},
+				RefColumns: []*schema.Column{ProductFeaturesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "product_feature_requires_required_by_id",
+				Columns:    []*schema.Column{ProductFeatureRequiresColumns[1]},
+				RefColumns: []*schema.Column{ProductFeaturesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ProductFeatureConflictsColumns holds the columns for the "product_feature_conflicts" table.
+	ProductFeatureConflictsColumns = []*schema.Column{
+		{Name: "product_feature_id", Type: field.TypeInt},
+		{Name: "conflict_id", Type: field.TypeInt},
+	}
+	// ProductFeatureConflictsTable holds the schema information for the "product_feature_conflicts" table.
+	ProductFeatureConflictsTable = &schema.Table{
+		Name:       "product_feature_conflicts",
+		Columns:    ProductFeatureConflictsColumns,
+		PrimaryKey: []*schema.Column{ProductFeatureConflictsColumns[0], ProductFeatureConflictsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "product_feature_conflicts_product_feature_id",
+				Columns:    []*schema.Column{ProductFeatureConflictsColumns[0]},
+				RefColumns: []*schema.Column{ProductFeaturesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "product_feature_conflicts_conflict_id",
+				Columns:    []*schema.Column{ProductFeatureConflictsColumns[1]},
+				RefColumns: []*schema.Column{ProductFeaturesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 		},
 	}
 	// SoftwareVersionFeaturesColumns holds the columns for the "software_version_features" table.
@@ -603,47 +1516,103 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APITokensTable,
 		AuditLogsTable,
+		CustomersTable,
 		DevicesTable,
+		DeviceAssignmentsTable,
+		DeviceFeatureOverridesTable,
+		DeviceGroupsTable,
+		DeviceHeartbeatsTable,
+		DeviceSavedFiltersTable,
+		DeviceTagsTable,
 		FirmwareVersionsTable,
+		JobsTable,
 		LicenseTypesTable,
 		LicenseTypeFeaturesTable,
+		LotsTable,
 		MetricEventsTable,
+		OrdersTable,
 		PostsTable,
 		PostCategoriesTable,
 		PostTagsTable,
 		PostTagRelationsTable,
 		ProductsTable,
 		ProductFeaturesTable,
+		ProductInvitationsTable,
 		ProductManagersTable,
+		RegistrationInvitationsTable,
+		SnAllocatorsTable,
+		SnBlocksTable,
+		SnRulesTable,
 		SoftwareVersionsTable,
+		TwoFactorPoliciesTable,
 		UsersTable,
+		DeviceTagRelationsTable,
+		DeviceGroupDevicesTable,
+		ProductFeatureRequiresTable,
+		ProductFeatureConflictsTable,
 		SoftwareVersionFeaturesTable,
 		SoftwareVersionFirmwareVersionsTable,
 	}
 )
 
 func init() {
+	APITokensTable.ForeignKeys[0].RefTable = UsersTable
 	AuditLogsTable.ForeignKeys[0].RefTable = ProductsTable
 	AuditLogsTable.ForeignKeys[1].RefTable = UsersTable
-	DevicesTable.ForeignKeys[0].RefTable = UsersTable
+	DevicesTable.ForeignKeys[0].RefTable = CustomersTable
 	DevicesTable.ForeignKeys[1].RefTable = UsersTable
-	DevicesTable.ForeignKeys[2].RefTable = LicenseTypesTable
-	DevicesTable.ForeignKeys[3].RefTable = ProductsTable
+	DevicesTable.ForeignKeys[2].RefTable = UsersTable
+	DevicesTable.ForeignKeys[3].RefTable = LicenseTypesTable
+	DevicesTable.ForeignKeys[4].RefTable = LotsTable
+	DevicesTable.ForeignKeys[5].RefTable = OrdersTable
+	DevicesTable.ForeignKeys[6].RefTable = ProductsTable
+	DeviceAssignmentsTable.ForeignKeys[0].RefTable = DevicesTable
+	DeviceFeatureOverridesTable.ForeignKeys[0].RefTable = DevicesTable
+	DeviceFeatureOverridesTable.ForeignKeys[1].RefTable = ProductFeaturesTable
+	DeviceGroupsTable.ForeignKeys[0].RefTable = ProductsTable
+	DeviceHeartbeatsTable.ForeignKeys[0].RefTable = DevicesTable
+	DeviceSavedFiltersTable.ForeignKeys[0].RefTable = UsersTable
+	DeviceTagsTable.ForeignKeys[0].RefTable = ProductsTable
 	FirmwareVersionsTable.ForeignKeys[0].RefTable = UsersTable
 	FirmwareVersionsTable.ForeignKeys[1].RefTable = ProductsTable
-	LicenseTypesTable.ForeignKeys[0].RefTable = ProductsTable
+	JobsTable.ForeignKeys[0].RefTable = UsersTable
+	LicenseTypesTable.ForeignKeys[0].RefTable = LicenseTypesTable
+	LicenseTypesTable.ForeignKeys[1].RefTable = ProductsTable
 	LicenseTypeFeaturesTable.ForeignKeys[0].RefTable = LicenseTypesTable
 	LicenseTypeFeaturesTable.ForeignKeys[1].RefTable = ProductFeaturesTable
+	LotsTable.ForeignKeys[0].RefTable = LicenseTypesTable
+	LotsTable.ForeignKeys[1].RefTable = OrdersTable
+	LotsTable.ForeignKeys[2].RefTable = ProductsTable
+	OrdersTable.ForeignKeys[0].RefTable = CustomersTable
+	OrdersTable.ForeignKeys[1].RefTable = LicenseTypesTable
+	OrdersTable.ForeignKeys[2].RefTable = ProductsTable
 	PostsTable.ForeignKeys[0].RefTable = PostCategoriesTable
 	PostsTable.ForeignKeys[1].RefTable = UsersTable
 	PostTagRelationsTable.ForeignKeys[0].RefTable = PostsTable
 	PostTagRelationsTable.ForeignKeys[1].RefTable = PostTagsTable
 	ProductFeaturesTable.ForeignKeys[0].RefTable = ProductsTable
+	ProductInvitationsTable.ForeignKeys[0].RefTable = ProductsTable
+	ProductInvitationsTable.ForeignKeys[1].RefTable = UsersTable
 	ProductManagersTable.ForeignKeys[0].RefTable = ProductsTable
 	ProductManagersTable.ForeignKeys[1].RefTable = UsersTable
+	RegistrationInvitationsTable.ForeignKeys[0].RefTable = ProductsTable
+	RegistrationInvitationsTable.ForeignKeys[1].RefTable = UsersTable
+	SnAllocatorsTable.ForeignKeys[0].RefTable = ProductsTable
+	SnBlocksTable.ForeignKeys[0].RefTable = SnAllocatorsTable
+	SnBlocksTable.ForeignKeys[1].RefTable = UsersTable
+	SnRulesTable.ForeignKeys[0].RefTable = ProductsTable
 	SoftwareVersionsTable.ForeignKeys[0].RefTable = ProductsTable
 	SoftwareVersionsTable.ForeignKeys[1].RefTable = UsersTable
+	DeviceTagRelationsTable.ForeignKeys[0].RefTable = DevicesTable
+	DeviceTagRelationsTable.ForeignKeys[1].RefTable = DeviceTagsTable
+	DeviceGroupDevicesTable.ForeignKeys[0].RefTable = DeviceGroupsTable
+	DeviceGroupDevicesTable.ForeignKeys[1].RefTable = DevicesTable
+	ProductFeatureRequiresTable.ForeignKeys[0].RefTable = ProductFeaturesTable
+	ProductFeatureRequiresTable.ForeignKeys[1].RefTable = ProductFeaturesTable
+	ProductFeatureConflictsTable.ForeignKeys[0].RefTable = ProductFeaturesTable
+	ProductFeatureConflictsTable.ForeignKeys[1].RefTable = ProductFeaturesTable
 	SoftwareVersionFeaturesTable.ForeignKeys[0].RefTable = SoftwareVersionsTable
 	SoftwareVersionFeaturesTable.ForeignKeys[1].RefTable = ProductFeaturesTable
 	SoftwareVersionFirmwareVersionsTable.ForeignKeys[0].RefTable = SoftwareVersionsTable
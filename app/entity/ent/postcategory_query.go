@@ -11,6 +11,7 @@ import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/post"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/postcategory"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -24,6 +25,7 @@ type PostCategoryQuery struct {
 	inters     []Interceptor
 	predicates []predicate.PostCategory
 	withPosts  *PostQuery
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -383,6 +385,9 @@ func (pcq *PostCategoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(pcq.modifiers) > 0 {
+		_spec.Modifiers = pcq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -436,6 +441,9 @@ func (pcq *PostCategoryQuery) loadPosts(ctx context.Context, query *PostQuery, n
 
 func (pcq *PostCategoryQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pcq.querySpec()
+	if len(pcq.modifiers) > 0 {
+		_spec.Modifiers = pcq.modifiers
+	}
 	_spec.Node.Columns = pcq.ctx.Fields
 	if len(pcq.ctx.Fields) > 0 {
 		_spec.Unique = pcq.ctx.Unique != nil && *pcq.ctx.Unique
@@ -498,6 +506,9 @@ func (pcq *PostCategoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if pcq.ctx.Unique != nil && *pcq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range pcq.modifiers {
+		m(selector)
+	}
 	for _, p := range pcq.predicates {
 		p(selector)
 	}
@@ -515,6 +526,32 @@ func (pcq *PostCategoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pcq *PostCategoryQuery) ForUpdate(opts ...sql.LockOption) *PostCategoryQuery {
+	if pcq.driver.Dialect() == dialect.Postgres {
+		pcq.Unique(false)
+	}
+	pcq.modifiers = append(pcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pcq *PostCategoryQuery) ForShare(opts ...sql.LockOption) *PostCategoryQuery {
+	if pcq.driver.Dialect() == dialect.Postgres {
+		pcq.Unique(false)
+	}
+	pcq.modifiers = append(pcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pcq
+}
+
 // PostCategoryGroupBy is the group-by builder for PostCategory entities.
 type PostCategoryGroupBy struct {
 	selector
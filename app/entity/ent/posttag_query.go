@@ -11,6 +11,7 @@ import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/posttag"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/posttagrelation"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -24,6 +25,7 @@ type PostTagQuery struct {
 	inters            []Interceptor
 	predicates        []predicate.PostTag
 	withPostRelations *PostTagRelationQuery
+	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -383,6 +385,9 @@ func (ptq *PostTagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pos
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(ptq.modifiers) > 0 {
+		_spec.Modifiers = ptq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -435,6 +440,9 @@ func (ptq *PostTagQuery) loadPostRelations(ctx context.Context, query *PostTagRe
 
 func (ptq *PostTagQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ptq.querySpec()
+	if len(ptq.modifiers) > 0 {
+		_spec.Modifiers = ptq.modifiers
+	}
 	_spec.Node.Columns = ptq.ctx.Fields
 	if len(ptq.ctx.Fields) > 0 {
 		_spec.Unique = ptq.ctx.Unique != nil && *ptq.ctx.Unique
@@ -497,6 +505,9 @@ func (ptq *PostTagQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if ptq.ctx.Unique != nil && *ptq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range ptq.modifiers {
+		m(selector)
+	}
 	for _, p := range ptq.predicates {
 		p(selector)
 	}
@@ -514,6 +525,32 @@ func (ptq *PostTagQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ptq *PostTagQuery) ForUpdate(opts ...sql.LockOption) *PostTagQuery {
+	if ptq.driver.Dialect() == dialect.Postgres {
+		ptq.Unique(false)
+	}
+	ptq.modifiers = append(ptq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ptq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ptq *PostTagQuery) ForShare(opts ...sql.LockOption) *PostTagQuery {
+	if ptq.driver.Dialect() == dialect.Postgres {
+		ptq.Unique(false)
+	}
+	ptq.modifiers = append(ptq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ptq
+}
+
 // PostTagGroupBy is the group-by builder for PostTag entities.
 type PostTagGroupBy struct {
 	selector
@@ -11,6 +11,7 @@ import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/posttag"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/posttagrelation"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -25,6 +26,7 @@ type PostTagRelationQuery struct {
 	predicates  []predicate.PostTagRelation
 	withPost    *PostQuery
 	withPostTag *PostTagQuery
+	modifiers   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -419,6 +421,9 @@ func (ptrq *PostTagRelationQuery) sqlAll(ctx context.Context, hooks ...queryHook
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(ptrq.modifiers) > 0 {
+		_spec.Modifiers = ptrq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -504,6 +509,9 @@ func (ptrq *PostTagRelationQuery) loadPostTag(ctx context.Context, query *PostTa
 
 func (ptrq *PostTagRelationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ptrq.querySpec()
+	if len(ptrq.modifiers) > 0 {
+		_spec.Modifiers = ptrq.modifiers
+	}
 	_spec.Node.Columns = ptrq.ctx.Fields
 	if len(ptrq.ctx.Fields) > 0 {
 		_spec.Unique = ptrq.ctx.Unique != nil && *ptrq.ctx.Unique
@@ -572,6 +580,9 @@ func (ptrq *PostTagRelationQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if ptrq.ctx.Unique != nil && *ptrq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range ptrq.modifiers {
+		m(selector)
+	}
 	for _, p := range ptrq.predicates {
 		p(selector)
 	}
@@ -589,6 +600,32 @@ func (ptrq *PostTagRelationQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ptrq *PostTagRelationQuery) ForUpdate(opts ...sql.LockOption) *PostTagRelationQuery {
+	if ptrq.driver.Dialect() == dialect.Postgres {
+		ptrq.Unique(false)
+	}
+	ptrq.modifiers = append(ptrq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ptrq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ptrq *PostTagRelationQuery) ForShare(opts ...sql.LockOption) *PostTagRelationQuery {
+	if ptrq.driver.Dialect() == dialect.Postgres {
+		ptrq.Unique(false)
+	}
+	ptrq.modifiers = append(ptrq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ptrq
+}
+
 // PostTagRelationGroupBy is the group-by builder for PostTagRelation entities.
 type PostTagRelationGroupBy struct {
 	selector
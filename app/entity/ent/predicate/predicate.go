@@ -6,24 +6,57 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIToken is the predicate function for apitoken builders.
+type APIToken func(*sql.Selector)
+
 // AuditLog is the predicate function for auditlog builders.
 type AuditLog func(*sql.Selector)
 
+// Customer is the predicate function for customer builders.
+type Customer func(*sql.Selector)
+
 // Device is the predicate function for device builders.
 type Device func(*sql.Selector)
 
+// DeviceAssignment is the predicate function for deviceassignment builders.
+type DeviceAssignment func(*sql.Selector)
+
+// DeviceFeatureOverride is the predicate function for devicefeatureoverride builders.
+type DeviceFeatureOverride func(*sql.Selector)
+
+// DeviceGroup is the predicate function for devicegroup builders.
+type DeviceGroup func(*sql.Selector)
+
+// DeviceHeartbeat is the predicate function for deviceheartbeat builders.
+type DeviceHeartbeat func(*sql.Selector)
+
+// DeviceSavedFilter is the predicate function for devicesavedfilter builders.
+type DeviceSavedFilter func(*sql.Selector)
+
+// DeviceTag is the predicate function for devicetag builders.
+type DeviceTag func(*sql.Selector)
+
 // FirmwareVersion is the predicate function for firmwareversion builders.
 type FirmwareVersion func(*sql.Selector)
 
+// Job is the predicate function for job builders.
+type Job func(*sql.Selector)
+
 // LicenseType is the predicate function for licensetype builders.
 type LicenseType func(*sql.Selector)
 
 // LicenseTypeFeatures is the predicate function for licensetypefeatures builders.
 type LicenseTypeFeatures func(*sql.Selector)
 
+// Lot is the predicate function for lot builders.
+type Lot func(*sql.Selector)
+
 // MetricEvent is the predicate function for metricevent builders.
 type MetricEvent func(*sql.Selector)
 
+// Order is the predicate function for order builders.
+type Order func(*sql.Selector)
+
 // Post is the predicate function for post builders.
 type Post func(*sql.Selector)
 
@@ -42,11 +75,29 @@ type Product func(*sql.Selector)
 // ProductFeature is the predicate function for productfeature builders.
 type ProductFeature func(*sql.Selector)
 
+// ProductInvitation is the predicate function for productinvitation builders.
+type ProductInvitation func(*sql.Selector)
+
 // ProductManager is the predicate function for productmanager builders.
 type ProductManager func(*sql.Selector)
 
+// RegistrationInvitation is the predicate function for registrationinvitation builders.
+type RegistrationInvitation func(*sql.Selector)
+
+// SnAllocator is the predicate function for snallocator builders.
+type SnAllocator func(*sql.Selector)
+
+// SnBlock is the predicate function for snblock builders.
+type SnBlock func(*sql.Selector)
+
+// SnRule is the predicate function for snrule builders.
+type SnRule func(*sql.Selector)
+
 // SoftwareVersion is the predicate function for softwareversion builders.
 type SoftwareVersion func(*sql.Selector)
 
+// TwoFactorPolicy is the predicate function for twofactorpolicy builders.
+type TwoFactorPolicy func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snrule"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 )
@@ -17,12 +18,20 @@ type Product struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 删除标记，正常记录为0，删除后为记录ID，用于唯一索引
+	DeletedID int `json:"deleted_id,omitempty"`
 	// 产品代号
 	Code string `json:"code,omitempty"`
 	// 产品类别
 	ProductType string `json:"product_type,omitempty"`
 	// 产品名称
 	ProductName string `json:"product_name,omitempty"`
+	// 保修期（月），设备出货后按此计算保修截止时间
+	WarrantyMonths int `json:"warranty_months,omitempty"`
+	// 设备自定义属性的JSON Schema，为空表示不限制
+	DeviceAttributeSchema string `json:"device_attribute_schema,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -49,9 +58,25 @@ type ProductEdges struct {
 	Devices []*Device `json:"devices,omitempty"`
 	// AuditLogs holds the value of the audit_logs edge.
 	AuditLogs []*AuditLog `json:"audit_logs,omitempty"`
+	// SnRule holds the value of the sn_rule edge.
+	SnRule *SnRule `json:"sn_rule,omitempty"`
+	// SnAllocators holds the value of the sn_allocators edge.
+	SnAllocators []*SnAllocator `json:"sn_allocators,omitempty"`
+	// DeviceTags holds the value of the device_tags edge.
+	DeviceTags []*DeviceTag `json:"device_tags,omitempty"`
+	// DeviceGroups holds the value of the device_groups edge.
+	DeviceGroups []*DeviceGroup `json:"device_groups,omitempty"`
+	// Orders holds the value of the orders edge.
+	Orders []*Order `json:"orders,omitempty"`
+	// Lots holds the value of the lots edge.
+	Lots []*Lot `json:"lots,omitempty"`
+	// Invitations holds the value of the invitations edge.
+	Invitations []*ProductInvitation `json:"invitations,omitempty"`
+	// RegistrationInvitations holds the value of the registration_invitations edge.
+	RegistrationInvitations []*RegistrationInvitation `json:"registration_invitations,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [15]bool
 }
 
 // ManagersOrErr returns the Managers value or an error if the edge
@@ -117,16 +142,92 @@ func (e ProductEdges) AuditLogsOrErr() ([]*AuditLog, error) {
 	return nil, &NotLoadedError{edge: "audit_logs"}
 }
 
+// SnRuleOrErr returns the SnRule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProductEdges) SnRuleOrErr() (*SnRule, error) {
+	if e.loadedTypes[7] {
+		if e.SnRule == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: snrule.Label}
+		}
+		return e.SnRule, nil
+	}
+	return nil, &NotLoadedError{edge: "sn_rule"}
+}
+
+// SnAllocatorsOrErr returns the SnAllocators value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) SnAllocatorsOrErr() ([]*SnAllocator, error) {
+	if e.loadedTypes[8] {
+		return e.SnAllocators, nil
+	}
+	return nil, &NotLoadedError{edge: "sn_allocators"}
+}
+
+// DeviceTagsOrErr returns the DeviceTags value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) DeviceTagsOrErr() ([]*DeviceTag, error) {
+	if e.loadedTypes[9] {
+		return e.DeviceTags, nil
+	}
+	return nil, &NotLoadedError{edge: "device_tags"}
+}
+
+// DeviceGroupsOrErr returns the DeviceGroups value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) DeviceGroupsOrErr() ([]*DeviceGroup, error) {
+	if e.loadedTypes[10] {
+		return e.DeviceGroups, nil
+	}
+	return nil, &NotLoadedError{edge: "device_groups"}
+}
+
+// OrdersOrErr returns the Orders value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) OrdersOrErr() ([]*Order, error) {
+	if e.loadedTypes[11] {
+		return e.Orders, nil
+	}
+	return nil, &NotLoadedError{edge: "orders"}
+}
+
+// LotsOrErr returns the Lots value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) LotsOrErr() ([]*Lot, error) {
+	if e.loadedTypes[12] {
+		return e.Lots, nil
+	}
+	return nil, &NotLoadedError{edge: "lots"}
+}
+
+// InvitationsOrErr returns the Invitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) InvitationsOrErr() ([]*ProductInvitation, error) {
+	if e.loadedTypes[13] {
+		return e.Invitations, nil
+	}
+	return nil, &NotLoadedError{edge: "invitations"}
+}
+
+// RegistrationInvitationsOrErr returns the RegistrationInvitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) RegistrationInvitationsOrErr() ([]*RegistrationInvitation, error) {
+	if e.loadedTypes[14] {
+		return e.RegistrationInvitations, nil
+	}
+	return nil, &NotLoadedError{edge: "registration_invitations"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Product) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case product.FieldID:
+		case product.FieldID, product.FieldDeletedID, product.FieldWarrantyMonths:
 			values[i] = new(sql.NullInt64)
-		case product.FieldCode, product.FieldProductType, product.FieldProductName:
+		case product.FieldCode, product.FieldProductType, product.FieldProductName, product.FieldDeviceAttributeSchema:
 			values[i] = new(sql.NullString)
-		case product.FieldCreatedAt, product.FieldUpdatedAt:
+		case product.FieldDeletedAt, product.FieldCreatedAt, product.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -149,6 +250,19 @@ func (pr *Product) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			pr.ID = int(value.Int64)
+		case product.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pr.DeletedAt = new(time.Time)
+				*pr.DeletedAt = value.Time
+			}
+		case product.FieldDeletedID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_id", values[i])
+			} else if value.Valid {
+				pr.DeletedID = int(value.Int64)
+			}
 		case product.FieldCode:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field code", values[i])
@@ -167,6 +281,18 @@ func (pr *Product) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.ProductName = value.String
 			}
+		case product.FieldWarrantyMonths:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field warranty_months", values[i])
+			} else if value.Valid {
+				pr.WarrantyMonths = int(value.Int64)
+			}
+		case product.FieldDeviceAttributeSchema:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_attribute_schema", values[i])
+			} else if value.Valid {
+				pr.DeviceAttributeSchema = value.String
+			}
 		case product.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -227,6 +353,46 @@ func (pr *Product) QueryAuditLogs() *AuditLogQuery {
 	return NewProductClient(pr.config).QueryAuditLogs(pr)
 }
 
+// QuerySnRule queries the "sn_rule" edge of the Product entity.
+func (pr *Product) QuerySnRule() *SnRuleQuery {
+	return NewProductClient(pr.config).QuerySnRule(pr)
+}
+
+// QuerySnAllocators queries the "sn_allocators" edge of the Product entity.
+func (pr *Product) QuerySnAllocators() *SnAllocatorQuery {
+	return NewProductClient(pr.config).QuerySnAllocators(pr)
+}
+
+// QueryDeviceTags queries the "device_tags" edge of the Product entity.
+func (pr *Product) QueryDeviceTags() *DeviceTagQuery {
+	return NewProductClient(pr.config).QueryDeviceTags(pr)
+}
+
+// QueryDeviceGroups queries the "device_groups" edge of the Product entity.
+func (pr *Product) QueryDeviceGroups() *DeviceGroupQuery {
+	return NewProductClient(pr.config).QueryDeviceGroups(pr)
+}
+
+// QueryOrders queries the "orders" edge of the Product entity.
+func (pr *Product) QueryOrders() *OrderQuery {
+	return NewProductClient(pr.config).QueryOrders(pr)
+}
+
+// QueryLots queries the "lots" edge of the Product entity.
+func (pr *Product) QueryLots() *LotQuery {
+	return NewProductClient(pr.config).QueryLots(pr)
+}
+
+// QueryInvitations queries the "invitations" edge of the Product entity.
+func (pr *Product) QueryInvitations() *ProductInvitationQuery {
+	return NewProductClient(pr.config).QueryInvitations(pr)
+}
+
+// QueryRegistrationInvitations queries the "registration_invitations" edge of the Product entity.
+func (pr *Product) QueryRegistrationInvitations() *RegistrationInvitationQuery {
+	return NewProductClient(pr.config).QueryRegistrationInvitations(pr)
+}
+
 // Update returns a builder for updating this Product.
 // Note that you need to call Product.Unwrap() before calling this method if this Product
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -250,6 +416,14 @@ func (pr *Product) String() string {
 	var builder strings.Builder
 	builder.WriteString("Product(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	if v := pr.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deleted_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.DeletedID))
+	builder.WriteString(", ")
 	builder.WriteString("code=")
 	builder.WriteString(pr.Code)
 	builder.WriteString(", ")
@@ -259,6 +433,12 @@ func (pr *Product) String() string {
 	builder.WriteString("product_name=")
 	builder.WriteString(pr.ProductName)
 	builder.WriteString(", ")
+	builder.WriteString("warranty_months=")
+	builder.WriteString(fmt.Sprintf("%v", pr.WarrantyMonths))
+	builder.WriteString(", ")
+	builder.WriteString("device_attribute_schema=")
+	builder.WriteString(pr.DeviceAttributeSchema)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
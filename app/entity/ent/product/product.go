@@ -5,6 +5,7 @@ package product
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -14,12 +15,20 @@ const (
 	Label = "product"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldDeletedID holds the string denoting the deleted_id field in the database.
+	FieldDeletedID = "deleted_id"
 	// FieldCode holds the string denoting the code field in the database.
 	FieldCode = "code"
 	// FieldProductType holds the string denoting the product_type field in the database.
 	FieldProductType = "product_type"
 	// FieldProductName holds the string denoting the product_name field in the database.
 	FieldProductName = "product_name"
+	// FieldWarrantyMonths holds the string denoting the warranty_months field in the database.
+	FieldWarrantyMonths = "warranty_months"
+	// FieldDeviceAttributeSchema holds the string denoting the device_attribute_schema field in the database.
+	FieldDeviceAttributeSchema = "device_attribute_schema"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -38,6 +47,22 @@ const (
 	EdgeDevices = "devices"
 	// EdgeAuditLogs holds the string denoting the audit_logs edge name in mutations.
 	EdgeAuditLogs = "audit_logs"
+	// EdgeSnRule holds the string denoting the sn_rule edge name in mutations.
+	EdgeSnRule = "sn_rule"
+	// EdgeSnAllocators holds the string denoting the sn_allocators edge name in mutations.
+	EdgeSnAllocators = "sn_allocators"
+	// EdgeDeviceTags holds the string denoting the device_tags edge name in mutations.
+	EdgeDeviceTags = "device_tags"
+	// EdgeDeviceGroups holds the string denoting the device_groups edge name in mutations.
+	EdgeDeviceGroups = "device_groups"
+	// EdgeOrders holds the string denoting the orders edge name in mutations.
+	EdgeOrders = "orders"
+	// EdgeLots holds the string denoting the lots edge name in mutations.
+	EdgeLots = "lots"
+	// EdgeInvitations holds the string denoting the invitations edge name in mutations.
+	EdgeInvitations = "invitations"
+	// EdgeRegistrationInvitations holds the string denoting the registration_invitations edge name in mutations.
+	EdgeRegistrationInvitations = "registration_invitations"
 	// Table holds the table name of the product in the database.
 	Table = "products"
 	// ManagersTable is the table that holds the managers relation/edge.
@@ -89,14 +114,74 @@ const (
 	AuditLogsInverseTable = "audit_logs"
 	// AuditLogsColumn is the table column denoting the audit_logs relation/edge.
 	AuditLogsColumn = "product_id"
+	// SnRuleTable is the table that holds the sn_rule relation/edge.
+	SnRuleTable = "sn_rules"
+	// SnRuleInverseTable is the table name for the SnRule entity.
+	// It exists in this package in order to avoid circular dependency with the "snrule" package.
+	SnRuleInverseTable = "sn_rules"
+	// SnRuleColumn is the table column denoting the sn_rule relation/edge.
+	SnRuleColumn = "product_id"
+	// SnAllocatorsTable is the table that holds the sn_allocators relation/edge.
+	SnAllocatorsTable = "sn_allocators"
+	// SnAllocatorsInverseTable is the table name for the SnAllocator entity.
+	// It exists in this package in order to avoid circular dependency with the "snallocator" package.
+	SnAllocatorsInverseTable = "sn_allocators"
+	// SnAllocatorsColumn is the table column denoting the sn_allocators relation/edge.
+	SnAllocatorsColumn = "product_id"
+	// DeviceTagsTable is the table that holds the device_tags relation/edge.
+	DeviceTagsTable = "device_tags"
+	// DeviceTagsInverseTable is the table name for the DeviceTag entity.
+	// It exists in this package in order to avoid circular dependency with the "devicetag" package.
+	DeviceTagsInverseTable = "device_tags"
+	// DeviceTagsColumn is the table column denoting the device_tags relation/edge.
+	DeviceTagsColumn = "product_id"
+	// DeviceGroupsTable is the table that holds the device_groups relation/edge.
+	DeviceGroupsTable = "device_groups"
+	// DeviceGroupsInverseTable is the table name for the DeviceGroup entity.
+	// It exists in this package in order to avoid circular dependency with the "devicegroup" package.
+	DeviceGroupsInverseTable = "device_groups"
+	// DeviceGroupsColumn is the table column denoting the device_groups relation/edge.
+	DeviceGroupsColumn = "product_id"
+	// OrdersTable is the table that holds the orders relation/edge.
+	OrdersTable = "orders"
+	// OrdersInverseTable is the table name for the Order entity.
+	// It exists in this package in order to avoid circular dependency with the "order" package.
+	OrdersInverseTable = "orders"
+	// OrdersColumn is the table column denoting the orders relation/edge.
+	OrdersColumn = "product_id"
+	// LotsTable is the table that holds the lots relation/edge.
+	LotsTable = "lots"
+	// LotsInverseTable is the table name for the Lot entity.
+	// It exists in this package in order to avoid circular dependency with the "lot" package.
+	LotsInverseTable = "lots"
+	// LotsColumn is the table column denoting the lots relation/edge.
+	LotsColumn = "product_id"
+	// InvitationsTable is the table that holds the invitations relation/edge.
+	InvitationsTable = "product_invitations"
+	// InvitationsInverseTable is the table name for the ProductInvitation entity.
+	// It exists in this package in order to avoid circular dependency with the "productinvitation" package.
+	InvitationsInverseTable = "product_invitations"
+	// InvitationsColumn is the table column denoting the invitations relation/edge.
+	InvitationsColumn = "product_id"
+	// RegistrationInvitationsTable is the table that holds the registration_invitations relation/edge.
+	RegistrationInvitationsTable = "registration_invitations"
+	// RegistrationInvitationsInverseTable is the table name for the RegistrationInvitation entity.
+	// It exists in this package in order to avoid circular dependency with the "registrationinvitation" package.
+	RegistrationInvitationsInverseTable = "registration_invitations"
+	// RegistrationInvitationsColumn is the table column denoting the registration_invitations relation/edge.
+	RegistrationInvitationsColumn = "product_id"
 )
 
 // Columns holds all SQL columns for product fields.
 var Columns = []string{
 	FieldID,
+	FieldDeletedAt,
+	FieldDeletedID,
 	FieldCode,
 	FieldProductType,
 	FieldProductName,
+	FieldWarrantyMonths,
+	FieldDeviceAttributeSchema,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -111,13 +196,26 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "cambridge-hit.com/gin-base/activateserver/app/entity/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultDeletedID holds the default value on creation for the "deleted_id" field.
+	DefaultDeletedID int
 	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
 	CodeValidator func(string) error
 	// DefaultProductType holds the default value on creation for the "product_type" field.
 	DefaultProductType string
 	// ProductNameValidator is a validator for the "product_name" field. It is called by the builders before save.
 	ProductNameValidator func(string) error
+	// DefaultWarrantyMonths holds the default value on creation for the "warranty_months" field.
+	DefaultWarrantyMonths int
+	// WarrantyMonthsValidator is a validator for the "warranty_months" field. It is called by the builders before save.
+	WarrantyMonthsValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -136,6 +234,16 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByDeletedID orders the results by the deleted_id field.
+func ByDeletedID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedID, opts...).ToFunc()
+}
+
 // ByCode orders the results by the code field.
 func ByCode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCode, opts...).ToFunc()
@@ -151,6 +259,16 @@ func ByProductName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProductName, opts...).ToFunc()
 }
 
+// ByWarrantyMonths orders the results by the warranty_months field.
+func ByWarrantyMonths(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWarrantyMonths, opts...).ToFunc()
+}
+
+// ByDeviceAttributeSchema orders the results by the device_attribute_schema field.
+func ByDeviceAttributeSchema(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceAttributeSchema, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -258,6 +376,111 @@ func ByAuditLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAuditLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySnRuleField orders the results by sn_rule field.
+func BySnRuleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSnRuleStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySnAllocatorsCount orders the results by sn_allocators count.
+func BySnAllocatorsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSnAllocatorsStep(), opts...)
+	}
+}
+
+// BySnAllocators orders the results by sn_allocators terms.
+func BySnAllocators(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSnAllocatorsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDeviceTagsCount orders the results by device_tags count.
+func ByDeviceTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeviceTagsStep(), opts...)
+	}
+}
+
+// ByDeviceTags orders the results by device_tags terms.
+func ByDeviceTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeviceTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDeviceGroupsCount orders the results by device_groups count.
+func ByDeviceGroupsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeviceGroupsStep(), opts...)
+	}
+}
+
+// ByDeviceGroups orders the results by device_groups terms.
+func ByDeviceGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeviceGroupsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOrdersCount orders the results by orders count.
+func ByOrdersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOrdersStep(), opts...)
+	}
+}
+
+// ByOrders orders the results by orders terms.
+func ByOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrdersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLotsCount orders the results by lots count.
+func ByLotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLotsStep(), opts...)
+	}
+}
+
+// ByLots orders the results by lots terms.
+func ByLots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInvitationsCount orders the results by invitations count.
+func ByInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvitationsStep(), opts...)
+	}
+}
+
+// ByInvitations orders the results by invitations terms.
+func ByInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRegistrationInvitationsCount orders the results by registration_invitations count.
+func ByRegistrationInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRegistrationInvitationsStep(), opts...)
+	}
+}
+
+// ByRegistrationInvitations orders the results by registration_invitations terms.
+func ByRegistrationInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRegistrationInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newManagersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -307,3 +530,59 @@ func newAuditLogsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, AuditLogsTable, AuditLogsColumn),
 	)
 }
+func newSnRuleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SnRuleInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, SnRuleTable, SnRuleColumn),
+	)
+}
+func newSnAllocatorsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SnAllocatorsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SnAllocatorsTable, SnAllocatorsColumn),
+	)
+}
+func newDeviceTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeviceTagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DeviceTagsTable, DeviceTagsColumn),
+	)
+}
+func newDeviceGroupsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeviceGroupsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DeviceGroupsTable, DeviceGroupsColumn),
+	)
+}
+func newOrdersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrdersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OrdersTable, OrdersColumn),
+	)
+}
+func newLotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LotsTable, LotsColumn),
+	)
+}
+func newInvitationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvitationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
+	)
+}
+func newRegistrationInvitationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RegistrationInvitationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RegistrationInvitationsTable, RegistrationInvitationsColumn),
+	)
+}
@@ -55,6 +55,16 @@ func IDLTE(id int) predicate.Product {
 	return predicate.Product(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedID applies equality check predicate on the "deleted_id" field. It's identical to DeletedIDEQ.
+func DeletedID(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDeletedID, v))
+}
+
 // Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
 func Code(v string) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldCode, v))
@@ -70,6 +80,16 @@ func ProductName(v string) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldProductName, v))
 }
 
+// WarrantyMonths applies equality check predicate on the "warranty_months" field. It's identical to WarrantyMonthsEQ.
+func WarrantyMonths(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldWarrantyMonths, v))
+}
+
+// DeviceAttributeSchema applies equality check predicate on the "device_attribute_schema" field. It's identical to DeviceAttributeSchemaEQ.
+func DeviceAttributeSchema(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDeviceAttributeSchema, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldCreatedAt, v))
@@ -80,6 +100,96 @@ func UpdatedAt(v time.Time) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DeletedIDEQ applies the EQ predicate on the "deleted_id" field.
+func DeletedIDEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDeletedID, v))
+}
+
+// DeletedIDNEQ applies the NEQ predicate on the "deleted_id" field.
+func DeletedIDNEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldDeletedID, v))
+}
+
+// DeletedIDIn applies the In predicate on the "deleted_id" field.
+func DeletedIDIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldDeletedID, vs...))
+}
+
+// DeletedIDNotIn applies the NotIn predicate on the "deleted_id" field.
+func DeletedIDNotIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldDeletedID, vs...))
+}
+
+// DeletedIDGT applies the GT predicate on the "deleted_id" field.
+func DeletedIDGT(v int) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldDeletedID, v))
+}
+
+// DeletedIDGTE applies the GTE predicate on the "deleted_id" field.
+func DeletedIDGTE(v int) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldDeletedID, v))
+}
+
+// DeletedIDLT applies the LT predicate on the "deleted_id" field.
+func DeletedIDLT(v int) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldDeletedID, v))
+}
+
+// DeletedIDLTE applies the LTE predicate on the "deleted_id" field.
+func DeletedIDLTE(v int) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldDeletedID, v))
+}
+
 // CodeEQ applies the EQ predicate on the "code" field.
 func CodeEQ(v string) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldCode, v))
@@ -285,6 +395,121 @@ func ProductNameContainsFold(v string) predicate.Product {
 	return predicate.Product(sql.FieldContainsFold(FieldProductName, v))
 }
 
+// WarrantyMonthsEQ applies the EQ predicate on the "warranty_months" field.
+func WarrantyMonthsEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldWarrantyMonths, v))
+}
+
+// WarrantyMonthsNEQ applies the NEQ predicate on the "warranty_months" field.
+func WarrantyMonthsNEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldWarrantyMonths, v))
+}
+
+// WarrantyMonthsIn applies the In predicate on the "warranty_months" field.
+func WarrantyMonthsIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldWarrantyMonths, vs...))
+}
+
+// WarrantyMonthsNotIn applies the NotIn predicate on the "warranty_months" field.
+func WarrantyMonthsNotIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldWarrantyMonths, vs...))
+}
+
+// WarrantyMonthsGT applies the GT predicate on the "warranty_months" field.
+func WarrantyMonthsGT(v int) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldWarrantyMonths, v))
+}
+
+// WarrantyMonthsGTE applies the GTE predicate on the "warranty_months" field.
+func WarrantyMonthsGTE(v int) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldWarrantyMonths, v))
+}
+
+// WarrantyMonthsLT applies the LT predicate on the "warranty_months" field.
+func WarrantyMonthsLT(v int) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldWarrantyMonths, v))
+}
+
+// WarrantyMonthsLTE applies the LTE predicate on the "warranty_months" field.
+func WarrantyMonthsLTE(v int) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldWarrantyMonths, v))
+}
+
+// DeviceAttributeSchemaEQ applies the EQ predicate on the "device_attribute_schema" field.
+func DeviceAttributeSchemaEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldDeviceAttributeSchema, v))
+}
+
+// DeviceAttributeSchemaNEQ applies the NEQ predicate on the "device_attribute_schema" field.
+func DeviceAttributeSchemaNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldDeviceAttributeSchema, v))
+}
+
+// DeviceAttributeSchemaIn applies the In predicate on the "device_attribute_schema" field.
+func DeviceAttributeSchemaIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldDeviceAttributeSchema, vs...))
+}
+
+// DeviceAttributeSchemaNotIn applies the NotIn predicate on the "device_attribute_schema" field.
+func DeviceAttributeSchemaNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldDeviceAttributeSchema, vs...))
+}
+
+// DeviceAttributeSchemaGT applies the GT predicate on the "device_attribute_schema" field.
+func DeviceAttributeSchemaGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldDeviceAttributeSchema, v))
+}
+
+// DeviceAttributeSchemaGTE applies the GTE predicate on the "device_attribute_schema" field.
+func DeviceAttributeSchemaGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldDeviceAttributeSchema, v))
+}
+
+// DeviceAttributeSchemaLT applies the LT predicate on the "device_attribute_schema" field.
+func DeviceAttributeSchemaLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldDeviceAttributeSchema, v))
+}
+
+// DeviceAttributeSchemaLTE applies the LTE predicate on the "device_attribute_schema" field.
+func DeviceAttributeSchemaLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldDeviceAttributeSchema, v))
+}
+
+// DeviceAttributeSchemaContains applies the Contains predicate on the "device_attribute_schema" field.
+func DeviceAttributeSchemaContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldDeviceAttributeSchema, v))
+}
+
+// DeviceAttributeSchemaHasPrefix applies the HasPrefix predicate on the "device_attribute_schema" field.
+func DeviceAttributeSchemaHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldDeviceAttributeSchema, v))
+}
+
+// DeviceAttributeSchemaHasSuffix applies the HasSuffix predicate on the "device_attribute_schema" field.
+func DeviceAttributeSchemaHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldDeviceAttributeSchema, v))
+}
+
+// DeviceAttributeSchemaIsNil applies the IsNil predicate on the "device_attribute_schema" field.
+func DeviceAttributeSchemaIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldDeviceAttributeSchema))
+}
+
+// DeviceAttributeSchemaNotNil applies the NotNil predicate on the "device_attribute_schema" field.
+func DeviceAttributeSchemaNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldDeviceAttributeSchema))
+}
+
+// DeviceAttributeSchemaEqualFold applies the EqualFold predicate on the "device_attribute_schema" field.
+func DeviceAttributeSchemaEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldDeviceAttributeSchema, v))
+}
+
+// DeviceAttributeSchemaContainsFold applies the ContainsFold predicate on the "device_attribute_schema" field.
+func DeviceAttributeSchemaContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldDeviceAttributeSchema, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldCreatedAt, v))
@@ -526,6 +751,190 @@ func HasAuditLogsWith(preds ...predicate.AuditLog) predicate.Product {
 	})
 }
 
+// HasSnRule applies the HasEdge predicate on the "sn_rule" edge.
+func HasSnRule() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, SnRuleTable, SnRuleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSnRuleWith applies the HasEdge predicate on the "sn_rule" edge with a given conditions (other predicates).
+func HasSnRuleWith(preds ...predicate.SnRule) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newSnRuleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSnAllocators applies the HasEdge predicate on the "sn_allocators" edge.
+func HasSnAllocators() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SnAllocatorsTable, SnAllocatorsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSnAllocatorsWith applies the HasEdge predicate on the "sn_allocators" edge with a given conditions (other predicates).
+func HasSnAllocatorsWith(preds ...predicate.SnAllocator) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newSnAllocatorsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeviceTags applies the HasEdge predicate on the "device_tags" edge.
+func HasDeviceTags() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeviceTagsTable, DeviceTagsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeviceTagsWith applies the HasEdge predicate on the "device_tags" edge with a given conditions (other predicates).
+func HasDeviceTagsWith(preds ...predicate.DeviceTag) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newDeviceTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeviceGroups applies the HasEdge predicate on the "device_groups" edge.
+func HasDeviceGroups() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeviceGroupsTable, DeviceGroupsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeviceGroupsWith applies the HasEdge predicate on the "device_groups" edge with a given conditions (other predicates).
+func HasDeviceGroupsWith(preds ...predicate.DeviceGroup) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newDeviceGroupsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrders applies the HasEdge predicate on the "orders" edge.
+func HasOrders() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OrdersTable, OrdersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrdersWith applies the HasEdge predicate on the "orders" edge with a given conditions (other predicates).
+func HasOrdersWith(preds ...predicate.Order) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newOrdersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLots applies the HasEdge predicate on the "lots" edge.
+func HasLots() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LotsTable, LotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLotsWith applies the HasEdge predicate on the "lots" edge with a given conditions (other predicates).
+func HasLotsWith(preds ...predicate.Lot) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newLotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvitations applies the HasEdge predicate on the "invitations" edge.
+func HasInvitations() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvitationsWith applies the HasEdge predicate on the "invitations" edge with a given conditions (other predicates).
+func HasInvitationsWith(preds ...predicate.ProductInvitation) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newInvitationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRegistrationInvitations applies the HasEdge predicate on the "registration_invitations" edge.
+func HasRegistrationInvitations() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RegistrationInvitationsTable, RegistrationInvitationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRegistrationInvitationsWith applies the HasEdge predicate on the "registration_invitations" edge with a given conditions (other predicates).
+func HasRegistrationInvitationsWith(preds ...predicate.RegistrationInvitation) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newRegistrationInvitationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Product) predicate.Product {
 	return predicate.Product(sql.AndPredicates(predicates...))
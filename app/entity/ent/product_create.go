@@ -10,11 +10,19 @@ import (
 
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/auditlog"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicegroup"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicetag"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/firmwareversion"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/lot"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productinvitation"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/registrationinvitation"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snallocator"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snrule"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/softwareversion"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -27,6 +35,34 @@ type ProductCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (pc *ProductCreate) SetDeletedAt(t time.Time) *ProductCreate {
+	pc.mutation.SetDeletedAt(t)
+	return pc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableDeletedAt(t *time.Time) *ProductCreate {
+	if t != nil {
+		pc.SetDeletedAt(*t)
+	}
+	return pc
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (pc *ProductCreate) SetDeletedID(i int) *ProductCreate {
+	pc.mutation.SetDeletedID(i)
+	return pc
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableDeletedID(i *int) *ProductCreate {
+	if i != nil {
+		pc.SetDeletedID(*i)
+	}
+	return pc
+}
+
 // SetCode sets the "code" field.
 func (pc *ProductCreate) SetCode(s string) *ProductCreate {
 	pc.mutation.SetCode(s)
@@ -53,6 +89,34 @@ func (pc *ProductCreate) SetProductName(s string) *ProductCreate {
 	return pc
 }
 
+// SetWarrantyMonths sets the "warranty_months" field.
+func (pc *ProductCreate) SetWarrantyMonths(i int) *ProductCreate {
+	pc.mutation.SetWarrantyMonths(i)
+	return pc
+}
+
+// SetNillableWarrantyMonths sets the "warranty_months" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableWarrantyMonths(i *int) *ProductCreate {
+	if i != nil {
+		pc.SetWarrantyMonths(*i)
+	}
+	return pc
+}
+
+// SetDeviceAttributeSchema sets the "device_attribute_schema" field.
+func (pc *ProductCreate) SetDeviceAttributeSchema(s string) *ProductCreate {
+	pc.mutation.SetDeviceAttributeSchema(s)
+	return pc
+}
+
+// SetNillableDeviceAttributeSchema sets the "device_attribute_schema" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableDeviceAttributeSchema(s *string) *ProductCreate {
+	if s != nil {
+		pc.SetDeviceAttributeSchema(*s)
+	}
+	return pc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (pc *ProductCreate) SetCreatedAt(t time.Time) *ProductCreate {
 	pc.mutation.SetCreatedAt(t)
@@ -192,6 +256,130 @@ func (pc *ProductCreate) AddAuditLogs(a ...*AuditLog) *ProductCreate {
 	return pc.AddAuditLogIDs(ids...)
 }
 
+// SetSnRuleID sets the "sn_rule" edge to the SnRule entity by ID.
+func (pc *ProductCreate) SetSnRuleID(id int) *ProductCreate {
+	pc.mutation.SetSnRuleID(id)
+	return pc
+}
+
+// SetNillableSnRuleID sets the "sn_rule" edge to the SnRule entity by ID if the given value is not nil.
+func (pc *ProductCreate) SetNillableSnRuleID(id *int) *ProductCreate {
+	if id != nil {
+		pc = pc.SetSnRuleID(*id)
+	}
+	return pc
+}
+
+// SetSnRule sets the "sn_rule" edge to the SnRule entity.
+func (pc *ProductCreate) SetSnRule(s *SnRule) *ProductCreate {
+	return pc.SetSnRuleID(s.ID)
+}
+
+// AddSnAllocatorIDs adds the "sn_allocators" edge to the SnAllocator entity by IDs.
+func (pc *ProductCreate) AddSnAllocatorIDs(ids ...int) *ProductCreate {
+	pc.mutation.AddSnAllocatorIDs(ids...)
+	return pc
+}
+
+// AddSnAllocators adds the "sn_allocators" edges to the SnAllocator entity.
+func (pc *ProductCreate) AddSnAllocators(s ...*SnAllocator) *ProductCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pc.AddSnAllocatorIDs(ids...)
+}
+
+// AddDeviceTagIDs adds the "device_tags" edge to the DeviceTag entity by IDs.
+func (pc *ProductCreate) AddDeviceTagIDs(ids ...int) *ProductCreate {
+	pc.mutation.AddDeviceTagIDs(ids...)
+	return pc
+}
+
+// AddDeviceTags adds the "device_tags" edges to the DeviceTag entity.
+func (pc *ProductCreate) AddDeviceTags(d ...*DeviceTag) *ProductCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pc.AddDeviceTagIDs(ids...)
+}
+
+// AddDeviceGroupIDs adds the "device_groups" edge to the DeviceGroup entity by IDs.
+func (pc *ProductCreate) AddDeviceGroupIDs(ids ...int) *ProductCreate {
+	pc.mutation.AddDeviceGroupIDs(ids...)
+	return pc
+}
+
+// AddDeviceGroups adds the "device_groups" edges to the DeviceGroup entity.
+func (pc *ProductCreate) AddDeviceGroups(d ...*DeviceGroup) *ProductCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pc.AddDeviceGroupIDs(ids...)
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (pc *ProductCreate) AddOrderIDs(ids ...int) *ProductCreate {
+	pc.mutation.AddOrderIDs(ids...)
+	return pc
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (pc *ProductCreate) AddOrders(o ...*Order) *ProductCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pc.AddOrderIDs(ids...)
+}
+
+// AddLotIDs adds the "lots" edge to the Lot entity by IDs.
+func (pc *ProductCreate) AddLotIDs(ids ...int) *ProductCreate {
+	pc.mutation.AddLotIDs(ids...)
+	return pc
+}
+
+// AddLots adds the "lots" edges to the Lot entity.
+func (pc *ProductCreate) AddLots(l ...*Lot) *ProductCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return pc.AddLotIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the ProductInvitation entity by IDs.
+func (pc *ProductCreate) AddInvitationIDs(ids ...int) *ProductCreate {
+	pc.mutation.AddInvitationIDs(ids...)
+	return pc
+}
+
+// AddInvitations adds the "invitations" edges to the ProductInvitation entity.
+func (pc *ProductCreate) AddInvitations(p ...*ProductInvitation) *ProductCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddInvitationIDs(ids...)
+}
+
+// AddRegistrationInvitationIDs adds the "registration_invitations" edge to the RegistrationInvitation entity by IDs.
+func (pc *ProductCreate) AddRegistrationInvitationIDs(ids ...int) *ProductCreate {
+	pc.mutation.AddRegistrationInvitationIDs(ids...)
+	return pc
+}
+
+// AddRegistrationInvitations adds the "registration_invitations" edges to the RegistrationInvitation entity.
+func (pc *ProductCreate) AddRegistrationInvitations(r ...*RegistrationInvitation) *ProductCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pc.AddRegistrationInvitationIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (pc *ProductCreate) Mutation() *ProductMutation {
 	return pc.mutation
@@ -199,7 +387,9 @@ func (pc *ProductCreate) Mutation() *ProductMutation {
 
 // Save creates the Product in the database.
 func (pc *ProductCreate) Save(ctx context.Context) (*Product, error) {
-	pc.defaults()
+	if err := pc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
 }
 
@@ -226,23 +416,41 @@ func (pc *ProductCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (pc *ProductCreate) defaults() {
+func (pc *ProductCreate) defaults() error {
+	if _, ok := pc.mutation.DeletedID(); !ok {
+		v := product.DefaultDeletedID
+		pc.mutation.SetDeletedID(v)
+	}
 	if _, ok := pc.mutation.ProductType(); !ok {
 		v := product.DefaultProductType
 		pc.mutation.SetProductType(v)
 	}
+	if _, ok := pc.mutation.WarrantyMonths(); !ok {
+		v := product.DefaultWarrantyMonths
+		pc.mutation.SetWarrantyMonths(v)
+	}
 	if _, ok := pc.mutation.CreatedAt(); !ok {
+		if product.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized product.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := product.DefaultCreatedAt()
 		pc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		if product.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized product.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := product.DefaultUpdatedAt()
 		pc.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (pc *ProductCreate) check() error {
+	if _, ok := pc.mutation.DeletedID(); !ok {
+		return &ValidationError{Name: "deleted_id", err: errors.New(`ent: missing required field "Product.deleted_id"`)}
+	}
 	if _, ok := pc.mutation.Code(); !ok {
 		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Product.code"`)}
 	}
@@ -259,6 +467,14 @@ func (pc *ProductCreate) check() error {
 			return &ValidationError{Name: "product_name", err: fmt.Errorf(`ent: validator failed for field "Product.product_name": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.WarrantyMonths(); !ok {
+		return &ValidationError{Name: "warranty_months", err: errors.New(`ent: missing required field "Product.warranty_months"`)}
+	}
+	if v, ok := pc.mutation.WarrantyMonths(); ok {
+		if err := product.WarrantyMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "warranty_months", err: fmt.Errorf(`ent: validator failed for field "Product.warranty_months": %w`, err)}
+		}
+	}
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Product.created_at"`)}
 	}
@@ -302,6 +518,14 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := pc.mutation.DeletedAt(); ok {
+		_spec.SetField(product.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := pc.mutation.DeletedID(); ok {
+		_spec.SetField(product.FieldDeletedID, field.TypeInt, value)
+		_node.DeletedID = value
+	}
 	if value, ok := pc.mutation.Code(); ok {
 		_spec.SetField(product.FieldCode, field.TypeString, value)
 		_node.Code = value
@@ -314,6 +538,14 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_spec.SetField(product.FieldProductName, field.TypeString, value)
 		_node.ProductName = value
 	}
+	if value, ok := pc.mutation.WarrantyMonths(); ok {
+		_spec.SetField(product.FieldWarrantyMonths, field.TypeInt, value)
+		_node.WarrantyMonths = value
+	}
+	if value, ok := pc.mutation.DeviceAttributeSchema(); ok {
+		_spec.SetField(product.FieldDeviceAttributeSchema, field.TypeString, value)
+		_node.DeviceAttributeSchema = value
+	}
 	if value, ok := pc.mutation.CreatedAt(); ok {
 		_spec.SetField(product.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -434,6 +666,134 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.SnRuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   product.SnRuleTable,
+			Columns: []string{product.SnRuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.SnAllocatorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.SnAllocatorsTable,
+			Columns: []string{product.SnAllocatorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snallocator.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.DeviceTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.DeviceTagsTable,
+			Columns: []string{product.DeviceTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.DeviceGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.DeviceGroupsTable,
+			Columns: []string{product.DeviceGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OrdersTable,
+			Columns: []string{product.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.LotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.LotsTable,
+			Columns: []string{product.LotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.InvitationsTable,
+			Columns: []string{product.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.RegistrationInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.RegistrationInvitationsTable,
+			Columns: []string{product.RegistrationInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -10,13 +10,22 @@ import (
 
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/auditlog"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicegroup"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicetag"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/firmwareversion"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/lot"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productinvitation"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/registrationinvitation"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snallocator"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snrule"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/softwareversion"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -25,17 +34,26 @@ import (
 // ProductQuery is the builder for querying Product entities.
 type ProductQuery struct {
 	config
-	ctx                  *QueryContext
-	order                []product.OrderOption
-	inters               []Interceptor
-	predicates           []predicate.Product
-	withManagers         *ProductManagerQuery
-	withLicenseTypes     *LicenseTypeQuery
-	withFeatures         *ProductFeatureQuery
-	withFirmwareVersions *FirmwareVersionQuery
-	withSoftwareVersions *SoftwareVersionQuery
-	withDevices          *DeviceQuery
-	withAuditLogs        *AuditLogQuery
+	ctx                         *QueryContext
+	order                       []product.OrderOption
+	inters                      []Interceptor
+	predicates                  []predicate.Product
+	withManagers                *ProductManagerQuery
+	withLicenseTypes            *LicenseTypeQuery
+	withFeatures                *ProductFeatureQuery
+	withFirmwareVersions        *FirmwareVersionQuery
+	withSoftwareVersions        *SoftwareVersionQuery
+	withDevices                 *DeviceQuery
+	withAuditLogs               *AuditLogQuery
+	withSnRule                  *SnRuleQuery
+	withSnAllocators            *SnAllocatorQuery
+	withDeviceTags              *DeviceTagQuery
+	withDeviceGroups            *DeviceGroupQuery
+	withOrders                  *OrderQuery
+	withLots                    *LotQuery
+	withInvitations             *ProductInvitationQuery
+	withRegistrationInvitations *RegistrationInvitationQuery
+	modifiers                   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -226,6 +244,182 @@ func (pq *ProductQuery) QueryAuditLogs() *AuditLogQuery {
 	return query
 }
 
+// QuerySnRule chains the current query on the "sn_rule" edge.
+func (pq *ProductQuery) QuerySnRule() *SnRuleQuery {
+	query := (&SnRuleClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(snrule.Table, snrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, product.SnRuleTable, product.SnRuleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySnAllocators chains the current query on the "sn_allocators" edge.
+func (pq *ProductQuery) QuerySnAllocators() *SnAllocatorQuery {
+	query := (&SnAllocatorClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(snallocator.Table, snallocator.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.SnAllocatorsTable, product.SnAllocatorsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDeviceTags chains the current query on the "device_tags" edge.
+func (pq *ProductQuery) QueryDeviceTags() *DeviceTagQuery {
+	query := (&DeviceTagClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(devicetag.Table, devicetag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.DeviceTagsTable, product.DeviceTagsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDeviceGroups chains the current query on the "device_groups" edge.
+func (pq *ProductQuery) QueryDeviceGroups() *DeviceGroupQuery {
+	query := (&DeviceGroupClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(devicegroup.Table, devicegroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.DeviceGroupsTable, product.DeviceGroupsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOrders chains the current query on the "orders" edge.
+func (pq *ProductQuery) QueryOrders() *OrderQuery {
+	query := (&OrderClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.OrdersTable, product.OrdersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLots chains the current query on the "lots" edge.
+func (pq *ProductQuery) QueryLots() *LotQuery {
+	query := (&LotClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(lot.Table, lot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.LotsTable, product.LotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvitations chains the current query on the "invitations" edge.
+func (pq *ProductQuery) QueryInvitations() *ProductInvitationQuery {
+	query := (&ProductInvitationClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(productinvitation.Table, productinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.InvitationsTable, product.InvitationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRegistrationInvitations chains the current query on the "registration_invitations" edge.
+func (pq *ProductQuery) QueryRegistrationInvitations() *RegistrationInvitationQuery {
+	query := (&RegistrationInvitationClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(registrationinvitation.Table, registrationinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.RegistrationInvitationsTable, product.RegistrationInvitationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Product entity from the query.
 // Returns a *NotFoundError when no Product was found.
 func (pq *ProductQuery) First(ctx context.Context) (*Product, error) {
@@ -413,18 +607,26 @@ func (pq *ProductQuery) Clone() *ProductQuery {
 		return nil
 	}
 	return &ProductQuery{
-		config:               pq.config,
-		ctx:                  pq.ctx.Clone(),
-		order:                append([]product.OrderOption{}, pq.order...),
-		inters:               append([]Interceptor{}, pq.inters...),
-		predicates:           append([]predicate.Product{}, pq.predicates...),
-		withManagers:         pq.withManagers.Clone(),
-		withLicenseTypes:     pq.withLicenseTypes.Clone(),
-		withFeatures:         pq.withFeatures.Clone(),
-		withFirmwareVersions: pq.withFirmwareVersions.Clone(),
-		withSoftwareVersions: pq.withSoftwareVersions.Clone(),
-		withDevices:          pq.withDevices.Clone(),
-		withAuditLogs:        pq.withAuditLogs.Clone(),
+		config:                      pq.config,
+		ctx:                         pq.ctx.Clone(),
+		order:                       append([]product.OrderOption{}, pq.order...),
+		inters:                      append([]Interceptor{}, pq.inters...),
+		predicates:                  append([]predicate.Product{}, pq.predicates...),
+		withManagers:                pq.withManagers.Clone(),
+		withLicenseTypes:            pq.withLicenseTypes.Clone(),
+		withFeatures:                pq.withFeatures.Clone(),
+		withFirmwareVersions:        pq.withFirmwareVersions.Clone(),
+		withSoftwareVersions:        pq.withSoftwareVersions.Clone(),
+		withDevices:                 pq.withDevices.Clone(),
+		withAuditLogs:               pq.withAuditLogs.Clone(),
+		withSnRule:                  pq.withSnRule.Clone(),
+		withSnAllocators:            pq.withSnAllocators.Clone(),
+		withDeviceTags:              pq.withDeviceTags.Clone(),
+		withDeviceGroups:            pq.withDeviceGroups.Clone(),
+		withOrders:                  pq.withOrders.Clone(),
+		withLots:                    pq.withLots.Clone(),
+		withInvitations:             pq.withInvitations.Clone(),
+		withRegistrationInvitations: pq.withRegistrationInvitations.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
@@ -508,18 +710,106 @@ func (pq *ProductQuery) WithAuditLogs(opts ...func(*AuditLogQuery)) *ProductQuer
 	return pq
 }
 
+// WithSnRule tells the query-builder to eager-load the nodes that are connected to
+// the "sn_rule" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProductQuery) WithSnRule(opts ...func(*SnRuleQuery)) *ProductQuery {
+	query := (&SnRuleClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withSnRule = query
+	return pq
+}
+
+// WithSnAllocators tells the query-builder to eager-load the nodes that are connected to
+// the "sn_allocators" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProductQuery) WithSnAllocators(opts ...func(*SnAllocatorQuery)) *ProductQuery {
+	query := (&SnAllocatorClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withSnAllocators = query
+	return pq
+}
+
+// WithDeviceTags tells the query-builder to eager-load the nodes that are connected to
+// the "device_tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProductQuery) WithDeviceTags(opts ...func(*DeviceTagQuery)) *ProductQuery {
+	query := (&DeviceTagClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withDeviceTags = query
+	return pq
+}
+
+// WithDeviceGroups tells the query-builder to eager-load the nodes that are connected to
+// the "device_groups" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProductQuery) WithDeviceGroups(opts ...func(*DeviceGroupQuery)) *ProductQuery {
+	query := (&DeviceGroupClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withDeviceGroups = query
+	return pq
+}
+
+// WithOrders tells the query-builder to eager-load the nodes that are connected to
+// the "orders" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProductQuery) WithOrders(opts ...func(*OrderQuery)) *ProductQuery {
+	query := (&OrderClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withOrders = query
+	return pq
+}
+
+// WithLots tells the query-builder to eager-load the nodes that are connected to
+// the "lots" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProductQuery) WithLots(opts ...func(*LotQuery)) *ProductQuery {
+	query := (&LotClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withLots = query
+	return pq
+}
+
+// WithInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "invitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProductQuery) WithInvitations(opts ...func(*ProductInvitationQuery)) *ProductQuery {
+	query := (&ProductInvitationClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withInvitations = query
+	return pq
+}
+
+// WithRegistrationInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "registration_invitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProductQuery) WithRegistrationInvitations(opts ...func(*RegistrationInvitationQuery)) *ProductQuery {
+	query := (&RegistrationInvitationClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withRegistrationInvitations = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Code string `json:"code,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Product.Query().
-//		GroupBy(product.FieldCode).
+//		GroupBy(product.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (pq *ProductQuery) GroupBy(field string, fields ...string) *ProductGroupBy {
@@ -537,11 +827,11 @@ func (pq *ProductQuery) GroupBy(field string, fields ...string) *ProductGroupBy
 // Example:
 //
 //	var v []struct {
-//		Code string `json:"code,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Product.Query().
-//		Select(product.FieldCode).
+//		Select(product.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (pq *ProductQuery) Select(fields ...string) *ProductSelect {
 	pq.ctx.Fields = append(pq.ctx.Fields, fields...)
@@ -586,7 +876,7 @@ func (pq *ProductQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prod
 	var (
 		nodes       = []*Product{}
 		_spec       = pq.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [15]bool{
 			pq.withManagers != nil,
 			pq.withLicenseTypes != nil,
 			pq.withFeatures != nil,
@@ -594,6 +884,14 @@ func (pq *ProductQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prod
 			pq.withSoftwareVersions != nil,
 			pq.withDevices != nil,
 			pq.withAuditLogs != nil,
+			pq.withSnRule != nil,
+			pq.withSnAllocators != nil,
+			pq.withDeviceTags != nil,
+			pq.withDeviceGroups != nil,
+			pq.withOrders != nil,
+			pq.withLots != nil,
+			pq.withInvitations != nil,
+			pq.withRegistrationInvitations != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -605,6 +903,9 @@ func (pq *ProductQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prod
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -663,6 +964,63 @@ func (pq *ProductQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prod
 			return nil, err
 		}
 	}
+	if query := pq.withSnRule; query != nil {
+		if err := pq.loadSnRule(ctx, query, nodes, nil,
+			func(n *Product, e *SnRule) { n.Edges.SnRule = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withSnAllocators; query != nil {
+		if err := pq.loadSnAllocators(ctx, query, nodes,
+			func(n *Product) { n.Edges.SnAllocators = []*SnAllocator{} },
+			func(n *Product, e *SnAllocator) { n.Edges.SnAllocators = append(n.Edges.SnAllocators, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withDeviceTags; query != nil {
+		if err := pq.loadDeviceTags(ctx, query, nodes,
+			func(n *Product) { n.Edges.DeviceTags = []*DeviceTag{} },
+			func(n *Product, e *DeviceTag) { n.Edges.DeviceTags = append(n.Edges.DeviceTags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withDeviceGroups; query != nil {
+		if err := pq.loadDeviceGroups(ctx, query, nodes,
+			func(n *Product) { n.Edges.DeviceGroups = []*DeviceGroup{} },
+			func(n *Product, e *DeviceGroup) { n.Edges.DeviceGroups = append(n.Edges.DeviceGroups, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withOrders; query != nil {
+		if err := pq.loadOrders(ctx, query, nodes,
+			func(n *Product) { n.Edges.Orders = []*Order{} },
+			func(n *Product, e *Order) { n.Edges.Orders = append(n.Edges.Orders, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withLots; query != nil {
+		if err := pq.loadLots(ctx, query, nodes,
+			func(n *Product) { n.Edges.Lots = []*Lot{} },
+			func(n *Product, e *Lot) { n.Edges.Lots = append(n.Edges.Lots, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withInvitations; query != nil {
+		if err := pq.loadInvitations(ctx, query, nodes,
+			func(n *Product) { n.Edges.Invitations = []*ProductInvitation{} },
+			func(n *Product, e *ProductInvitation) { n.Edges.Invitations = append(n.Edges.Invitations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withRegistrationInvitations; query != nil {
+		if err := pq.loadRegistrationInvitations(ctx, query, nodes,
+			func(n *Product) { n.Edges.RegistrationInvitations = []*RegistrationInvitation{} },
+			func(n *Product, e *RegistrationInvitation) {
+				n.Edges.RegistrationInvitations = append(n.Edges.RegistrationInvitations, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -876,9 +1234,252 @@ func (pq *ProductQuery) loadAuditLogs(ctx context.Context, query *AuditLogQuery,
 	}
 	return nil
 }
+func (pq *ProductQuery) loadSnRule(ctx context.Context, query *SnRuleQuery, nodes []*Product, init func(*Product), assign func(*Product, *SnRule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Product)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(snrule.FieldProductID)
+	}
+	query.Where(predicate.SnRule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.SnRuleColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProductID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "product_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProductQuery) loadSnAllocators(ctx context.Context, query *SnAllocatorQuery, nodes []*Product, init func(*Product), assign func(*Product, *SnAllocator)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Product)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(snallocator.FieldProductID)
+	}
+	query.Where(predicate.SnAllocator(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.SnAllocatorsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProductID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "product_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProductQuery) loadDeviceTags(ctx context.Context, query *DeviceTagQuery, nodes []*Product, init func(*Product), assign func(*Product, *DeviceTag)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Product)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(devicetag.FieldProductID)
+	}
+	query.Where(predicate.DeviceTag(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.DeviceTagsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProductID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "product_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProductQuery) loadDeviceGroups(ctx context.Context, query *DeviceGroupQuery, nodes []*Product, init func(*Product), assign func(*Product, *DeviceGroup)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Product)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(devicegroup.FieldProductID)
+	}
+	query.Where(predicate.DeviceGroup(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.DeviceGroupsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProductID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "product_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProductQuery) loadOrders(ctx context.Context, query *OrderQuery, nodes []*Product, init func(*Product), assign func(*Product, *Order)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Product)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(order.FieldProductID)
+	}
+	query.Where(predicate.Order(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.OrdersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProductID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "product_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProductQuery) loadLots(ctx context.Context, query *LotQuery, nodes []*Product, init func(*Product), assign func(*Product, *Lot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Product)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(lot.FieldProductID)
+	}
+	query.Where(predicate.Lot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.LotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProductID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "product_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProductQuery) loadInvitations(ctx context.Context, query *ProductInvitationQuery, nodes []*Product, init func(*Product), assign func(*Product, *ProductInvitation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Product)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(productinvitation.FieldProductID)
+	}
+	query.Where(predicate.ProductInvitation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.InvitationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProductID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "product_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProductQuery) loadRegistrationInvitations(ctx context.Context, query *RegistrationInvitationQuery, nodes []*Product, init func(*Product), assign func(*Product, *RegistrationInvitation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Product)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(registrationinvitation.FieldProductID)
+	}
+	query.Where(predicate.RegistrationInvitation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.RegistrationInvitationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProductID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "product_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "product_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (pq *ProductQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
 	_spec.Node.Columns = pq.ctx.Fields
 	if len(pq.ctx.Fields) > 0 {
 		_spec.Unique = pq.ctx.Unique != nil && *pq.ctx.Unique
@@ -941,6 +1542,9 @@ func (pq *ProductQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if pq.ctx.Unique != nil && *pq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range pq.modifiers {
+		m(selector)
+	}
 	for _, p := range pq.predicates {
 		p(selector)
 	}
@@ -958,6 +1562,32 @@ func (pq *ProductQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pq *ProductQuery) ForUpdate(opts ...sql.LockOption) *ProductQuery {
+	if pq.driver.Dialect() == dialect.Postgres {
+		pq.Unique(false)
+	}
+	pq.modifiers = append(pq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pq *ProductQuery) ForShare(opts ...sql.LockOption) *ProductQuery {
+	if pq.driver.Dialect() == dialect.Postgres {
+		pq.Unique(false)
+	}
+	pq.modifiers = append(pq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pq
+}
+
 // ProductGroupBy is the group-by builder for Product entities.
 type ProductGroupBy struct {
 	selector
@@ -10,12 +10,20 @@ import (
 
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/auditlog"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicegroup"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicetag"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/firmwareversion"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/lot"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productinvitation"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/registrationinvitation"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snallocator"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snrule"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/softwareversion"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -35,6 +43,47 @@ func (pu *ProductUpdate) Where(ps ...predicate.Product) *ProductUpdate {
 	return pu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (pu *ProductUpdate) SetDeletedAt(t time.Time) *ProductUpdate {
+	pu.mutation.SetDeletedAt(t)
+	return pu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableDeletedAt(t *time.Time) *ProductUpdate {
+	if t != nil {
+		pu.SetDeletedAt(*t)
+	}
+	return pu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pu *ProductUpdate) ClearDeletedAt() *ProductUpdate {
+	pu.mutation.ClearDeletedAt()
+	return pu
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (pu *ProductUpdate) SetDeletedID(i int) *ProductUpdate {
+	pu.mutation.ResetDeletedID()
+	pu.mutation.SetDeletedID(i)
+	return pu
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableDeletedID(i *int) *ProductUpdate {
+	if i != nil {
+		pu.SetDeletedID(*i)
+	}
+	return pu
+}
+
+// AddDeletedID adds i to the "deleted_id" field.
+func (pu *ProductUpdate) AddDeletedID(i int) *ProductUpdate {
+	pu.mutation.AddDeletedID(i)
+	return pu
+}
+
 // SetCode sets the "code" field.
 func (pu *ProductUpdate) SetCode(s string) *ProductUpdate {
 	pu.mutation.SetCode(s)
@@ -67,6 +116,47 @@ func (pu *ProductUpdate) SetProductName(s string) *ProductUpdate {
 	return pu
 }
 
+// SetWarrantyMonths sets the "warranty_months" field.
+func (pu *ProductUpdate) SetWarrantyMonths(i int) *ProductUpdate {
+	pu.mutation.ResetWarrantyMonths()
+	pu.mutation.SetWarrantyMonths(i)
+	return pu
+}
+
+// SetNillableWarrantyMonths sets the "warranty_months" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableWarrantyMonths(i *int) *ProductUpdate {
+	if i != nil {
+		pu.SetWarrantyMonths(*i)
+	}
+	return pu
+}
+
+// AddWarrantyMonths adds i to the "warranty_months" field.
+func (pu *ProductUpdate) AddWarrantyMonths(i int) *ProductUpdate {
+	pu.mutation.AddWarrantyMonths(i)
+	return pu
+}
+
+// SetDeviceAttributeSchema sets the "device_attribute_schema" field.
+func (pu *ProductUpdate) SetDeviceAttributeSchema(s string) *ProductUpdate {
+	pu.mutation.SetDeviceAttributeSchema(s)
+	return pu
+}
+
+// SetNillableDeviceAttributeSchema sets the "device_attribute_schema" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableDeviceAttributeSchema(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetDeviceAttributeSchema(*s)
+	}
+	return pu
+}
+
+// ClearDeviceAttributeSchema clears the value of the "device_attribute_schema" field.
+func (pu *ProductUpdate) ClearDeviceAttributeSchema() *ProductUpdate {
+	pu.mutation.ClearDeviceAttributeSchema()
+	return pu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (pu *ProductUpdate) SetUpdatedAt(t time.Time) *ProductUpdate {
 	pu.mutation.SetUpdatedAt(t)
@@ -178,6 +268,130 @@ func (pu *ProductUpdate) AddAuditLogs(a ...*AuditLog) *ProductUpdate {
 	return pu.AddAuditLogIDs(ids...)
 }
 
+// SetSnRuleID sets the "sn_rule" edge to the SnRule entity by ID.
+func (pu *ProductUpdate) SetSnRuleID(id int) *ProductUpdate {
+	pu.mutation.SetSnRuleID(id)
+	return pu
+}
+
+// SetNillableSnRuleID sets the "sn_rule" edge to the SnRule entity by ID if the given value is not nil.
+func (pu *ProductUpdate) SetNillableSnRuleID(id *int) *ProductUpdate {
+	if id != nil {
+		pu = pu.SetSnRuleID(*id)
+	}
+	return pu
+}
+
+// SetSnRule sets the "sn_rule" edge to the SnRule entity.
+func (pu *ProductUpdate) SetSnRule(s *SnRule) *ProductUpdate {
+	return pu.SetSnRuleID(s.ID)
+}
+
+// AddSnAllocatorIDs adds the "sn_allocators" edge to the SnAllocator entity by IDs.
+func (pu *ProductUpdate) AddSnAllocatorIDs(ids ...int) *ProductUpdate {
+	pu.mutation.AddSnAllocatorIDs(ids...)
+	return pu
+}
+
+// AddSnAllocators adds the "sn_allocators" edges to the SnAllocator entity.
+func (pu *ProductUpdate) AddSnAllocators(s ...*SnAllocator) *ProductUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.AddSnAllocatorIDs(ids...)
+}
+
+// AddDeviceTagIDs adds the "device_tags" edge to the DeviceTag entity by IDs.
+func (pu *ProductUpdate) AddDeviceTagIDs(ids ...int) *ProductUpdate {
+	pu.mutation.AddDeviceTagIDs(ids...)
+	return pu
+}
+
+// AddDeviceTags adds the "device_tags" edges to the DeviceTag entity.
+func (pu *ProductUpdate) AddDeviceTags(d ...*DeviceTag) *ProductUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pu.AddDeviceTagIDs(ids...)
+}
+
+// AddDeviceGroupIDs adds the "device_groups" edge to the DeviceGroup entity by IDs.
+func (pu *ProductUpdate) AddDeviceGroupIDs(ids ...int) *ProductUpdate {
+	pu.mutation.AddDeviceGroupIDs(ids...)
+	return pu
+}
+
+// AddDeviceGroups adds the "device_groups" edges to the DeviceGroup entity.
+func (pu *ProductUpdate) AddDeviceGroups(d ...*DeviceGroup) *ProductUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pu.AddDeviceGroupIDs(ids...)
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (pu *ProductUpdate) AddOrderIDs(ids ...int) *ProductUpdate {
+	pu.mutation.AddOrderIDs(ids...)
+	return pu
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (pu *ProductUpdate) AddOrders(o ...*Order) *ProductUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pu.AddOrderIDs(ids...)
+}
+
+// AddLotIDs adds the "lots" edge to the Lot entity by IDs.
+func (pu *ProductUpdate) AddLotIDs(ids ...int) *ProductUpdate {
+	pu.mutation.AddLotIDs(ids...)
+	return pu
+}
+
+// AddLots adds the "lots" edges to the Lot entity.
+func (pu *ProductUpdate) AddLots(l ...*Lot) *ProductUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return pu.AddLotIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the ProductInvitation entity by IDs.
+func (pu *ProductUpdate) AddInvitationIDs(ids ...int) *ProductUpdate {
+	pu.mutation.AddInvitationIDs(ids...)
+	return pu
+}
+
+// AddInvitations adds the "invitations" edges to the ProductInvitation entity.
+func (pu *ProductUpdate) AddInvitations(p ...*ProductInvitation) *ProductUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddInvitationIDs(ids...)
+}
+
+// AddRegistrationInvitationIDs adds the "registration_invitations" edge to the RegistrationInvitation entity by IDs.
+func (pu *ProductUpdate) AddRegistrationInvitationIDs(ids ...int) *ProductUpdate {
+	pu.mutation.AddRegistrationInvitationIDs(ids...)
+	return pu
+}
+
+// AddRegistrationInvitations adds the "registration_invitations" edges to the RegistrationInvitation entity.
+func (pu *ProductUpdate) AddRegistrationInvitations(r ...*RegistrationInvitation) *ProductUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.AddRegistrationInvitationIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (pu *ProductUpdate) Mutation() *ProductMutation {
 	return pu.mutation
@@ -330,9 +544,164 @@ func (pu *ProductUpdate) RemoveAuditLogs(a ...*AuditLog) *ProductUpdate {
 	return pu.RemoveAuditLogIDs(ids...)
 }
 
+// ClearSnRule clears the "sn_rule" edge to the SnRule entity.
+func (pu *ProductUpdate) ClearSnRule() *ProductUpdate {
+	pu.mutation.ClearSnRule()
+	return pu
+}
+
+// ClearSnAllocators clears all "sn_allocators" edges to the SnAllocator entity.
+func (pu *ProductUpdate) ClearSnAllocators() *ProductUpdate {
+	pu.mutation.ClearSnAllocators()
+	return pu
+}
+
+// RemoveSnAllocatorIDs removes the "sn_allocators" edge to SnAllocator entities by IDs.
+func (pu *ProductUpdate) RemoveSnAllocatorIDs(ids ...int) *ProductUpdate {
+	pu.mutation.RemoveSnAllocatorIDs(ids...)
+	return pu
+}
+
+// RemoveSnAllocators removes "sn_allocators" edges to SnAllocator entities.
+func (pu *ProductUpdate) RemoveSnAllocators(s ...*SnAllocator) *ProductUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return pu.RemoveSnAllocatorIDs(ids...)
+}
+
+// ClearDeviceTags clears all "device_tags" edges to the DeviceTag entity.
+func (pu *ProductUpdate) ClearDeviceTags() *ProductUpdate {
+	pu.mutation.ClearDeviceTags()
+	return pu
+}
+
+// RemoveDeviceTagIDs removes the "device_tags" edge to DeviceTag entities by IDs.
+func (pu *ProductUpdate) RemoveDeviceTagIDs(ids ...int) *ProductUpdate {
+	pu.mutation.RemoveDeviceTagIDs(ids...)
+	return pu
+}
+
+// RemoveDeviceTags removes "device_tags" edges to DeviceTag entities.
+func (pu *ProductUpdate) RemoveDeviceTags(d ...*DeviceTag) *ProductUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pu.RemoveDeviceTagIDs(ids...)
+}
+
+// ClearDeviceGroups clears all "device_groups" edges to the DeviceGroup entity.
+func (pu *ProductUpdate) ClearDeviceGroups() *ProductUpdate {
+	pu.mutation.ClearDeviceGroups()
+	return pu
+}
+
+// RemoveDeviceGroupIDs removes the "device_groups" edge to DeviceGroup entities by IDs.
+func (pu *ProductUpdate) RemoveDeviceGroupIDs(ids ...int) *ProductUpdate {
+	pu.mutation.RemoveDeviceGroupIDs(ids...)
+	return pu
+}
+
+// RemoveDeviceGroups removes "device_groups" edges to DeviceGroup entities.
+func (pu *ProductUpdate) RemoveDeviceGroups(d ...*DeviceGroup) *ProductUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pu.RemoveDeviceGroupIDs(ids...)
+}
+
+// ClearOrders clears all "orders" edges to the Order entity.
+func (pu *ProductUpdate) ClearOrders() *ProductUpdate {
+	pu.mutation.ClearOrders()
+	return pu
+}
+
+// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
+func (pu *ProductUpdate) RemoveOrderIDs(ids ...int) *ProductUpdate {
+	pu.mutation.RemoveOrderIDs(ids...)
+	return pu
+}
+
+// RemoveOrders removes "orders" edges to Order entities.
+func (pu *ProductUpdate) RemoveOrders(o ...*Order) *ProductUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pu.RemoveOrderIDs(ids...)
+}
+
+// ClearLots clears all "lots" edges to the Lot entity.
+func (pu *ProductUpdate) ClearLots() *ProductUpdate {
+	pu.mutation.ClearLots()
+	return pu
+}
+
+// RemoveLotIDs removes the "lots" edge to Lot entities by IDs.
+func (pu *ProductUpdate) RemoveLotIDs(ids ...int) *ProductUpdate {
+	pu.mutation.RemoveLotIDs(ids...)
+	return pu
+}
+
+// RemoveLots removes "lots" edges to Lot entities.
+func (pu *ProductUpdate) RemoveLots(l ...*Lot) *ProductUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return pu.RemoveLotIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the ProductInvitation entity.
+func (pu *ProductUpdate) ClearInvitations() *ProductUpdate {
+	pu.mutation.ClearInvitations()
+	return pu
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to ProductInvitation entities by IDs.
+func (pu *ProductUpdate) RemoveInvitationIDs(ids ...int) *ProductUpdate {
+	pu.mutation.RemoveInvitationIDs(ids...)
+	return pu
+}
+
+// RemoveInvitations removes "invitations" edges to ProductInvitation entities.
+func (pu *ProductUpdate) RemoveInvitations(p ...*ProductInvitation) *ProductUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveInvitationIDs(ids...)
+}
+
+// ClearRegistrationInvitations clears all "registration_invitations" edges to the RegistrationInvitation entity.
+func (pu *ProductUpdate) ClearRegistrationInvitations() *ProductUpdate {
+	pu.mutation.ClearRegistrationInvitations()
+	return pu
+}
+
+// RemoveRegistrationInvitationIDs removes the "registration_invitations" edge to RegistrationInvitation entities by IDs.
+func (pu *ProductUpdate) RemoveRegistrationInvitationIDs(ids ...int) *ProductUpdate {
+	pu.mutation.RemoveRegistrationInvitationIDs(ids...)
+	return pu
+}
+
+// RemoveRegistrationInvitations removes "registration_invitations" edges to RegistrationInvitation entities.
+func (pu *ProductUpdate) RemoveRegistrationInvitations(r ...*RegistrationInvitation) *ProductUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.RemoveRegistrationInvitationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *ProductUpdate) Save(ctx context.Context) (int, error) {
-	pu.defaults()
+	if err := pu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
 }
 
@@ -359,11 +728,15 @@ func (pu *ProductUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (pu *ProductUpdate) defaults() {
+func (pu *ProductUpdate) defaults() error {
 	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		if product.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized product.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := product.UpdateDefaultUpdatedAt()
 		pu.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -378,6 +751,11 @@ func (pu *ProductUpdate) check() error {
 			return &ValidationError{Name: "product_name", err: fmt.Errorf(`ent: validator failed for field "Product.product_name": %w`, err)}
 		}
 	}
+	if v, ok := pu.mutation.WarrantyMonths(); ok {
+		if err := product.WarrantyMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "warranty_months", err: fmt.Errorf(`ent: validator failed for field "Product.warranty_months": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -393,6 +771,18 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := pu.mutation.DeletedAt(); ok {
+		_spec.SetField(product.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pu.mutation.DeletedAtCleared() {
+		_spec.ClearField(product.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.DeletedID(); ok {
+		_spec.SetField(product.FieldDeletedID, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedDeletedID(); ok {
+		_spec.AddField(product.FieldDeletedID, field.TypeInt, value)
+	}
 	if value, ok := pu.mutation.Code(); ok {
 		_spec.SetField(product.FieldCode, field.TypeString, value)
 	}
@@ -405,6 +795,18 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.ProductName(); ok {
 		_spec.SetField(product.FieldProductName, field.TypeString, value)
 	}
+	if value, ok := pu.mutation.WarrantyMonths(); ok {
+		_spec.SetField(product.FieldWarrantyMonths, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedWarrantyMonths(); ok {
+		_spec.AddField(product.FieldWarrantyMonths, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.DeviceAttributeSchema(); ok {
+		_spec.SetField(product.FieldDeviceAttributeSchema, field.TypeString, value)
+	}
+	if pu.mutation.DeviceAttributeSchemaCleared() {
+		_spec.ClearField(product.FieldDeviceAttributeSchema, field.TypeString)
+	}
 	if value, ok := pu.mutation.UpdatedAt(); ok {
 		_spec.SetField(product.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -723,47 +1125,432 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{product.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if pu.mutation.SnRuleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   product.SnRuleTable,
+			Columns: []string{product.SnRuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snrule.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	pu.mutation.done = true
-	return n, nil
-}
-
-// ProductUpdateOne is the builder for updating a single Product entity.
-type ProductUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *ProductMutation
-}
-
-// SetCode sets the "code" field.
-func (puo *ProductUpdateOne) SetCode(s string) *ProductUpdateOne {
-	puo.mutation.SetCode(s)
-	return puo
-}
-
-// SetProductType sets the "product_type" field.
-func (puo *ProductUpdateOne) SetProductType(s string) *ProductUpdateOne {
-	puo.mutation.SetProductType(s)
-	return puo
-}
-
-// SetNillableProductType sets the "product_type" field if the given value is not nil.
-func (puo *ProductUpdateOne) SetNillableProductType(s *string) *ProductUpdateOne {
-	if s != nil {
-		puo.SetProductType(*s)
+	if nodes := pu.mutation.SnRuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   product.SnRuleTable,
+			Columns: []string{product.SnRuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return puo
-}
-
-// ClearProductType clears the value of the "product_type" field.
+	if pu.mutation.SnAllocatorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.SnAllocatorsTable,
+			Columns: []string{product.SnAllocatorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snallocator.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedSnAllocatorsIDs(); len(nodes) > 0 && !pu.mutation.SnAllocatorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.SnAllocatorsTable,
+			Columns: []string{product.SnAllocatorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snallocator.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.SnAllocatorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.SnAllocatorsTable,
+			Columns: []string{product.SnAllocatorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snallocator.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.DeviceTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.DeviceTagsTable,
+			Columns: []string{product.DeviceTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedDeviceTagsIDs(); len(nodes) > 0 && !pu.mutation.DeviceTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.DeviceTagsTable,
+			Columns: []string{product.DeviceTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.DeviceTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.DeviceTagsTable,
+			Columns: []string{product.DeviceTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.DeviceGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.DeviceGroupsTable,
+			Columns: []string{product.DeviceGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedDeviceGroupsIDs(); len(nodes) > 0 && !pu.mutation.DeviceGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.DeviceGroupsTable,
+			Columns: []string{product.DeviceGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.DeviceGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.DeviceGroupsTable,
+			Columns: []string{product.DeviceGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OrdersTable,
+			Columns: []string{product.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !pu.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OrdersTable,
+			Columns: []string{product.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OrdersTable,
+			Columns: []string{product.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.LotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.LotsTable,
+			Columns: []string{product.LotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedLotsIDs(); len(nodes) > 0 && !pu.mutation.LotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.LotsTable,
+			Columns: []string{product.LotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.LotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.LotsTable,
+			Columns: []string{product.LotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.InvitationsTable,
+			Columns: []string{product.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !pu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.InvitationsTable,
+			Columns: []string{product.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.InvitationsTable,
+			Columns: []string{product.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.RegistrationInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.RegistrationInvitationsTable,
+			Columns: []string{product.RegistrationInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationinvitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedRegistrationInvitationsIDs(); len(nodes) > 0 && !pu.mutation.RegistrationInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.RegistrationInvitationsTable,
+			Columns: []string{product.RegistrationInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RegistrationInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.RegistrationInvitationsTable,
+			Columns: []string{product.RegistrationInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{product.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// ProductUpdateOne is the builder for updating a single Product entity.
+type ProductUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (puo *ProductUpdateOne) SetDeletedAt(t time.Time) *ProductUpdateOne {
+	puo.mutation.SetDeletedAt(t)
+	return puo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableDeletedAt(t *time.Time) *ProductUpdateOne {
+	if t != nil {
+		puo.SetDeletedAt(*t)
+	}
+	return puo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (puo *ProductUpdateOne) ClearDeletedAt() *ProductUpdateOne {
+	puo.mutation.ClearDeletedAt()
+	return puo
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (puo *ProductUpdateOne) SetDeletedID(i int) *ProductUpdateOne {
+	puo.mutation.ResetDeletedID()
+	puo.mutation.SetDeletedID(i)
+	return puo
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableDeletedID(i *int) *ProductUpdateOne {
+	if i != nil {
+		puo.SetDeletedID(*i)
+	}
+	return puo
+}
+
+// AddDeletedID adds i to the "deleted_id" field.
+func (puo *ProductUpdateOne) AddDeletedID(i int) *ProductUpdateOne {
+	puo.mutation.AddDeletedID(i)
+	return puo
+}
+
+// SetCode sets the "code" field.
+func (puo *ProductUpdateOne) SetCode(s string) *ProductUpdateOne {
+	puo.mutation.SetCode(s)
+	return puo
+}
+
+// SetProductType sets the "product_type" field.
+func (puo *ProductUpdateOne) SetProductType(s string) *ProductUpdateOne {
+	puo.mutation.SetProductType(s)
+	return puo
+}
+
+// SetNillableProductType sets the "product_type" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableProductType(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetProductType(*s)
+	}
+	return puo
+}
+
+// ClearProductType clears the value of the "product_type" field.
 func (puo *ProductUpdateOne) ClearProductType() *ProductUpdateOne {
 	puo.mutation.ClearProductType()
 	return puo
@@ -775,6 +1562,47 @@ func (puo *ProductUpdateOne) SetProductName(s string) *ProductUpdateOne {
 	return puo
 }
 
+// SetWarrantyMonths sets the "warranty_months" field.
+func (puo *ProductUpdateOne) SetWarrantyMonths(i int) *ProductUpdateOne {
+	puo.mutation.ResetWarrantyMonths()
+	puo.mutation.SetWarrantyMonths(i)
+	return puo
+}
+
+// SetNillableWarrantyMonths sets the "warranty_months" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableWarrantyMonths(i *int) *ProductUpdateOne {
+	if i != nil {
+		puo.SetWarrantyMonths(*i)
+	}
+	return puo
+}
+
+// AddWarrantyMonths adds i to the "warranty_months" field.
+func (puo *ProductUpdateOne) AddWarrantyMonths(i int) *ProductUpdateOne {
+	puo.mutation.AddWarrantyMonths(i)
+	return puo
+}
+
+// SetDeviceAttributeSchema sets the "device_attribute_schema" field.
+func (puo *ProductUpdateOne) SetDeviceAttributeSchema(s string) *ProductUpdateOne {
+	puo.mutation.SetDeviceAttributeSchema(s)
+	return puo
+}
+
+// SetNillableDeviceAttributeSchema sets the "device_attribute_schema" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableDeviceAttributeSchema(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetDeviceAttributeSchema(*s)
+	}
+	return puo
+}
+
+// ClearDeviceAttributeSchema clears the value of the "device_attribute_schema" field.
+func (puo *ProductUpdateOne) ClearDeviceAttributeSchema() *ProductUpdateOne {
+	puo.mutation.ClearDeviceAttributeSchema()
+	return puo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (puo *ProductUpdateOne) SetUpdatedAt(t time.Time) *ProductUpdateOne {
 	puo.mutation.SetUpdatedAt(t)
@@ -886,6 +1714,130 @@ func (puo *ProductUpdateOne) AddAuditLogs(a ...*AuditLog) *ProductUpdateOne {
 	return puo.AddAuditLogIDs(ids...)
 }
 
+// SetSnRuleID sets the "sn_rule" edge to the SnRule entity by ID.
+func (puo *ProductUpdateOne) SetSnRuleID(id int) *ProductUpdateOne {
+	puo.mutation.SetSnRuleID(id)
+	return puo
+}
+
+// SetNillableSnRuleID sets the "sn_rule" edge to the SnRule entity by ID if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableSnRuleID(id *int) *ProductUpdateOne {
+	if id != nil {
+		puo = puo.SetSnRuleID(*id)
+	}
+	return puo
+}
+
+// SetSnRule sets the "sn_rule" edge to the SnRule entity.
+func (puo *ProductUpdateOne) SetSnRule(s *SnRule) *ProductUpdateOne {
+	return puo.SetSnRuleID(s.ID)
+}
+
+// AddSnAllocatorIDs adds the "sn_allocators" edge to the SnAllocator entity by IDs.
+func (puo *ProductUpdateOne) AddSnAllocatorIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.AddSnAllocatorIDs(ids...)
+	return puo
+}
+
+// AddSnAllocators adds the "sn_allocators" edges to the SnAllocator entity.
+func (puo *ProductUpdateOne) AddSnAllocators(s ...*SnAllocator) *ProductUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.AddSnAllocatorIDs(ids...)
+}
+
+// AddDeviceTagIDs adds the "device_tags" edge to the DeviceTag entity by IDs.
+func (puo *ProductUpdateOne) AddDeviceTagIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.AddDeviceTagIDs(ids...)
+	return puo
+}
+
+// AddDeviceTags adds the "device_tags" edges to the DeviceTag entity.
+func (puo *ProductUpdateOne) AddDeviceTags(d ...*DeviceTag) *ProductUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return puo.AddDeviceTagIDs(ids...)
+}
+
+// AddDeviceGroupIDs adds the "device_groups" edge to the DeviceGroup entity by IDs.
+func (puo *ProductUpdateOne) AddDeviceGroupIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.AddDeviceGroupIDs(ids...)
+	return puo
+}
+
+// AddDeviceGroups adds the "device_groups" edges to the DeviceGroup entity.
+func (puo *ProductUpdateOne) AddDeviceGroups(d ...*DeviceGroup) *ProductUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return puo.AddDeviceGroupIDs(ids...)
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (puo *ProductUpdateOne) AddOrderIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.AddOrderIDs(ids...)
+	return puo
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (puo *ProductUpdateOne) AddOrders(o ...*Order) *ProductUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return puo.AddOrderIDs(ids...)
+}
+
+// AddLotIDs adds the "lots" edge to the Lot entity by IDs.
+func (puo *ProductUpdateOne) AddLotIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.AddLotIDs(ids...)
+	return puo
+}
+
+// AddLots adds the "lots" edges to the Lot entity.
+func (puo *ProductUpdateOne) AddLots(l ...*Lot) *ProductUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return puo.AddLotIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the ProductInvitation entity by IDs.
+func (puo *ProductUpdateOne) AddInvitationIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.AddInvitationIDs(ids...)
+	return puo
+}
+
+// AddInvitations adds the "invitations" edges to the ProductInvitation entity.
+func (puo *ProductUpdateOne) AddInvitations(p ...*ProductInvitation) *ProductUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddInvitationIDs(ids...)
+}
+
+// AddRegistrationInvitationIDs adds the "registration_invitations" edge to the RegistrationInvitation entity by IDs.
+func (puo *ProductUpdateOne) AddRegistrationInvitationIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.AddRegistrationInvitationIDs(ids...)
+	return puo
+}
+
+// AddRegistrationInvitations adds the "registration_invitations" edges to the RegistrationInvitation entity.
+func (puo *ProductUpdateOne) AddRegistrationInvitations(r ...*RegistrationInvitation) *ProductUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.AddRegistrationInvitationIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (puo *ProductUpdateOne) Mutation() *ProductMutation {
 	return puo.mutation
@@ -1038,6 +1990,159 @@ func (puo *ProductUpdateOne) RemoveAuditLogs(a ...*AuditLog) *ProductUpdateOne {
 	return puo.RemoveAuditLogIDs(ids...)
 }
 
+// ClearSnRule clears the "sn_rule" edge to the SnRule entity.
+func (puo *ProductUpdateOne) ClearSnRule() *ProductUpdateOne {
+	puo.mutation.ClearSnRule()
+	return puo
+}
+
+// ClearSnAllocators clears all "sn_allocators" edges to the SnAllocator entity.
+func (puo *ProductUpdateOne) ClearSnAllocators() *ProductUpdateOne {
+	puo.mutation.ClearSnAllocators()
+	return puo
+}
+
+// RemoveSnAllocatorIDs removes the "sn_allocators" edge to SnAllocator entities by IDs.
+func (puo *ProductUpdateOne) RemoveSnAllocatorIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.RemoveSnAllocatorIDs(ids...)
+	return puo
+}
+
+// RemoveSnAllocators removes "sn_allocators" edges to SnAllocator entities.
+func (puo *ProductUpdateOne) RemoveSnAllocators(s ...*SnAllocator) *ProductUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.RemoveSnAllocatorIDs(ids...)
+}
+
+// ClearDeviceTags clears all "device_tags" edges to the DeviceTag entity.
+func (puo *ProductUpdateOne) ClearDeviceTags() *ProductUpdateOne {
+	puo.mutation.ClearDeviceTags()
+	return puo
+}
+
+// RemoveDeviceTagIDs removes the "device_tags" edge to DeviceTag entities by IDs.
+func (puo *ProductUpdateOne) RemoveDeviceTagIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.RemoveDeviceTagIDs(ids...)
+	return puo
+}
+
+// RemoveDeviceTags removes "device_tags" edges to DeviceTag entities.
+func (puo *ProductUpdateOne) RemoveDeviceTags(d ...*DeviceTag) *ProductUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return puo.RemoveDeviceTagIDs(ids...)
+}
+
+// ClearDeviceGroups clears all "device_groups" edges to the DeviceGroup entity.
+func (puo *ProductUpdateOne) ClearDeviceGroups() *ProductUpdateOne {
+	puo.mutation.ClearDeviceGroups()
+	return puo
+}
+
+// RemoveDeviceGroupIDs removes the "device_groups" edge to DeviceGroup entities by IDs.
+func (puo *ProductUpdateOne) RemoveDeviceGroupIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.RemoveDeviceGroupIDs(ids...)
+	return puo
+}
+
+// RemoveDeviceGroups removes "device_groups" edges to DeviceGroup entities.
+func (puo *ProductUpdateOne) RemoveDeviceGroups(d ...*DeviceGroup) *ProductUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return puo.RemoveDeviceGroupIDs(ids...)
+}
+
+// ClearOrders clears all "orders" edges to the Order entity.
+func (puo *ProductUpdateOne) ClearOrders() *ProductUpdateOne {
+	puo.mutation.ClearOrders()
+	return puo
+}
+
+// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
+func (puo *ProductUpdateOne) RemoveOrderIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.RemoveOrderIDs(ids...)
+	return puo
+}
+
+// RemoveOrders removes "orders" edges to Order entities.
+func (puo *ProductUpdateOne) RemoveOrders(o ...*Order) *ProductUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return puo.RemoveOrderIDs(ids...)
+}
+
+// ClearLots clears all "lots" edges to the Lot entity.
+func (puo *ProductUpdateOne) ClearLots() *ProductUpdateOne {
+	puo.mutation.ClearLots()
+	return puo
+}
+
+// RemoveLotIDs removes the "lots" edge to Lot entities by IDs.
+func (puo *ProductUpdateOne) RemoveLotIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.RemoveLotIDs(ids...)
+	return puo
+}
+
+// RemoveLots removes "lots" edges to Lot entities.
+func (puo *ProductUpdateOne) RemoveLots(l ...*Lot) *ProductUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return puo.RemoveLotIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the ProductInvitation entity.
+func (puo *ProductUpdateOne) ClearInvitations() *ProductUpdateOne {
+	puo.mutation.ClearInvitations()
+	return puo
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to ProductInvitation entities by IDs.
+func (puo *ProductUpdateOne) RemoveInvitationIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.RemoveInvitationIDs(ids...)
+	return puo
+}
+
+// RemoveInvitations removes "invitations" edges to ProductInvitation entities.
+func (puo *ProductUpdateOne) RemoveInvitations(p ...*ProductInvitation) *ProductUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveInvitationIDs(ids...)
+}
+
+// ClearRegistrationInvitations clears all "registration_invitations" edges to the RegistrationInvitation entity.
+func (puo *ProductUpdateOne) ClearRegistrationInvitations() *ProductUpdateOne {
+	puo.mutation.ClearRegistrationInvitations()
+	return puo
+}
+
+// RemoveRegistrationInvitationIDs removes the "registration_invitations" edge to RegistrationInvitation entities by IDs.
+func (puo *ProductUpdateOne) RemoveRegistrationInvitationIDs(ids ...int) *ProductUpdateOne {
+	puo.mutation.RemoveRegistrationInvitationIDs(ids...)
+	return puo
+}
+
+// RemoveRegistrationInvitations removes "registration_invitations" edges to RegistrationInvitation entities.
+func (puo *ProductUpdateOne) RemoveRegistrationInvitations(r ...*RegistrationInvitation) *ProductUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.RemoveRegistrationInvitationIDs(ids...)
+}
+
 // Where appends a list predicates to the ProductUpdate builder.
 func (puo *ProductUpdateOne) Where(ps ...predicate.Product) *ProductUpdateOne {
 	puo.mutation.Where(ps...)
@@ -1053,7 +2158,9 @@ func (puo *ProductUpdateOne) Select(field string, fields ...string) *ProductUpda
 
 // Save executes the query and returns the updated Product entity.
 func (puo *ProductUpdateOne) Save(ctx context.Context) (*Product, error) {
-	puo.defaults()
+	if err := puo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
 }
 
@@ -1080,11 +2187,15 @@ func (puo *ProductUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (puo *ProductUpdateOne) defaults() {
+func (puo *ProductUpdateOne) defaults() error {
 	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		if product.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized product.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := product.UpdateDefaultUpdatedAt()
 		puo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -1099,6 +2210,11 @@ func (puo *ProductUpdateOne) check() error {
 			return &ValidationError{Name: "product_name", err: fmt.Errorf(`ent: validator failed for field "Product.product_name": %w`, err)}
 		}
 	}
+	if v, ok := puo.mutation.WarrantyMonths(); ok {
+		if err := product.WarrantyMonthsValidator(v); err != nil {
+			return &ValidationError{Name: "warranty_months", err: fmt.Errorf(`ent: validator failed for field "Product.warranty_months": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1131,6 +2247,18 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 			}
 		}
 	}
+	if value, ok := puo.mutation.DeletedAt(); ok {
+		_spec.SetField(product.FieldDeletedAt, field.TypeTime, value)
+	}
+	if puo.mutation.DeletedAtCleared() {
+		_spec.ClearField(product.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.DeletedID(); ok {
+		_spec.SetField(product.FieldDeletedID, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedDeletedID(); ok {
+		_spec.AddField(product.FieldDeletedID, field.TypeInt, value)
+	}
 	if value, ok := puo.mutation.Code(); ok {
 		_spec.SetField(product.FieldCode, field.TypeString, value)
 	}
@@ -1143,6 +2271,18 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 	if value, ok := puo.mutation.ProductName(); ok {
 		_spec.SetField(product.FieldProductName, field.TypeString, value)
 	}
+	if value, ok := puo.mutation.WarrantyMonths(); ok {
+		_spec.SetField(product.FieldWarrantyMonths, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedWarrantyMonths(); ok {
+		_spec.AddField(product.FieldWarrantyMonths, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.DeviceAttributeSchema(); ok {
+		_spec.SetField(product.FieldDeviceAttributeSchema, field.TypeString, value)
+	}
+	if puo.mutation.DeviceAttributeSchemaCleared() {
+		_spec.ClearField(product.FieldDeviceAttributeSchema, field.TypeString)
+	}
 	if value, ok := puo.mutation.UpdatedAt(); ok {
 		_spec.SetField(product.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1461,6 +2601,350 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.SnRuleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   product.SnRuleTable,
+			Columns: []string{product.SnRuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snrule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.SnRuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   product.SnRuleTable,
+			Columns: []string{product.SnRuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.SnAllocatorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.SnAllocatorsTable,
+			Columns: []string{product.SnAllocatorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snallocator.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedSnAllocatorsIDs(); len(nodes) > 0 && !puo.mutation.SnAllocatorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.SnAllocatorsTable,
+			Columns: []string{product.SnAllocatorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snallocator.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.SnAllocatorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.SnAllocatorsTable,
+			Columns: []string{product.SnAllocatorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snallocator.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.DeviceTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.DeviceTagsTable,
+			Columns: []string{product.DeviceTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedDeviceTagsIDs(); len(nodes) > 0 && !puo.mutation.DeviceTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.DeviceTagsTable,
+			Columns: []string{product.DeviceTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.DeviceTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.DeviceTagsTable,
+			Columns: []string{product.DeviceTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.DeviceGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.DeviceGroupsTable,
+			Columns: []string{product.DeviceGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedDeviceGroupsIDs(); len(nodes) > 0 && !puo.mutation.DeviceGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.DeviceGroupsTable,
+			Columns: []string{product.DeviceGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.DeviceGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.DeviceGroupsTable,
+			Columns: []string{product.DeviceGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicegroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OrdersTable,
+			Columns: []string{product.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !puo.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OrdersTable,
+			Columns: []string{product.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OrdersTable,
+			Columns: []string{product.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.LotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.LotsTable,
+			Columns: []string{product.LotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedLotsIDs(); len(nodes) > 0 && !puo.mutation.LotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.LotsTable,
+			Columns: []string{product.LotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.LotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.LotsTable,
+			Columns: []string{product.LotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.InvitationsTable,
+			Columns: []string{product.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !puo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.InvitationsTable,
+			Columns: []string{product.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.InvitationsTable,
+			Columns: []string{product.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.RegistrationInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.RegistrationInvitationsTable,
+			Columns: []string{product.RegistrationInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationinvitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedRegistrationInvitationsIDs(); len(nodes) > 0 && !puo.mutation.RegistrationInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.RegistrationInvitationsTable,
+			Columns: []string{product.RegistrationInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RegistrationInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.RegistrationInvitationsTable,
+			Columns: []string{product.RegistrationInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Product{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
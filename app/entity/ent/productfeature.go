@@ -18,6 +18,10 @@ type ProductFeature struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 删除标记，正常记录为0，删除后为记录ID，用于唯一索引
+	DeletedID int `json:"deleted_id,omitempty"`
 	// 功能名称
 	FeatureName string `json:"feature_name,omitempty"`
 	// 功能编码
@@ -42,11 +46,19 @@ type ProductFeatureEdges struct {
 	LicenseTypes []*LicenseType `json:"license_types,omitempty"`
 	// SoftwareVersions holds the value of the software_versions edge.
 	SoftwareVersions []*SoftwareVersion `json:"software_versions,omitempty"`
+	// DeviceOverrides holds the value of the device_overrides edge.
+	DeviceOverrides []*DeviceFeatureOverride `json:"device_overrides,omitempty"`
+	// RequiredBy holds the value of the required_by edge.
+	RequiredBy []*ProductFeature `json:"required_by,omitempty"`
+	// Requires holds the value of the requires edge.
+	Requires []*ProductFeature `json:"requires,omitempty"`
+	// Conflicts holds the value of the conflicts edge.
+	Conflicts []*ProductFeature `json:"conflicts,omitempty"`
 	// LicenseTypeFeatures holds the value of the license_type_features edge.
 	LicenseTypeFeatures []*LicenseTypeFeatures `json:"license_type_features,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [8]bool
 }
 
 // ProductOrErr returns the Product value or an error if the edge
@@ -80,10 +92,46 @@ func (e ProductFeatureEdges) SoftwareVersionsOrErr() ([]*SoftwareVersion, error)
 	return nil, &NotLoadedError{edge: "software_versions"}
 }
 
+// DeviceOverridesOrErr returns the DeviceOverrides value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductFeatureEdges) DeviceOverridesOrErr() ([]*DeviceFeatureOverride, error) {
+	if e.loadedTypes[3] {
+		return e.DeviceOverrides, nil
+	}
+	return nil, &NotLoadedError{edge: "device_overrides"}
+}
+
+// RequiredByOrErr returns the RequiredBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductFeatureEdges) RequiredByOrErr() ([]*ProductFeature, error) {
+	if e.loadedTypes[4] {
+		return e.RequiredBy, nil
+	}
+	return nil, &NotLoadedError{edge: "required_by"}
+}
+
+// RequiresOrErr returns the Requires value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductFeatureEdges) RequiresOrErr() ([]*ProductFeature, error) {
+	if e.loadedTypes[5] {
+		return e.Requires, nil
+	}
+	return nil, &NotLoadedError{edge: "requires"}
+}
+
+// ConflictsOrErr returns the Conflicts value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductFeatureEdges) ConflictsOrErr() ([]*ProductFeature, error) {
+	if e.loadedTypes[6] {
+		return e.Conflicts, nil
+	}
+	return nil, &NotLoadedError{edge: "conflicts"}
+}
+
 // LicenseTypeFeaturesOrErr returns the LicenseTypeFeatures value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProductFeatureEdges) LicenseTypeFeaturesOrErr() ([]*LicenseTypeFeatures, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[7] {
 		return e.LicenseTypeFeatures, nil
 	}
 	return nil, &NotLoadedError{edge: "license_type_features"}
@@ -94,11 +142,11 @@ func (*ProductFeature) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case productfeature.FieldID, productfeature.FieldProductID:
+		case productfeature.FieldID, productfeature.FieldDeletedID, productfeature.FieldProductID:
 			values[i] = new(sql.NullInt64)
 		case productfeature.FieldFeatureName, productfeature.FieldFeatureCode:
 			values[i] = new(sql.NullString)
-		case productfeature.FieldCreatedAt, productfeature.FieldUpdatedAt:
+		case productfeature.FieldDeletedAt, productfeature.FieldCreatedAt, productfeature.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -121,6 +169,19 @@ func (pf *ProductFeature) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			pf.ID = int(value.Int64)
+		case productfeature.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pf.DeletedAt = new(time.Time)
+				*pf.DeletedAt = value.Time
+			}
+		case productfeature.FieldDeletedID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_id", values[i])
+			} else if value.Valid {
+				pf.DeletedID = int(value.Int64)
+			}
 		case productfeature.FieldFeatureName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field feature_name", values[i])
@@ -179,6 +240,26 @@ func (pf *ProductFeature) QuerySoftwareVersions() *SoftwareVersionQuery {
 	return NewProductFeatureClient(pf.config).QuerySoftwareVersions(pf)
 }
 
+// QueryDeviceOverrides queries the "device_overrides" edge of the ProductFeature entity.
+func (pf *ProductFeature) QueryDeviceOverrides() *DeviceFeatureOverrideQuery {
+	return NewProductFeatureClient(pf.config).QueryDeviceOverrides(pf)
+}
+
+// QueryRequiredBy queries the "required_by" edge of the ProductFeature entity.
+func (pf *ProductFeature) QueryRequiredBy() *ProductFeatureQuery {
+	return NewProductFeatureClient(pf.config).QueryRequiredBy(pf)
+}
+
+// QueryRequires queries the "requires" edge of the ProductFeature entity.
+func (pf *ProductFeature) QueryRequires() *ProductFeatureQuery {
+	return NewProductFeatureClient(pf.config).QueryRequires(pf)
+}
+
+// QueryConflicts queries the "conflicts" edge of the ProductFeature entity.
+func (pf *ProductFeature) QueryConflicts() *ProductFeatureQuery {
+	return NewProductFeatureClient(pf.config).QueryConflicts(pf)
+}
+
 // QueryLicenseTypeFeatures queries the "license_type_features" edge of the ProductFeature entity.
 func (pf *ProductFeature) QueryLicenseTypeFeatures() *LicenseTypeFeaturesQuery {
 	return NewProductFeatureClient(pf.config).QueryLicenseTypeFeatures(pf)
@@ -207,6 +288,14 @@ func (pf *ProductFeature) String() string {
 	var builder strings.Builder
 	builder.WriteString("ProductFeature(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", pf.ID))
+	if v := pf.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deleted_id=")
+	builder.WriteString(fmt.Sprintf("%v", pf.DeletedID))
+	builder.WriteString(", ")
 	builder.WriteString("feature_name=")
 	builder.WriteString(pf.FeatureName)
 	builder.WriteString(", ")
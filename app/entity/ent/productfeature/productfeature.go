@@ -5,6 +5,7 @@ package productfeature
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -14,6 +15,10 @@ const (
 	Label = "product_feature"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldDeletedID holds the string denoting the deleted_id field in the database.
+	FieldDeletedID = "deleted_id"
 	// FieldFeatureName holds the string denoting the feature_name field in the database.
 	FieldFeatureName = "feature_name"
 	// FieldFeatureCode holds the string denoting the feature_code field in the database.
@@ -30,6 +35,14 @@ const (
 	EdgeLicenseTypes = "license_types"
 	// EdgeSoftwareVersions holds the string denoting the software_versions edge name in mutations.
 	EdgeSoftwareVersions = "software_versions"
+	// EdgeDeviceOverrides holds the string denoting the device_overrides edge name in mutations.
+	EdgeDeviceOverrides = "device_overrides"
+	// EdgeRequiredBy holds the string denoting the required_by edge name in mutations.
+	EdgeRequiredBy = "required_by"
+	// EdgeRequires holds the string denoting the requires edge name in mutations.
+	EdgeRequires = "requires"
+	// EdgeConflicts holds the string denoting the conflicts edge name in mutations.
+	EdgeConflicts = "conflicts"
 	// EdgeLicenseTypeFeatures holds the string denoting the license_type_features edge name in mutations.
 	EdgeLicenseTypeFeatures = "license_type_features"
 	// Table holds the table name of the productfeature in the database.
@@ -51,6 +64,19 @@ const (
 	// SoftwareVersionsInverseTable is the table name for the SoftwareVersion entity.
 	// It exists in this package in order to avoid circular dependency with the "softwareversion" package.
 	SoftwareVersionsInverseTable = "software_versions"
+	// DeviceOverridesTable is the table that holds the device_overrides relation/edge.
+	DeviceOverridesTable = "device_feature_overrides"
+	// DeviceOverridesInverseTable is the table name for the DeviceFeatureOverride entity.
+	// It exists in this package in order to avoid circular dependency with the "devicefeatureoverride" package.
+	DeviceOverridesInverseTable = "device_feature_overrides"
+	// DeviceOverridesColumn is the table column denoting the device_overrides relation/edge.
+	DeviceOverridesColumn = "feature_id"
+	// RequiredByTable is the table that holds the required_by relation/edge. The primary key declared below.
+	RequiredByTable = "product_feature_requires"
+	// RequiresTable is the table that holds the requires relation/edge. The primary key declared below.
+	RequiresTable = "product_feature_requires"
+	// ConflictsTable is the table that holds the conflicts relation/edge. The primary key declared below.
+	ConflictsTable = "product_feature_conflicts"
 	// LicenseTypeFeaturesTable is the table that holds the license_type_features relation/edge.
 	LicenseTypeFeaturesTable = "license_type_features"
 	// LicenseTypeFeaturesInverseTable is the table name for the LicenseTypeFeatures entity.
@@ -63,6 +89,8 @@ const (
 // Columns holds all SQL columns for productfeature fields.
 var Columns = []string{
 	FieldID,
+	FieldDeletedAt,
+	FieldDeletedID,
 	FieldFeatureName,
 	FieldFeatureCode,
 	FieldProductID,
@@ -77,6 +105,15 @@ var (
 	// SoftwareVersionsPrimaryKey and SoftwareVersionsColumn2 are the table columns denoting the
 	// primary key for the software_versions relation (M2M).
 	SoftwareVersionsPrimaryKey = []string{"software_version_id", "product_feature_id"}
+	// RequiredByPrimaryKey and RequiredByColumn2 are the table columns denoting the
+	// primary key for the required_by relation (M2M).
+	RequiredByPrimaryKey = []string{"product_feature_id", "required_by_id"}
+	// RequiresPrimaryKey and RequiresColumn2 are the table columns denoting the
+	// primary key for the requires relation (M2M).
+	RequiresPrimaryKey = []string{"product_feature_id", "required_by_id"}
+	// ConflictsPrimaryKey and ConflictsColumn2 are the table columns denoting the
+	// primary key for the conflicts relation (M2M).
+	ConflictsPrimaryKey = []string{"product_feature_id", "conflict_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -89,7 +126,17 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "cambridge-hit.com/gin-base/activateserver/app/entity/ent/runtime"
 var (
+	Hooks        [2]ent.Hook
+	Interceptors [2]ent.Interceptor
+	Policy       ent.Policy
+	// DefaultDeletedID holds the default value on creation for the "deleted_id" field.
+	DefaultDeletedID int
 	// FeatureNameValidator is a validator for the "feature_name" field. It is called by the builders before save.
 	FeatureNameValidator func(string) error
 	// FeatureCodeValidator is a validator for the "feature_code" field. It is called by the builders before save.
@@ -112,6 +159,16 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByDeletedID orders the results by the deleted_id field.
+func ByDeletedID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedID, opts...).ToFunc()
+}
+
 // ByFeatureName orders the results by the feature_name field.
 func ByFeatureName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFeatureName, opts...).ToFunc()
@@ -172,6 +229,62 @@ func BySoftwareVersions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 	}
 }
 
+// ByDeviceOverridesCount orders the results by device_overrides count.
+func ByDeviceOverridesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeviceOverridesStep(), opts...)
+	}
+}
+
+// ByDeviceOverrides orders the results by device_overrides terms.
+func ByDeviceOverrides(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeviceOverridesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRequiredByCount orders the results by required_by count.
+func ByRequiredByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRequiredByStep(), opts...)
+	}
+}
+
+// ByRequiredBy orders the results by required_by terms.
+func ByRequiredBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRequiredByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRequiresCount orders the results by requires count.
+func ByRequiresCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRequiresStep(), opts...)
+	}
+}
+
+// ByRequires orders the results by requires terms.
+func ByRequires(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRequiresStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByConflictsCount orders the results by conflicts count.
+func ByConflictsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newConflictsStep(), opts...)
+	}
+}
+
+// ByConflicts orders the results by conflicts terms.
+func ByConflicts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConflictsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByLicenseTypeFeaturesCount orders the results by license_type_features count.
 func ByLicenseTypeFeaturesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -206,6 +319,34 @@ func newSoftwareVersionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, SoftwareVersionsTable, SoftwareVersionsPrimaryKey...),
 	)
 }
+func newDeviceOverridesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeviceOverridesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DeviceOverridesTable, DeviceOverridesColumn),
+	)
+}
+func newRequiredByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, RequiredByTable, RequiredByPrimaryKey...),
+	)
+}
+func newRequiresStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, RequiresTable, RequiresPrimaryKey...),
+	)
+}
+func newConflictsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, ConflictsTable, ConflictsPrimaryKey...),
+	)
+}
 func newLicenseTypeFeaturesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -55,6 +55,16 @@ func IDLTE(id int) predicate.ProductFeature {
 	return predicate.ProductFeature(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedID applies equality check predicate on the "deleted_id" field. It's identical to DeletedIDEQ.
+func DeletedID(v int) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldEQ(FieldDeletedID, v))
+}
+
 // FeatureName applies equality check predicate on the "feature_name" field. It's identical to FeatureNameEQ.
 func FeatureName(v string) predicate.ProductFeature {
 	return predicate.ProductFeature(sql.FieldEQ(FieldFeatureName, v))
@@ -80,6 +90,96 @@ func UpdatedAt(v time.Time) predicate.ProductFeature {
 	return predicate.ProductFeature(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DeletedIDEQ applies the EQ predicate on the "deleted_id" field.
+func DeletedIDEQ(v int) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldEQ(FieldDeletedID, v))
+}
+
+// DeletedIDNEQ applies the NEQ predicate on the "deleted_id" field.
+func DeletedIDNEQ(v int) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldNEQ(FieldDeletedID, v))
+}
+
+// DeletedIDIn applies the In predicate on the "deleted_id" field.
+func DeletedIDIn(vs ...int) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldIn(FieldDeletedID, vs...))
+}
+
+// DeletedIDNotIn applies the NotIn predicate on the "deleted_id" field.
+func DeletedIDNotIn(vs ...int) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldNotIn(FieldDeletedID, vs...))
+}
+
+// DeletedIDGT applies the GT predicate on the "deleted_id" field.
+func DeletedIDGT(v int) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldGT(FieldDeletedID, v))
+}
+
+// DeletedIDGTE applies the GTE predicate on the "deleted_id" field.
+func DeletedIDGTE(v int) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldGTE(FieldDeletedID, v))
+}
+
+// DeletedIDLT applies the LT predicate on the "deleted_id" field.
+func DeletedIDLT(v int) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldLT(FieldDeletedID, v))
+}
+
+// DeletedIDLTE applies the LTE predicate on the "deleted_id" field.
+func DeletedIDLTE(v int) predicate.ProductFeature {
+	return predicate.ProductFeature(sql.FieldLTE(FieldDeletedID, v))
+}
+
 // FeatureNameEQ applies the EQ predicate on the "feature_name" field.
 func FeatureNameEQ(v string) predicate.ProductFeature {
 	return predicate.ProductFeature(sql.FieldEQ(FieldFeatureName, v))
@@ -379,6 +479,98 @@ func HasSoftwareVersionsWith(preds ...predicate.SoftwareVersion) predicate.Produ
 	})
 }
 
+// HasDeviceOverrides applies the HasEdge predicate on the "device_overrides" edge.
+func HasDeviceOverrides() predicate.ProductFeature {
+	return predicate.ProductFeature(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeviceOverridesTable, DeviceOverridesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeviceOverridesWith applies the HasEdge predicate on the "device_overrides" edge with a given conditions (other predicates).
+func HasDeviceOverridesWith(preds ...predicate.DeviceFeatureOverride) predicate.ProductFeature {
+	return predicate.ProductFeature(func(s *sql.Selector) {
+		step := newDeviceOverridesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRequiredBy applies the HasEdge predicate on the "required_by" edge.
+func HasRequiredBy() predicate.ProductFeature {
+	return predicate.ProductFeature(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, RequiredByTable, RequiredByPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRequiredByWith applies the HasEdge predicate on the "required_by" edge with a given conditions (other predicates).
+func HasRequiredByWith(preds ...predicate.ProductFeature) predicate.ProductFeature {
+	return predicate.ProductFeature(func(s *sql.Selector) {
+		step := newRequiredByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRequires applies the HasEdge predicate on the "requires" edge.
+func HasRequires() predicate.ProductFeature {
+	return predicate.ProductFeature(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, RequiresTable, RequiresPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRequiresWith applies the HasEdge predicate on the "requires" edge with a given conditions (other predicates).
+func HasRequiresWith(preds ...predicate.ProductFeature) predicate.ProductFeature {
+	return predicate.ProductFeature(func(s *sql.Selector) {
+		step := newRequiresStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasConflicts applies the HasEdge predicate on the "conflicts" edge.
+func HasConflicts() predicate.ProductFeature {
+	return predicate.ProductFeature(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, ConflictsTable, ConflictsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConflictsWith applies the HasEdge predicate on the "conflicts" edge with a given conditions (other predicates).
+func HasConflictsWith(preds ...predicate.ProductFeature) predicate.ProductFeature {
+	return predicate.ProductFeature(func(s *sql.Selector) {
+		step := newConflictsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasLicenseTypeFeatures applies the HasEdge predicate on the "license_type_features" edge.
 func HasLicenseTypeFeatures() predicate.ProductFeature {
 	return predicate.ProductFeature(func(s *sql.Selector) {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicefeatureoverride"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetypefeatures"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
@@ -24,6 +25,34 @@ type ProductFeatureCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (pfc *ProductFeatureCreate) SetDeletedAt(t time.Time) *ProductFeatureCreate {
+	pfc.mutation.SetDeletedAt(t)
+	return pfc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pfc *ProductFeatureCreate) SetNillableDeletedAt(t *time.Time) *ProductFeatureCreate {
+	if t != nil {
+		pfc.SetDeletedAt(*t)
+	}
+	return pfc
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (pfc *ProductFeatureCreate) SetDeletedID(i int) *ProductFeatureCreate {
+	pfc.mutation.SetDeletedID(i)
+	return pfc
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (pfc *ProductFeatureCreate) SetNillableDeletedID(i *int) *ProductFeatureCreate {
+	if i != nil {
+		pfc.SetDeletedID(*i)
+	}
+	return pfc
+}
+
 // SetFeatureName sets the "feature_name" field.
 func (pfc *ProductFeatureCreate) SetFeatureName(s string) *ProductFeatureCreate {
 	pfc.mutation.SetFeatureName(s)
@@ -111,6 +140,66 @@ func (pfc *ProductFeatureCreate) AddSoftwareVersions(s ...*SoftwareVersion) *Pro
 	return pfc.AddSoftwareVersionIDs(ids...)
 }
 
+// AddDeviceOverrideIDs adds the "device_overrides" edge to the DeviceFeatureOverride entity by IDs.
+func (pfc *ProductFeatureCreate) AddDeviceOverrideIDs(ids ...int) *ProductFeatureCreate {
+	pfc.mutation.AddDeviceOverrideIDs(ids...)
+	return pfc
+}
+
+// AddDeviceOverrides adds the "device_overrides" edges to the DeviceFeatureOverride entity.
+func (pfc *ProductFeatureCreate) AddDeviceOverrides(d ...*DeviceFeatureOverride) *ProductFeatureCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pfc.AddDeviceOverrideIDs(ids...)
+}
+
+// AddRequiredByIDs adds the "required_by" edge to the ProductFeature entity by IDs.
+func (pfc *ProductFeatureCreate) AddRequiredByIDs(ids ...int) *ProductFeatureCreate {
+	pfc.mutation.AddRequiredByIDs(ids...)
+	return pfc
+}
+
+// AddRequiredBy adds the "required_by" edges to the ProductFeature entity.
+func (pfc *ProductFeatureCreate) AddRequiredBy(p ...*ProductFeature) *ProductFeatureCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pfc.AddRequiredByIDs(ids...)
+}
+
+// AddRequireIDs adds the "requires" edge to the ProductFeature entity by IDs.
+func (pfc *ProductFeatureCreate) AddRequireIDs(ids ...int) *ProductFeatureCreate {
+	pfc.mutation.AddRequireIDs(ids...)
+	return pfc
+}
+
+// AddRequires adds the "requires" edges to the ProductFeature entity.
+func (pfc *ProductFeatureCreate) AddRequires(p ...*ProductFeature) *ProductFeatureCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pfc.AddRequireIDs(ids...)
+}
+
+// AddConflictIDs adds the "conflicts" edge to the ProductFeature entity by IDs.
+func (pfc *ProductFeatureCreate) AddConflictIDs(ids ...int) *ProductFeatureCreate {
+	pfc.mutation.AddConflictIDs(ids...)
+	return pfc
+}
+
+// AddConflicts adds the "conflicts" edges to the ProductFeature entity.
+func (pfc *ProductFeatureCreate) AddConflicts(p ...*ProductFeature) *ProductFeatureCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pfc.AddConflictIDs(ids...)
+}
+
 // AddLicenseTypeFeatureIDs adds the "license_type_features" edge to the LicenseTypeFeatures entity by IDs.
 func (pfc *ProductFeatureCreate) AddLicenseTypeFeatureIDs(ids ...int) *ProductFeatureCreate {
 	pfc.mutation.AddLicenseTypeFeatureIDs(ids...)
@@ -133,7 +222,9 @@ func (pfc *ProductFeatureCreate) Mutation() *ProductFeatureMutation {
 
 // Save creates the ProductFeature in the database.
 func (pfc *ProductFeatureCreate) Save(ctx context.Context) (*ProductFeature, error) {
-	pfc.defaults()
+	if err := pfc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, pfc.sqlSave, pfc.mutation, pfc.hooks)
 }
 
@@ -160,19 +251,33 @@ func (pfc *ProductFeatureCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (pfc *ProductFeatureCreate) defaults() {
+func (pfc *ProductFeatureCreate) defaults() error {
+	if _, ok := pfc.mutation.DeletedID(); !ok {
+		v := productfeature.DefaultDeletedID
+		pfc.mutation.SetDeletedID(v)
+	}
 	if _, ok := pfc.mutation.CreatedAt(); !ok {
+		if productfeature.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized productfeature.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := productfeature.DefaultCreatedAt()
 		pfc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := pfc.mutation.UpdatedAt(); !ok {
+		if productfeature.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized productfeature.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := productfeature.DefaultUpdatedAt()
 		pfc.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (pfc *ProductFeatureCreate) check() error {
+	if _, ok := pfc.mutation.DeletedID(); !ok {
+		return &ValidationError{Name: "deleted_id", err: errors.New(`ent: missing required field "ProductFeature.deleted_id"`)}
+	}
 	if _, ok := pfc.mutation.FeatureName(); !ok {
 		return &ValidationError{Name: "feature_name", err: errors.New(`ent: missing required field "ProductFeature.feature_name"`)}
 	}
@@ -238,6 +343,14 @@ func (pfc *ProductFeatureCreate) createSpec() (*ProductFeature, *sqlgraph.Create
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := pfc.mutation.DeletedAt(); ok {
+		_spec.SetField(productfeature.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := pfc.mutation.DeletedID(); ok {
+		_spec.SetField(productfeature.FieldDeletedID, field.TypeInt, value)
+		_node.DeletedID = value
+	}
 	if value, ok := pfc.mutation.FeatureName(); ok {
 		_spec.SetField(productfeature.FieldFeatureName, field.TypeString, value)
 		_node.FeatureName = value
@@ -307,6 +420,70 @@ func (pfc *ProductFeatureCreate) createSpec() (*ProductFeature, *sqlgraph.Create
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pfc.mutation.DeviceOverridesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productfeature.DeviceOverridesTable,
+			Columns: []string{productfeature.DeviceOverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicefeatureoverride.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pfc.mutation.RequiredByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   productfeature.RequiredByTable,
+			Columns: productfeature.RequiredByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pfc.mutation.RequiresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   productfeature.RequiresTable,
+			Columns: productfeature.RequiresPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pfc.mutation.ConflictsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   productfeature.ConflictsTable,
+			Columns: productfeature.ConflictsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := pfc.mutation.LicenseTypeFeaturesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
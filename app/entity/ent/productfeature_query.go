@@ -5,15 +5,18 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicefeatureoverride"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetypefeatures"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/softwareversion"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -29,7 +32,12 @@ type ProductFeatureQuery struct {
 	withProduct             *ProductQuery
 	withLicenseTypes        *LicenseTypeQuery
 	withSoftwareVersions    *SoftwareVersionQuery
+	withDeviceOverrides     *DeviceFeatureOverrideQuery
+	withRequiredBy          *ProductFeatureQuery
+	withRequires            *ProductFeatureQuery
+	withConflicts           *ProductFeatureQuery
 	withLicenseTypeFeatures *LicenseTypeFeaturesQuery
+	modifiers               []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -132,6 +140,94 @@ func (pfq *ProductFeatureQuery) QuerySoftwareVersions() *SoftwareVersionQuery {
 	return query
 }
 
+// QueryDeviceOverrides chains the current query on the "device_overrides" edge.
+func (pfq *ProductFeatureQuery) QueryDeviceOverrides() *DeviceFeatureOverrideQuery {
+	query := (&DeviceFeatureOverrideClient{config: pfq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productfeature.Table, productfeature.FieldID, selector),
+			sqlgraph.To(devicefeatureoverride.Table, devicefeatureoverride.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, productfeature.DeviceOverridesTable, productfeature.DeviceOverridesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRequiredBy chains the current query on the "required_by" edge.
+func (pfq *ProductFeatureQuery) QueryRequiredBy() *ProductFeatureQuery {
+	query := (&ProductFeatureClient{config: pfq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productfeature.Table, productfeature.FieldID, selector),
+			sqlgraph.To(productfeature.Table, productfeature.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, productfeature.RequiredByTable, productfeature.RequiredByPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(pfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRequires chains the current query on the "requires" edge.
+func (pfq *ProductFeatureQuery) QueryRequires() *ProductFeatureQuery {
+	query := (&ProductFeatureClient{config: pfq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productfeature.Table, productfeature.FieldID, selector),
+			sqlgraph.To(productfeature.Table, productfeature.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, productfeature.RequiresTable, productfeature.RequiresPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(pfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryConflicts chains the current query on the "conflicts" edge.
+func (pfq *ProductFeatureQuery) QueryConflicts() *ProductFeatureQuery {
+	query := (&ProductFeatureClient{config: pfq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productfeature.Table, productfeature.FieldID, selector),
+			sqlgraph.To(productfeature.Table, productfeature.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, productfeature.ConflictsTable, productfeature.ConflictsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(pfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryLicenseTypeFeatures chains the current query on the "license_type_features" edge.
 func (pfq *ProductFeatureQuery) QueryLicenseTypeFeatures() *LicenseTypeFeaturesQuery {
 	query := (&LicenseTypeFeaturesClient{config: pfq.config}).Query()
@@ -349,6 +445,10 @@ func (pfq *ProductFeatureQuery) Clone() *ProductFeatureQuery {
 		withProduct:             pfq.withProduct.Clone(),
 		withLicenseTypes:        pfq.withLicenseTypes.Clone(),
 		withSoftwareVersions:    pfq.withSoftwareVersions.Clone(),
+		withDeviceOverrides:     pfq.withDeviceOverrides.Clone(),
+		withRequiredBy:          pfq.withRequiredBy.Clone(),
+		withRequires:            pfq.withRequires.Clone(),
+		withConflicts:           pfq.withConflicts.Clone(),
 		withLicenseTypeFeatures: pfq.withLicenseTypeFeatures.Clone(),
 		// clone intermediate query.
 		sql:  pfq.sql.Clone(),
@@ -389,6 +489,50 @@ func (pfq *ProductFeatureQuery) WithSoftwareVersions(opts ...func(*SoftwareVersi
 	return pfq
 }
 
+// WithDeviceOverrides tells the query-builder to eager-load the nodes that are connected to
+// the "device_overrides" edge. The optional arguments are used to configure the query builder of the edge.
+func (pfq *ProductFeatureQuery) WithDeviceOverrides(opts ...func(*DeviceFeatureOverrideQuery)) *ProductFeatureQuery {
+	query := (&DeviceFeatureOverrideClient{config: pfq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pfq.withDeviceOverrides = query
+	return pfq
+}
+
+// WithRequiredBy tells the query-builder to eager-load the nodes that are connected to
+// the "required_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (pfq *ProductFeatureQuery) WithRequiredBy(opts ...func(*ProductFeatureQuery)) *ProductFeatureQuery {
+	query := (&ProductFeatureClient{config: pfq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pfq.withRequiredBy = query
+	return pfq
+}
+
+// WithRequires tells the query-builder to eager-load the nodes that are connected to
+// the "requires" edge. The optional arguments are used to configure the query builder of the edge.
+func (pfq *ProductFeatureQuery) WithRequires(opts ...func(*ProductFeatureQuery)) *ProductFeatureQuery {
+	query := (&ProductFeatureClient{config: pfq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pfq.withRequires = query
+	return pfq
+}
+
+// WithConflicts tells the query-builder to eager-load the nodes that are connected to
+// the "conflicts" edge. The optional arguments are used to configure the query builder of the edge.
+func (pfq *ProductFeatureQuery) WithConflicts(opts ...func(*ProductFeatureQuery)) *ProductFeatureQuery {
+	query := (&ProductFeatureClient{config: pfq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pfq.withConflicts = query
+	return pfq
+}
+
 // WithLicenseTypeFeatures tells the query-builder to eager-load the nodes that are connected to
 // the "license_type_features" edge. The optional arguments are used to configure the query builder of the edge.
 func (pfq *ProductFeatureQuery) WithLicenseTypeFeatures(opts ...func(*LicenseTypeFeaturesQuery)) *ProductFeatureQuery {
@@ -406,12 +550,12 @@ func (pfq *ProductFeatureQuery) WithLicenseTypeFeatures(opts ...func(*LicenseTyp
 // Example:
 //
 //	var v []struct {
-//		FeatureName string `json:"feature_name,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.ProductFeature.Query().
-//		GroupBy(productfeature.FieldFeatureName).
+//		GroupBy(productfeature.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (pfq *ProductFeatureQuery) GroupBy(field string, fields ...string) *ProductFeatureGroupBy {
@@ -429,11 +573,11 @@ func (pfq *ProductFeatureQuery) GroupBy(field string, fields ...string) *Product
 // Example:
 //
 //	var v []struct {
-//		FeatureName string `json:"feature_name,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.ProductFeature.Query().
-//		Select(productfeature.FieldFeatureName).
+//		Select(productfeature.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (pfq *ProductFeatureQuery) Select(fields ...string) *ProductFeatureSelect {
 	pfq.ctx.Fields = append(pfq.ctx.Fields, fields...)
@@ -471,6 +615,12 @@ func (pfq *ProductFeatureQuery) prepareQuery(ctx context.Context) error {
 		}
 		pfq.sql = prev
 	}
+	if productfeature.Policy == nil {
+		return errors.New("ent: uninitialized productfeature.Policy (forgotten import ent/runtime?)")
+	}
+	if err := productfeature.Policy.EvalQuery(ctx, pfq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -478,10 +628,14 @@ func (pfq *ProductFeatureQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	var (
 		nodes       = []*ProductFeature{}
 		_spec       = pfq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [8]bool{
 			pfq.withProduct != nil,
 			pfq.withLicenseTypes != nil,
 			pfq.withSoftwareVersions != nil,
+			pfq.withDeviceOverrides != nil,
+			pfq.withRequiredBy != nil,
+			pfq.withRequires != nil,
+			pfq.withConflicts != nil,
 			pfq.withLicenseTypeFeatures != nil,
 		}
 	)
@@ -494,6 +648,9 @@ func (pfq *ProductFeatureQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(pfq.modifiers) > 0 {
+		_spec.Modifiers = pfq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -525,6 +682,36 @@ func (pfq *ProductFeatureQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := pfq.withDeviceOverrides; query != nil {
+		if err := pfq.loadDeviceOverrides(ctx, query, nodes,
+			func(n *ProductFeature) { n.Edges.DeviceOverrides = []*DeviceFeatureOverride{} },
+			func(n *ProductFeature, e *DeviceFeatureOverride) {
+				n.Edges.DeviceOverrides = append(n.Edges.DeviceOverrides, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := pfq.withRequiredBy; query != nil {
+		if err := pfq.loadRequiredBy(ctx, query, nodes,
+			func(n *ProductFeature) { n.Edges.RequiredBy = []*ProductFeature{} },
+			func(n *ProductFeature, e *ProductFeature) { n.Edges.RequiredBy = append(n.Edges.RequiredBy, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pfq.withRequires; query != nil {
+		if err := pfq.loadRequires(ctx, query, nodes,
+			func(n *ProductFeature) { n.Edges.Requires = []*ProductFeature{} },
+			func(n *ProductFeature, e *ProductFeature) { n.Edges.Requires = append(n.Edges.Requires, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pfq.withConflicts; query != nil {
+		if err := pfq.loadConflicts(ctx, query, nodes,
+			func(n *ProductFeature) { n.Edges.Conflicts = []*ProductFeature{} },
+			func(n *ProductFeature, e *ProductFeature) { n.Edges.Conflicts = append(n.Edges.Conflicts, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := pfq.withLicenseTypeFeatures; query != nil {
 		if err := pfq.loadLicenseTypeFeatures(ctx, query, nodes,
 			func(n *ProductFeature) { n.Edges.LicenseTypeFeatures = []*LicenseTypeFeatures{} },
@@ -688,6 +875,219 @@ func (pfq *ProductFeatureQuery) loadSoftwareVersions(ctx context.Context, query
 	}
 	return nil
 }
+func (pfq *ProductFeatureQuery) loadDeviceOverrides(ctx context.Context, query *DeviceFeatureOverrideQuery, nodes []*ProductFeature, init func(*ProductFeature), assign func(*ProductFeature, *DeviceFeatureOverride)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*ProductFeature)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(devicefeatureoverride.FieldFeatureID)
+	}
+	query.Where(predicate.DeviceFeatureOverride(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(productfeature.DeviceOverridesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FeatureID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "feature_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pfq *ProductFeatureQuery) loadRequiredBy(ctx context.Context, query *ProductFeatureQuery, nodes []*ProductFeature, init func(*ProductFeature), assign func(*ProductFeature, *ProductFeature)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*ProductFeature)
+	nids := make(map[int]map[*ProductFeature]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(productfeature.RequiredByTable)
+		s.Join(joinT).On(s.C(productfeature.FieldID), joinT.C(productfeature.RequiredByPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(productfeature.RequiredByPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(productfeature.RequiredByPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*ProductFeature]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*ProductFeature](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "required_by" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (pfq *ProductFeatureQuery) loadRequires(ctx context.Context, query *ProductFeatureQuery, nodes []*ProductFeature, init func(*ProductFeature), assign func(*ProductFeature, *ProductFeature)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*ProductFeature)
+	nids := make(map[int]map[*ProductFeature]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(productfeature.RequiresTable)
+		s.Join(joinT).On(s.C(productfeature.FieldID), joinT.C(productfeature.RequiresPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(productfeature.RequiresPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(productfeature.RequiresPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*ProductFeature]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*ProductFeature](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "requires" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (pfq *ProductFeatureQuery) loadConflicts(ctx context.Context, query *ProductFeatureQuery, nodes []*ProductFeature, init func(*ProductFeature), assign func(*ProductFeature, *ProductFeature)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*ProductFeature)
+	nids := make(map[int]map[*ProductFeature]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(productfeature.ConflictsTable)
+		s.Join(joinT).On(s.C(productfeature.FieldID), joinT.C(productfeature.ConflictsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(productfeature.ConflictsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(productfeature.ConflictsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*ProductFeature]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*ProductFeature](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "conflicts" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 func (pfq *ProductFeatureQuery) loadLicenseTypeFeatures(ctx context.Context, query *LicenseTypeFeaturesQuery, nodes []*ProductFeature, init func(*ProductFeature), assign func(*ProductFeature, *LicenseTypeFeatures)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*ProductFeature)
@@ -721,6 +1121,9 @@ func (pfq *ProductFeatureQuery) loadLicenseTypeFeatures(ctx context.Context, que
 
 func (pfq *ProductFeatureQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pfq.querySpec()
+	if len(pfq.modifiers) > 0 {
+		_spec.Modifiers = pfq.modifiers
+	}
 	_spec.Node.Columns = pfq.ctx.Fields
 	if len(pfq.ctx.Fields) > 0 {
 		_spec.Unique = pfq.ctx.Unique != nil && *pfq.ctx.Unique
@@ -786,6 +1189,9 @@ func (pfq *ProductFeatureQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if pfq.ctx.Unique != nil && *pfq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range pfq.modifiers {
+		m(selector)
+	}
 	for _, p := range pfq.predicates {
 		p(selector)
 	}
@@ -803,6 +1209,32 @@ func (pfq *ProductFeatureQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pfq *ProductFeatureQuery) ForUpdate(opts ...sql.LockOption) *ProductFeatureQuery {
+	if pfq.driver.Dialect() == dialect.Postgres {
+		pfq.Unique(false)
+	}
+	pfq.modifiers = append(pfq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pfq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pfq *ProductFeatureQuery) ForShare(opts ...sql.LockOption) *ProductFeatureQuery {
+	if pfq.driver.Dialect() == dialect.Postgres {
+		pfq.Unique(false)
+	}
+	pfq.modifiers = append(pfq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pfq
+}
+
 // ProductFeatureGroupBy is the group-by builder for ProductFeature entities.
 type ProductFeatureGroupBy struct {
 	selector
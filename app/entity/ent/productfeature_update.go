@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicefeatureoverride"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetypefeatures"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
@@ -32,6 +33,47 @@ func (pfu *ProductFeatureUpdate) Where(ps ...predicate.ProductFeature) *ProductF
 	return pfu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (pfu *ProductFeatureUpdate) SetDeletedAt(t time.Time) *ProductFeatureUpdate {
+	pfu.mutation.SetDeletedAt(t)
+	return pfu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pfu *ProductFeatureUpdate) SetNillableDeletedAt(t *time.Time) *ProductFeatureUpdate {
+	if t != nil {
+		pfu.SetDeletedAt(*t)
+	}
+	return pfu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pfu *ProductFeatureUpdate) ClearDeletedAt() *ProductFeatureUpdate {
+	pfu.mutation.ClearDeletedAt()
+	return pfu
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (pfu *ProductFeatureUpdate) SetDeletedID(i int) *ProductFeatureUpdate {
+	pfu.mutation.ResetDeletedID()
+	pfu.mutation.SetDeletedID(i)
+	return pfu
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (pfu *ProductFeatureUpdate) SetNillableDeletedID(i *int) *ProductFeatureUpdate {
+	if i != nil {
+		pfu.SetDeletedID(*i)
+	}
+	return pfu
+}
+
+// AddDeletedID adds i to the "deleted_id" field.
+func (pfu *ProductFeatureUpdate) AddDeletedID(i int) *ProductFeatureUpdate {
+	pfu.mutation.AddDeletedID(i)
+	return pfu
+}
+
 // SetFeatureName sets the "feature_name" field.
 func (pfu *ProductFeatureUpdate) SetFeatureName(s string) *ProductFeatureUpdate {
 	pfu.mutation.SetFeatureName(s)
@@ -85,6 +127,66 @@ func (pfu *ProductFeatureUpdate) AddSoftwareVersions(s ...*SoftwareVersion) *Pro
 	return pfu.AddSoftwareVersionIDs(ids...)
 }
 
+// AddDeviceOverrideIDs adds the "device_overrides" edge to the DeviceFeatureOverride entity by IDs.
+func (pfu *ProductFeatureUpdate) AddDeviceOverrideIDs(ids ...int) *ProductFeatureUpdate {
+	pfu.mutation.AddDeviceOverrideIDs(ids...)
+	return pfu
+}
+
+// AddDeviceOverrides adds the "device_overrides" edges to the DeviceFeatureOverride entity.
+func (pfu *ProductFeatureUpdate) AddDeviceOverrides(d ...*DeviceFeatureOverride) *ProductFeatureUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pfu.AddDeviceOverrideIDs(ids...)
+}
+
+// AddRequiredByIDs adds the "required_by" edge to the ProductFeature entity by IDs.
+func (pfu *ProductFeatureUpdate) AddRequiredByIDs(ids ...int) *ProductFeatureUpdate {
+	pfu.mutation.AddRequiredByIDs(ids...)
+	return pfu
+}
+
+// AddRequiredBy adds the "required_by" edges to the ProductFeature entity.
+func (pfu *ProductFeatureUpdate) AddRequiredBy(p ...*ProductFeature) *ProductFeatureUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pfu.AddRequiredByIDs(ids...)
+}
+
+// AddRequireIDs adds the "requires" edge to the ProductFeature entity by IDs.
+func (pfu *ProductFeatureUpdate) AddRequireIDs(ids ...int) *ProductFeatureUpdate {
+	pfu.mutation.AddRequireIDs(ids...)
+	return pfu
+}
+
+// AddRequires adds the "requires" edges to the ProductFeature entity.
+func (pfu *ProductFeatureUpdate) AddRequires(p ...*ProductFeature) *ProductFeatureUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pfu.AddRequireIDs(ids...)
+}
+
+// AddConflictIDs adds the "conflicts" edge to the ProductFeature entity by IDs.
+func (pfu *ProductFeatureUpdate) AddConflictIDs(ids ...int) *ProductFeatureUpdate {
+	pfu.mutation.AddConflictIDs(ids...)
+	return pfu
+}
+
+// AddConflicts adds the "conflicts" edges to the ProductFeature entity.
+func (pfu *ProductFeatureUpdate) AddConflicts(p ...*ProductFeature) *ProductFeatureUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pfu.AddConflictIDs(ids...)
+}
+
 // AddLicenseTypeFeatureIDs adds the "license_type_features" edge to the LicenseTypeFeatures entity by IDs.
 func (pfu *ProductFeatureUpdate) AddLicenseTypeFeatureIDs(ids ...int) *ProductFeatureUpdate {
 	pfu.mutation.AddLicenseTypeFeatureIDs(ids...)
@@ -153,6 +255,90 @@ func (pfu *ProductFeatureUpdate) RemoveSoftwareVersions(s ...*SoftwareVersion) *
 	return pfu.RemoveSoftwareVersionIDs(ids...)
 }
 
+// ClearDeviceOverrides clears all "device_overrides" edges to the DeviceFeatureOverride entity.
+func (pfu *ProductFeatureUpdate) ClearDeviceOverrides() *ProductFeatureUpdate {
+	pfu.mutation.ClearDeviceOverrides()
+	return pfu
+}
+
+// RemoveDeviceOverrideIDs removes the "device_overrides" edge to DeviceFeatureOverride entities by IDs.
+func (pfu *ProductFeatureUpdate) RemoveDeviceOverrideIDs(ids ...int) *ProductFeatureUpdate {
+	pfu.mutation.RemoveDeviceOverrideIDs(ids...)
+	return pfu
+}
+
+// RemoveDeviceOverrides removes "device_overrides" edges to DeviceFeatureOverride entities.
+func (pfu *ProductFeatureUpdate) RemoveDeviceOverrides(d ...*DeviceFeatureOverride) *ProductFeatureUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pfu.RemoveDeviceOverrideIDs(ids...)
+}
+
+// ClearRequiredBy clears all "required_by" edges to the ProductFeature entity.
+func (pfu *ProductFeatureUpdate) ClearRequiredBy() *ProductFeatureUpdate {
+	pfu.mutation.ClearRequiredBy()
+	return pfu
+}
+
+// RemoveRequiredByIDs removes the "required_by" edge to ProductFeature entities by IDs.
+func (pfu *ProductFeatureUpdate) RemoveRequiredByIDs(ids ...int) *ProductFeatureUpdate {
+	pfu.mutation.RemoveRequiredByIDs(ids...)
+	return pfu
+}
+
+// RemoveRequiredBy removes "required_by" edges to ProductFeature entities.
+func (pfu *ProductFeatureUpdate) RemoveRequiredBy(p ...*ProductFeature) *ProductFeatureUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pfu.RemoveRequiredByIDs(ids...)
+}
+
+// ClearRequires clears all "requires" edges to the ProductFeature entity.
+func (pfu *ProductFeatureUpdate) ClearRequires() *ProductFeatureUpdate {
+	pfu.mutation.ClearRequires()
+	return pfu
+}
+
+// RemoveRequireIDs removes the "requires" edge to ProductFeature entities by IDs.
+func (pfu *ProductFeatureUpdate) RemoveRequireIDs(ids ...int) *ProductFeatureUpdate {
+	pfu.mutation.RemoveRequireIDs(ids...)
+	return pfu
+}
+
+// RemoveRequires removes "requires" edges to ProductFeature entities.
+func (pfu *ProductFeatureUpdate) RemoveRequires(p ...*ProductFeature) *ProductFeatureUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pfu.RemoveRequireIDs(ids...)
+}
+
+// ClearConflicts clears all "conflicts" edges to the ProductFeature entity.
+func (pfu *ProductFeatureUpdate) ClearConflicts() *ProductFeatureUpdate {
+	pfu.mutation.ClearConflicts()
+	return pfu
+}
+
+// RemoveConflictIDs removes the "conflicts" edge to ProductFeature entities by IDs.
+func (pfu *ProductFeatureUpdate) RemoveConflictIDs(ids ...int) *ProductFeatureUpdate {
+	pfu.mutation.RemoveConflictIDs(ids...)
+	return pfu
+}
+
+// RemoveConflicts removes "conflicts" edges to ProductFeature entities.
+func (pfu *ProductFeatureUpdate) RemoveConflicts(p ...*ProductFeature) *ProductFeatureUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pfu.RemoveConflictIDs(ids...)
+}
+
 // ClearLicenseTypeFeatures clears all "license_type_features" edges to the LicenseTypeFeatures entity.
 func (pfu *ProductFeatureUpdate) ClearLicenseTypeFeatures() *ProductFeatureUpdate {
 	pfu.mutation.ClearLicenseTypeFeatures()
@@ -176,7 +362,9 @@ func (pfu *ProductFeatureUpdate) RemoveLicenseTypeFeatures(l ...*LicenseTypeFeat
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pfu *ProductFeatureUpdate) Save(ctx context.Context) (int, error) {
-	pfu.defaults()
+	if err := pfu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, pfu.sqlSave, pfu.mutation, pfu.hooks)
 }
 
@@ -203,11 +391,15 @@ func (pfu *ProductFeatureUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (pfu *ProductFeatureUpdate) defaults() {
+func (pfu *ProductFeatureUpdate) defaults() error {
 	if _, ok := pfu.mutation.UpdatedAt(); !ok {
+		if productfeature.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized productfeature.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := productfeature.UpdateDefaultUpdatedAt()
 		pfu.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -235,6 +427,18 @@ func (pfu *ProductFeatureUpdate) sqlSave(ctx context.Context) (n int, err error)
 			}
 		}
 	}
+	if value, ok := pfu.mutation.DeletedAt(); ok {
+		_spec.SetField(productfeature.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pfu.mutation.DeletedAtCleared() {
+		_spec.ClearField(productfeature.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := pfu.mutation.DeletedID(); ok {
+		_spec.SetField(productfeature.FieldDeletedID, field.TypeInt, value)
+	}
+	if value, ok := pfu.mutation.AddedDeletedID(); ok {
+		_spec.AddField(productfeature.FieldDeletedID, field.TypeInt, value)
+	}
 	if value, ok := pfu.mutation.FeatureName(); ok {
 		_spec.SetField(productfeature.FieldFeatureName, field.TypeString, value)
 	}
@@ -372,6 +576,186 @@ func (pfu *ProductFeatureUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pfu.mutation.DeviceOverridesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productfeature.DeviceOverridesTable,
+			Columns: []string{productfeature.DeviceOverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicefeatureoverride.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfu.mutation.RemovedDeviceOverridesIDs(); len(nodes) > 0 && !pfu.mutation.DeviceOverridesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productfeature.DeviceOverridesTable,
+			Columns: []string{productfeature.DeviceOverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicefeatureoverride.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfu.mutation.DeviceOverridesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productfeature.DeviceOverridesTable,
+			Columns: []string{productfeature.DeviceOverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicefeatureoverride.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pfu.mutation.RequiredByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   productfeature.RequiredByTable,
+			Columns: productfeature.RequiredByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfu.mutation.RemovedRequiredByIDs(); len(nodes) > 0 && !pfu.mutation.RequiredByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   productfeature.RequiredByTable,
+			Columns: productfeature.RequiredByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfu.mutation.RequiredByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   productfeature.RequiredByTable,
+			Columns: productfeature.RequiredByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pfu.mutation.RequiresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   productfeature.RequiresTable,
+			Columns: productfeature.RequiresPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfu.mutation.RemovedRequiresIDs(); len(nodes) > 0 && !pfu.mutation.RequiresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   productfeature.RequiresTable,
+			Columns: productfeature.RequiresPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfu.mutation.RequiresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   productfeature.RequiresTable,
+			Columns: productfeature.RequiresPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pfu.mutation.ConflictsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   productfeature.ConflictsTable,
+			Columns: productfeature.ConflictsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfu.mutation.RemovedConflictsIDs(); len(nodes) > 0 && !pfu.mutation.ConflictsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   productfeature.ConflictsTable,
+			Columns: productfeature.ConflictsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfu.mutation.ConflictsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   productfeature.ConflictsTable,
+			Columns: productfeature.ConflictsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if pfu.mutation.LicenseTypeFeaturesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -437,6 +821,47 @@ type ProductFeatureUpdateOne struct {
 	mutation *ProductFeatureMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (pfuo *ProductFeatureUpdateOne) SetDeletedAt(t time.Time) *ProductFeatureUpdateOne {
+	pfuo.mutation.SetDeletedAt(t)
+	return pfuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pfuo *ProductFeatureUpdateOne) SetNillableDeletedAt(t *time.Time) *ProductFeatureUpdateOne {
+	if t != nil {
+		pfuo.SetDeletedAt(*t)
+	}
+	return pfuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pfuo *ProductFeatureUpdateOne) ClearDeletedAt() *ProductFeatureUpdateOne {
+	pfuo.mutation.ClearDeletedAt()
+	return pfuo
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (pfuo *ProductFeatureUpdateOne) SetDeletedID(i int) *ProductFeatureUpdateOne {
+	pfuo.mutation.ResetDeletedID()
+	pfuo.mutation.SetDeletedID(i)
+	return pfuo
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (pfuo *ProductFeatureUpdateOne) SetNillableDeletedID(i *int) *ProductFeatureUpdateOne {
+	if i != nil {
+		pfuo.SetDeletedID(*i)
+	}
+	return pfuo
+}
+
+// AddDeletedID adds i to the "deleted_id" field.
+func (pfuo *ProductFeatureUpdateOne) AddDeletedID(i int) *ProductFeatureUpdateOne {
+	pfuo.mutation.AddDeletedID(i)
+	return pfuo
+}
+
 // SetFeatureName sets the "feature_name" field.
 func (pfuo *ProductFeatureUpdateOne) SetFeatureName(s string) *ProductFeatureUpdateOne {
 	pfuo.mutation.SetFeatureName(s)
@@ -490,6 +915,66 @@ func (pfuo *ProductFeatureUpdateOne) AddSoftwareVersions(s ...*SoftwareVersion)
 	return pfuo.AddSoftwareVersionIDs(ids...)
 }
 
+// AddDeviceOverrideIDs adds the "device_overrides" edge to the DeviceFeatureOverride entity by IDs.
+func (pfuo *ProductFeatureUpdateOne) AddDeviceOverrideIDs(ids ...int) *ProductFeatureUpdateOne {
+	pfuo.mutation.AddDeviceOverrideIDs(ids...)
+	return pfuo
+}
+
+// AddDeviceOverrides adds the "device_overrides" edges to the DeviceFeatureOverride entity.
+func (pfuo *ProductFeatureUpdateOne) AddDeviceOverrides(d ...*DeviceFeatureOverride) *ProductFeatureUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pfuo.AddDeviceOverrideIDs(ids...)
+}
+
+// AddRequiredByIDs adds the "required_by" edge to the ProductFeature entity by IDs.
+func (pfuo *ProductFeatureUpdateOne) AddRequiredByIDs(ids ...int) *ProductFeatureUpdateOne {
+	pfuo.mutation.AddRequiredByIDs(ids...)
+	return pfuo
+}
+
+// AddRequiredBy adds the "required_by" edges to the ProductFeature entity.
+func (pfuo *ProductFeatureUpdateOne) AddRequiredBy(p ...*ProductFeature) *ProductFeatureUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pfuo.AddRequiredByIDs(ids...)
+}
+
+// AddRequireIDs adds the "requires" edge to the ProductFeature entity by IDs.
+func (pfuo *ProductFeatureUpdateOne) AddRequireIDs(ids ...int) *ProductFeatureUpdateOne {
+	pfuo.mutation.AddRequireIDs(ids...)
+	return pfuo
+}
+
+// AddRequires adds the "requires" edges to the ProductFeature entity.
+func (pfuo *ProductFeatureUpdateOne) AddRequires(p ...*ProductFeature) *ProductFeatureUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pfuo.AddRequireIDs(ids...)
+}
+
+// AddConflictIDs adds the "conflicts" edge to the ProductFeature entity by IDs.
+func (pfuo *ProductFeatureUpdateOne) AddConflictIDs(ids ...int) *ProductFeatureUpdateOne {
+	pfuo.mutation.AddConflictIDs(ids...)
+	return pfuo
+}
+
+// AddConflicts adds the "conflicts" edges to the ProductFeature entity.
+func (pfuo *ProductFeatureUpdateOne) AddConflicts(p ...*ProductFeature) *ProductFeatureUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pfuo.AddConflictIDs(ids...)
+}
+
 // AddLicenseTypeFeatureIDs adds the "license_type_features" edge to the LicenseTypeFeatures entity by IDs.
 func (pfuo *ProductFeatureUpdateOne) AddLicenseTypeFeatureIDs(ids ...int) *ProductFeatureUpdateOne {
 	pfuo.mutation.AddLicenseTypeFeatureIDs(ids...)
@@ -558,6 +1043,90 @@ func (pfuo *ProductFeatureUpdateOne) RemoveSoftwareVersions(s ...*SoftwareVersio
 	return pfuo.RemoveSoftwareVersionIDs(ids...)
 }
 
+// ClearDeviceOverrides clears all "device_overrides" edges to the DeviceFeatureOverride entity.
+func (pfuo *ProductFeatureUpdateOne) ClearDeviceOverrides() *ProductFeatureUpdateOne {
+	pfuo.mutation.ClearDeviceOverrides()
+	return pfuo
+}
+
+// RemoveDeviceOverrideIDs removes the "device_overrides" edge to DeviceFeatureOverride entities by IDs.
+func (pfuo *ProductFeatureUpdateOne) RemoveDeviceOverrideIDs(ids ...int) *ProductFeatureUpdateOne {
+	pfuo.mutation.RemoveDeviceOverrideIDs(ids...)
+	return pfuo
+}
+
+// RemoveDeviceOverrides removes "device_overrides" edges to DeviceFeatureOverride entities.
+func (pfuo *ProductFeatureUpdateOne) RemoveDeviceOverrides(d ...*DeviceFeatureOverride) *ProductFeatureUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pfuo.RemoveDeviceOverrideIDs(ids...)
+}
+
+// ClearRequiredBy clears all "required_by" edges to the ProductFeature entity.
+func (pfuo *ProductFeatureUpdateOne) ClearRequiredBy() *ProductFeatureUpdateOne {
+	pfuo.mutation.ClearRequiredBy()
+	return pfuo
+}
+
+// RemoveRequiredByIDs removes the "required_by" edge to ProductFeature entities by IDs.
+func (pfuo *ProductFeatureUpdateOne) RemoveRequiredByIDs(ids ...int) *ProductFeatureUpdateOne {
+	pfuo.mutation.RemoveRequiredByIDs(ids...)
+	return pfuo
+}
+
+// RemoveRequiredBy removes "required_by" edges to ProductFeature entities.
+func (pfuo *ProductFeatureUpdateOne) RemoveRequiredBy(p ...*ProductFeature) *ProductFeatureUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pfuo.RemoveRequiredByIDs(ids...)
+}
+
+// ClearRequires clears all "requires" edges to the ProductFeature entity.
+func (pfuo *ProductFeatureUpdateOne) ClearRequires() *ProductFeatureUpdateOne {
+	pfuo.mutation.ClearRequires()
+	return pfuo
+}
+
+// RemoveRequireIDs removes the "requires" edge to ProductFeature entities by IDs.
+func (pfuo *ProductFeatureUpdateOne) RemoveRequireIDs(ids ...int) *ProductFeatureUpdateOne {
+	pfuo.mutation.RemoveRequireIDs(ids...)
+	return pfuo
+}
+
+// RemoveRequires removes "requires" edges to ProductFeature entities.
+func (pfuo *ProductFeatureUpdateOne) RemoveRequires(p ...*ProductFeature) *ProductFeatureUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pfuo.RemoveRequireIDs(ids...)
+}
+
+// ClearConflicts clears all "conflicts" edges to the ProductFeature entity.
+func (pfuo *ProductFeatureUpdateOne) ClearConflicts() *ProductFeatureUpdateOne {
+	pfuo.mutation.ClearConflicts()
+	return pfuo
+}
+
+// RemoveConflictIDs removes the "conflicts" edge to ProductFeature entities by IDs.
+func (pfuo *ProductFeatureUpdateOne) RemoveConflictIDs(ids ...int) *ProductFeatureUpdateOne {
+	pfuo.mutation.RemoveConflictIDs(ids...)
+	return pfuo
+}
+
+// RemoveConflicts removes "conflicts" edges to ProductFeature entities.
+func (pfuo *ProductFeatureUpdateOne) RemoveConflicts(p ...*ProductFeature) *ProductFeatureUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pfuo.RemoveConflictIDs(ids...)
+}
+
 // ClearLicenseTypeFeatures clears all "license_type_features" edges to the LicenseTypeFeatures entity.
 func (pfuo *ProductFeatureUpdateOne) ClearLicenseTypeFeatures() *ProductFeatureUpdateOne {
 	pfuo.mutation.ClearLicenseTypeFeatures()
@@ -594,7 +1163,9 @@ func (pfuo *ProductFeatureUpdateOne) Select(field string, fields ...string) *Pro
 
 // Save executes the query and returns the updated ProductFeature entity.
 func (pfuo *ProductFeatureUpdateOne) Save(ctx context.Context) (*ProductFeature, error) {
-	pfuo.defaults()
+	if err := pfuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, pfuo.sqlSave, pfuo.mutation, pfuo.hooks)
 }
 
@@ -621,11 +1192,15 @@ func (pfuo *ProductFeatureUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (pfuo *ProductFeatureUpdateOne) defaults() {
+func (pfuo *ProductFeatureUpdateOne) defaults() error {
 	if _, ok := pfuo.mutation.UpdatedAt(); !ok {
+		if productfeature.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized productfeature.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := productfeature.UpdateDefaultUpdatedAt()
 		pfuo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -670,6 +1245,18 @@ func (pfuo *ProductFeatureUpdateOne) sqlSave(ctx context.Context) (_node *Produc
 			}
 		}
 	}
+	if value, ok := pfuo.mutation.DeletedAt(); ok {
+		_spec.SetField(productfeature.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pfuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(productfeature.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := pfuo.mutation.DeletedID(); ok {
+		_spec.SetField(productfeature.FieldDeletedID, field.TypeInt, value)
+	}
+	if value, ok := pfuo.mutation.AddedDeletedID(); ok {
+		_spec.AddField(productfeature.FieldDeletedID, field.TypeInt, value)
+	}
 	if value, ok := pfuo.mutation.FeatureName(); ok {
 		_spec.SetField(productfeature.FieldFeatureName, field.TypeString, value)
 	}
@@ -807,6 +1394,186 @@ func (pfuo *ProductFeatureUpdateOne) sqlSave(ctx context.Context) (_node *Produc
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pfuo.mutation.DeviceOverridesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productfeature.DeviceOverridesTable,
+			Columns: []string{productfeature.DeviceOverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicefeatureoverride.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfuo.mutation.RemovedDeviceOverridesIDs(); len(nodes) > 0 && !pfuo.mutation.DeviceOverridesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productfeature.DeviceOverridesTable,
+			Columns: []string{productfeature.DeviceOverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicefeatureoverride.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfuo.mutation.DeviceOverridesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productfeature.DeviceOverridesTable,
+			Columns: []string{productfeature.DeviceOverridesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicefeatureoverride.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pfuo.mutation.RequiredByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   productfeature.RequiredByTable,
+			Columns: productfeature.RequiredByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfuo.mutation.RemovedRequiredByIDs(); len(nodes) > 0 && !pfuo.mutation.RequiredByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   productfeature.RequiredByTable,
+			Columns: productfeature.RequiredByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfuo.mutation.RequiredByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   productfeature.RequiredByTable,
+			Columns: productfeature.RequiredByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pfuo.mutation.RequiresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   productfeature.RequiresTable,
+			Columns: productfeature.RequiresPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfuo.mutation.RemovedRequiresIDs(); len(nodes) > 0 && !pfuo.mutation.RequiresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   productfeature.RequiresTable,
+			Columns: productfeature.RequiresPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfuo.mutation.RequiresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   productfeature.RequiresTable,
+			Columns: productfeature.RequiresPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pfuo.mutation.ConflictsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   productfeature.ConflictsTable,
+			Columns: productfeature.ConflictsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfuo.mutation.RemovedConflictsIDs(); len(nodes) > 0 && !pfuo.mutation.ConflictsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   productfeature.ConflictsTable,
+			Columns: productfeature.ConflictsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pfuo.mutation.ConflictsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   productfeature.ConflictsTable,
+			Columns: productfeature.ConflictsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productfeature.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if pfuo.mutation.LicenseTypeFeaturesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productinvitation"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductInvitationCreate is the builder for creating a ProductInvitation entity.
+type ProductInvitationCreate struct {
+	config
+	mutation *ProductInvitationMutation
+	hooks    []Hook
+}
+
+// SetProductID sets the "product_id" field.
+func (pic *ProductInvitationCreate) SetProductID(i int) *ProductInvitationCreate {
+	pic.mutation.SetProductID(i)
+	return pic
+}
+
+// SetType sets the "type" field.
+func (pic *ProductInvitationCreate) SetType(pr productinvitation.Type) *ProductInvitationCreate {
+	pic.mutation.SetType(pr)
+	return pic
+}
+
+// SetEmail sets the "email" field.
+func (pic *ProductInvitationCreate) SetEmail(s string) *ProductInvitationCreate {
+	pic.mutation.SetEmail(s)
+	return pic
+}
+
+// SetAccessRole sets the "access_role" field.
+func (pic *ProductInvitationCreate) SetAccessRole(pr productinvitation.AccessRole) *ProductInvitationCreate {
+	pic.mutation.SetAccessRole(pr)
+	return pic
+}
+
+// SetNillableAccessRole sets the "access_role" field if the given value is not nil.
+func (pic *ProductInvitationCreate) SetNillableAccessRole(pr *productinvitation.AccessRole) *ProductInvitationCreate {
+	if pr != nil {
+		pic.SetAccessRole(*pr)
+	}
+	return pic
+}
+
+// SetRemark sets the "remark" field.
+func (pic *ProductInvitationCreate) SetRemark(s string) *ProductInvitationCreate {
+	pic.mutation.SetRemark(s)
+	return pic
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (pic *ProductInvitationCreate) SetNillableRemark(s *string) *ProductInvitationCreate {
+	if s != nil {
+		pic.SetRemark(*s)
+	}
+	return pic
+}
+
+// SetStatus sets the "status" field.
+func (pic *ProductInvitationCreate) SetStatus(pr productinvitation.Status) *ProductInvitationCreate {
+	pic.mutation.SetStatus(pr)
+	return pic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pic *ProductInvitationCreate) SetNillableStatus(pr *productinvitation.Status) *ProductInvitationCreate {
+	if pr != nil {
+		pic.SetStatus(*pr)
+	}
+	return pic
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (pic *ProductInvitationCreate) SetInvitedBy(i int) *ProductInvitationCreate {
+	pic.mutation.SetInvitedBy(i)
+	return pic
+}
+
+// SetRespondedBy sets the "responded_by" field.
+func (pic *ProductInvitationCreate) SetRespondedBy(i int) *ProductInvitationCreate {
+	pic.mutation.SetRespondedBy(i)
+	return pic
+}
+
+// SetNillableRespondedBy sets the "responded_by" field if the given value is not nil.
+func (pic *ProductInvitationCreate) SetNillableRespondedBy(i *int) *ProductInvitationCreate {
+	if i != nil {
+		pic.SetRespondedBy(*i)
+	}
+	return pic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (pic *ProductInvitationCreate) SetExpiresAt(t time.Time) *ProductInvitationCreate {
+	pic.mutation.SetExpiresAt(t)
+	return pic
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (pic *ProductInvitationCreate) SetRespondedAt(t time.Time) *ProductInvitationCreate {
+	pic.mutation.SetRespondedAt(t)
+	return pic
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (pic *ProductInvitationCreate) SetNillableRespondedAt(t *time.Time) *ProductInvitationCreate {
+	if t != nil {
+		pic.SetRespondedAt(*t)
+	}
+	return pic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pic *ProductInvitationCreate) SetCreatedAt(t time.Time) *ProductInvitationCreate {
+	pic.mutation.SetCreatedAt(t)
+	return pic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pic *ProductInvitationCreate) SetNillableCreatedAt(t *time.Time) *ProductInvitationCreate {
+	if t != nil {
+		pic.SetCreatedAt(*t)
+	}
+	return pic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pic *ProductInvitationCreate) SetUpdatedAt(t time.Time) *ProductInvitationCreate {
+	pic.mutation.SetUpdatedAt(t)
+	return pic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pic *ProductInvitationCreate) SetNillableUpdatedAt(t *time.Time) *ProductInvitationCreate {
+	if t != nil {
+		pic.SetUpdatedAt(*t)
+	}
+	return pic
+}
+
+// SetID sets the "id" field.
+func (pic *ProductInvitationCreate) SetID(i int) *ProductInvitationCreate {
+	pic.mutation.SetID(i)
+	return pic
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (pic *ProductInvitationCreate) SetProduct(p *Product) *ProductInvitationCreate {
+	return pic.SetProductID(p.ID)
+}
+
+// SetInviterID sets the "inviter" edge to the User entity by ID.
+func (pic *ProductInvitationCreate) SetInviterID(id int) *ProductInvitationCreate {
+	pic.mutation.SetInviterID(id)
+	return pic
+}
+
+// SetInviter sets the "inviter" edge to the User entity.
+func (pic *ProductInvitationCreate) SetInviter(u *User) *ProductInvitationCreate {
+	return pic.SetInviterID(u.ID)
+}
+
+// Mutation returns the ProductInvitationMutation object of the builder.
+func (pic *ProductInvitationCreate) Mutation() *ProductInvitationMutation {
+	return pic.mutation
+}
+
+// Save creates the ProductInvitation in the database.
+func (pic *ProductInvitationCreate) Save(ctx context.Context) (*ProductInvitation, error) {
+	pic.defaults()
+	return withHooks(ctx, pic.sqlSave, pic.mutation, pic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pic *ProductInvitationCreate) SaveX(ctx context.Context) *ProductInvitation {
+	v, err := pic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pic *ProductInvitationCreate) Exec(ctx context.Context) error {
+	_, err := pic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pic *ProductInvitationCreate) ExecX(ctx context.Context) {
+	if err := pic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pic *ProductInvitationCreate) defaults() {
+	if _, ok := pic.mutation.Remark(); !ok {
+		v := productinvitation.DefaultRemark
+		pic.mutation.SetRemark(v)
+	}
+	if _, ok := pic.mutation.Status(); !ok {
+		v := productinvitation.DefaultStatus
+		pic.mutation.SetStatus(v)
+	}
+	if _, ok := pic.mutation.CreatedAt(); !ok {
+		v := productinvitation.DefaultCreatedAt()
+		pic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pic.mutation.UpdatedAt(); !ok {
+		v := productinvitation.DefaultUpdatedAt()
+		pic.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pic *ProductInvitationCreate) check() error {
+	if _, ok := pic.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "ProductInvitation.product_id"`)}
+	}
+	if _, ok := pic.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ProductInvitation.type"`)}
+	}
+	if v, ok := pic.mutation.GetType(); ok {
+		if err := productinvitation.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ProductInvitation.type": %w`, err)}
+		}
+	}
+	if _, ok := pic.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "ProductInvitation.email"`)}
+	}
+	if v, ok := pic.mutation.Email(); ok {
+		if err := productinvitation.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "ProductInvitation.email": %w`, err)}
+		}
+	}
+	if v, ok := pic.mutation.AccessRole(); ok {
+		if err := productinvitation.AccessRoleValidator(v); err != nil {
+			return &ValidationError{Name: "access_role", err: fmt.Errorf(`ent: validator failed for field "ProductInvitation.access_role": %w`, err)}
+		}
+	}
+	if _, ok := pic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ProductInvitation.status"`)}
+	}
+	if v, ok := pic.mutation.Status(); ok {
+		if err := productinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProductInvitation.status": %w`, err)}
+		}
+	}
+	if _, ok := pic.mutation.InvitedBy(); !ok {
+		return &ValidationError{Name: "invited_by", err: errors.New(`ent: missing required field "ProductInvitation.invited_by"`)}
+	}
+	if _, ok := pic.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ProductInvitation.expires_at"`)}
+	}
+	if _, ok := pic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductInvitation.created_at"`)}
+	}
+	if _, ok := pic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProductInvitation.updated_at"`)}
+	}
+	if v, ok := pic.mutation.ID(); ok {
+		if err := productinvitation.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ProductInvitation.id": %w`, err)}
+		}
+	}
+	if _, ok := pic.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product", err: errors.New(`ent: missing required edge "ProductInvitation.product"`)}
+	}
+	if _, ok := pic.mutation.InviterID(); !ok {
+		return &ValidationError{Name: "inviter", err: errors.New(`ent: missing required edge "ProductInvitation.inviter"`)}
+	}
+	return nil
+}
+
+func (pic *ProductInvitationCreate) sqlSave(ctx context.Context) (*ProductInvitation, error) {
+	if err := pic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	pic.mutation.id = &_node.ID
+	pic.mutation.done = true
+	return _node, nil
+}
+
+func (pic *ProductInvitationCreate) createSpec() (*ProductInvitation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProductInvitation{config: pic.config}
+		_spec = sqlgraph.NewCreateSpec(productinvitation.Table, sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt))
+	)
+	if id, ok := pic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pic.mutation.GetType(); ok {
+		_spec.SetField(productinvitation.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := pic.mutation.Email(); ok {
+		_spec.SetField(productinvitation.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := pic.mutation.AccessRole(); ok {
+		_spec.SetField(productinvitation.FieldAccessRole, field.TypeEnum, value)
+		_node.AccessRole = &value
+	}
+	if value, ok := pic.mutation.Remark(); ok {
+		_spec.SetField(productinvitation.FieldRemark, field.TypeString, value)
+		_node.Remark = value
+	}
+	if value, ok := pic.mutation.Status(); ok {
+		_spec.SetField(productinvitation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := pic.mutation.RespondedBy(); ok {
+		_spec.SetField(productinvitation.FieldRespondedBy, field.TypeInt, value)
+		_node.RespondedBy = &value
+	}
+	if value, ok := pic.mutation.ExpiresAt(); ok {
+		_spec.SetField(productinvitation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := pic.mutation.RespondedAt(); ok {
+		_spec.SetField(productinvitation.FieldRespondedAt, field.TypeTime, value)
+		_node.RespondedAt = &value
+	}
+	if value, ok := pic.mutation.CreatedAt(); ok {
+		_spec.SetField(productinvitation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pic.mutation.UpdatedAt(); ok {
+		_spec.SetField(productinvitation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := pic.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productinvitation.ProductTable,
+			Columns: []string{productinvitation.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProductID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pic.mutation.InviterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productinvitation.InviterTable,
+			Columns: []string{productinvitation.InviterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InvitedBy = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProductInvitationCreateBulk is the builder for creating many ProductInvitation entities in bulk.
+type ProductInvitationCreateBulk struct {
+	config
+	err      error
+	builders []*ProductInvitationCreate
+}
+
+// Save creates the ProductInvitation entities in the database.
+func (picb *ProductInvitationCreateBulk) Save(ctx context.Context) ([]*ProductInvitation, error) {
+	if picb.err != nil {
+		return nil, picb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(picb.builders))
+	nodes := make([]*ProductInvitation, len(picb.builders))
+	mutators := make([]Mutator, len(picb.builders))
+	for i := range picb.builders {
+		func(i int, root context.Context) {
+			builder := picb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductInvitationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, picb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, picb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, picb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (picb *ProductInvitationCreateBulk) SaveX(ctx context.Context) []*ProductInvitation {
+	v, err := picb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (picb *ProductInvitationCreateBulk) Exec(ctx context.Context) error {
+	_, err := picb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (picb *ProductInvitationCreateBulk) ExecX(ctx context.Context) {
+	if err := picb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
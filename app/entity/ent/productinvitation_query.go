@@ -0,0 +1,717 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productinvitation"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductInvitationQuery is the builder for querying ProductInvitation entities.
+type ProductInvitationQuery struct {
+	config
+	ctx         *QueryContext
+	order       []productinvitation.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ProductInvitation
+	withProduct *ProductQuery
+	withInviter *UserQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProductInvitationQuery builder.
+func (piq *ProductInvitationQuery) Where(ps ...predicate.ProductInvitation) *ProductInvitationQuery {
+	piq.predicates = append(piq.predicates, ps...)
+	return piq
+}
+
+// Limit the number of records to be returned by this query.
+func (piq *ProductInvitationQuery) Limit(limit int) *ProductInvitationQuery {
+	piq.ctx.Limit = &limit
+	return piq
+}
+
+// Offset to start from.
+func (piq *ProductInvitationQuery) Offset(offset int) *ProductInvitationQuery {
+	piq.ctx.Offset = &offset
+	return piq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (piq *ProductInvitationQuery) Unique(unique bool) *ProductInvitationQuery {
+	piq.ctx.Unique = &unique
+	return piq
+}
+
+// Order specifies how the records should be ordered.
+func (piq *ProductInvitationQuery) Order(o ...productinvitation.OrderOption) *ProductInvitationQuery {
+	piq.order = append(piq.order, o...)
+	return piq
+}
+
+// QueryProduct chains the current query on the "product" edge.
+func (piq *ProductInvitationQuery) QueryProduct() *ProductQuery {
+	query := (&ProductClient{config: piq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := piq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := piq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productinvitation.Table, productinvitation.FieldID, selector),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productinvitation.ProductTable, productinvitation.ProductColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(piq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInviter chains the current query on the "inviter" edge.
+func (piq *ProductInvitationQuery) QueryInviter() *UserQuery {
+	query := (&UserClient{config: piq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := piq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := piq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productinvitation.Table, productinvitation.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productinvitation.InviterTable, productinvitation.InviterColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(piq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProductInvitation entity from the query.
+// Returns a *NotFoundError when no ProductInvitation was found.
+func (piq *ProductInvitationQuery) First(ctx context.Context) (*ProductInvitation, error) {
+	nodes, err := piq.Limit(1).All(setContextOp(ctx, piq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{productinvitation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (piq *ProductInvitationQuery) FirstX(ctx context.Context) *ProductInvitation {
+	node, err := piq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProductInvitation ID from the query.
+// Returns a *NotFoundError when no ProductInvitation ID was found.
+func (piq *ProductInvitationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = piq.Limit(1).IDs(setContextOp(ctx, piq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{productinvitation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (piq *ProductInvitationQuery) FirstIDX(ctx context.Context) int {
+	id, err := piq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProductInvitation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProductInvitation entity is found.
+// Returns a *NotFoundError when no ProductInvitation entities are found.
+func (piq *ProductInvitationQuery) Only(ctx context.Context) (*ProductInvitation, error) {
+	nodes, err := piq.Limit(2).All(setContextOp(ctx, piq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{productinvitation.Label}
+	default:
+		return nil, &NotSingularError{productinvitation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (piq *ProductInvitationQuery) OnlyX(ctx context.Context) *ProductInvitation {
+	node, err := piq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProductInvitation ID in the query.
+// Returns a *NotSingularError when more than one ProductInvitation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (piq *ProductInvitationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = piq.Limit(2).IDs(setContextOp(ctx, piq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{productinvitation.Label}
+	default:
+		err = &NotSingularError{productinvitation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (piq *ProductInvitationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := piq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProductInvitations.
+func (piq *ProductInvitationQuery) All(ctx context.Context) ([]*ProductInvitation, error) {
+	ctx = setContextOp(ctx, piq.ctx, "All")
+	if err := piq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProductInvitation, *ProductInvitationQuery]()
+	return withInterceptors[[]*ProductInvitation](ctx, piq, qr, piq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (piq *ProductInvitationQuery) AllX(ctx context.Context) []*ProductInvitation {
+	nodes, err := piq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProductInvitation IDs.
+func (piq *ProductInvitationQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if piq.ctx.Unique == nil && piq.path != nil {
+		piq.Unique(true)
+	}
+	ctx = setContextOp(ctx, piq.ctx, "IDs")
+	if err = piq.Select(productinvitation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (piq *ProductInvitationQuery) IDsX(ctx context.Context) []int {
+	ids, err := piq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (piq *ProductInvitationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, piq.ctx, "Count")
+	if err := piq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, piq, querierCount[*ProductInvitationQuery](), piq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (piq *ProductInvitationQuery) CountX(ctx context.Context) int {
+	count, err := piq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (piq *ProductInvitationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, piq.ctx, "Exist")
+	switch _, err := piq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (piq *ProductInvitationQuery) ExistX(ctx context.Context) bool {
+	exist, err := piq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProductInvitationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (piq *ProductInvitationQuery) Clone() *ProductInvitationQuery {
+	if piq == nil {
+		return nil
+	}
+	return &ProductInvitationQuery{
+		config:      piq.config,
+		ctx:         piq.ctx.Clone(),
+		order:       append([]productinvitation.OrderOption{}, piq.order...),
+		inters:      append([]Interceptor{}, piq.inters...),
+		predicates:  append([]predicate.ProductInvitation{}, piq.predicates...),
+		withProduct: piq.withProduct.Clone(),
+		withInviter: piq.withInviter.Clone(),
+		// clone intermediate query.
+		sql:  piq.sql.Clone(),
+		path: piq.path,
+	}
+}
+
+// WithProduct tells the query-builder to eager-load the nodes that are connected to
+// the "product" edge. The optional arguments are used to configure the query builder of the edge.
+func (piq *ProductInvitationQuery) WithProduct(opts ...func(*ProductQuery)) *ProductInvitationQuery {
+	query := (&ProductClient{config: piq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	piq.withProduct = query
+	return piq
+}
+
+// WithInviter tells the query-builder to eager-load the nodes that are connected to
+// the "inviter" edge. The optional arguments are used to configure the query builder of the edge.
+func (piq *ProductInvitationQuery) WithInviter(opts ...func(*UserQuery)) *ProductInvitationQuery {
+	query := (&UserClient{config: piq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	piq.withInviter = query
+	return piq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProductID int `json:"product_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProductInvitation.Query().
+//		GroupBy(productinvitation.FieldProductID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (piq *ProductInvitationQuery) GroupBy(field string, fields ...string) *ProductInvitationGroupBy {
+	piq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProductInvitationGroupBy{build: piq}
+	grbuild.flds = &piq.ctx.Fields
+	grbuild.label = productinvitation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProductID int `json:"product_id,omitempty"`
+//	}
+//
+//	client.ProductInvitation.Query().
+//		Select(productinvitation.FieldProductID).
+//		Scan(ctx, &v)
+func (piq *ProductInvitationQuery) Select(fields ...string) *ProductInvitationSelect {
+	piq.ctx.Fields = append(piq.ctx.Fields, fields...)
+	sbuild := &ProductInvitationSelect{ProductInvitationQuery: piq}
+	sbuild.label = productinvitation.Label
+	sbuild.flds, sbuild.scan = &piq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProductInvitationSelect configured with the given aggregations.
+func (piq *ProductInvitationQuery) Aggregate(fns ...AggregateFunc) *ProductInvitationSelect {
+	return piq.Select().Aggregate(fns...)
+}
+
+func (piq *ProductInvitationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range piq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, piq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range piq.ctx.Fields {
+		if !productinvitation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if piq.path != nil {
+		prev, err := piq.path(ctx)
+		if err != nil {
+			return err
+		}
+		piq.sql = prev
+	}
+	return nil
+}
+
+func (piq *ProductInvitationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProductInvitation, error) {
+	var (
+		nodes       = []*ProductInvitation{}
+		_spec       = piq.querySpec()
+		loadedTypes = [2]bool{
+			piq.withProduct != nil,
+			piq.withInviter != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProductInvitation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProductInvitation{config: piq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(piq.modifiers) > 0 {
+		_spec.Modifiers = piq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, piq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := piq.withProduct; query != nil {
+		if err := piq.loadProduct(ctx, query, nodes, nil,
+			func(n *ProductInvitation, e *Product) { n.Edges.Product = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := piq.withInviter; query != nil {
+		if err := piq.loadInviter(ctx, query, nodes, nil,
+			func(n *ProductInvitation, e *User) { n.Edges.Inviter = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (piq *ProductInvitationQuery) loadProduct(ctx context.Context, query *ProductQuery, nodes []*ProductInvitation, init func(*ProductInvitation), assign func(*ProductInvitation, *Product)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ProductInvitation)
+	for i := range nodes {
+		fk := nodes[i].ProductID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(product.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "product_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (piq *ProductInvitationQuery) loadInviter(ctx context.Context, query *UserQuery, nodes []*ProductInvitation, init func(*ProductInvitation), assign func(*ProductInvitation, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ProductInvitation)
+	for i := range nodes {
+		fk := nodes[i].InvitedBy
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "invited_by" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (piq *ProductInvitationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := piq.querySpec()
+	if len(piq.modifiers) > 0 {
+		_spec.Modifiers = piq.modifiers
+	}
+	_spec.Node.Columns = piq.ctx.Fields
+	if len(piq.ctx.Fields) > 0 {
+		_spec.Unique = piq.ctx.Unique != nil && *piq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, piq.driver, _spec)
+}
+
+func (piq *ProductInvitationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(productinvitation.Table, productinvitation.Columns, sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt))
+	_spec.From = piq.sql
+	if unique := piq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if piq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := piq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productinvitation.FieldID)
+		for i := range fields {
+			if fields[i] != productinvitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if piq.withProduct != nil {
+			_spec.Node.AddColumnOnce(productinvitation.FieldProductID)
+		}
+		if piq.withInviter != nil {
+			_spec.Node.AddColumnOnce(productinvitation.FieldInvitedBy)
+		}
+	}
+	if ps := piq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := piq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := piq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := piq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (piq *ProductInvitationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(piq.driver.Dialect())
+	t1 := builder.Table(productinvitation.Table)
+	columns := piq.ctx.Fields
+	if len(columns) == 0 {
+		columns = productinvitation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if piq.sql != nil {
+		selector = piq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if piq.ctx.Unique != nil && *piq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range piq.modifiers {
+		m(selector)
+	}
+	for _, p := range piq.predicates {
+		p(selector)
+	}
+	for _, p := range piq.order {
+		p(selector)
+	}
+	if offset := piq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := piq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (piq *ProductInvitationQuery) ForUpdate(opts ...sql.LockOption) *ProductInvitationQuery {
+	if piq.driver.Dialect() == dialect.Postgres {
+		piq.Unique(false)
+	}
+	piq.modifiers = append(piq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return piq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (piq *ProductInvitationQuery) ForShare(opts ...sql.LockOption) *ProductInvitationQuery {
+	if piq.driver.Dialect() == dialect.Postgres {
+		piq.Unique(false)
+	}
+	piq.modifiers = append(piq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return piq
+}
+
+// ProductInvitationGroupBy is the group-by builder for ProductInvitation entities.
+type ProductInvitationGroupBy struct {
+	selector
+	build *ProductInvitationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pigb *ProductInvitationGroupBy) Aggregate(fns ...AggregateFunc) *ProductInvitationGroupBy {
+	pigb.fns = append(pigb.fns, fns...)
+	return pigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pigb *ProductInvitationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pigb.build.ctx, "GroupBy")
+	if err := pigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProductInvitationQuery, *ProductInvitationGroupBy](ctx, pigb.build, pigb, pigb.build.inters, v)
+}
+
+func (pigb *ProductInvitationGroupBy) sqlScan(ctx context.Context, root *ProductInvitationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pigb.fns))
+	for _, fn := range pigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pigb.flds)+len(pigb.fns))
+		for _, f := range *pigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProductInvitationSelect is the builder for selecting fields of ProductInvitation entities.
+type ProductInvitationSelect struct {
+	*ProductInvitationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pis *ProductInvitationSelect) Aggregate(fns ...AggregateFunc) *ProductInvitationSelect {
+	pis.fns = append(pis.fns, fns...)
+	return pis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pis *ProductInvitationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pis.ctx, "Select")
+	if err := pis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProductInvitationQuery, *ProductInvitationSelect](ctx, pis.ProductInvitationQuery, pis, pis.inters, v)
+}
+
+func (pis *ProductInvitationSelect) sqlScan(ctx context.Context, root *ProductInvitationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pis.fns))
+	for _, fn := range pis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
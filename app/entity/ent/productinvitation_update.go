@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productinvitation"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductInvitationUpdate is the builder for updating ProductInvitation entities.
+type ProductInvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductInvitationMutation
+}
+
+// Where appends a list predicates to the ProductInvitationUpdate builder.
+func (piu *ProductInvitationUpdate) Where(ps ...predicate.ProductInvitation) *ProductInvitationUpdate {
+	piu.mutation.Where(ps...)
+	return piu
+}
+
+// SetStatus sets the "status" field.
+func (piu *ProductInvitationUpdate) SetStatus(pr productinvitation.Status) *ProductInvitationUpdate {
+	piu.mutation.SetStatus(pr)
+	return piu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (piu *ProductInvitationUpdate) SetNillableStatus(pr *productinvitation.Status) *ProductInvitationUpdate {
+	if pr != nil {
+		piu.SetStatus(*pr)
+	}
+	return piu
+}
+
+// SetRespondedBy sets the "responded_by" field.
+func (piu *ProductInvitationUpdate) SetRespondedBy(i int) *ProductInvitationUpdate {
+	piu.mutation.ResetRespondedBy()
+	piu.mutation.SetRespondedBy(i)
+	return piu
+}
+
+// SetNillableRespondedBy sets the "responded_by" field if the given value is not nil.
+func (piu *ProductInvitationUpdate) SetNillableRespondedBy(i *int) *ProductInvitationUpdate {
+	if i != nil {
+		piu.SetRespondedBy(*i)
+	}
+	return piu
+}
+
+// AddRespondedBy adds i to the "responded_by" field.
+func (piu *ProductInvitationUpdate) AddRespondedBy(i int) *ProductInvitationUpdate {
+	piu.mutation.AddRespondedBy(i)
+	return piu
+}
+
+// ClearRespondedBy clears the value of the "responded_by" field.
+func (piu *ProductInvitationUpdate) ClearRespondedBy() *ProductInvitationUpdate {
+	piu.mutation.ClearRespondedBy()
+	return piu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (piu *ProductInvitationUpdate) SetExpiresAt(t time.Time) *ProductInvitationUpdate {
+	piu.mutation.SetExpiresAt(t)
+	return piu
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (piu *ProductInvitationUpdate) SetRespondedAt(t time.Time) *ProductInvitationUpdate {
+	piu.mutation.SetRespondedAt(t)
+	return piu
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (piu *ProductInvitationUpdate) SetNillableRespondedAt(t *time.Time) *ProductInvitationUpdate {
+	if t != nil {
+		piu.SetRespondedAt(*t)
+	}
+	return piu
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (piu *ProductInvitationUpdate) ClearRespondedAt() *ProductInvitationUpdate {
+	piu.mutation.ClearRespondedAt()
+	return piu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (piu *ProductInvitationUpdate) SetUpdatedAt(t time.Time) *ProductInvitationUpdate {
+	piu.mutation.SetUpdatedAt(t)
+	return piu
+}
+
+// Mutation returns the ProductInvitationMutation object of the builder.
+func (piu *ProductInvitationUpdate) Mutation() *ProductInvitationMutation {
+	return piu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (piu *ProductInvitationUpdate) Save(ctx context.Context) (int, error) {
+	piu.defaults()
+	return withHooks(ctx, piu.sqlSave, piu.mutation, piu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (piu *ProductInvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := piu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (piu *ProductInvitationUpdate) Exec(ctx context.Context) error {
+	_, err := piu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (piu *ProductInvitationUpdate) ExecX(ctx context.Context) {
+	if err := piu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (piu *ProductInvitationUpdate) defaults() {
+	if _, ok := piu.mutation.UpdatedAt(); !ok {
+		v := productinvitation.UpdateDefaultUpdatedAt()
+		piu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (piu *ProductInvitationUpdate) check() error {
+	if v, ok := piu.mutation.Status(); ok {
+		if err := productinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProductInvitation.status": %w`, err)}
+		}
+	}
+	if _, ok := piu.mutation.ProductID(); piu.mutation.ProductCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ProductInvitation.product"`)
+	}
+	if _, ok := piu.mutation.InviterID(); piu.mutation.InviterCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ProductInvitation.inviter"`)
+	}
+	return nil
+}
+
+func (piu *ProductInvitationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := piu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productinvitation.Table, productinvitation.Columns, sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt))
+	if ps := piu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if piu.mutation.AccessRoleCleared() {
+		_spec.ClearField(productinvitation.FieldAccessRole, field.TypeEnum)
+	}
+	if piu.mutation.RemarkCleared() {
+		_spec.ClearField(productinvitation.FieldRemark, field.TypeString)
+	}
+	if value, ok := piu.mutation.Status(); ok {
+		_spec.SetField(productinvitation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := piu.mutation.RespondedBy(); ok {
+		_spec.SetField(productinvitation.FieldRespondedBy, field.TypeInt, value)
+	}
+	if value, ok := piu.mutation.AddedRespondedBy(); ok {
+		_spec.AddField(productinvitation.FieldRespondedBy, field.TypeInt, value)
+	}
+	if piu.mutation.RespondedByCleared() {
+		_spec.ClearField(productinvitation.FieldRespondedBy, field.TypeInt)
+	}
+	if value, ok := piu.mutation.ExpiresAt(); ok {
+		_spec.SetField(productinvitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := piu.mutation.RespondedAt(); ok {
+		_spec.SetField(productinvitation.FieldRespondedAt, field.TypeTime, value)
+	}
+	if piu.mutation.RespondedAtCleared() {
+		_spec.ClearField(productinvitation.FieldRespondedAt, field.TypeTime)
+	}
+	if value, ok := piu.mutation.UpdatedAt(); ok {
+		_spec.SetField(productinvitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, piu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	piu.mutation.done = true
+	return n, nil
+}
+
+// ProductInvitationUpdateOne is the builder for updating a single ProductInvitation entity.
+type ProductInvitationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductInvitationMutation
+}
+
+// SetStatus sets the "status" field.
+func (piuo *ProductInvitationUpdateOne) SetStatus(pr productinvitation.Status) *ProductInvitationUpdateOne {
+	piuo.mutation.SetStatus(pr)
+	return piuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (piuo *ProductInvitationUpdateOne) SetNillableStatus(pr *productinvitation.Status) *ProductInvitationUpdateOne {
+	if pr != nil {
+		piuo.SetStatus(*pr)
+	}
+	return piuo
+}
+
+// SetRespondedBy sets the "responded_by" field.
+func (piuo *ProductInvitationUpdateOne) SetRespondedBy(i int) *ProductInvitationUpdateOne {
+	piuo.mutation.ResetRespondedBy()
+	piuo.mutation.SetRespondedBy(i)
+	return piuo
+}
+
+// SetNillableRespondedBy sets the "responded_by" field if the given value is not nil.
+func (piuo *ProductInvitationUpdateOne) SetNillableRespondedBy(i *int) *ProductInvitationUpdateOne {
+	if i != nil {
+		piuo.SetRespondedBy(*i)
+	}
+	return piuo
+}
+
+// AddRespondedBy adds i to the "responded_by" field.
+func (piuo *ProductInvitationUpdateOne) AddRespondedBy(i int) *ProductInvitationUpdateOne {
+	piuo.mutation.AddRespondedBy(i)
+	return piuo
+}
+
+// ClearRespondedBy clears the value of the "responded_by" field.
+func (piuo *ProductInvitationUpdateOne) ClearRespondedBy() *ProductInvitationUpdateOne {
+	piuo.mutation.ClearRespondedBy()
+	return piuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (piuo *ProductInvitationUpdateOne) SetExpiresAt(t time.Time) *ProductInvitationUpdateOne {
+	piuo.mutation.SetExpiresAt(t)
+	return piuo
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (piuo *ProductInvitationUpdateOne) SetRespondedAt(t time.Time) *ProductInvitationUpdateOne {
+	piuo.mutation.SetRespondedAt(t)
+	return piuo
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (piuo *ProductInvitationUpdateOne) SetNillableRespondedAt(t *time.Time) *ProductInvitationUpdateOne {
+	if t != nil {
+		piuo.SetRespondedAt(*t)
+	}
+	return piuo
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (piuo *ProductInvitationUpdateOne) ClearRespondedAt() *ProductInvitationUpdateOne {
+	piuo.mutation.ClearRespondedAt()
+	return piuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (piuo *ProductInvitationUpdateOne) SetUpdatedAt(t time.Time) *ProductInvitationUpdateOne {
+	piuo.mutation.SetUpdatedAt(t)
+	return piuo
+}
+
+// Mutation returns the ProductInvitationMutation object of the builder.
+func (piuo *ProductInvitationUpdateOne) Mutation() *ProductInvitationMutation {
+	return piuo.mutation
+}
+
+// Where appends a list predicates to the ProductInvitationUpdate builder.
+func (piuo *ProductInvitationUpdateOne) Where(ps ...predicate.ProductInvitation) *ProductInvitationUpdateOne {
+	piuo.mutation.Where(ps...)
+	return piuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (piuo *ProductInvitationUpdateOne) Select(field string, fields ...string) *ProductInvitationUpdateOne {
+	piuo.fields = append([]string{field}, fields...)
+	return piuo
+}
+
+// Save executes the query and returns the updated ProductInvitation entity.
+func (piuo *ProductInvitationUpdateOne) Save(ctx context.Context) (*ProductInvitation, error) {
+	piuo.defaults()
+	return withHooks(ctx, piuo.sqlSave, piuo.mutation, piuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (piuo *ProductInvitationUpdateOne) SaveX(ctx context.Context) *ProductInvitation {
+	node, err := piuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (piuo *ProductInvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := piuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (piuo *ProductInvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := piuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (piuo *ProductInvitationUpdateOne) defaults() {
+	if _, ok := piuo.mutation.UpdatedAt(); !ok {
+		v := productinvitation.UpdateDefaultUpdatedAt()
+		piuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (piuo *ProductInvitationUpdateOne) check() error {
+	if v, ok := piuo.mutation.Status(); ok {
+		if err := productinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProductInvitation.status": %w`, err)}
+		}
+	}
+	if _, ok := piuo.mutation.ProductID(); piuo.mutation.ProductCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ProductInvitation.product"`)
+	}
+	if _, ok := piuo.mutation.InviterID(); piuo.mutation.InviterCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ProductInvitation.inviter"`)
+	}
+	return nil
+}
+
+func (piuo *ProductInvitationUpdateOne) sqlSave(ctx context.Context) (_node *ProductInvitation, err error) {
+	if err := piuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productinvitation.Table, productinvitation.Columns, sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt))
+	id, ok := piuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductInvitation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := piuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productinvitation.FieldID)
+		for _, f := range fields {
+			if !productinvitation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productinvitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := piuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if piuo.mutation.AccessRoleCleared() {
+		_spec.ClearField(productinvitation.FieldAccessRole, field.TypeEnum)
+	}
+	if piuo.mutation.RemarkCleared() {
+		_spec.ClearField(productinvitation.FieldRemark, field.TypeString)
+	}
+	if value, ok := piuo.mutation.Status(); ok {
+		_spec.SetField(productinvitation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := piuo.mutation.RespondedBy(); ok {
+		_spec.SetField(productinvitation.FieldRespondedBy, field.TypeInt, value)
+	}
+	if value, ok := piuo.mutation.AddedRespondedBy(); ok {
+		_spec.AddField(productinvitation.FieldRespondedBy, field.TypeInt, value)
+	}
+	if piuo.mutation.RespondedByCleared() {
+		_spec.ClearField(productinvitation.FieldRespondedBy, field.TypeInt)
+	}
+	if value, ok := piuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(productinvitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := piuo.mutation.RespondedAt(); ok {
+		_spec.SetField(productinvitation.FieldRespondedAt, field.TypeTime, value)
+	}
+	if piuo.mutation.RespondedAtCleared() {
+		_spec.ClearField(productinvitation.FieldRespondedAt, field.TypeTime)
+	}
+	if value, ok := piuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(productinvitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ProductInvitation{config: piuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, piuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	piuo.mutation.done = true
+	return _node, nil
+}
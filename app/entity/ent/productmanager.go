@@ -27,6 +27,8 @@ type ProductManager struct {
 	ProductID int `json:"product_id,omitempty"`
 	// 权限：只读、完全，【主管理员和super_user(id:0)不受此字段限制】
 	Permissions productmanager.Permissions `json:"permissions,omitempty"`
+	// 产品内角色，为空时按role和permissions推导：主管理员为owner，full为product_admin，read为viewer
+	AccessRole *productmanager.AccessRole `json:"access_role,omitempty"`
 	// 备注信息
 	Remark string `json:"remark,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -83,7 +85,7 @@ func (*ProductManager) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case productmanager.FieldID, productmanager.FieldUserID, productmanager.FieldProductID:
 			values[i] = new(sql.NullInt64)
-		case productmanager.FieldRole, productmanager.FieldPermissions, productmanager.FieldRemark:
+		case productmanager.FieldRole, productmanager.FieldPermissions, productmanager.FieldAccessRole, productmanager.FieldRemark:
 			values[i] = new(sql.NullString)
 		case productmanager.FieldCreatedAt, productmanager.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -132,6 +134,13 @@ func (pm *ProductManager) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pm.Permissions = productmanager.Permissions(value.String)
 			}
+		case productmanager.FieldAccessRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_role", values[i])
+			} else if value.Valid {
+				pm.AccessRole = new(productmanager.AccessRole)
+				*pm.AccessRole = productmanager.AccessRole(value.String)
+			}
 		case productmanager.FieldRemark:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field remark", values[i])
@@ -208,6 +217,11 @@ func (pm *ProductManager) String() string {
 	builder.WriteString("permissions=")
 	builder.WriteString(fmt.Sprintf("%v", pm.Permissions))
 	builder.WriteString(", ")
+	if v := pm.AccessRole; v != nil {
+		builder.WriteString("access_role=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("remark=")
 	builder.WriteString(pm.Remark)
 	builder.WriteString(", ")
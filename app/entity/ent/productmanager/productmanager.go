@@ -23,6 +23,8 @@ const (
 	FieldProductID = "product_id"
 	// FieldPermissions holds the string denoting the permissions field in the database.
 	FieldPermissions = "permissions"
+	// FieldAccessRole holds the string denoting the access_role field in the database.
+	FieldAccessRole = "access_role"
 	// FieldRemark holds the string denoting the remark field in the database.
 	FieldRemark = "remark"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -58,6 +60,7 @@ var Columns = []string{
 	FieldUserID,
 	FieldProductID,
 	FieldPermissions,
+	FieldAccessRole,
 	FieldRemark,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -133,6 +136,31 @@ func PermissionsValidator(pe Permissions) error {
 	}
 }
 
+// AccessRole defines the type for the "access_role" enum field.
+type AccessRole string
+
+// AccessRole values.
+const (
+	AccessRoleViewer         AccessRole = "viewer"
+	AccessRoleDeviceOperator AccessRole = "device_operator"
+	AccessRoleReleaseManager AccessRole = "release_manager"
+	AccessRoleProductAdmin   AccessRole = "product_admin"
+)
+
+func (ar AccessRole) String() string {
+	return string(ar)
+}
+
+// AccessRoleValidator is a validator for the "access_role" field enum values. It is called by the builders before save.
+func AccessRoleValidator(ar AccessRole) error {
+	switch ar {
+	case AccessRoleViewer, AccessRoleDeviceOperator, AccessRoleReleaseManager, AccessRoleProductAdmin:
+		return nil
+	default:
+		return fmt.Errorf("productmanager: invalid enum value for access_role field: %q", ar)
+	}
+}
+
 // OrderOption defines the ordering options for the ProductManager queries.
 type OrderOption func(*sql.Selector)
 
@@ -161,6 +189,11 @@ func ByPermissions(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPermissions, opts...).ToFunc()
 }
 
+// ByAccessRole orders the results by the access_role field.
+func ByAccessRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessRole, opts...).ToFunc()
+}
+
 // ByRemark orders the results by the remark field.
 func ByRemark(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRemark, opts...).ToFunc()
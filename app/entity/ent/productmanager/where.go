@@ -170,6 +170,36 @@ func PermissionsNotNil() predicate.ProductManager {
 	return predicate.ProductManager(sql.FieldNotNull(FieldPermissions))
 }
 
+// AccessRoleEQ applies the EQ predicate on the "access_role" field.
+func AccessRoleEQ(v AccessRole) predicate.ProductManager {
+	return predicate.ProductManager(sql.FieldEQ(FieldAccessRole, v))
+}
+
+// AccessRoleNEQ applies the NEQ predicate on the "access_role" field.
+func AccessRoleNEQ(v AccessRole) predicate.ProductManager {
+	return predicate.ProductManager(sql.FieldNEQ(FieldAccessRole, v))
+}
+
+// AccessRoleIn applies the In predicate on the "access_role" field.
+func AccessRoleIn(vs ...AccessRole) predicate.ProductManager {
+	return predicate.ProductManager(sql.FieldIn(FieldAccessRole, vs...))
+}
+
+// AccessRoleNotIn applies the NotIn predicate on the "access_role" field.
+func AccessRoleNotIn(vs ...AccessRole) predicate.ProductManager {
+	return predicate.ProductManager(sql.FieldNotIn(FieldAccessRole, vs...))
+}
+
+// AccessRoleIsNil applies the IsNil predicate on the "access_role" field.
+func AccessRoleIsNil() predicate.ProductManager {
+	return predicate.ProductManager(sql.FieldIsNull(FieldAccessRole))
+}
+
+// AccessRoleNotNil applies the NotNil predicate on the "access_role" field.
+func AccessRoleNotNil() predicate.ProductManager {
+	return predicate.ProductManager(sql.FieldNotNull(FieldAccessRole))
+}
+
 // RemarkEQ applies the EQ predicate on the "remark" field.
 func RemarkEQ(v string) predicate.ProductManager {
 	return predicate.ProductManager(sql.FieldEQ(FieldRemark, v))
@@ -54,6 +54,20 @@ func (pmc *ProductManagerCreate) SetNillablePermissions(pr *productmanager.Permi
 	return pmc
 }
 
+// SetAccessRole sets the "access_role" field.
+func (pmc *ProductManagerCreate) SetAccessRole(pr productmanager.AccessRole) *ProductManagerCreate {
+	pmc.mutation.SetAccessRole(pr)
+	return pmc
+}
+
+// SetNillableAccessRole sets the "access_role" field if the given value is not nil.
+func (pmc *ProductManagerCreate) SetNillableAccessRole(pr *productmanager.AccessRole) *ProductManagerCreate {
+	if pr != nil {
+		pmc.SetAccessRole(*pr)
+	}
+	return pmc
+}
+
 // SetRemark sets the "remark" field.
 func (pmc *ProductManagerCreate) SetRemark(s string) *ProductManagerCreate {
 	pmc.mutation.SetRemark(s)
@@ -182,6 +196,11 @@ func (pmc *ProductManagerCreate) check() error {
 			return &ValidationError{Name: "permissions", err: fmt.Errorf(`ent: validator failed for field "ProductManager.permissions": %w`, err)}
 		}
 	}
+	if v, ok := pmc.mutation.AccessRole(); ok {
+		if err := productmanager.AccessRoleValidator(v); err != nil {
+			return &ValidationError{Name: "access_role", err: fmt.Errorf(`ent: validator failed for field "ProductManager.access_role": %w`, err)}
+		}
+	}
 	if _, ok := pmc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductManager.created_at"`)}
 	}
@@ -239,6 +258,10 @@ func (pmc *ProductManagerCreate) createSpec() (*ProductManager, *sqlgraph.Create
 		_spec.SetField(productmanager.FieldPermissions, field.TypeEnum, value)
 		_node.Permissions = value
 	}
+	if value, ok := pmc.mutation.AccessRole(); ok {
+		_spec.SetField(productmanager.FieldAccessRole, field.TypeEnum, value)
+		_node.AccessRole = &value
+	}
 	if value, ok := pmc.mutation.Remark(); ok {
 		_spec.SetField(productmanager.FieldRemark, field.TypeString, value)
 		_node.Remark = value
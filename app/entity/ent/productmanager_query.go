@@ -11,6 +11,7 @@ import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -25,6 +26,7 @@ type ProductManagerQuery struct {
 	predicates  []predicate.ProductManager
 	withUser    *UserQuery
 	withProduct *ProductQuery
+	modifiers   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -419,6 +421,9 @@ func (pmq *ProductManagerQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(pmq.modifiers) > 0 {
+		_spec.Modifiers = pmq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -504,6 +509,9 @@ func (pmq *ProductManagerQuery) loadProduct(ctx context.Context, query *ProductQ
 
 func (pmq *ProductManagerQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pmq.querySpec()
+	if len(pmq.modifiers) > 0 {
+		_spec.Modifiers = pmq.modifiers
+	}
 	_spec.Node.Columns = pmq.ctx.Fields
 	if len(pmq.ctx.Fields) > 0 {
 		_spec.Unique = pmq.ctx.Unique != nil && *pmq.ctx.Unique
@@ -572,6 +580,9 @@ func (pmq *ProductManagerQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if pmq.ctx.Unique != nil && *pmq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range pmq.modifiers {
+		m(selector)
+	}
 	for _, p := range pmq.predicates {
 		p(selector)
 	}
@@ -589,6 +600,32 @@ func (pmq *ProductManagerQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pmq *ProductManagerQuery) ForUpdate(opts ...sql.LockOption) *ProductManagerQuery {
+	if pmq.driver.Dialect() == dialect.Postgres {
+		pmq.Unique(false)
+	}
+	pmq.modifiers = append(pmq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pmq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pmq *ProductManagerQuery) ForShare(opts ...sql.LockOption) *ProductManagerQuery {
+	if pmq.driver.Dialect() == dialect.Postgres {
+		pmq.Unique(false)
+	}
+	pmq.modifiers = append(pmq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pmq
+}
+
 // ProductManagerGroupBy is the group-by builder for ProductManager entities.
 type ProductManagerGroupBy struct {
 	selector
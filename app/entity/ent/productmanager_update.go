@@ -68,6 +68,26 @@ func (pmu *ProductManagerUpdate) ClearPermissions() *ProductManagerUpdate {
 	return pmu
 }
 
+// SetAccessRole sets the "access_role" field.
+func (pmu *ProductManagerUpdate) SetAccessRole(pr productmanager.AccessRole) *ProductManagerUpdate {
+	pmu.mutation.SetAccessRole(pr)
+	return pmu
+}
+
+// SetNillableAccessRole sets the "access_role" field if the given value is not nil.
+func (pmu *ProductManagerUpdate) SetNillableAccessRole(pr *productmanager.AccessRole) *ProductManagerUpdate {
+	if pr != nil {
+		pmu.SetAccessRole(*pr)
+	}
+	return pmu
+}
+
+// ClearAccessRole clears the value of the "access_role" field.
+func (pmu *ProductManagerUpdate) ClearAccessRole() *ProductManagerUpdate {
+	pmu.mutation.ClearAccessRole()
+	return pmu
+}
+
 // SetRemark sets the "remark" field.
 func (pmu *ProductManagerUpdate) SetRemark(s string) *ProductManagerUpdate {
 	pmu.mutation.SetRemark(s)
@@ -169,6 +189,11 @@ func (pmu *ProductManagerUpdate) check() error {
 			return &ValidationError{Name: "permissions", err: fmt.Errorf(`ent: validator failed for field "ProductManager.permissions": %w`, err)}
 		}
 	}
+	if v, ok := pmu.mutation.AccessRole(); ok {
+		if err := productmanager.AccessRoleValidator(v); err != nil {
+			return &ValidationError{Name: "access_role", err: fmt.Errorf(`ent: validator failed for field "ProductManager.access_role": %w`, err)}
+		}
+	}
 	if _, ok := pmu.mutation.UserID(); pmu.mutation.UserCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "ProductManager.user"`)
 	}
@@ -199,6 +224,12 @@ func (pmu *ProductManagerUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if pmu.mutation.PermissionsCleared() {
 		_spec.ClearField(productmanager.FieldPermissions, field.TypeEnum)
 	}
+	if value, ok := pmu.mutation.AccessRole(); ok {
+		_spec.SetField(productmanager.FieldAccessRole, field.TypeEnum, value)
+	}
+	if pmu.mutation.AccessRoleCleared() {
+		_spec.ClearField(productmanager.FieldAccessRole, field.TypeEnum)
+	}
 	if value, ok := pmu.mutation.Remark(); ok {
 		_spec.SetField(productmanager.FieldRemark, field.TypeString, value)
 	}
@@ -324,6 +355,26 @@ func (pmuo *ProductManagerUpdateOne) ClearPermissions() *ProductManagerUpdateOne
 	return pmuo
 }
 
+// SetAccessRole sets the "access_role" field.
+func (pmuo *ProductManagerUpdateOne) SetAccessRole(pr productmanager.AccessRole) *ProductManagerUpdateOne {
+	pmuo.mutation.SetAccessRole(pr)
+	return pmuo
+}
+
+// SetNillableAccessRole sets the "access_role" field if the given value is not nil.
+func (pmuo *ProductManagerUpdateOne) SetNillableAccessRole(pr *productmanager.AccessRole) *ProductManagerUpdateOne {
+	if pr != nil {
+		pmuo.SetAccessRole(*pr)
+	}
+	return pmuo
+}
+
+// ClearAccessRole clears the value of the "access_role" field.
+func (pmuo *ProductManagerUpdateOne) ClearAccessRole() *ProductManagerUpdateOne {
+	pmuo.mutation.ClearAccessRole()
+	return pmuo
+}
+
 // SetRemark sets the "remark" field.
 func (pmuo *ProductManagerUpdateOne) SetRemark(s string) *ProductManagerUpdateOne {
 	pmuo.mutation.SetRemark(s)
@@ -438,6 +489,11 @@ func (pmuo *ProductManagerUpdateOne) check() error {
 			return &ValidationError{Name: "permissions", err: fmt.Errorf(`ent: validator failed for field "ProductManager.permissions": %w`, err)}
 		}
 	}
+	if v, ok := pmuo.mutation.AccessRole(); ok {
+		if err := productmanager.AccessRoleValidator(v); err != nil {
+			return &ValidationError{Name: "access_role", err: fmt.Errorf(`ent: validator failed for field "ProductManager.access_role": %w`, err)}
+		}
+	}
 	if _, ok := pmuo.mutation.UserID(); pmuo.mutation.UserCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "ProductManager.user"`)
 	}
@@ -485,6 +541,12 @@ func (pmuo *ProductManagerUpdateOne) sqlSave(ctx context.Context) (_node *Produc
 	if pmuo.mutation.PermissionsCleared() {
 		_spec.ClearField(productmanager.FieldPermissions, field.TypeEnum)
 	}
+	if value, ok := pmuo.mutation.AccessRole(); ok {
+		_spec.SetField(productmanager.FieldAccessRole, field.TypeEnum, value)
+	}
+	if pmuo.mutation.AccessRoleCleared() {
+		_spec.ClearField(productmanager.FieldAccessRole, field.TypeEnum)
+	}
 	if value, ok := pmuo.mutation.Remark(); ok {
 		_spec.SetField(productmanager.FieldRemark, field.TypeString, value)
 	}
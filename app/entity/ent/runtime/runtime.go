@@ -2,7 +2,1033 @@
 
 package runtime
 
-// The schema-stitching logic is generated in cambridge-hit.com/gin-base/activateserver/app/entity/ent/runtime.go
+import (
+	"context"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/apitoken"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/auditlog"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/customer"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/deviceassignment"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicefeatureoverride"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicegroup"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/deviceheartbeat"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicesavedfilter"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicetag"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/firmwareversion"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/job"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetypefeatures"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/lot"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/metricevent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/post"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/postcategory"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/posttag"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/posttagrelation"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productinvitation"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/registrationinvitation"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snallocator"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snblock"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snrule"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/softwareversion"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/twofactorpolicy"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/schema"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	apitokenFields := schema.APIToken{}.Fields()
+	_ = apitokenFields
+	// apitokenDescName is the schema descriptor for name field.
+	apitokenDescName := apitokenFields[1].Descriptor()
+	// apitoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apitoken.NameValidator = apitokenDescName.Validators[0].(func(string) error)
+	// apitokenDescLastUsedIP is the schema descriptor for last_used_ip field.
+	apitokenDescLastUsedIP := apitokenFields[11].Descriptor()
+	// apitoken.DefaultLastUsedIP holds the default value on creation for the last_used_ip field.
+	apitoken.DefaultLastUsedIP = apitokenDescLastUsedIP.Default.(string)
+	// apitokenDescCreatedAt is the schema descriptor for created_at field.
+	apitokenDescCreatedAt := apitokenFields[14].Descriptor()
+	// apitoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apitoken.DefaultCreatedAt = apitokenDescCreatedAt.Default.(func() time.Time)
+	// apitokenDescUpdatedAt is the schema descriptor for updated_at field.
+	apitokenDescUpdatedAt := apitokenFields[15].Descriptor()
+	// apitoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apitoken.DefaultUpdatedAt = apitokenDescUpdatedAt.Default.(func() time.Time)
+	// apitoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apitoken.UpdateDefaultUpdatedAt = apitokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apitokenDescID is the schema descriptor for id field.
+	apitokenDescID := apitokenFields[0].Descriptor()
+	// apitoken.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	apitoken.IDValidator = apitokenDescID.Validators[0].(func(int) error)
+	auditlogMixin := schema.AuditLog{}.Mixin()
+	auditlog.Policy = privacy.NewPolicies(auditlogMixin[0], schema.AuditLog{})
+	auditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := auditlog.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	auditlogMixinInters0 := auditlogMixin[0].Interceptors()
+	auditlog.Interceptors[0] = auditlogMixinInters0[0]
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescModule is the schema descriptor for module field.
+	auditlogDescModule := auditlogFields[2].Descriptor()
+	// auditlog.ModuleValidator is a validator for the "module" field. It is called by the builders before save.
+	auditlog.ModuleValidator = auditlogDescModule.Validators[0].(func(string) error)
+	// auditlogDescActionType is the schema descriptor for action_type field.
+	auditlogDescActionType := auditlogFields[3].Descriptor()
+	// auditlog.ActionTypeValidator is a validator for the "action_type" field. It is called by the builders before save.
+	auditlog.ActionTypeValidator = auditlogDescActionType.Validators[0].(func(string) error)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[7].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	// auditlogDescID is the schema descriptor for id field.
+	auditlogDescID := auditlogFields[0].Descriptor()
+	// auditlog.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	auditlog.IDValidator = auditlogDescID.Validators[0].(func(int) error)
+	customerFields := schema.Customer{}.Fields()
+	_ = customerFields
+	// customerDescName is the schema descriptor for name field.
+	customerDescName := customerFields[1].Descriptor()
+	// customer.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	customer.NameValidator = customerDescName.Validators[0].(func(string) error)
+	// customerDescContactName is the schema descriptor for contact_name field.
+	customerDescContactName := customerFields[2].Descriptor()
+	// customer.DefaultContactName holds the default value on creation for the contact_name field.
+	customer.DefaultContactName = customerDescContactName.Default.(string)
+	// customerDescContactPhone is the schema descriptor for contact_phone field.
+	customerDescContactPhone := customerFields[3].Descriptor()
+	// customer.DefaultContactPhone holds the default value on creation for the contact_phone field.
+	customer.DefaultContactPhone = customerDescContactPhone.Default.(string)
+	// customerDescContactEmail is the schema descriptor for contact_email field.
+	customerDescContactEmail := customerFields[4].Descriptor()
+	// customer.DefaultContactEmail holds the default value on creation for the contact_email field.
+	customer.DefaultContactEmail = customerDescContactEmail.Default.(string)
+	// customerDescRegion is the schema descriptor for region field.
+	customerDescRegion := customerFields[5].Descriptor()
+	// customer.DefaultRegion holds the default value on creation for the region field.
+	customer.DefaultRegion = customerDescRegion.Default.(string)
+	// customerDescOemTag is the schema descriptor for oem_tag field.
+	customerDescOemTag := customerFields[6].Descriptor()
+	// customer.DefaultOemTag holds the default value on creation for the oem_tag field.
+	customer.DefaultOemTag = customerDescOemTag.Default.(string)
+	// customerDescRemark is the schema descriptor for remark field.
+	customerDescRemark := customerFields[7].Descriptor()
+	// customer.DefaultRemark holds the default value on creation for the remark field.
+	customer.DefaultRemark = customerDescRemark.Default.(string)
+	// customerDescCreatedAt is the schema descriptor for created_at field.
+	customerDescCreatedAt := customerFields[9].Descriptor()
+	// customer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	customer.DefaultCreatedAt = customerDescCreatedAt.Default.(func() time.Time)
+	// customerDescUpdatedAt is the schema descriptor for updated_at field.
+	customerDescUpdatedAt := customerFields[10].Descriptor()
+	// customer.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	customer.DefaultUpdatedAt = customerDescUpdatedAt.Default.(func() time.Time)
+	// customer.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	customer.UpdateDefaultUpdatedAt = customerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// customerDescID is the schema descriptor for id field.
+	customerDescID := customerFields[0].Descriptor()
+	// customer.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	customer.IDValidator = customerDescID.Validators[0].(func(int) error)
+	deviceMixin := schema.Device{}.Mixin()
+	device.Policy = privacy.NewPolicies(deviceMixin[1], schema.Device{})
+	device.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := device.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	deviceMixinHooks0 := deviceMixin[0].Hooks()
+
+	device.Hooks[1] = deviceMixinHooks0[0]
+	deviceMixinInters0 := deviceMixin[0].Interceptors()
+	deviceMixinInters1 := deviceMixin[1].Interceptors()
+	device.Interceptors[0] = deviceMixinInters0[0]
+	device.Interceptors[1] = deviceMixinInters1[0]
+	deviceMixinFields0 := deviceMixin[0].Fields()
+	_ = deviceMixinFields0
+	deviceFields := schema.Device{}.Fields()
+	_ = deviceFields
+	// deviceDescDeletedID is the schema descriptor for deleted_id field.
+	deviceDescDeletedID := deviceMixinFields0[1].Descriptor()
+	// device.DefaultDeletedID holds the default value on creation for the deleted_id field.
+	device.DefaultDeletedID = deviceDescDeletedID.Default.(int)
+	// deviceDescOemTag is the schema descriptor for oem_tag field.
+	deviceDescOemTag := deviceFields[4].Descriptor()
+	// device.DefaultOemTag holds the default value on creation for the oem_tag field.
+	device.DefaultOemTag = deviceDescOemTag.Default.(string)
+	// deviceDescRemark is the schema descriptor for remark field.
+	deviceDescRemark := deviceFields[5].Descriptor()
+	// device.DefaultRemark holds the default value on creation for the remark field.
+	device.DefaultRemark = deviceDescRemark.Default.(string)
+	// deviceDescLastSoftwareVersion is the schema descriptor for last_software_version field.
+	deviceDescLastSoftwareVersion := deviceFields[19].Descriptor()
+	// device.DefaultLastSoftwareVersion holds the default value on creation for the last_software_version field.
+	device.DefaultLastSoftwareVersion = deviceDescLastSoftwareVersion.Default.(string)
+	// deviceDescLastFirmwareVersion is the schema descriptor for last_firmware_version field.
+	deviceDescLastFirmwareVersion := deviceFields[20].Descriptor()
+	// device.DefaultLastFirmwareVersion holds the default value on creation for the last_firmware_version field.
+	device.DefaultLastFirmwareVersion = deviceDescLastFirmwareVersion.Default.(string)
+	// deviceDescLastUptime is the schema descriptor for last_uptime field.
+	deviceDescLastUptime := deviceFields[21].Descriptor()
+	// device.DefaultLastUptime holds the default value on creation for the last_uptime field.
+	device.DefaultLastUptime = deviceDescLastUptime.Default.(int64)
+	// deviceDescHeartbeatPublicKey is the schema descriptor for heartbeat_public_key field.
+	deviceDescHeartbeatPublicKey := deviceFields[22].Descriptor()
+	// device.DefaultHeartbeatPublicKey holds the default value on creation for the heartbeat_public_key field.
+	device.DefaultHeartbeatPublicKey = deviceDescHeartbeatPublicKey.Default.(string)
+	deviceassignmentFields := schema.DeviceAssignment{}.Fields()
+	_ = deviceassignmentFields
+	// deviceassignmentDescCustomerName is the schema descriptor for customer_name field.
+	deviceassignmentDescCustomerName := deviceassignmentFields[3].Descriptor()
+	// deviceassignment.DefaultCustomerName holds the default value on creation for the customer_name field.
+	deviceassignment.DefaultCustomerName = deviceassignmentDescCustomerName.Default.(string)
+	// deviceassignmentDescPreviousCustomerName is the schema descriptor for previous_customer_name field.
+	deviceassignmentDescPreviousCustomerName := deviceassignmentFields[5].Descriptor()
+	// deviceassignment.DefaultPreviousCustomerName holds the default value on creation for the previous_customer_name field.
+	deviceassignment.DefaultPreviousCustomerName = deviceassignmentDescPreviousCustomerName.Default.(string)
+	// deviceassignmentDescRemark is the schema descriptor for remark field.
+	deviceassignmentDescRemark := deviceassignmentFields[8].Descriptor()
+	// deviceassignment.DefaultRemark holds the default value on creation for the remark field.
+	deviceassignment.DefaultRemark = deviceassignmentDescRemark.Default.(string)
+	// deviceassignmentDescAssignedAt is the schema descriptor for assigned_at field.
+	deviceassignmentDescAssignedAt := deviceassignmentFields[10].Descriptor()
+	// deviceassignment.DefaultAssignedAt holds the default value on creation for the assigned_at field.
+	deviceassignment.DefaultAssignedAt = deviceassignmentDescAssignedAt.Default.(func() time.Time)
+	// deviceassignmentDescID is the schema descriptor for id field.
+	deviceassignmentDescID := deviceassignmentFields[0].Descriptor()
+	// deviceassignment.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	deviceassignment.IDValidator = deviceassignmentDescID.Validators[0].(func(int) error)
+	devicefeatureoverrideFields := schema.DeviceFeatureOverride{}.Fields()
+	_ = devicefeatureoverrideFields
+	// devicefeatureoverrideDescRemark is the schema descriptor for remark field.
+	devicefeatureoverrideDescRemark := devicefeatureoverrideFields[5].Descriptor()
+	// devicefeatureoverride.DefaultRemark holds the default value on creation for the remark field.
+	devicefeatureoverride.DefaultRemark = devicefeatureoverrideDescRemark.Default.(string)
+	// devicefeatureoverrideDescCreatedAt is the schema descriptor for created_at field.
+	devicefeatureoverrideDescCreatedAt := devicefeatureoverrideFields[8].Descriptor()
+	// devicefeatureoverride.DefaultCreatedAt holds the default value on creation for the created_at field.
+	devicefeatureoverride.DefaultCreatedAt = devicefeatureoverrideDescCreatedAt.Default.(func() time.Time)
+	// devicefeatureoverrideDescUpdatedAt is the schema descriptor for updated_at field.
+	devicefeatureoverrideDescUpdatedAt := devicefeatureoverrideFields[9].Descriptor()
+	// devicefeatureoverride.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	devicefeatureoverride.DefaultUpdatedAt = devicefeatureoverrideDescUpdatedAt.Default.(func() time.Time)
+	// devicefeatureoverride.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	devicefeatureoverride.UpdateDefaultUpdatedAt = devicefeatureoverrideDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// devicefeatureoverrideDescID is the schema descriptor for id field.
+	devicefeatureoverrideDescID := devicefeatureoverrideFields[0].Descriptor()
+	// devicefeatureoverride.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	devicefeatureoverride.IDValidator = devicefeatureoverrideDescID.Validators[0].(func(int) error)
+	devicegroupFields := schema.DeviceGroup{}.Fields()
+	_ = devicegroupFields
+	// devicegroupDescName is the schema descriptor for name field.
+	devicegroupDescName := devicegroupFields[2].Descriptor()
+	// devicegroup.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	devicegroup.NameValidator = devicegroupDescName.Validators[0].(func(string) error)
+	// devicegroupDescDescription is the schema descriptor for description field.
+	devicegroupDescDescription := devicegroupFields[3].Descriptor()
+	// devicegroup.DefaultDescription holds the default value on creation for the description field.
+	devicegroup.DefaultDescription = devicegroupDescDescription.Default.(string)
+	// devicegroupDescCreatedAt is the schema descriptor for created_at field.
+	devicegroupDescCreatedAt := devicegroupFields[5].Descriptor()
+	// devicegroup.DefaultCreatedAt holds the default value on creation for the created_at field.
+	devicegroup.DefaultCreatedAt = devicegroupDescCreatedAt.Default.(func() time.Time)
+	// devicegroupDescUpdatedAt is the schema descriptor for updated_at field.
+	devicegroupDescUpdatedAt := devicegroupFields[6].Descriptor()
+	// devicegroup.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	devicegroup.DefaultUpdatedAt = devicegroupDescUpdatedAt.Default.(func() time.Time)
+	// devicegroup.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	devicegroup.UpdateDefaultUpdatedAt = devicegroupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// devicegroupDescID is the schema descriptor for id field.
+	devicegroupDescID := devicegroupFields[0].Descriptor()
+	// devicegroup.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	devicegroup.IDValidator = devicegroupDescID.Validators[0].(func(int) error)
+	deviceheartbeatFields := schema.DeviceHeartbeat{}.Fields()
+	_ = deviceheartbeatFields
+	// deviceheartbeatDescSoftwareVersion is the schema descriptor for software_version field.
+	deviceheartbeatDescSoftwareVersion := deviceheartbeatFields[3].Descriptor()
+	// deviceheartbeat.DefaultSoftwareVersion holds the default value on creation for the software_version field.
+	deviceheartbeat.DefaultSoftwareVersion = deviceheartbeatDescSoftwareVersion.Default.(string)
+	// deviceheartbeatDescFirmwareVersion is the schema descriptor for firmware_version field.
+	deviceheartbeatDescFirmwareVersion := deviceheartbeatFields[4].Descriptor()
+	// deviceheartbeat.DefaultFirmwareVersion holds the default value on creation for the firmware_version field.
+	deviceheartbeat.DefaultFirmwareVersion = deviceheartbeatDescFirmwareVersion.Default.(string)
+	// deviceheartbeatDescUptime is the schema descriptor for uptime field.
+	deviceheartbeatDescUptime := deviceheartbeatFields[5].Descriptor()
+	// deviceheartbeat.DefaultUptime holds the default value on creation for the uptime field.
+	deviceheartbeat.DefaultUptime = deviceheartbeatDescUptime.Default.(int64)
+	// deviceheartbeatDescRemoteIP is the schema descriptor for remote_ip field.
+	deviceheartbeatDescRemoteIP := deviceheartbeatFields[7].Descriptor()
+	// deviceheartbeat.DefaultRemoteIP holds the default value on creation for the remote_ip field.
+	deviceheartbeat.DefaultRemoteIP = deviceheartbeatDescRemoteIP.Default.(string)
+	// deviceheartbeatDescCreatedAt is the schema descriptor for created_at field.
+	deviceheartbeatDescCreatedAt := deviceheartbeatFields[8].Descriptor()
+	// deviceheartbeat.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deviceheartbeat.DefaultCreatedAt = deviceheartbeatDescCreatedAt.Default.(func() time.Time)
+	// deviceheartbeatDescID is the schema descriptor for id field.
+	deviceheartbeatDescID := deviceheartbeatFields[0].Descriptor()
+	// deviceheartbeat.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	deviceheartbeat.IDValidator = deviceheartbeatDescID.Validators[0].(func(int) error)
+	devicesavedfilterFields := schema.DeviceSavedFilter{}.Fields()
+	_ = devicesavedfilterFields
+	// devicesavedfilterDescName is the schema descriptor for name field.
+	devicesavedfilterDescName := devicesavedfilterFields[2].Descriptor()
+	// devicesavedfilter.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	devicesavedfilter.NameValidator = devicesavedfilterDescName.Validators[0].(func(string) error)
+	// devicesavedfilterDescCreatedAt is the schema descriptor for created_at field.
+	devicesavedfilterDescCreatedAt := devicesavedfilterFields[4].Descriptor()
+	// devicesavedfilter.DefaultCreatedAt holds the default value on creation for the created_at field.
+	devicesavedfilter.DefaultCreatedAt = devicesavedfilterDescCreatedAt.Default.(func() time.Time)
+	// devicesavedfilterDescUpdatedAt is the schema descriptor for updated_at field.
+	devicesavedfilterDescUpdatedAt := devicesavedfilterFields[5].Descriptor()
+	// devicesavedfilter.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	devicesavedfilter.DefaultUpdatedAt = devicesavedfilterDescUpdatedAt.Default.(func() time.Time)
+	// devicesavedfilter.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	devicesavedfilter.UpdateDefaultUpdatedAt = devicesavedfilterDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// devicesavedfilterDescID is the schema descriptor for id field.
+	devicesavedfilterDescID := devicesavedfilterFields[0].Descriptor()
+	// devicesavedfilter.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	devicesavedfilter.IDValidator = devicesavedfilterDescID.Validators[0].(func(int) error)
+	devicetagFields := schema.DeviceTag{}.Fields()
+	_ = devicetagFields
+	// devicetagDescName is the schema descriptor for name field.
+	devicetagDescName := devicetagFields[2].Descriptor()
+	// devicetag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	devicetag.NameValidator = devicetagDescName.Validators[0].(func(string) error)
+	// devicetagDescColor is the schema descriptor for color field.
+	devicetagDescColor := devicetagFields[3].Descriptor()
+	// devicetag.DefaultColor holds the default value on creation for the color field.
+	devicetag.DefaultColor = devicetagDescColor.Default.(string)
+	// devicetagDescCreatedAt is the schema descriptor for created_at field.
+	devicetagDescCreatedAt := devicetagFields[5].Descriptor()
+	// devicetag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	devicetag.DefaultCreatedAt = devicetagDescCreatedAt.Default.(func() time.Time)
+	// devicetagDescUpdatedAt is the schema descriptor for updated_at field.
+	devicetagDescUpdatedAt := devicetagFields[6].Descriptor()
+	// devicetag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	devicetag.DefaultUpdatedAt = devicetagDescUpdatedAt.Default.(func() time.Time)
+	// devicetag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	devicetag.UpdateDefaultUpdatedAt = devicetagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// devicetagDescID is the schema descriptor for id field.
+	devicetagDescID := devicetagFields[0].Descriptor()
+	// devicetag.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	devicetag.IDValidator = devicetagDescID.Validators[0].(func(int) error)
+	firmwareversionMixin := schema.FirmwareVersion{}.Mixin()
+	firmwareversion.Policy = privacy.NewPolicies(firmwareversionMixin[1], schema.FirmwareVersion{})
+	firmwareversion.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := firmwareversion.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	firmwareversionMixinHooks0 := firmwareversionMixin[0].Hooks()
+
+	firmwareversion.Hooks[1] = firmwareversionMixinHooks0[0]
+	firmwareversionMixinInters0 := firmwareversionMixin[0].Interceptors()
+	firmwareversionMixinInters1 := firmwareversionMixin[1].Interceptors()
+	firmwareversion.Interceptors[0] = firmwareversionMixinInters0[0]
+	firmwareversion.Interceptors[1] = firmwareversionMixinInters1[0]
+	firmwareversionMixinFields0 := firmwareversionMixin[0].Fields()
+	_ = firmwareversionMixinFields0
+	firmwareversionFields := schema.FirmwareVersion{}.Fields()
+	_ = firmwareversionFields
+	// firmwareversionDescDeletedID is the schema descriptor for deleted_id field.
+	firmwareversionDescDeletedID := firmwareversionMixinFields0[1].Descriptor()
+	// firmwareversion.DefaultDeletedID holds the default value on creation for the deleted_id field.
+	firmwareversion.DefaultDeletedID = firmwareversionDescDeletedID.Default.(int)
+	// firmwareversionDescVersion is the schema descriptor for version field.
+	firmwareversionDescVersion := firmwareversionFields[1].Descriptor()
+	// firmwareversion.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	firmwareversion.VersionValidator = firmwareversionDescVersion.Validators[0].(func(string) error)
+	// firmwareversionDescReleaseDate is the schema descriptor for release_date field.
+	firmwareversionDescReleaseDate := firmwareversionFields[2].Descriptor()
+	// firmwareversion.DefaultReleaseDate holds the default value on creation for the release_date field.
+	firmwareversion.DefaultReleaseDate = firmwareversionDescReleaseDate.Default.(func() time.Time)
+	// firmwareversionDescCreatedAt is the schema descriptor for created_at field.
+	firmwareversionDescCreatedAt := firmwareversionFields[5].Descriptor()
+	// firmwareversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	firmwareversion.DefaultCreatedAt = firmwareversionDescCreatedAt.Default.(func() time.Time)
+	// firmwareversionDescUpdatedAt is the schema descriptor for updated_at field.
+	firmwareversionDescUpdatedAt := firmwareversionFields[6].Descriptor()
+	// firmwareversion.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	firmwareversion.DefaultUpdatedAt = firmwareversionDescUpdatedAt.Default.(func() time.Time)
+	// firmwareversion.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	firmwareversion.UpdateDefaultUpdatedAt = firmwareversionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescTotal is the schema descriptor for total field.
+	jobDescTotal := jobFields[4].Descriptor()
+	// job.DefaultTotal holds the default value on creation for the total field.
+	job.DefaultTotal = jobDescTotal.Default.(int)
+	// jobDescProcessed is the schema descriptor for processed field.
+	jobDescProcessed := jobFields[5].Descriptor()
+	// job.DefaultProcessed holds the default value on creation for the processed field.
+	job.DefaultProcessed = jobDescProcessed.Default.(int)
+	// jobDescSucceeded is the schema descriptor for succeeded field.
+	jobDescSucceeded := jobFields[6].Descriptor()
+	// job.DefaultSucceeded holds the default value on creation for the succeeded field.
+	job.DefaultSucceeded = jobDescSucceeded.Default.(int)
+	// jobDescFailed is the schema descriptor for failed field.
+	jobDescFailed := jobFields[7].Descriptor()
+	// job.DefaultFailed holds the default value on creation for the failed field.
+	job.DefaultFailed = jobDescFailed.Default.(int)
+	// jobDescResult is the schema descriptor for result field.
+	jobDescResult := jobFields[8].Descriptor()
+	// job.DefaultResult holds the default value on creation for the result field.
+	job.DefaultResult = jobDescResult.Default.(string)
+	// jobDescErrorReport is the schema descriptor for error_report field.
+	jobDescErrorReport := jobFields[9].Descriptor()
+	// job.DefaultErrorReport holds the default value on creation for the error_report field.
+	job.DefaultErrorReport = jobDescErrorReport.Default.(string)
+	// jobDescCreatedAt is the schema descriptor for created_at field.
+	jobDescCreatedAt := jobFields[13].Descriptor()
+	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
+	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
+	// jobDescUpdatedAt is the schema descriptor for updated_at field.
+	jobDescUpdatedAt := jobFields[14].Descriptor()
+	// job.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	job.DefaultUpdatedAt = jobDescUpdatedAt.Default.(func() time.Time)
+	// job.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	job.UpdateDefaultUpdatedAt = jobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// jobDescID is the schema descriptor for id field.
+	jobDescID := jobFields[0].Descriptor()
+	// job.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	job.IDValidator = jobDescID.Validators[0].(func(int) error)
+	licensetypeMixin := schema.LicenseType{}.Mixin()
+	licensetype.Policy = privacy.NewPolicies(licensetypeMixin[1], schema.LicenseType{})
+	licensetype.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := licensetype.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	licensetypeMixinHooks0 := licensetypeMixin[0].Hooks()
+
+	licensetype.Hooks[1] = licensetypeMixinHooks0[0]
+	licensetypeMixinInters0 := licensetypeMixin[0].Interceptors()
+	licensetypeMixinInters1 := licensetypeMixin[1].Interceptors()
+	licensetype.Interceptors[0] = licensetypeMixinInters0[0]
+	licensetype.Interceptors[1] = licensetypeMixinInters1[0]
+	licensetypeMixinFields0 := licensetypeMixin[0].Fields()
+	_ = licensetypeMixinFields0
+	licensetypeFields := schema.LicenseType{}.Fields()
+	_ = licensetypeFields
+	// licensetypeDescDeletedID is the schema descriptor for deleted_id field.
+	licensetypeDescDeletedID := licensetypeMixinFields0[1].Descriptor()
+	// licensetype.DefaultDeletedID holds the default value on creation for the deleted_id field.
+	licensetype.DefaultDeletedID = licensetypeDescDeletedID.Default.(int)
+	// licensetypeDescTypeName is the schema descriptor for type_name field.
+	licensetypeDescTypeName := licensetypeFields[1].Descriptor()
+	// licensetype.TypeNameValidator is a validator for the "type_name" field. It is called by the builders before save.
+	licensetype.TypeNameValidator = licensetypeDescTypeName.Validators[0].(func(string) error)
+	// licensetypeDescLicenseType is the schema descriptor for license_type field.
+	licensetypeDescLicenseType := licensetypeFields[2].Descriptor()
+	// licensetype.LicenseTypeValidator is a validator for the "license_type" field. It is called by the builders before save.
+	licensetype.LicenseTypeValidator = licensetypeDescLicenseType.Validators[0].(func(string) error)
+	// licensetypeDescCreatedAt is the schema descriptor for created_at field.
+	licensetypeDescCreatedAt := licensetypeFields[5].Descriptor()
+	// licensetype.DefaultCreatedAt holds the default value on creation for the created_at field.
+	licensetype.DefaultCreatedAt = licensetypeDescCreatedAt.Default.(func() time.Time)
+	// licensetypeDescUpdatedAt is the schema descriptor for updated_at field.
+	licensetypeDescUpdatedAt := licensetypeFields[6].Descriptor()
+	// licensetype.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	licensetype.DefaultUpdatedAt = licensetypeDescUpdatedAt.Default.(func() time.Time)
+	// licensetype.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	licensetype.UpdateDefaultUpdatedAt = licensetypeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// licensetypeDescID is the schema descriptor for id field.
+	licensetypeDescID := licensetypeFields[0].Descriptor()
+	// licensetype.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	licensetype.IDValidator = licensetypeDescID.Validators[0].(func(int) error)
+	licensetypefeaturesFields := schema.LicenseTypeFeatures{}.Fields()
+	_ = licensetypefeaturesFields
+	// licensetypefeaturesDescCreatedAt is the schema descriptor for created_at field.
+	licensetypefeaturesDescCreatedAt := licensetypefeaturesFields[3].Descriptor()
+	// licensetypefeatures.DefaultCreatedAt holds the default value on creation for the created_at field.
+	licensetypefeatures.DefaultCreatedAt = licensetypefeaturesDescCreatedAt.Default.(func() time.Time)
+	// licensetypefeaturesDescID is the schema descriptor for id field.
+	licensetypefeaturesDescID := licensetypefeaturesFields[0].Descriptor()
+	// licensetypefeatures.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	licensetypefeatures.IDValidator = licensetypefeaturesDescID.Validators[0].(func(int) error)
+	lotFields := schema.Lot{}.Fields()
+	_ = lotFields
+	// lotDescLotNo is the schema descriptor for lot_no field.
+	lotDescLotNo := lotFields[1].Descriptor()
+	// lot.LotNoValidator is a validator for the "lot_no" field. It is called by the builders before save.
+	lot.LotNoValidator = lotDescLotNo.Validators[0].(func(string) error)
+	// lotDescQuantity is the schema descriptor for quantity field.
+	lotDescQuantity := lotFields[5].Descriptor()
+	// lot.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	lot.QuantityValidator = lotDescQuantity.Validators[0].(func(int) error)
+	// lotDescRemark is the schema descriptor for remark field.
+	lotDescRemark := lotFields[8].Descriptor()
+	// lot.DefaultRemark holds the default value on creation for the remark field.
+	lot.DefaultRemark = lotDescRemark.Default.(string)
+	// lotDescCreatedAt is the schema descriptor for created_at field.
+	lotDescCreatedAt := lotFields[11].Descriptor()
+	// lot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	lot.DefaultCreatedAt = lotDescCreatedAt.Default.(func() time.Time)
+	// lotDescUpdatedAt is the schema descriptor for updated_at field.
+	lotDescUpdatedAt := lotFields[12].Descriptor()
+	// lot.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	lot.DefaultUpdatedAt = lotDescUpdatedAt.Default.(func() time.Time)
+	// lot.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	lot.UpdateDefaultUpdatedAt = lotDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// lotDescID is the schema descriptor for id field.
+	lotDescID := lotFields[0].Descriptor()
+	// lot.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	lot.IDValidator = lotDescID.Validators[0].(func(int) error)
+	metriceventFields := schema.MetricEvent{}.Fields()
+	_ = metriceventFields
+	// metriceventDescType is the schema descriptor for type field.
+	metriceventDescType := metriceventFields[1].Descriptor()
+	// metricevent.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	metricevent.TypeValidator = metriceventDescType.Validators[0].(func(string) error)
+	// metriceventDescPage is the schema descriptor for page field.
+	metriceventDescPage := metriceventFields[3].Descriptor()
+	// metricevent.DefaultPage holds the default value on creation for the page field.
+	metricevent.DefaultPage = metriceventDescPage.Default.(string)
+	// metriceventDescPageID is the schema descriptor for page_id field.
+	metriceventDescPageID := metriceventFields[4].Descriptor()
+	// metricevent.DefaultPageID holds the default value on creation for the page_id field.
+	metricevent.DefaultPageID = metriceventDescPageID.Default.(string)
+	// metriceventDescReferrer is the schema descriptor for referrer field.
+	metriceventDescReferrer := metriceventFields[5].Descriptor()
+	// metricevent.DefaultReferrer holds the default value on creation for the referrer field.
+	metricevent.DefaultReferrer = metriceventDescReferrer.Default.(string)
+	// metriceventDescUserAgent is the schema descriptor for user_agent field.
+	metriceventDescUserAgent := metriceventFields[6].Descriptor()
+	// metricevent.DefaultUserAgent holds the default value on creation for the user_agent field.
+	metricevent.DefaultUserAgent = metriceventDescUserAgent.Default.(string)
+	// metriceventDescCreatedAt is the schema descriptor for created_at field.
+	metriceventDescCreatedAt := metriceventFields[8].Descriptor()
+	// metricevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	metricevent.DefaultCreatedAt = metriceventDescCreatedAt.Default.(func() time.Time)
+	// metriceventDescUpdatedAt is the schema descriptor for updated_at field.
+	metriceventDescUpdatedAt := metriceventFields[9].Descriptor()
+	// metricevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	metricevent.DefaultUpdatedAt = metriceventDescUpdatedAt.Default.(func() time.Time)
+	// metricevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	metricevent.UpdateDefaultUpdatedAt = metriceventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// metriceventDescID is the schema descriptor for id field.
+	metriceventDescID := metriceventFields[0].Descriptor()
+	// metricevent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	metricevent.IDValidator = metriceventDescID.Validators[0].(func(int) error)
+	orderFields := schema.Order{}.Fields()
+	_ = orderFields
+	// orderDescOrderNo is the schema descriptor for order_no field.
+	orderDescOrderNo := orderFields[1].Descriptor()
+	// order.OrderNoValidator is a validator for the "order_no" field. It is called by the builders before save.
+	order.OrderNoValidator = orderDescOrderNo.Validators[0].(func(string) error)
+	// orderDescQuantity is the schema descriptor for quantity field.
+	orderDescQuantity := orderFields[5].Descriptor()
+	// order.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	order.QuantityValidator = orderDescQuantity.Validators[0].(func(int) error)
+	// orderDescRemark is the schema descriptor for remark field.
+	orderDescRemark := orderFields[7].Descriptor()
+	// order.DefaultRemark holds the default value on creation for the remark field.
+	order.DefaultRemark = orderDescRemark.Default.(string)
+	// orderDescCreatedAt is the schema descriptor for created_at field.
+	orderDescCreatedAt := orderFields[10].Descriptor()
+	// order.DefaultCreatedAt holds the default value on creation for the created_at field.
+	order.DefaultCreatedAt = orderDescCreatedAt.Default.(func() time.Time)
+	// orderDescUpdatedAt is the schema descriptor for updated_at field.
+	orderDescUpdatedAt := orderFields[11].Descriptor()
+	// order.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	order.DefaultUpdatedAt = orderDescUpdatedAt.Default.(func() time.Time)
+	// order.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	order.UpdateDefaultUpdatedAt = orderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// orderDescID is the schema descriptor for id field.
+	orderDescID := orderFields[0].Descriptor()
+	// order.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	order.IDValidator = orderDescID.Validators[0].(func(int) error)
+	postFields := schema.Post{}.Fields()
+	_ = postFields
+	// postDescTitle is the schema descriptor for title field.
+	postDescTitle := postFields[1].Descriptor()
+	// post.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	post.TitleValidator = postDescTitle.Validators[0].(func(string) error)
+	// postDescStatus is the schema descriptor for status field.
+	postDescStatus := postFields[5].Descriptor()
+	// post.DefaultStatus holds the default value on creation for the status field.
+	post.DefaultStatus = postDescStatus.Default.(string)
+	// postDescViewCount is the schema descriptor for view_count field.
+	postDescViewCount := postFields[6].Descriptor()
+	// post.DefaultViewCount holds the default value on creation for the view_count field.
+	post.DefaultViewCount = postDescViewCount.Default.(int)
+	// postDescCreatedAt is the schema descriptor for created_at field.
+	postDescCreatedAt := postFields[8].Descriptor()
+	// post.DefaultCreatedAt holds the default value on creation for the created_at field.
+	post.DefaultCreatedAt = postDescCreatedAt.Default.(func() time.Time)
+	// postDescUpdatedAt is the schema descriptor for updated_at field.
+	postDescUpdatedAt := postFields[9].Descriptor()
+	// post.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	post.DefaultUpdatedAt = postDescUpdatedAt.Default.(func() time.Time)
+	// post.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	post.UpdateDefaultUpdatedAt = postDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// postDescID is the schema descriptor for id field.
+	postDescID := postFields[0].Descriptor()
+	// post.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	post.IDValidator = postDescID.Validators[0].(func(int) error)
+	postcategoryFields := schema.PostCategory{}.Fields()
+	_ = postcategoryFields
+	// postcategoryDescName is the schema descriptor for name field.
+	postcategoryDescName := postcategoryFields[1].Descriptor()
+	// postcategory.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	postcategory.NameValidator = postcategoryDescName.Validators[0].(func(string) error)
+	// postcategoryDescSortOrder is the schema descriptor for sort_order field.
+	postcategoryDescSortOrder := postcategoryFields[5].Descriptor()
+	// postcategory.DefaultSortOrder holds the default value on creation for the sort_order field.
+	postcategory.DefaultSortOrder = postcategoryDescSortOrder.Default.(int)
+	// postcategoryDescIsActive is the schema descriptor for is_active field.
+	postcategoryDescIsActive := postcategoryFields[6].Descriptor()
+	// postcategory.DefaultIsActive holds the default value on creation for the is_active field.
+	postcategory.DefaultIsActive = postcategoryDescIsActive.Default.(bool)
+	// postcategoryDescCreatedAt is the schema descriptor for created_at field.
+	postcategoryDescCreatedAt := postcategoryFields[7].Descriptor()
+	// postcategory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	postcategory.DefaultCreatedAt = postcategoryDescCreatedAt.Default.(func() time.Time)
+	// postcategoryDescUpdatedAt is the schema descriptor for updated_at field.
+	postcategoryDescUpdatedAt := postcategoryFields[8].Descriptor()
+	// postcategory.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	postcategory.DefaultUpdatedAt = postcategoryDescUpdatedAt.Default.(func() time.Time)
+	// postcategory.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	postcategory.UpdateDefaultUpdatedAt = postcategoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// postcategoryDescID is the schema descriptor for id field.
+	postcategoryDescID := postcategoryFields[0].Descriptor()
+	// postcategory.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	postcategory.IDValidator = postcategoryDescID.Validators[0].(func(int) error)
+	posttagFields := schema.PostTag{}.Fields()
+	_ = posttagFields
+	// posttagDescName is the schema descriptor for name field.
+	posttagDescName := posttagFields[1].Descriptor()
+	// posttag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	posttag.NameValidator = posttagDescName.Validators[0].(func(string) error)
+	// posttagDescPostCount is the schema descriptor for post_count field.
+	posttagDescPostCount := posttagFields[5].Descriptor()
+	// posttag.DefaultPostCount holds the default value on creation for the post_count field.
+	posttag.DefaultPostCount = posttagDescPostCount.Default.(int)
+	// posttagDescIsActive is the schema descriptor for is_active field.
+	posttagDescIsActive := posttagFields[6].Descriptor()
+	// posttag.DefaultIsActive holds the default value on creation for the is_active field.
+	posttag.DefaultIsActive = posttagDescIsActive.Default.(bool)
+	// posttagDescCreatedAt is the schema descriptor for created_at field.
+	posttagDescCreatedAt := posttagFields[7].Descriptor()
+	// posttag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	posttag.DefaultCreatedAt = posttagDescCreatedAt.Default.(func() time.Time)
+	// posttagDescUpdatedAt is the schema descriptor for updated_at field.
+	posttagDescUpdatedAt := posttagFields[8].Descriptor()
+	// posttag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	posttag.DefaultUpdatedAt = posttagDescUpdatedAt.Default.(func() time.Time)
+	// posttag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	posttag.UpdateDefaultUpdatedAt = posttagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// posttagDescID is the schema descriptor for id field.
+	posttagDescID := posttagFields[0].Descriptor()
+	// posttag.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	posttag.IDValidator = posttagDescID.Validators[0].(func(int) error)
+	posttagrelationFields := schema.PostTagRelation{}.Fields()
+	_ = posttagrelationFields
+	// posttagrelationDescCreatedAt is the schema descriptor for created_at field.
+	posttagrelationDescCreatedAt := posttagrelationFields[3].Descriptor()
+	// posttagrelation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	posttagrelation.DefaultCreatedAt = posttagrelationDescCreatedAt.Default.(func() time.Time)
+	// posttagrelationDescID is the schema descriptor for id field.
+	posttagrelationDescID := posttagrelationFields[0].Descriptor()
+	// posttagrelation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	posttagrelation.IDValidator = posttagrelationDescID.Validators[0].(func(int) error)
+	productMixin := schema.Product{}.Mixin()
+	productMixinHooks0 := productMixin[0].Hooks()
+	product.Hooks[0] = productMixinHooks0[0]
+	productMixinInters0 := productMixin[0].Interceptors()
+	product.Interceptors[0] = productMixinInters0[0]
+	productMixinFields0 := productMixin[0].Fields()
+	_ = productMixinFields0
+	productFields := schema.Product{}.Fields()
+	_ = productFields
+	// productDescDeletedID is the schema descriptor for deleted_id field.
+	productDescDeletedID := productMixinFields0[1].Descriptor()
+	// product.DefaultDeletedID holds the default value on creation for the deleted_id field.
+	product.DefaultDeletedID = productDescDeletedID.Default.(int)
+	// productDescCode is the schema descriptor for code field.
+	productDescCode := productFields[1].Descriptor()
+	// product.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	product.CodeValidator = productDescCode.Validators[0].(func(string) error)
+	// productDescProductType is the schema descriptor for product_type field.
+	productDescProductType := productFields[2].Descriptor()
+	// product.DefaultProductType holds the default value on creation for the product_type field.
+	product.DefaultProductType = productDescProductType.Default.(string)
+	// productDescProductName is the schema descriptor for product_name field.
+	productDescProductName := productFields[3].Descriptor()
+	// product.ProductNameValidator is a validator for the "product_name" field. It is called by the builders before save.
+	product.ProductNameValidator = productDescProductName.Validators[0].(func(string) error)
+	// productDescWarrantyMonths is the schema descriptor for warranty_months field.
+	productDescWarrantyMonths := productFields[4].Descriptor()
+	// product.DefaultWarrantyMonths holds the default value on creation for the warranty_months field.
+	product.DefaultWarrantyMonths = productDescWarrantyMonths.Default.(int)
+	// product.WarrantyMonthsValidator is a validator for the "warranty_months" field. It is called by the builders before save.
+	product.WarrantyMonthsValidator = productDescWarrantyMonths.Validators[0].(func(int) error)
+	// productDescCreatedAt is the schema descriptor for created_at field.
+	productDescCreatedAt := productFields[6].Descriptor()
+	// product.DefaultCreatedAt holds the default value on creation for the created_at field.
+	product.DefaultCreatedAt = productDescCreatedAt.Default.(func() time.Time)
+	// productDescUpdatedAt is the schema descriptor for updated_at field.
+	productDescUpdatedAt := productFields[7].Descriptor()
+	// product.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	product.DefaultUpdatedAt = productDescUpdatedAt.Default.(func() time.Time)
+	// product.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	product.UpdateDefaultUpdatedAt = productDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// productDescID is the schema descriptor for id field.
+	productDescID := productFields[0].Descriptor()
+	// product.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	product.IDValidator = productDescID.Validators[0].(func(int) error)
+	productfeatureMixin := schema.ProductFeature{}.Mixin()
+	productfeature.Policy = privacy.NewPolicies(productfeatureMixin[1], schema.ProductFeature{})
+	productfeature.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := productfeature.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	productfeatureMixinHooks0 := productfeatureMixin[0].Hooks()
+
+	productfeature.Hooks[1] = productfeatureMixinHooks0[0]
+	productfeatureMixinInters0 := productfeatureMixin[0].Interceptors()
+	productfeatureMixinInters1 := productfeatureMixin[1].Interceptors()
+	productfeature.Interceptors[0] = productfeatureMixinInters0[0]
+	productfeature.Interceptors[1] = productfeatureMixinInters1[0]
+	productfeatureMixinFields0 := productfeatureMixin[0].Fields()
+	_ = productfeatureMixinFields0
+	productfeatureFields := schema.ProductFeature{}.Fields()
+	_ = productfeatureFields
+	// productfeatureDescDeletedID is the schema descriptor for deleted_id field.
+	productfeatureDescDeletedID := productfeatureMixinFields0[1].Descriptor()
+	// productfeature.DefaultDeletedID holds the default value on creation for the deleted_id field.
+	productfeature.DefaultDeletedID = productfeatureDescDeletedID.Default.(int)
+	// productfeatureDescFeatureName is the schema descriptor for feature_name field.
+	productfeatureDescFeatureName := productfeatureFields[1].Descriptor()
+	// productfeature.FeatureNameValidator is a validator for the "feature_name" field. It is called by the builders before save.
+	productfeature.FeatureNameValidator = productfeatureDescFeatureName.Validators[0].(func(string) error)
+	// productfeatureDescFeatureCode is the schema descriptor for feature_code field.
+	productfeatureDescFeatureCode := productfeatureFields[2].Descriptor()
+	// productfeature.FeatureCodeValidator is a validator for the "feature_code" field. It is called by the builders before save.
+	productfeature.FeatureCodeValidator = productfeatureDescFeatureCode.Validators[0].(func(string) error)
+	// productfeatureDescCreatedAt is the schema descriptor for created_at field.
+	productfeatureDescCreatedAt := productfeatureFields[4].Descriptor()
+	// productfeature.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productfeature.DefaultCreatedAt = productfeatureDescCreatedAt.Default.(func() time.Time)
+	// productfeatureDescUpdatedAt is the schema descriptor for updated_at field.
+	productfeatureDescUpdatedAt := productfeatureFields[5].Descriptor()
+	// productfeature.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	productfeature.DefaultUpdatedAt = productfeatureDescUpdatedAt.Default.(func() time.Time)
+	// productfeature.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	productfeature.UpdateDefaultUpdatedAt = productfeatureDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// productfeatureDescID is the schema descriptor for id field.
+	productfeatureDescID := productfeatureFields[0].Descriptor()
+	// productfeature.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	productfeature.IDValidator = productfeatureDescID.Validators[0].(func(int) error)
+	productinvitationFields := schema.ProductInvitation{}.Fields()
+	_ = productinvitationFields
+	// productinvitationDescEmail is the schema descriptor for email field.
+	productinvitationDescEmail := productinvitationFields[3].Descriptor()
+	// productinvitation.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	productinvitation.EmailValidator = productinvitationDescEmail.Validators[0].(func(string) error)
+	// productinvitationDescRemark is the schema descriptor for remark field.
+	productinvitationDescRemark := productinvitationFields[5].Descriptor()
+	// productinvitation.DefaultRemark holds the default value on creation for the remark field.
+	productinvitation.DefaultRemark = productinvitationDescRemark.Default.(string)
+	// productinvitationDescCreatedAt is the schema descriptor for created_at field.
+	productinvitationDescCreatedAt := productinvitationFields[11].Descriptor()
+	// productinvitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productinvitation.DefaultCreatedAt = productinvitationDescCreatedAt.Default.(func() time.Time)
+	// productinvitationDescUpdatedAt is the schema descriptor for updated_at field.
+	productinvitationDescUpdatedAt := productinvitationFields[12].Descriptor()
+	// productinvitation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	productinvitation.DefaultUpdatedAt = productinvitationDescUpdatedAt.Default.(func() time.Time)
+	// productinvitation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	productinvitation.UpdateDefaultUpdatedAt = productinvitationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// productinvitationDescID is the schema descriptor for id field.
+	productinvitationDescID := productinvitationFields[0].Descriptor()
+	// productinvitation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	productinvitation.IDValidator = productinvitationDescID.Validators[0].(func(int) error)
+	productmanagerFields := schema.ProductManager{}.Fields()
+	_ = productmanagerFields
+	// productmanagerDescCreatedAt is the schema descriptor for created_at field.
+	productmanagerDescCreatedAt := productmanagerFields[7].Descriptor()
+	// productmanager.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productmanager.DefaultCreatedAt = productmanagerDescCreatedAt.Default.(func() time.Time)
+	// productmanagerDescUpdatedAt is the schema descriptor for updated_at field.
+	productmanagerDescUpdatedAt := productmanagerFields[8].Descriptor()
+	// productmanager.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	productmanager.DefaultUpdatedAt = productmanagerDescUpdatedAt.Default.(func() time.Time)
+	// productmanager.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	productmanager.UpdateDefaultUpdatedAt = productmanagerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// productmanagerDescID is the schema descriptor for id field.
+	productmanagerDescID := productmanagerFields[0].Descriptor()
+	// productmanager.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	productmanager.IDValidator = productmanagerDescID.Validators[0].(func(int) error)
+	registrationinvitationFields := schema.RegistrationInvitation{}.Fields()
+	_ = registrationinvitationFields
+	// registrationinvitationDescMaxUses is the schema descriptor for max_uses field.
+	registrationinvitationDescMaxUses := registrationinvitationFields[4].Descriptor()
+	// registrationinvitation.DefaultMaxUses holds the default value on creation for the max_uses field.
+	registrationinvitation.DefaultMaxUses = registrationinvitationDescMaxUses.Default.(int)
+	// registrationinvitation.MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	registrationinvitation.MaxUsesValidator = registrationinvitationDescMaxUses.Validators[0].(func(int) error)
+	// registrationinvitationDescUsedCount is the schema descriptor for used_count field.
+	registrationinvitationDescUsedCount := registrationinvitationFields[5].Descriptor()
+	// registrationinvitation.DefaultUsedCount holds the default value on creation for the used_count field.
+	registrationinvitation.DefaultUsedCount = registrationinvitationDescUsedCount.Default.(int)
+	// registrationinvitation.UsedCountValidator is a validator for the "used_count" field. It is called by the builders before save.
+	registrationinvitation.UsedCountValidator = registrationinvitationDescUsedCount.Validators[0].(func(int) error)
+	// registrationinvitationDescRemark is the schema descriptor for remark field.
+	registrationinvitationDescRemark := registrationinvitationFields[8].Descriptor()
+	// registrationinvitation.DefaultRemark holds the default value on creation for the remark field.
+	registrationinvitation.DefaultRemark = registrationinvitationDescRemark.Default.(string)
+	// registrationinvitationDescCreatedAt is the schema descriptor for created_at field.
+	registrationinvitationDescCreatedAt := registrationinvitationFields[13].Descriptor()
+	// registrationinvitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	registrationinvitation.DefaultCreatedAt = registrationinvitationDescCreatedAt.Default.(func() time.Time)
+	// registrationinvitationDescUpdatedAt is the schema descriptor for updated_at field.
+	registrationinvitationDescUpdatedAt := registrationinvitationFields[14].Descriptor()
+	// registrationinvitation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	registrationinvitation.DefaultUpdatedAt = registrationinvitationDescUpdatedAt.Default.(func() time.Time)
+	// registrationinvitation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	registrationinvitation.UpdateDefaultUpdatedAt = registrationinvitationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// registrationinvitationDescID is the schema descriptor for id field.
+	registrationinvitationDescID := registrationinvitationFields[0].Descriptor()
+	// registrationinvitation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	registrationinvitation.IDValidator = registrationinvitationDescID.Validators[0].(func(int) error)
+	snallocatorFields := schema.SnAllocator{}.Fields()
+	_ = snallocatorFields
+	// snallocatorDescName is the schema descriptor for name field.
+	snallocatorDescName := snallocatorFields[2].Descriptor()
+	// snallocator.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	snallocator.NameValidator = snallocatorDescName.Validators[0].(func(string) error)
+	// snallocatorDescPrefix is the schema descriptor for prefix field.
+	snallocatorDescPrefix := snallocatorFields[3].Descriptor()
+	// snallocator.DefaultPrefix holds the default value on creation for the prefix field.
+	snallocator.DefaultPrefix = snallocatorDescPrefix.Default.(string)
+	// snallocatorDescDateFormat is the schema descriptor for date_format field.
+	snallocatorDescDateFormat := snallocatorFields[4].Descriptor()
+	// snallocator.DefaultDateFormat holds the default value on creation for the date_format field.
+	snallocator.DefaultDateFormat = snallocatorDescDateFormat.Default.(string)
+	// snallocatorDescCounterWidth is the schema descriptor for counter_width field.
+	snallocatorDescCounterWidth := snallocatorFields[5].Descriptor()
+	// snallocator.DefaultCounterWidth holds the default value on creation for the counter_width field.
+	snallocator.DefaultCounterWidth = snallocatorDescCounterWidth.Default.(int)
+	// snallocator.CounterWidthValidator is a validator for the "counter_width" field. It is called by the builders before save.
+	snallocator.CounterWidthValidator = snallocatorDescCounterWidth.Validators[0].(func(int) error)
+	// snallocatorDescNextCounter is the schema descriptor for next_counter field.
+	snallocatorDescNextCounter := snallocatorFields[7].Descriptor()
+	// snallocator.DefaultNextCounter holds the default value on creation for the next_counter field.
+	snallocator.DefaultNextCounter = snallocatorDescNextCounter.Default.(int64)
+	// snallocator.NextCounterValidator is a validator for the "next_counter" field. It is called by the builders before save.
+	snallocator.NextCounterValidator = snallocatorDescNextCounter.Validators[0].(func(int64) error)
+	// snallocatorDescCreatedAt is the schema descriptor for created_at field.
+	snallocatorDescCreatedAt := snallocatorFields[10].Descriptor()
+	// snallocator.DefaultCreatedAt holds the default value on creation for the created_at field.
+	snallocator.DefaultCreatedAt = snallocatorDescCreatedAt.Default.(func() time.Time)
+	// snallocatorDescUpdatedAt is the schema descriptor for updated_at field.
+	snallocatorDescUpdatedAt := snallocatorFields[11].Descriptor()
+	// snallocator.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	snallocator.DefaultUpdatedAt = snallocatorDescUpdatedAt.Default.(func() time.Time)
+	// snallocator.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	snallocator.UpdateDefaultUpdatedAt = snallocatorDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// snallocatorDescID is the schema descriptor for id field.
+	snallocatorDescID := snallocatorFields[0].Descriptor()
+	// snallocator.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	snallocator.IDValidator = snallocatorDescID.Validators[0].(func(int) error)
+	snblockFields := schema.SnBlock{}.Fields()
+	_ = snblockFields
+	// snblockDescPrefix is the schema descriptor for prefix field.
+	snblockDescPrefix := snblockFields[3].Descriptor()
+	// snblock.DefaultPrefix holds the default value on creation for the prefix field.
+	snblock.DefaultPrefix = snblockDescPrefix.Default.(string)
+	// snblockDescDateCode is the schema descriptor for date_code field.
+	snblockDescDateCode := snblockFields[4].Descriptor()
+	// snblock.DefaultDateCode holds the default value on creation for the date_code field.
+	snblock.DefaultDateCode = snblockDescDateCode.Default.(string)
+	// snblockDescCheckAlgorithm is the schema descriptor for check_algorithm field.
+	snblockDescCheckAlgorithm := snblockFields[6].Descriptor()
+	// snblock.DefaultCheckAlgorithm holds the default value on creation for the check_algorithm field.
+	snblock.DefaultCheckAlgorithm = snblockDescCheckAlgorithm.Default.(string)
+	// snblockDescCount is the schema descriptor for count field.
+	snblockDescCount := snblockFields[8].Descriptor()
+	// snblock.CountValidator is a validator for the "count" field. It is called by the builders before save.
+	snblock.CountValidator = snblockDescCount.Validators[0].(func(int) error)
+	// snblockDescDevicesCreated is the schema descriptor for devices_created field.
+	snblockDescDevicesCreated := snblockFields[11].Descriptor()
+	// snblock.DefaultDevicesCreated holds the default value on creation for the devices_created field.
+	snblock.DefaultDevicesCreated = snblockDescDevicesCreated.Default.(bool)
+	// snblockDescRemark is the schema descriptor for remark field.
+	snblockDescRemark := snblockFields[13].Descriptor()
+	// snblock.DefaultRemark holds the default value on creation for the remark field.
+	snblock.DefaultRemark = snblockDescRemark.Default.(string)
+	// snblockDescCreatedAt is the schema descriptor for created_at field.
+	snblockDescCreatedAt := snblockFields[15].Descriptor()
+	// snblock.DefaultCreatedAt holds the default value on creation for the created_at field.
+	snblock.DefaultCreatedAt = snblockDescCreatedAt.Default.(func() time.Time)
+	// snblockDescID is the schema descriptor for id field.
+	snblockDescID := snblockFields[0].Descriptor()
+	// snblock.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	snblock.IDValidator = snblockDescID.Validators[0].(func(int) error)
+	snruleFields := schema.SnRule{}.Fields()
+	_ = snruleFields
+	// snruleDescPrefix is the schema descriptor for prefix field.
+	snruleDescPrefix := snruleFields[2].Descriptor()
+	// snrule.DefaultPrefix holds the default value on creation for the prefix field.
+	snrule.DefaultPrefix = snruleDescPrefix.Default.(string)
+	// snruleDescPattern is the schema descriptor for pattern field.
+	snruleDescPattern := snruleFields[3].Descriptor()
+	// snrule.DefaultPattern holds the default value on creation for the pattern field.
+	snrule.DefaultPattern = snruleDescPattern.Default.(string)
+	// snruleDescMinLength is the schema descriptor for min_length field.
+	snruleDescMinLength := snruleFields[4].Descriptor()
+	// snrule.DefaultMinLength holds the default value on creation for the min_length field.
+	snrule.DefaultMinLength = snruleDescMinLength.Default.(int)
+	// snrule.MinLengthValidator is a validator for the "min_length" field. It is called by the builders before save.
+	snrule.MinLengthValidator = snruleDescMinLength.Validators[0].(func(int) error)
+	// snruleDescMaxLength is the schema descriptor for max_length field.
+	snruleDescMaxLength := snruleFields[5].Descriptor()
+	// snrule.DefaultMaxLength holds the default value on creation for the max_length field.
+	snrule.DefaultMaxLength = snruleDescMaxLength.Default.(int)
+	// snrule.MaxLengthValidator is a validator for the "max_length" field. It is called by the builders before save.
+	snrule.MaxLengthValidator = snruleDescMaxLength.Validators[0].(func(int) error)
+	// snruleDescCreatedAt is the schema descriptor for created_at field.
+	snruleDescCreatedAt := snruleFields[8].Descriptor()
+	// snrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	snrule.DefaultCreatedAt = snruleDescCreatedAt.Default.(func() time.Time)
+	// snruleDescUpdatedAt is the schema descriptor for updated_at field.
+	snruleDescUpdatedAt := snruleFields[9].Descriptor()
+	// snrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	snrule.DefaultUpdatedAt = snruleDescUpdatedAt.Default.(func() time.Time)
+	// snrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	snrule.UpdateDefaultUpdatedAt = snruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// snruleDescID is the schema descriptor for id field.
+	snruleDescID := snruleFields[0].Descriptor()
+	// snrule.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	snrule.IDValidator = snruleDescID.Validators[0].(func(int) error)
+	softwareversionMixin := schema.SoftwareVersion{}.Mixin()
+	softwareversion.Policy = privacy.NewPolicies(softwareversionMixin[1], schema.SoftwareVersion{})
+	softwareversion.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := softwareversion.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	softwareversionMixinHooks0 := softwareversionMixin[0].Hooks()
+
+	softwareversion.Hooks[1] = softwareversionMixinHooks0[0]
+	softwareversionMixinInters0 := softwareversionMixin[0].Interceptors()
+	softwareversionMixinInters1 := softwareversionMixin[1].Interceptors()
+	softwareversion.Interceptors[0] = softwareversionMixinInters0[0]
+	softwareversion.Interceptors[1] = softwareversionMixinInters1[0]
+	softwareversionMixinFields0 := softwareversionMixin[0].Fields()
+	_ = softwareversionMixinFields0
+	softwareversionFields := schema.SoftwareVersion{}.Fields()
+	_ = softwareversionFields
+	// softwareversionDescDeletedID is the schema descriptor for deleted_id field.
+	softwareversionDescDeletedID := softwareversionMixinFields0[1].Descriptor()
+	// softwareversion.DefaultDeletedID holds the default value on creation for the deleted_id field.
+	softwareversion.DefaultDeletedID = softwareversionDescDeletedID.Default.(int)
+	// softwareversionDescVersion is the schema descriptor for version field.
+	softwareversionDescVersion := softwareversionFields[1].Descriptor()
+	// softwareversion.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	softwareversion.VersionValidator = softwareversionDescVersion.Validators[0].(func(string) error)
+	// softwareversionDescCreatedAt is the schema descriptor for created_at field.
+	softwareversionDescCreatedAt := softwareversionFields[6].Descriptor()
+	// softwareversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	softwareversion.DefaultCreatedAt = softwareversionDescCreatedAt.Default.(func() time.Time)
+	// softwareversionDescUpdatedAt is the schema descriptor for updated_at field.
+	softwareversionDescUpdatedAt := softwareversionFields[7].Descriptor()
+	// softwareversion.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	softwareversion.DefaultUpdatedAt = softwareversionDescUpdatedAt.Default.(func() time.Time)
+	// softwareversion.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	softwareversion.UpdateDefaultUpdatedAt = softwareversionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	twofactorpolicyFields := schema.TwoFactorPolicy{}.Fields()
+	_ = twofactorpolicyFields
+	// twofactorpolicyDescRole is the schema descriptor for role field.
+	twofactorpolicyDescRole := twofactorpolicyFields[1].Descriptor()
+	// twofactorpolicy.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	twofactorpolicy.RoleValidator = twofactorpolicyDescRole.Validators[0].(func(string) error)
+	// twofactorpolicyDescCreatedAt is the schema descriptor for created_at field.
+	twofactorpolicyDescCreatedAt := twofactorpolicyFields[3].Descriptor()
+	// twofactorpolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	twofactorpolicy.DefaultCreatedAt = twofactorpolicyDescCreatedAt.Default.(func() time.Time)
+	// twofactorpolicyDescID is the schema descriptor for id field.
+	twofactorpolicyDescID := twofactorpolicyFields[0].Descriptor()
+	// twofactorpolicy.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	twofactorpolicy.IDValidator = twofactorpolicyDescID.Validators[0].(func(int) error)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[1].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescPassword is the schema descriptor for password field.
+	userDescPassword := userFields[2].Descriptor()
+	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
+	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
+	// userDescIsEnabled is the schema descriptor for is_enabled field.
+	userDescIsEnabled := userFields[3].Descriptor()
+	// user.DefaultIsEnabled holds the default value on creation for the is_enabled field.
+	user.DefaultIsEnabled = userDescIsEnabled.Default.(bool)
+	// userDescEmailVerified is the schema descriptor for email_verified field.
+	userDescEmailVerified := userFields[4].Descriptor()
+	// user.DefaultEmailVerified holds the default value on creation for the email_verified field.
+	user.DefaultEmailVerified = userDescEmailVerified.Default.(bool)
+	// userDescIsSystemAdmin is the schema descriptor for is_system_admin field.
+	userDescIsSystemAdmin := userFields[5].Descriptor()
+	// user.DefaultIsSystemAdmin holds the default value on creation for the is_system_admin field.
+	user.DefaultIsSystemAdmin = userDescIsSystemAdmin.Default.(bool)
+	// userDescIsService is the schema descriptor for is_service field.
+	userDescIsService := userFields[6].Descriptor()
+	// user.DefaultIsService holds the default value on creation for the is_service field.
+	user.DefaultIsService = userDescIsService.Default.(bool)
+	// userDescTotpEnabled is the schema descriptor for totp_enabled field.
+	userDescTotpEnabled := userFields[8].Descriptor()
+	// user.DefaultTotpEnabled holds the default value on creation for the totp_enabled field.
+	user.DefaultTotpEnabled = userDescTotpEnabled.Default.(bool)
+	// userDescTotpLastStep is the schema descriptor for totp_last_step field.
+	userDescTotpLastStep := userFields[10].Descriptor()
+	// user.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	user.DefaultTotpLastStep = userDescTotpLastStep.Default.(int64)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[14].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[15].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+}
 
 const (
 	Version = "v0.12.4"                                         // Version of ent codegen.
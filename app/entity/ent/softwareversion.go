@@ -19,6 +19,10 @@ type SoftwareVersion struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 删除标记，正常记录为0，删除后为记录ID，用于唯一索引
+	DeletedID int `json:"deleted_id,omitempty"`
 	// 产品ID
 	ProductID int `json:"product_id,omitempty"`
 	// 版本号
@@ -105,11 +109,11 @@ func (*SoftwareVersion) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case softwareversion.FieldID, softwareversion.FieldProductID, softwareversion.FieldCreatedBy:
+		case softwareversion.FieldID, softwareversion.FieldDeletedID, softwareversion.FieldProductID, softwareversion.FieldCreatedBy:
 			values[i] = new(sql.NullInt64)
 		case softwareversion.FieldVersion, softwareversion.FieldUpdateLog, softwareversion.FieldRemark:
 			values[i] = new(sql.NullString)
-		case softwareversion.FieldReleaseDate, softwareversion.FieldCreatedAt, softwareversion.FieldUpdatedAt:
+		case softwareversion.FieldDeletedAt, softwareversion.FieldReleaseDate, softwareversion.FieldCreatedAt, softwareversion.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -132,6 +136,19 @@ func (sv *SoftwareVersion) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			sv.ID = int(value.Int64)
+		case softwareversion.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				sv.DeletedAt = new(time.Time)
+				*sv.DeletedAt = value.Time
+			}
+		case softwareversion.FieldDeletedID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_id", values[i])
+			} else if value.Valid {
+				sv.DeletedID = int(value.Int64)
+			}
 		case softwareversion.FieldProductID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field product_id", values[i])
@@ -236,6 +253,14 @@ func (sv *SoftwareVersion) String() string {
 	var builder strings.Builder
 	builder.WriteString("SoftwareVersion(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", sv.ID))
+	if v := sv.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deleted_id=")
+	builder.WriteString(fmt.Sprintf("%v", sv.DeletedID))
+	builder.WriteString(", ")
 	builder.WriteString("product_id=")
 	builder.WriteString(fmt.Sprintf("%v", sv.ProductID))
 	builder.WriteString(", ")
@@ -24,6 +24,34 @@ type SoftwareVersionCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (svc *SoftwareVersionCreate) SetDeletedAt(t time.Time) *SoftwareVersionCreate {
+	svc.mutation.SetDeletedAt(t)
+	return svc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (svc *SoftwareVersionCreate) SetNillableDeletedAt(t *time.Time) *SoftwareVersionCreate {
+	if t != nil {
+		svc.SetDeletedAt(*t)
+	}
+	return svc
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (svc *SoftwareVersionCreate) SetDeletedID(i int) *SoftwareVersionCreate {
+	svc.mutation.SetDeletedID(i)
+	return svc
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (svc *SoftwareVersionCreate) SetNillableDeletedID(i *int) *SoftwareVersionCreate {
+	if i != nil {
+		svc.SetDeletedID(*i)
+	}
+	return svc
+}
+
 // SetProductID sets the "product_id" field.
 func (svc *SoftwareVersionCreate) SetProductID(i int) *SoftwareVersionCreate {
 	svc.mutation.SetProductID(i)
@@ -157,7 +185,9 @@ func (svc *SoftwareVersionCreate) Mutation() *SoftwareVersionMutation {
 
 // Save creates the SoftwareVersion in the database.
 func (svc *SoftwareVersionCreate) Save(ctx context.Context) (*SoftwareVersion, error) {
-	svc.defaults()
+	if err := svc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, svc.sqlSave, svc.mutation, svc.hooks)
 }
 
@@ -184,19 +214,33 @@ func (svc *SoftwareVersionCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (svc *SoftwareVersionCreate) defaults() {
+func (svc *SoftwareVersionCreate) defaults() error {
+	if _, ok := svc.mutation.DeletedID(); !ok {
+		v := softwareversion.DefaultDeletedID
+		svc.mutation.SetDeletedID(v)
+	}
 	if _, ok := svc.mutation.CreatedAt(); !ok {
+		if softwareversion.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized softwareversion.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := softwareversion.DefaultCreatedAt()
 		svc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := svc.mutation.UpdatedAt(); !ok {
+		if softwareversion.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized softwareversion.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := softwareversion.DefaultUpdatedAt()
 		svc.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (svc *SoftwareVersionCreate) check() error {
+	if _, ok := svc.mutation.DeletedID(); !ok {
+		return &ValidationError{Name: "deleted_id", err: errors.New(`ent: missing required field "SoftwareVersion.deleted_id"`)}
+	}
 	if _, ok := svc.mutation.ProductID(); !ok {
 		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "SoftwareVersion.product_id"`)}
 	}
@@ -252,6 +296,14 @@ func (svc *SoftwareVersionCreate) createSpec() (*SoftwareVersion, *sqlgraph.Crea
 		_node = &SoftwareVersion{config: svc.config}
 		_spec = sqlgraph.NewCreateSpec(softwareversion.Table, sqlgraph.NewFieldSpec(softwareversion.FieldID, field.TypeInt))
 	)
+	if value, ok := svc.mutation.DeletedAt(); ok {
+		_spec.SetField(softwareversion.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := svc.mutation.DeletedID(); ok {
+		_spec.SetField(softwareversion.FieldDeletedID, field.TypeInt, value)
+		_node.DeletedID = value
+	}
 	if value, ok := svc.mutation.Version(); ok {
 		_spec.SetField(softwareversion.FieldVersion, field.TypeString, value)
 		_node.Version = value
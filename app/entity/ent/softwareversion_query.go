@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
@@ -14,6 +15,7 @@ import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/softwareversion"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -30,6 +32,7 @@ type SoftwareVersionQuery struct {
 	withFirmwareVersions *FirmwareVersionQuery
 	withProduct          *ProductQuery
 	withCreator          *UserQuery
+	modifiers            []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -406,12 +409,12 @@ func (svq *SoftwareVersionQuery) WithCreator(opts ...func(*UserQuery)) *Software
 // Example:
 //
 //	var v []struct {
-//		ProductID int `json:"product_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.SoftwareVersion.Query().
-//		GroupBy(softwareversion.FieldProductID).
+//		GroupBy(softwareversion.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (svq *SoftwareVersionQuery) GroupBy(field string, fields ...string) *SoftwareVersionGroupBy {
@@ -429,11 +432,11 @@ func (svq *SoftwareVersionQuery) GroupBy(field string, fields ...string) *Softwa
 // Example:
 //
 //	var v []struct {
-//		ProductID int `json:"product_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.SoftwareVersion.Query().
-//		Select(softwareversion.FieldProductID).
+//		Select(softwareversion.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (svq *SoftwareVersionQuery) Select(fields ...string) *SoftwareVersionSelect {
 	svq.ctx.Fields = append(svq.ctx.Fields, fields...)
@@ -471,6 +474,12 @@ func (svq *SoftwareVersionQuery) prepareQuery(ctx context.Context) error {
 		}
 		svq.sql = prev
 	}
+	if softwareversion.Policy == nil {
+		return errors.New("ent: uninitialized softwareversion.Policy (forgotten import ent/runtime?)")
+	}
+	if err := softwareversion.Policy.EvalQuery(ctx, svq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -494,6 +503,9 @@ func (svq *SoftwareVersionQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(svq.modifiers) > 0 {
+		_spec.Modifiers = svq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -717,6 +729,9 @@ func (svq *SoftwareVersionQuery) loadCreator(ctx context.Context, query *UserQue
 
 func (svq *SoftwareVersionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := svq.querySpec()
+	if len(svq.modifiers) > 0 {
+		_spec.Modifiers = svq.modifiers
+	}
 	_spec.Node.Columns = svq.ctx.Fields
 	if len(svq.ctx.Fields) > 0 {
 		_spec.Unique = svq.ctx.Unique != nil && *svq.ctx.Unique
@@ -785,6 +800,9 @@ func (svq *SoftwareVersionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if svq.ctx.Unique != nil && *svq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range svq.modifiers {
+		m(selector)
+	}
 	for _, p := range svq.predicates {
 		p(selector)
 	}
@@ -802,6 +820,32 @@ func (svq *SoftwareVersionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (svq *SoftwareVersionQuery) ForUpdate(opts ...sql.LockOption) *SoftwareVersionQuery {
+	if svq.driver.Dialect() == dialect.Postgres {
+		svq.Unique(false)
+	}
+	svq.modifiers = append(svq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return svq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (svq *SoftwareVersionQuery) ForShare(opts ...sql.LockOption) *SoftwareVersionQuery {
+	if svq.driver.Dialect() == dialect.Postgres {
+		svq.Unique(false)
+	}
+	svq.modifiers = append(svq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return svq
+}
+
 // SoftwareVersionGroupBy is the group-by builder for SoftwareVersion entities.
 type SoftwareVersionGroupBy struct {
 	selector
@@ -32,6 +32,47 @@ func (svu *SoftwareVersionUpdate) Where(ps ...predicate.SoftwareVersion) *Softwa
 	return svu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (svu *SoftwareVersionUpdate) SetDeletedAt(t time.Time) *SoftwareVersionUpdate {
+	svu.mutation.SetDeletedAt(t)
+	return svu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (svu *SoftwareVersionUpdate) SetNillableDeletedAt(t *time.Time) *SoftwareVersionUpdate {
+	if t != nil {
+		svu.SetDeletedAt(*t)
+	}
+	return svu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (svu *SoftwareVersionUpdate) ClearDeletedAt() *SoftwareVersionUpdate {
+	svu.mutation.ClearDeletedAt()
+	return svu
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (svu *SoftwareVersionUpdate) SetDeletedID(i int) *SoftwareVersionUpdate {
+	svu.mutation.ResetDeletedID()
+	svu.mutation.SetDeletedID(i)
+	return svu
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (svu *SoftwareVersionUpdate) SetNillableDeletedID(i *int) *SoftwareVersionUpdate {
+	if i != nil {
+		svu.SetDeletedID(*i)
+	}
+	return svu
+}
+
+// AddDeletedID adds i to the "deleted_id" field.
+func (svu *SoftwareVersionUpdate) AddDeletedID(i int) *SoftwareVersionUpdate {
+	svu.mutation.AddDeletedID(i)
+	return svu
+}
+
 // SetProductID sets the "product_id" field.
 func (svu *SoftwareVersionUpdate) SetProductID(i int) *SoftwareVersionUpdate {
 	svu.mutation.SetProductID(i)
@@ -223,7 +264,9 @@ func (svu *SoftwareVersionUpdate) ClearCreator() *SoftwareVersionUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (svu *SoftwareVersionUpdate) Save(ctx context.Context) (int, error) {
-	svu.defaults()
+	if err := svu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, svu.sqlSave, svu.mutation, svu.hooks)
 }
 
@@ -250,11 +293,15 @@ func (svu *SoftwareVersionUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (svu *SoftwareVersionUpdate) defaults() {
+func (svu *SoftwareVersionUpdate) defaults() error {
 	if _, ok := svu.mutation.UpdatedAt(); !ok {
+		if softwareversion.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized softwareversion.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := softwareversion.UpdateDefaultUpdatedAt()
 		svu.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -285,6 +332,18 @@ func (svu *SoftwareVersionUpdate) sqlSave(ctx context.Context) (n int, err error
 			}
 		}
 	}
+	if value, ok := svu.mutation.DeletedAt(); ok {
+		_spec.SetField(softwareversion.FieldDeletedAt, field.TypeTime, value)
+	}
+	if svu.mutation.DeletedAtCleared() {
+		_spec.ClearField(softwareversion.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := svu.mutation.DeletedID(); ok {
+		_spec.SetField(softwareversion.FieldDeletedID, field.TypeInt, value)
+	}
+	if value, ok := svu.mutation.AddedDeletedID(); ok {
+		_spec.AddField(softwareversion.FieldDeletedID, field.TypeInt, value)
+	}
 	if value, ok := svu.mutation.Version(); ok {
 		_spec.SetField(softwareversion.FieldVersion, field.TypeString, value)
 	}
@@ -477,6 +536,47 @@ type SoftwareVersionUpdateOne struct {
 	mutation *SoftwareVersionMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (svuo *SoftwareVersionUpdateOne) SetDeletedAt(t time.Time) *SoftwareVersionUpdateOne {
+	svuo.mutation.SetDeletedAt(t)
+	return svuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (svuo *SoftwareVersionUpdateOne) SetNillableDeletedAt(t *time.Time) *SoftwareVersionUpdateOne {
+	if t != nil {
+		svuo.SetDeletedAt(*t)
+	}
+	return svuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (svuo *SoftwareVersionUpdateOne) ClearDeletedAt() *SoftwareVersionUpdateOne {
+	svuo.mutation.ClearDeletedAt()
+	return svuo
+}
+
+// SetDeletedID sets the "deleted_id" field.
+func (svuo *SoftwareVersionUpdateOne) SetDeletedID(i int) *SoftwareVersionUpdateOne {
+	svuo.mutation.ResetDeletedID()
+	svuo.mutation.SetDeletedID(i)
+	return svuo
+}
+
+// SetNillableDeletedID sets the "deleted_id" field if the given value is not nil.
+func (svuo *SoftwareVersionUpdateOne) SetNillableDeletedID(i *int) *SoftwareVersionUpdateOne {
+	if i != nil {
+		svuo.SetDeletedID(*i)
+	}
+	return svuo
+}
+
+// AddDeletedID adds i to the "deleted_id" field.
+func (svuo *SoftwareVersionUpdateOne) AddDeletedID(i int) *SoftwareVersionUpdateOne {
+	svuo.mutation.AddDeletedID(i)
+	return svuo
+}
+
 // SetProductID sets the "product_id" field.
 func (svuo *SoftwareVersionUpdateOne) SetProductID(i int) *SoftwareVersionUpdateOne {
 	svuo.mutation.SetProductID(i)
@@ -681,7 +781,9 @@ func (svuo *SoftwareVersionUpdateOne) Select(field string, fields ...string) *So
 
 // Save executes the query and returns the updated SoftwareVersion entity.
 func (svuo *SoftwareVersionUpdateOne) Save(ctx context.Context) (*SoftwareVersion, error) {
-	svuo.defaults()
+	if err := svuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, svuo.sqlSave, svuo.mutation, svuo.hooks)
 }
 
@@ -708,11 +810,15 @@ func (svuo *SoftwareVersionUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (svuo *SoftwareVersionUpdateOne) defaults() {
+func (svuo *SoftwareVersionUpdateOne) defaults() error {
 	if _, ok := svuo.mutation.UpdatedAt(); !ok {
+		if softwareversion.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized softwareversion.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := softwareversion.UpdateDefaultUpdatedAt()
 		svuo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -760,6 +866,18 @@ func (svuo *SoftwareVersionUpdateOne) sqlSave(ctx context.Context) (_node *Softw
 			}
 		}
 	}
+	if value, ok := svuo.mutation.DeletedAt(); ok {
+		_spec.SetField(softwareversion.FieldDeletedAt, field.TypeTime, value)
+	}
+	if svuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(softwareversion.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := svuo.mutation.DeletedID(); ok {
+		_spec.SetField(softwareversion.FieldDeletedID, field.TypeInt, value)
+	}
+	if value, ok := svuo.mutation.AddedDeletedID(); ok {
+		_spec.AddField(softwareversion.FieldDeletedID, field.TypeInt, value)
+	}
 	if value, ok := svuo.mutation.Version(); ok {
 		_spec.SetField(softwareversion.FieldVersion, field.TypeString, value)
 	}
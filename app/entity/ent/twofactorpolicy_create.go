@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/twofactorpolicy"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TwoFactorPolicyCreate is the builder for creating a TwoFactorPolicy entity.
+type TwoFactorPolicyCreate struct {
+	config
+	mutation *TwoFactorPolicyMutation
+	hooks    []Hook
+}
+
+// SetRole sets the "role" field.
+func (tfpc *TwoFactorPolicyCreate) SetRole(s string) *TwoFactorPolicyCreate {
+	tfpc.mutation.SetRole(s)
+	return tfpc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (tfpc *TwoFactorPolicyCreate) SetCreatedBy(i int) *TwoFactorPolicyCreate {
+	tfpc.mutation.SetCreatedBy(i)
+	return tfpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tfpc *TwoFactorPolicyCreate) SetCreatedAt(t time.Time) *TwoFactorPolicyCreate {
+	tfpc.mutation.SetCreatedAt(t)
+	return tfpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tfpc *TwoFactorPolicyCreate) SetNillableCreatedAt(t *time.Time) *TwoFactorPolicyCreate {
+	if t != nil {
+		tfpc.SetCreatedAt(*t)
+	}
+	return tfpc
+}
+
+// SetID sets the "id" field.
+func (tfpc *TwoFactorPolicyCreate) SetID(i int) *TwoFactorPolicyCreate {
+	tfpc.mutation.SetID(i)
+	return tfpc
+}
+
+// Mutation returns the TwoFactorPolicyMutation object of the builder.
+func (tfpc *TwoFactorPolicyCreate) Mutation() *TwoFactorPolicyMutation {
+	return tfpc.mutation
+}
+
+// Save creates the TwoFactorPolicy in the database.
+func (tfpc *TwoFactorPolicyCreate) Save(ctx context.Context) (*TwoFactorPolicy, error) {
+	tfpc.defaults()
+	return withHooks(ctx, tfpc.sqlSave, tfpc.mutation, tfpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tfpc *TwoFactorPolicyCreate) SaveX(ctx context.Context) *TwoFactorPolicy {
+	v, err := tfpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tfpc *TwoFactorPolicyCreate) Exec(ctx context.Context) error {
+	_, err := tfpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tfpc *TwoFactorPolicyCreate) ExecX(ctx context.Context) {
+	if err := tfpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tfpc *TwoFactorPolicyCreate) defaults() {
+	if _, ok := tfpc.mutation.CreatedAt(); !ok {
+		v := twofactorpolicy.DefaultCreatedAt()
+		tfpc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tfpc *TwoFactorPolicyCreate) check() error {
+	if _, ok := tfpc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "TwoFactorPolicy.role"`)}
+	}
+	if v, ok := tfpc.mutation.Role(); ok {
+		if err := twofactorpolicy.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TwoFactorPolicy.role": %w`, err)}
+		}
+	}
+	if _, ok := tfpc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "TwoFactorPolicy.created_by"`)}
+	}
+	if _, ok := tfpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TwoFactorPolicy.created_at"`)}
+	}
+	if v, ok := tfpc.mutation.ID(); ok {
+		if err := twofactorpolicy.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TwoFactorPolicy.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tfpc *TwoFactorPolicyCreate) sqlSave(ctx context.Context) (*TwoFactorPolicy, error) {
+	if err := tfpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tfpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tfpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	tfpc.mutation.id = &_node.ID
+	tfpc.mutation.done = true
+	return _node, nil
+}
+
+func (tfpc *TwoFactorPolicyCreate) createSpec() (*TwoFactorPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TwoFactorPolicy{config: tfpc.config}
+		_spec = sqlgraph.NewCreateSpec(twofactorpolicy.Table, sqlgraph.NewFieldSpec(twofactorpolicy.FieldID, field.TypeInt))
+	)
+	if id, ok := tfpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tfpc.mutation.Role(); ok {
+		_spec.SetField(twofactorpolicy.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if value, ok := tfpc.mutation.CreatedBy(); ok {
+		_spec.SetField(twofactorpolicy.FieldCreatedBy, field.TypeInt, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := tfpc.mutation.CreatedAt(); ok {
+		_spec.SetField(twofactorpolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// TwoFactorPolicyCreateBulk is the builder for creating many TwoFactorPolicy entities in bulk.
+type TwoFactorPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*TwoFactorPolicyCreate
+}
+
+// Save creates the TwoFactorPolicy entities in the database.
+func (tfpcb *TwoFactorPolicyCreateBulk) Save(ctx context.Context) ([]*TwoFactorPolicy, error) {
+	if tfpcb.err != nil {
+		return nil, tfpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tfpcb.builders))
+	nodes := make([]*TwoFactorPolicy, len(tfpcb.builders))
+	mutators := make([]Mutator, len(tfpcb.builders))
+	for i := range tfpcb.builders {
+		func(i int, root context.Context) {
+			builder := tfpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TwoFactorPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tfpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tfpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tfpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tfpcb *TwoFactorPolicyCreateBulk) SaveX(ctx context.Context) []*TwoFactorPolicy {
+	v, err := tfpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tfpcb *TwoFactorPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := tfpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tfpcb *TwoFactorPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := tfpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/twofactorpolicy"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TwoFactorPolicyQuery is the builder for querying TwoFactorPolicy entities.
+type TwoFactorPolicyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []twofactorpolicy.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TwoFactorPolicy
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TwoFactorPolicyQuery builder.
+func (tfpq *TwoFactorPolicyQuery) Where(ps ...predicate.TwoFactorPolicy) *TwoFactorPolicyQuery {
+	tfpq.predicates = append(tfpq.predicates, ps...)
+	return tfpq
+}
+
+// Limit the number of records to be returned by this query.
+func (tfpq *TwoFactorPolicyQuery) Limit(limit int) *TwoFactorPolicyQuery {
+	tfpq.ctx.Limit = &limit
+	return tfpq
+}
+
+// Offset to start from.
+func (tfpq *TwoFactorPolicyQuery) Offset(offset int) *TwoFactorPolicyQuery {
+	tfpq.ctx.Offset = &offset
+	return tfpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tfpq *TwoFactorPolicyQuery) Unique(unique bool) *TwoFactorPolicyQuery {
+	tfpq.ctx.Unique = &unique
+	return tfpq
+}
+
+// Order specifies how the records should be ordered.
+func (tfpq *TwoFactorPolicyQuery) Order(o ...twofactorpolicy.OrderOption) *TwoFactorPolicyQuery {
+	tfpq.order = append(tfpq.order, o...)
+	return tfpq
+}
+
+// First returns the first TwoFactorPolicy entity from the query.
+// Returns a *NotFoundError when no TwoFactorPolicy was found.
+func (tfpq *TwoFactorPolicyQuery) First(ctx context.Context) (*TwoFactorPolicy, error) {
+	nodes, err := tfpq.Limit(1).All(setContextOp(ctx, tfpq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{twofactorpolicy.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tfpq *TwoFactorPolicyQuery) FirstX(ctx context.Context) *TwoFactorPolicy {
+	node, err := tfpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TwoFactorPolicy ID from the query.
+// Returns a *NotFoundError when no TwoFactorPolicy ID was found.
+func (tfpq *TwoFactorPolicyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tfpq.Limit(1).IDs(setContextOp(ctx, tfpq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{twofactorpolicy.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tfpq *TwoFactorPolicyQuery) FirstIDX(ctx context.Context) int {
+	id, err := tfpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TwoFactorPolicy entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TwoFactorPolicy entity is found.
+// Returns a *NotFoundError when no TwoFactorPolicy entities are found.
+func (tfpq *TwoFactorPolicyQuery) Only(ctx context.Context) (*TwoFactorPolicy, error) {
+	nodes, err := tfpq.Limit(2).All(setContextOp(ctx, tfpq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{twofactorpolicy.Label}
+	default:
+		return nil, &NotSingularError{twofactorpolicy.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tfpq *TwoFactorPolicyQuery) OnlyX(ctx context.Context) *TwoFactorPolicy {
+	node, err := tfpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TwoFactorPolicy ID in the query.
+// Returns a *NotSingularError when more than one TwoFactorPolicy ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tfpq *TwoFactorPolicyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tfpq.Limit(2).IDs(setContextOp(ctx, tfpq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{twofactorpolicy.Label}
+	default:
+		err = &NotSingularError{twofactorpolicy.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tfpq *TwoFactorPolicyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tfpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TwoFactorPolicies.
+func (tfpq *TwoFactorPolicyQuery) All(ctx context.Context) ([]*TwoFactorPolicy, error) {
+	ctx = setContextOp(ctx, tfpq.ctx, "All")
+	if err := tfpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TwoFactorPolicy, *TwoFactorPolicyQuery]()
+	return withInterceptors[[]*TwoFactorPolicy](ctx, tfpq, qr, tfpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tfpq *TwoFactorPolicyQuery) AllX(ctx context.Context) []*TwoFactorPolicy {
+	nodes, err := tfpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TwoFactorPolicy IDs.
+func (tfpq *TwoFactorPolicyQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if tfpq.ctx.Unique == nil && tfpq.path != nil {
+		tfpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tfpq.ctx, "IDs")
+	if err = tfpq.Select(twofactorpolicy.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tfpq *TwoFactorPolicyQuery) IDsX(ctx context.Context) []int {
+	ids, err := tfpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tfpq *TwoFactorPolicyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tfpq.ctx, "Count")
+	if err := tfpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tfpq, querierCount[*TwoFactorPolicyQuery](), tfpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tfpq *TwoFactorPolicyQuery) CountX(ctx context.Context) int {
+	count, err := tfpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tfpq *TwoFactorPolicyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tfpq.ctx, "Exist")
+	switch _, err := tfpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tfpq *TwoFactorPolicyQuery) ExistX(ctx context.Context) bool {
+	exist, err := tfpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TwoFactorPolicyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tfpq *TwoFactorPolicyQuery) Clone() *TwoFactorPolicyQuery {
+	if tfpq == nil {
+		return nil
+	}
+	return &TwoFactorPolicyQuery{
+		config:     tfpq.config,
+		ctx:        tfpq.ctx.Clone(),
+		order:      append([]twofactorpolicy.OrderOption{}, tfpq.order...),
+		inters:     append([]Interceptor{}, tfpq.inters...),
+		predicates: append([]predicate.TwoFactorPolicy{}, tfpq.predicates...),
+		// clone intermediate query.
+		sql:  tfpq.sql.Clone(),
+		path: tfpq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Role string `json:"role,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TwoFactorPolicy.Query().
+//		GroupBy(twofactorpolicy.FieldRole).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tfpq *TwoFactorPolicyQuery) GroupBy(field string, fields ...string) *TwoFactorPolicyGroupBy {
+	tfpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TwoFactorPolicyGroupBy{build: tfpq}
+	grbuild.flds = &tfpq.ctx.Fields
+	grbuild.label = twofactorpolicy.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Role string `json:"role,omitempty"`
+//	}
+//
+//	client.TwoFactorPolicy.Query().
+//		Select(twofactorpolicy.FieldRole).
+//		Scan(ctx, &v)
+func (tfpq *TwoFactorPolicyQuery) Select(fields ...string) *TwoFactorPolicySelect {
+	tfpq.ctx.Fields = append(tfpq.ctx.Fields, fields...)
+	sbuild := &TwoFactorPolicySelect{TwoFactorPolicyQuery: tfpq}
+	sbuild.label = twofactorpolicy.Label
+	sbuild.flds, sbuild.scan = &tfpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TwoFactorPolicySelect configured with the given aggregations.
+func (tfpq *TwoFactorPolicyQuery) Aggregate(fns ...AggregateFunc) *TwoFactorPolicySelect {
+	return tfpq.Select().Aggregate(fns...)
+}
+
+func (tfpq *TwoFactorPolicyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tfpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tfpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tfpq.ctx.Fields {
+		if !twofactorpolicy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tfpq.path != nil {
+		prev, err := tfpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tfpq.sql = prev
+	}
+	return nil
+}
+
+func (tfpq *TwoFactorPolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TwoFactorPolicy, error) {
+	var (
+		nodes = []*TwoFactorPolicy{}
+		_spec = tfpq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TwoFactorPolicy).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TwoFactorPolicy{config: tfpq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(tfpq.modifiers) > 0 {
+		_spec.Modifiers = tfpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tfpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (tfpq *TwoFactorPolicyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tfpq.querySpec()
+	if len(tfpq.modifiers) > 0 {
+		_spec.Modifiers = tfpq.modifiers
+	}
+	_spec.Node.Columns = tfpq.ctx.Fields
+	if len(tfpq.ctx.Fields) > 0 {
+		_spec.Unique = tfpq.ctx.Unique != nil && *tfpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tfpq.driver, _spec)
+}
+
+func (tfpq *TwoFactorPolicyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(twofactorpolicy.Table, twofactorpolicy.Columns, sqlgraph.NewFieldSpec(twofactorpolicy.FieldID, field.TypeInt))
+	_spec.From = tfpq.sql
+	if unique := tfpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tfpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tfpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, twofactorpolicy.FieldID)
+		for i := range fields {
+			if fields[i] != twofactorpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tfpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tfpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tfpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tfpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tfpq *TwoFactorPolicyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tfpq.driver.Dialect())
+	t1 := builder.Table(twofactorpolicy.Table)
+	columns := tfpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = twofactorpolicy.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tfpq.sql != nil {
+		selector = tfpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tfpq.ctx.Unique != nil && *tfpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range tfpq.modifiers {
+		m(selector)
+	}
+	for _, p := range tfpq.predicates {
+		p(selector)
+	}
+	for _, p := range tfpq.order {
+		p(selector)
+	}
+	if offset := tfpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tfpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tfpq *TwoFactorPolicyQuery) ForUpdate(opts ...sql.LockOption) *TwoFactorPolicyQuery {
+	if tfpq.driver.Dialect() == dialect.Postgres {
+		tfpq.Unique(false)
+	}
+	tfpq.modifiers = append(tfpq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tfpq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tfpq *TwoFactorPolicyQuery) ForShare(opts ...sql.LockOption) *TwoFactorPolicyQuery {
+	if tfpq.driver.Dialect() == dialect.Postgres {
+		tfpq.Unique(false)
+	}
+	tfpq.modifiers = append(tfpq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tfpq
+}
+
+// TwoFactorPolicyGroupBy is the group-by builder for TwoFactorPolicy entities.
+type TwoFactorPolicyGroupBy struct {
+	selector
+	build *TwoFactorPolicyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tfpgb *TwoFactorPolicyGroupBy) Aggregate(fns ...AggregateFunc) *TwoFactorPolicyGroupBy {
+	tfpgb.fns = append(tfpgb.fns, fns...)
+	return tfpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tfpgb *TwoFactorPolicyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tfpgb.build.ctx, "GroupBy")
+	if err := tfpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TwoFactorPolicyQuery, *TwoFactorPolicyGroupBy](ctx, tfpgb.build, tfpgb, tfpgb.build.inters, v)
+}
+
+func (tfpgb *TwoFactorPolicyGroupBy) sqlScan(ctx context.Context, root *TwoFactorPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tfpgb.fns))
+	for _, fn := range tfpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tfpgb.flds)+len(tfpgb.fns))
+		for _, f := range *tfpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tfpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tfpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TwoFactorPolicySelect is the builder for selecting fields of TwoFactorPolicy entities.
+type TwoFactorPolicySelect struct {
+	*TwoFactorPolicyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tfps *TwoFactorPolicySelect) Aggregate(fns ...AggregateFunc) *TwoFactorPolicySelect {
+	tfps.fns = append(tfps.fns, fns...)
+	return tfps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tfps *TwoFactorPolicySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tfps.ctx, "Select")
+	if err := tfps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TwoFactorPolicyQuery, *TwoFactorPolicySelect](ctx, tfps.TwoFactorPolicyQuery, tfps, tfps.inters, v)
+}
+
+func (tfps *TwoFactorPolicySelect) sqlScan(ctx context.Context, root *TwoFactorPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tfps.fns))
+	for _, fn := range tfps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tfps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tfps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -12,18 +12,40 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIToken is the client for interacting with the APIToken builders.
+	APIToken *APITokenClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// Customer is the client for interacting with the Customer builders.
+	Customer *CustomerClient
 	// Device is the client for interacting with the Device builders.
 	Device *DeviceClient
+	// DeviceAssignment is the client for interacting with the DeviceAssignment builders.
+	DeviceAssignment *DeviceAssignmentClient
+	// DeviceFeatureOverride is the client for interacting with the DeviceFeatureOverride builders.
+	DeviceFeatureOverride *DeviceFeatureOverrideClient
+	// DeviceGroup is the client for interacting with the DeviceGroup builders.
+	DeviceGroup *DeviceGroupClient
+	// DeviceHeartbeat is the client for interacting with the DeviceHeartbeat builders.
+	DeviceHeartbeat *DeviceHeartbeatClient
+	// DeviceSavedFilter is the client for interacting with the DeviceSavedFilter builders.
+	DeviceSavedFilter *DeviceSavedFilterClient
+	// DeviceTag is the client for interacting with the DeviceTag builders.
+	DeviceTag *DeviceTagClient
 	// FirmwareVersion is the client for interacting with the FirmwareVersion builders.
 	FirmwareVersion *FirmwareVersionClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
 	// LicenseType is the client for interacting with the LicenseType builders.
 	LicenseType *LicenseTypeClient
 	// LicenseTypeFeatures is the client for interacting with the LicenseTypeFeatures builders.
 	LicenseTypeFeatures *LicenseTypeFeaturesClient
+	// Lot is the client for interacting with the Lot builders.
+	Lot *LotClient
 	// MetricEvent is the client for interacting with the MetricEvent builders.
 	MetricEvent *MetricEventClient
+	// Order is the client for interacting with the Order builders.
+	Order *OrderClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
 	// PostCategory is the client for interacting with the PostCategory builders.
@@ -36,10 +58,22 @@ type Tx struct {
 	Product *ProductClient
 	// ProductFeature is the client for interacting with the ProductFeature builders.
 	ProductFeature *ProductFeatureClient
+	// ProductInvitation is the client for interacting with the ProductInvitation builders.
+	ProductInvitation *ProductInvitationClient
 	// ProductManager is the client for interacting with the ProductManager builders.
 	ProductManager *ProductManagerClient
+	// RegistrationInvitation is the client for interacting with the RegistrationInvitation builders.
+	RegistrationInvitation *RegistrationInvitationClient
+	// SnAllocator is the client for interacting with the SnAllocator builders.
+	SnAllocator *SnAllocatorClient
+	// SnBlock is the client for interacting with the SnBlock builders.
+	SnBlock *SnBlockClient
+	// SnRule is the client for interacting with the SnRule builders.
+	SnRule *SnRuleClient
 	// SoftwareVersion is the client for interacting with the SoftwareVersion builders.
 	SoftwareVersion *SoftwareVersionClient
+	// TwoFactorPolicy is the client for interacting with the TwoFactorPolicy builders.
+	TwoFactorPolicy *TwoFactorPolicyClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -173,20 +207,37 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIToken = NewAPITokenClient(tx.config)
 	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.Customer = NewCustomerClient(tx.config)
 	tx.Device = NewDeviceClient(tx.config)
+	tx.DeviceAssignment = NewDeviceAssignmentClient(tx.config)
+	tx.DeviceFeatureOverride = NewDeviceFeatureOverrideClient(tx.config)
+	tx.DeviceGroup = NewDeviceGroupClient(tx.config)
+	tx.DeviceHeartbeat = NewDeviceHeartbeatClient(tx.config)
+	tx.DeviceSavedFilter = NewDeviceSavedFilterClient(tx.config)
+	tx.DeviceTag = NewDeviceTagClient(tx.config)
 	tx.FirmwareVersion = NewFirmwareVersionClient(tx.config)
+	tx.Job = NewJobClient(tx.config)
 	tx.LicenseType = NewLicenseTypeClient(tx.config)
 	tx.LicenseTypeFeatures = NewLicenseTypeFeaturesClient(tx.config)
+	tx.Lot = NewLotClient(tx.config)
 	tx.MetricEvent = NewMetricEventClient(tx.config)
+	tx.Order = NewOrderClient(tx.config)
 	tx.Post = NewPostClient(tx.config)
 	tx.PostCategory = NewPostCategoryClient(tx.config)
 	tx.PostTag = NewPostTagClient(tx.config)
 	tx.PostTagRelation = NewPostTagRelationClient(tx.config)
 	tx.Product = NewProductClient(tx.config)
 	tx.ProductFeature = NewProductFeatureClient(tx.config)
+	tx.ProductInvitation = NewProductInvitationClient(tx.config)
 	tx.ProductManager = NewProductManagerClient(tx.config)
+	tx.RegistrationInvitation = NewRegistrationInvitationClient(tx.config)
+	tx.SnAllocator = NewSnAllocatorClient(tx.config)
+	tx.SnBlock = NewSnBlockClient(tx.config)
+	tx.SnRule = NewSnRuleClient(tx.config)
 	tx.SoftwareVersion = NewSoftwareVersionClient(tx.config)
+	tx.TwoFactorPolicy = NewTwoFactorPolicyClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -197,7 +248,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: AuditLog.QueryXXX(), the query will be executed
+// applies a query, for example: APIToken.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -23,8 +24,26 @@ type User struct {
 	Password string `json:"-"`
 	// 用户是否正常启用
 	IsEnabled bool `json:"is_enabled,omitempty"`
+	// 邮箱是否已验证，开启注册验证时新注册用户为false，验证后才能登录
+	EmailVerified bool `json:"email_verified,omitempty"`
+	// 系统管理员，拥有全部产品的全部权限
+	IsSystemAdmin bool `json:"is_system_admin,omitempty"`
+	// 服务账号，只能通过服务令牌访问，不能登录
+	IsService bool `json:"is_service,omitempty"`
 	// LastLoginAt holds the value of the "last_login_at" field.
 	LastLoginAt time.Time `json:"last_login_at,omitempty"`
+	// 是否已开启两步验证
+	TotpEnabled bool `json:"totp_enabled,omitempty"`
+	// 两步验证密钥，加密保存
+	TotpSecret *string `json:"-"`
+	// 最近一次通过校验的时间步，不接受不大于它的验证码，防止重放
+	TotpLastStep int64 `json:"totp_last_step,omitempty"`
+	// 未使用的恢复码的SHA-256
+	TotpRecoveryCodes []string `json:"-"`
+	// 关联的单点登录身份提供方
+	OidcIssuer *string `json:"oidc_issuer,omitempty"`
+	// 身份提供方中的用户标识(sub)，关联后按(issuer, sub)登录，不再按邮箱匹配
+	OidcSubject *string `json:"oidc_subject,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -47,9 +66,19 @@ type UserEdges struct {
 	UpdatedDevices []*Device `json:"updated_devices,omitempty"`
 	// Posts holds the value of the posts edge.
 	Posts []*Post `json:"posts,omitempty"`
+	// DeviceFilters holds the value of the device_filters edge.
+	DeviceFilters []*DeviceSavedFilter `json:"device_filters,omitempty"`
+	// Jobs holds the value of the jobs edge.
+	Jobs []*Job `json:"jobs,omitempty"`
+	// Invitations holds the value of the invitations edge.
+	Invitations []*ProductInvitation `json:"invitations,omitempty"`
+	// RegistrationInvitations holds the value of the registration_invitations edge.
+	RegistrationInvitations []*RegistrationInvitation `json:"registration_invitations,omitempty"`
+	// APITokens holds the value of the api_tokens edge.
+	APITokens []*APIToken `json:"api_tokens,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [10]bool
 }
 
 // ProductsOrErr returns the Products value or an error if the edge
@@ -97,16 +126,63 @@ func (e UserEdges) PostsOrErr() ([]*Post, error) {
 	return nil, &NotLoadedError{edge: "posts"}
 }
 
+// DeviceFiltersOrErr returns the DeviceFilters value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DeviceFiltersOrErr() ([]*DeviceSavedFilter, error) {
+	if e.loadedTypes[5] {
+		return e.DeviceFilters, nil
+	}
+	return nil, &NotLoadedError{edge: "device_filters"}
+}
+
+// JobsOrErr returns the Jobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) JobsOrErr() ([]*Job, error) {
+	if e.loadedTypes[6] {
+		return e.Jobs, nil
+	}
+	return nil, &NotLoadedError{edge: "jobs"}
+}
+
+// InvitationsOrErr returns the Invitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) InvitationsOrErr() ([]*ProductInvitation, error) {
+	if e.loadedTypes[7] {
+		return e.Invitations, nil
+	}
+	return nil, &NotLoadedError{edge: "invitations"}
+}
+
+// RegistrationInvitationsOrErr returns the RegistrationInvitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RegistrationInvitationsOrErr() ([]*RegistrationInvitation, error) {
+	if e.loadedTypes[8] {
+		return e.RegistrationInvitations, nil
+	}
+	return nil, &NotLoadedError{edge: "registration_invitations"}
+}
+
+// APITokensOrErr returns the APITokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) APITokensOrErr() ([]*APIToken, error) {
+	if e.loadedTypes[9] {
+		return e.APITokens, nil
+	}
+	return nil, &NotLoadedError{edge: "api_tokens"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldIsEnabled:
+		case user.FieldTotpRecoveryCodes:
+			values[i] = new([]byte)
+		case user.FieldIsEnabled, user.FieldEmailVerified, user.FieldIsSystemAdmin, user.FieldIsService, user.FieldTotpEnabled:
 			values[i] = new(sql.NullBool)
-		case user.FieldID:
+		case user.FieldID, user.FieldTotpLastStep:
 			values[i] = new(sql.NullInt64)
-		case user.FieldEmail, user.FieldPassword:
+		case user.FieldEmail, user.FieldPassword, user.FieldTotpSecret, user.FieldOidcIssuer, user.FieldOidcSubject:
 			values[i] = new(sql.NullString)
 		case user.FieldLastLoginAt, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -149,12 +225,71 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.IsEnabled = value.Bool
 			}
+		case user.FieldEmailVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified", values[i])
+			} else if value.Valid {
+				u.EmailVerified = value.Bool
+			}
+		case user.FieldIsSystemAdmin:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_system_admin", values[i])
+			} else if value.Valid {
+				u.IsSystemAdmin = value.Bool
+			}
+		case user.FieldIsService:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_service", values[i])
+			} else if value.Valid {
+				u.IsService = value.Bool
+			}
 		case user.FieldLastLoginAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field last_login_at", values[i])
 			} else if value.Valid {
 				u.LastLoginAt = value.Time
 			}
+		case user.FieldTotpEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_enabled", values[i])
+			} else if value.Valid {
+				u.TotpEnabled = value.Bool
+			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				u.TotpSecret = new(string)
+				*u.TotpSecret = value.String
+			}
+		case user.FieldTotpLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_step", values[i])
+			} else if value.Valid {
+				u.TotpLastStep = value.Int64
+			}
+		case user.FieldTotpRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_recovery_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.TotpRecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field totp_recovery_codes: %w", err)
+				}
+			}
+		case user.FieldOidcIssuer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field oidc_issuer", values[i])
+			} else if value.Valid {
+				u.OidcIssuer = new(string)
+				*u.OidcIssuer = value.String
+			}
+		case user.FieldOidcSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field oidc_subject", values[i])
+			} else if value.Valid {
+				u.OidcSubject = new(string)
+				*u.OidcSubject = value.String
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -205,6 +340,31 @@ func (u *User) QueryPosts() *PostQuery {
 	return NewUserClient(u.config).QueryPosts(u)
 }
 
+// QueryDeviceFilters queries the "device_filters" edge of the User entity.
+func (u *User) QueryDeviceFilters() *DeviceSavedFilterQuery {
+	return NewUserClient(u.config).QueryDeviceFilters(u)
+}
+
+// QueryJobs queries the "jobs" edge of the User entity.
+func (u *User) QueryJobs() *JobQuery {
+	return NewUserClient(u.config).QueryJobs(u)
+}
+
+// QueryInvitations queries the "invitations" edge of the User entity.
+func (u *User) QueryInvitations() *ProductInvitationQuery {
+	return NewUserClient(u.config).QueryInvitations(u)
+}
+
+// QueryRegistrationInvitations queries the "registration_invitations" edge of the User entity.
+func (u *User) QueryRegistrationInvitations() *RegistrationInvitationQuery {
+	return NewUserClient(u.config).QueryRegistrationInvitations(u)
+}
+
+// QueryAPITokens queries the "api_tokens" edge of the User entity.
+func (u *User) QueryAPITokens() *APITokenQuery {
+	return NewUserClient(u.config).QueryAPITokens(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -236,9 +396,38 @@ func (u *User) String() string {
 	builder.WriteString("is_enabled=")
 	builder.WriteString(fmt.Sprintf("%v", u.IsEnabled))
 	builder.WriteString(", ")
+	builder.WriteString("email_verified=")
+	builder.WriteString(fmt.Sprintf("%v", u.EmailVerified))
+	builder.WriteString(", ")
+	builder.WriteString("is_system_admin=")
+	builder.WriteString(fmt.Sprintf("%v", u.IsSystemAdmin))
+	builder.WriteString(", ")
+	builder.WriteString("is_service=")
+	builder.WriteString(fmt.Sprintf("%v", u.IsService))
+	builder.WriteString(", ")
 	builder.WriteString("last_login_at=")
 	builder.WriteString(u.LastLoginAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("totp_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("totp_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("totp_last_step=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpLastStep))
+	builder.WriteString(", ")
+	builder.WriteString("totp_recovery_codes=<sensitive>")
+	builder.WriteString(", ")
+	if v := u.OidcIssuer; v != nil {
+		builder.WriteString("oidc_issuer=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := u.OidcSubject; v != nil {
+		builder.WriteString("oidc_subject=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -8,10 +8,15 @@ import (
 	"fmt"
 	"time"
 
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/apitoken"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/auditlog"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicesavedfilter"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/job"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/post"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productinvitation"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/registrationinvitation"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -50,6 +55,48 @@ func (uc *UserCreate) SetNillableIsEnabled(b *bool) *UserCreate {
 	return uc
 }
 
+// SetEmailVerified sets the "email_verified" field.
+func (uc *UserCreate) SetEmailVerified(b bool) *UserCreate {
+	uc.mutation.SetEmailVerified(b)
+	return uc
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmailVerified(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetEmailVerified(*b)
+	}
+	return uc
+}
+
+// SetIsSystemAdmin sets the "is_system_admin" field.
+func (uc *UserCreate) SetIsSystemAdmin(b bool) *UserCreate {
+	uc.mutation.SetIsSystemAdmin(b)
+	return uc
+}
+
+// SetNillableIsSystemAdmin sets the "is_system_admin" field if the given value is not nil.
+func (uc *UserCreate) SetNillableIsSystemAdmin(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetIsSystemAdmin(*b)
+	}
+	return uc
+}
+
+// SetIsService sets the "is_service" field.
+func (uc *UserCreate) SetIsService(b bool) *UserCreate {
+	uc.mutation.SetIsService(b)
+	return uc
+}
+
+// SetNillableIsService sets the "is_service" field if the given value is not nil.
+func (uc *UserCreate) SetNillableIsService(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetIsService(*b)
+	}
+	return uc
+}
+
 // SetLastLoginAt sets the "last_login_at" field.
 func (uc *UserCreate) SetLastLoginAt(t time.Time) *UserCreate {
 	uc.mutation.SetLastLoginAt(t)
@@ -64,6 +111,82 @@ func (uc *UserCreate) SetNillableLastLoginAt(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetTotpEnabled sets the "totp_enabled" field.
+func (uc *UserCreate) SetTotpEnabled(b bool) *UserCreate {
+	uc.mutation.SetTotpEnabled(b)
+	return uc
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpEnabled(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetTotpEnabled(*b)
+	}
+	return uc
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (uc *UserCreate) SetTotpSecret(s string) *UserCreate {
+	uc.mutation.SetTotpSecret(s)
+	return uc
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpSecret(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTotpSecret(*s)
+	}
+	return uc
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (uc *UserCreate) SetTotpLastStep(i int64) *UserCreate {
+	uc.mutation.SetTotpLastStep(i)
+	return uc
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpLastStep(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetTotpLastStep(*i)
+	}
+	return uc
+}
+
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (uc *UserCreate) SetTotpRecoveryCodes(s []string) *UserCreate {
+	uc.mutation.SetTotpRecoveryCodes(s)
+	return uc
+}
+
+// SetOidcIssuer sets the "oidc_issuer" field.
+func (uc *UserCreate) SetOidcIssuer(s string) *UserCreate {
+	uc.mutation.SetOidcIssuer(s)
+	return uc
+}
+
+// SetNillableOidcIssuer sets the "oidc_issuer" field if the given value is not nil.
+func (uc *UserCreate) SetNillableOidcIssuer(s *string) *UserCreate {
+	if s != nil {
+		uc.SetOidcIssuer(*s)
+	}
+	return uc
+}
+
+// SetOidcSubject sets the "oidc_subject" field.
+func (uc *UserCreate) SetOidcSubject(s string) *UserCreate {
+	uc.mutation.SetOidcSubject(s)
+	return uc
+}
+
+// SetNillableOidcSubject sets the "oidc_subject" field if the given value is not nil.
+func (uc *UserCreate) SetNillableOidcSubject(s *string) *UserCreate {
+	if s != nil {
+		uc.SetOidcSubject(*s)
+	}
+	return uc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uc *UserCreate) SetCreatedAt(t time.Time) *UserCreate {
 	uc.mutation.SetCreatedAt(t)
@@ -173,6 +296,81 @@ func (uc *UserCreate) AddPosts(p ...*Post) *UserCreate {
 	return uc.AddPostIDs(ids...)
 }
 
+// AddDeviceFilterIDs adds the "device_filters" edge to the DeviceSavedFilter entity by IDs.
+func (uc *UserCreate) AddDeviceFilterIDs(ids ...int) *UserCreate {
+	uc.mutation.AddDeviceFilterIDs(ids...)
+	return uc
+}
+
+// AddDeviceFilters adds the "device_filters" edges to the DeviceSavedFilter entity.
+func (uc *UserCreate) AddDeviceFilters(d ...*DeviceSavedFilter) *UserCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uc.AddDeviceFilterIDs(ids...)
+}
+
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (uc *UserCreate) AddJobIDs(ids ...int) *UserCreate {
+	uc.mutation.AddJobIDs(ids...)
+	return uc
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (uc *UserCreate) AddJobs(j ...*Job) *UserCreate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return uc.AddJobIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the ProductInvitation entity by IDs.
+func (uc *UserCreate) AddInvitationIDs(ids ...int) *UserCreate {
+	uc.mutation.AddInvitationIDs(ids...)
+	return uc
+}
+
+// AddInvitations adds the "invitations" edges to the ProductInvitation entity.
+func (uc *UserCreate) AddInvitations(p ...*ProductInvitation) *UserCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddInvitationIDs(ids...)
+}
+
+// AddRegistrationInvitationIDs adds the "registration_invitations" edge to the RegistrationInvitation entity by IDs.
+func (uc *UserCreate) AddRegistrationInvitationIDs(ids ...int) *UserCreate {
+	uc.mutation.AddRegistrationInvitationIDs(ids...)
+	return uc
+}
+
+// AddRegistrationInvitations adds the "registration_invitations" edges to the RegistrationInvitation entity.
+func (uc *UserCreate) AddRegistrationInvitations(r ...*RegistrationInvitation) *UserCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRegistrationInvitationIDs(ids...)
+}
+
+// AddAPITokenIDs adds the "api_tokens" edge to the APIToken entity by IDs.
+func (uc *UserCreate) AddAPITokenIDs(ids ...int) *UserCreate {
+	uc.mutation.AddAPITokenIDs(ids...)
+	return uc
+}
+
+// AddAPITokens adds the "api_tokens" edges to the APIToken entity.
+func (uc *UserCreate) AddAPITokens(a ...*APIToken) *UserCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAPITokenIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -212,6 +410,26 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultIsEnabled
 		uc.mutation.SetIsEnabled(v)
 	}
+	if _, ok := uc.mutation.EmailVerified(); !ok {
+		v := user.DefaultEmailVerified
+		uc.mutation.SetEmailVerified(v)
+	}
+	if _, ok := uc.mutation.IsSystemAdmin(); !ok {
+		v := user.DefaultIsSystemAdmin
+		uc.mutation.SetIsSystemAdmin(v)
+	}
+	if _, ok := uc.mutation.IsService(); !ok {
+		v := user.DefaultIsService
+		uc.mutation.SetIsService(v)
+	}
+	if _, ok := uc.mutation.TotpEnabled(); !ok {
+		v := user.DefaultTotpEnabled
+		uc.mutation.SetTotpEnabled(v)
+	}
+	if _, ok := uc.mutation.TotpLastStep(); !ok {
+		v := user.DefaultTotpLastStep
+		uc.mutation.SetTotpLastStep(v)
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		uc.mutation.SetCreatedAt(v)
@@ -243,6 +461,21 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.IsEnabled(); !ok {
 		return &ValidationError{Name: "is_enabled", err: errors.New(`ent: missing required field "User.is_enabled"`)}
 	}
+	if _, ok := uc.mutation.EmailVerified(); !ok {
+		return &ValidationError{Name: "email_verified", err: errors.New(`ent: missing required field "User.email_verified"`)}
+	}
+	if _, ok := uc.mutation.IsSystemAdmin(); !ok {
+		return &ValidationError{Name: "is_system_admin", err: errors.New(`ent: missing required field "User.is_system_admin"`)}
+	}
+	if _, ok := uc.mutation.IsService(); !ok {
+		return &ValidationError{Name: "is_service", err: errors.New(`ent: missing required field "User.is_service"`)}
+	}
+	if _, ok := uc.mutation.TotpEnabled(); !ok {
+		return &ValidationError{Name: "totp_enabled", err: errors.New(`ent: missing required field "User.totp_enabled"`)}
+	}
+	if _, ok := uc.mutation.TotpLastStep(); !ok {
+		return &ValidationError{Name: "totp_last_step", err: errors.New(`ent: missing required field "User.totp_last_step"`)}
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -293,10 +526,46 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldIsEnabled, field.TypeBool, value)
 		_node.IsEnabled = value
 	}
+	if value, ok := uc.mutation.EmailVerified(); ok {
+		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
+		_node.EmailVerified = value
+	}
+	if value, ok := uc.mutation.IsSystemAdmin(); ok {
+		_spec.SetField(user.FieldIsSystemAdmin, field.TypeBool, value)
+		_node.IsSystemAdmin = value
+	}
+	if value, ok := uc.mutation.IsService(); ok {
+		_spec.SetField(user.FieldIsService, field.TypeBool, value)
+		_node.IsService = value
+	}
 	if value, ok := uc.mutation.LastLoginAt(); ok {
 		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
 		_node.LastLoginAt = value
 	}
+	if value, ok := uc.mutation.TotpEnabled(); ok {
+		_spec.SetField(user.FieldTotpEnabled, field.TypeBool, value)
+		_node.TotpEnabled = value
+	}
+	if value, ok := uc.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+		_node.TotpSecret = &value
+	}
+	if value, ok := uc.mutation.TotpLastStep(); ok {
+		_spec.SetField(user.FieldTotpLastStep, field.TypeInt64, value)
+		_node.TotpLastStep = value
+	}
+	if value, ok := uc.mutation.TotpRecoveryCodes(); ok {
+		_spec.SetField(user.FieldTotpRecoveryCodes, field.TypeJSON, value)
+		_node.TotpRecoveryCodes = value
+	}
+	if value, ok := uc.mutation.OidcIssuer(); ok {
+		_spec.SetField(user.FieldOidcIssuer, field.TypeString, value)
+		_node.OidcIssuer = &value
+	}
+	if value, ok := uc.mutation.OidcSubject(); ok {
+		_spec.SetField(user.FieldOidcSubject, field.TypeString, value)
+		_node.OidcSubject = &value
+	}
 	if value, ok := uc.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -385,6 +654,86 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.DeviceFiltersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceFiltersTable,
+			Columns: []string{user.DeviceFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicesavedfilter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JobsTable,
+			Columns: []string{user.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.RegistrationInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RegistrationInvitationsTable,
+			Columns: []string{user.RegistrationInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.APITokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
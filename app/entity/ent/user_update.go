@@ -8,14 +8,20 @@ import (
 	"fmt"
 	"time"
 
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/apitoken"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/auditlog"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicesavedfilter"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/job"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/post"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productinvitation"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/registrationinvitation"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -58,6 +64,48 @@ func (uu *UserUpdate) SetNillableIsEnabled(b *bool) *UserUpdate {
 	return uu
 }
 
+// SetEmailVerified sets the "email_verified" field.
+func (uu *UserUpdate) SetEmailVerified(b bool) *UserUpdate {
+	uu.mutation.SetEmailVerified(b)
+	return uu
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableEmailVerified(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetEmailVerified(*b)
+	}
+	return uu
+}
+
+// SetIsSystemAdmin sets the "is_system_admin" field.
+func (uu *UserUpdate) SetIsSystemAdmin(b bool) *UserUpdate {
+	uu.mutation.SetIsSystemAdmin(b)
+	return uu
+}
+
+// SetNillableIsSystemAdmin sets the "is_system_admin" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableIsSystemAdmin(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetIsSystemAdmin(*b)
+	}
+	return uu
+}
+
+// SetIsService sets the "is_service" field.
+func (uu *UserUpdate) SetIsService(b bool) *UserUpdate {
+	uu.mutation.SetIsService(b)
+	return uu
+}
+
+// SetNillableIsService sets the "is_service" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableIsService(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetIsService(*b)
+	}
+	return uu
+}
+
 // SetLastLoginAt sets the "last_login_at" field.
 func (uu *UserUpdate) SetLastLoginAt(t time.Time) *UserUpdate {
 	uu.mutation.SetLastLoginAt(t)
@@ -78,6 +126,119 @@ func (uu *UserUpdate) ClearLastLoginAt() *UserUpdate {
 	return uu
 }
 
+// SetTotpEnabled sets the "totp_enabled" field.
+func (uu *UserUpdate) SetTotpEnabled(b bool) *UserUpdate {
+	uu.mutation.SetTotpEnabled(b)
+	return uu
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpEnabled(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetTotpEnabled(*b)
+	}
+	return uu
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (uu *UserUpdate) SetTotpSecret(s string) *UserUpdate {
+	uu.mutation.SetTotpSecret(s)
+	return uu
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpSecret(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetTotpSecret(*s)
+	}
+	return uu
+}
+
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (uu *UserUpdate) ClearTotpSecret() *UserUpdate {
+	uu.mutation.ClearTotpSecret()
+	return uu
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (uu *UserUpdate) SetTotpLastStep(i int64) *UserUpdate {
+	uu.mutation.ResetTotpLastStep()
+	uu.mutation.SetTotpLastStep(i)
+	return uu
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpLastStep(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetTotpLastStep(*i)
+	}
+	return uu
+}
+
+// AddTotpLastStep adds i to the "totp_last_step" field.
+func (uu *UserUpdate) AddTotpLastStep(i int64) *UserUpdate {
+	uu.mutation.AddTotpLastStep(i)
+	return uu
+}
+
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (uu *UserUpdate) SetTotpRecoveryCodes(s []string) *UserUpdate {
+	uu.mutation.SetTotpRecoveryCodes(s)
+	return uu
+}
+
+// AppendTotpRecoveryCodes appends s to the "totp_recovery_codes" field.
+func (uu *UserUpdate) AppendTotpRecoveryCodes(s []string) *UserUpdate {
+	uu.mutation.AppendTotpRecoveryCodes(s)
+	return uu
+}
+
+// ClearTotpRecoveryCodes clears the value of the "totp_recovery_codes" field.
+func (uu *UserUpdate) ClearTotpRecoveryCodes() *UserUpdate {
+	uu.mutation.ClearTotpRecoveryCodes()
+	return uu
+}
+
+// SetOidcIssuer sets the "oidc_issuer" field.
+func (uu *UserUpdate) SetOidcIssuer(s string) *UserUpdate {
+	uu.mutation.SetOidcIssuer(s)
+	return uu
+}
+
+// SetNillableOidcIssuer sets the "oidc_issuer" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableOidcIssuer(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetOidcIssuer(*s)
+	}
+	return uu
+}
+
+// ClearOidcIssuer clears the value of the "oidc_issuer" field.
+func (uu *UserUpdate) ClearOidcIssuer() *UserUpdate {
+	uu.mutation.ClearOidcIssuer()
+	return uu
+}
+
+// SetOidcSubject sets the "oidc_subject" field.
+func (uu *UserUpdate) SetOidcSubject(s string) *UserUpdate {
+	uu.mutation.SetOidcSubject(s)
+	return uu
+}
+
+// SetNillableOidcSubject sets the "oidc_subject" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableOidcSubject(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetOidcSubject(*s)
+	}
+	return uu
+}
+
+// ClearOidcSubject clears the value of the "oidc_subject" field.
+func (uu *UserUpdate) ClearOidcSubject() *UserUpdate {
+	uu.mutation.ClearOidcSubject()
+	return uu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (uu *UserUpdate) SetUpdatedAt(t time.Time) *UserUpdate {
 	uu.mutation.SetUpdatedAt(t)
@@ -159,6 +320,81 @@ func (uu *UserUpdate) AddPosts(p ...*Post) *UserUpdate {
 	return uu.AddPostIDs(ids...)
 }
 
+// AddDeviceFilterIDs adds the "device_filters" edge to the DeviceSavedFilter entity by IDs.
+func (uu *UserUpdate) AddDeviceFilterIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddDeviceFilterIDs(ids...)
+	return uu
+}
+
+// AddDeviceFilters adds the "device_filters" edges to the DeviceSavedFilter entity.
+func (uu *UserUpdate) AddDeviceFilters(d ...*DeviceSavedFilter) *UserUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.AddDeviceFilterIDs(ids...)
+}
+
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (uu *UserUpdate) AddJobIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddJobIDs(ids...)
+	return uu
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (uu *UserUpdate) AddJobs(j ...*Job) *UserUpdate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return uu.AddJobIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the ProductInvitation entity by IDs.
+func (uu *UserUpdate) AddInvitationIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddInvitationIDs(ids...)
+	return uu
+}
+
+// AddInvitations adds the "invitations" edges to the ProductInvitation entity.
+func (uu *UserUpdate) AddInvitations(p ...*ProductInvitation) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.AddInvitationIDs(ids...)
+}
+
+// AddRegistrationInvitationIDs adds the "registration_invitations" edge to the RegistrationInvitation entity by IDs.
+func (uu *UserUpdate) AddRegistrationInvitationIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddRegistrationInvitationIDs(ids...)
+	return uu
+}
+
+// AddRegistrationInvitations adds the "registration_invitations" edges to the RegistrationInvitation entity.
+func (uu *UserUpdate) AddRegistrationInvitations(r ...*RegistrationInvitation) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddRegistrationInvitationIDs(ids...)
+}
+
+// AddAPITokenIDs adds the "api_tokens" edge to the APIToken entity by IDs.
+func (uu *UserUpdate) AddAPITokenIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddAPITokenIDs(ids...)
+	return uu
+}
+
+// AddAPITokens adds the "api_tokens" edges to the APIToken entity.
+func (uu *UserUpdate) AddAPITokens(a ...*APIToken) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAPITokenIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -269,6 +505,111 @@ func (uu *UserUpdate) RemovePosts(p ...*Post) *UserUpdate {
 	return uu.RemovePostIDs(ids...)
 }
 
+// ClearDeviceFilters clears all "device_filters" edges to the DeviceSavedFilter entity.
+func (uu *UserUpdate) ClearDeviceFilters() *UserUpdate {
+	uu.mutation.ClearDeviceFilters()
+	return uu
+}
+
+// RemoveDeviceFilterIDs removes the "device_filters" edge to DeviceSavedFilter entities by IDs.
+func (uu *UserUpdate) RemoveDeviceFilterIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveDeviceFilterIDs(ids...)
+	return uu
+}
+
+// RemoveDeviceFilters removes "device_filters" edges to DeviceSavedFilter entities.
+func (uu *UserUpdate) RemoveDeviceFilters(d ...*DeviceSavedFilter) *UserUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.RemoveDeviceFilterIDs(ids...)
+}
+
+// ClearJobs clears all "jobs" edges to the Job entity.
+func (uu *UserUpdate) ClearJobs() *UserUpdate {
+	uu.mutation.ClearJobs()
+	return uu
+}
+
+// RemoveJobIDs removes the "jobs" edge to Job entities by IDs.
+func (uu *UserUpdate) RemoveJobIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveJobIDs(ids...)
+	return uu
+}
+
+// RemoveJobs removes "jobs" edges to Job entities.
+func (uu *UserUpdate) RemoveJobs(j ...*Job) *UserUpdate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return uu.RemoveJobIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the ProductInvitation entity.
+func (uu *UserUpdate) ClearInvitations() *UserUpdate {
+	uu.mutation.ClearInvitations()
+	return uu
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to ProductInvitation entities by IDs.
+func (uu *UserUpdate) RemoveInvitationIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveInvitationIDs(ids...)
+	return uu
+}
+
+// RemoveInvitations removes "invitations" edges to ProductInvitation entities.
+func (uu *UserUpdate) RemoveInvitations(p ...*ProductInvitation) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.RemoveInvitationIDs(ids...)
+}
+
+// ClearRegistrationInvitations clears all "registration_invitations" edges to the RegistrationInvitation entity.
+func (uu *UserUpdate) ClearRegistrationInvitations() *UserUpdate {
+	uu.mutation.ClearRegistrationInvitations()
+	return uu
+}
+
+// RemoveRegistrationInvitationIDs removes the "registration_invitations" edge to RegistrationInvitation entities by IDs.
+func (uu *UserUpdate) RemoveRegistrationInvitationIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveRegistrationInvitationIDs(ids...)
+	return uu
+}
+
+// RemoveRegistrationInvitations removes "registration_invitations" edges to RegistrationInvitation entities.
+func (uu *UserUpdate) RemoveRegistrationInvitations(r ...*RegistrationInvitation) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveRegistrationInvitationIDs(ids...)
+}
+
+// ClearAPITokens clears all "api_tokens" edges to the APIToken entity.
+func (uu *UserUpdate) ClearAPITokens() *UserUpdate {
+	uu.mutation.ClearAPITokens()
+	return uu
+}
+
+// RemoveAPITokenIDs removes the "api_tokens" edge to APIToken entities by IDs.
+func (uu *UserUpdate) RemoveAPITokenIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveAPITokenIDs(ids...)
+	return uu
+}
+
+// RemoveAPITokens removes "api_tokens" edges to APIToken entities.
+func (uu *UserUpdate) RemoveAPITokens(a ...*APIToken) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveAPITokenIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	uu.defaults()
@@ -341,12 +682,59 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.IsEnabled(); ok {
 		_spec.SetField(user.FieldIsEnabled, field.TypeBool, value)
 	}
+	if value, ok := uu.mutation.EmailVerified(); ok {
+		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := uu.mutation.IsSystemAdmin(); ok {
+		_spec.SetField(user.FieldIsSystemAdmin, field.TypeBool, value)
+	}
+	if value, ok := uu.mutation.IsService(); ok {
+		_spec.SetField(user.FieldIsService, field.TypeBool, value)
+	}
 	if value, ok := uu.mutation.LastLoginAt(); ok {
 		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
 	}
 	if uu.mutation.LastLoginAtCleared() {
 		_spec.ClearField(user.FieldLastLoginAt, field.TypeTime)
 	}
+	if value, ok := uu.mutation.TotpEnabled(); ok {
+		_spec.SetField(user.FieldTotpEnabled, field.TypeBool, value)
+	}
+	if value, ok := uu.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+	}
+	if uu.mutation.TotpSecretCleared() {
+		_spec.ClearField(user.FieldTotpSecret, field.TypeString)
+	}
+	if value, ok := uu.mutation.TotpLastStep(); ok {
+		_spec.SetField(user.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedTotpLastStep(); ok {
+		_spec.AddField(user.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.TotpRecoveryCodes(); ok {
+		_spec.SetField(user.FieldTotpRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := uu.mutation.AppendedTotpRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldTotpRecoveryCodes, value)
+		})
+	}
+	if uu.mutation.TotpRecoveryCodesCleared() {
+		_spec.ClearField(user.FieldTotpRecoveryCodes, field.TypeJSON)
+	}
+	if value, ok := uu.mutation.OidcIssuer(); ok {
+		_spec.SetField(user.FieldOidcIssuer, field.TypeString, value)
+	}
+	if uu.mutation.OidcIssuerCleared() {
+		_spec.ClearField(user.FieldOidcIssuer, field.TypeString)
+	}
+	if value, ok := uu.mutation.OidcSubject(); ok {
+		_spec.SetField(user.FieldOidcSubject, field.TypeString, value)
+	}
+	if uu.mutation.OidcSubjectCleared() {
+		_spec.ClearField(user.FieldOidcSubject, field.TypeString)
+	}
 	if value, ok := uu.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -575,58 +963,325 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.DeviceFiltersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceFiltersTable,
+			Columns: []string{user.DeviceFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicesavedfilter.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetEmail sets the "email" field.
-func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
-	uuo.mutation.SetEmail(s)
-	return uuo
-}
-
-// SetPassword sets the "password" field.
-func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
-	uuo.mutation.SetPassword(s)
-	return uuo
-}
-
-// SetIsEnabled sets the "is_enabled" field.
-func (uuo *UserUpdateOne) SetIsEnabled(b bool) *UserUpdateOne {
-	uuo.mutation.SetIsEnabled(b)
-	return uuo
-}
-
-// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableIsEnabled(b *bool) *UserUpdateOne {
-	if b != nil {
-		uuo.SetIsEnabled(*b)
+	if nodes := uu.mutation.RemovedDeviceFiltersIDs(); len(nodes) > 0 && !uu.mutation.DeviceFiltersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceFiltersTable,
+			Columns: []string{user.DeviceFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicesavedfilter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetLastLoginAt sets the "last_login_at" field.
-func (uuo *UserUpdateOne) SetLastLoginAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetLastLoginAt(t)
-	return uuo
-}
-
+	if nodes := uu.mutation.DeviceFiltersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceFiltersTable,
+			Columns: []string{user.DeviceFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicesavedfilter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JobsTable,
+			Columns: []string{user.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedJobsIDs(); len(nodes) > 0 && !uu.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JobsTable,
+			Columns: []string{user.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JobsTable,
+			Columns: []string{user.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !uu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.RegistrationInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RegistrationInvitationsTable,
+			Columns: []string{user.RegistrationInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationinvitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedRegistrationInvitationsIDs(); len(nodes) > 0 && !uu.mutation.RegistrationInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RegistrationInvitationsTable,
+			Columns: []string{user.RegistrationInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RegistrationInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RegistrationInvitationsTable,
+			Columns: []string{user.RegistrationInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.APITokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedAPITokensIDs(); len(nodes) > 0 && !uu.mutation.APITokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.APITokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetPassword sets the "password" field.
+func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
+	uuo.mutation.SetPassword(s)
+	return uuo
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (uuo *UserUpdateOne) SetIsEnabled(b bool) *UserUpdateOne {
+	uuo.mutation.SetIsEnabled(b)
+	return uuo
+}
+
+// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableIsEnabled(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetIsEnabled(*b)
+	}
+	return uuo
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (uuo *UserUpdateOne) SetEmailVerified(b bool) *UserUpdateOne {
+	uuo.mutation.SetEmailVerified(b)
+	return uuo
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmailVerified(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetEmailVerified(*b)
+	}
+	return uuo
+}
+
+// SetIsSystemAdmin sets the "is_system_admin" field.
+func (uuo *UserUpdateOne) SetIsSystemAdmin(b bool) *UserUpdateOne {
+	uuo.mutation.SetIsSystemAdmin(b)
+	return uuo
+}
+
+// SetNillableIsSystemAdmin sets the "is_system_admin" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableIsSystemAdmin(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetIsSystemAdmin(*b)
+	}
+	return uuo
+}
+
+// SetIsService sets the "is_service" field.
+func (uuo *UserUpdateOne) SetIsService(b bool) *UserUpdateOne {
+	uuo.mutation.SetIsService(b)
+	return uuo
+}
+
+// SetNillableIsService sets the "is_service" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableIsService(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetIsService(*b)
+	}
+	return uuo
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (uuo *UserUpdateOne) SetLastLoginAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetLastLoginAt(t)
+	return uuo
+}
+
 // SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
 func (uuo *UserUpdateOne) SetNillableLastLoginAt(t *time.Time) *UserUpdateOne {
 	if t != nil {
@@ -641,6 +1296,119 @@ func (uuo *UserUpdateOne) ClearLastLoginAt() *UserUpdateOne {
 	return uuo
 }
 
+// SetTotpEnabled sets the "totp_enabled" field.
+func (uuo *UserUpdateOne) SetTotpEnabled(b bool) *UserUpdateOne {
+	uuo.mutation.SetTotpEnabled(b)
+	return uuo
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpEnabled(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetTotpEnabled(*b)
+	}
+	return uuo
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (uuo *UserUpdateOne) SetTotpSecret(s string) *UserUpdateOne {
+	uuo.mutation.SetTotpSecret(s)
+	return uuo
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpSecret(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetTotpSecret(*s)
+	}
+	return uuo
+}
+
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (uuo *UserUpdateOne) ClearTotpSecret() *UserUpdateOne {
+	uuo.mutation.ClearTotpSecret()
+	return uuo
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (uuo *UserUpdateOne) SetTotpLastStep(i int64) *UserUpdateOne {
+	uuo.mutation.ResetTotpLastStep()
+	uuo.mutation.SetTotpLastStep(i)
+	return uuo
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpLastStep(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetTotpLastStep(*i)
+	}
+	return uuo
+}
+
+// AddTotpLastStep adds i to the "totp_last_step" field.
+func (uuo *UserUpdateOne) AddTotpLastStep(i int64) *UserUpdateOne {
+	uuo.mutation.AddTotpLastStep(i)
+	return uuo
+}
+
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (uuo *UserUpdateOne) SetTotpRecoveryCodes(s []string) *UserUpdateOne {
+	uuo.mutation.SetTotpRecoveryCodes(s)
+	return uuo
+}
+
+// AppendTotpRecoveryCodes appends s to the "totp_recovery_codes" field.
+func (uuo *UserUpdateOne) AppendTotpRecoveryCodes(s []string) *UserUpdateOne {
+	uuo.mutation.AppendTotpRecoveryCodes(s)
+	return uuo
+}
+
+// ClearTotpRecoveryCodes clears the value of the "totp_recovery_codes" field.
+func (uuo *UserUpdateOne) ClearTotpRecoveryCodes() *UserUpdateOne {
+	uuo.mutation.ClearTotpRecoveryCodes()
+	return uuo
+}
+
+// SetOidcIssuer sets the "oidc_issuer" field.
+func (uuo *UserUpdateOne) SetOidcIssuer(s string) *UserUpdateOne {
+	uuo.mutation.SetOidcIssuer(s)
+	return uuo
+}
+
+// SetNillableOidcIssuer sets the "oidc_issuer" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableOidcIssuer(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetOidcIssuer(*s)
+	}
+	return uuo
+}
+
+// ClearOidcIssuer clears the value of the "oidc_issuer" field.
+func (uuo *UserUpdateOne) ClearOidcIssuer() *UserUpdateOne {
+	uuo.mutation.ClearOidcIssuer()
+	return uuo
+}
+
+// SetOidcSubject sets the "oidc_subject" field.
+func (uuo *UserUpdateOne) SetOidcSubject(s string) *UserUpdateOne {
+	uuo.mutation.SetOidcSubject(s)
+	return uuo
+}
+
+// SetNillableOidcSubject sets the "oidc_subject" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableOidcSubject(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetOidcSubject(*s)
+	}
+	return uuo
+}
+
+// ClearOidcSubject clears the value of the "oidc_subject" field.
+func (uuo *UserUpdateOne) ClearOidcSubject() *UserUpdateOne {
+	uuo.mutation.ClearOidcSubject()
+	return uuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
 	uuo.mutation.SetUpdatedAt(t)
@@ -689,37 +1457,112 @@ func (uuo *UserUpdateOne) AddCreatedDevices(d ...*Device) *UserUpdateOne {
 	for i := range d {
 		ids[i] = d[i].ID
 	}
-	return uuo.AddCreatedDeviceIDs(ids...)
+	return uuo.AddCreatedDeviceIDs(ids...)
+}
+
+// AddUpdatedDeviceIDs adds the "updated_devices" edge to the Device entity by IDs.
+func (uuo *UserUpdateOne) AddUpdatedDeviceIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddUpdatedDeviceIDs(ids...)
+	return uuo
+}
+
+// AddUpdatedDevices adds the "updated_devices" edges to the Device entity.
+func (uuo *UserUpdateOne) AddUpdatedDevices(d ...*Device) *UserUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.AddUpdatedDeviceIDs(ids...)
+}
+
+// AddPostIDs adds the "posts" edge to the Post entity by IDs.
+func (uuo *UserUpdateOne) AddPostIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddPostIDs(ids...)
+	return uuo
+}
+
+// AddPosts adds the "posts" edges to the Post entity.
+func (uuo *UserUpdateOne) AddPosts(p ...*Post) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddPostIDs(ids...)
+}
+
+// AddDeviceFilterIDs adds the "device_filters" edge to the DeviceSavedFilter entity by IDs.
+func (uuo *UserUpdateOne) AddDeviceFilterIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddDeviceFilterIDs(ids...)
+	return uuo
+}
+
+// AddDeviceFilters adds the "device_filters" edges to the DeviceSavedFilter entity.
+func (uuo *UserUpdateOne) AddDeviceFilters(d ...*DeviceSavedFilter) *UserUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.AddDeviceFilterIDs(ids...)
+}
+
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (uuo *UserUpdateOne) AddJobIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddJobIDs(ids...)
+	return uuo
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (uuo *UserUpdateOne) AddJobs(j ...*Job) *UserUpdateOne {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return uuo.AddJobIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the ProductInvitation entity by IDs.
+func (uuo *UserUpdateOne) AddInvitationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddInvitationIDs(ids...)
+	return uuo
+}
+
+// AddInvitations adds the "invitations" edges to the ProductInvitation entity.
+func (uuo *UserUpdateOne) AddInvitations(p ...*ProductInvitation) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddInvitationIDs(ids...)
 }
 
-// AddUpdatedDeviceIDs adds the "updated_devices" edge to the Device entity by IDs.
-func (uuo *UserUpdateOne) AddUpdatedDeviceIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddUpdatedDeviceIDs(ids...)
+// AddRegistrationInvitationIDs adds the "registration_invitations" edge to the RegistrationInvitation entity by IDs.
+func (uuo *UserUpdateOne) AddRegistrationInvitationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddRegistrationInvitationIDs(ids...)
 	return uuo
 }
 
-// AddUpdatedDevices adds the "updated_devices" edges to the Device entity.
-func (uuo *UserUpdateOne) AddUpdatedDevices(d ...*Device) *UserUpdateOne {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+// AddRegistrationInvitations adds the "registration_invitations" edges to the RegistrationInvitation entity.
+func (uuo *UserUpdateOne) AddRegistrationInvitations(r ...*RegistrationInvitation) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
 	}
-	return uuo.AddUpdatedDeviceIDs(ids...)
+	return uuo.AddRegistrationInvitationIDs(ids...)
 }
 
-// AddPostIDs adds the "posts" edge to the Post entity by IDs.
-func (uuo *UserUpdateOne) AddPostIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddPostIDs(ids...)
+// AddAPITokenIDs adds the "api_tokens" edge to the APIToken entity by IDs.
+func (uuo *UserUpdateOne) AddAPITokenIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddAPITokenIDs(ids...)
 	return uuo
 }
 
-// AddPosts adds the "posts" edges to the Post entity.
-func (uuo *UserUpdateOne) AddPosts(p ...*Post) *UserUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+// AddAPITokens adds the "api_tokens" edges to the APIToken entity.
+func (uuo *UserUpdateOne) AddAPITokens(a ...*APIToken) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return uuo.AddPostIDs(ids...)
+	return uuo.AddAPITokenIDs(ids...)
 }
 
 // Mutation returns the UserMutation object of the builder.
@@ -832,6 +1675,111 @@ func (uuo *UserUpdateOne) RemovePosts(p ...*Post) *UserUpdateOne {
 	return uuo.RemovePostIDs(ids...)
 }
 
+// ClearDeviceFilters clears all "device_filters" edges to the DeviceSavedFilter entity.
+func (uuo *UserUpdateOne) ClearDeviceFilters() *UserUpdateOne {
+	uuo.mutation.ClearDeviceFilters()
+	return uuo
+}
+
+// RemoveDeviceFilterIDs removes the "device_filters" edge to DeviceSavedFilter entities by IDs.
+func (uuo *UserUpdateOne) RemoveDeviceFilterIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveDeviceFilterIDs(ids...)
+	return uuo
+}
+
+// RemoveDeviceFilters removes "device_filters" edges to DeviceSavedFilter entities.
+func (uuo *UserUpdateOne) RemoveDeviceFilters(d ...*DeviceSavedFilter) *UserUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.RemoveDeviceFilterIDs(ids...)
+}
+
+// ClearJobs clears all "jobs" edges to the Job entity.
+func (uuo *UserUpdateOne) ClearJobs() *UserUpdateOne {
+	uuo.mutation.ClearJobs()
+	return uuo
+}
+
+// RemoveJobIDs removes the "jobs" edge to Job entities by IDs.
+func (uuo *UserUpdateOne) RemoveJobIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveJobIDs(ids...)
+	return uuo
+}
+
+// RemoveJobs removes "jobs" edges to Job entities.
+func (uuo *UserUpdateOne) RemoveJobs(j ...*Job) *UserUpdateOne {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return uuo.RemoveJobIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the ProductInvitation entity.
+func (uuo *UserUpdateOne) ClearInvitations() *UserUpdateOne {
+	uuo.mutation.ClearInvitations()
+	return uuo
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to ProductInvitation entities by IDs.
+func (uuo *UserUpdateOne) RemoveInvitationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveInvitationIDs(ids...)
+	return uuo
+}
+
+// RemoveInvitations removes "invitations" edges to ProductInvitation entities.
+func (uuo *UserUpdateOne) RemoveInvitations(p ...*ProductInvitation) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemoveInvitationIDs(ids...)
+}
+
+// ClearRegistrationInvitations clears all "registration_invitations" edges to the RegistrationInvitation entity.
+func (uuo *UserUpdateOne) ClearRegistrationInvitations() *UserUpdateOne {
+	uuo.mutation.ClearRegistrationInvitations()
+	return uuo
+}
+
+// RemoveRegistrationInvitationIDs removes the "registration_invitations" edge to RegistrationInvitation entities by IDs.
+func (uuo *UserUpdateOne) RemoveRegistrationInvitationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveRegistrationInvitationIDs(ids...)
+	return uuo
+}
+
+// RemoveRegistrationInvitations removes "registration_invitations" edges to RegistrationInvitation entities.
+func (uuo *UserUpdateOne) RemoveRegistrationInvitations(r ...*RegistrationInvitation) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveRegistrationInvitationIDs(ids...)
+}
+
+// ClearAPITokens clears all "api_tokens" edges to the APIToken entity.
+func (uuo *UserUpdateOne) ClearAPITokens() *UserUpdateOne {
+	uuo.mutation.ClearAPITokens()
+	return uuo
+}
+
+// RemoveAPITokenIDs removes the "api_tokens" edge to APIToken entities by IDs.
+func (uuo *UserUpdateOne) RemoveAPITokenIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveAPITokenIDs(ids...)
+	return uuo
+}
+
+// RemoveAPITokens removes "api_tokens" edges to APIToken entities.
+func (uuo *UserUpdateOne) RemoveAPITokens(a ...*APIToken) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveAPITokenIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	uuo.mutation.Where(ps...)
@@ -934,12 +1882,59 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.IsEnabled(); ok {
 		_spec.SetField(user.FieldIsEnabled, field.TypeBool, value)
 	}
+	if value, ok := uuo.mutation.EmailVerified(); ok {
+		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.IsSystemAdmin(); ok {
+		_spec.SetField(user.FieldIsSystemAdmin, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.IsService(); ok {
+		_spec.SetField(user.FieldIsService, field.TypeBool, value)
+	}
 	if value, ok := uuo.mutation.LastLoginAt(); ok {
 		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
 	}
 	if uuo.mutation.LastLoginAtCleared() {
 		_spec.ClearField(user.FieldLastLoginAt, field.TypeTime)
 	}
+	if value, ok := uuo.mutation.TotpEnabled(); ok {
+		_spec.SetField(user.FieldTotpEnabled, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+	}
+	if uuo.mutation.TotpSecretCleared() {
+		_spec.ClearField(user.FieldTotpSecret, field.TypeString)
+	}
+	if value, ok := uuo.mutation.TotpLastStep(); ok {
+		_spec.SetField(user.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedTotpLastStep(); ok {
+		_spec.AddField(user.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.TotpRecoveryCodes(); ok {
+		_spec.SetField(user.FieldTotpRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := uuo.mutation.AppendedTotpRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldTotpRecoveryCodes, value)
+		})
+	}
+	if uuo.mutation.TotpRecoveryCodesCleared() {
+		_spec.ClearField(user.FieldTotpRecoveryCodes, field.TypeJSON)
+	}
+	if value, ok := uuo.mutation.OidcIssuer(); ok {
+		_spec.SetField(user.FieldOidcIssuer, field.TypeString, value)
+	}
+	if uuo.mutation.OidcIssuerCleared() {
+		_spec.ClearField(user.FieldOidcIssuer, field.TypeString)
+	}
+	if value, ok := uuo.mutation.OidcSubject(); ok {
+		_spec.SetField(user.FieldOidcSubject, field.TypeString, value)
+	}
+	if uuo.mutation.OidcSubjectCleared() {
+		_spec.ClearField(user.FieldOidcSubject, field.TypeString)
+	}
 	if value, ok := uuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1168,6 +2163,231 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.DeviceFiltersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceFiltersTable,
+			Columns: []string{user.DeviceFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicesavedfilter.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedDeviceFiltersIDs(); len(nodes) > 0 && !uuo.mutation.DeviceFiltersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceFiltersTable,
+			Columns: []string{user.DeviceFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicesavedfilter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.DeviceFiltersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceFiltersTable,
+			Columns: []string{user.DeviceFiltersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicesavedfilter.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JobsTable,
+			Columns: []string{user.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedJobsIDs(); len(nodes) > 0 && !uuo.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JobsTable,
+			Columns: []string{user.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JobsTable,
+			Columns: []string{user.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !uuo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.RegistrationInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RegistrationInvitationsTable,
+			Columns: []string{user.RegistrationInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationinvitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedRegistrationInvitationsIDs(); len(nodes) > 0 && !uuo.mutation.RegistrationInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RegistrationInvitationsTable,
+			Columns: []string{user.RegistrationInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RegistrationInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RegistrationInvitationsTable,
+			Columns: []string{user.RegistrationInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationinvitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.APITokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedAPITokensIDs(); len(nodes) > 0 && !uuo.mutation.APITokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.APITokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.APITokensTable,
+			Columns: []string{user.APITokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apitoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// APIToken holds the schema definition for the APIToken entity.
+// 自动化使用的API令牌，个人令牌代表创建人，服务令牌代表为其创建的服务账号；只保存哈希，按前缀识别
+type APIToken struct {
+	ent.Schema
+}
+
+// Fields of the APIToken.
+func (APIToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			Comment("令牌名称"),
+		field.Enum("type").
+			Values("personal", "service").
+			Immutable().
+			Comment("令牌类型：个人令牌、服务令牌"),
+		field.String("token_prefix").
+			Unique().
+			Immutable().
+			Comment("令牌前缀，认证时按前缀查找，也用于在列表和日志中辨认"),
+		field.String("token_hash").
+			Sensitive().
+			Immutable().
+			Comment("令牌的SHA-256，令牌只在创建时返回"),
+		field.Int("user_id").
+			Immutable().
+			Comment("令牌代表的用户，服务令牌为其服务账号"),
+		field.Int("created_by").
+			Immutable().
+			Comment("创建人ID"),
+		field.JSON("scopes", []string{}).
+			Comment("授权范围，如devices:write、versions:read"),
+		field.JSON("product_ids", []int{}).
+			Optional().
+			Comment("限制可访问的产品，为空时不限制"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("过期时间，为空时不过期"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			Comment("最近使用时间"),
+		field.String("last_used_ip").
+			Optional().
+			Default("").
+			Comment("最近使用的IP"),
+		field.Int("revoked_by").
+			Optional().
+			Nillable().
+			Comment("撤销人ID"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("撤销时间"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the APIToken.
+func (APIToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("api_tokens").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the APIToken.
+func (APIToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("created_by"),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"time"
 )
 
@@ -12,6 +13,13 @@ type AuditLog struct {
 	ent.Schema
 }
 
+// Mixin of the AuditLog.
+func (AuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		ProductScopeMixin{},
+	}
+}
+
 // Fields of the AuditLog.
 func (AuditLog) Fields() []ent.Field {
 	return []ent.Field{
@@ -60,3 +68,12 @@ func (AuditLog) Edges() []ent.Edge {
 			Unique(),
 	}
 }
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		// 游标分页按(created_at, id)倒序
+		index.Fields("created_at"),
+		index.Fields("product_id", "created_at"),
+	}
+}
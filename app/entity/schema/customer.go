@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Customer holds the schema definition for the Customer entity.
+// 设备的最终客户，客户不属于某个产品，同一客户可以拥有多个产品的设备
+type Customer struct {
+	ent.Schema
+}
+
+// Fields of the Customer.
+func (Customer) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			Comment("客户名称"),
+		field.String("contact_name").
+			Optional().
+			Default("").
+			Comment("联系人"),
+		field.String("contact_phone").
+			Optional().
+			Default("").
+			Comment("联系电话"),
+		field.String("contact_email").
+			Optional().
+			Default("").
+			Comment("联系邮箱"),
+		field.String("region").
+			Optional().
+			Default("").
+			Comment("所在地区"),
+		field.String("oem_tag").
+			Optional().
+			Default("").
+			Comment("所属OEM厂商标记"),
+		field.String("remark").
+			Optional().
+			Default("").
+			Comment("备注"),
+		field.Int("created_by").
+			Optional().
+			Comment("创建人ID"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Customer.
+func (Customer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("devices", Device.Type),
+		edge.To("orders", Order.Type),
+	}
+}
+
+// Indexes of the Customer.
+func (Customer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Unique(),
+		index.Fields("region"),
+		index.Fields("oem_tag"),
+	}
+}
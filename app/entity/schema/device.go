@@ -12,15 +12,43 @@ type Device struct {
 	ent.Schema
 }
 
+// Mixin of the Device.
+func (Device) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+		ProductScopeMixin{},
+	}
+}
+
 // Fields of the Device.
 func (Device) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("id"),
-		field.String("sn").Unique().Comment("设备序列号"),
+		field.String("sn").Comment("设备序列号"),
 		field.Int("product_id").Comment("所属产品ID"),
 		field.Int("license_type_id").Optional().Comment("许可证类型ID"),
 		field.String("oem_tag").Optional().Default("").Comment("OEM厂商标记"),
 		field.String("remark").Optional().Default("").Comment("备注"),
+		field.Enum("state").
+			Values("manufactured", "shipped", "activated", "suspended", "rma", "scrapped").
+			Default("manufactured").
+			Comment("生命周期状态"),
+		field.Time("shipped_at").Optional().Nillable().Comment("最近一次出货时间"),
+		field.Time("activated_at").Optional().Nillable().Comment("最近一次激活时间"),
+		field.Time("suspended_at").Optional().Nillable().Comment("最近一次停用时间"),
+		field.Time("rma_at").Optional().Nillable().Comment("最近一次返修时间"),
+		field.Time("scrapped_at").Optional().Nillable().Comment("报废时间"),
+		field.Time("last_seen_at").Optional().Nillable().Comment("最后心跳时间"),
+		field.Int("customer_id").Optional().Comment("所属客户ID"),
+		field.Time("warranty_start_at").Optional().Nillable().Comment("保修开始时间，默认为出货时间"),
+		field.Time("warranty_end_at").Optional().Nillable().Comment("保修截止时间，默认按产品保修期计算"),
+		field.Int("order_id").Optional().Comment("来源订单ID"),
+		field.Int("lot_id").Optional().Comment("来源生产批次ID"),
+		field.JSON("attributes", map[string]interface{}{}).Optional().Comment("自定义属性，按产品的属性Schema校验"),
+		field.String("last_software_version").Optional().Default("").Comment("最后上报的软件版本"),
+		field.String("last_firmware_version").Optional().Default("").Comment("最后上报的韧件版本"),
+		field.Int64("last_uptime").Optional().Default(0).Comment("最后上报的运行时长（秒）"),
+		field.String("heartbeat_public_key").Optional().Default("").Comment("心跳签名公钥（Ed25519，base64），设备自行生成密钥对，私钥不离开设备"),
 		field.Time("created_at").Comment("创建时间"),
 		field.Int("created_by").Optional().Comment("创建人ID"),
 		field.Time("updated_at").Comment("更新时间"),
@@ -46,14 +74,41 @@ func (Device) Edges() []ent.Edge {
 		edge.To("updater", User.Type).
 			Field("updated_by").
 			Unique(),
+		edge.To("tags", DeviceTag.Type).
+			StorageKey(edge.Table("device_tag_relations"), edge.Columns("device_id", "device_tag_id")),
+		edge.From("groups", DeviceGroup.Type).
+			Ref("devices"),
+		edge.To("heartbeats", DeviceHeartbeat.Type),
+		edge.From("customer", Customer.Type).
+			Ref("devices").
+			Unique().
+			Field("customer_id"),
+		edge.To("assignments", DeviceAssignment.Type),
+		edge.To("feature_overrides", DeviceFeatureOverride.Type),
+		edge.From("order", Order.Type).
+			Ref("devices").
+			Unique().
+			Field("order_id"),
+		edge.From("lot", Lot.Type).
+			Ref("devices").
+			Unique().
+			Field("lot_id"),
 	}
 }
 
 // Indexes of the Device.
 func (Device) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("sn").Unique(),
+		index.Fields("sn", "deleted_id").Unique(), // 回收站中的SN允许被重新使用
 		index.Fields("product_id"),
 		index.Fields("license_type_id"),
+		index.Fields("product_id", "state"),
+		index.Fields("product_id", "last_seen_at"),
+		index.Fields("product_id", "created_at"), // 游标分页按(created_at, id)倒序
+		index.Fields("created_at"),
+		index.Fields("customer_id"),
+		index.Fields("warranty_end_at"),
+		index.Fields("order_id"),
+		index.Fields("lot_id"),
 	}
 } 
\ No newline at end of file
@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DeviceAssignment holds the schema definition for the DeviceAssignment entity.
+// 设备归属客户的变更历史，客户只记录ID和名称快照，删除客户后历史仍然保留
+type DeviceAssignment struct {
+	ent.Schema
+}
+
+// Fields of the DeviceAssignment.
+func (DeviceAssignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.Int("device_id").
+			Immutable().
+			Comment("设备ID"),
+		field.Int("customer_id").
+			Optional().
+			Immutable().
+			Comment("新客户ID，为空表示解除归属"),
+		field.String("customer_name").
+			Optional().
+			Default("").
+			Immutable().
+			Comment("新客户名称"),
+		field.Int("previous_customer_id").
+			Optional().
+			Immutable().
+			Comment("原客户ID"),
+		field.String("previous_customer_name").
+			Optional().
+			Default("").
+			Immutable().
+			Comment("原客户名称"),
+		field.Time("warranty_start_at").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("变更后的保修开始时间"),
+		field.Time("warranty_end_at").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("变更后的保修截止时间"),
+		field.String("remark").
+			Optional().
+			Default("").
+			Immutable().
+			Comment("备注"),
+		field.Int("assigned_by").
+			Immutable().
+			Comment("操作人ID"),
+		field.Time("assigned_at").
+			Immutable().
+			Default(time.Now).
+			Comment("变更时间"),
+	}
+}
+
+// Edges of the DeviceAssignment.
+func (DeviceAssignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("device", Device.Type).
+			Ref("assignments").
+			Field("device_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the DeviceAssignment.
+func (DeviceAssignment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("device_id", "assigned_at"),
+		index.Fields("customer_id", "assigned_at"),
+	}
+}
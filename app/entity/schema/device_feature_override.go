@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DeviceFeatureOverride holds the schema definition for the DeviceFeatureOverride entity.
+// 单台设备在许可证类型之外额外授予或禁用的功能，同一设备的同一功能只有一条记录
+type DeviceFeatureOverride struct {
+	ent.Schema
+}
+
+// Fields of the DeviceFeatureOverride.
+func (DeviceFeatureOverride) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.Int("device_id").
+			Immutable().
+			Comment("设备ID"),
+		field.Int("feature_id").
+			Immutable().
+			Comment("功能ID"),
+		field.Enum("effect").
+			Values("grant", "deny").
+			Comment("grant额外授予，deny禁用许可证类型中的功能"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("到期时间，为空表示长期有效"),
+		field.String("remark").
+			Optional().
+			Default("").
+			Comment("备注，如销售单号"),
+		field.Int("created_by").
+			Comment("创建人ID"),
+		field.Int("updated_by").
+			Comment("更新人ID"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the DeviceFeatureOverride.
+func (DeviceFeatureOverride) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("device", Device.Type).
+			Ref("feature_overrides").
+			Field("device_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("feature", ProductFeature.Type).
+			Ref("device_overrides").
+			Field("feature_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the DeviceFeatureOverride.
+func (DeviceFeatureOverride) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("device_id", "feature_id").Unique(),
+		index.Fields("feature_id"),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DeviceGroup holds the schema definition for the DeviceGroup entity.
+// 静态设备分组，成员需要显式添加，可作为批量操作的目标
+type DeviceGroup struct {
+	ent.Schema
+}
+
+// Fields of the DeviceGroup.
+func (DeviceGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.Int("product_id").
+			Comment("所属产品ID"),
+		field.String("name").
+			NotEmpty().
+			Comment("分组名称"),
+		field.String("description").
+			Optional().
+			Default("").
+			Comment("分组描述"),
+		field.Int("created_by").
+			Optional().
+			Comment("创建人ID"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the DeviceGroup.
+func (DeviceGroup) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).
+			Ref("device_groups").
+			Field("product_id").
+			Unique().
+			Required(),
+		edge.To("devices", Device.Type).
+			Comment("分组内的设备"),
+	}
+}
+
+// Indexes of the DeviceGroup.
+func (DeviceGroup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "name").
+			Unique(),
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DeviceHeartbeat holds the schema definition for the DeviceHeartbeat entity.
+// 原始心跳记录，只保留配置的天数，设备的最新状态冗余在Device上
+type DeviceHeartbeat struct {
+	ent.Schema
+}
+
+// Fields of the DeviceHeartbeat.
+func (DeviceHeartbeat) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.Int("device_id").
+			Immutable().
+			Comment("设备ID"),
+		field.Int("product_id").
+			Immutable().
+			Comment("产品ID"),
+		field.String("software_version").
+			Optional().
+			Default("").
+			Immutable().
+			Comment("软件版本"),
+		field.String("firmware_version").
+			Optional().
+			Default("").
+			Immutable().
+			Comment("韧件版本"),
+		field.Int64("uptime").
+			Default(0).
+			Immutable().
+			Comment("运行时长（秒）"),
+		field.JSON("status", map[string]interface{}{}).
+			Optional().
+			Immutable().
+			Comment("设备上报的状态信息"),
+		field.String("remote_ip").
+			Optional().
+			Default("").
+			Immutable().
+			Comment("来源IP"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			Comment("接收时间"),
+	}
+}
+
+// Edges of the DeviceHeartbeat.
+func (DeviceHeartbeat) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("device", Device.Type).
+			Ref("heartbeats").
+			Field("device_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the DeviceHeartbeat.
+func (DeviceHeartbeat) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("device_id", "created_at"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DeviceSavedFilter holds the schema definition for the DeviceSavedFilter entity.
+// 用户保存的动态设备筛选条件，查询时按条件实时匹配设备
+type DeviceSavedFilter struct {
+	ent.Schema
+}
+
+// Fields of the DeviceSavedFilter.
+func (DeviceSavedFilter) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.Int("user_id").
+			Comment("所属用户ID"),
+		field.String("name").
+			NotEmpty().
+			Comment("筛选名称"),
+		field.Text("condition").
+			Comment("筛选条件JSON，对应dto.DeviceCondition"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the DeviceSavedFilter.
+func (DeviceSavedFilter) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("device_filters").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the DeviceSavedFilter.
+func (DeviceSavedFilter) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "name").
+			Unique(),
+	}
+}
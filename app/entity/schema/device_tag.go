@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DeviceTag holds the schema definition for the DeviceTag entity.
+// 标签按产品划分，与设备多对多关联
+type DeviceTag struct {
+	ent.Schema
+}
+
+// Fields of the DeviceTag.
+func (DeviceTag) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.Int("product_id").
+			Comment("所属产品ID"),
+		field.String("name").
+			NotEmpty().
+			Comment("标签名称"),
+		field.String("color").
+			Optional().
+			Default("").
+			Comment("标签颜色，用于前端显示"),
+		field.Int("created_by").
+			Optional().
+			Comment("创建人ID"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the DeviceTag.
+func (DeviceTag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).
+			Ref("device_tags").
+			Field("product_id").
+			Unique().
+			Required(),
+		edge.From("devices", Device.Type).
+			Ref("tags"),
+	}
+}
+
+// Indexes of the DeviceTag.
+func (DeviceTag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "name").
+			Unique(),
+	}
+}
@@ -13,6 +13,14 @@ type FirmwareVersion struct {
 	ent.Schema
 }
 
+// Mixin 软删除
+func (FirmwareVersion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+		ProductScopeMixin{},
+	}
+}
+
 // Fields 定义表字段
 func (FirmwareVersion) Fields() []ent.Field {
 	return []ent.Field{
@@ -61,8 +69,8 @@ func (FirmwareVersion) Edges() []ent.Edge {
 // Indexes 定义索引
 func (FirmwareVersion) Indexes() []ent.Index {
 	return []ent.Index{
-		// 确保同一产品下版本号唯一
-		index.Fields("product_id", "version").
+		// 确保同一产品下版本号唯一，回收站中的版本号允许被重新使用
+		index.Fields("product_id", "version", "deleted_id").
 			Unique(),
 	}
 } 
\ No newline at end of file
@@ -0,0 +1,115 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Job holds the schema definition for the Job entity.
+// 异步批量任务，按分块处理条目，每块一个事务并记录进度，服务重启后从已处理位置继续
+type Job struct {
+	ent.Schema
+}
+
+// Fields of the Job.
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.Enum("type").
+			Values("device_batch_add", "device_batch_license").
+			Immutable().
+			Comment("任务类型"),
+		field.String("params").
+			SchemaType(map[string]string{
+				"mysql":    "LONGTEXT",
+				"postgres": "TEXT",
+				"sqlite3":  "TEXT",
+			}).
+			Immutable().
+			Comment("任务参数(JSON格式)"),
+		field.Enum("status").
+			Values("pending", "running", "succeeded", "failed", "canceled").
+			Default("pending").
+			Comment("任务状态"),
+		field.Int("total").
+			Default(0).
+			Comment("条目总数"),
+		field.Int("processed").
+			Default(0).
+			Comment("已处理条目数，条目按顺序处理"),
+		field.Int("succeeded").
+			Default(0).
+			Comment("成功条目数"),
+		field.Int("failed").
+			Default(0).
+			Comment("失败条目数"),
+		field.String("result").
+			Optional().
+			Default("").
+			SchemaType(map[string]string{
+				"mysql":    "TEXT",
+				"postgres": "TEXT",
+				"sqlite3":  "TEXT",
+			}).
+			Comment("任务结果(JSON格式)"),
+		field.String("error_report").
+			Optional().
+			Default("").
+			SchemaType(map[string]string{
+				"mysql":    "LONGTEXT",
+				"postgres": "TEXT",
+				"sqlite3":  "TEXT",
+			}).
+			Comment("失败条目及原因(JSON格式)"),
+		field.Int("retry_of").
+			Optional().
+			Comment("重试来源任务ID"),
+		field.Int("created_by").
+			Immutable().
+			Comment("创建人ID"),
+		field.Int("api_token_id").
+			Optional().
+			Immutable().
+			Comment("提交时使用的API令牌ID，执行时受该令牌当前的授权范围限制"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("started_at").
+			Optional().
+			Nillable().
+			Comment("开始执行时间"),
+		field.Time("finished_at").
+			Optional().
+			Nillable().
+			Comment("结束时间"),
+	}
+}
+
+// Edges of the Job.
+func (Job) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("creator", User.Type).
+			Ref("jobs").
+			Field("created_by").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the Job.
+func (Job) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_by", "created_at"),
+		index.Fields("status"),
+	}
+}
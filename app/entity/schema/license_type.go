@@ -13,6 +13,14 @@ type LicenseType struct {
 	ent.Schema
 }
 
+// Mixin of the LicenseType.
+func (LicenseType) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+		ProductScopeMixin{},
+	}
+}
+
 // Fields of the LicenseType.
 func (LicenseType) Fields() []ent.Field {
 	return []ent.Field{
@@ -28,6 +36,10 @@ func (LicenseType) Fields() []ent.Field {
 			Comment("许可证编码"),
 		field.Int("product_id").
 			Comment("所属产品ID"),
+		field.Int("parent_id").
+			Optional().
+			Nillable().
+			Comment("父许可证类型ID，继承父类型的全部功能"),
 		field.Time("created_at").
 			Immutable().
 			Default(time.Now),
@@ -48,5 +60,11 @@ func (LicenseType) Edges() []ent.Edge {
 		edge.To("features", ProductFeature.Type).
 			Through("license_type_features", LicenseTypeFeatures.Type),
 		edge.To("devices", Device.Type),
+		edge.To("orders", Order.Type),
+		edge.To("lots", Lot.Type),
+		edge.To("children", LicenseType.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
 	}
 }
@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Lot holds the schema definition for the Lot entity.
+// 生产批次，可以归属某个订单，批次号在产品内唯一
+type Lot struct {
+	ent.Schema
+}
+
+// Fields of the Lot.
+func (Lot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.String("lot_no").
+			NotEmpty().
+			Comment("批次号"),
+		field.Int("product_id").
+			Comment("所属产品ID"),
+		field.Int("order_id").
+			Optional().
+			Comment("所属订单ID"),
+		field.Int("license_type_id").
+			Optional().
+			Comment("许可证类型ID，设备入库时未指定许可证类型则使用该值"),
+		field.Int("quantity").
+			NonNegative().
+			Comment("批次数量"),
+		field.Time("manufactured_at").
+			Optional().
+			Nillable().
+			Comment("生产日期"),
+		field.Time("ship_date").
+			Optional().
+			Nillable().
+			Comment("出货日期"),
+		field.String("remark").
+			Optional().
+			Default("").
+			Comment("备注"),
+		field.Int("created_by").
+			Optional().
+			Comment("创建人ID"),
+		field.Int("updated_by").
+			Optional().
+			Comment("更新人ID"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Lot.
+func (Lot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).
+			Ref("lots").
+			Unique().
+			Field("product_id").
+			Required(),
+		edge.From("order", Order.Type).
+			Ref("lots").
+			Unique().
+			Field("order_id"),
+		edge.From("license_type", LicenseType.Type).
+			Ref("lots").
+			Unique().
+			Field("license_type_id"),
+		edge.To("devices", Device.Type),
+	}
+}
+
+// Indexes of the Lot.
+func (Lot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "lot_no").
+			Unique(),
+		index.Fields("product_id", "created_at"),
+		index.Fields("order_id"),
+	}
+}
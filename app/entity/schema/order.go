@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Order holds the schema definition for the Order entity.
+// 采购订单，设备批量入库时可以关联订单，用于统计订单履约情况
+type Order struct {
+	ent.Schema
+}
+
+// Fields of the Order.
+func (Order) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.String("order_no").
+			NotEmpty().
+			Comment("订单号"),
+		field.Int("product_id").
+			Comment("所属产品ID"),
+		field.Int("customer_id").
+			Optional().
+			Comment("下单客户ID"),
+		field.Int("license_type_id").
+			Optional().
+			Comment("订购的许可证类型ID，设备入库时未指定许可证类型则使用该值"),
+		field.Int("quantity").
+			NonNegative().
+			Comment("订购数量"),
+		field.Time("ship_date").
+			Optional().
+			Nillable().
+			Comment("计划出货日期"),
+		field.String("remark").
+			Optional().
+			Default("").
+			Comment("备注"),
+		field.Int("created_by").
+			Optional().
+			Comment("创建人ID"),
+		field.Int("updated_by").
+			Optional().
+			Comment("更新人ID"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Order.
+func (Order) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).
+			Ref("orders").
+			Unique().
+			Field("product_id").
+			Required(),
+		edge.From("customer", Customer.Type).
+			Ref("orders").
+			Unique().
+			Field("customer_id"),
+		edge.From("license_type", LicenseType.Type).
+			Ref("orders").
+			Unique().
+			Field("license_type_id"),
+		edge.To("lots", Lot.Type),
+		edge.To("devices", Device.Type),
+	}
+}
+
+// Indexes of the Order.
+func (Order) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("order_no").
+			Unique(),
+		index.Fields("product_id", "created_at"),
+		index.Fields("customer_id"),
+	}
+}
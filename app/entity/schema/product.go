@@ -14,6 +14,13 @@ type Product struct {
 	ent.Schema
 }
 
+// Mixin of the Product.
+func (Product) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 // Fields of the Product.
 func (Product) Fields() []ent.Field {
 	return []ent.Field{
@@ -22,16 +29,21 @@ func (Product) Fields() []ent.Field {
 			Immutable(),
 		field.String("code").
 			NotEmpty().
-			Comment("产品代号").
-			Unique(),
+			Comment("产品代号"),
 		field.String("product_type").
 			Default("default").
 			Optional().
 			Comment("产品类别"),
 		field.String("product_name").
 			NotEmpty().
-			Comment("产品名称").
-			Unique(),
+			Comment("产品名称"),
+		field.Int("warranty_months").
+			NonNegative().
+			Default(12).
+			Comment("保修期（月），设备出货后按此计算保修截止时间"),
+		field.Text("device_attribute_schema").
+			Optional().
+			Comment("设备自定义属性的JSON Schema，为空表示不限制"),
 		field.Time("created_at").
 			Immutable().
 			Default(time.Now), // 自动设置创建时间
@@ -51,13 +63,25 @@ func (Product) Edges() []ent.Edge {
 		edge.To("software_versions", SoftwareVersion.Type), // 产品的软件版本
 		edge.To("devices", Device.Type),
 		edge.To("audit_logs", AuditLog.Type), // 产品的审计日志
+		edge.To("sn_rule", SnRule.Type).Unique(), // 产品的序列号规则
+		edge.To("sn_allocators", SnAllocator.Type), // 产品的SN分配器
+		edge.To("device_tags", DeviceTag.Type),     // 产品的设备标签
+		edge.To("device_groups", DeviceGroup.Type), // 产品的设备分组
+		edge.To("orders", Order.Type),              // 产品的采购订单
+		edge.To("lots", Lot.Type),                  // 产品的生产批次
+		edge.To("invitations", ProductInvitation.Type), // 产品的管理员邀请和转让
+		// 注册后加入产品的邀请码
+		edge.To("registration_invitations", RegistrationInvitation.Type),
 	}
 }
 
 // Indexes of the Product.
 func (Product) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("code").
+		// 回收站中的代号和名称允许被重新使用
+		index.Fields("code", "deleted_id").
+			Unique(),
+		index.Fields("product_name", "deleted_id").
 			Unique(),
 	}
 }
@@ -13,6 +13,14 @@ type ProductFeature struct {
 	ent.Schema
 }
 
+// Mixin of the ProductFeature.
+func (ProductFeature) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+		ProductScopeMixin{},
+	}
+}
+
 // Fields of the ProductFeature.
 func (ProductFeature) Fields() []ent.Field {
 	return []ent.Field{
@@ -50,5 +58,11 @@ func (ProductFeature) Edges() []ent.Edge {
 			Through("license_type_features", LicenseTypeFeatures.Type),
 		edge.From("software_versions", SoftwareVersion.Type).
 			Ref("features"),
+		edge.To("device_overrides", DeviceFeatureOverride.Type),
+		// 依赖：启用本功能时必须同时启用requires中的功能
+		edge.To("requires", ProductFeature.Type).
+			From("required_by"),
+		// 互斥：不能与conflicts中的功能同时启用，关系是对称的
+		edge.To("conflicts", ProductFeature.Type),
 	}
 } 
\ No newline at end of file
@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProductInvitation holds the schema definition for the ProductInvitation entity.
+// 产品管理员邀请和主管理员转让，被邀请人接受后生效；邀请未注册的邮箱时在注册时自动加入，转让仍需被邀请人接受
+type ProductInvitation struct {
+	ent.Schema
+}
+
+// Fields of the ProductInvitation.
+func (ProductInvitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.Int("product_id").
+			Immutable().
+			Comment("产品ID"),
+		field.Enum("type").
+			Values("manager", "transfer").
+			Immutable().
+			Comment("邀请类型：添加副管理员、转让主管理员"),
+		field.String("email").
+			NotEmpty().
+			Immutable().
+			Comment("被邀请人邮箱"),
+		field.Enum("access_role").
+			Values("viewer", "device_operator", "release_manager", "product_admin").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("加入后的角色，与产品管理员的access_role相同，转让时为空"),
+		field.String("remark").
+			Optional().
+			Default("").
+			Immutable().
+			Comment("备注，加入后写入产品管理员备注"),
+		field.Enum("status").
+			Values("pending", "accepted", "declined", "revoked").
+			Default("pending").
+			Comment("状态，pending且超过expires_at视为已过期"),
+		field.Int("invited_by").
+			Immutable().
+			Comment("邀请人ID"),
+		field.Int("responded_by").
+			Optional().
+			Nillable().
+			Comment("接受、拒绝或撤销的用户ID"),
+		field.Time("expires_at").
+			Comment("过期时间"),
+		field.Time("responded_at").
+			Optional().
+			Nillable().
+			Comment("处理时间"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ProductInvitation.
+func (ProductInvitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).
+			Ref("invitations").
+			Field("product_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("inviter", User.Type).
+			Ref("invitations").
+			Field("invited_by").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the ProductInvitation.
+func (ProductInvitation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "status"),
+		index.Fields("email", "status"),
+	}
+}
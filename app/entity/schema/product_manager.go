@@ -27,6 +27,11 @@ func (ProductManager) Fields() []ent.Field {
 			Values("read", "full").
 			Default("read").
 			Comment("权限：只读、完全，【主管理员和super_user(id:0)不受此字段限制】"),
+		field.Enum("access_role").
+			Values("viewer", "device_operator", "release_manager", "product_admin").
+			Optional().
+			Nillable().
+			Comment("产品内角色，为空时按role和permissions推导：主管理员为owner，full为product_admin，read为viewer"),
 		field.String("remark").
 			Optional().
 			Comment("备注信息"),
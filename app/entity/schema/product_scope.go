@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"context"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/intercept"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/privacy"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ProductScopeMixin 按产品隔离的行级权限，使用该mixin的表必须有product_id字段。
+// 查询自动过滤为上下文中viewer可访问的产品，写入受隐私策略约束，
+// 后台任务、定时任务和迁移使用viewer.SystemContext显式绕过
+type ProductScopeMixin struct {
+	mixin.Schema
+}
+
+// Interceptors of the ProductScopeMixin.
+func (ProductScopeMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			// 没有viewer的查询由隐私策略拒绝
+			v := viewer.FromContext(ctx)
+			if v == nil {
+				return nil
+			}
+			ids, all, err := v.ProductIDs(ctx)
+			if err != nil || all {
+				return err
+			}
+			q.WhereP(sql.FieldIn("product_id", ids...))
+			return nil
+		}),
+	}
+}
+
+// Policy of the ProductScopeMixin.
+func (ProductScopeMixin) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			privacy.QueryRuleFunc(func(ctx context.Context, _ ent.Query) error {
+				if viewer.FromContext(ctx) == nil {
+					return privacy.Denyf("product scope: no viewer in context")
+				}
+				return privacy.Skip
+			}),
+		},
+		Mutation: privacy.MutationPolicy{
+			privacy.MutationRuleFunc(productScopeMutation),
+		},
+	}
+}
+
+// productScopeMutation 写入的product_id必须在viewer范围内，更新和删除只作用于范围内的记录。
+// 没有viewer时只允许创建不属于任何产品的记录，如登录时的审计日志
+func productScopeMutation(ctx context.Context, m ent.Mutation) error {
+	v := viewer.FromContext(ctx)
+	if v == nil {
+		if _, ok := m.Field("product_id"); ok || !m.Op().Is(ent.OpCreate) {
+			return privacy.Denyf("product scope: no viewer in context")
+		}
+		return privacy.Skip
+	}
+	ids, all, err := v.ProductIDs(ctx)
+	if err != nil {
+		return privacy.Denyf("product scope: %v", err)
+	}
+	if all {
+		return privacy.Skip
+	}
+	if value, ok := m.Field("product_id"); ok {
+		if id, _ := value.(int); !viewer.Contains(ids, id) {
+			return privacy.Denyf("product scope: product %d is out of scope", id)
+		}
+	}
+	if !m.Op().Is(ent.OpCreate) {
+		w, ok := m.(interface{ WhereP(...func(*sql.Selector)) })
+		if !ok {
+			return privacy.Denyf("product scope: unexpected mutation %T", m)
+		}
+		w.WhereP(sql.FieldIn("product_id", ids...))
+	}
+	return privacy.Skip
+}
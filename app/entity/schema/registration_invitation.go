@@ -0,0 +1,108 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RegistrationInvitation holds the schema definition for the RegistrationInvitation entity.
+// 注册邀请码，仅邀请注册模式下注册必须使用；可绑定邮箱、限制使用次数，并可在注册后自动加入产品
+type RegistrationInvitation struct {
+	ent.Schema
+}
+
+// Fields of the RegistrationInvitation.
+func (RegistrationInvitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.String("code_hash").
+			Unique().
+			Sensitive().
+			Immutable().
+			Comment("邀请码的SHA-256，邀请码只在创建时返回"),
+		field.String("code_prefix").
+			Immutable().
+			Comment("邀请码前几位，用于在列表中辨认"),
+		field.String("email").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("绑定的邮箱，为空时任何邮箱都可以使用"),
+		field.Int("max_uses").
+			Positive().
+			Default(1).
+			Immutable().
+			Comment("最多可注册的用户数"),
+		field.Int("used_count").
+			NonNegative().
+			Default(0).
+			Comment("已注册的用户数"),
+		field.Int("product_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("注册后加入的产品，为空时只注册账号"),
+		field.Enum("access_role").
+			Values("viewer", "device_operator", "release_manager", "product_admin").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("加入产品后的角色，与产品管理员的access_role相同"),
+		field.String("remark").
+			Optional().
+			Default("").
+			Immutable().
+			Comment("备注"),
+		field.Int("created_by").
+			Immutable().
+			Comment("创建人ID"),
+		field.Time("expires_at").
+			Immutable().
+			Comment("过期时间"),
+		field.Int("revoked_by").
+			Optional().
+			Nillable().
+			Comment("撤销人ID"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("撤销时间"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the RegistrationInvitation.
+func (RegistrationInvitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).
+			Ref("registration_invitations").
+			Field("product_id").
+			Unique().
+			Immutable(),
+		edge.From("creator", User.Type).
+			Ref("registration_invitations").
+			Field("created_by").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the RegistrationInvitation.
+func (RegistrationInvitation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id"),
+		index.Fields("created_by"),
+	}
+}
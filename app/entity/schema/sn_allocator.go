@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SnAllocator holds the schema definition for the SnAllocator entity.
+// SN格式：前缀 + 日期码 + 补零计数器 + 可选校验位
+type SnAllocator struct {
+	ent.Schema
+}
+
+// Fields of the SnAllocator.
+func (SnAllocator) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.Int("product_id").
+			Comment("所属产品ID"),
+		field.String("name").
+			NotEmpty().
+			Comment("分配器名称"),
+		field.String("prefix").
+			Optional().
+			Default("").
+			Comment("SN前缀"),
+		field.String("date_format").
+			Optional().
+			Default("").
+			Comment("日期码格式，支持YYYY、YY、MM、DD、WW，为空不带日期码"),
+		field.Int("counter_width").
+			Positive().
+			Default(6).
+			Comment("计数器位数，不足补零"),
+		field.Enum("check_algorithm").
+			Values("none", "luhn", "mod37").
+			Default("none").
+			Comment("校验位算法，计算范围为去掉前缀后的部分"),
+		field.Int64("next_counter").
+			Positive().
+			Default(1).
+			Comment("下一个可分配的计数器值"),
+		field.Int("default_license_type_id").
+			Optional().
+			Comment("预创建设备时的默认许可证类型"),
+		field.Int("created_by").
+			Optional().
+			Comment("创建人ID"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the SnAllocator.
+func (SnAllocator) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).
+			Ref("sn_allocators").
+			Field("product_id").
+			Unique().
+			Required(),
+		edge.To("blocks", SnBlock.Type),
+	}
+}
+
+// Indexes of the SnAllocator.
+func (SnAllocator) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "name").
+			Unique(),
+	}
+}
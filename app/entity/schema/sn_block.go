@@ -0,0 +1,100 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SnBlock holds the schema definition for the SnBlock entity.
+// SN分配台账，保存分配时的格式快照，分配器修改后仍可还原整段SN
+type SnBlock struct {
+	ent.Schema
+}
+
+// Fields of the SnBlock.
+func (SnBlock) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.Int("allocator_id").
+			Immutable().
+			Comment("分配器ID"),
+		field.Int("product_id").
+			Immutable().
+			Comment("所属产品ID"),
+		field.String("prefix").
+			Immutable().
+			Default("").
+			Comment("分配时的SN前缀"),
+		field.String("date_code").
+			Immutable().
+			Default("").
+			Comment("分配时的日期码"),
+		field.Int("counter_width").
+			Immutable().
+			Comment("分配时的计数器位数"),
+		field.String("check_algorithm").
+			Immutable().
+			Default("none").
+			Comment("分配时的校验位算法"),
+		field.Int64("start_counter").
+			Immutable().
+			Comment("起始计数器值"),
+		field.Int("count").
+			Immutable().
+			Positive().
+			Comment("分配数量"),
+		field.String("first_sn").
+			Immutable().
+			Comment("第一个SN"),
+		field.String("last_sn").
+			Immutable().
+			Comment("最后一个SN"),
+		field.Bool("devices_created").
+			Default(false).
+			Comment("是否已预创建设备"),
+		field.Int("license_type_id").
+			Optional().
+			Comment("预创建设备使用的许可证类型"),
+		field.String("remark").
+			Optional().
+			Default("").
+			Comment("备注"),
+		field.Int("requested_by").
+			Immutable().
+			Comment("申请人ID"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the SnBlock.
+func (SnBlock) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("allocator", SnAllocator.Type).
+			Ref("blocks").
+			Field("allocator_id").
+			Immutable().
+			Unique().
+			Required(),
+		edge.To("requester", User.Type).
+			Field("requested_by").
+			Immutable().
+			Unique().
+			Required().
+			Comment("申请人"),
+	}
+}
+
+// Indexes of the SnBlock.
+func (SnBlock) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "created_at"),
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// SnRule holds the schema definition for the SnRule entity.
+// 每个产品最多一条序列号规则，没有规则的产品不限制SN格式
+type SnRule struct {
+	ent.Schema
+}
+
+// Fields of the SnRule.
+func (SnRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.Int("product_id").
+			Unique().
+			Comment("所属产品ID"),
+		field.String("prefix").
+			Optional().
+			Default("").
+			Comment("SN前缀"),
+		field.String("pattern").
+			Optional().
+			Default("").
+			Comment("SN正则表达式，为空不校验"),
+		field.Int("min_length").
+			NonNegative().
+			Default(0).
+			Comment("SN最小长度，0不限制"),
+		field.Int("max_length").
+			NonNegative().
+			Default(0).
+			Comment("SN最大长度，0不限制"),
+		field.Enum("check_algorithm").
+			Values("none", "luhn", "mod37").
+			Default("none").
+			Comment("校验位算法，校验位为SN最后一位，计算范围为去掉前缀后的部分"),
+		field.Int("updated_by").
+			Optional().
+			Comment("更新人ID"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the SnRule.
+func (SnRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).
+			Ref("sn_rule").
+			Field("product_id").
+			Unique().
+			Required(),
+	}
+}
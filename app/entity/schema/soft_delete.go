@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/hook"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/intercept"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// softDeleteKey 上下文键，标记查询需要包含已软删除的记录
+type softDeleteKey struct{}
+
+// SkipSoftDelete 返回包含已删除记录的上下文，用于回收站、恢复和清理
+func SkipSoftDelete(parent context.Context) context.Context {
+	return context.WithValue(parent, softDeleteKey{}, true)
+}
+
+// SoftDeleteMixin 软删除，deleted_at不为空表示记录在回收站中。
+// MySQL唯一索引不约束NULL，唯一索引使用非空的deleted_id：正常记录为0，回收站中为记录自身ID
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+// Fields of the SoftDeleteMixin.
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("删除时间"),
+		field.Int("deleted_id").
+			Default(0).
+			Comment("删除标记，正常记录为0，删除后为记录ID，用于唯一索引"),
+	}
+}
+
+// Hooks of the SoftDeleteMixin.
+func (SoftDeleteMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(syncDeletedID, ent.OpUpdate|ent.OpUpdateOne),
+	}
+}
+
+// syncDeletedID 设置或清空deleted_at时同步deleted_id，
+// 软删除只支持按ID逐条更新，批量更新无法为每条记录写入各自的ID
+func syncDeletedID(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		if m.FieldCleared("deleted_at") {
+			if err := m.SetField("deleted_id", 0); err != nil {
+				return nil, err
+			}
+		} else if _, ok := m.Field("deleted_at"); ok {
+			one, _ := m.(interface{ ID() (int, bool) })
+			if one == nil || !m.Op().Is(ent.OpUpdateOne) {
+				return nil, fmt.Errorf("soft delete: %s must be deleted by id", m.Type())
+			}
+			id, _ := one.ID()
+			if err := m.SetField("deleted_id", id); err != nil {
+				return nil, err
+			}
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// Interceptors of the SoftDeleteMixin.
+func (SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			// 默认查询过滤已删除记录
+			if skip, _ := ctx.Value(softDeleteKey{}).(bool); skip {
+				return nil
+			}
+			q.WhereP(sql.FieldIsNull("deleted_at"))
+			return nil
+		}),
+	}
+}
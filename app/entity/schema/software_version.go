@@ -13,6 +13,14 @@ type SoftwareVersion struct {
 	ent.Schema
 }
 
+// Mixin 软删除
+func (SoftwareVersion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+		ProductScopeMixin{},
+	}
+}
+
 // Fields 定义表字段
 func (SoftwareVersion) Fields() []ent.Field {
 	return []ent.Field{
@@ -64,8 +72,8 @@ func (SoftwareVersion) Edges() []ent.Edge {
 // Indexes 定义索引
 func (SoftwareVersion) Indexes() []ent.Index {
 	return []ent.Index{
-		// 确保同一产品下版本号唯一
-		index.Fields("product_id", "version").
+		// 确保同一产品下版本号唯一，回收站中的版本号允许被重新使用
+		index.Fields("product_id", "version", "deleted_id").
 			Unique(),
 	}
 } 
\ No newline at end of file
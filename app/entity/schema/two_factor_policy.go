@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// TwoFactorPolicy holds the schema definition for the TwoFactorPolicy entity.
+// 必须开启两步验证的角色，用户在任一产品中拥有其中的角色即必须开启
+type TwoFactorPolicy struct {
+	ent.Schema
+}
+
+// Fields of the TwoFactorPolicy.
+func (TwoFactorPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.String("role").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("角色，与权限矩阵中的角色相同"),
+		field.Int("created_by").
+			Immutable().
+			Comment("设置人ID"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
@@ -27,8 +27,40 @@ func (User) Fields() []ent.Field {
 		field.Bool("is_enabled").
 			Default(true).
 			Comment("用户是否正常启用"),
+		field.Bool("email_verified").
+			Default(true).
+			Comment("邮箱是否已验证，开启注册验证时新注册用户为false，验证后才能登录"),
+		field.Bool("is_system_admin").
+			Default(false).
+			Comment("系统管理员，拥有全部产品的全部权限"),
+		field.Bool("is_service").
+			Default(false).
+			Comment("服务账号，只能通过服务令牌访问，不能登录"),
 		field.Time("last_login_at").
 			Optional(),
+		field.Bool("totp_enabled").
+			Default(false).
+			Comment("是否已开启两步验证"),
+		field.String("totp_secret").
+			Optional().
+			Nillable().
+			Sensitive().
+			Comment("两步验证密钥，加密保存"),
+		field.Int64("totp_last_step").
+			Default(0).
+			Comment("最近一次通过校验的时间步，不接受不大于它的验证码，防止重放"),
+		field.JSON("totp_recovery_codes", []string{}).
+			Optional().
+			Sensitive().
+			Comment("未使用的恢复码的SHA-256"),
+		field.String("oidc_issuer").
+			Optional().
+			Nillable().
+			Comment("关联的单点登录身份提供方"),
+		field.String("oidc_subject").
+			Optional().
+			Nillable().
+			Comment("身份提供方中的用户标识(sub)，关联后按(issuer, sub)登录，不再按邮箱匹配"),
 		field.Time("created_at").
 			Immutable().
 			Default(time.Now), // 自动设置创建时间
@@ -47,7 +79,14 @@ func (User) Edges() []ent.Edge {
 			Ref("creator"),
 		edge.From("updated_devices", Device.Type).
 			Ref("updater"),
-		edge.To("posts", Post.Type), // 用户创建的文章
+		edge.To("posts", Post.Type),                       // 用户创建的文章
+		edge.To("device_filters", DeviceSavedFilter.Type), // 用户保存的设备筛选
+		edge.To("jobs", Job.Type),                         // 用户提交的异步任务
+		edge.To("invitations", ProductInvitation.Type),    // 用户发出的产品邀请
+		// 用户创建的注册邀请码
+		edge.To("registration_invitations", RegistrationInvitation.Type),
+		// 用户的API令牌
+		edge.To("api_tokens", APIToken.Type),
 	}
 }
 
@@ -56,5 +95,8 @@ func (User) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("email").
 			Unique(),
+		// 未关联的用户两列都为NULL，不受唯一约束
+		index.Fields("oidc_issuer", "oidc_subject").
+			Unique(),
 	}
 }
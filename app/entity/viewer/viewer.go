@@ -0,0 +1,100 @@
+package viewer
+
+import (
+	"context"
+	"sync"
+)
+
+// ContextKey gin.Context中保存Viewer的键，gin.Context作为context.Context时只按字符串键查找Keys
+const ContextKey = "viewer"
+
+// ctxKey 普通上下文中保存Viewer的键
+type ctxKey struct{}
+
+// Loader 加载用户可访问的产品ID，all为true表示不限制
+type Loader func(ctx context.Context) (ids []int, all bool, err error)
+
+// Viewer 查询身份，决定ent查询和写入可访问的产品范围
+type Viewer struct {
+	UserID int
+	system bool
+	load   Loader
+
+	mu     sync.Mutex
+	loaded bool
+	ids    []int
+	all    bool
+}
+
+// System 系统身份，不限制产品范围，用于后台任务、定时任务、迁移和设备签名接口
+func System() *Viewer {
+	return &Viewer{system: true}
+}
+
+// ForUser 登录用户的查询身份，产品范围在第一次使用时加载并在请求内缓存
+func ForUser(userID int, load Loader) *Viewer {
+	return &Viewer{UserID: userID, load: load}
+}
+
+// IsSystem 是否为系统身份
+func (v *Viewer) IsSystem() bool {
+	return v.system
+}
+
+// ProductIDs 可访问的产品ID，all为true表示不限制
+func (v *Viewer) ProductIDs(ctx context.Context) ([]int, bool, error) {
+	if v.system {
+		return nil, true, nil
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if !v.loaded {
+		ids, all, err := v.load(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		v.ids, v.all, v.loaded = ids, all, true
+	}
+	return v.ids, v.all, nil
+}
+
+// AddProduct 将当前请求中新建的产品加入可访问范围，创建者随后可以写入该产品的记录
+func (v *Viewer) AddProduct(ctx context.Context, productID int) error {
+	ids, all, err := v.ProductIDs(ctx)
+	if err != nil || all || Contains(ids, productID) {
+		return err
+	}
+	v.mu.Lock()
+	v.ids = append(v.ids, productID)
+	v.mu.Unlock()
+	return nil
+}
+
+// Contains 产品ID是否在范围内
+func Contains(ids []int, productID int) bool {
+	for _, id := range ids {
+		if id == productID {
+			return true
+		}
+	}
+	return false
+}
+
+// NewContext 返回携带查询身份的上下文
+func NewContext(parent context.Context, v *Viewer) context.Context {
+	return context.WithValue(parent, ctxKey{}, v)
+}
+
+// SystemContext 返回系统身份的上下文，显式绕过产品范围限制
+func SystemContext(parent context.Context) context.Context {
+	return NewContext(parent, System())
+}
+
+// FromContext 获取上下文中的查询身份，没有时返回nil
+func FromContext(ctx context.Context) *Viewer {
+	if v, ok := ctx.Value(ctxKey{}).(*Viewer); ok {
+		return v
+	}
+	v, _ := ctx.Value(ContextKey).(*Viewer)
+	return v
+}
@@ -0,0 +1,62 @@
+package viewer
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestViewerProductIDs(t *testing.T) {
+	calls := 0
+	v := ForUser(7, func(context.Context) ([]int, bool, error) {
+		calls++
+		return []int{1, 2}, false, nil
+	})
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if ids, all, err := v.ProductIDs(ctx); err != nil || all || !reflect.DeepEqual(ids, []int{1, 2}) {
+			t.Fatalf("ProductIDs = %v, %v, %v", ids, all, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("loader called %d times, want 1", calls)
+	}
+
+	// 新建的产品加入范围，重复加入不产生重复ID
+	_ = v.AddProduct(ctx, 3)
+	_ = v.AddProduct(ctx, 3)
+	if ids, _, _ := v.ProductIDs(ctx); !reflect.DeepEqual(ids, []int{1, 2, 3}) {
+		t.Errorf("ProductIDs after AddProduct = %v", ids)
+	}
+
+	if ids, all, _ := System().ProductIDs(ctx); !all || ids != nil {
+		t.Errorf("system ProductIDs = %v, %v", ids, all)
+	}
+
+	// 加载失败时不缓存，下次重新加载
+	failed := ForUser(8, func(context.Context) ([]int, bool, error) {
+		calls++
+		return nil, false, errors.New("db down")
+	})
+	if _, _, err := failed.ProductIDs(ctx); err == nil {
+		t.Error("expected load error")
+	}
+	if err := failed.AddProduct(ctx, 1); err == nil {
+		t.Error("expected AddProduct to return load error")
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	if FromContext(context.Background()) != nil {
+		t.Error("empty context has viewer")
+	}
+	if v := FromContext(SystemContext(context.Background())); v == nil || !v.IsSystem() {
+		t.Errorf("SystemContext viewer = %+v", v)
+	}
+	// gin.Context按字符串键查找Keys中的值
+	u := ForUser(1, nil)
+	if v := FromContext(context.WithValue(context.Background(), ContextKey, u)); v != u {
+		t.Errorf("string key viewer = %+v", v)
+	}
+}
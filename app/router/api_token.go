@@ -0,0 +1,21 @@
+package router
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/controller"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Routers = append(Routers, APITokenRouterRegister)
+}
+
+func APITokenRouterRegister(r *gin.RouterGroup) {
+	apiTokenGroup := r.Group("api-token")
+	apiTokenController := controller.NewAPITokenController()
+	{
+		apiTokenGroup.POST("/create-personal", apiTokenController.CreatePersonalToken)
+		apiTokenGroup.POST("/create-service", apiTokenController.CreateServiceToken)
+		apiTokenGroup.GET("/list", apiTokenController.ListTokens)
+		apiTokenGroup.POST("/revoke", apiTokenController.RevokeToken)
+	}
+}
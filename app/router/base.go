@@ -22,9 +22,15 @@ func BaseRouterRegister(r *gin.RouterGroup) {
 		//baseGroup.GET("/refresh", baseController.Refresh)
 		baseGroup.POST("/login", baseController.UserLoginByPassword)
 		baseGroup.POST("/register", baseController.UserRegisterByPassword)
-		//baseGroup.POST("/registerByInvitation", baseController.RegisterByInvitation)
-		//baseGroup.POST("/sendActivationEmail", baseController.SendActivationEmail)
-		//baseGroup.POST("/validateActivationEmail", baseController.ValidateActivationEmail)
-		//baseGroup.POST("/resetPassword", baseController.ResetPassword)
+		baseGroup.POST("/verifyTwoFactor", baseController.VerifyTwoFactor)
+		baseGroup.POST("/enrollTwoFactor", baseController.EnrollTwoFactor)
+		baseGroup.POST("/activateTwoFactor", baseController.ActivateTwoFactor)
+		baseGroup.GET("/oidc/authorize", baseController.OIDCAuthorize)
+		baseGroup.POST("/oidc/login", baseController.OIDCLogin)
+		baseGroup.POST("/registerByInvitation", baseController.RegisterByInvitation)
+		baseGroup.POST("/sendActivationEmail", baseController.SendActivationEmail)
+		baseGroup.POST("/validateActivationEmail", baseController.ValidateActivationEmail)
+		baseGroup.POST("/sendResetPasswordEmail", baseController.SendResetPasswordEmail)
+		baseGroup.POST("/resetPassword", baseController.ResetPassword)
 	}
 }
@@ -0,0 +1,21 @@
+package router
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/controller"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Routers = append(Routers, CatalogRouterRegister)
+}
+
+func CatalogRouterRegister(r *gin.RouterGroup) {
+	catalogGroup := r.Group("catalog")
+	catalogController := controller.NewCatalogController()
+	{
+		// 产品目录导出、预览和导入
+		catalogGroup.GET("/export", catalogController.ExportCatalog)
+		catalogGroup.POST("/plan", catalogController.PlanCatalog)
+		catalogGroup.POST("/apply", catalogController.ApplyCatalog)
+	}
+}
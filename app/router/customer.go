@@ -0,0 +1,28 @@
+package router
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/controller"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Routers = append(Routers, CustomerRouterRegister)
+}
+
+func CustomerRouterRegister(r *gin.RouterGroup) {
+	customerGroup := r.Group("customer")
+	customerController := controller.NewCustomerController()
+	{
+		// 客户管理
+		customerGroup.GET("/list", customerController.ListCustomers)
+		customerGroup.POST("/add", customerController.AddCustomer)
+		customerGroup.POST("/update", customerController.UpdateCustomer)
+		customerGroup.GET("/del", customerController.DeleteCustomer)
+
+		// 设备归属和保修
+		customerGroup.POST("/assign", customerController.AssignCustomer)
+		customerGroup.GET("/devices", customerController.ListCustomerDevices)
+		customerGroup.GET("/assignment-history", customerController.ListAssignmentHistory)
+		customerGroup.GET("/warranty-expiry", customerController.WarrantyExpiryReport)
+	}
+}
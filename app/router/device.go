@@ -2,6 +2,7 @@ package router
 
 import (
 	"cambridge-hit.com/gin-base/activateserver/app/controller"
+	"cambridge-hit.com/gin-base/activateserver/pkg/middleware"
 	"github.com/gin-gonic/gin"
 )
 
@@ -23,12 +24,31 @@ func DeviceRouterRegister(r *gin.RouterGroup) {
 		// 设备管理
 		deviceGroup.POST("/add", deviceController.AddDevice)
 		deviceGroup.POST("/batch-add", deviceController.BatchAddDevices)
+		deviceGroup.POST("/import", deviceController.ImportDevices)
 		deviceGroup.PUT("/update", deviceController.UpdateDevice)
 		deviceGroup.DELETE("/:id", deviceController.DeleteDevice)
 		deviceGroup.POST("/batch-update-license", deviceController.BatchUpdateLicenseType)
+		deviceGroup.GET("/export", deviceController.ExportDevices)
+
+		// 自定义属性定义
+		deviceGroup.GET("/attribute-schema", deviceController.GetAttributeSchema)
+		deviceGroup.POST("/attribute-schema", deviceController.SetAttributeSchema)
+
+		// 设备功能附加项
+		deviceGroup.POST("/feature-add-on", deviceController.SetFeatureAddOn)
+		deviceGroup.DELETE("/feature-add-on/:id", deviceController.DeleteFeatureAddOn)
+
+		// 生命周期状态变更
+		deviceGroup.POST("/transition", deviceController.TransitionDevice)
+		deviceGroup.POST("/batch-transition", deviceController.BatchTransitionDevices)
+
+		// 设备心跳及版本分布
+		deviceGroup.POST("/heartbeat", middleware.SystemViewer(), deviceController.ReportHeartbeat)
+		deviceGroup.POST("/signing-key", deviceController.RegisterHeartbeatKey) // 登记心跳签名公钥，需要登录
+		deviceGroup.GET("/version-distribution", deviceController.GetVersionDistribution)
 
 		// 获取设备激活文件
-		deviceGroup.GET("/activation-file/:sn", deviceController.GetActivationFile)
+		deviceGroup.GET("/activation-file/:sn", middleware.SystemViewer(), deviceController.GetActivationFile)
 
 		// 许可证类型列表
 		deviceGroup.GET("/license-types", deviceController.GetLicenseTypes)
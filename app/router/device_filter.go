@@ -0,0 +1,20 @@
+package router
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/controller"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Routers = append(Routers, DeviceFilterRouterRegister)
+}
+
+func DeviceFilterRouterRegister(r *gin.RouterGroup) {
+	deviceFilterGroup := r.Group("device-filter")
+	deviceFilterController := controller.NewDeviceFilterController()
+	{
+		deviceFilterGroup.GET("/list", deviceFilterController.ListDeviceFilters)
+		deviceFilterGroup.POST("/save", deviceFilterController.SaveDeviceFilter)
+		deviceFilterGroup.GET("/del", deviceFilterController.DeleteDeviceFilter)
+	}
+}
@@ -0,0 +1,25 @@
+package router
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/controller"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Routers = append(Routers, DeviceGroupRouterRegister)
+}
+
+func DeviceGroupRouterRegister(r *gin.RouterGroup) {
+	deviceGroupGroup := r.Group("device-group")
+	deviceGroupController := controller.NewDeviceGroupController()
+	{
+		deviceGroupGroup.GET("/list", deviceGroupController.ListDeviceGroups)
+		deviceGroupGroup.POST("/add", deviceGroupController.AddDeviceGroup)
+		deviceGroupGroup.POST("/put", deviceGroupController.ModifyDeviceGroup)
+		deviceGroupGroup.GET("/del", deviceGroupController.DeleteDeviceGroup)
+
+		// 分组成员，成员列表通过 /device/list?group_id= 查询
+		deviceGroupGroup.POST("/add-devices", deviceGroupController.AddDeviceGroupMembers)
+		deviceGroupGroup.POST("/remove-devices", deviceGroupController.RemoveDeviceGroupMembers)
+	}
+}
@@ -0,0 +1,25 @@
+package router
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/controller"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Routers = append(Routers, DeviceTagRouterRegister)
+}
+
+func DeviceTagRouterRegister(r *gin.RouterGroup) {
+	deviceTagGroup := r.Group("device-tag")
+	deviceTagController := controller.NewDeviceTagController()
+	{
+		deviceTagGroup.GET("/list", deviceTagController.ListDeviceTags)
+		deviceTagGroup.POST("/add", deviceTagController.AddDeviceTag)
+		deviceTagGroup.POST("/put", deviceTagController.ModifyDeviceTag)
+		deviceTagGroup.GET("/del", deviceTagController.DeleteDeviceTag)
+
+		// 设备打标签
+		deviceTagGroup.POST("/assign", deviceTagController.AssignDeviceTags)
+		deviceTagGroup.POST("/unassign", deviceTagController.UnassignDeviceTags)
+	}
+}
@@ -0,0 +1,26 @@
+package router
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/controller"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Routers = append(Routers, JobRouterRegister)
+}
+
+func JobRouterRegister(r *gin.RouterGroup) {
+	jobGroup := r.Group("job")
+	jobController := controller.NewJobController()
+	{
+		// 提交异步批量任务
+		jobGroup.POST("/device-batch-add", jobController.SubmitDeviceBatchAdd)
+		jobGroup.POST("/device-batch-update-license", jobController.SubmitDeviceBatchLicense)
+
+		// 任务查询、取消和重试
+		jobGroup.GET("/list", jobController.ListJobs)
+		jobGroup.GET("/:id", jobController.GetJob)
+		jobGroup.POST("/cancel", jobController.CancelJob)
+		jobGroup.POST("/retry", jobController.RetryJob)
+	}
+}
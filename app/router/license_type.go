@@ -17,5 +17,7 @@ func LicenseTypeRouterRegister(r *gin.RouterGroup) {
 		licenseTypeGroup.POST("/add", licenseTypeController.AddLicenseType)
 		licenseTypeGroup.GET("/del", licenseTypeController.DeleteLicenseType)
 		licenseTypeGroup.POST("/update-features", licenseTypeController.UpdateLicenseTypeFeatures)
+		licenseTypeGroup.GET("/effective-features", licenseTypeController.GetEffectiveFeatures)
+		licenseTypeGroup.POST("/set-parent", licenseTypeController.SetLicenseTypeParent)
 	}
 }
@@ -0,0 +1,35 @@
+package router
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/controller"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Routers = append(Routers, OrderRouterRegister)
+}
+
+func OrderRouterRegister(r *gin.RouterGroup) {
+	orderController := controller.NewOrderController()
+
+	orderGroup := r.Group("order")
+	{
+		// 采购订单
+		orderGroup.GET("/list", orderController.ListOrders)
+		orderGroup.POST("/add", orderController.AddOrder)
+		orderGroup.POST("/update", orderController.UpdateOrder)
+		orderGroup.GET("/del", orderController.DeleteOrder)
+
+		// 订单履约报表
+		orderGroup.GET("/fulfilment", orderController.FulfilmentReport)
+	}
+
+	lotGroup := r.Group("lot")
+	{
+		// 生产批次
+		lotGroup.GET("/list", orderController.ListLots)
+		lotGroup.POST("/add", orderController.AddLot)
+		lotGroup.POST("/update", orderController.UpdateLot)
+		lotGroup.GET("/del", orderController.DeleteLot)
+	}
+}
@@ -15,6 +15,7 @@ func ProductRouterRegister(r *gin.RouterGroup) {
 	{
 		productGroup.GET("/list", productController.ListProduct)
 		productGroup.POST("/add", productController.AddProduct)
+		productGroup.POST("/clone", productController.CloneProduct)
 		productGroup.GET("/del", productController.DeleteProduct)
 		productGroup.POST("/put", productController.ModifyProduct)
 		productGroup.POST("/add-manager", productController.AddManager)
@@ -16,5 +16,8 @@ func ProductFeatureRouterRegister(r *gin.RouterGroup) {
 		productFeatureGroup.GET("/list", productFeatureController.ListProductFeatures)
 		productFeatureGroup.POST("/add", productFeatureController.AddProductFeature)
 		productFeatureGroup.GET("/del", productFeatureController.DeleteProductFeature)
+		productFeatureGroup.GET("/graph", productFeatureController.GetFeatureGraph)
+		productFeatureGroup.POST("/relation/add", productFeatureController.AddFeatureRelation)
+		productFeatureGroup.POST("/relation/del", productFeatureController.RemoveFeatureRelation)
 	}
 } 
\ No newline at end of file
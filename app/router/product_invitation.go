@@ -0,0 +1,26 @@
+package router
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/controller"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Routers = append(Routers, ProductInvitationRouterRegister)
+}
+
+func ProductInvitationRouterRegister(r *gin.RouterGroup) {
+	invitationGroup := r.Group("invitation")
+	invitationController := controller.NewProductInvitationController()
+	{
+		// 产品管理员发出和管理邀请
+		invitationGroup.POST("/manager", invitationController.InviteManager)
+		invitationGroup.POST("/transfer", invitationController.TransferOwnership)
+		invitationGroup.GET("/list", invitationController.ListInvitations)
+		invitationGroup.POST("/revoke", invitationController.RevokeInvitation)
+		// 被邀请人处理邀请
+		invitationGroup.GET("/mine", invitationController.ListMyInvitations)
+		invitationGroup.POST("/accept", invitationController.AcceptInvitation)
+		invitationGroup.POST("/decline", invitationController.DeclineInvitation)
+	}
+}
@@ -0,0 +1,23 @@
+package router
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/controller"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Routers = append(Routers, RBACRouterRegister)
+}
+
+func RBACRouterRegister(r *gin.RouterGroup) {
+	rbacGroup := r.Group("rbac")
+	rbacController := controller.NewRBACController()
+	{
+		// 权限矩阵和当前用户的权限
+		rbacGroup.GET("/roles", rbacController.GetRoleMatrix)
+		rbacGroup.GET("/my-permissions", rbacController.GetMyPermissions)
+		// 角色分配
+		rbacGroup.POST("/manager-role", rbacController.SetManagerRole)
+		rbacGroup.POST("/system-admin", rbacController.SetSystemAdmin)
+	}
+}
@@ -0,0 +1,19 @@
+package router
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/controller"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Routers = append(Routers, RecycleBinRouterRegister)
+}
+
+func RecycleBinRouterRegister(r *gin.RouterGroup) {
+	recycleBinGroup := r.Group("recycle-bin")
+	recycleBinController := controller.NewRecycleBinController()
+	{
+		recycleBinGroup.GET("/list", recycleBinController.ListRecycleBin)
+		recycleBinGroup.POST("/restore", recycleBinController.RestoreRecycled)
+	}
+}
@@ -0,0 +1,20 @@
+package router
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/controller"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Routers = append(Routers, RegistrationInvitationRouterRegister)
+}
+
+func RegistrationInvitationRouterRegister(r *gin.RouterGroup) {
+	registrationInvitationGroup := r.Group("registration-invitation")
+	registrationInvitationController := controller.NewRegistrationInvitationController()
+	{
+		registrationInvitationGroup.POST("/create", registrationInvitationController.CreateInvitation)
+		registrationInvitationGroup.GET("/list", registrationInvitationController.ListInvitations)
+		registrationInvitationGroup.POST("/revoke", registrationInvitationController.RevokeInvitation)
+	}
+}
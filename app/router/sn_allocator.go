@@ -0,0 +1,25 @@
+package router
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/controller"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Routers = append(Routers, SnAllocatorRouterRegister)
+}
+
+func SnAllocatorRouterRegister(r *gin.RouterGroup) {
+	snAllocatorGroup := r.Group("sn-allocator")
+	snAllocatorController := controller.NewSnAllocatorController()
+	{
+		snAllocatorGroup.GET("/list", snAllocatorController.ListSnAllocators)
+		snAllocatorGroup.POST("/add", snAllocatorController.AddSnAllocator)
+		snAllocatorGroup.POST("/put", snAllocatorController.ModifySnAllocator)
+
+		// 分配SN段及台账
+		snAllocatorGroup.POST("/allocate", snAllocatorController.AllocateSnBlock)
+		snAllocatorGroup.GET("/blocks", snAllocatorController.ListSnBlocks)
+		snAllocatorGroup.GET("/blocks/:id/export", snAllocatorController.ExportSnBlock)
+	}
+}
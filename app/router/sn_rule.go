@@ -0,0 +1,22 @@
+package router
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/controller"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Routers = append(Routers, SnRuleRouterRegister)
+}
+
+func SnRuleRouterRegister(r *gin.RouterGroup) {
+	snRuleGroup := r.Group("sn-rule")
+	snRuleController := controller.NewSnRuleController()
+	{
+		snRuleGroup.GET("/get", snRuleController.GetSnRule)
+		snRuleGroup.POST("/save", snRuleController.SaveSnRule)
+		snRuleGroup.GET("/del", snRuleController.DeleteSnRule)
+		// 工厂工具预校验SN
+		snRuleGroup.POST("/validate", snRuleController.ValidateSNs)
+	}
+}
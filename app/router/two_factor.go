@@ -0,0 +1,27 @@
+package router
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/controller"
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	Routers = append(Routers, TwoFactorRouterRegister)
+}
+
+func TwoFactorRouterRegister(r *gin.RouterGroup) {
+	twoFactorGroup := r.Group("two-factor")
+	twoFactorController := controller.NewTwoFactorController()
+	{
+		// 当前用户的两步验证
+		twoFactorGroup.GET("/status", twoFactorController.Status)
+		twoFactorGroup.POST("/enroll", twoFactorController.Enroll)
+		twoFactorGroup.POST("/activate", twoFactorController.Activate)
+		twoFactorGroup.POST("/disable", twoFactorController.Disable)
+		twoFactorGroup.POST("/recovery-codes", twoFactorController.RegenerateRecoveryCodes)
+		// 系统管理员
+		twoFactorGroup.POST("/reset", twoFactorController.Reset)
+		twoFactorGroup.GET("/policy", twoFactorController.GetPolicy)
+		twoFactorGroup.POST("/policy", twoFactorController.SetPolicy)
+	}
+}
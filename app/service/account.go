@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/cache"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/mail"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/str"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 一次性令牌用途，签名中包含用途，验证令牌不能用于重置密码
+const (
+	tokenPurposeVerify  = "verify"
+	tokenPurposeReset   = "reset"
+	tokenPurposePreAuth = "pre_auth" // 登录两步验证的临时令牌
+)
+
+const (
+	defaultVerifyTokenMinutes  = 24 * 60
+	defaultResetTokenMinutes   = 30
+	defaultMailIntervalSeconds = 60
+)
+
+func accountConfig() resource.AccountConfig {
+	conf := resource.AccountConfig{}
+	if resource.Conf.AccountConfig != nil {
+		conf = *resource.Conf.AccountConfig
+	}
+	if conf.VerifyTokenMinutes <= 0 {
+		conf.VerifyTokenMinutes = defaultVerifyTokenMinutes
+	}
+	if conf.ResetTokenMinutes <= 0 {
+		conf.ResetTokenMinutes = defaultResetTokenMinutes
+	}
+	if conf.MailIntervalSeconds <= 0 {
+		conf.MailIntervalSeconds = defaultMailIntervalSeconds
+	}
+	if conf.TokenSecret == "" && resource.Conf.JwtConfig != nil {
+		conf.TokenSecret = resource.Conf.JwtConfig.AccessTokenSecret
+	}
+	return conf
+}
+
+// signAccountToken 令牌为 随机串.签名，签名覆盖用途和随机串
+func signAccountToken(secret, purpose, nonce string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(purpose + "." + nonce))
+	return nonce + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// parseAccountToken 校验签名并返回随机串，签名不符时不查询Redis
+func parseAccountToken(secret, purpose, token string) (string, bool) {
+	nonce, _, ok := strings.Cut(token, ".")
+	if !ok || nonce == "" {
+		return "", false
+	}
+	want := signAccountToken(secret, purpose, nonce)
+	return nonce, subtle.ConstantTimeCompare([]byte(want), []byte(token)) == 1
+}
+
+// accountTokenKey 令牌在Redis中的key
+func accountTokenKey(purpose, nonce string) string {
+	switch purpose {
+	case tokenPurposeReset:
+		return str.GetPasswordResetKey(nonce)
+	case tokenPurposePreAuth:
+		return str.GetPreAuthKey(nonce)
+	default:
+		return str.GetEmailActivateKey(nonce)
+	}
+}
+
+// issueAccountToken 生成一次性令牌并保存到Redis，同一用户同一用途只有最新的令牌有效
+func issueAccountToken(purpose string, userID int, ttl time.Duration) (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	nonce := base64.RawURLEncoding.EncodeToString(buf)
+
+	userKey := str.GetAccountTokenUserKey(purpose, userID)
+	if old, err := cache.MyRedis.GetDel(userKey); err == nil {
+		_ = cache.MyRedis.Del(accountTokenKey(purpose, old))
+	} else if !errors.Is(err, redis.Nil) {
+		return "", err
+	}
+	if err := cache.MyRedis.Set(accountTokenKey(purpose, nonce), userID, ttl); err != nil {
+		return "", err
+	}
+	if err := cache.MyRedis.Set(userKey, nonce, ttl); err != nil {
+		return "", err
+	}
+	return signAccountToken(accountConfig().TokenSecret, purpose, nonce), nil
+}
+
+// consumeAccountToken 校验并删除令牌，返回令牌所属用户，无效、已使用或已过期时返回0
+func consumeAccountToken(purpose, token string) (int, error) {
+	nonce, ok := parseAccountToken(accountConfig().TokenSecret, purpose, token)
+	if !ok {
+		return 0, nil
+	}
+	value, err := cache.MyRedis.GetDel(accountTokenKey(purpose, nonce))
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	userID, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	_ = cache.MyRedis.Del(str.GetAccountTokenUserKey(purpose, userID))
+	return userID, nil
+}
+
+// mailAllowed 同一邮箱同一用途在间隔内只发送一次
+func mailAllowed(purpose, email string) bool {
+	interval := time.Duration(accountConfig().MailIntervalSeconds) * time.Second
+	ok, err := cache.MyRedis.SetNX(str.GetMailThrottleKey(purpose, email), 1, interval)
+	if err != nil {
+		logger.Error("check mail throttle failed", zap.Error(err))
+		return false
+	}
+	return ok
+}
+
+// accountMail 邮箱验证和密码重置邮件内容
+func accountMail(purpose, email, token string, ttl time.Duration) mail.Message {
+	base := strings.TrimRight(accountConfig().LinkBaseURL, "/")
+	if purpose == tokenPurposeReset {
+		return mail.Message{
+			To:      []string{email},
+			Subject: "重置密码 / Reset your password",
+			Body: fmt.Sprintf("请在%d分钟内打开以下链接重置密码，如非本人操作请忽略本邮件。\n"+
+				"Open the link below within %d minutes to reset your password. Ignore this email if you did not request it.\n\n%s/reset-password?token=%s\n",
+				int(ttl.Minutes()), int(ttl.Minutes()), base, token),
+		}
+	}
+	return mail.Message{
+		To:      []string{email},
+		Subject: "验证邮箱 / Verify your email",
+		Body: fmt.Sprintf("请在%d分钟内打开以下链接验证邮箱。\n"+
+			"Open the link below within %d minutes to verify your email address.\n\n%s/verify-email?token=%s\n",
+			int(ttl.Minutes()), int(ttl.Minutes()), base, token),
+	}
+}
+
+// sendAccountMail 生成令牌并发送邮件
+func sendAccountMail(ctx context.Context, purpose string, u *ent.User) error {
+	minutes := accountConfig().VerifyTokenMinutes
+	if purpose == tokenPurposeReset {
+		minutes = accountConfig().ResetTokenMinutes
+	}
+	ttl := time.Duration(minutes) * time.Minute
+	token, err := issueAccountToken(purpose, u.ID, ttl)
+	if err != nil {
+		return err
+	}
+	return mail.Send(ctx, accountMail(purpose, u.Email, token, ttl))
+}
+
+// requireEmailVerification 新注册用户是否需要验证邮箱
+func requireEmailVerification() bool {
+	return resource.Conf.AccountConfig != nil && resource.Conf.AccountConfig.RequireEmailVerification
+}
+
+// SendActivationEmail 重新发送邮箱验证邮件，邮箱不存在或已验证时同样返回成功，避免泄露注册情况
+func (s *BaseService) SendActivationEmail(c *gin.Context, email string) resource.RspCode {
+	u, err := dto.Client().User.Query().Where(user.EmailEQ(email)).Only(c)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.CODE_SUCCESS
+		}
+		logger.Error("query user failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if u.EmailVerified || !mailAllowed(tokenPurposeVerify, email) {
+		return resource.CODE_SUCCESS
+	}
+	if err := sendAccountMail(c, tokenPurposeVerify, u); err != nil {
+		logger.Error("send verification email failed", zap.Error(err))
+		return resource.ERR_MAIL_SEND_FAILED
+	}
+	return resource.CODE_SUCCESS
+}
+
+// ValidateActivationEmail 使用邮件中的令牌验证邮箱
+func (s *BaseService) ValidateActivationEmail(c *gin.Context, token string) resource.RspCode {
+	userID, err := consumeAccountToken(tokenPurposeVerify, token)
+	if err != nil {
+		logger.Error("consume verification token failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if userID == 0 {
+		return resource.ERR_ACCOUNT_TOKEN_INVALID
+	}
+
+	u, err := dto.Client().User.UpdateOneID(userID).SetEmailVerified(true).Save(c)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_ACCOUNT_TOKEN_INVALID
+		}
+		logger.Error("verify email failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	CreateAuditLog(c, nil, dto.AuditLogData{
+		UserID: u.ID,
+		Action: dto.ActionVerifyEmail,
+		Module: dto.ModuleAuth,
+		DetailInfo: map[string]interface{}{
+			"email":  u.Email,
+			"status": "success",
+		},
+	})
+	// 注册时未生效的副管理员邀请在验证后生效
+	completeInvitations(c, u)
+	return resource.CODE_SUCCESS
+}
+
+// SendResetPasswordEmail 发送密码重置邮件，邮箱不存在时同样返回成功，避免泄露注册情况
+func (s *BaseService) SendResetPasswordEmail(c *gin.Context, email string) resource.RspCode {
+	u, err := dto.Client().User.Query().Where(user.EmailEQ(email)).Only(c)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.CODE_SUCCESS
+		}
+		logger.Error("query user failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if !u.IsEnabled || !mailAllowed(tokenPurposeReset, email) {
+		return resource.CODE_SUCCESS
+	}
+	if err := sendAccountMail(c, tokenPurposeReset, u); err != nil {
+		logger.Error("send reset password email failed", zap.Error(err))
+		return resource.ERR_MAIL_SEND_FAILED
+	}
+	return resource.CODE_SUCCESS
+}
+
+// ResetPassword 使用邮件中的令牌重置密码，能收到邮件即证明拥有邮箱，同时标记邮箱已验证
+func (s *BaseService) ResetPassword(c *gin.Context, param dto.ResetPassword) resource.RspCode {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(param.Password), bcrypt.DefaultCost)
+	if err != nil {
+		logger.Error("hash password failed", zap.Error(err))
+		return resource.ERR_OPERATION_FAILED
+	}
+	userID, err := consumeAccountToken(tokenPurposeReset, param.Token)
+	if err != nil {
+		logger.Error("consume reset token failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if userID == 0 {
+		return resource.ERR_ACCOUNT_TOKEN_INVALID
+	}
+
+	u, err := dto.Client().User.UpdateOneID(userID).
+		SetPassword(string(hashedPassword)).
+		SetEmailVerified(true).
+		Save(c)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_ACCOUNT_TOKEN_INVALID
+		}
+		logger.Error("reset password failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	CreateAuditLog(c, nil, dto.AuditLogData{
+		UserID: u.ID,
+		Action: dto.ActionResetPassword,
+		Module: dto.ModuleAuth,
+		DetailInfo: map[string]interface{}{
+			"email":  u.Email,
+			"status": "success",
+		},
+	})
+	return resource.CODE_SUCCESS
+}
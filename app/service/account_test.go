@@ -0,0 +1,56 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/resource"
+)
+
+func TestAccountToken(t *testing.T) {
+	token := signAccountToken("secret", tokenPurposeVerify, "nonce")
+	nonce, ok := parseAccountToken("secret", tokenPurposeVerify, token)
+	if !ok || nonce != "nonce" {
+		t.Fatalf("parse = %q, %v", nonce, ok)
+	}
+
+	tests := []struct {
+		name    string
+		secret  string
+		purpose string
+		token   string
+	}{
+		// 验证令牌不能用于重置密码
+		{"purpose", "secret", tokenPurposeReset, token},
+		{"secret", "other", tokenPurposeVerify, token},
+		{"tampered", "secret", tokenPurposeVerify, "other" + token[strings.Index(token, "."):]},
+		{"no signature", "secret", tokenPurposeVerify, "nonce"},
+		{"empty nonce", "secret", tokenPurposeVerify, token[strings.Index(token, "."):]},
+		{"empty", "secret", tokenPurposeVerify, ""},
+	}
+	for _, tt := range tests {
+		if _, ok := parseAccountToken(tt.secret, tt.purpose, tt.token); ok {
+			t.Errorf("%s: token accepted", tt.name)
+		}
+	}
+}
+
+func TestAccountMail(t *testing.T) {
+	old := resource.Conf.AccountConfig
+	defer func() { resource.Conf.AccountConfig = old }()
+	resource.Conf.AccountConfig = &resource.AccountConfig{LinkBaseURL: "https://example.com/app/"}
+
+	msg := accountMail(tokenPurposeReset, "a@example.com", "tok", 30*time.Minute)
+	if len(msg.To) != 1 || msg.To[0] != "a@example.com" {
+		t.Errorf("to = %v", msg.To)
+	}
+	if !strings.Contains(msg.Body, "https://example.com/app/reset-password?token=tok") || !strings.Contains(msg.Body, "30") {
+		t.Errorf("reset body = %q", msg.Body)
+	}
+
+	msg = accountMail(tokenPurposeVerify, "a@example.com", "tok", 24*time.Hour)
+	if !strings.Contains(msg.Body, "https://example.com/app/verify-email?token=tok") || !strings.Contains(msg.Body, "1440") {
+		t.Errorf("verify body = %q", msg.Body)
+	}
+}
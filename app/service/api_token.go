@@ -0,0 +1,560 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/apitoken"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	// apiTokenIDLen 令牌前缀中随机部分的字节数，按前缀查找令牌
+	apiTokenIDLen = 4
+	// apiTokenSecretLen 令牌密钥部分的字节数
+	apiTokenSecretLen = 20
+	// apiTokenTouchInterval 最近使用时间的更新间隔，避免每个请求都写数据库
+	apiTokenTouchInterval = time.Minute
+	// serviceAccountDomain 服务账号的邮箱域名，不能接收邮件
+	serviceAccountDomain = "service.invalid"
+	// unusablePassword 服务账号和单点登录创建的用户没有密码，不是有效的bcrypt哈希，任何密码都无法登录
+	unusablePassword = "!"
+)
+
+// scopePermissions 授权范围对应的产品权限，没有对应范围的权限（产品修改、管理员管理、回收站等）不能通过API令牌使用
+var scopePermissions = map[string][]dto.Permission{
+	dto.ScopeProductsRead:  {dto.PermProductView},
+	dto.ScopeDevicesRead:   {dto.PermDeviceView},
+	dto.ScopeDevicesWrite:  {dto.PermDeviceView, dto.PermDeviceWrite, dto.PermDeviceDelete},
+	dto.ScopeSNAllocate:    {dto.PermSNAllocate},
+	dto.ScopeVersionsRead:  {dto.PermReleaseView},
+	dto.ScopeVersionsWrite: {dto.PermReleaseView, dto.PermReleaseWrite},
+	dto.ScopeAuditRead:     {dto.PermAuditView},
+}
+
+// grantHasPermission 令牌的授权范围是否包含权限
+func grantHasPermission(grant *auth.TokenGrant, perm dto.Permission) bool {
+	for _, scope := range grant.Scopes {
+		for _, p := range scopePermissions[scope] {
+			if p == perm {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// tokenPermits 只检查系统管理员的操作也需要令牌的授权范围包含对应权限，JWT认证的请求不受影响
+func tokenPermits(ctx context.Context, perm dto.Permission) bool {
+	grant := auth.GetTokenGrant(ctx)
+	return grant == nil || grantHasPermission(grant, perm)
+}
+
+// normalizeScopes 去重排序，包含未知的授权范围时返回false
+func normalizeScopes(scopes []string) ([]string, bool) {
+	seen := make(map[string]bool, len(scopes))
+	result := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		if _, ok := scopePermissions[s]; !ok {
+			return nil, false
+		}
+		if !seen[s] {
+			seen[s] = true
+			result = append(result, s)
+		}
+	}
+	sort.Strings(result)
+	return result, len(result) > 0
+}
+
+// newAPIToken 生成令牌：act_<前缀>_<密钥>，返回完整令牌和用于查找的前缀
+func newAPIToken() (token, prefix string, err error) {
+	buf := make([]byte, apiTokenIDLen+apiTokenSecretLen)
+	if _, err = rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	prefix = auth.APITokenPrefix + hex.EncodeToString(buf[:apiTokenIDLen])
+	return prefix + "_" + hex.EncodeToString(buf[apiTokenIDLen:]), prefix, nil
+}
+
+// parseAPIToken 取出令牌的前缀，格式不正确时返回false
+func parseAPIToken(token string) (string, bool) {
+	rest, ok := strings.CutPrefix(token, auth.APITokenPrefix)
+	if !ok {
+		return "", false
+	}
+	id, secret, ok := strings.Cut(rest, "_")
+	if !ok || len(id) != apiTokenIDLen*2 || len(secret) != apiTokenSecretLen*2 {
+		return "", false
+	}
+	return auth.APITokenPrefix + id, true
+}
+
+// hashAPIToken 令牌只保存哈希，数据库泄露时无法直接使用
+func hashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// apiTokenExpiresAt 有效期为空时不过期
+func apiTokenExpiresAt(now time.Time, days int) *time.Time {
+	if days <= 0 {
+		return nil
+	}
+	t := now.AddDate(0, 0, days)
+	return &t
+}
+
+// apiTokenStatus 令牌状态，依次判断撤销和过期
+func apiTokenStatus(t *ent.APIToken, now time.Time) string {
+	switch {
+	case t.RevokedAt != nil:
+		return dto.APITokenStatusRevoked
+	case t.ExpiresAt != nil && !now.Before(*t.ExpiresAt):
+		return dto.APITokenStatusExpired
+	default:
+		return dto.APITokenStatusActive
+	}
+}
+
+func toAPITokenInfo(t *ent.APIToken, now time.Time) dto.APITokenInfo {
+	info := dto.APITokenInfo{
+		ID:          t.ID,
+		Name:        t.Name,
+		Type:        t.Type.String(),
+		TokenPrefix: t.TokenPrefix,
+		UserID:      t.UserID,
+		Scopes:      t.Scopes,
+		ProductIDs:  t.ProductIds,
+		Status:      apiTokenStatus(t, now),
+		CreatedBy:   t.CreatedBy,
+		ExpiresAt:   t.ExpiresAt,
+		LastUsedAt:  t.LastUsedAt,
+		LastUsedIP:  t.LastUsedIP,
+		RevokedAt:   t.RevokedAt,
+		CreatedAt:   t.CreatedAt,
+	}
+	if u := t.Edges.User; u != nil {
+		info.UserEmail = u.Email
+	}
+	return info
+}
+
+// serviceTokenProduct 服务令牌所属的产品，创建时限制为该产品
+func serviceTokenProduct(t *ent.APIToken) int {
+	if t.Type != apitoken.TypeService || len(t.ProductIds) == 0 {
+		return 0
+	}
+	return t.ProductIds[0]
+}
+
+// canManageAPIToken 个人令牌由本人或系统管理员管理，服务令牌需要所属产品的管理员管理权限
+func canManageAPIToken(ctx context.Context, userID int, t *ent.APIToken) bool {
+	if t.Type == apitoken.TypePersonal {
+		return t.UserID == userID || isSystemAdmin(ctx, userID)
+	}
+	if productID := serviceTokenProduct(t); productID > 0 {
+		return authorize(ctx, userID, productID, dto.PermManagerManage)
+	}
+	return isSystemAdmin(ctx, userID)
+}
+
+// AuthenticateAPIToken 校验API令牌，返回其代表的用户和授权范围，并更新最近使用时间
+func AuthenticateAPIToken(ctx context.Context, token, ip string) (*auth.UserAuthInfo, *auth.TokenGrant, resource.RspCode) {
+	prefix, ok := parseAPIToken(token)
+	if !ok {
+		return nil, nil, resource.ERR_API_TOKEN_INVALID
+	}
+	t, err := dto.Client().APIToken.Query().
+		Where(apitoken.TokenPrefixEQ(prefix)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, resource.ERR_API_TOKEN_INVALID
+		}
+		logger.Error("query api token failed", zap.Error(err))
+		return nil, nil, resource.ERR_QUERY_FAILED
+	}
+	if subtle.ConstantTimeCompare([]byte(hashAPIToken(token)), []byte(t.TokenHash)) != 1 {
+		return nil, nil, resource.ERR_API_TOKEN_INVALID
+	}
+	now := time.Now()
+	if apiTokenStatus(t, now) != dto.APITokenStatusActive || t.Edges.User == nil || !t.Edges.User.IsEnabled {
+		return nil, nil, resource.ERR_API_TOKEN_INVALID
+	}
+
+	// 最近使用时间只用于展示，更新失败不影响请求
+	if t.LastUsedAt == nil || now.Sub(*t.LastUsedAt) >= apiTokenTouchInterval || t.LastUsedIP != ip {
+		err = dto.Client().APIToken.UpdateOneID(t.ID).
+			SetLastUsedAt(now).
+			SetLastUsedIP(ip).
+			Exec(ctx)
+		if err != nil {
+			logger.Warn("update api token last used failed", zap.Error(err), zap.Int("token_id", t.ID))
+		}
+	}
+
+	u := t.Edges.User
+	uai := &auth.UserAuthInfo{
+		UserID:      u.ID,
+		Email:       u.Email,
+		CreatedAt:   u.CreatedAt,
+		LastLoginAt: u.LastLoginAt,
+	}
+	return uai, &auth.TokenGrant{TokenID: t.ID, Scopes: t.Scopes, ProductIDs: t.ProductIds}, resource.CODE_SUCCESS
+}
+
+// APITokenService API令牌管理
+type APITokenService struct{}
+
+// NewAPITokenService 创建API令牌服务实例
+func NewAPITokenService() *APITokenService {
+	return &APITokenService{}
+}
+
+// CreatePersonalToken 创建代表当前用户的个人令牌，令牌只在返回结果中出现一次；
+// 限制产品时当前用户必须能访问这些产品
+func (s *APITokenService) CreatePersonalToken(c *gin.Context, userID int, param dto.CreatePersonalToken) (*dto.APITokenInfo, resource.RspCode) {
+	scopes, ok := normalizeScopes(param.Scopes)
+	if !ok {
+		return nil, resource.ERR_API_TOKEN_SCOPE
+	}
+	productIDs := make([]int, 0, len(param.ProductIDs))
+	seen := make(map[int]bool, len(param.ProductIDs))
+	for _, id := range param.ProductIDs {
+		if seen[id] {
+			continue
+		}
+		if !authorize(c, userID, id, dto.PermProductView) {
+			return nil, resource.ERR_NO_PERMISSION
+		}
+		seen[id] = true
+		productIDs = append(productIDs, id)
+	}
+
+	token, prefix, err := newAPIToken()
+	if err != nil {
+		logger.Error("generate api token failed", zap.Error(err))
+		return nil, resource.ERR_OPERATION_FAILED
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	now := time.Now()
+	t, err := tx.APIToken.Create().
+		SetName(param.Name).
+		SetType(apitoken.TypePersonal).
+		SetTokenPrefix(prefix).
+		SetTokenHash(hashAPIToken(token)).
+		SetUserID(userID).
+		SetCreatedBy(userID).
+		SetScopes(scopes).
+		SetProductIds(productIDs).
+		SetNillableExpiresAt(apiTokenExpiresAt(now, param.ExpireDays)).
+		Save(c)
+	if err != nil {
+		logger.Error("create api token failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionCreate,
+		Module:    dto.ModuleAuth,
+		ProductID: 0,
+		DetailInfo: map[string]interface{}{
+			"operation":    "create_api_token",
+			"token_id":     t.ID,
+			"token_prefix": t.TokenPrefix,
+			"type":         t.Type,
+			"scopes":       scopes,
+			"product_ids":  productIDs,
+			"expires_at":   t.ExpiresAt,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	info := toAPITokenInfo(t, now)
+	info.Token = token
+	return &info, resource.CODE_SUCCESS
+}
+
+// CreateServiceToken 为产品创建服务账号和服务令牌，服务账号以指定角色加入产品，令牌限制为该产品；
+// 令牌的权限同时受授权范围和服务账号角色限制
+func (s *APITokenService) CreateServiceToken(c *gin.Context, userID int, param dto.CreateServiceToken) (*dto.APITokenInfo, resource.RspCode) {
+	scopes, ok := normalizeScopes(param.Scopes)
+	if !ok {
+		return nil, resource.ERR_API_TOKEN_SCOPE
+	}
+	if !authorize(c, userID, param.ProductID, dto.PermManagerManage) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+	exist, err := dto.Client().Product.Query().Where(product.IDEQ(param.ProductID)).Exist(c)
+	if err != nil {
+		logger.Error("query product failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if !exist {
+		return nil, resource.ERR_PRODUCT_NOT_EXIST
+	}
+	role := managerInvitationRole(param.AccessRole, "")
+
+	token, prefix, err := newAPIToken()
+	if err != nil {
+		logger.Error("generate api token failed", zap.Error(err))
+		return nil, resource.ERR_OPERATION_FAILED
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	svc, err := tx.User.Create().
+		SetEmail(fmt.Sprintf("%s@%s", strings.ReplaceAll(prefix, "_", "-"), serviceAccountDomain)).
+		SetPassword(unusablePassword).
+		SetIsService(true).
+		SetEmailVerified(true).
+		Save(c)
+	if err != nil {
+		logger.Error("create service account failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	err = tx.ProductManager.Create().
+		SetUserID(svc.ID).
+		SetProductID(param.ProductID).
+		SetRole(productmanager.RoleAssistant).
+		SetAccessRole(role).
+		SetPermissions(legacyPermissions(role)).
+		SetRemark(param.Name).
+		Exec(c)
+	if err != nil {
+		logger.Error("add service account manager failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	now := time.Now()
+	t, err := tx.APIToken.Create().
+		SetName(param.Name).
+		SetType(apitoken.TypeService).
+		SetTokenPrefix(prefix).
+		SetTokenHash(hashAPIToken(token)).
+		SetUserID(svc.ID).
+		SetCreatedBy(userID).
+		SetScopes(scopes).
+		SetProductIds([]int{param.ProductID}).
+		SetNillableExpiresAt(apiTokenExpiresAt(now, param.ExpireDays)).
+		Save(c)
+	if err != nil {
+		logger.Error("create api token failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_FAILED
+	}
+	t.Edges.User = svc
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionCreate,
+		Module:    dto.ModuleAuth,
+		ProductID: param.ProductID,
+		DetailInfo: map[string]interface{}{
+			"operation":       "create_api_token",
+			"token_id":        t.ID,
+			"token_prefix":    t.TokenPrefix,
+			"type":            t.Type,
+			"service_user_id": svc.ID,
+			"access_role":     role,
+			"scopes":          scopes,
+			"expires_at":      t.ExpiresAt,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	info := toAPITokenInfo(t, now)
+	info.Token = token
+	return &info, resource.CODE_SUCCESS
+}
+
+// ListTokens API令牌列表，不指定产品时返回自己的个人令牌，指定产品时返回该产品的服务令牌
+func (s *APITokenService) ListTokens(c *gin.Context, userID int, query dto.APITokenQuery) ([]dto.APITokenInfo, resource.RspCode) {
+	q := dto.Client().APIToken.Query().
+		WithUser().
+		Order(ent.Desc(apitoken.FieldID))
+	if query.ProductID > 0 {
+		if !authorize(c, userID, query.ProductID, dto.PermManagerManage) {
+			return nil, resource.ERR_NO_PERMISSION
+		}
+		q.Where(apitoken.TypeEQ(apitoken.TypeService), func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(apitoken.FieldProductIds, query.ProductID))
+		})
+	} else {
+		q.Where(apitoken.TypeEQ(apitoken.TypePersonal), apitoken.UserIDEQ(userID))
+	}
+
+	now := time.Now()
+	switch query.Status {
+	case "":
+	case dto.APITokenStatusActive:
+		q.Where(apitoken.RevokedAtIsNil(), apitoken.Or(apitoken.ExpiresAtIsNil(), apitoken.ExpiresAtGT(now)))
+	case dto.APITokenStatusRevoked:
+		q.Where(apitoken.RevokedAtNotNil())
+	case dto.APITokenStatusExpired:
+		q.Where(apitoken.RevokedAtIsNil(), apitoken.ExpiresAtLTE(now))
+	}
+	tokens, err := q.All(c)
+	if err != nil {
+		logger.Error("query api tokens failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	result := make([]dto.APITokenInfo, 0, len(tokens))
+	for _, t := range tokens {
+		result = append(result, toAPITokenInfo(t, now))
+	}
+	return result, resource.CODE_SUCCESS
+}
+
+// RevokeToken 撤销API令牌，立即失效；服务令牌同时停用其服务账号并移出产品
+func (s *APITokenService) RevokeToken(c *gin.Context, userID, id int) resource.RspCode {
+	t, err := dto.Client().APIToken.Get(c, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_API_TOKEN_NOT_EXIST
+		}
+		logger.Error("query api token failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if !canManageAPIToken(c, userID, t) {
+		return resource.ERR_NO_PERMISSION
+	}
+	if t.RevokedAt != nil {
+		return resource.ERR_API_TOKEN_REVOKED
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	n, err := tx.APIToken.Update().
+		Where(apitoken.IDEQ(t.ID), apitoken.RevokedAtIsNil()).
+		SetRevokedBy(userID).
+		SetRevokedAt(time.Now()).
+		Save(c)
+	if err != nil {
+		logger.Error("revoke api token failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_MOD_FAILED
+	}
+	if n == 0 {
+		_ = tx.Rollback()
+		return resource.ERR_API_TOKEN_REVOKED
+	}
+
+	if t.Type == apitoken.TypeService {
+		_, err = tx.ProductManager.Delete().
+			Where(productmanager.UserIDEQ(t.UserID)).
+			Exec(c)
+		if err == nil {
+			err = tx.User.Update().
+				Where(user.IDEQ(t.UserID), user.IsService(true)).
+				SetIsEnabled(false).
+				Exec(c)
+		}
+		if err != nil {
+			logger.Error("disable service account failed", zap.Error(err))
+			_ = tx.Rollback()
+			return resource.ERR_MOD_FAILED
+		}
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionDelete,
+		Module:    dto.ModuleAuth,
+		ProductID: serviceTokenProduct(t),
+		DetailInfo: map[string]interface{}{
+			"operation":    "revoke_api_token",
+			"token_id":     t.ID,
+			"token_prefix": t.TokenPrefix,
+			"type":         t.Type,
+			"user_id":      t.UserID,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	return resource.CODE_SUCCESS
+}
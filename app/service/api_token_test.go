@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+)
+
+func TestAPIToken(t *testing.T) {
+	token, prefix, err := newAPIToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !auth.IsAPIToken(token) || !strings.HasPrefix(token, prefix+"_") {
+		t.Fatalf("token %q does not start with prefix %q", token, prefix)
+	}
+	got, ok := parseAPIToken(token)
+	if !ok || got != prefix {
+		t.Errorf("parseAPIToken = %q, %v, want %q", got, ok, prefix)
+	}
+	if hashAPIToken(token) == hashAPIToken(token+"x") || len(hashAPIToken(token)) != 64 {
+		t.Error("hashAPIToken is not a SHA-256 hex digest")
+	}
+
+	for _, bad := range []string{"", "act_", "act_abc_def", prefix, "jwt." + token, token[:len(token)-1]} {
+		if _, ok := parseAPIToken(bad); ok {
+			t.Errorf("parseAPIToken(%q) accepted a malformed token", bad)
+		}
+	}
+}
+
+func TestNormalizeScopes(t *testing.T) {
+	got, ok := normalizeScopes([]string{dto.ScopeVersionsRead, dto.ScopeDevicesWrite, dto.ScopeVersionsRead})
+	if !ok || !reflect.DeepEqual(got, []string{dto.ScopeDevicesWrite, dto.ScopeVersionsRead}) {
+		t.Errorf("normalizeScopes = %v, %v", got, ok)
+	}
+	if _, ok := normalizeScopes([]string{dto.ScopeDevicesRead, "products:delete"}); ok {
+		t.Error("unknown scope accepted")
+	}
+	if _, ok := normalizeScopes(nil); ok {
+		t.Error("empty scopes accepted")
+	}
+}
+
+func TestGrantPermissions(t *testing.T) {
+	grant := &auth.TokenGrant{Scopes: []string{dto.ScopeDevicesWrite, dto.ScopeVersionsRead}, ProductIDs: []int{3}}
+	for _, perm := range []dto.Permission{dto.PermDeviceView, dto.PermDeviceWrite, dto.PermDeviceDelete, dto.PermReleaseView} {
+		if !grantHasPermission(grant, perm) {
+			t.Errorf("grant should have %s", perm)
+		}
+	}
+	for _, perm := range []dto.Permission{dto.PermReleaseWrite, dto.PermProductEdit, dto.PermManagerManage, dto.PermRecycleManage} {
+		if grantHasPermission(grant, perm) {
+			t.Errorf("grant should not have %s", perm)
+		}
+	}
+	if !grant.AllowsProduct(3) || grant.AllowsProduct(4) {
+		t.Error("product restriction not applied")
+	}
+	if !(&auth.TokenGrant{}).AllowsProduct(4) {
+		t.Error("grant without product restriction should allow any product")
+	}
+}
+
+func TestAuthorizeTokenScope(t *testing.T) {
+	// 授权范围不包含权限时在查询角色之前拒绝，系统管理员的令牌也一样
+	grant := &auth.TokenGrant{Scopes: []string{dto.ScopeVersionsRead}}
+	ctx := context.WithValue(context.Background(), auth.TokenGrantContextKey, grant)
+	if auth.GetTokenGrant(ctx) != grant {
+		t.Fatal("grant not found in context")
+	}
+	if authorize(ctx, dto.SuperAdminID, 1, dto.PermDeviceWrite) {
+		t.Error("token without devices:write authorized device write")
+	}
+	ids, err := authorizedProductIDs(ctx, dto.SuperAdminID, dto.PermDeviceView)
+	if err != nil || ids == nil || len(ids) != 0 {
+		t.Errorf("authorizedProductIDs = %v, %v, want empty", ids, err)
+	}
+	ids, err = authorizedProductIDs(context.WithValue(context.Background(), auth.TokenGrantContextKey,
+		&auth.TokenGrant{Scopes: []string{dto.ScopeDevicesRead}, ProductIDs: []int{2, 5}}), dto.SuperAdminID, dto.PermDeviceView)
+	if err != nil || !reflect.DeepEqual(ids, []int{2, 5}) {
+		t.Errorf("authorizedProductIDs = %v, %v, want token products", ids, err)
+	}
+}
+
+// TestListDevicesTokenScope 不指定产品时设备列表同样受令牌授权范围限制
+func TestListDevicesTokenScope(t *testing.T) {
+	client := testClient(t)
+	ctx := systemCtx()
+	u := client.User.Create().SetID(dto.SuperAdminID + 490).SetEmail("token-list@example.com").SetPassword("x").SaveX(ctx)
+	p := client.Product.Create().SetCode("TKL1").SetProductName("令牌列表产品").SaveX(ctx)
+	client.ProductManager.Create().SetUserID(u.ID).SetProductID(p.ID).SetRole(productmanager.RoleMain).SaveX(ctx)
+	now := time.Now()
+	client.Device.Create().SetSn("TKL-SN-1").SetProductID(p.ID).SetCreatedAt(now).SetUpdatedAt(now).SaveX(ctx)
+
+	s := &DeviceService{}
+	list := func(grant *auth.TokenGrant, productID int) ([]dto.DeviceInfo, resource.RspCode) {
+		filter := dto.DeviceFilter{}
+		filter.ProductID = productID
+		result, code := s.ListDevices(userGinContext(u.ID, grant), u.ID, filter)
+		if code != resource.CODE_SUCCESS {
+			return nil, code
+		}
+		return result.List.([]dto.DeviceInfo), code
+	}
+
+	for _, scope := range []string{dto.ScopeVersionsRead, dto.ScopeAuditRead} {
+		grant := &auth.TokenGrant{Scopes: []string{scope}}
+		if devices, code := list(grant, 0); code != resource.CODE_SUCCESS || len(devices) != 0 {
+			t.Errorf("%s without product_id: %d devices, %v", scope, len(devices), code)
+		}
+		if _, code := list(grant, p.ID); code != resource.ERR_NO_PERMISSION {
+			t.Errorf("%s with product_id: %v", scope, code)
+		}
+	}
+	if devices, code := list(&auth.TokenGrant{Scopes: []string{dto.ScopeDevicesRead}}, 0); code != resource.CODE_SUCCESS || len(devices) != 1 {
+		t.Errorf("devices:read: %d devices, %v", len(devices), code)
+	}
+	if devices, code := list(nil, 0); code != resource.CODE_SUCCESS || len(devices) != 1 {
+		t.Errorf("jwt: %d devices, %v", len(devices), code)
+	}
+}
+
+func TestAPITokenStatus(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	tests := []struct {
+		token ent.APIToken
+		want  string
+	}{
+		{ent.APIToken{}, dto.APITokenStatusActive},
+		{ent.APIToken{ExpiresAt: &future}, dto.APITokenStatusActive},
+		{ent.APIToken{ExpiresAt: &now}, dto.APITokenStatusExpired},
+		{ent.APIToken{ExpiresAt: &past, RevokedAt: &past}, dto.APITokenStatusRevoked},
+	}
+	for i, tt := range tests {
+		if got := apiTokenStatus(&tt.token, now); got != tt.want {
+			t.Errorf("case %d: status = %s, want %s", i, got, tt.want)
+		}
+	}
+}
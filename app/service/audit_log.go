@@ -122,12 +122,20 @@ func CreateAuditLog(ctx context.Context, tx *ent.Tx, data dto.AuditLogData) erro
 //	return nil
 //}
 
-// ListLogs 查询审计日志列表
-func (s *AuditLogService) ListLogs(c *gin.Context, query dto.OperationLogQuery) (*dto.PageResult, resource.RspCode) {
+// ListLogs 查询审计日志列表，非系统管理员只能查看有审计权限的产品的日志
+func (s *AuditLogService) ListLogs(c *gin.Context, userID int, query dto.OperationLogQuery) (*dto.PageResult, resource.RspCode) {
+	productIDs, code := visibleProductIDs(c, userID, query.ProductID, dto.PermAuditView)
+	if code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+
 	// 构建查询
 	q := dto.Client().AuditLog.Query().
 		WithOperator().
 		WithProduct()
+	if productIDs != nil {
+		q = q.Where(auditlog.ProductIDIn(productIDs...))
+	}
 
 	// 添加时间范围过滤
 	if !query.StartTime.IsZero() {
@@ -157,32 +165,38 @@ func (s *AuditLogService) ListLogs(c *gin.Context, query dto.OperationLogQuery)
 		q = q.Where(auditlog.ProductIDEQ(query.ProductID))
 	}
 
+	// 分页参数处理
+	pg, err := newPaging(query.Page, query.PageSize, query.CursorParams)
+	if err != nil {
+		return nil, resource.ERR_INVALID_PARAMETER
+	}
+
 	// 计算总数
-	total, err := q.Count(c)
+	total, capped, err := pg.countTotal(c, q.Clone().Count, func(limit int) ([]int, error) {
+		return q.Clone().Limit(limit).IDs(c)
+	})
 	if err != nil {
 		logger.Error("count audit logs failed", zap.Error(err))
 		return nil, resource.ERR_QUERY_FAILED
 	}
 
-	// 分页参数处理
-	if query.Page < 1 {
-		query.Page = 1
-	}
-	if query.PageSize < 1 {
-		query.PageSize = 10
-	}
-
 	// 查询数据
-	logs, err := q.
-		Order(ent.Desc(auditlog.FieldCreatedAt)).
-		Limit(query.PageSize).
-		Offset((query.Page - 1) * query.PageSize).
-		All(c)
+	if err := pg.apply(q); err != nil {
+		logger.Error("apply audit log paging failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	logs, err := q.All(c)
 
 	if err != nil {
 		logger.Error("query audit logs failed", zap.Error(err))
 		return nil, resource.ERR_QUERY_FAILED
 	}
+	n, hasMore := pg.trim(len(logs))
+	logs = logs[:n]
+	var next string
+	if hasMore {
+		next = encodeCursor(logs[n-1].CreatedAt, logs[n-1].ID)
+	}
 
 	// 构造返回数据
 	var responses []dto.OperationLogResponse
@@ -211,12 +225,5 @@ func (s *AuditLogService) ListLogs(c *gin.Context, query dto.OperationLogQuery)
 		responses = append(responses, response)
 	}
 
-	result := &dto.PageResult{
-		Total:    int64(total),
-		Page:     query.Page,
-		PageSize: query.PageSize,
-		List:     responses,
-	}
-
-	return result, resource.CODE_SUCCESS
+	return pg.result(responses, total, capped, next), resource.CODE_SUCCESS
 }
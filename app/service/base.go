@@ -5,10 +5,13 @@ import (
 	"cambridge-hit.com/gin-base/activateserver/resource"
 	"time"
 
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
 	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
 	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
 	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -20,65 +23,148 @@ func NewBaseService() *BaseService {
 	return &BaseService{}
 }
 
+// UserRegisterByPassword 开放注册，仅邀请注册模式下必须使用邀请码
 func (s *BaseService) UserRegisterByPassword(c *gin.Context, param dto.UserLoginInfo) (e resource.RspCode) {
+	if registrationMode() == dto.RegistrationModeInvite {
+		registerFailed(c, param.Email, "invitation_required")
+		return resource.ERR_REGISTRATION_CLOSED
+	}
+	return s.register(c, param.Email, param.Password, "")
+}
+
+// RegisterByInvitation 使用邀请码注册，开放注册模式下同样可用，以便注册后加入邀请码指定的产品
+func (s *BaseService) RegisterByInvitation(c *gin.Context, param dto.RegisterByInvitation) (e resource.RspCode) {
+	return s.register(c, param.Email, param.Password, param.Code)
+}
+
+// registerFailed 记录注册失败的审计日志
+func registerFailed(c *gin.Context, email, reason string) {
+	CreateAuditLog(c, nil, dto.AuditLogData{
+		UserID:    dto.AnonymousID,
+		Action:    dto.ActionRegister,
+		Module:    dto.ModuleAuth,
+		ProductID: 0,
+		DetailInfo: map[string]interface{}{
+			"email":  email,
+			"status": "failed",
+			"reason": reason,
+		},
+	})
+}
+
+// register 创建用户，code不为空时在同一事务中使用邀请码
+func (s *BaseService) register(c *gin.Context, email, password, code string) (e resource.RspCode) {
 	// 检查用户是否已存在
 	exist, err := dto.Client().User.Query().
-		Where(user.EmailEQ(param.Email)).
+		Where(user.EmailEQ(email)).
 		Exist(c)
 	if err != nil {
 		logger.Error("查询用户失败")
 		return resource.ERR_QUERY_FAILED
 	}
 	if exist {
-		CreateAuditLog(c, nil, dto.AuditLogData{
-			UserID:    dto.AnonymousID,
-			Action:    dto.ActionRegister,
-			Module:    dto.ModuleAuth,
-			ProductID: 0,
-			DetailInfo: map[string]interface{}{
-				"email":  param.Email,
-				"status": "failed",
-				"reason": "user_exist",
-			},
-		})
+		registerFailed(c, email, "user_exist")
 		return resource.ERR_USER_EXIST
 	}
 
 	// 加密密码
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(param.Password), bcrypt.DefaultCost)
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		logger.Error("密码加密失败")
 		return resource.ERR_OPERATION_FAILED
 	}
 
-	// 创建用户
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_ADD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	var inv *ent.RegistrationInvitation
+	if code != "" {
+		var rc resource.RspCode
+		if inv, rc = useRegistrationInvitation(c, tx, code, email); rc != resource.CODE_SUCCESS {
+			_ = tx.Rollback()
+			registerFailed(c, email, "invalid_invitation")
+			return rc
+		}
+	}
+
+	// 创建用户，开启注册验证时邮箱验证后才能登录；邀请码发到绑定的邮箱，使用即证明拥有邮箱
 	now := time.Now()
-	_, err = dto.Client().User.Create().
-		SetEmail(param.Email).
+	verified := !requireEmailVerification() || (inv != nil && inv.Email != nil)
+	newUser, err := tx.User.Create().
+		SetEmail(email).
 		SetPassword(string(hashedPassword)).
+		SetEmailVerified(verified).
 		SetCreatedAt(now).
 		SetLastLoginAt(now).
 		Save(c)
 
 	if err != nil {
 		logger.Error("创建用户失败")
+		_ = tx.Rollback()
 		return resource.ERR_ADD_FAILED
 	}
-	CreateAuditLog(c, nil, dto.AuditLogData{
-		UserID:    dto.AnonymousID,
-		Action:    dto.ActionRegister,
-		Module:    dto.ModuleAuth,
-		ProductID: 0,
-		DetailInfo: map[string]interface{}{
-			"email":  param.Email,
-			"status": "success",
-		},
+
+	detail := map[string]interface{}{
+		"email":  email,
+		"status": "success",
+	}
+	if inv != nil {
+		detail["invitation_id"] = inv.ID
+		detail["code_prefix"] = inv.CodePrefix
+		detail["invited_by"] = inv.CreatedBy
+	}
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:     dto.AnonymousID,
+		Action:     dto.ActionRegister,
+		Module:     dto.ModuleAuth,
+		ProductID:  0,
+		DetailInfo: detail,
 	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 注册接口没有登录用户，以新用户的身份加入邀请码指定的产品
+	if inv != nil && inv.ProductID != nil {
+		c.Set(viewer.ContextKey, NewViewer(newUser.ID))
+		if rc := joinInvitedProduct(c, tx, inv, newUser.ID); rc != resource.CODE_SUCCESS {
+			_ = tx.Rollback()
+			return rc
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_ADD_FAILED
+	}
+
+	if !newUser.EmailVerified {
+		// 邀请在邮箱验证后生效，避免他人用该邮箱注册获得邀请
+		mailAllowed(tokenPurposeVerify, newUser.Email)
+		if err := sendAccountMail(c, tokenPurposeVerify, newUser); err != nil {
+			logger.Error("send verification email failed", zap.Error(err))
+		}
+		return resource.CODE_SUCCESS
+	}
+	// 发给该邮箱的副管理员邀请在注册后生效
+	completeInvitations(c, newUser)
 
 	return resource.CODE_SUCCESS
 }
 
-func (s *BaseService) UserLoginByPassword(c *gin.Context, param dto.UserLoginInfo) (at, rt string, uai auth.UserAuthInfo, e resource.RspCode) {
+// UserLoginByPassword 密码登录，开启两步验证或角色要求两步验证时返回临时令牌，由第二步完成登录
+func (s *BaseService) UserLoginByPassword(c *gin.Context, param dto.UserLoginInfo) (at, rt string, uai auth.UserAuthInfo, challenge *dto.TwoFactorChallenge, e resource.RspCode) {
 	// 查找用户
 	user_info, err := dto.Client().User.Query().
 		Where(user.EmailEQ(param.Email)).
@@ -117,6 +203,78 @@ func (s *BaseService) UserLoginByPassword(c *gin.Context, param dto.UserLoginInf
 		return
 	}
 
+	if user_info.IsService {
+		CreateAuditLog(c, nil, dto.AuditLogData{
+			UserID:    dto.AnonymousID,
+			Action:    dto.ActionLogin,
+			Module:    dto.ModuleAuth,
+			ProductID: 0,
+			DetailInfo: map[string]interface{}{
+				"email":  param.Email,
+				"status": "failed",
+				"reason": "service_account",
+			},
+		})
+		e = resource.ERR_SERVICE_ACCOUNT_LOGIN
+		return
+	}
+
+	if !user_info.EmailVerified && requireEmailVerification() {
+		CreateAuditLog(c, nil, dto.AuditLogData{
+			UserID:    dto.AnonymousID,
+			Action:    dto.ActionLogin,
+			Module:    dto.ModuleAuth,
+			ProductID: 0,
+			DetailInfo: map[string]interface{}{
+				"email":  param.Email,
+				"status": "failed",
+				"reason": "email_not_verified",
+			},
+		})
+		e = resource.ERR_EMAIL_NOT_VERIFIED
+		return
+	}
+
+	return s.finishLogin(c, user_info, "password")
+}
+
+// finishLogin 第一步验证通过后，需要两步验证时返回临时令牌，否则签发令牌
+func (s *BaseService) finishLogin(c *gin.Context, user_info *ent.User, method string) (at, rt string, uai auth.UserAuthInfo, challenge *dto.TwoFactorChallenge, e resource.RspCode) {
+	required, err := twoFactorRequired(c, user_info.ID)
+	if err != nil {
+		logger.Error("check two factor policy failed", zap.Error(err))
+		e = resource.ERR_QUERY_FAILED
+		return
+	}
+	if user_info.TotpEnabled || required {
+		challenge, err = issuePreAuth(user_info, required)
+		if err != nil {
+			logger.Error("issue pre-auth token failed", zap.Error(err))
+			e = resource.ERR_OPERATION_FAILED
+			return
+		}
+		CreateAuditLog(c, nil, dto.AuditLogData{
+			UserID:    dto.AnonymousID,
+			Action:    dto.ActionLogin,
+			Module:    dto.ModuleAuth,
+			ProductID: 0,
+			DetailInfo: map[string]interface{}{
+				"email":               user_info.Email,
+				"status":              "two_factor_pending",
+				"method":              method,
+				"enrollment_required": challenge.EnrollmentRequired,
+			},
+		})
+		e = resource.CODE_SUCCESS
+		return
+	}
+
+	at, rt, uai, e = s.completeLogin(c, user_info, method)
+	return
+}
+
+// completeLogin 签发令牌并记录登录，method为最后一步的验证方式
+func (s *BaseService) completeLogin(c *gin.Context, user_info *ent.User, method string) (at, rt string, uai auth.UserAuthInfo, e resource.RspCode) {
 	// 创建用户认证信息(在更新用户登录时间之前创建)
 	authInfo := auth.UserAuthInfo{
 		UserID:      user_info.ID,
@@ -126,7 +284,7 @@ func (s *BaseService) UserLoginByPassword(c *gin.Context, param dto.UserLoginInf
 	}
 
 	// 生成JWT token
-	at, rt, err = auth.GenAccessTokenAndRefreshToken(authInfo)
+	at, rt, err := auth.GenAccessTokenAndRefreshToken(authInfo)
 	if err != nil {
 		logger.Error("生成jwt失败")
 		e = resource.ERR_OPERATION_FAILED
@@ -146,6 +304,7 @@ func (s *BaseService) UserLoginByPassword(c *gin.Context, param dto.UserLoginInf
 		DetailInfo: map[string]interface{}{
 			"user_info": authInfo,
 			"status":    "success",
+			"method":    method,
 		},
 	})
 	return at, rt, authInfo, resource.CODE_SUCCESS
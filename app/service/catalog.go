@@ -0,0 +1,950 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicefeatureoverride"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+// 目录变更的记录类型
+const (
+	catalogKindProduct     = "product"
+	catalogKindFeature     = "feature"
+	catalogKindLicenseType = "license_type"
+	catalogKindManager     = "manager"
+)
+
+// CatalogService 产品目录导入导出服务
+type CatalogService struct{}
+
+// NewCatalogService 创建产品目录服务实例
+func NewCatalogService() *CatalogService {
+	return &CatalogService{}
+}
+
+// catalogState 数据库中产品的当前定义
+type catalogState struct {
+	product      *ent.Product                   // 为空表示新产品
+	features     map[string]*ent.ProductFeature // 按功能编码
+	licenseTypes map[string]*ent.LicenseType    // 按许可证编码，预加载功能
+	managers     map[string]*ent.ProductManager // 按邮箱，预加载用户
+	users        map[string]int                 // 文档中管理员邮箱对应的用户ID
+	nameTaken    bool                           // 产品名称已被其它产品使用
+	typesInUse   map[int]bool                   // 被设备、订单或批次使用的许可证类型
+	featuresUsed map[int]bool                   // 设备通过许可证类型或附加项启用的功能
+}
+
+// catalogApply 执行变更时的上下文
+type catalogApply struct {
+	productID      int
+	featureIDs     map[string]int // 功能编码对应的ID，包含本次新建的功能
+	licenseTypeIDs map[string]int // 许可证编码对应的ID，包含本次新建的许可证类型
+}
+
+// catalogOp 一项变更及其执行方法
+type catalogOp struct {
+	change dto.CatalogChange
+	apply  func(ctx context.Context, tx *ent.Tx, a *catalogApply) error
+}
+
+// ParseCatalog 解析YAML格式的产品目录，不允许未知字段
+func ParseCatalog(data []byte) (*dto.CatalogDocument, error) {
+	var doc dto.CatalogDocument
+	dec := yaml.NewDecoder(strings.NewReader(string(data)))
+	dec.KnownFields(true)
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// validateCatalog 检查文档本身的一致性，不访问数据库
+func validateCatalog(doc *dto.CatalogDocument) []string {
+	var errs []string
+	if doc.Version != dto.CatalogVersion {
+		errs = append(errs, fmt.Sprintf("unsupported version %d", doc.Version))
+	}
+	codes := make(map[string]bool)
+	names := make(map[string]bool)
+	for _, p := range doc.Products {
+		prefix := "product " + p.Code
+		if p.Code == "" || p.ProductName == "" {
+			errs = append(errs, "product code and product_name are required")
+			continue
+		}
+		if codes[p.Code] {
+			errs = append(errs, prefix+": duplicate product code")
+		}
+		codes[p.Code] = true
+		if names[p.ProductName] {
+			errs = append(errs, prefix+": duplicate product_name "+p.ProductName)
+		}
+		names[p.ProductName] = true
+		if p.WarrantyMonths != nil && (*p.WarrantyMonths < 0 || *p.WarrantyMonths > 240) {
+			errs = append(errs, prefix+": warranty_months must be between 0 and 240")
+		}
+
+		features := make(map[string]bool)
+		for _, f := range p.Features {
+			if f.FeatureCode == "" || f.FeatureName == "" {
+				errs = append(errs, prefix+": feature_code and feature_name are required")
+				continue
+			}
+			if features[f.FeatureCode] {
+				errs = append(errs, prefix+": duplicate feature "+f.FeatureCode)
+			}
+			features[f.FeatureCode] = true
+		}
+
+		licenseTypes := make(map[string]bool)
+		for _, lt := range p.LicenseTypes {
+			if lt.LicenseType == "" || lt.TypeName == "" {
+				errs = append(errs, prefix+": license_type and type_name are required")
+				continue
+			}
+			if licenseTypes[lt.LicenseType] {
+				errs = append(errs, prefix+": duplicate license type "+lt.LicenseType)
+			}
+			licenseTypes[lt.LicenseType] = true
+			for _, code := range lt.Features {
+				if !features[code] {
+					errs = append(errs, fmt.Sprintf("%s: license type %s references unknown feature %s", prefix, lt.LicenseType, code))
+				}
+			}
+		}
+		parents := make(map[string]string)
+		for _, lt := range p.LicenseTypes {
+			if lt.Parent == "" {
+				continue
+			}
+			if !licenseTypes[lt.Parent] {
+				errs = append(errs, fmt.Sprintf("%s: license type %s has unknown parent %s", prefix, lt.LicenseType, lt.Parent))
+				continue
+			}
+			parents[lt.LicenseType] = lt.Parent
+		}
+		for _, lt := range p.LicenseTypes {
+			// 沿父类型向上，步数超过类型数量说明存在环
+			code, steps := lt.LicenseType, 0
+			for parents[code] != "" && steps <= len(parents) {
+				code = parents[code]
+				steps++
+			}
+			if steps > len(parents) {
+				errs = append(errs, fmt.Sprintf("%s: license type %s has a cyclic parent chain", prefix, lt.LicenseType))
+			}
+		}
+
+		emails := make(map[string]bool)
+		mains := 0
+		for _, m := range p.Managers {
+			if emails[m.Email] {
+				errs = append(errs, prefix+": duplicate manager "+m.Email)
+			}
+			emails[m.Email] = true
+			switch productmanager.Role(m.Role) {
+			case productmanager.RoleMain:
+				mains++
+			case productmanager.RoleAssistant:
+			default:
+				errs = append(errs, fmt.Sprintf("%s: manager %s has invalid role %q", prefix, m.Email, m.Role))
+			}
+			if m.Permissions != "" && productmanager.PermissionsValidator(productmanager.Permissions(m.Permissions)) != nil {
+				errs = append(errs, fmt.Sprintf("%s: manager %s has invalid permissions %q", prefix, m.Email, m.Permissions))
+			}
+			if m.AccessRole != "" && productmanager.AccessRoleValidator(productmanager.AccessRole(m.AccessRole)) != nil {
+				errs = append(errs, fmt.Sprintf("%s: manager %s has invalid access role %q", prefix, m.Email, m.AccessRole))
+			}
+		}
+		if mains > 1 {
+			errs = append(errs, prefix+": only one main manager is allowed")
+		}
+	}
+	return errs
+}
+
+// loadCatalogState 读取产品的当前定义
+func loadCatalogState(ctx context.Context, client *ent.Client, want dto.CatalogProduct) (*catalogState, error) {
+	st := &catalogState{
+		features:     make(map[string]*ent.ProductFeature),
+		licenseTypes: make(map[string]*ent.LicenseType),
+		managers:     make(map[string]*ent.ProductManager),
+		users:        make(map[string]int),
+	}
+
+	p, err := client.Product.Query().Where(product.CodeEQ(want.Code)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	st.product = p
+
+	nameQuery := client.Product.Query().Where(product.ProductNameEQ(want.ProductName))
+	if p != nil {
+		nameQuery = nameQuery.Where(product.IDNEQ(p.ID))
+	}
+	if st.nameTaken, err = nameQuery.Exist(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(want.Managers) > 0 {
+		emails := make([]string, 0, len(want.Managers))
+		for _, m := range want.Managers {
+			emails = append(emails, m.Email)
+		}
+		users, err := client.User.Query().Where(user.EmailIn(emails...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			st.users[u.Email] = u.ID
+		}
+	}
+
+	if p == nil {
+		return st, nil
+	}
+
+	features, err := client.ProductFeature.Query().Where(productfeature.ProductIDEQ(p.ID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range features {
+		st.features[f.FeatureCode] = f
+	}
+	licenseTypes, err := client.LicenseType.Query().Where(licensetype.ProductIDEQ(p.ID)).WithFeatures().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, lt := range licenseTypes {
+		st.licenseTypes[lt.LicenseType] = lt
+	}
+	if st.typesInUse, st.featuresUsed, err = catalogUsage(ctx, client, p.ID, licenseTypes); err != nil {
+		return nil, err
+	}
+	managers, err := client.ProductManager.Query().Where(productmanager.ProductIDEQ(p.ID)).WithUser().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range managers {
+		if m.Edges.User != nil {
+			st.managers[m.Edges.User.Email] = m
+		}
+	}
+	return st, nil
+}
+
+// catalogUsage 查询产品中仍在使用的许可证类型和功能，目录不能删除它们，否则已出货的设备会失去功能
+func catalogUsage(ctx context.Context, client *ent.Client, productID int, licenseTypes []*ent.LicenseType) (map[int]bool, map[int]bool, error) {
+	typesInUse := make(map[int]bool)
+	featuresUsed := make(map[int]bool)
+	tree := newLicenseTypeTree(licenseTypes)
+	for _, lt := range licenseTypes {
+		inUse, err := licenseTypeInUse(ctx, client, lt.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !inUse {
+			continue
+		}
+		typesInUse[lt.ID] = true
+		for _, f := range tree.effectiveFeatures(lt.ID) {
+			featuresUsed[f.ID] = true
+		}
+	}
+	// 预加载设备时排除回收站中的设备
+	addOns, err := client.DeviceFeatureOverride.Query().
+		Where(
+			devicefeatureoverride.EffectEQ(devicefeatureoverride.EffectGrant),
+			devicefeatureoverride.HasFeatureWith(productfeature.ProductIDEQ(productID)),
+		).
+		WithDevice().
+		All(viewer.SystemContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, o := range addOns {
+		if o.Edges.Device != nil {
+			featuresUsed[o.FeatureID] = true
+		}
+	}
+	return typesInUse, featuresUsed, nil
+}
+
+// diffCatalogProduct 比较文档与数据库中的产品定义，生成按执行顺序排列的变更；
+// 删除的功能和许可证类型移入回收站，仍在使用的记为错误
+func diffCatalogProduct(want dto.CatalogProduct, st *catalogState, userID int) ([]catalogOp, []string) {
+	var ops []catalogOp
+	var errs []string
+	prefix := "product " + want.Code
+	change := func(action, kind, key string, fields ...string) dto.CatalogChange {
+		return dto.CatalogChange{Action: action, Kind: kind, Product: want.Code, Key: key, Fields: fields}
+	}
+
+	if st.nameTaken {
+		errs = append(errs, prefix+": product_name "+want.ProductName+" is used by another product")
+	}
+
+	// 产品
+	if st.product == nil {
+		ops = append(ops, catalogOp{
+			change: change(dto.CatalogCreate, catalogKindProduct, want.Code),
+			apply: func(ctx context.Context, tx *ent.Tx, a *catalogApply) error {
+				create := tx.Product.Create().
+					SetCode(want.Code).
+					SetProductName(want.ProductName).
+					SetDeviceAttributeSchema(want.DeviceAttributeSchema)
+				if want.ProductType != "" {
+					create.SetProductType(want.ProductType)
+				}
+				if want.WarrantyMonths != nil {
+					create.SetWarrantyMonths(*want.WarrantyMonths)
+				}
+				p, err := create.Save(ctx)
+				if err != nil {
+					return err
+				}
+				a.productID = p.ID
+				if err := grantViewerProduct(ctx, p.ID); err != nil {
+					return err
+				}
+				// 文档未管理管理员时，与新增产品一样由操作人作为主管理员
+				if want.Managers != nil {
+					return nil
+				}
+				return tx.ProductManager.Create().
+					SetUserID(userID).
+					SetProductID(p.ID).
+					SetRole(productmanager.RoleMain).
+					SetPermissions(productmanager.PermissionsFull).
+					Exec(ctx)
+			},
+		})
+	} else {
+		var fields []string
+		if st.product.ProductName != want.ProductName {
+			fields = append(fields, "product_name")
+		}
+		if want.ProductType != "" && st.product.ProductType != want.ProductType {
+			fields = append(fields, "product_type")
+		}
+		if want.WarrantyMonths != nil && st.product.WarrantyMonths != *want.WarrantyMonths {
+			fields = append(fields, "warranty_months")
+		}
+		if st.product.DeviceAttributeSchema != want.DeviceAttributeSchema {
+			fields = append(fields, "device_attribute_schema")
+		}
+		if len(fields) > 0 {
+			ops = append(ops, catalogOp{
+				change: change(dto.CatalogUpdate, catalogKindProduct, want.Code, fields...),
+				apply: func(ctx context.Context, tx *ent.Tx, a *catalogApply) error {
+					update := tx.Product.UpdateOneID(a.productID).
+						SetProductName(want.ProductName).
+						SetDeviceAttributeSchema(want.DeviceAttributeSchema)
+					if want.ProductType != "" {
+						update.SetProductType(want.ProductType)
+					}
+					if want.WarrantyMonths != nil {
+						update.SetWarrantyMonths(*want.WarrantyMonths)
+					}
+					return update.Exec(ctx)
+				},
+			})
+		}
+	}
+
+	// 功能
+	wantFeatures := make(map[string]bool, len(want.Features))
+	for _, f := range want.Features {
+		f := f
+		wantFeatures[f.FeatureCode] = true
+		cur, ok := st.features[f.FeatureCode]
+		switch {
+		case !ok:
+			ops = append(ops, catalogOp{
+				change: change(dto.CatalogCreate, catalogKindFeature, f.FeatureCode),
+				apply: func(ctx context.Context, tx *ent.Tx, a *catalogApply) error {
+					created, err := tx.ProductFeature.Create().
+						SetProductID(a.productID).
+						SetFeatureCode(f.FeatureCode).
+						SetFeatureName(f.FeatureName).
+						Save(ctx)
+					if err != nil {
+						return err
+					}
+					a.featureIDs[f.FeatureCode] = created.ID
+					return nil
+				},
+			})
+		case cur.FeatureName != f.FeatureName:
+			id := cur.ID
+			ops = append(ops, catalogOp{
+				change: change(dto.CatalogUpdate, catalogKindFeature, f.FeatureCode, "feature_name"),
+				apply: func(ctx context.Context, tx *ent.Tx, a *catalogApply) error {
+					return tx.ProductFeature.UpdateOneID(id).SetFeatureName(f.FeatureName).Exec(ctx)
+				},
+			})
+		}
+	}
+
+	// 许可证类型及其功能，父类型先于子类型处理
+	ltCodes := make(map[int]string, len(st.licenseTypes))
+	for code, lt := range st.licenseTypes {
+		ltCodes[lt.ID] = code
+	}
+	wantLicenseTypes := make(map[string]bool, len(want.LicenseTypes))
+	for _, lt := range parentsFirst(want.LicenseTypes) {
+		lt := lt
+		wantLicenseTypes[lt.LicenseType] = true
+		cur, ok := st.licenseTypes[lt.LicenseType]
+		if !ok {
+			ops = append(ops, catalogOp{
+				change: change(dto.CatalogCreate, catalogKindLicenseType, lt.LicenseType),
+				apply: func(ctx context.Context, tx *ent.Tx, a *catalogApply) error {
+					create := tx.LicenseType.Create().
+						SetProductID(a.productID).
+						SetLicenseType(lt.LicenseType).
+						SetTypeName(lt.TypeName).
+						AddFeatureIDs(a.lookupFeatures(lt.Features)...)
+					if id, ok := a.licenseTypeIDs[lt.Parent]; ok {
+						create.SetParentID(id)
+					}
+					created, err := create.Save(ctx)
+					if err != nil {
+						return err
+					}
+					a.licenseTypeIDs[lt.LicenseType] = created.ID
+					return nil
+				},
+			})
+			continue
+		}
+
+		var fields []string
+		if cur.TypeName != lt.TypeName {
+			fields = append(fields, "type_name")
+		}
+		curParent := ""
+		if cur.ParentID != nil {
+			curParent = ltCodes[*cur.ParentID]
+		}
+		parentChanged := curParent != lt.Parent
+		if parentChanged {
+			fields = append(fields, "parent")
+		}
+		curCodes := make([]string, 0, len(cur.Edges.Features))
+		for _, f := range cur.Edges.Features {
+			curCodes = append(curCodes, f.FeatureCode)
+		}
+		featuresChanged := !sameCodes(curCodes, lt.Features)
+		if featuresChanged {
+			fields = append(fields, "features")
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		id := cur.ID
+		ops = append(ops, catalogOp{
+			change: change(dto.CatalogUpdate, catalogKindLicenseType, lt.LicenseType, fields...),
+			apply: func(ctx context.Context, tx *ent.Tx, a *catalogApply) error {
+				update := tx.LicenseType.UpdateOneID(id).SetTypeName(lt.TypeName)
+				if featuresChanged {
+					update.ClearFeatures().AddFeatureIDs(a.lookupFeatures(lt.Features)...)
+				}
+				if parentChanged {
+					if parentID, ok := a.licenseTypeIDs[lt.Parent]; ok {
+						update.SetParentID(parentID)
+					} else {
+						update.ClearParentID()
+					}
+				}
+				return update.Exec(ctx)
+			},
+		})
+	}
+
+	// 文档中不存在的许可证类型和功能移入回收站
+	for _, code := range sortedKeys(st.licenseTypes) {
+		if wantLicenseTypes[code] {
+			continue
+		}
+		id := st.licenseTypes[code].ID
+		if st.typesInUse[id] {
+			errs = append(errs, prefix+": license type "+code+" is used by devices, orders or lots and cannot be deleted")
+			continue
+		}
+		ops = append(ops, catalogOp{
+			change: change(dto.CatalogDelete, catalogKindLicenseType, code),
+			apply: func(ctx context.Context, tx *ent.Tx, a *catalogApply) error {
+				return tx.LicenseType.UpdateOneID(id).SetDeletedAt(time.Now()).Exec(ctx)
+			},
+		})
+	}
+	for _, code := range sortedKeys(st.features) {
+		if wantFeatures[code] {
+			continue
+		}
+		id := st.features[code].ID
+		if st.featuresUsed[id] {
+			errs = append(errs, prefix+": feature "+code+" is enabled on devices and cannot be deleted")
+			continue
+		}
+		ops = append(ops, catalogOp{
+			change: change(dto.CatalogDelete, catalogKindFeature, code),
+			apply: func(ctx context.Context, tx *ent.Tx, a *catalogApply) error {
+				return tx.ProductFeature.UpdateOneID(id).SetDeletedAt(time.Now()).Exec(ctx)
+			},
+		})
+	}
+
+	// 管理员，文档未写managers时不处理
+	if want.Managers == nil {
+		return ops, errs
+	}
+	wantManagers := make(map[string]bool, len(want.Managers))
+	for _, m := range want.Managers {
+		m := m
+		wantManagers[m.Email] = true
+		managerID, ok := st.users[m.Email]
+		if !ok {
+			errs = append(errs, prefix+": unknown user "+m.Email)
+			continue
+		}
+		permissions := productmanager.Permissions(m.Permissions)
+		if permissions == "" {
+			permissions = productmanager.PermissionsRead
+		}
+		var accessRole *productmanager.AccessRole
+		if m.AccessRole != "" && productmanager.Role(m.Role) != productmanager.RoleMain {
+			r := productmanager.AccessRole(m.AccessRole)
+			accessRole = &r
+			permissions = legacyPermissions(r)
+		}
+		cur, ok := st.managers[m.Email]
+		if !ok {
+			ops = append(ops, catalogOp{
+				change: change(dto.CatalogCreate, catalogKindManager, m.Email),
+				apply: func(ctx context.Context, tx *ent.Tx, a *catalogApply) error {
+					return tx.ProductManager.Create().
+						SetProductID(a.productID).
+						SetUserID(managerID).
+						SetRole(productmanager.Role(m.Role)).
+						SetPermissions(permissions).
+						SetNillableAccessRole(accessRole).
+						Exec(ctx)
+				},
+			})
+			continue
+		}
+		// 主管理员只能通过移交变更
+		if (cur.Role == productmanager.RoleMain) != (productmanager.Role(m.Role) == productmanager.RoleMain) {
+			errs = append(errs, prefix+": main manager cannot be changed by catalog import")
+			continue
+		}
+		var fields []string
+		if cur.Permissions != permissions {
+			fields = append(fields, "permissions")
+		}
+		if accessRoleString(cur.AccessRole) != accessRoleString(accessRole) {
+			fields = append(fields, "access_role")
+		}
+		if len(fields) > 0 {
+			id := cur.ID
+			ops = append(ops, catalogOp{
+				change: change(dto.CatalogUpdate, catalogKindManager, m.Email, fields...),
+				apply: func(ctx context.Context, tx *ent.Tx, a *catalogApply) error {
+					update := tx.ProductManager.UpdateOneID(id).SetPermissions(permissions)
+					if accessRole != nil {
+						update.SetAccessRole(*accessRole)
+					} else {
+						update.ClearAccessRole()
+					}
+					return update.Exec(ctx)
+				},
+			})
+		}
+	}
+	for _, email := range sortedKeys(st.managers) {
+		if wantManagers[email] {
+			continue
+		}
+		cur := st.managers[email]
+		if cur.Role == productmanager.RoleMain {
+			errs = append(errs, prefix+": main manager cannot be removed by catalog import")
+			continue
+		}
+		id := cur.ID
+		ops = append(ops, catalogOp{
+			change: change(dto.CatalogDelete, catalogKindManager, email),
+			apply: func(ctx context.Context, tx *ent.Tx, a *catalogApply) error {
+				return tx.ProductManager.DeleteOneID(id).Exec(ctx)
+			},
+		})
+	}
+
+	return ops, errs
+}
+
+// parentsFirst 按继承关系排序许可证类型，父类型在前，其余保持文档中的顺序
+func parentsFirst(lts []dto.CatalogLicenseType) []dto.CatalogLicenseType {
+	inDoc := make(map[string]bool, len(lts))
+	for _, lt := range lts {
+		inDoc[lt.LicenseType] = true
+	}
+	sorted := make([]dto.CatalogLicenseType, 0, len(lts))
+	done := make(map[string]bool, len(lts))
+	for len(sorted) < len(lts) {
+		progress := false
+		for _, lt := range lts {
+			if done[lt.LicenseType] || (lt.Parent != "" && inDoc[lt.Parent] && !done[lt.Parent]) {
+				continue
+			}
+			done[lt.LicenseType] = true
+			sorted = append(sorted, lt)
+			progress = true
+		}
+		// 存在环时（已由validateCatalog报告）其余类型按原顺序
+		if !progress {
+			for _, lt := range lts {
+				if !done[lt.LicenseType] {
+					done[lt.LicenseType] = true
+					sorted = append(sorted, lt)
+				}
+			}
+		}
+	}
+	return sorted
+}
+
+// lookupFeatures 功能编码转换为功能ID
+func (a *catalogApply) lookupFeatures(codes []string) []int {
+	ids := make([]int, 0, len(codes))
+	for _, code := range codes {
+		if id, ok := a.featureIDs[code]; ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// sameCodes 比较两组编码是否相同，忽略顺序
+func sameCodes(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// PlanCatalog 计算导入目录需要的变更，不修改数据库
+func PlanCatalog(ctx context.Context, doc *dto.CatalogDocument) (*dto.CatalogPlan, error) {
+	plan := &dto.CatalogPlan{Changes: []dto.CatalogChange{}, Errors: validateCatalog(doc)}
+	if len(plan.Errors) > 0 {
+		return plan, nil
+	}
+	for _, want := range doc.Products {
+		st, err := loadCatalogState(ctx, dto.Client(), want)
+		if err != nil {
+			return nil, err
+		}
+		ops, errs := diffCatalogProduct(want, st, dto.SuperAdminID)
+		for _, op := range ops {
+			plan.Changes = append(plan.Changes, op.change)
+		}
+		plan.Errors = append(plan.Errors, errs...)
+	}
+	return plan, nil
+}
+
+// ApplyCatalog 在一个事务中执行导入，计划有错误时不修改数据库；每个有变更的产品记录一条审计日志
+func ApplyCatalog(ctx context.Context, doc *dto.CatalogDocument, userID int) (*dto.CatalogPlan, error) {
+	plan := &dto.CatalogPlan{Changes: []dto.CatalogChange{}, Errors: validateCatalog(doc)}
+	if len(plan.Errors) > 0 {
+		return plan, nil
+	}
+
+	tx, err := dto.Client().Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	// 先计算全部产品的变更，有错误时整体放弃
+	type productOps struct {
+		want dto.CatalogProduct
+		st   *catalogState
+		ops  []catalogOp
+	}
+	all := make([]productOps, 0, len(doc.Products))
+	for _, want := range doc.Products {
+		st, err := loadCatalogState(ctx, tx.Client(), want)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		ops, errs := diffCatalogProduct(want, st, userID)
+		plan.Errors = append(plan.Errors, errs...)
+		all = append(all, productOps{want: want, st: st, ops: ops})
+	}
+	if len(plan.Errors) > 0 {
+		_ = tx.Rollback()
+		return plan, nil
+	}
+
+	for _, p := range all {
+		if len(p.ops) == 0 {
+			continue
+		}
+		a := &catalogApply{
+			featureIDs:     make(map[string]int, len(p.st.features)),
+			licenseTypeIDs: make(map[string]int, len(p.st.licenseTypes)),
+		}
+		if p.st.product != nil {
+			a.productID = p.st.product.ID
+		}
+		for code, f := range p.st.features {
+			a.featureIDs[code] = f.ID
+		}
+		for code, lt := range p.st.licenseTypes {
+			a.licenseTypeIDs[code] = lt.ID
+		}
+		changes := make([]dto.CatalogChange, 0, len(p.ops))
+		for _, op := range p.ops {
+			if err := op.apply(ctx, tx, a); err != nil {
+				_ = tx.Rollback()
+				return nil, fmt.Errorf("%s %s %s: %w", op.change.Action, op.change.Kind, op.change.Key, err)
+			}
+			changes = append(changes, op.change)
+		}
+
+		action := dto.ActionUpdate
+		if p.st.product == nil {
+			action = dto.ActionCreate
+		}
+		err = CreateAuditLog(ctx, tx, dto.AuditLogData{
+			UserID:    userID,
+			Action:    action,
+			Module:    dto.ModuleProduct,
+			ProductID: a.productID,
+			DetailInfo: map[string]interface{}{
+				"operation": "catalog_import",
+				"code":      p.want.Code,
+				"changes":   changes,
+			},
+		})
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, changes...)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	for _, p := range all {
+		if p.st.product != nil {
+			InvalidateProductSummary(p.st.product.ID)
+		}
+	}
+	return plan, nil
+}
+
+// BuildCatalog 导出产品的定义，记录按编码排序，便于比较版本差异
+func BuildCatalog(ctx context.Context, products []*ent.Product) (*dto.CatalogDocument, error) {
+	doc := &dto.CatalogDocument{Version: dto.CatalogVersion, Products: make([]dto.CatalogProduct, 0, len(products))}
+	for _, p := range products {
+		st, err := loadCatalogState(ctx, dto.Client(), dto.CatalogProduct{Code: p.Code, ProductName: p.ProductName})
+		if err != nil {
+			return nil, err
+		}
+		warranty := p.WarrantyMonths
+		cp := dto.CatalogProduct{
+			Code:                  p.Code,
+			ProductName:           p.ProductName,
+			ProductType:           p.ProductType,
+			WarrantyMonths:        &warranty,
+			DeviceAttributeSchema: p.DeviceAttributeSchema,
+			Features:              []dto.CatalogFeature{},
+			LicenseTypes:          []dto.CatalogLicenseType{},
+			Managers:              []dto.CatalogManager{},
+		}
+		for _, code := range sortedKeys(st.features) {
+			cp.Features = append(cp.Features, dto.CatalogFeature{FeatureCode: code, FeatureName: st.features[code].FeatureName})
+		}
+		ltCodes := make(map[int]string, len(st.licenseTypes))
+		for code, lt := range st.licenseTypes {
+			ltCodes[lt.ID] = code
+		}
+		for _, code := range sortedKeys(st.licenseTypes) {
+			lt := st.licenseTypes[code]
+			codes := make([]string, 0, len(lt.Edges.Features))
+			for _, f := range lt.Edges.Features {
+				codes = append(codes, f.FeatureCode)
+			}
+			sort.Strings(codes)
+			parent := ""
+			if lt.ParentID != nil {
+				parent = ltCodes[*lt.ParentID]
+			}
+			cp.LicenseTypes = append(cp.LicenseTypes, dto.CatalogLicenseType{LicenseType: code, TypeName: lt.TypeName, Parent: parent, Features: codes})
+		}
+		for _, email := range sortedKeys(st.managers) {
+			m := st.managers[email]
+			cp.Managers = append(cp.Managers, dto.CatalogManager{
+				Email:       email,
+				Role:        m.Role.String(),
+				Permissions: m.Permissions.String(),
+				AccessRole:  accessRoleString(m.AccessRole),
+			})
+		}
+		doc.Products = append(doc.Products, cp)
+	}
+	return doc, nil
+}
+
+// MarshalCatalog 产品目录转换为YAML
+func MarshalCatalog(doc *dto.CatalogDocument) ([]byte, error) {
+	var buf strings.Builder
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return []byte(buf.String()), nil
+}
+
+// checkCatalogWritePermission 导入目录需要对文档中已有产品的修改权限，管理员列表需要管理员管理权限，
+// 新产品与新增产品一样不限制
+func checkCatalogWritePermission(c *gin.Context, userID int, doc *dto.CatalogDocument) resource.RspCode {
+	for _, want := range doc.Products {
+		p, err := dto.Client().Product.Query().Where(product.CodeEQ(want.Code)).Only(c)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			logger.Error("query product failed", zap.Error(err))
+			return resource.ERR_QUERY_FAILED
+		}
+		if !authorize(c, userID, p.ID, dto.PermProductEdit) {
+			return resource.ERR_NO_PERMISSION
+		}
+		if want.Managers != nil && !authorize(c, userID, p.ID, dto.PermManagerManage) {
+			return resource.ERR_NO_PERMISSION
+		}
+	}
+	return resource.CODE_SUCCESS
+}
+
+// ExportCatalog 导出产品目录（YAML）
+func (s *CatalogService) ExportCatalog(c *gin.Context, userID int, param dto.CatalogExport) ([]byte, string, resource.RspCode) {
+	productIDs, code := visibleProductIDs(c, userID, 0, dto.PermProductView)
+	if code != resource.CODE_SUCCESS {
+		return nil, "", code
+	}
+	q := dto.Client().Product.Query().Where(product.IDIn(param.ProductIDs...))
+	if productIDs != nil {
+		q = q.Where(product.IDIn(productIDs...))
+	}
+	products, err := q.Order(ent.Asc(product.FieldCode)).All(c)
+	if err != nil {
+		logger.Error("query products failed", zap.Error(err))
+		return nil, "", resource.ERR_QUERY_FAILED
+	}
+	if len(products) != len(uniqueInts(param.ProductIDs)) {
+		return nil, "", resource.ERR_PRODUCT_NOT_EXIST
+	}
+
+	doc, err := BuildCatalog(c, products)
+	if err != nil {
+		logger.Error("build catalog failed", zap.Error(err))
+		return nil, "", resource.ERR_QUERY_FAILED
+	}
+	data, err := MarshalCatalog(doc)
+	if err != nil {
+		logger.Error("marshal catalog failed", zap.Error(err))
+		return nil, "", resource.ERR_OPERATION_FAILED
+	}
+	filename := "catalog_" + time.Now().Format("20060102150405") + ".yaml"
+	return data, filename, resource.CODE_SUCCESS
+}
+
+// PlanCatalog 解析目录并返回导入计划
+func (s *CatalogService) PlanCatalog(c *gin.Context, userID int, data []byte) (*dto.CatalogPlan, resource.RspCode) {
+	doc, err := ParseCatalog(data)
+	if err != nil {
+		logger.Info("parse catalog failed", zap.Error(err))
+		return &dto.CatalogPlan{Changes: []dto.CatalogChange{}, Errors: []string{err.Error()}}, resource.CODE_SUCCESS
+	}
+	if code := checkCatalogWritePermission(c, userID, doc); code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	plan, err := PlanCatalog(c, doc)
+	if err != nil {
+		logger.Error("plan catalog failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	return plan, resource.CODE_SUCCESS
+}
+
+// ApplyCatalog 解析并执行目录导入，计划有错误时返回ERR_CATALOG_INVALID和错误列表
+func (s *CatalogService) ApplyCatalog(c *gin.Context, userID int, data []byte) (*dto.CatalogPlan, resource.RspCode) {
+	doc, err := ParseCatalog(data)
+	if err != nil {
+		logger.Info("parse catalog failed", zap.Error(err))
+		return &dto.CatalogPlan{Changes: []dto.CatalogChange{}, Errors: []string{err.Error()}}, resource.ERR_CATALOG_INVALID
+	}
+	if code := checkCatalogWritePermission(c, userID, doc); code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	plan, err := ApplyCatalog(c, doc, userID)
+	if err != nil {
+		logger.Error("apply catalog failed", zap.Error(err))
+		return nil, resource.ERR_MOD_FAILED
+	}
+	if len(plan.Errors) > 0 {
+		return plan, resource.ERR_CATALOG_INVALID
+	}
+	return plan, resource.CODE_SUCCESS
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+)
+
+const testCatalog = `
+version: 1
+products:
+  - code: P100
+    product_name: Gateway
+    warranty_months: 24
+    features:
+      - feature_code: VPN
+        feature_name: VPN
+      - feature_code: QOS
+        feature_name: Traffic shaping
+    license_types:
+      - license_type: PRO
+        type_name: Professional
+        features: [VPN, QOS]
+    managers:
+      - email: owner@example.com
+        role: main
+        permissions: full
+      - email: ops@example.com
+        role: assistant
+`
+
+func TestParseCatalog(t *testing.T) {
+	doc, err := ParseCatalog([]byte(testCatalog))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs := validateCatalog(doc); len(errs) > 0 {
+		t.Fatalf("validateCatalog = %v", errs)
+	}
+	p := doc.Products[0]
+	if p.Code != "P100" || *p.WarrantyMonths != 24 || len(p.Features) != 2 || len(p.Managers) != 2 {
+		t.Errorf("parsed product = %+v", p)
+	}
+
+	if _, err := ParseCatalog([]byte("version: 1\nproducts:\n  - code: P1\n    colour: red\n")); err == nil {
+		t.Error("unknown field accepted")
+	}
+
+	// 导出后重新解析结果不变
+	data, err := MarshalCatalog(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := ParseCatalog(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(doc, again) {
+		t.Errorf("round trip = %+v, want %+v", again, doc)
+	}
+}
+
+func TestValidateCatalog(t *testing.T) {
+	doc := &dto.CatalogDocument{
+		Version: 1,
+		Products: []dto.CatalogProduct{{
+			Code:        "P1",
+			ProductName: "One",
+			Features:    []dto.CatalogFeature{{FeatureCode: "A", FeatureName: "A"}, {FeatureCode: "A", FeatureName: "A2"}},
+			LicenseTypes: []dto.CatalogLicenseType{
+				{LicenseType: "STD", TypeName: "Standard", Features: []string{"A", "B"}},
+			},
+			Managers: []dto.CatalogManager{
+				{Email: "a@example.com", Role: "main"},
+				{Email: "b@example.com", Role: "main"},
+				{Email: "c@example.com", Role: "owner"},
+			},
+		}},
+	}
+	want := []string{
+		"product P1: duplicate feature A",
+		"product P1: license type STD references unknown feature B",
+		`product P1: manager c@example.com has invalid role "owner"`,
+		"product P1: only one main manager is allowed",
+	}
+	if got := validateCatalog(doc); !reflect.DeepEqual(got, want) {
+		t.Errorf("validateCatalog = %q, want %q", got, want)
+	}
+}
+
+func TestCatalogLicenseTypeParents(t *testing.T) {
+	lts := []dto.CatalogLicenseType{
+		{LicenseType: "ENT", TypeName: "Enterprise", Parent: "PRO"},
+		{LicenseType: "PRO", TypeName: "Pro", Parent: "BASIC"},
+		{LicenseType: "BASIC", TypeName: "Basic"},
+	}
+	var order []string
+	for _, lt := range parentsFirst(lts) {
+		order = append(order, lt.LicenseType)
+	}
+	if want := []string{"BASIC", "PRO", "ENT"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("parentsFirst = %v, want %v", order, want)
+	}
+
+	lts[2].Parent = "ENT"
+	lts = append(lts, dto.CatalogLicenseType{LicenseType: "OEM", TypeName: "OEM", Parent: "TRIAL"})
+	doc := &dto.CatalogDocument{Version: 1, Products: []dto.CatalogProduct{{Code: "P1", ProductName: "One", LicenseTypes: lts}}}
+	want := []string{
+		"product P1: license type OEM has unknown parent TRIAL",
+		"product P1: license type ENT has a cyclic parent chain",
+		"product P1: license type PRO has a cyclic parent chain",
+		"product P1: license type BASIC has a cyclic parent chain",
+	}
+	if got := validateCatalog(doc); !reflect.DeepEqual(got, want) {
+		t.Errorf("validateCatalog = %q, want %q", got, want)
+	}
+}
+
+func catalogChanges(ops []catalogOp) []dto.CatalogChange {
+	changes := make([]dto.CatalogChange, 0, len(ops))
+	for _, op := range ops {
+		changes = append(changes, op.change)
+	}
+	return changes
+}
+
+func TestDiffCatalogProduct(t *testing.T) {
+	doc, err := ParseCatalog([]byte(testCatalog))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := doc.Products[0]
+
+	// 新产品：全部新建
+	st := &catalogState{users: map[string]int{"owner@example.com": 2, "ops@example.com": 3}}
+	ops, errs := diffCatalogProduct(want, st, 1)
+	if len(errs) > 0 {
+		t.Fatalf("errors = %v", errs)
+	}
+	if len(ops) != 6 || ops[0].change.Kind != catalogKindProduct || ops[0].change.Action != dto.CatalogCreate {
+		t.Fatalf("changes = %+v", catalogChanges(ops))
+	}
+
+	// 已有产品：改名、功能映射变化、多余的记录删除
+	vpn := &ent.ProductFeature{ID: 11, FeatureCode: "VPN", FeatureName: "VPN"}
+	old := &ent.ProductFeature{ID: 12, FeatureCode: "OLD", FeatureName: "Old"}
+	pro := &ent.LicenseType{ID: 21, LicenseType: "PRO", TypeName: "Professional"}
+	pro.Edges.Features = []*ent.ProductFeature{vpn}
+	st = &catalogState{
+		product:      &ent.Product{ID: 1, Code: "P100", ProductName: "Gateway", WarrantyMonths: 24},
+		features:     map[string]*ent.ProductFeature{"VPN": vpn, "OLD": old},
+		licenseTypes: map[string]*ent.LicenseType{"PRO": pro, "BASIC": {ID: 22, LicenseType: "BASIC", TypeName: "Basic"}},
+		managers: map[string]*ent.ProductManager{
+			"owner@example.com":  {ID: 31, Role: productmanager.RoleMain, Permissions: productmanager.PermissionsFull},
+			"ops@example.com":    {ID: 32, Role: productmanager.RoleAssistant, Permissions: productmanager.PermissionsFull},
+			"former@example.com": {ID: 33, Role: productmanager.RoleAssistant, Permissions: productmanager.PermissionsRead},
+		},
+		users: map[string]int{"owner@example.com": 2, "ops@example.com": 3},
+	}
+	ops, errs = diffCatalogProduct(want, st, 1)
+	if len(errs) > 0 {
+		t.Fatalf("errors = %v", errs)
+	}
+	wantChanges := []dto.CatalogChange{
+		{Action: dto.CatalogCreate, Kind: catalogKindFeature, Product: "P100", Key: "QOS"},
+		{Action: dto.CatalogUpdate, Kind: catalogKindLicenseType, Product: "P100", Key: "PRO", Fields: []string{"features"}},
+		{Action: dto.CatalogDelete, Kind: catalogKindLicenseType, Product: "P100", Key: "BASIC"},
+		{Action: dto.CatalogDelete, Kind: catalogKindFeature, Product: "P100", Key: "OLD"},
+		{Action: dto.CatalogUpdate, Kind: catalogKindManager, Product: "P100", Key: "ops@example.com", Fields: []string{"permissions"}},
+		{Action: dto.CatalogDelete, Kind: catalogKindManager, Product: "P100", Key: "former@example.com"},
+	}
+	if got := catalogChanges(ops); !reflect.DeepEqual(got, wantChanges) {
+		t.Errorf("changes = %+v, want %+v", got, wantChanges)
+	}
+
+	// 仍在使用的许可证类型和功能不能删除
+	st.typesInUse = map[int]bool{22: true}
+	st.featuresUsed = map[int]bool{12: true}
+	ops, errs = diffCatalogProduct(want, st, 1)
+	wantErrs := []string{
+		"product P100: license type BASIC is used by devices, orders or lots and cannot be deleted",
+		"product P100: feature OLD is enabled on devices and cannot be deleted",
+	}
+	if !reflect.DeepEqual(errs, wantErrs) {
+		t.Errorf("errors = %q, want %q", errs, wantErrs)
+	}
+	for _, op := range ops {
+		if op.change.Action == dto.CatalogDelete && op.change.Kind != catalogKindManager {
+			t.Errorf("in-use record planned for deletion: %+v", op.change)
+		}
+	}
+	st.typesInUse, st.featuresUsed = nil, nil
+
+	// 主管理员不能通过目录移除，未知用户报错
+	want.Managers = []dto.CatalogManager{{Email: "new@example.com", Role: "assistant"}}
+	_, errs = diffCatalogProduct(want, st, 1)
+	wantErrs = []string{
+		"product P100: unknown user new@example.com",
+		"product P100: main manager cannot be removed by catalog import",
+	}
+	if !reflect.DeepEqual(errs, wantErrs) {
+		t.Errorf("errors = %q, want %q", errs, wantErrs)
+	}
+}
+
+// TestApplyCatalogKeepsUsedRecords 设备仍在使用的许可证类型和附加项功能不能由目录删除
+func TestApplyCatalogKeepsUsedRecords(t *testing.T) {
+	client := testClient(t)
+	ctx := systemCtx()
+	u := client.User.Create().SetID(dto.SuperAdminID + 520).SetEmail("catalog-use@example.com").SetPassword("x").SaveX(ctx)
+	p := client.Product.Create().SetCode("CATUSE").SetProductName("目录使用产品").SaveX(ctx)
+	keep := client.ProductFeature.Create().SetProductID(p.ID).SetFeatureCode("KEEP").SetFeatureName("Keep").SaveX(ctx)
+	typed := client.ProductFeature.Create().SetProductID(p.ID).SetFeatureCode("TYPED").SetFeatureName("Typed").SaveX(ctx)
+	addOn := client.ProductFeature.Create().SetProductID(p.ID).SetFeatureCode("ADDON").SetFeatureName("Add-on").SaveX(ctx)
+	free := client.ProductFeature.Create().SetProductID(p.ID).SetFeatureCode("FREE").SetFeatureName("Free").SaveX(ctx)
+	used := client.LicenseType.Create().SetProductID(p.ID).SetLicenseType("USED").SetTypeName("Used").AddFeatures(typed).SaveX(ctx)
+	now := time.Now()
+	d := client.Device.Create().SetSn("CATUSE-SN-1").SetProductID(p.ID).SetLicenseTypeID(used.ID).
+		SetCreatedAt(now).SetUpdatedAt(now).SaveX(ctx)
+	client.DeviceFeatureOverride.Create().SetDeviceID(d.ID).SetFeatureID(addOn.ID).SetEffect("grant").
+		SetCreatedBy(u.ID).SetUpdatedBy(u.ID).SaveX(ctx)
+
+	doc, err := ParseCatalog([]byte(`
+version: 1
+products:
+  - code: CATUSE
+    product_name: 目录使用产品
+    features:
+      - feature_code: KEEP
+        feature_name: Keep
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := ApplyCatalog(ctx, doc, u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantErrs := []string{
+		"product CATUSE: license type USED is used by devices, orders or lots and cannot be deleted",
+		"product CATUSE: feature ADDON is enabled on devices and cannot be deleted",
+		"product CATUSE: feature TYPED is enabled on devices and cannot be deleted",
+	}
+	if !reflect.DeepEqual(plan.Errors, wantErrs) {
+		t.Fatalf("errors = %q, want %q", plan.Errors, wantErrs)
+	}
+	for _, id := range []int{keep.ID, typed.ID, addOn.ID, free.ID} {
+		if _, err := client.ProductFeature.Get(ctx, id); err != nil {
+			t.Errorf("feature %d deleted by rejected plan: %v", id, err)
+		}
+	}
+
+	// 设备移入回收站后可以删除
+	client.Device.UpdateOne(d).SetDeletedAt(now).ExecX(ctx)
+	if plan, err = ApplyCatalog(ctx, doc, u.ID); err != nil || len(plan.Errors) > 0 {
+		t.Fatalf("apply after device deleted: %v, %v", plan, err)
+	}
+	if _, err := client.LicenseType.Get(ctx, used.ID); !ent.IsNotFound(err) {
+		t.Errorf("unused license type not deleted: %v", err)
+	}
+}
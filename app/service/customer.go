@@ -0,0 +1,719 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/customer"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/deviceassignment"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/schema"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const defaultWarrantyExpiryDays = 30 // 保修到期报表默认统计天数
+
+// CustomerService 客户管理服务
+type CustomerService struct{}
+
+// NewCustomerService 创建客户管理服务实例
+func NewCustomerService() *CustomerService {
+	return &CustomerService{}
+}
+
+// defaultWarranty 按出货时间和产品保修期计算默认保修时间
+func defaultWarranty(shippedAt time.Time, months int) (time.Time, time.Time) {
+	return shippedAt, shippedAt.AddDate(0, months, 0)
+}
+
+// productWarrantyMonths 查询产品保修期，cache缓存同一批设备中已查询的产品
+func productWarrantyMonths(ctx context.Context, tx *ent.Tx, productID int, cache map[int]int) (int, error) {
+	if months, ok := cache[productID]; ok {
+		return months, nil
+	}
+	p, err := tx.Product.Get(ctx, productID)
+	if err != nil {
+		return 0, err
+	}
+	cache[productID] = p.WarrantyMonths
+	return p.WarrantyMonths, nil
+}
+
+// canManageCustomers 系统管理员和对任一产品有设备写权限的管理员可以维护客户
+func canManageCustomers(c *gin.Context, userID int) bool {
+	ids, err := authorizedProductIDs(c, userID, dto.PermDeviceWrite)
+	return err == nil && (ids == nil || len(ids) > 0)
+}
+
+// scopeDevices 将设备查询限制在用户管理的产品内
+func scopeDevices(q *ent.DeviceQuery, productIDs []int) *ent.DeviceQuery {
+	if productIDs == nil {
+		return q
+	}
+	return q.Where(device.ProductIDIn(productIDs...))
+}
+
+// toCustomerInfo 转换为客户DTO
+func toCustomerInfo(cu *ent.Customer, deviceCount int) dto.CustomerInfo {
+	return dto.CustomerInfo{
+		ID:           cu.ID,
+		Name:         cu.Name,
+		ContactName:  cu.ContactName,
+		ContactPhone: cu.ContactPhone,
+		ContactEmail: cu.ContactEmail,
+		Region:       cu.Region,
+		OEMTag:       cu.OemTag,
+		Remark:       cu.Remark,
+		DeviceCount:  deviceCount,
+		CreatedAt:    cu.CreatedAt,
+		UpdatedAt:    cu.UpdatedAt,
+	}
+}
+
+// ListCustomers 查询客户列表，设备数量只统计用户管理的产品
+func (s *CustomerService) ListCustomers(c *gin.Context, userID int, query dto.CustomerQuery) (*dto.PageResult, resource.RspCode) {
+	q := dto.Client().Customer.Query()
+	if query.Search != "" {
+		q = q.Where(customer.Or(
+			customer.NameContainsFold(query.Search),
+			customer.ContactNameContainsFold(query.Search),
+		))
+	}
+	if query.Region != "" {
+		q = q.Where(customer.RegionEQ(query.Region))
+	}
+	if query.OEMTag != "" {
+		q = q.Where(customer.OemTagEQ(query.OEMTag))
+	}
+
+	total, err := q.Clone().Count(c)
+	if err != nil {
+		logger.Error("count customers failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	customers, err := q.
+		Order(ent.Asc(customer.FieldName)).
+		Offset((query.Page - 1) * query.PageSize).
+		Limit(query.PageSize).
+		All(c)
+	if err != nil {
+		logger.Error("query customers failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	// 分组统计设备数量
+	counts := make(map[int]int, len(customers))
+	if len(customers) > 0 {
+		productIDs, err := authorizedProductIDs(c, userID, dto.PermDeviceView)
+		if err != nil {
+			logger.Error("query managed products failed", zap.Error(err))
+			return nil, resource.ERR_QUERY_FAILED
+		}
+		ids := make([]int, len(customers))
+		for i, cu := range customers {
+			ids[i] = cu.ID
+		}
+		var rows []struct {
+			CustomerID int `json:"customer_id"`
+			Count      int `json:"count"`
+		}
+		err = scopeDevices(dto.Client().Device.Query(), productIDs).
+			Where(device.CustomerIDIn(ids...)).
+			GroupBy(device.FieldCustomerID).
+			Aggregate(ent.Count()).
+			Scan(c, &rows)
+		if err != nil {
+			logger.Error("count customer devices failed", zap.Error(err))
+			return nil, resource.ERR_QUERY_FAILED
+		}
+		for _, row := range rows {
+			counts[row.CustomerID] = row.Count
+		}
+	}
+
+	list := make([]dto.CustomerInfo, 0, len(customers))
+	for _, cu := range customers {
+		list = append(list, toCustomerInfo(cu, counts[cu.ID]))
+	}
+	return &dto.PageResult{
+		Total:    int64(total),
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		List:     list,
+	}, resource.CODE_SUCCESS
+}
+
+// AddCustomer 添加客户
+func (s *CustomerService) AddCustomer(c *gin.Context, userID int, param dto.AddCustomer) (*dto.CustomerInfo, resource.RspCode) {
+	// 1. 检查用户权限
+	if !canManageCustomers(c, userID) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 检查客户名称是否重复
+	exist, err := dto.Client().Customer.Query().
+		Where(customer.NameEQ(param.Name)).
+		Exist(c)
+	if err != nil {
+		logger.Error("check customer name failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if exist {
+		return nil, resource.ERR_CUSTOMER_EXIST
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	cu, err := tx.Customer.Create().
+		SetName(param.Name).
+		SetContactName(param.ContactName).
+		SetContactPhone(param.ContactPhone).
+		SetContactEmail(param.ContactEmail).
+		SetRegion(param.Region).
+		SetOemTag(param.OEMTag).
+		SetRemark(param.Remark).
+		SetCreatedBy(userID).
+		Save(c)
+	if err != nil {
+		logger.Error("create customer failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	// 4. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:     userID,
+		Action:     dto.ActionCreate,
+		Module:     dto.ModuleCustomer,
+		DetailInfo: cu,
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 5. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	info := toCustomerInfo(cu, 0)
+	return &info, resource.CODE_SUCCESS
+}
+
+// UpdateCustomer 修改客户
+func (s *CustomerService) UpdateCustomer(c *gin.Context, userID int, param dto.ModifyCustomer) resource.RspCode {
+	// 1. 检查用户权限
+	if !canManageCustomers(c, userID) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	old, err := dto.Client().Customer.Get(c, param.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_CUSTOMER_NOT_EXIST
+		}
+		logger.Error("query customer failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+
+	// 2. 检查客户名称是否重复
+	if param.Name != old.Name {
+		exist, err := dto.Client().Customer.Query().
+			Where(customer.NameEQ(param.Name), customer.IDNEQ(param.ID)).
+			Exist(c)
+		if err != nil {
+			logger.Error("check customer name failed", zap.Error(err))
+			return resource.ERR_QUERY_FAILED
+		}
+		if exist {
+			return resource.ERR_CUSTOMER_EXIST
+		}
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	updated, err := tx.Customer.UpdateOneID(param.ID).
+		SetName(param.Name).
+		SetContactName(param.ContactName).
+		SetContactPhone(param.ContactPhone).
+		SetContactEmail(param.ContactEmail).
+		SetRegion(param.Region).
+		SetOemTag(param.OEMTag).
+		SetRemark(param.Remark).
+		Save(c)
+	if err != nil {
+		logger.Error("update customer failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_MOD_FAILED
+	}
+
+	// 4. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID: userID,
+		Action: dto.ActionUpdate,
+		Module: dto.ModuleCustomer,
+		DetailInfo: map[string]interface{}{
+			"old_customer": old,
+			"new_customer": updated,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 5. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// DeleteCustomer 删除客户，仅系统管理员可用，客户名下还有设备时不允许删除，归属历史和订单保留
+func (s *CustomerService) DeleteCustomer(c *gin.Context, userID, id int) resource.RspCode {
+	if !isSystemAdmin(c, userID) || !tokenPermits(c, dto.PermDeviceDelete) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	cu, err := dto.Client().Customer.Get(c, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_CUSTOMER_NOT_EXIST
+		}
+		logger.Error("query customer failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+
+	// 回收站中的设备同样保留客户关联
+	hasDevices, err := dto.Client().Device.Query().
+		Where(device.CustomerIDEQ(id)).
+		Exist(schema.SkipSoftDelete(c))
+	if err != nil {
+		logger.Error("check customer devices failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if hasDevices {
+		return resource.ERR_CUSTOMER_HAS_DEVICES
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_DEL_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	// 订单保留，只解除客户关联
+	if _, err := tx.Order.Update().Where(order.CustomerIDEQ(id)).ClearCustomerID().Save(c); err != nil {
+		logger.Error("clear order customer failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_DEL_FAILED
+	}
+
+	if err := tx.Customer.DeleteOne(cu).Exec(c); err != nil {
+		logger.Error("delete customer failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_DEL_FAILED
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:     userID,
+		Action:     dto.ActionDelete,
+		Module:     dto.ModuleCustomer,
+		DetailInfo: cu,
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_DEL_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// AssignCustomer 设置设备归属客户并记录历史，每台设备一条审计日志
+func (s *CustomerService) AssignCustomer(c *gin.Context, userID int, param dto.AssignCustomer) resource.RspCode {
+	deviceIDs, code := resolveDeviceTargets(c, param.DeviceIDs, param.GroupID)
+	if code != resource.CODE_SUCCESS {
+		return code
+	}
+	if len(deviceIDs) == 0 {
+		return resource.ERR_INVALID_PARAMETER
+	}
+	if param.WarrantyStartAt != nil && param.WarrantyEndAt != nil && param.WarrantyEndAt.Before(*param.WarrantyStartAt) {
+		return resource.ERR_INVALID_PARAMETER
+	}
+
+	// 1. 检查客户
+	var target *ent.Customer
+	if param.CustomerID > 0 {
+		cu, err := dto.Client().Customer.Get(c, param.CustomerID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return resource.ERR_CUSTOMER_NOT_EXIST
+			}
+			logger.Error("query customer failed", zap.Error(err))
+			return resource.ERR_QUERY_FAILED
+		}
+		target = cu
+	}
+
+	// 2. 获取设备并检查写权限
+	devices, err := dto.Client().Device.Query().
+		Where(device.IDIn(deviceIDs...)).
+		WithCustomer().
+		All(c)
+	if err != nil {
+		logger.Error("query devices failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if len(devices) == 0 {
+		return resource.ERR_DEVICE_NOT_EXIST
+	}
+	checked := make(map[int]bool)
+	for _, d := range devices {
+		if checked[d.ProductID] {
+			continue
+		}
+		if !authorize(c, userID, d.ProductID, dto.PermDeviceWrite) {
+			return resource.ERR_NO_PERMISSION
+		}
+		checked[d.ProductID] = true
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	now := time.Now()
+	warrantyMonths := make(map[int]int)
+	for _, d := range devices {
+		// 4. 计算保修时间：优先使用指定值，其次保留已有值，已出货的设备按出货时间计算
+		start, end := d.WarrantyStartAt, d.WarrantyEndAt
+		if start == nil && d.ShippedAt != nil {
+			months, err := productWarrantyMonths(c, tx, d.ProductID, warrantyMonths)
+			if err != nil {
+				logger.Error("query product warranty failed", zap.Error(err))
+				_ = tx.Rollback()
+				return resource.ERR_QUERY_FAILED
+			}
+			defaultStart, defaultEnd := defaultWarranty(*d.ShippedAt, months)
+			start, end = &defaultStart, &defaultEnd
+		}
+		if param.WarrantyStartAt != nil {
+			start = param.WarrantyStartAt
+		}
+		if param.WarrantyEndAt != nil {
+			end = param.WarrantyEndAt
+		}
+
+		// 5. 更新设备归属
+		update := tx.Device.UpdateOne(d).
+			SetUpdatedAt(now).
+			SetUpdatedBy(userID)
+		if target != nil {
+			update.SetCustomerID(target.ID)
+		} else {
+			update.ClearCustomerID()
+		}
+		if start != nil {
+			update.SetWarrantyStartAt(*start)
+		}
+		if end != nil {
+			update.SetWarrantyEndAt(*end)
+		}
+		updatedDevice, err := update.Save(c)
+		if err != nil {
+			logger.Error("update device customer failed", zap.Error(err))
+			_ = tx.Rollback()
+			return resource.ERR_MOD_FAILED
+		}
+
+		// 6. 记录归属历史
+		history := tx.DeviceAssignment.Create().
+			SetDeviceID(d.ID).
+			SetNillableWarrantyStartAt(start).
+			SetNillableWarrantyEndAt(end).
+			SetRemark(param.Remark).
+			SetAssignedBy(userID).
+			SetAssignedAt(now)
+		if target != nil {
+			history.SetCustomerID(target.ID).SetCustomerName(target.Name)
+		}
+		if d.Edges.Customer != nil {
+			history.SetPreviousCustomerID(d.Edges.Customer.ID).SetPreviousCustomerName(d.Edges.Customer.Name)
+		}
+		if err := history.Exec(c); err != nil {
+			logger.Error("create device assignment failed", zap.Error(err))
+			_ = tx.Rollback()
+			return resource.ERR_MOD_FAILED
+		}
+
+		// 7. 创建审计日志
+		oldDevice := *d
+		oldDevice.Edges = ent.DeviceEdges{}
+		err = CreateAuditLog(c, tx, dto.AuditLogData{
+			UserID:    userID,
+			Action:    dto.ActionUpdate,
+			Module:    dto.ModuleDevice,
+			ProductID: d.ProductID,
+			DetailInfo: map[string]interface{}{
+				"old_device": oldDevice,
+				"new_device": updatedDevice,
+				"operation":  "assign_customer",
+			},
+		})
+		if err != nil {
+			logger.Error("create audit log failed", zap.Error(err))
+			_ = tx.Rollback()
+			return resource.ERR_ADD_LOG_FAILED
+		}
+	}
+
+	// 8. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// ListCustomerDevices 查询客户名下的设备，只返回用户管理的产品中的设备
+func (s *CustomerService) ListCustomerDevices(c *gin.Context, userID int, query dto.CustomerDeviceQuery) (*dto.PageResult, resource.RspCode) {
+	exist, err := dto.Client().Customer.Query().
+		Where(customer.IDEQ(query.CustomerID)).
+		Exist(c)
+	if err != nil {
+		logger.Error("check customer failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if !exist {
+		return nil, resource.ERR_CUSTOMER_NOT_EXIST
+	}
+
+	productIDs, err := authorizedProductIDs(c, userID, dto.PermDeviceView)
+	if err != nil {
+		logger.Error("query managed products failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	pg, err := newPaging(query.Page, query.PageSize, query.CursorParams)
+	if err != nil {
+		return nil, resource.ERR_INVALID_PARAMETER
+	}
+
+	q := scopeDevices(dto.Client().Device.Query(), productIDs).
+		Where(device.CustomerIDEQ(query.CustomerID))
+	if query.ProductID > 0 {
+		q = q.Where(device.ProductIDEQ(query.ProductID))
+	}
+
+	total, capped, err := pg.countTotal(c, q.Clone().Count, func(limit int) ([]int, error) {
+		return q.Clone().Limit(limit).IDs(c)
+	})
+	if err != nil {
+		logger.Error("count customer devices failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if err := pg.apply(q); err != nil {
+		logger.Error("apply device paging failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	devices, err := q.
+		WithProduct().
+		WithLicenseType().
+		WithCustomer().
+		WithTags().
+		All(c)
+	if err != nil {
+		logger.Error("query customer devices failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	n, hasMore := pg.trim(len(devices))
+	devices = devices[:n]
+	var next string
+	if hasMore {
+		next = encodeCursor(devices[n-1].CreatedAt, devices[n-1].ID)
+	}
+	deviceInfos := make([]dto.DeviceInfo, 0, len(devices))
+	for _, d := range devices {
+		deviceInfos = append(deviceInfos, toDeviceInfo(d))
+	}
+	return pg.result(deviceInfos, total, capped, next), resource.CODE_SUCCESS
+}
+
+// ListAssignmentHistory 查询设备归属变更历史，按时间倒序
+func (s *CustomerService) ListAssignmentHistory(c *gin.Context, userID, deviceID int) ([]dto.DeviceAssignmentInfo, resource.RspCode) {
+	d, err := dto.Client().Device.Get(c, deviceID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, resource.ERR_DEVICE_NOT_EXIST
+		}
+		logger.Error("query device failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if !authorize(c, userID, d.ProductID, dto.PermDeviceView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	rows, err := dto.Client().DeviceAssignment.Query().
+		Where(deviceassignment.DeviceIDEQ(deviceID)).
+		Order(ent.Desc(deviceassignment.FieldAssignedAt), ent.Desc(deviceassignment.FieldID)).
+		All(c)
+	if err != nil {
+		logger.Error("query device assignments failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	list := make([]dto.DeviceAssignmentInfo, 0, len(rows))
+	for _, r := range rows {
+		list = append(list, dto.DeviceAssignmentInfo{
+			ID:                   r.ID,
+			DeviceID:             r.DeviceID,
+			CustomerID:           r.CustomerID,
+			CustomerName:         r.CustomerName,
+			PreviousCustomerID:   r.PreviousCustomerID,
+			PreviousCustomerName: r.PreviousCustomerName,
+			WarrantyStartAt:      r.WarrantyStartAt,
+			WarrantyEndAt:        r.WarrantyEndAt,
+			Remark:               r.Remark,
+			AssignedBy:           r.AssignedBy,
+			AssignedAt:           r.AssignedAt,
+		})
+	}
+	return list, resource.CODE_SUCCESS
+}
+
+// WarrantyExpiryReport 保修到期报表，列出指定天数内到期的设备，按到期时间升序，报废设备不统计
+func (s *CustomerService) WarrantyExpiryReport(c *gin.Context, userID int, query dto.WarrantyExpiryQuery) (*dto.PageResult, resource.RspCode) {
+	productIDs, err := authorizedProductIDs(c, userID, dto.PermDeviceView)
+	if err != nil {
+		logger.Error("query managed products failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	days := query.Days
+	if days <= 0 {
+		days = defaultWarrantyExpiryDays
+	}
+	now := time.Now()
+	q := scopeDevices(dto.Client().Device.Query(), productIDs).
+		Where(
+			device.WarrantyEndAtNotNil(),
+			device.WarrantyEndAtLTE(now.AddDate(0, 0, days)),
+			device.StateNEQ(device.StateScrapped),
+		)
+	if !query.IncludeExpired {
+		q = q.Where(device.WarrantyEndAtGTE(now))
+	}
+	if query.ProductID > 0 {
+		q = q.Where(device.ProductIDEQ(query.ProductID))
+	}
+	if query.CustomerID > 0 {
+		q = q.Where(device.CustomerIDEQ(query.CustomerID))
+	}
+
+	total, err := q.Clone().Count(c)
+	if err != nil {
+		logger.Error("count warranty expiry failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	devices, err := q.
+		WithProduct().
+		WithCustomer().
+		Order(ent.Asc(device.FieldWarrantyEndAt), ent.Asc(device.FieldID)).
+		Offset((query.Page - 1) * query.PageSize).
+		Limit(query.PageSize).
+		All(c)
+	if err != nil {
+		logger.Error("query warranty expiry failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	list := make([]dto.WarrantyExpiryItem, 0, len(devices))
+	for _, d := range devices {
+		item := dto.WarrantyExpiryItem{
+			DeviceID:      d.ID,
+			SN:            d.Sn,
+			ProductID:     d.ProductID,
+			CustomerID:    d.CustomerID,
+			State:         d.State.String(),
+			WarrantyEndAt: *d.WarrantyEndAt,
+			DaysLeft:      daysLeft(now, *d.WarrantyEndAt),
+		}
+		if d.Edges.Product != nil {
+			item.ProductName = d.Edges.Product.ProductName
+		}
+		if d.Edges.Customer != nil {
+			item.CustomerName = d.Edges.Customer.Name
+		}
+		list = append(list, item)
+	}
+	return &dto.PageResult{
+		Total:    int64(total),
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		List:     list,
+	}, resource.CODE_SUCCESS
+}
+
+// daysLeft 距离保修截止的天数，不足一天按一天计，已过保为负数
+func daysLeft(now, end time.Time) int {
+	return int(math.Ceil(end.Sub(now).Hours() / 24))
+}
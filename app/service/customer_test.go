@@ -0,0 +1,35 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDefaultWarranty(t *testing.T) {
+	shipped := time.Date(2024, 1, 31, 8, 0, 0, 0, time.UTC)
+	start, end := defaultWarranty(shipped, 12)
+	if !start.Equal(shipped) {
+		t.Errorf("start = %v, want %v", start, shipped)
+	}
+	if want := time.Date(2025, 1, 31, 8, 0, 0, 0, time.UTC); !end.Equal(want) {
+		t.Errorf("end = %v, want %v", end, want)
+	}
+}
+
+func TestDaysLeft(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		end  time.Time
+		want int
+	}{
+		{now.Add(36 * time.Hour), 2},
+		{now.Add(24 * time.Hour), 1},
+		{now.Add(time.Hour), 1},
+		{now.Add(-25 * time.Hour), -1},
+	}
+	for _, tc := range cases {
+		if got := daysLeft(now, tc.end); got != tc.want {
+			t.Errorf("daysLeft(%v) = %d, want %d", tc.end, got, tc.want)
+		}
+	}
+}
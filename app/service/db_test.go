@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/enttest"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3" // SQLite驱动
+)
+
+var (
+	testClientOnce sync.Once
+	testEntClient  *ent.Client
+)
+
+// testClient 包内测试共享的SQLite内存数据库。dto.SetClient只能设置一次，
+// 各测试使用各自唯一的SN、产品代号和邮箱
+func testClient(t *testing.T) *ent.Client {
+	testClientOnce.Do(func() {
+		testEntClient = enttest.Open(t, dialect.SQLite, "file:service?mode=memory&cache=shared&_fk=1")
+		dto.SetClient(testEntClient)
+	})
+	return testEntClient
+}
+
+// systemCtx 系统身份的上下文，用于准备测试数据
+func systemCtx() context.Context {
+	return viewer.SystemContext(context.Background())
+}
+
+// testGinContext 携带指定查询身份的请求上下文，v为nil时模拟未经认证的请求
+func testGinContext(v *viewer.Viewer) *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("POST", "/", nil)
+	if v != nil {
+		c.Set(viewer.ContextKey, v)
+	}
+	return c
+}
+
+// userGinContext 用户请求的上下文，grant不为nil时模拟API令牌认证的请求
+func userGinContext(userID int, grant *auth.TokenGrant) *gin.Context {
+	c := testGinContext(NewTokenViewer(userID, grant))
+	if grant != nil {
+		auth.SetTokenGrant(c, grant)
+	}
+	return c
+}
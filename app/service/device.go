@@ -7,6 +7,7 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha256"
+	"encoding/csv"
 	"fmt"
 	jsoniter "github.com/json-iterator/go"
 	"io"
@@ -16,9 +17,12 @@ import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicegroup"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicetag"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
 	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
 	"cambridge-hit.com/gin-base/activateserver/pkg/util/str"
 	"cambridge-hit.com/gin-base/activateserver/resource"
@@ -52,8 +56,8 @@ func encryptSN(sn string) string {
 func (s *DeviceService) ListProducts(c *gin.Context, userID int, page, pageSize int) (*dto.PageResult, resource.RspCode) {
 	// 构建查询
 	q := dto.Client().ProductManager.Query()
-	if userID != 1 {
-		// 用户ID不是1，列出用户作为管理员或协作者管理的产品
+	if !isSystemAdmin(c, userID) {
+		// 不是系统管理员，列出用户作为管理员或协作者管理的产品
 		q = q.Where(productmanager.UserIDEQ(userID))
 	}
 
@@ -94,20 +98,11 @@ func (s *DeviceService) ListProducts(c *gin.Context, userID int, page, pageSize
 		return nil, resource.ERR_QUERY_FAILED
 	}
 
-	// 计算设备数量
-	var deviceSummaries []dto.DeviceSummary
-	for _, p := range products {
-		count, err := dto.Client().Device.Query().Where(device.ProductIDEQ(p.ID)).Count(c)
-		if err != nil {
-			logger.Error("count devices failed", zap.Error(err), zap.Int("product_id", p.ID))
-			continue
-		}
-
-		deviceSummaries = append(deviceSummaries, dto.DeviceSummary{
-			ProductID:   p.ID,
-			ProductName: p.ProductName,
-			Count:       count,
-		})
+	// 统计设备数量（按许可证类型和生命周期状态分组）
+	deviceSummaries, err := productSummaries(c, products)
+	if err != nil {
+		logger.Error("count devices failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
 	}
 
 	// 构建分页结果
@@ -126,109 +121,77 @@ func (s *DeviceService) ListProducts(c *gin.Context, userID int, page, pageSize
 
 // ListDevices 获取产品下设备列表
 func (s *DeviceService) ListDevices(c *gin.Context, userID int, filter dto.DeviceFilter) (*dto.PageResult, resource.RspCode) {
-	// 权限检查
-	//if userID != 1 {
-	//	exist, err := dto.Client().ProductManager.Query().
-	//		Where(
-	//			productmanager.ProductIDEQ(filter.ProductID),
-	//			productmanager.UserIDEQ(userID),
-	//		).Exist(c)
-	//	if err != nil || !exist {
-	//		return nil, resource.ERR_NO_PERMISSION
-	//	}
-	//}
-
-	// 构建查询
-	q := dto.Client().Device.Query()
-	if filter.ProductID != 0 {
-		q = q.Where(device.ProductIDEQ(filter.ProductID))
+	// 使用已保存的筛选器
+	cond := filter.DeviceCondition
+	if filter.SavedFilterID > 0 {
+		saved, code := loadDeviceCondition(c, userID, filter.SavedFilterID)
+		if code != resource.CODE_SUCCESS {
+			return nil, code
+		}
+		cond = *saved
 	}
-
-	// 应用过滤条件
-	if filter.LicenseTypeID > 0 {
-		q = q.Where(device.LicenseTypeIDEQ(filter.LicenseTypeID))
+	if !checkAttrFilters(cond.Attrs) {
+		return nil, resource.ERR_INVALID_PARAMETER
+	}
+	// 查询身份只限制产品范围，还需按查看设备的权限和API令牌的授权范围确定可查看的产品
+	productIDs, code := visibleProductIDs(c, userID, cond.ProductID, dto.PermDeviceView)
+	if code != resource.CODE_SUCCESS {
+		return nil, code
 	}
 
-	if filter.SN != "" {
-		q = q.Where(device.SnContainsFold(filter.SN))
+	pg, err := newPaging(filter.Page, filter.PageSize, filter.CursorParams)
+	if err != nil {
+		return nil, resource.ERR_INVALID_PARAMETER
 	}
 
-	if filter.OEMTag != "" {
-		q = q.Where(device.OemTagContainsFold(filter.OEMTag))
+	// 构建查询
+	q := applyDeviceCondition(dto.Client().Device.Query(), cond)
+	if productIDs != nil {
+		q = q.Where(device.ProductIDIn(productIDs...))
 	}
 
 	// 计算总数
-	total, err := q.Count(c)
+	total, capped, err := pg.countTotal(c, q.Clone().Count, func(limit int) ([]int, error) {
+		return q.Clone().Limit(limit).IDs(c)
+	})
 	if err != nil {
 		logger.Error("count devices failed", zap.Error(err))
 		return nil, resource.ERR_QUERY_FAILED
 	}
 
 	// 执行分页查询
-	offset := (filter.Page - 1) * filter.PageSize
+	if err := pg.apply(q); err != nil {
+		logger.Error("apply device paging failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
 	devices, err := q.
 		WithProduct().
 		WithLicenseType().
 		WithCreator().
 		WithUpdater().
-		Order(ent.Desc(device.FieldCreatedAt)).
-		Limit(filter.PageSize).
-		Offset(offset).
+		WithTags().
+		WithCustomer().
 		All(c)
 
 	if err != nil {
 		logger.Error("query devices failed", zap.Error(err))
 		return nil, resource.ERR_QUERY_FAILED
 	}
+	n, hasMore := pg.trim(len(devices))
+	devices = devices[:n]
+	var next string
+	if hasMore {
+		next = encodeCursor(devices[n-1].CreatedAt, devices[n-1].ID)
+	}
 
 	// 转换为DTO
 	deviceInfos := make([]dto.DeviceInfo, 0, len(devices))
 	for _, d := range devices {
-		deviceInfo := dto.DeviceInfo{
-			ID:            d.ID,
-			SN:            d.Sn,
-			SNEncrypted:   encryptSN(d.Sn),
-			ProductID:     d.ProductID,
-			LicenseTypeID: d.LicenseTypeID,
-			OEMTag:        d.OemTag,
-			Remark:        d.Remark,
-			CreatedAt:     d.CreatedAt,
-			CreatedBy:     d.CreatedBy,
-			UpdatedAt:     d.UpdatedAt,
-			UpdatedBy:     d.UpdatedBy,
-		}
-
-		// 添加关联信息
-		if d.Edges.Product != nil {
-			deviceInfo.ProductName = d.Edges.Product.ProductName
-			deviceInfo.ProductCode = d.Edges.Product.Code
-		}
-
-		if d.Edges.LicenseType != nil {
-			deviceInfo.LicenseTypeName = d.Edges.LicenseType.TypeName
-			deviceInfo.LicenseTypeCode = d.Edges.LicenseType.LicenseType
-		}
-
-		if d.Edges.Creator != nil {
-			deviceInfo.CreatedByEmail = d.Edges.Creator.Email
-		}
-
-		if d.Edges.Updater != nil {
-			deviceInfo.UpdatedByEmail = d.Edges.Updater.Email
-		}
-
-		deviceInfos = append(deviceInfos, deviceInfo)
+		deviceInfos = append(deviceInfos, toDeviceInfo(d))
 	}
 
 	// 构建分页结果
-	result := &dto.PageResult{
-		Total:    int64(total),
-		Page:     filter.Page,
-		PageSize: filter.PageSize,
-		List:     deviceInfos,
-	}
-
-	return result, resource.CODE_SUCCESS
+	return pg.result(deviceInfos, total, capped, next), resource.CODE_SUCCESS
 }
 
 // GetDeviceBySN 通过SN获取设备
@@ -239,6 +202,8 @@ func (s *DeviceService) GetDeviceBySN(c *gin.Context, userID int, sn string) (*d
 		WithLicenseType().
 		WithCreator().
 		WithUpdater().
+		WithTags().
+		WithCustomer().
 		Only(c)
 
 	if err != nil {
@@ -250,30 +215,59 @@ func (s *DeviceService) GetDeviceBySN(c *gin.Context, userID int, sn string) (*d
 	}
 
 	// 权限检查
-	if userID != 1 {
-		exist, err := dto.Client().ProductManager.Query().
-			Where(
-				productmanager.ProductIDEQ(d.ProductID),
-				productmanager.UserIDEQ(userID),
-			).Exist(c)
-		if err != nil || !exist {
-			return nil, resource.ERR_NO_PERMISSION
-		}
+	if !authorize(c, userID, d.ProductID, dto.PermDeviceView) {
+		return nil, resource.ERR_NO_PERMISSION
 	}
 
 	// 转换为DTO
+	deviceInfo := toDeviceInfo(d)
+
+	// 功能及其来源
+	features, addOns, err := deviceFeatures(c, d)
+	if err != nil {
+		logger.Error("query device features failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	now := time.Now()
+	deviceInfo.Features = features
+	deviceInfo.AddOns = make([]dto.DeviceFeatureAddOnInfo, 0, len(addOns))
+	for _, o := range addOns {
+		deviceInfo.AddOns = append(deviceInfo.AddOns, toAddOnInfo(o, now))
+	}
+	return &deviceInfo, resource.CODE_SUCCESS
+}
+
+// toDeviceInfo 转换为设备DTO，关联信息需要预先加载
+func toDeviceInfo(d *ent.Device) dto.DeviceInfo {
 	deviceInfo := dto.DeviceInfo{
-		ID:            d.ID,
-		SN:            d.Sn,
-		SNEncrypted:   encryptSN(d.Sn),
-		ProductID:     d.ProductID,
-		LicenseTypeID: d.LicenseTypeID,
-		OEMTag:        d.OemTag,
-		Remark:        d.Remark,
-		CreatedAt:     d.CreatedAt,
-		CreatedBy:     d.CreatedBy,
-		UpdatedAt:     d.UpdatedAt,
-		UpdatedBy:     d.UpdatedBy,
+		ID:              d.ID,
+		SN:              d.Sn,
+		SNEncrypted:     encryptSN(d.Sn),
+		ProductID:       d.ProductID,
+		LicenseTypeID:   d.LicenseTypeID,
+		OEMTag:          d.OemTag,
+		Remark:          d.Remark,
+		State:           d.State.String(),
+		ShippedAt:       d.ShippedAt,
+		ActivatedAt:     d.ActivatedAt,
+		SuspendedAt:     d.SuspendedAt,
+		RmaAt:           d.RmaAt,
+		ScrappedAt:      d.ScrappedAt,
+		LastSeenAt:      d.LastSeenAt,
+		CustomerID:      d.CustomerID,
+		WarrantyStartAt: d.WarrantyStartAt,
+		WarrantyEndAt:   d.WarrantyEndAt,
+		OrderID:         d.OrderID,
+		LotID:           d.LotID,
+		Attributes:      d.Attributes,
+		Online:          d.LastSeenAt != nil && !d.LastSeenAt.Before(onlineSince()),
+		SoftwareVersion: d.LastSoftwareVersion,
+		FirmwareVersion: d.LastFirmwareVersion,
+		Uptime:          d.LastUptime,
+		CreatedAt:       d.CreatedAt,
+		CreatedBy:       d.CreatedBy,
+		UpdatedAt:       d.UpdatedAt,
+		UpdatedBy:       d.UpdatedBy,
 	}
 
 	// 添加关联信息
@@ -287,6 +281,10 @@ func (s *DeviceService) GetDeviceBySN(c *gin.Context, userID int, sn string) (*d
 		deviceInfo.LicenseTypeCode = d.Edges.LicenseType.LicenseType
 	}
 
+	if d.Edges.Customer != nil {
+		deviceInfo.CustomerName = d.Edges.Customer.Name
+	}
+
 	if d.Edges.Creator != nil {
 		deviceInfo.CreatedByEmail = d.Edges.Creator.Email
 	}
@@ -295,21 +293,118 @@ func (s *DeviceService) GetDeviceBySN(c *gin.Context, userID int, sn string) (*d
 		deviceInfo.UpdatedByEmail = d.Edges.Updater.Email
 	}
 
-	return &deviceInfo, resource.CODE_SUCCESS
+	deviceInfo.Tags = make([]dto.DeviceTagInfo, 0, len(d.Edges.Tags))
+	for _, t := range d.Edges.Tags {
+		deviceInfo.Tags = append(deviceInfo.Tags, dto.DeviceTagInfo{ID: t.ID, Name: t.Name, Color: t.Color})
+	}
+
+	return deviceInfo
+}
+
+// applyDeviceCondition 将筛选条件应用到设备查询
+func applyDeviceCondition(q *ent.DeviceQuery, cond dto.DeviceCondition) *ent.DeviceQuery {
+	if cond.ProductID != 0 {
+		q = q.Where(device.ProductIDEQ(cond.ProductID))
+	}
+
+	// 应用过滤条件
+	if cond.LicenseTypeID > 0 {
+		q = q.Where(device.LicenseTypeIDEQ(cond.LicenseTypeID))
+	}
+
+	if cond.SN != "" {
+		q = q.Where(device.SnContainsFold(cond.SN))
+	}
+
+	if cond.OEMTag != "" {
+		q = q.Where(device.OemTagContainsFold(cond.OEMTag))
+	}
+
+	if cond.State != "" {
+		q = q.Where(device.StateEQ(device.State(cond.State)))
+	}
+
+	// 设备需同时包含全部标签
+	for _, tagID := range cond.TagIDs {
+		q = q.Where(device.HasTagsWith(devicetag.IDEQ(tagID)))
+	}
+
+	if cond.GroupID > 0 {
+		q = q.Where(device.HasGroupsWith(devicegroup.IDEQ(cond.GroupID)))
+	}
+
+	// 在线状态按最后心跳时间判定
+	switch cond.Online {
+	case "online":
+		q = q.Where(device.LastSeenAtGTE(onlineSince()))
+	case "offline":
+		q = q.Where(device.Or(device.LastSeenAtIsNil(), device.LastSeenAtLT(onlineSince())))
+	}
+
+	if cond.SoftwareVersion != "" {
+		q = q.Where(device.LastSoftwareVersionEQ(cond.SoftwareVersion))
+	}
+
+	if cond.FirmwareVersion != "" {
+		q = q.Where(device.LastFirmwareVersionEQ(cond.FirmwareVersion))
+	}
+
+	if cond.CustomerID > 0 {
+		q = q.Where(device.CustomerIDEQ(cond.CustomerID))
+	}
+
+	if cond.OrderID > 0 {
+		q = q.Where(device.OrderIDEQ(cond.OrderID))
+	}
+
+	if cond.LotID > 0 {
+		q = q.Where(device.LotIDEQ(cond.LotID))
+	}
+
+	// 自定义属性条件，不合法的表达式在请求校验时拒绝
+	for _, expr := range cond.Attrs {
+		if f, ok := parseAttrFilter(expr); ok {
+			q = q.Where(f.predicate())
+		}
+	}
+
+	return q
+}
+
+// resolveDeviceTargets 合并批量操作的目标设备，分组成员与显式指定的设备ID去重合并
+func resolveDeviceTargets(c *gin.Context, deviceIDs []int, groupID int) ([]int, resource.RspCode) {
+	if groupID <= 0 {
+		return deviceIDs, resource.CODE_SUCCESS
+	}
+
+	exist, err := dto.Client().DeviceGroup.Query().
+		Where(devicegroup.IDEQ(groupID)).
+		Exist(c)
+	if err != nil {
+		logger.Error("check device group failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if !exist {
+		return nil, resource.ERR_DEVICE_GROUP_NOT_EXIST
+	}
+
+	memberIDs, err := dto.Client().DeviceGroup.Query().
+		Where(devicegroup.IDEQ(groupID)).
+		QueryDevices().
+		IDs(c)
+	if err != nil {
+		logger.Error("query device group members failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	return uniqueInts(append(deviceIDs, memberIDs...)), resource.CODE_SUCCESS
 }
 
 // GetLicenseTypesByProductID 获取产品下的许可证类型
 func (s *DeviceService) GetLicenseTypesByProductID(c *gin.Context, userID int, productID int) ([]*ent.LicenseType, resource.RspCode) {
 	// 权限检查
-	if userID != 1 {
-		exist, err := dto.Client().ProductManager.Query().
-			Where(
-				productmanager.ProductIDEQ(productID),
-				productmanager.UserIDEQ(userID),
-			).Exist(c)
-		if err != nil || !exist {
-			return nil, resource.ERR_NO_PERMISSION
-		}
+	if !authorize(c, userID, productID, dto.PermProductView) {
+		return nil, resource.ERR_NO_PERMISSION
 	}
 
 	// 查询产品下的许可证类型
@@ -329,15 +424,8 @@ func (s *DeviceService) GetLicenseTypesByProductID(c *gin.Context, userID int, p
 // AddDevice 添加单个设备
 func (s *DeviceService) AddDevice(c *gin.Context, userID int, param dto.DeviceAdd) resource.RspCode {
 	// 权限检查
-	if userID != 1 {
-		exist, err := dto.Client().ProductManager.Query().
-			Where(
-				productmanager.ProductIDEQ(param.ProductID),
-				productmanager.UserIDEQ(userID),
-			).Exist(c)
-		if err != nil || !exist {
-			return resource.ERR_NO_PERMISSION
-		}
+	if !authorize(c, userID, param.ProductID, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
 	}
 
 	// 检查产品是否存在
@@ -366,10 +454,25 @@ func (s *DeviceService) AddDevice(c *gin.Context, userID int, param dto.DeviceAd
 		return resource.ERR_LICENSE_TYPE_NOT_EXIST
 	}
 
-	// 检查SN是否重复
+	// 检查SN是否符合产品规则
+	invalidSNs, err := checkSNs(c, param.ProductID, []string{param.SN})
+	if err != nil {
+		logger.Error("check sn rule failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if len(invalidSNs) > 0 {
+		return resource.ERR_DEVICE_SN_INVALID
+	}
+
+	// 检查自定义属性是否符合产品的属性定义
+	if code := checkDeviceAttributes(c, param.ProductID, param.Attributes); code != resource.CODE_SUCCESS {
+		return code
+	}
+
+	// 检查SN是否重复，SN全局唯一，需要检查所有产品的设备
 	exist, err := dto.Client().Device.Query().
 		Where(device.SnEQ(param.SN)).
-		Exist(c)
+		Exist(viewer.SystemContext(c))
 	if err != nil {
 		logger.Error("check device sn failed", zap.Error(err))
 		return resource.ERR_QUERY_FAILED
@@ -394,7 +497,7 @@ func (s *DeviceService) AddDevice(c *gin.Context, userID int, param dto.DeviceAd
 	now := time.Now()
 
 	// 创建设备
-	newDevice, err := tx.Device.Create().
+	newDevice, err := setDeviceAttributes(tx.Device.Create(), param.Attributes).
 		SetSn(param.SN).
 		SetProductID(param.ProductID).
 		SetLicenseTypeID(param.LicenseTypeID).
@@ -435,21 +538,16 @@ func (s *DeviceService) AddDevice(c *gin.Context, userID int, param dto.DeviceAd
 		return resource.ERR_ADD_FAILED
 	}
 
+	InvalidateProductSummary(param.ProductID)
+
 	return resource.CODE_SUCCESS
 }
 
 // BatchAddDevices 批量添加设备
 func (s *DeviceService) BatchAddDevices(c *gin.Context, userID int, param dto.DeviceBatchAdd) resource.RspCode {
 	// 权限检查
-	if userID != 1 {
-		exist, err := dto.Client().ProductManager.Query().
-			Where(
-				productmanager.ProductIDEQ(param.ProductID),
-				productmanager.UserIDEQ(userID),
-			).Exist(c)
-		if err != nil || !exist {
-			return resource.ERR_NO_PERMISSION
-		}
+	if !authorize(c, userID, param.ProductID, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
 	}
 
 	// 检查产品是否存在
@@ -464,6 +562,11 @@ func (s *DeviceService) BatchAddDevices(c *gin.Context, userID int, param dto.De
 		return resource.ERR_PRODUCT_NOT_EXIST
 	}
 
+	// 检查来源订单和批次，未指定许可证类型时使用批次或订单的许可证类型
+	if code := applyDeviceSource(c, &param); code != resource.CODE_SUCCESS {
+		return code
+	}
+
 	// 检查许可证类型是否存在
 	licenseTypeExist, err := dto.Client().LicenseType.Query().
 		Where(
@@ -491,11 +594,33 @@ func (s *DeviceService) BatchAddDevices(c *gin.Context, userID int, param dto.De
 		return resource.ERR_INVALID_PARAMETER
 	}
 
-	// 检查SN是否重复
+	// 检查SN是否符合产品规则
+	invalidSNs, err := checkSNs(c, param.ProductID, validSNs)
+	if err != nil {
+		logger.Error("check sn rule failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if len(invalidSNs) > 0 {
+		logger.Info("batch add devices rejected by sn rule", zap.Any("invalid", invalidSNs))
+		return resource.ERR_DEVICE_SN_INVALID
+	}
+
+	// 检查每台设备的自定义属性
+	attrSchema, err := loadAttributeSchema(c, param.ProductID)
+	if err != nil {
+		logger.Error("load device attribute schema failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if failures := checkBatchAttributes(attrSchema, param, validSNs); len(failures) > 0 {
+		logger.Info("batch add devices rejected by attribute schema", zap.Any("invalid", failures))
+		return resource.ERR_DEVICE_ATTRIBUTE_INVALID
+	}
+
+	// 检查SN是否重复，SN全局唯一，需要检查所有产品的设备
 	existingSNs, err := dto.Client().Device.Query().
 		Where(device.SnIn(validSNs...)).
 		Select(device.FieldSn).
-		Strings(c)
+		Strings(viewer.SystemContext(c))
 	if err != nil {
 		logger.Error("check device sn failed", zap.Error(err))
 		return resource.ERR_QUERY_FAILED
@@ -524,7 +649,8 @@ func (s *DeviceService) BatchAddDevices(c *gin.Context, userID int, param dto.De
 	// 批量创建设备
 	bulk := make([]*ent.DeviceCreate, len(validSNs))
 	for i, sn := range validSNs {
-		bulk[i] = tx.Device.Create().
+		create := setDeviceAttributes(tx.Device.Create(), deviceAttributes(param, sn))
+		bulk[i] = setDeviceSource(create, param.OrderID, param.LotID).
 			SetSn(sn).
 			SetProductID(param.ProductID).
 			SetLicenseTypeID(param.LicenseTypeID).
@@ -550,8 +676,10 @@ func (s *DeviceService) BatchAddDevices(c *gin.Context, userID int, param dto.De
 		Module:    dto.ModuleDevice,
 		ProductID: param.ProductID,
 		DetailInfo: map[string]interface{}{
-			"count": len(devices),
-			"sns":   validSNs,
+			"count":    len(devices),
+			"sns":      validSNs,
+			"order_id": param.OrderID,
+			"lot_id":   param.LotID,
 		},
 	})
 
@@ -567,9 +695,82 @@ func (s *DeviceService) BatchAddDevices(c *gin.Context, userID int, param dto.De
 		return resource.ERR_ADD_FAILED
 	}
 
+	InvalidateProductSummary(param.ProductID)
+
 	return resource.CODE_SUCCESS
 }
 
+// ImportDevices 从CSV文件导入设备，第一列为SN，首行为表头"sn"时跳过；
+// 表头中SN之后的列为自定义属性名，属性值按产品的属性定义转换类型，空值忽略
+func (s *DeviceService) ImportDevices(c *gin.Context, userID int, param dto.DeviceImport, r io.Reader) resource.RspCode {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		logger.Error("parse device import file failed", zap.Error(err))
+		return resource.ERR_INVALID_PARAMETER
+	}
+
+	var sns []string
+	var attrNames []string
+	itemAttrs := make(map[string]map[string]interface{})
+	for i, record := range records {
+		if len(record) == 0 {
+			continue
+		}
+		sn := strings.TrimSpace(strings.TrimPrefix(record[0], "\ufeff"))
+		if i == 0 && strings.EqualFold(sn, "sn") {
+			for _, name := range record[1:] {
+				attrNames = append(attrNames, strings.TrimSpace(name))
+			}
+			continue
+		}
+		sns = append(sns, sn)
+		if len(attrNames) > 0 && len(record) > 1 {
+			itemAttrs[sn] = make(map[string]interface{})
+			for j, text := range record[1:] {
+				if j < len(attrNames) && attrNames[j] != "" && strings.TrimSpace(text) != "" {
+					itemAttrs[sn][attrNames[j]] = strings.TrimSpace(text)
+				}
+			}
+		}
+	}
+
+	// 按属性定义转换属性值类型
+	if len(itemAttrs) > 0 {
+		attrSchema, err := loadAttributeSchema(c, param.ProductID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return resource.ERR_PRODUCT_NOT_EXIST
+			}
+			logger.Error("load device attribute schema failed", zap.Error(err))
+			return resource.ERR_QUERY_FAILED
+		}
+		for sn, attrs := range itemAttrs {
+			for name, text := range attrs {
+				v, err := attrSchema.Coerce(name, text.(string))
+				if err != nil {
+					logger.Info("device import rejected by attribute schema", zap.String("sn", sn), zap.Error(err))
+					return resource.ERR_DEVICE_ATTRIBUTE_INVALID
+				}
+				attrs[name] = v
+			}
+		}
+	}
+
+	// 复用批量添加的权限、规则和重复检查
+	return s.BatchAddDevices(c, userID, dto.DeviceBatchAdd{
+		ProductID:      param.ProductID,
+		SNs:            sns,
+		LicenseTypeID:  param.LicenseTypeID,
+		OrderID:        param.OrderID,
+		LotID:          param.LotID,
+		OEMTag:         param.OEMTag,
+		Remark:         param.Remark,
+		ItemAttributes: itemAttrs,
+	})
+}
+
 // UpdateDevice 更新设备
 func (s *DeviceService) UpdateDevice(c *gin.Context, userID int, param dto.DeviceUpdate) resource.RspCode {
 	// 获取设备信息
@@ -587,15 +788,8 @@ func (s *DeviceService) UpdateDevice(c *gin.Context, userID int, param dto.Devic
 	}
 
 	// 权限检查
-	if userID != 1 {
-		exist, err := dto.Client().ProductManager.Query().
-			Where(
-				productmanager.ProductIDEQ(d.ProductID),
-				productmanager.UserIDEQ(userID),
-			).Exist(c)
-		if err != nil || !exist {
-			return resource.ERR_NO_PERMISSION
-		}
+	if !authorize(c, userID, d.ProductID, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
 	}
 
 	// 检查许可证类型是否存在
@@ -612,6 +806,13 @@ func (s *DeviceService) UpdateDevice(c *gin.Context, userID int, param dto.Devic
 		return resource.ERR_LICENSE_TYPE_NOT_EXIST
 	}
 
+	// 未传属性时保持不变，传入时整体替换
+	if param.Attributes != nil {
+		if code := checkDeviceAttributes(c, d.ProductID, param.Attributes); code != resource.CODE_SUCCESS {
+			return code
+		}
+	}
+
 	// 开启事务
 	tx, err := dto.Client().Tx(c)
 	if err != nil {
@@ -628,7 +829,11 @@ func (s *DeviceService) UpdateDevice(c *gin.Context, userID int, param dto.Devic
 	oldDevice := *d
 
 	// 更新设备
-	updatedDevice, err := tx.Device.UpdateOne(d).
+	update := tx.Device.UpdateOne(d)
+	if param.Attributes != nil {
+		update.SetAttributes(param.Attributes)
+	}
+	updatedDevice, err := update.
 		SetLicenseTypeID(param.LicenseTypeID).
 		SetOemTag(param.OEMTag).
 		SetRemark(param.Remark).
@@ -666,6 +871,8 @@ func (s *DeviceService) UpdateDevice(c *gin.Context, userID int, param dto.Devic
 		return resource.ERR_MOD_FAILED
 	}
 
+	InvalidateProductSummary(d.ProductID)
+
 	return resource.CODE_SUCCESS
 }
 
@@ -685,16 +892,8 @@ func (s *DeviceService) DeleteDevice(c *gin.Context, userID int, deviceID int) r
 	}
 
 	// 权限检查
-	if userID != 1 {
-		exist, err := dto.Client().ProductManager.Query().
-			Where(
-				productmanager.ProductIDEQ(d.ProductID),
-				productmanager.UserIDEQ(userID),
-				productmanager.RoleEQ(productmanager.RoleMain),
-			).Exist(c)
-		if err != nil || !exist {
-			return resource.ERR_NO_PERMISSION
-		}
+	if !authorize(c, userID, d.ProductID, dto.PermDeviceDelete) {
+		return resource.ERR_NO_PERMISSION
 	}
 
 	// 开启事务
@@ -713,8 +912,8 @@ func (s *DeviceService) DeleteDevice(c *gin.Context, userID int, deviceID int) r
 	// 保存设备信息用于审计日志
 	deviceInfo := *d
 
-	// 删除设备
-	err = tx.Device.DeleteOne(d).Exec(c)
+	// 移入回收站
+	err = tx.Device.UpdateOne(d).SetDeletedAt(time.Now()).Exec(c)
 	if err != nil {
 		logger.Error("delete device failed", zap.Error(err))
 		_ = tx.Rollback()
@@ -744,14 +943,21 @@ func (s *DeviceService) DeleteDevice(c *gin.Context, userID int, deviceID int) r
 		return resource.ERR_DEL_FAILED
 	}
 
+	InvalidateProductSummary(d.ProductID)
+
 	return resource.CODE_SUCCESS
 }
 
 // BatchUpdateLicenseType 批量更新设备许可证类型
 func (s *DeviceService) BatchUpdateLicenseType(c *gin.Context, userID int, param dto.DeviceBatchUpdateLicense) resource.RspCode {
-	if len(param.DeviceIDs) == 0 {
+	deviceIDs, code := resolveDeviceTargets(c, param.DeviceIDs, param.GroupID)
+	if code != resource.CODE_SUCCESS {
+		return code
+	}
+	if len(deviceIDs) == 0 {
 		return resource.ERR_INVALID_PARAMETER
 	}
+	param.DeviceIDs = deviceIDs
 
 	// 获取设备列表
 	devices, err := dto.Client().Device.Query().
@@ -777,15 +983,8 @@ func (s *DeviceService) BatchUpdateLicenseType(c *gin.Context, userID int, param
 	// 检查权限和许可证类型
 	for productID := range devicesByProduct {
 		// 权限检查
-		if userID != 1 {
-			exist, err := dto.Client().ProductManager.Query().
-				Where(
-					productmanager.ProductIDEQ(productID),
-					productmanager.UserIDEQ(userID),
-				).Exist(c)
-			if err != nil || !exist {
-				return resource.ERR_NO_PERMISSION
-			}
+		if !authorize(c, userID, productID, dto.PermDeviceWrite) {
+			return resource.ERR_NO_PERMISSION
 		}
 
 		// 检查许可证类型是否存在
@@ -861,6 +1060,8 @@ func (s *DeviceService) BatchUpdateLicenseType(c *gin.Context, userID int, param
 		return resource.ERR_MOD_FAILED
 	}
 
+	invalidateDeviceSummaries(devices)
+
 	return resource.CODE_SUCCESS
 }
 
@@ -882,21 +1083,20 @@ func (s *DeviceService) GetActivationFile(c *gin.Context, sn string) ([]byte, re
 		return nil, resource.ERR_QUERY_FAILED
 	}
 
-	// 获取许可证类型对应的功能编码
-	features, err := dto.Client().LicenseType.Query().
-		Where(licensetype.IDEQ(device.LicenseTypeID)).
-		QueryFeatures().
-		All(c)
+	// 停用或报废的设备拒绝下发激活文件
+	if deviceRefusesActivation(device.State) {
+		return nil, resource.ERR_DEVICE_STATE_INACTIVE
+	}
+
+	// 许可证类型的功能合并设备的功能附加项
+	features, _, err := deviceFeatures(c, device)
 	if err != nil {
 		logger.Error("query features failed", zap.Error(err))
 		return nil, resource.ERR_QUERY_FAILED
 	}
 
 	// 提取功能编码
-	featureCodes := make([]string, 0, len(features))
-	for _, f := range features {
-		featureCodes = append(featureCodes, f.FeatureCode)
-	}
+	featureCodes := activeFeatureCodes(features)
 
 	// 生成激活文件内容
 	activationData := dto.ActivationData{
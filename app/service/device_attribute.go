@@ -0,0 +1,432 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/validate"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/gin-gonic/gin"
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+)
+
+const (
+	deviceExportLimit = 50000 // 单次最多导出的设备数量
+	deviceExportChunk = 1000  // 导出时每次查询的设备数量
+)
+
+// loadAttributeSchema 加载产品的设备属性定义，未定义时返回开放的Schema
+func loadAttributeSchema(ctx context.Context, productID int) (*validate.AttributeSchema, error) {
+	p, err := dto.Client().Product.Get(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(p.DeviceAttributeSchema) == "" {
+		return validate.OpenAttributeSchema(), nil
+	}
+	return validate.ParseAttributeSchema([]byte(p.DeviceAttributeSchema))
+}
+
+// checkDeviceAttributes 按产品的属性定义校验单台设备的属性
+func checkDeviceAttributes(ctx context.Context, productID int, attrs map[string]interface{}) resource.RspCode {
+	schema, err := loadAttributeSchema(ctx, productID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_PRODUCT_NOT_EXIST
+		}
+		logger.Error("load device attribute schema failed", zap.Error(err), zap.Int("product_id", productID))
+		return resource.ERR_QUERY_FAILED
+	}
+	if err := schema.Validate(attrs); err != nil {
+		logger.Info("device attributes rejected", zap.Int("product_id", productID), zap.Error(err))
+		return resource.ERR_DEVICE_ATTRIBUTE_INVALID
+	}
+	return resource.CODE_SUCCESS
+}
+
+// deviceAttributes 批量添加时单台设备的属性：公共属性加上按SN指定的属性
+func deviceAttributes(param dto.DeviceBatchAdd, sn string) map[string]interface{} {
+	item := param.ItemAttributes[sn]
+	if len(param.Attributes) == 0 && len(item) == 0 {
+		return nil
+	}
+	attrs := make(map[string]interface{}, len(param.Attributes)+len(item))
+	for k, v := range param.Attributes {
+		attrs[k] = v
+	}
+	for k, v := range item {
+		attrs[k] = v
+	}
+	return attrs
+}
+
+// checkBatchAttributes 校验批量添加的每台设备的属性，返回不合规的SN及原因
+func checkBatchAttributes(schema *validate.AttributeSchema, param dto.DeviceBatchAdd, sns []string) []dto.JobItemError {
+	var failures []dto.JobItemError
+	for _, sn := range sns {
+		if err := schema.Validate(deviceAttributes(param, sn)); err != nil {
+			failures = append(failures, dto.JobItemError{Item: sn, Reason: err.Error()})
+		}
+	}
+	return failures
+}
+
+// setDeviceAttributes 设置新设备的属性，没有属性时保持为空
+func setDeviceAttributes(create *ent.DeviceCreate, attrs map[string]interface{}) *ent.DeviceCreate {
+	if len(attrs) > 0 {
+		create.SetAttributes(attrs)
+	}
+	return create
+}
+
+// attrFilterPattern 属性条件表达式：属性名、比较运算符和值
+var attrFilterPattern = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]{0,63})\s*(>=|<=|!=|=|>|<)\s*(.*)$`)
+
+// attrFilter 属性筛选条件
+type attrFilter struct {
+	key   string
+	op    string
+	value string
+}
+
+// parseAttrFilter 解析属性条件表达式，如mac=00:11:22:33:44:55、board_rev>=2
+func parseAttrFilter(expr string) (attrFilter, bool) {
+	m := attrFilterPattern.FindStringSubmatch(strings.TrimSpace(expr))
+	if m == nil {
+		return attrFilter{}, false
+	}
+	return attrFilter{key: m[1], op: m[2], value: strings.TrimSpace(m[3])}, true
+}
+
+// checkAttrFilters 检查属性条件表达式是否都合法
+func checkAttrFilters(exprs []string) bool {
+	for _, expr := range exprs {
+		if _, ok := parseAttrFilter(expr); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// predicate 构建属性条件：等于和不等于按文本比较，因此数字和布尔值也可以匹配；
+// 范围比较在值为数字时按数值比较，否则按文本比较。没有该属性的设备不满足任何条件
+func (f attrFilter) predicate() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		col := s.C(device.FieldAttributes)
+		path := sqljson.Path(f.key)
+		var arg interface{} = f.value
+		unquote := sqljson.Unquote(true)
+		if f.op != "=" && f.op != "!=" {
+			if n, err := strconv.ParseFloat(f.value, 64); err == nil {
+				arg = n
+				unquote = sqljson.Unquote(false)
+			}
+		}
+		switch f.op {
+		case "=":
+			s.Where(sqljson.ValueEQ(col, arg, path, unquote))
+		case "!=":
+			s.Where(sqljson.ValueNEQ(col, arg, path, unquote))
+		case ">":
+			s.Where(sqljson.ValueGT(col, arg, path, unquote))
+		case ">=":
+			s.Where(sqljson.ValueGTE(col, arg, path, unquote))
+		case "<":
+			s.Where(sqljson.ValueLT(col, arg, path, unquote))
+		case "<=":
+			s.Where(sqljson.ValueLTE(col, arg, path, unquote))
+		}
+	})
+}
+
+// GetAttributeSchema 获取产品的设备属性定义
+func (s *DeviceService) GetAttributeSchema(c *gin.Context, userID, productID int) (*dto.DeviceAttributeSchemaInfo, resource.RspCode) {
+	if !authorize(c, userID, productID, dto.PermProductView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	p, err := dto.Client().Product.Get(c, productID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, resource.ERR_PRODUCT_NOT_EXIST
+		}
+		logger.Error("query product failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	info := &dto.DeviceAttributeSchemaInfo{ProductID: p.ID, Properties: []string{}}
+	if p.DeviceAttributeSchema != "" {
+		schema, err := validate.ParseAttributeSchema([]byte(p.DeviceAttributeSchema))
+		if err != nil {
+			logger.Error("parse device attribute schema failed", zap.Error(err), zap.Int("product_id", p.ID))
+			return nil, resource.ERR_ATTRIBUTE_SCHEMA_INVALID
+		}
+		info.Schema = []byte(p.DeviceAttributeSchema)
+		info.Properties = schema.Names()
+	}
+	return info, resource.CODE_SUCCESS
+}
+
+// SetAttributeSchema 设置产品的设备属性定义，属性存储在设备的JSON字段中，修改定义不需要数据库迁移；
+// 已有设备不会按新定义重新校验，在下次修改属性时校验
+func (s *DeviceService) SetAttributeSchema(c *gin.Context, userID int, param dto.DeviceAttributeSchema) resource.RspCode {
+	if !authorize(c, userID, param.ProductID, dto.PermProductEdit) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	raw := strings.TrimSpace(string(param.Schema))
+	if raw == "null" {
+		raw = ""
+	}
+	if raw != "" {
+		if _, err := validate.ParseAttributeSchema([]byte(raw)); err != nil {
+			logger.Info("device attribute schema rejected", zap.Error(err))
+			return resource.ERR_ATTRIBUTE_SCHEMA_INVALID
+		}
+	}
+
+	old, err := dto.Client().Product.Get(c, param.ProductID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_PRODUCT_NOT_EXIST
+		}
+		logger.Error("query product failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := tx.Product.UpdateOneID(param.ProductID).SetDeviceAttributeSchema(raw).Exec(c); err != nil {
+		logger.Error("update device attribute schema failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_MOD_FAILED
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionUpdate,
+		Module:    dto.ModuleProduct,
+		ProductID: param.ProductID,
+		DetailInfo: map[string]interface{}{
+			"operation":  "device_attribute_schema",
+			"old_schema": old.DeviceAttributeSchema,
+			"new_schema": raw,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// ExportDevices 按设备列表的筛选条件导出CSV，只导出用户管理的产品中的设备；
+// 自定义属性按列展开，列名为"attr."加属性名；features为合并附加项后写入激活文件的功能编码
+func (s *DeviceService) ExportDevices(c *gin.Context, userID int, param dto.DeviceExport) ([]byte, string, resource.RspCode) {
+	cond := param.DeviceCondition
+	if param.SavedFilterID > 0 {
+		saved, code := loadDeviceCondition(c, userID, param.SavedFilterID)
+		if code != resource.CODE_SUCCESS {
+			return nil, "", code
+		}
+		cond = *saved
+	}
+	if !checkAttrFilters(cond.Attrs) {
+		return nil, "", resource.ERR_INVALID_PARAMETER
+	}
+	// 查询身份只限制产品范围，还需按查看设备的权限和API令牌的授权范围确定可查看的产品
+	productIDs, code := visibleProductIDs(c, userID, cond.ProductID, dto.PermDeviceView)
+	if code != resource.CODE_SUCCESS {
+		return nil, "", code
+	}
+
+	q := applyDeviceCondition(dto.Client().Device.Query(), cond)
+	if productIDs != nil {
+		q = q.Where(device.ProductIDIn(productIDs...))
+	}
+	total, err := q.Clone().Count(c)
+	if err != nil {
+		logger.Error("count export devices failed", zap.Error(err))
+		return nil, "", resource.ERR_QUERY_FAILED
+	}
+	if total > deviceExportLimit {
+		return nil, "", resource.ERR_EXPORT_TOO_MANY
+	}
+
+	// 按ID分块读取，避免一次加载全部关联数据
+	devices := make([]*ent.Device, 0, total)
+	lastID := 0
+	for {
+		chunk, err := q.Clone().
+			Where(device.IDGT(lastID)).
+			Order(ent.Asc(device.FieldID)).
+			Limit(deviceExportChunk).
+			WithProduct().
+			WithLicenseType().
+			WithCustomer().
+			WithFeatureOverrides(func(q *ent.DeviceFeatureOverrideQuery) {
+				q.WithFeature()
+			}).
+			All(c)
+		if err != nil {
+			logger.Error("query export devices failed", zap.Error(err))
+			return nil, "", resource.ERR_QUERY_FAILED
+		}
+		devices = append(devices, chunk...)
+		if len(chunk) < deviceExportChunk {
+			break
+		}
+		lastID = chunk[len(chunk)-1].ID
+	}
+
+	// 属性列：指定产品时包含属性定义中的全部属性，再加上设备中出现的其它属性
+	attrCols := make(map[string]bool)
+	if cond.ProductID > 0 {
+		schema, err := loadAttributeSchema(c, cond.ProductID)
+		if err != nil && !ent.IsNotFound(err) {
+			logger.Warn("load device attribute schema failed", zap.Error(err))
+		}
+		if schema != nil {
+			for _, name := range schema.Names() {
+				attrCols[name] = true
+			}
+		}
+	}
+	for _, d := range devices {
+		for name := range d.Attributes {
+			attrCols[name] = true
+		}
+	}
+	attrNames := make([]string, 0, len(attrCols))
+	for name := range attrCols {
+		attrNames = append(attrNames, name)
+	}
+	sort.Strings(attrNames)
+
+	// 许可证类型的有效功能（包含继承的功能），与设备的附加项合并后导出
+	ltProductIDs := make([]int, 0)
+	seenProduct := make(map[int]bool)
+	for _, d := range devices {
+		if d.LicenseTypeID > 0 && !seenProduct[d.ProductID] {
+			seenProduct[d.ProductID] = true
+			ltProductIDs = append(ltProductIDs, d.ProductID)
+		}
+	}
+	ltFeatures := make(map[int][]*ent.ProductFeature)
+	if len(ltProductIDs) > 0 {
+		tree, err := loadLicenseTypeTree(c, dto.Client(), ltProductIDs...)
+		if err != nil {
+			logger.Error("query export license type features failed", zap.Error(err))
+			return nil, "", resource.ERR_QUERY_FAILED
+		}
+		for id := range tree.types {
+			ltFeatures[id] = tree.effectiveFeatures(id)
+		}
+	}
+	now := time.Now()
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	header := []string{"sn", "product_code", "license_type", "state", "customer", "order_id", "lot_id",
+		"oem_tag", "remark", "shipped_at", "activated_at", "warranty_end_at", "created_at", "features", "feature_add_ons"}
+	for _, name := range attrNames {
+		header = append(header, "attr."+name)
+	}
+	_ = w.Write(header)
+	for _, d := range devices {
+		info := toDeviceInfo(d)
+		row := []string{
+			info.SN,
+			info.ProductCode,
+			info.LicenseTypeCode,
+			info.State,
+			info.CustomerName,
+			formatOptionalID(info.OrderID),
+			formatOptionalID(info.LotID),
+			info.OEMTag,
+			info.Remark,
+			formatOptionalTime(info.ShippedAt),
+			formatOptionalTime(info.ActivatedAt),
+			formatOptionalTime(info.WarrantyEndAt),
+			info.CreatedAt.Format(time.RFC3339),
+			strings.Join(activeFeatureCodes(mergeDeviceFeatures(ltFeatures[d.LicenseTypeID], d.Edges.FeatureOverrides, now)), ";"),
+			formatAddOns(d.Edges.FeatureOverrides, now),
+		}
+		for _, name := range attrNames {
+			row = append(row, formatAttributeValue(d.Attributes[name]))
+		}
+		_ = w.Write(row)
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		logger.Error("write csv failed", zap.Error(err))
+		return nil, "", resource.ERR_OPERATION_FAILED
+	}
+
+	filename := "devices_" + time.Now().Format("20060102150405") + ".csv"
+	return buf.Bytes(), filename, resource.CODE_SUCCESS
+}
+
+func formatOptionalID(id int) string {
+	if id <= 0 {
+		return ""
+	}
+	return strconv.Itoa(id)
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// formatAttributeValue 属性值转为文本，数字不使用科学计数法
+func formatAttributeValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case bool:
+		return strconv.FormatBool(val)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	}
+	raw, err := jsoniter.MarshalToString(v)
+	if err != nil {
+		return ""
+	}
+	return raw
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"testing"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+)
+
+func TestParseAttrFilter(t *testing.T) {
+	cases := []struct {
+		expr string
+		want attrFilter
+		ok   bool
+	}{
+		{"mac=00:11:22:33:44:55", attrFilter{key: "mac", op: "=", value: "00:11:22:33:44:55"}, true},
+		{" board_rev >= 2 ", attrFilter{key: "board_rev", op: ">=", value: "2"}, true},
+		{"region!=cn", attrFilter{key: "region", op: "!=", value: "cn"}, true},
+		{"note=a=b", attrFilter{key: "note", op: "=", value: "a=b"}, true},
+		{"rev<", attrFilter{key: "rev", op: "<", value: ""}, true},
+		{"1abc=x", attrFilter{}, false},
+		{"mac", attrFilter{}, false},
+		{"a.b=1", attrFilter{}, false},
+	}
+	for _, tc := range cases {
+		got, ok := parseAttrFilter(tc.expr)
+		if ok != tc.ok || got != tc.want {
+			t.Errorf("parseAttrFilter(%q) = %+v, %v, want %+v, %v", tc.expr, got, ok, tc.want, tc.ok)
+		}
+	}
+	if checkAttrFilters([]string{"a=1", "b"}) {
+		t.Error("checkAttrFilters accepted an invalid expression")
+	}
+}
+
+func TestDeviceAttributes(t *testing.T) {
+	param := dto.DeviceBatchAdd{
+		Attributes: map[string]interface{}{"region": "eu", "rev": 1.0},
+		ItemAttributes: map[string]map[string]interface{}{
+			"SN002": {"rev": 2.0},
+		},
+	}
+	if got := deviceAttributes(param, "SN001"); got["region"] != "eu" || got["rev"] != 1.0 {
+		t.Errorf("SN001 attributes = %v", got)
+	}
+	if got := deviceAttributes(param, "SN002"); got["region"] != "eu" || got["rev"] != 2.0 {
+		t.Errorf("SN002 attributes = %v", got)
+	}
+	if param.Attributes["rev"] != 1.0 {
+		t.Error("common attributes modified")
+	}
+	if got := deviceAttributes(dto.DeviceBatchAdd{}, "SN001"); got != nil {
+		t.Errorf("empty attributes = %v, want nil", got)
+	}
+}
@@ -0,0 +1,414 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicefeatureoverride"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// addOnActive 附加项是否在有效期内
+func addOnActive(o *ent.DeviceFeatureOverride, now time.Time) bool {
+	return o.ExpiresAt == nil || o.ExpiresAt.After(now)
+}
+
+// mergeDeviceFeatures 合并许可证类型的功能和设备的附加项：有效的grant追加功能，
+// 有效的deny禁用许可证类型中的功能，过期的附加项忽略；附加项需要预先加载功能
+func mergeDeviceFeatures(ltFeatures []*ent.ProductFeature, addOns []*ent.DeviceFeatureOverride, now time.Time) []dto.DeviceFeature {
+	features := make([]dto.DeviceFeature, 0, len(ltFeatures)+len(addOns))
+	index := make(map[int]int, len(ltFeatures))
+	for _, f := range ltFeatures {
+		index[f.ID] = len(features)
+		features = append(features, dto.DeviceFeature{
+			FeatureID:   f.ID,
+			FeatureCode: f.FeatureCode,
+			FeatureName: f.FeatureName,
+			Source:      dto.FeatureSourceLicenseType,
+			Active:      true,
+		})
+	}
+
+	for _, o := range addOns {
+		// 功能已删除
+		if o.Edges.Feature == nil || !addOnActive(o, now) {
+			continue
+		}
+		i, fromLicenseType := index[o.FeatureID]
+		switch o.Effect {
+		case devicefeatureoverride.EffectDeny:
+			if fromLicenseType {
+				features[i].Active = false
+				features[i].AddOnID = o.ID
+				features[i].ExpiresAt = o.ExpiresAt
+			}
+		case devicefeatureoverride.EffectGrant:
+			// 许可证类型已包含该功能时以许可证类型为准
+			if fromLicenseType {
+				continue
+			}
+			index[o.FeatureID] = len(features)
+			features = append(features, dto.DeviceFeature{
+				FeatureID:   o.FeatureID,
+				FeatureCode: o.Edges.Feature.FeatureCode,
+				FeatureName: o.Edges.Feature.FeatureName,
+				Source:      dto.FeatureSourceAddOn,
+				Active:      true,
+				AddOnID:     o.ID,
+				ExpiresAt:   o.ExpiresAt,
+			})
+		}
+	}
+	return features
+}
+
+// activeFeatureCodes 写入激活文件的功能编码
+func activeFeatureCodes(features []dto.DeviceFeature) []string {
+	codes := make([]string, 0, len(features))
+	for _, f := range features {
+		if f.Active {
+			codes = append(codes, f.FeatureCode)
+		}
+	}
+	return codes
+}
+
+// licenseTypeFeatures 查询许可证类型的有效功能（包含沿父类型继承的功能），未分配许可证类型时为空
+func licenseTypeFeatures(ctx context.Context, licenseTypeID int) ([]*ent.ProductFeature, error) {
+	if licenseTypeID <= 0 {
+		return nil, nil
+	}
+	lt, err := dto.Client().LicenseType.Get(ctx, licenseTypeID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	tree, err := loadLicenseTypeTree(ctx, dto.Client(), lt.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	return tree.effectiveFeatures(licenseTypeID), nil
+}
+
+// deviceAddOns 查询设备的功能附加项（包含已过期的）
+func deviceAddOns(ctx context.Context, deviceID int) ([]*ent.DeviceFeatureOverride, error) {
+	return dto.Client().DeviceFeatureOverride.Query().
+		Where(devicefeatureoverride.DeviceIDEQ(deviceID)).
+		WithFeature().
+		Order(ent.Asc(devicefeatureoverride.FieldID)).
+		All(ctx)
+}
+
+// addOnViolations 按change修改设备的附加项后，校验设备启用的功能是否满足依赖和互斥关系；
+// 只返回修改引入的问题，修改前已存在的（如关系是后来添加的）不影响本次操作
+func addOnViolations(ctx context.Context, d *ent.Device, change func([]*ent.DeviceFeatureOverride) []*ent.DeviceFeatureOverride) ([]dto.FeatureViolation, error) {
+	ltFeatures, err := licenseTypeFeatures(ctx, d.LicenseTypeID)
+	if err != nil {
+		return nil, err
+	}
+	addOns, err := deviceAddOns(ctx, d.ID)
+	if err != nil {
+		return nil, err
+	}
+	g, err := loadFeatureGraph(ctx, dto.Client(), d.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	existing := make(map[dto.FeatureViolation]bool)
+	for _, v := range g.violations(activeFeatureIDs(mergeDeviceFeatures(ltFeatures, addOns, now))) {
+		existing[v] = true
+	}
+	changed := append([]*ent.DeviceFeatureOverride(nil), addOns...)
+	var result []dto.FeatureViolation
+	for _, v := range g.violations(activeFeatureIDs(mergeDeviceFeatures(ltFeatures, change(changed), now))) {
+		if !existing[v] {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// deviceFeatures 计算设备当前的功能
+func deviceFeatures(ctx context.Context, d *ent.Device) ([]dto.DeviceFeature, []*ent.DeviceFeatureOverride, error) {
+	ltFeatures, err := licenseTypeFeatures(ctx, d.LicenseTypeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	addOns, err := deviceAddOns(ctx, d.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return mergeDeviceFeatures(ltFeatures, addOns, time.Now()), addOns, nil
+}
+
+func toAddOnInfo(o *ent.DeviceFeatureOverride, now time.Time) dto.DeviceFeatureAddOnInfo {
+	info := dto.DeviceFeatureAddOnInfo{
+		ID:        o.ID,
+		DeviceID:  o.DeviceID,
+		FeatureID: o.FeatureID,
+		Effect:    o.Effect.String(),
+		ExpiresAt: o.ExpiresAt,
+		Expired:   !addOnActive(o, now),
+		Remark:    o.Remark,
+		CreatedBy: o.CreatedBy,
+		CreatedAt: o.CreatedAt,
+		UpdatedBy: o.UpdatedBy,
+		UpdatedAt: o.UpdatedAt,
+	}
+	if o.Edges.Feature != nil {
+		info.FeatureCode = o.Edges.Feature.FeatureCode
+		info.FeatureName = o.Edges.Feature.FeatureName
+	}
+	return info
+}
+
+// formatAddOns 导出时的附加项文本，如"+FEAT_A@2026-12-31;-FEAT_B"，只包含有效的附加项
+func formatAddOns(addOns []*ent.DeviceFeatureOverride, now time.Time) string {
+	parts := make([]string, 0, len(addOns))
+	for _, o := range addOns {
+		if o.Edges.Feature == nil || !addOnActive(o, now) {
+			continue
+		}
+		part := "+"
+		if o.Effect == devicefeatureoverride.EffectDeny {
+			part = "-"
+		}
+		part += o.Edges.Feature.FeatureCode
+		if o.ExpiresAt != nil {
+			part += "@" + o.ExpiresAt.Format("2006-01-02")
+		}
+		parts = append(parts, part)
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ";")
+}
+
+// SetFeatureAddOn 设置设备的功能附加项，同一功能已有附加项时覆盖
+func (s *DeviceService) SetFeatureAddOn(c *gin.Context, userID int, param dto.DeviceFeatureAddOn) ([]dto.FeatureViolation, resource.RspCode) {
+	d, err := dto.Client().Device.Get(c, param.DeviceID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, resource.ERR_DEVICE_NOT_EXIST
+		}
+		logger.Error("query device failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if !authorize(c, userID, d.ProductID, dto.PermDeviceWrite) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+	now := time.Now()
+	if param.ExpiresAt != nil && !param.ExpiresAt.After(now) {
+		return nil, resource.ERR_INVALID_PARAMETER
+	}
+
+	// 功能需属于设备所在产品
+	feature, err := dto.Client().ProductFeature.Query().
+		Where(
+			productfeature.IDEQ(param.FeatureID),
+			productfeature.ProductIDEQ(d.ProductID),
+		).Only(c)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, resource.ERR_FEATURE_NOT_EXIST
+		}
+		logger.Error("query feature failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	old, err := dto.Client().DeviceFeatureOverride.Query().
+		Where(
+			devicefeatureoverride.DeviceIDEQ(d.ID),
+			devicefeatureoverride.FeatureIDEQ(feature.ID),
+		).Only(c)
+	if err != nil && !ent.IsNotFound(err) {
+		logger.Error("query device feature add-on failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	// 设置后设备的功能组合需满足依赖和互斥关系
+	candidate := &ent.DeviceFeatureOverride{
+		DeviceID:  d.ID,
+		FeatureID: feature.ID,
+		Effect:    devicefeatureoverride.Effect(param.Effect),
+		ExpiresAt: param.ExpiresAt,
+	}
+	candidate.Edges.Feature = feature
+	violations, err := addOnViolations(c, d, func(addOns []*ent.DeviceFeatureOverride) []*ent.DeviceFeatureOverride {
+		for i, o := range addOns {
+			if o.FeatureID == feature.ID {
+				addOns[i] = candidate
+				return addOns
+			}
+		}
+		return append(addOns, candidate)
+	})
+	if err != nil {
+		logger.Error("check device features failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if len(violations) > 0 {
+		return violations, resource.ERR_FEATURE_CONSTRAINT
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return nil, resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	var saved *ent.DeviceFeatureOverride
+	if old == nil {
+		saved, err = tx.DeviceFeatureOverride.Create().
+			SetDeviceID(d.ID).
+			SetFeatureID(feature.ID).
+			SetEffect(devicefeatureoverride.Effect(param.Effect)).
+			SetNillableExpiresAt(param.ExpiresAt).
+			SetRemark(param.Remark).
+			SetCreatedBy(userID).
+			SetUpdatedBy(userID).
+			Save(c)
+	} else {
+		update := tx.DeviceFeatureOverride.UpdateOne(old).
+			SetEffect(devicefeatureoverride.Effect(param.Effect)).
+			SetRemark(param.Remark).
+			SetUpdatedBy(userID)
+		if param.ExpiresAt != nil {
+			update.SetExpiresAt(*param.ExpiresAt)
+		} else {
+			update.ClearExpiresAt()
+		}
+		saved, err = update.Save(c)
+	}
+	if err != nil {
+		logger.Error("save device feature add-on failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_MOD_FAILED
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionUpdate,
+		Module:    dto.ModuleDevice,
+		ProductID: d.ProductID,
+		DetailInfo: map[string]interface{}{
+			"operation":    "feature_add_on",
+			"sn":           d.Sn,
+			"feature_code": feature.FeatureCode,
+			"old_add_on":   old,
+			"new_add_on":   saved,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return nil, resource.ERR_MOD_FAILED
+	}
+
+	return nil, resource.CODE_SUCCESS
+}
+
+// DeleteFeatureAddOn 删除设备的功能附加项
+func (s *DeviceService) DeleteFeatureAddOn(c *gin.Context, userID, addOnID int) ([]dto.FeatureViolation, resource.RspCode) {
+	o, err := dto.Client().DeviceFeatureOverride.Query().
+		Where(devicefeatureoverride.IDEQ(addOnID)).
+		WithDevice().
+		WithFeature().
+		Only(c)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, resource.ERR_FEATURE_ADDON_NOT_EXIST
+		}
+		logger.Error("query device feature add-on failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	// 设备已删除时附加项随设备一起清理
+	if o.Edges.Device == nil {
+		return nil, resource.ERR_DEVICE_NOT_EXIST
+	}
+	if !authorize(c, userID, o.Edges.Device.ProductID, dto.PermDeviceWrite) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	// 删除后设备的功能组合需满足依赖和互斥关系，如删除被其他功能依赖的授予
+	violations, err := addOnViolations(c, o.Edges.Device, func(addOns []*ent.DeviceFeatureOverride) []*ent.DeviceFeatureOverride {
+		rest := addOns[:0]
+		for _, a := range addOns {
+			if a.ID != o.ID {
+				rest = append(rest, a)
+			}
+		}
+		return rest
+	})
+	if err != nil {
+		logger.Error("check device features failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if len(violations) > 0 {
+		return violations, resource.ERR_FEATURE_CONSTRAINT
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return nil, resource.ERR_DEL_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := tx.DeviceFeatureOverride.DeleteOneID(o.ID).Exec(c); err != nil {
+		logger.Error("delete device feature add-on failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_DEL_FAILED
+	}
+
+	info := toAddOnInfo(o, time.Now())
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionDelete,
+		Module:    dto.ModuleDevice,
+		ProductID: o.Edges.Device.ProductID,
+		DetailInfo: map[string]interface{}{
+			"operation": "feature_add_on",
+			"sn":        o.Edges.Device.Sn,
+			"add_on":    info,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return nil, resource.ERR_DEL_FAILED
+	}
+
+	return nil, resource.CODE_SUCCESS
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicefeatureoverride"
+)
+
+func testAddOn(id int, f *ent.ProductFeature, effect devicefeatureoverride.Effect, expiresAt *time.Time) *ent.DeviceFeatureOverride {
+	o := &ent.DeviceFeatureOverride{ID: id, FeatureID: f.ID, Effect: effect, ExpiresAt: expiresAt}
+	o.Edges.Feature = f
+	return o
+}
+
+func TestMergeDeviceFeatures(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	later := now.Add(24 * time.Hour)
+	earlier := now.Add(-time.Hour)
+	a := &ent.ProductFeature{ID: 1, FeatureCode: "A"}
+	b := &ent.ProductFeature{ID: 2, FeatureCode: "B"}
+	c := &ent.ProductFeature{ID: 3, FeatureCode: "C"}
+	d := &ent.ProductFeature{ID: 4, FeatureCode: "D"}
+
+	addOns := []*ent.DeviceFeatureOverride{
+		testAddOn(10, b, devicefeatureoverride.EffectDeny, &later),
+		testAddOn(11, c, devicefeatureoverride.EffectGrant, nil),
+		testAddOn(12, d, devicefeatureoverride.EffectGrant, &earlier), // 已过期
+		testAddOn(13, a, devicefeatureoverride.EffectGrant, nil),      // 许可证类型已包含
+	}
+	deleted := &ent.DeviceFeatureOverride{ID: 14, FeatureID: 5, Effect: devicefeatureoverride.EffectGrant}
+	addOns = append(addOns, deleted)
+
+	features := mergeDeviceFeatures([]*ent.ProductFeature{a, b}, addOns, now)
+	if got, want := activeFeatureCodes(features), []string{"A", "C"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("active codes = %v, want %v", got, want)
+	}
+	if len(features) != 3 {
+		t.Fatalf("features = %+v", features)
+	}
+	if f := features[0]; f.Source != dto.FeatureSourceLicenseType || f.AddOnID != 0 {
+		t.Errorf("A = %+v", f)
+	}
+	if f := features[1]; f.Active || f.AddOnID != 10 || f.ExpiresAt == nil {
+		t.Errorf("B = %+v", f)
+	}
+	if f := features[2]; f.Source != dto.FeatureSourceAddOn || f.AddOnID != 11 {
+		t.Errorf("C = %+v", f)
+	}
+
+	// deny到期后许可证类型的功能恢复
+	features = mergeDeviceFeatures([]*ent.ProductFeature{a, b}, addOns, later)
+	if got, want := activeFeatureCodes(features), []string{"A", "B", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("active codes after expiry = %v, want %v", got, want)
+	}
+}
+
+func TestFormatAddOns(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	expires := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+	addOns := []*ent.DeviceFeatureOverride{
+		testAddOn(1, &ent.ProductFeature{ID: 1, FeatureCode: "B"}, devicefeatureoverride.EffectDeny, nil),
+		testAddOn(2, &ent.ProductFeature{ID: 2, FeatureCode: "A"}, devicefeatureoverride.EffectGrant, &expires),
+		testAddOn(3, &ent.ProductFeature{ID: 3, FeatureCode: "C"}, devicefeatureoverride.EffectGrant, &earlier),
+	}
+	if got, want := formatAddOns(addOns, now), "+A@2026-12-31;-B"; got != want {
+		t.Errorf("formatAddOns = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicesavedfilter"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+)
+
+// DeviceFilterService 设备筛选器服务，筛选器只对创建者可见
+type DeviceFilterService struct{}
+
+// NewDeviceFilterService 创建设备筛选器服务实例
+func NewDeviceFilterService() *DeviceFilterService {
+	return &DeviceFilterService{}
+}
+
+// toDeviceFilterInfo 转换为筛选器DTO
+func toDeviceFilterInfo(f *ent.DeviceSavedFilter) (dto.DeviceFilterInfo, error) {
+	info := dto.DeviceFilterInfo{
+		ID:        f.ID,
+		Name:      f.Name,
+		CreatedAt: f.CreatedAt,
+		UpdatedAt: f.UpdatedAt,
+	}
+	err := jsoniter.UnmarshalFromString(f.Condition, &info.Condition)
+	return info, err
+}
+
+// loadDeviceCondition 加载用户保存的筛选条件
+func loadDeviceCondition(c *gin.Context, userID, filterID int) (*dto.DeviceCondition, resource.RspCode) {
+	f, err := dto.Client().DeviceSavedFilter.Query().
+		Where(
+			devicesavedfilter.IDEQ(filterID),
+			devicesavedfilter.UserIDEQ(userID),
+		).Only(c)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, resource.ERR_DEVICE_FILTER_NOT_EXIST
+		}
+		logger.Error("query device filter failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	info, err := toDeviceFilterInfo(f)
+	if err != nil {
+		logger.Error("unmarshal device filter failed", zap.Error(err))
+		return nil, resource.ERR_OPERATION_FAILED
+	}
+	return &info.Condition, resource.CODE_SUCCESS
+}
+
+// ListDeviceFilters 获取用户保存的筛选器
+func (s *DeviceFilterService) ListDeviceFilters(c *gin.Context, userID int) ([]dto.DeviceFilterInfo, resource.RspCode) {
+	filters, err := dto.Client().DeviceSavedFilter.Query().
+		Where(devicesavedfilter.UserIDEQ(userID)).
+		Order(ent.Asc(devicesavedfilter.FieldName)).
+		All(c)
+	if err != nil {
+		logger.Error("query device filters failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	result := make([]dto.DeviceFilterInfo, 0, len(filters))
+	for _, f := range filters {
+		info, err := toDeviceFilterInfo(f)
+		if err != nil {
+			logger.Error("unmarshal device filter failed", zap.Error(err))
+			continue
+		}
+		result = append(result, info)
+	}
+
+	return result, resource.CODE_SUCCESS
+}
+
+// SaveDeviceFilter 新增或更新筛选器
+func (s *DeviceFilterService) SaveDeviceFilter(c *gin.Context, userID int, param dto.SaveDeviceFilter) resource.RspCode {
+	if !checkAttrFilters(param.Condition.Attrs) {
+		return resource.ERR_INVALID_PARAMETER
+	}
+
+	condition, err := jsoniter.MarshalToString(param.Condition)
+	if err != nil {
+		logger.Error("marshal device filter failed", zap.Error(err))
+		return resource.ERR_INVALID_PARAMETER
+	}
+
+	// 同一用户下名称唯一
+	nameQuery := dto.Client().DeviceSavedFilter.Query().
+		Where(
+			devicesavedfilter.UserIDEQ(userID),
+			devicesavedfilter.NameEQ(param.Name),
+		)
+	if param.ID > 0 {
+		nameQuery = nameQuery.Where(devicesavedfilter.IDNEQ(param.ID))
+	}
+	exist, err := nameQuery.Exist(c)
+	if err != nil {
+		logger.Error("check device filter failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if exist {
+		return resource.ERR_DEVICE_FILTER_EXIST
+	}
+
+	if param.ID == 0 {
+		_, err = dto.Client().DeviceSavedFilter.Create().
+			SetUserID(userID).
+			SetName(param.Name).
+			SetCondition(condition).
+			Save(c)
+		if err != nil {
+			logger.Error("create device filter failed", zap.Error(err))
+			return resource.ERR_ADD_FAILED
+		}
+		return resource.CODE_SUCCESS
+	}
+
+	n, err := dto.Client().DeviceSavedFilter.Update().
+		Where(
+			devicesavedfilter.IDEQ(param.ID),
+			devicesavedfilter.UserIDEQ(userID),
+		).
+		SetName(param.Name).
+		SetCondition(condition).
+		Save(c)
+	if err != nil {
+		logger.Error("update device filter failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	if n == 0 {
+		return resource.ERR_DEVICE_FILTER_NOT_EXIST
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// DeleteDeviceFilter 删除筛选器
+func (s *DeviceFilterService) DeleteDeviceFilter(c *gin.Context, userID, filterID int) resource.RspCode {
+	n, err := dto.Client().DeviceSavedFilter.Delete().
+		Where(
+			devicesavedfilter.IDEQ(filterID),
+			devicesavedfilter.UserIDEQ(userID),
+		).Exec(c)
+	if err != nil {
+		logger.Error("delete device filter failed", zap.Error(err))
+		return resource.ERR_DEL_FAILED
+	}
+	if n == 0 {
+		return resource.ERR_DEVICE_FILTER_NOT_EXIST
+	}
+
+	return resource.CODE_SUCCESS
+}
@@ -0,0 +1,374 @@
+package service
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicegroup"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// DeviceGroupService 设备分组服务
+type DeviceGroupService struct{}
+
+// NewDeviceGroupService 创建设备分组服务实例
+func NewDeviceGroupService() *DeviceGroupService {
+	return &DeviceGroupService{}
+}
+
+// uniqueInts 去重并保持原有顺序
+func uniqueInts(ids []int) []int {
+	seen := make(map[int]bool, len(ids))
+	result := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// ListDeviceGroups 获取产品下的设备分组
+func (s *DeviceGroupService) ListDeviceGroups(c *gin.Context, userID, productID int) ([]dto.DeviceGroupInfo, resource.RspCode) {
+	// 权限检查
+	if !authorize(c, userID, productID, dto.PermDeviceView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	groups, err := dto.Client().DeviceGroup.Query().
+		Where(devicegroup.ProductIDEQ(productID)).
+		Order(ent.Asc(devicegroup.FieldName)).
+		All(c)
+	if err != nil {
+		logger.Error("query device groups failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	result := make([]dto.DeviceGroupInfo, 0, len(groups))
+	for _, g := range groups {
+		count, err := g.QueryDevices().Count(c)
+		if err != nil {
+			logger.Error("count group devices failed", zap.Error(err))
+			return nil, resource.ERR_QUERY_FAILED
+		}
+		result = append(result, dto.DeviceGroupInfo{
+			ID:          g.ID,
+			ProductID:   g.ProductID,
+			Name:        g.Name,
+			Description: g.Description,
+			DeviceCount: count,
+			CreatedBy:   g.CreatedBy,
+			CreatedAt:   g.CreatedAt,
+			UpdatedAt:   g.UpdatedAt,
+		})
+	}
+
+	return result, resource.CODE_SUCCESS
+}
+
+// AddDeviceGroup 新增设备分组
+func (s *DeviceGroupService) AddDeviceGroup(c *gin.Context, userID int, param dto.AddDeviceGroup) resource.RspCode {
+	// 1. 检查用户权限
+	if !authorize(c, userID, param.ProductID, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 检查分组名是否重复
+	exist, err := dto.Client().DeviceGroup.Query().
+		Where(
+			devicegroup.ProductIDEQ(param.ProductID),
+			devicegroup.NameEQ(param.Name),
+		).Exist(c)
+	if err != nil {
+		logger.Error("check device group failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if exist {
+		return resource.ERR_DEVICE_GROUP_EXIST
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_ADD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	group, err := tx.DeviceGroup.Create().
+		SetProductID(param.ProductID).
+		SetName(param.Name).
+		SetDescription(param.Description).
+		SetCreatedBy(userID).
+		Save(c)
+	if err != nil {
+		logger.Error("create device group failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_FAILED
+	}
+
+	// 4. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:     userID,
+		Action:     dto.ActionCreate,
+		Module:     dto.ModuleDeviceGroup,
+		ProductID:  param.ProductID,
+		DetailInfo: group,
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 5. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_ADD_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// ModifyDeviceGroup 修改设备分组
+func (s *DeviceGroupService) ModifyDeviceGroup(c *gin.Context, userID int, param dto.ModifyDeviceGroup) resource.RspCode {
+	group, err := dto.Client().DeviceGroup.Get(c, param.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_DEVICE_GROUP_NOT_EXIST
+		}
+		logger.Error("query device group failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+
+	// 1. 检查用户权限
+	if !authorize(c, userID, group.ProductID, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 检查分组名是否重复
+	if param.Name != group.Name {
+		exist, err := dto.Client().DeviceGroup.Query().
+			Where(
+				devicegroup.ProductIDEQ(group.ProductID),
+				devicegroup.NameEQ(param.Name),
+			).Exist(c)
+		if err != nil {
+			logger.Error("check device group failed", zap.Error(err))
+			return resource.ERR_QUERY_FAILED
+		}
+		if exist {
+			return resource.ERR_DEVICE_GROUP_EXIST
+		}
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	newGroup, err := tx.DeviceGroup.UpdateOne(group).
+		SetName(param.Name).
+		SetDescription(param.Description).
+		Save(c)
+	if err != nil {
+		logger.Error("update device group failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_MOD_FAILED
+	}
+
+	// 4. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionUpdate,
+		Module:    dto.ModuleDeviceGroup,
+		ProductID: group.ProductID,
+		DetailInfo: map[string]interface{}{
+			"old_group": group,
+			"new_group": newGroup,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 5. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// DeleteDeviceGroup 删除设备分组，分组内的设备不受影响
+func (s *DeviceGroupService) DeleteDeviceGroup(c *gin.Context, userID, groupID int) resource.RspCode {
+	group, err := dto.Client().DeviceGroup.Get(c, groupID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_DEVICE_GROUP_NOT_EXIST
+		}
+		logger.Error("query device group failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+
+	// 1. 检查用户权限
+	if !authorize(c, userID, group.ProductID, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_DEL_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := tx.DeviceGroup.UpdateOne(group).ClearDevices().Exec(c); err != nil {
+		logger.Error("clear device group members failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_DEL_FAILED
+	}
+	if err := tx.DeviceGroup.DeleteOne(group).Exec(c); err != nil {
+		logger.Error("delete device group failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_DEL_FAILED
+	}
+
+	// 3. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:     userID,
+		Action:     dto.ActionDelete,
+		Module:     dto.ModuleDeviceGroup,
+		ProductID:  group.ProductID,
+		DetailInfo: group,
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 4. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_DEL_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// UpdateDeviceGroupMembers 向分组添加或移除设备，设备必须属于分组所在的产品
+func (s *DeviceGroupService) UpdateDeviceGroupMembers(c *gin.Context, userID int, param dto.DeviceGroupMembers, remove bool) resource.RspCode {
+	deviceIDs := uniqueInts(param.DeviceIDs)
+	if len(deviceIDs) == 0 {
+		return resource.ERR_INVALID_PARAMETER
+	}
+
+	group, err := dto.Client().DeviceGroup.Get(c, param.GroupID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_DEVICE_GROUP_NOT_EXIST
+		}
+		logger.Error("query device group failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+
+	// 1. 检查用户权限
+	if !authorize(c, userID, group.ProductID, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 检查设备是否属于同一产品
+	count, err := dto.Client().Device.Query().
+		Where(
+			device.IDIn(deviceIDs...),
+			device.ProductIDEQ(group.ProductID),
+		).Count(c)
+	if err != nil {
+		logger.Error("check devices failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if count != len(deviceIDs) {
+		return resource.ERR_DEVICE_NOT_EXIST
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	update := tx.DeviceGroup.UpdateOne(group).RemoveDeviceIDs(deviceIDs...)
+	if !remove {
+		// 先移除再添加，避免重复关联导致主键冲突
+		update.AddDeviceIDs(deviceIDs...)
+	}
+	if err := update.Exec(c); err != nil {
+		logger.Error("update device group members failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_MOD_FAILED
+	}
+
+	// 4. 创建审计日志
+	operation := "add_members"
+	if remove {
+		operation = "remove_members"
+	}
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionUpdate,
+		Module:    dto.ModuleDeviceGroup,
+		ProductID: group.ProductID,
+		DetailInfo: map[string]interface{}{
+			"group_id":   group.ID,
+			"device_ids": deviceIDs,
+			"operation":  operation,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 5. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
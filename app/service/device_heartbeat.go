@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/deviceheartbeat"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+)
+
+const (
+	heartbeatClockSkew      = 5 * time.Minute // 心跳时间戳允许的偏差
+	heartbeatMaxStatusKeys  = 32              // 状态信息最多的键数量
+	heartbeatMaxStatusBytes = 4096            // 状态信息序列化后的最大字节数
+
+	defaultOnlineWindow           = 300 // 默认在线判定窗口（秒）
+	defaultHeartbeatRetentionDays = 7   // 默认原始心跳保留天数
+)
+
+// deviceConfig 读取设备接入配置，未配置的项使用默认值
+func deviceConfig() resource.DeviceConfig {
+	var cfg resource.DeviceConfig
+	if resource.Conf.DeviceConfig != nil {
+		cfg = *resource.Conf.DeviceConfig
+	}
+	if cfg.OnlineWindow <= 0 {
+		cfg.OnlineWindow = defaultOnlineWindow
+	}
+	if cfg.HeartbeatRetentionDays <= 0 {
+		cfg.HeartbeatRetentionDays = defaultHeartbeatRetentionDays
+	}
+	return cfg
+}
+
+// parseHeartbeatKey 解析设备登记的Ed25519公钥（标准base64编码）
+func parseHeartbeatKey(s string) (ed25519.PublicKey, bool) {
+	key, err := base64.StdEncoding.DecodeString(s)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return nil, false
+	}
+	return key, true
+}
+
+// verifyDeviceSignature 使用设备登记的公钥校验请求签名和时间戳，
+// 签名为 hex(Ed25519(timestamp + "\n" + body))，未登记公钥的设备无法上报心跳
+func verifyDeviceSignature(publicKey, timestamp, signature string, body []byte) bool {
+	key, ok := parseHeartbeatKey(publicKey)
+	if !ok {
+		return false
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	if d := time.Since(time.Unix(ts, 0)); d > heartbeatClockSkew || d < -heartbeatClockSkew {
+		return false
+	}
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	msg := make([]byte, 0, len(timestamp)+1+len(body))
+	msg = append(append(append(msg, timestamp...), '\n'), body...)
+	return ed25519.Verify(key, msg, sig)
+}
+
+// onlineSince 最后心跳晚于该时间的设备视为在线
+func onlineSince() time.Time {
+	return time.Now().Add(-time.Duration(deviceConfig().OnlineWindow) * time.Second)
+}
+
+// RegisterHeartbeatKey 登记设备的心跳签名公钥，服务端只保存公钥
+func (s *DeviceService) RegisterHeartbeatKey(c *gin.Context, userID int, param dto.DeviceHeartbeatKey) resource.RspCode {
+	if _, ok := parseHeartbeatKey(param.PublicKey); !ok {
+		return resource.ERR_INVALID_PARAMETER
+	}
+
+	d, err := dto.Client().Device.Get(c, param.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_DEVICE_NOT_EXIST
+		}
+		logger.Error("query device failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+
+	// 1. 检查用户权限
+	if !authorize(c, userID, d.ProductID, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	// 3. 替换公钥，不修改心跳状态
+	err = tx.Device.UpdateOne(d).
+		SetHeartbeatPublicKey(param.PublicKey).
+		SetUpdatedAt(time.Now()).
+		SetUpdatedBy(userID).
+		Exec(c)
+	if err != nil {
+		logger.Error("update device heartbeat key failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_MOD_FAILED
+	}
+
+	// 4. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionUpdate,
+		Module:    dto.ModuleDevice,
+		ProductID: d.ProductID,
+		DetailInfo: map[string]interface{}{
+			"device_id":      d.ID,
+			"sn":             d.Sn,
+			"old_public_key": d.HeartbeatPublicKey,
+			"new_public_key": param.PublicKey,
+			"operation":      "register_heartbeat_key",
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 5. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// ReportHeartbeat 接收设备心跳，记录原始心跳并更新设备的最后状态
+func (s *DeviceService) ReportHeartbeat(c *gin.Context, sn, timestamp, signature string, body []byte) resource.RspCode {
+	// 1. 使用设备登记的公钥校验签名，设备不存在时同样返回签名无效，避免探测SN
+	d, err := dto.Client().Device.Query().
+		Where(device.SnEQ(sn)).
+		Only(c)
+	if err != nil && !ent.IsNotFound(err) {
+		logger.Error("query device failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if d == nil || !verifyDeviceSignature(d.HeartbeatPublicKey, timestamp, signature, body) {
+		return resource.ERR_DEVICE_SIGN_INVALID
+	}
+
+	// 2. 解析并校验心跳内容
+	var param dto.DeviceHeartbeat
+	if err := jsoniter.Unmarshal(body, &param); err != nil || param.SN != sn || param.Uptime < 0 {
+		return resource.ERR_INVALID_PARAMETER
+	}
+	if len(param.Status) > heartbeatMaxStatusKeys {
+		return resource.ERR_INVALID_PARAMETER
+	}
+	if raw, err := jsoniter.Marshal(param.Status); err != nil || len(raw) > heartbeatMaxStatusBytes {
+		return resource.ERR_INVALID_PARAMETER
+	}
+
+	// 3. 记录原始心跳
+	now := time.Now()
+	err = dto.Client().DeviceHeartbeat.Create().
+		SetDeviceID(d.ID).
+		SetProductID(d.ProductID).
+		SetSoftwareVersion(param.SoftwareVersion).
+		SetFirmwareVersion(param.FirmwareVersion).
+		SetUptime(param.Uptime).
+		SetStatus(param.Status).
+		SetRemoteIP(c.ClientIP()).
+		SetCreatedAt(now).
+		Exec(c)
+	if err != nil {
+		logger.Error("create device heartbeat failed", zap.Error(err))
+		return resource.ERR_ADD_FAILED
+	}
+
+	// 4. 更新设备最后状态，不修改updated_at，避免覆盖人工操作记录
+	err = dto.Client().Device.UpdateOne(d).
+		SetLastSeenAt(now).
+		SetLastSoftwareVersion(param.SoftwareVersion).
+		SetLastFirmwareVersion(param.FirmwareVersion).
+		SetLastUptime(param.Uptime).
+		Exec(c)
+	if err != nil {
+		logger.Error("update device last seen failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// PurgeDeviceHeartbeats 清理超过保留期的原始心跳，由定时任务调用
+func PurgeDeviceHeartbeats() {
+	before := time.Now().AddDate(0, 0, -deviceConfig().HeartbeatRetentionDays)
+	n, err := dto.Client().DeviceHeartbeat.Delete().
+		Where(deviceheartbeat.CreatedAtLT(before)).
+		Exec(context.Background())
+	if err != nil {
+		logger.Error("purge device heartbeats failed", zap.Error(err))
+		return
+	}
+	logger.Info("purge device heartbeats", zap.Int("deleted", n), zap.Time("before", before))
+}
+
+// GetVersionDistribution 获取产品设备的版本分布
+func (s *DeviceService) GetVersionDistribution(c *gin.Context, userID, productID int) (*dto.VersionDistribution, resource.RspCode) {
+	// 权限检查
+	if !authorize(c, userID, productID, dto.PermDeviceView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	result := &dto.VersionDistribution{ProductID: productID}
+	q := dto.Client().Device.Query().Where(device.ProductIDEQ(productID))
+
+	var err error
+	if result.Total, err = q.Clone().Count(c); err != nil {
+		logger.Error("count devices failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if result.Online, err = q.Clone().Where(device.LastSeenAtGTE(onlineSince())).Count(c); err != nil {
+		logger.Error("count online devices failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	if result.Software, err = groupDeviceVersions(c, q.Clone(), device.FieldLastSoftwareVersion); err != nil {
+		logger.Error("group software versions failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if result.Firmware, err = groupDeviceVersions(c, q.Clone(), device.FieldLastFirmwareVersion); err != nil {
+		logger.Error("group firmware versions failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	return result, resource.CODE_SUCCESS
+}
+
+// groupDeviceVersions 按版本字段统计设备数量，按数量降序
+func groupDeviceVersions(c *gin.Context, q *ent.DeviceQuery, field string) ([]dto.VersionCount, error) {
+	var rows []struct {
+		Software string `json:"last_software_version"`
+		Firmware string `json:"last_firmware_version"`
+		Count    int    `json:"count"`
+	}
+	err := q.GroupBy(field).
+		Aggregate(ent.As(ent.Count(), "count")).
+		Scan(c, &rows)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]dto.VersionCount, 0, len(rows))
+	for _, r := range rows {
+		version := r.Software
+		if field == device.FieldLastFirmwareVersion {
+			version = r.Firmware
+		}
+		result = append(result, dto.VersionCount{Version: version, Count: r.Count})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Count > result[j].Count })
+	return result, nil
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+	"testing"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// signHeartbeat 设备端的签名方式
+func signHeartbeat(key ed25519.PrivateKey, ts string, body []byte) string {
+	return hex.EncodeToString(ed25519.Sign(key, append([]byte(ts+"\n"), body...)))
+}
+
+func TestVerifyDeviceSignature(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(rand.Reader)
+	_, other, _ := ed25519.GenerateKey(rand.Reader)
+	publicKey := base64.StdEncoding.EncodeToString(pub)
+
+	body := []byte(`{"sn":"SN0001","uptime":10}`)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	stale := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+
+	if !verifyDeviceSignature(publicKey, now, signHeartbeat(priv, now, body), body) {
+		t.Error("valid signature rejected")
+	}
+	if verifyDeviceSignature(publicKey, stale, signHeartbeat(priv, stale, body), body) {
+		t.Error("stale timestamp accepted")
+	}
+	if verifyDeviceSignature(publicKey, now, signHeartbeat(other, now, body), body) {
+		t.Error("signature with another key accepted")
+	}
+	if verifyDeviceSignature(publicKey, now, signHeartbeat(priv, now, body), []byte(`{"sn":"SN0001","uptime":11}`)) {
+		t.Error("signature accepted for modified body")
+	}
+	if verifyDeviceSignature("", now, signHeartbeat(priv, now, body), body) {
+		t.Error("heartbeat accepted without registered key")
+	}
+}
+
+// TestAnonymousCannotObtainSigningKey 激活文件接口不需要认证，
+// 拿到激活文件的人不能据此伪造设备心跳
+func TestAnonymousCannotObtainSigningKey(t *testing.T) {
+	client := testClient(t)
+	ctx := systemCtx()
+
+	old := resource.Conf.App
+	defer func() { resource.Conf.App = old }()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resource.Conf.App = &resource.App{}
+	resource.Conf.App.SetPrivateKey(rsaKey)
+
+	pub, priv, _ := ed25519.GenerateKey(rand.Reader)
+	p := client.Product.Create().SetCode("HB1").SetProductName("心跳产品").SaveX(ctx)
+	now := time.Now()
+	d := client.Device.Create().SetSn("HB-SN-1").SetProductID(p.ID).
+		SetHeartbeatPublicKey(base64.StdEncoding.EncodeToString(pub)).
+		SetCreatedAt(now).SetUpdatedAt(now).SaveX(ctx)
+	client.Device.Create().SetSn("HB-SN-2").SetProductID(p.ID).
+		SetCreatedAt(now).SetUpdatedAt(now).SaveX(ctx)
+
+	// 1. 匿名获取激活文件，与路由一样使用系统身份
+	s := &DeviceService{}
+	enc, code := s.GetActivationFile(testGinContext(viewer.System()), "HB-SN-1")
+	if code != resource.CODE_SUCCESS {
+		t.Fatalf("get activation file: %v", code)
+	}
+	plain, err := decrypt([]byte("lqFrzHIimXT66RgpglhASciWerqFMEjJ"), enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var file struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := jsoniter.Unmarshal(plain, &file); err != nil {
+		t.Fatal(err)
+	}
+	for k := range file.Data {
+		switch k {
+		case "sn", "product_id", "license_type", "oem_tag", "created_at", "feature_codes":
+		default:
+			t.Errorf("activation file exposes %q", k)
+		}
+	}
+	if bytes.Contains(plain, []byte(base64.StdEncoding.EncodeToString(priv))) || bytes.Contains(plain, []byte(hex.EncodeToString(priv.Seed()))) {
+		t.Error("activation file contains the device private key")
+	}
+
+	// 2. 只有设备私钥签名的心跳被接受
+	report := func(sn string, key ed25519.PrivateKey) resource.RspCode {
+		body := []byte(`{"sn":"` + sn + `","software_version":"1.0.0","uptime":10}`)
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		return s.ReportHeartbeat(testGinContext(viewer.System()), sn, ts, signHeartbeat(key, ts, body), body)
+	}
+	_, forged, _ := ed25519.GenerateKey(rand.Reader)
+	if code := report("HB-SN-1", forged); code != resource.ERR_DEVICE_SIGN_INVALID {
+		t.Errorf("forged heartbeat: %v", code)
+	}
+	if code := report("HB-SN-2", forged); code != resource.ERR_DEVICE_SIGN_INVALID {
+		t.Errorf("heartbeat without registered key: %v", code)
+	}
+	if code := report("HB-SN-404", forged); code != resource.ERR_DEVICE_SIGN_INVALID {
+		t.Errorf("heartbeat for unknown SN: %v", code)
+	}
+	if code := report("HB-SN-1", priv); code != resource.CODE_SUCCESS {
+		t.Fatalf("device heartbeat: %v", code)
+	}
+	if n := client.DeviceHeartbeat.Query().CountX(ctx); n != 1 {
+		t.Errorf("heartbeats recorded = %d, want 1", n)
+	}
+
+	// 3. 登记公钥需要设备写权限
+	code = s.RegisterHeartbeatKey(testGinContext(viewer.System()), dto.AnonymousID, dto.DeviceHeartbeatKey{
+		ID:        d.ID,
+		PublicKey: base64.StdEncoding.EncodeToString(pub),
+	})
+	if code != resource.ERR_NO_PERMISSION {
+		t.Errorf("anonymous key registration: %v", code)
+	}
+}
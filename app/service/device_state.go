@@ -0,0 +1,237 @@
+package service
+
+import (
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// deviceStates 设备生命周期的全部状态
+var deviceStates = []device.State{
+	device.StateManufactured, device.StateShipped, device.StateActivated,
+	device.StateSuspended, device.StateRma, device.StateScrapped,
+}
+
+// deviceTransitions 设备生命周期允许的状态变更，报废为终态
+var deviceTransitions = map[device.State][]device.State{
+	device.StateManufactured: {device.StateShipped, device.StateScrapped},
+	device.StateShipped:      {device.StateActivated, device.StateSuspended, device.StateRma, device.StateScrapped},
+	device.StateActivated:    {device.StateSuspended, device.StateRma, device.StateScrapped},
+	device.StateSuspended:    {device.StateActivated, device.StateRma, device.StateScrapped},
+	device.StateRma:          {device.StateManufactured, device.StateShipped, device.StateScrapped},
+}
+
+// canTransition 检查状态变更是否合法
+func canTransition(from, to device.State) bool {
+	for _, s := range deviceTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// deviceRefusesActivation 停用和报废的设备不再下发激活文件
+func deviceRefusesActivation(state device.State) bool {
+	return state == device.StateSuspended || state == device.StateScrapped
+}
+
+// setStateTime 记录进入新状态的时间，出厂时间即创建时间
+func setStateTime(update *ent.DeviceUpdateOne, state device.State, now time.Time) *ent.DeviceUpdateOne {
+	switch state {
+	case device.StateShipped:
+		update.SetShippedAt(now)
+	case device.StateActivated:
+		update.SetActivatedAt(now)
+	case device.StateSuspended:
+		update.SetSuspendedAt(now)
+	case device.StateRma:
+		update.SetRmaAt(now)
+	case device.StateScrapped:
+		update.SetScrappedAt(now)
+	}
+	return update
+}
+
+// transitionDevices 在事务内变更设备状态，不允许变更的设备跳过并在结果中标记
+func transitionDevices(c *gin.Context, tx *ent.Tx, userID int, devices []*ent.Device, to device.State, remark, operation string) ([]dto.DeviceTransitionResult, error) {
+	now := time.Now()
+	warrantyMonths := make(map[int]int)
+	results := make([]dto.DeviceTransitionResult, 0, len(devices))
+	for _, d := range devices {
+		result := dto.DeviceTransitionResult{
+			ID:   d.ID,
+			SN:   d.Sn,
+			From: d.State.String(),
+			To:   to.String(),
+		}
+		if !canTransition(d.State, to) {
+			results = append(results, result)
+			continue
+		}
+
+		update := tx.Device.UpdateOne(d).
+			SetState(to).
+			SetUpdatedAt(now).
+			SetUpdatedBy(userID)
+		if remark != "" {
+			update.SetRemark(remark)
+		}
+		// 首次出货时按产品保修期设置默认保修时间
+		if to == device.StateShipped && d.WarrantyStartAt == nil {
+			months, err := productWarrantyMonths(c, tx, d.ProductID, warrantyMonths)
+			if err != nil {
+				return nil, err
+			}
+			start, end := defaultWarranty(now, months)
+			update.SetWarrantyStartAt(start).SetWarrantyEndAt(end)
+		}
+		updatedDevice, err := setStateTime(update, to, now).Save(c)
+		if err != nil {
+			return nil, err
+		}
+
+		err = CreateAuditLog(c, tx, dto.AuditLogData{
+			UserID:    userID,
+			Action:    dto.ActionUpdate,
+			Module:    dto.ModuleDevice,
+			ProductID: d.ProductID,
+			DetailInfo: map[string]interface{}{
+				"old_device": d,
+				"new_device": updatedDevice,
+				"operation":  operation,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result.Success = true
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// TransitionDevice 变更单个设备的生命周期状态
+func (s *DeviceService) TransitionDevice(c *gin.Context, userID int, param dto.DeviceTransition) (*dto.DeviceTransitionResult, resource.RspCode) {
+	d, err := dto.Client().Device.Get(c, param.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, resource.ERR_DEVICE_NOT_EXIST
+		}
+		logger.Error("query device failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	// 1. 检查用户权限
+	if !authorize(c, userID, d.ProductID, dto.PermDeviceWrite) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 检查状态变更是否合法
+	to := device.State(param.State)
+	if !canTransition(d.State, to) {
+		return nil, resource.ERR_DEVICE_STATE_TRANSITION
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return nil, resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	results, err := transitionDevices(c, tx, userID, []*ent.Device{d}, to, param.Remark, "transition_state")
+	if err != nil {
+		logger.Error("transition device state failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_MOD_FAILED
+	}
+
+	// 4. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return nil, resource.ERR_MOD_FAILED
+	}
+
+	InvalidateProductSummary(d.ProductID)
+
+	return &results[0], resource.CODE_SUCCESS
+}
+
+// BatchTransitionDevices 批量变更设备状态，不允许变更的设备会被跳过并在结果中返回
+func (s *DeviceService) BatchTransitionDevices(c *gin.Context, userID int, param dto.DeviceBatchTransition) ([]dto.DeviceTransitionResult, resource.RspCode) {
+	deviceIDs, code := resolveDeviceTargets(c, param.DeviceIDs, param.GroupID)
+	if code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	if len(deviceIDs) == 0 {
+		return nil, resource.ERR_INVALID_PARAMETER
+	}
+
+	// 获取设备列表
+	devices, err := dto.Client().Device.Query().
+		Where(device.IDIn(deviceIDs...)).
+		All(c)
+	if err != nil {
+		logger.Error("query devices failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if len(devices) == 0 {
+		return nil, resource.ERR_DEVICE_NOT_EXIST
+	}
+
+	// 检查每个产品的写权限
+	checked := make(map[int]bool)
+	for _, d := range devices {
+		if checked[d.ProductID] {
+			continue
+		}
+		if !authorize(c, userID, d.ProductID, dto.PermDeviceWrite) {
+			return nil, resource.ERR_NO_PERMISSION
+		}
+		checked[d.ProductID] = true
+	}
+
+	// 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return nil, resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	results, err := transitionDevices(c, tx, userID, devices, device.State(param.State), param.Remark, "batch_transition_state")
+	if err != nil {
+		logger.Error("transition device state failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_MOD_FAILED
+	}
+
+	// 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return nil, resource.ERR_MOD_FAILED
+	}
+
+	invalidateDeviceSummaries(devices)
+
+	return results, resource.CODE_SUCCESS
+}
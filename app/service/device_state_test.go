@@ -0,0 +1,29 @@
+package service
+
+import (
+	"testing"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+)
+
+func TestCanTransition(t *testing.T) {
+	cases := []struct {
+		from, to device.State
+		want     bool
+	}{
+		{device.StateManufactured, device.StateShipped, true},
+		{device.StateManufactured, device.StateActivated, false},
+		{device.StateShipped, device.StateActivated, true},
+		{device.StateActivated, device.StateSuspended, true},
+		{device.StateSuspended, device.StateActivated, true},
+		{device.StateActivated, device.StateActivated, false},
+		{device.StateRma, device.StateManufactured, true},
+		{device.StateScrapped, device.StateManufactured, false},
+		{device.StateScrapped, device.StateActivated, false},
+	}
+	for _, tc := range cases {
+		if got := canTransition(tc.from, tc.to); got != tc.want {
+			t.Errorf("canTransition(%s, %s) = %v, want %v", tc.from, tc.to, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,355 @@
+package service
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicetag"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// DeviceTagService 设备标签服务
+type DeviceTagService struct{}
+
+// NewDeviceTagService 创建设备标签服务实例
+func NewDeviceTagService() *DeviceTagService {
+	return &DeviceTagService{}
+}
+
+// ListDeviceTags 获取产品下的设备标签
+func (s *DeviceTagService) ListDeviceTags(c *gin.Context, userID, productID int) ([]*ent.DeviceTag, resource.RspCode) {
+	// 权限检查
+	if !authorize(c, userID, productID, dto.PermDeviceView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	tags, err := dto.Client().DeviceTag.Query().
+		Where(devicetag.ProductIDEQ(productID)).
+		Order(ent.Asc(devicetag.FieldName)).
+		All(c)
+	if err != nil {
+		logger.Error("query device tags failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	return tags, resource.CODE_SUCCESS
+}
+
+// AddDeviceTag 新增设备标签
+func (s *DeviceTagService) AddDeviceTag(c *gin.Context, userID int, param dto.AddDeviceTag) resource.RspCode {
+	// 1. 检查用户权限
+	if !authorize(c, userID, param.ProductID, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 检查标签名是否重复
+	exist, err := dto.Client().DeviceTag.Query().
+		Where(
+			devicetag.ProductIDEQ(param.ProductID),
+			devicetag.NameEQ(param.Name),
+		).Exist(c)
+	if err != nil {
+		logger.Error("check device tag failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if exist {
+		return resource.ERR_DEVICE_TAG_EXIST
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_ADD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	tag, err := tx.DeviceTag.Create().
+		SetProductID(param.ProductID).
+		SetName(param.Name).
+		SetColor(param.Color).
+		SetCreatedBy(userID).
+		Save(c)
+	if err != nil {
+		logger.Error("create device tag failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_FAILED
+	}
+
+	// 4. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:     userID,
+		Action:     dto.ActionCreate,
+		Module:     dto.ModuleDeviceTag,
+		ProductID:  param.ProductID,
+		DetailInfo: tag,
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 5. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_ADD_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// ModifyDeviceTag 修改设备标签
+func (s *DeviceTagService) ModifyDeviceTag(c *gin.Context, userID int, param dto.ModifyDeviceTag) resource.RspCode {
+	tag, err := dto.Client().DeviceTag.Get(c, param.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_DEVICE_TAG_NOT_EXIST
+		}
+		logger.Error("query device tag failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+
+	// 1. 检查用户权限
+	if !authorize(c, userID, tag.ProductID, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 检查标签名是否重复
+	if param.Name != tag.Name {
+		exist, err := dto.Client().DeviceTag.Query().
+			Where(
+				devicetag.ProductIDEQ(tag.ProductID),
+				devicetag.NameEQ(param.Name),
+			).Exist(c)
+		if err != nil {
+			logger.Error("check device tag failed", zap.Error(err))
+			return resource.ERR_QUERY_FAILED
+		}
+		if exist {
+			return resource.ERR_DEVICE_TAG_EXIST
+		}
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	newTag, err := tx.DeviceTag.UpdateOne(tag).
+		SetName(param.Name).
+		SetColor(param.Color).
+		Save(c)
+	if err != nil {
+		logger.Error("update device tag failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_MOD_FAILED
+	}
+
+	// 4. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionUpdate,
+		Module:    dto.ModuleDeviceTag,
+		ProductID: tag.ProductID,
+		DetailInfo: map[string]interface{}{
+			"old_tag": tag,
+			"new_tag": newTag,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 5. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// DeleteDeviceTag 删除设备标签，同时解除与设备的关联
+func (s *DeviceTagService) DeleteDeviceTag(c *gin.Context, userID, tagID int) resource.RspCode {
+	tag, err := dto.Client().DeviceTag.Get(c, tagID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_DEVICE_TAG_NOT_EXIST
+		}
+		logger.Error("query device tag failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+
+	// 1. 检查用户权限
+	if !authorize(c, userID, tag.ProductID, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_DEL_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := tx.DeviceTag.UpdateOne(tag).ClearDevices().Exec(c); err != nil {
+		logger.Error("clear device tag relations failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_DEL_FAILED
+	}
+	if err := tx.DeviceTag.DeleteOne(tag).Exec(c); err != nil {
+		logger.Error("delete device tag failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_DEL_FAILED
+	}
+
+	// 3. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:     userID,
+		Action:     dto.ActionDelete,
+		Module:     dto.ModuleDeviceTag,
+		ProductID:  tag.ProductID,
+		DetailInfo: tag,
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 4. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_DEL_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// AssignDeviceTags 为设备添加或移除标签，标签和设备必须属于同一产品
+func (s *DeviceTagService) AssignDeviceTags(c *gin.Context, userID int, param dto.AssignDeviceTags, remove bool) resource.RspCode {
+	param.DeviceIDs = uniqueInts(param.DeviceIDs)
+	param.TagIDs = uniqueInts(param.TagIDs)
+	if len(param.DeviceIDs) == 0 || len(param.TagIDs) == 0 {
+		return resource.ERR_INVALID_PARAMETER
+	}
+
+	tags, err := dto.Client().DeviceTag.Query().
+		Where(devicetag.IDIn(param.TagIDs...)).
+		All(c)
+	if err != nil {
+		logger.Error("query device tags failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if len(tags) != len(param.TagIDs) {
+		return resource.ERR_DEVICE_TAG_NOT_EXIST
+	}
+	productID := tags[0].ProductID
+	for _, t := range tags {
+		if t.ProductID != productID {
+			return resource.ERR_INVALID_PARAMETER
+		}
+	}
+
+	// 1. 检查用户权限
+	if !authorize(c, userID, productID, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 设备必须属于标签所在的产品
+	count, err := dto.Client().Device.Query().
+		Where(
+			device.IDIn(param.DeviceIDs...),
+			device.ProductIDEQ(productID),
+		).Count(c)
+	if err != nil {
+		logger.Error("check devices failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if count != len(param.DeviceIDs) {
+		return resource.ERR_DEVICE_NOT_EXIST
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	for _, t := range tags {
+		update := tx.DeviceTag.UpdateOne(t)
+		if remove {
+			update.RemoveDeviceIDs(param.DeviceIDs...)
+		} else {
+			// 先移除再添加，避免重复关联导致主键冲突
+			update.RemoveDeviceIDs(param.DeviceIDs...).AddDeviceIDs(param.DeviceIDs...)
+		}
+		if err := update.Exec(c); err != nil {
+			logger.Error("update device tag relations failed", zap.Error(err))
+			_ = tx.Rollback()
+			return resource.ERR_MOD_FAILED
+		}
+	}
+
+	// 4. 创建审计日志
+	operation := "assign_tags"
+	if remove {
+		operation = "unassign_tags"
+	}
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionUpdate,
+		Module:    dto.ModuleDeviceTag,
+		ProductID: productID,
+		DetailInfo: map[string]interface{}{
+			"device_ids": param.DeviceIDs,
+			"tag_ids":    param.TagIDs,
+			"operation":  operation,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 5. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
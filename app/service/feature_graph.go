@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// featureGraph 产品内功能的依赖/互斥关系，已删除的功能不参与校验
+type featureGraph struct {
+	features  map[int]*ent.ProductFeature
+	requires  map[int][]int
+	conflicts map[int][]int
+}
+
+// newFeatureGraph 由预先加载了requires和conflicts的功能构建关系图，指向列表外功能的边忽略
+func newFeatureGraph(features []*ent.ProductFeature) *featureGraph {
+	g := &featureGraph{
+		features:  make(map[int]*ent.ProductFeature, len(features)),
+		requires:  make(map[int][]int),
+		conflicts: make(map[int][]int),
+	}
+	for _, f := range features {
+		g.features[f.ID] = f
+	}
+	for _, f := range features {
+		for _, r := range f.Edges.Requires {
+			if _, ok := g.features[r.ID]; ok {
+				g.requires[f.ID] = append(g.requires[f.ID], r.ID)
+			}
+		}
+		for _, r := range f.Edges.Conflicts {
+			if _, ok := g.features[r.ID]; ok {
+				g.conflicts[f.ID] = append(g.conflicts[f.ID], r.ID)
+			}
+		}
+		sort.Ints(g.requires[f.ID])
+		sort.Ints(g.conflicts[f.ID])
+	}
+	return g
+}
+
+// loadFeatureGraph 查询产品的功能关系图
+func loadFeatureGraph(ctx context.Context, client *ent.Client, productID int) (*featureGraph, error) {
+	features, err := client.ProductFeature.Query().
+		Where(productfeature.ProductIDEQ(productID)).
+		WithRequires().
+		WithConflicts().
+		Order(ent.Asc(productfeature.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newFeatureGraph(features), nil
+}
+
+func (g *featureGraph) code(id int) string {
+	if f, ok := g.features[id]; ok {
+		return f.FeatureCode
+	}
+	return fmt.Sprintf("#%d", id)
+}
+
+// withDependencies 返回功能及其直接和间接依赖的功能，按ID排序
+func (g *featureGraph) withDependencies(ids []int) []int {
+	seen := make(map[int]bool, len(ids))
+	stack := append([]int(nil), ids...)
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		stack = append(stack, g.requires[id]...)
+	}
+	result := make([]int, 0, len(seen))
+	for id := range seen {
+		result = append(result, id)
+	}
+	sort.Ints(result)
+	return result
+}
+
+// violations 校验一组同时启用的功能：缺少的直接依赖和互斥的功能对
+func (g *featureGraph) violations(ids []int) []dto.FeatureViolation {
+	enabled := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		if _, ok := g.features[id]; ok {
+			enabled[id] = true
+		}
+	}
+	sorted := make([]int, 0, len(enabled))
+	for id := range enabled {
+		sorted = append(sorted, id)
+	}
+	sort.Ints(sorted)
+
+	var result []dto.FeatureViolation
+	for _, id := range sorted {
+		for _, r := range g.requires[id] {
+			if !enabled[r] {
+				result = append(result, dto.FeatureViolation{
+					Rule:        dto.FeatureViolationMissing,
+					FeatureCode: g.code(id),
+					RelatedCode: g.code(r),
+					Message:     fmt.Sprintf("feature %s requires %s", g.code(id), g.code(r)),
+				})
+			}
+		}
+	}
+	for _, id := range sorted {
+		for _, r := range g.conflicts[id] {
+			// 互斥关系是对称的，每对只报告一次
+			if r > id && enabled[r] {
+				result = append(result, dto.FeatureViolation{
+					Rule:        dto.FeatureViolationConflict,
+					FeatureCode: g.code(id),
+					RelatedCode: g.code(r),
+					Message:     fmt.Sprintf("feature %s conflicts with %s", g.code(id), g.code(r)),
+				})
+			}
+		}
+	}
+	return result
+}
+
+// dependsOn from是否直接或间接依赖to
+func (g *featureGraph) dependsOn(from, to int) bool {
+	for _, id := range g.withDependencies(g.requires[from]) {
+		if id == to {
+			return true
+		}
+	}
+	return false
+}
+
+// relationViolations 校验新增的关系：依赖不能成环，添加后每个功能连同其依赖都必须能同时启用
+func (g *featureGraph) relationViolations(rel dto.FeatureRelation) []dto.FeatureViolation {
+	if rel.Relation == dto.FeatureRelationRequires && (rel.FeatureID == rel.RelatedID || g.dependsOn(rel.RelatedID, rel.FeatureID)) {
+		return []dto.FeatureViolation{{
+			Rule:        dto.FeatureViolationCycle,
+			FeatureCode: g.code(rel.FeatureID),
+			RelatedCode: g.code(rel.RelatedID),
+			Message:     fmt.Sprintf("%s already depends on %s, the dependency would form a cycle", g.code(rel.RelatedID), g.code(rel.FeatureID)),
+		}}
+	}
+
+	next := &featureGraph{features: g.features, requires: make(map[int][]int), conflicts: make(map[int][]int)}
+	for id, rs := range g.requires {
+		next.requires[id] = append([]int(nil), rs...)
+	}
+	for id, rs := range g.conflicts {
+		next.conflicts[id] = append([]int(nil), rs...)
+	}
+	switch rel.Relation {
+	case dto.FeatureRelationRequires:
+		next.requires[rel.FeatureID] = append(next.requires[rel.FeatureID], rel.RelatedID)
+	case dto.FeatureRelationConflicts:
+		next.conflicts[rel.FeatureID] = append(next.conflicts[rel.FeatureID], rel.RelatedID)
+		next.conflicts[rel.RelatedID] = append(next.conflicts[rel.RelatedID], rel.FeatureID)
+	}
+
+	ids := make([]int, 0, len(g.features))
+	for id := range g.features {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	var result []dto.FeatureViolation
+	for _, id := range ids {
+		for _, v := range next.violations(next.withDependencies([]int{id})) {
+			if v.Rule != dto.FeatureViolationConflict {
+				continue
+			}
+			v.Message = fmt.Sprintf("feature %s could never be enabled: %s", g.code(id), v.Message)
+			result = append(result, v)
+			break
+		}
+	}
+	return result
+}
+
+// activeFeatureIDs 设备当前启用的功能ID
+func activeFeatureIDs(features []dto.DeviceFeature) []int {
+	ids := make([]int, 0, len(features))
+	for _, f := range features {
+		if f.Active {
+			ids = append(ids, f.FeatureID)
+		}
+	}
+	return ids
+}
+
+// GetFeatureGraph 获取产品的功能依赖/互斥关系图
+func (s *ProductFeatureService) GetFeatureGraph(c *gin.Context, userID, productID int) (*dto.FeatureGraph, resource.RspCode) {
+	if !authorize(c, userID, productID, dto.PermProductView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	g, err := loadFeatureGraph(c, dto.Client(), productID)
+	if err != nil {
+		logger.Error("query feature graph failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	ids := make([]int, 0, len(g.features))
+	for id := range g.features {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	graph := &dto.FeatureGraph{
+		ProductID: productID,
+		Nodes:     make([]dto.FeatureGraphNode, 0, len(ids)),
+		Edges:     []dto.FeatureGraphEdge{},
+	}
+	for _, id := range ids {
+		f := g.features[id]
+		graph.Nodes = append(graph.Nodes, dto.FeatureGraphNode{ID: f.ID, FeatureCode: f.FeatureCode, FeatureName: f.FeatureName})
+		for _, r := range g.requires[id] {
+			graph.Edges = append(graph.Edges, dto.FeatureGraphEdge{From: id, To: r, Relation: dto.FeatureRelationRequires})
+		}
+		for _, r := range g.conflicts[id] {
+			if r > id {
+				graph.Edges = append(graph.Edges, dto.FeatureGraphEdge{From: id, To: r, Relation: dto.FeatureRelationConflicts})
+			}
+		}
+	}
+	return graph, resource.CODE_SUCCESS
+}
+
+// AddFeatureRelation 添加同一产品内两个功能之间的依赖或互斥关系
+func (s *ProductFeatureService) AddFeatureRelation(c *gin.Context, userID int, param dto.FeatureRelation) ([]dto.FeatureViolation, resource.RspCode) {
+	return s.changeFeatureRelation(c, userID, param, true)
+}
+
+// RemoveFeatureRelation 删除功能之间的依赖或互斥关系
+func (s *ProductFeatureService) RemoveFeatureRelation(c *gin.Context, userID int, param dto.FeatureRelation) resource.RspCode {
+	_, code := s.changeFeatureRelation(c, userID, param, false)
+	return code
+}
+
+func (s *ProductFeatureService) changeFeatureRelation(c *gin.Context, userID int, param dto.FeatureRelation, add bool) ([]dto.FeatureViolation, resource.RspCode) {
+	if param.FeatureID == param.RelatedID {
+		return nil, resource.ERR_INVALID_PARAMETER
+	}
+	features, err := dto.Client().ProductFeature.Query().
+		Where(productfeature.IDIn(param.FeatureID, param.RelatedID)).
+		All(c)
+	if err != nil {
+		logger.Error("query product feature failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if len(features) != 2 || features[0].ProductID != features[1].ProductID {
+		return nil, resource.ERR_FEATURE_NOT_EXIST
+	}
+	productID := features[0].ProductID
+
+	if !authorize(c, userID, productID, dto.PermProductEdit) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	g, err := loadFeatureGraph(c, dto.Client(), productID)
+	if err != nil {
+		logger.Error("query feature graph failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if add {
+		if violations := g.relationViolations(param); len(violations) > 0 {
+			return violations, resource.ERR_FEATURE_CONSTRAINT
+		}
+	}
+
+	code := resource.ERR_MOD_FAILED
+	action := dto.ActionUpdate
+	if !add {
+		code = resource.ERR_DEL_FAILED
+		action = dto.ActionDelete
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return nil, code
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	update := tx.ProductFeature.UpdateOneID(param.FeatureID)
+	switch {
+	case add && param.Relation == dto.FeatureRelationRequires:
+		update.AddRequireIDs(param.RelatedID)
+	case add:
+		update.AddConflictIDs(param.RelatedID)
+	case param.Relation == dto.FeatureRelationRequires:
+		update.RemoveRequireIDs(param.RelatedID)
+	default:
+		update.RemoveConflictIDs(param.RelatedID)
+	}
+	if err := update.Exec(c); err != nil {
+		logger.Error("save feature relation failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, code
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    action,
+		Module:    dto.ModuleFeature,
+		ProductID: productID,
+		DetailInfo: map[string]interface{}{
+			"operation":    "feature_relation",
+			"relation":     param.Relation,
+			"feature_code": g.code(param.FeatureID),
+			"related_code": g.code(param.RelatedID),
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return nil, code
+	}
+
+	return nil, resource.CODE_SUCCESS
+}
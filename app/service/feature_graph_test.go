@@ -0,0 +1,75 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+)
+
+// testFeatureGraph NET <- SYNC <- BACKUP，SYNC与OFFLINE互斥
+func testFeatureGraph() (*featureGraph, map[string]*ent.ProductFeature) {
+	f := map[string]*ent.ProductFeature{
+		"NET":     {ID: 1, FeatureCode: "NET"},
+		"SYNC":    {ID: 2, FeatureCode: "SYNC"},
+		"BACKUP":  {ID: 3, FeatureCode: "BACKUP"},
+		"OFFLINE": {ID: 4, FeatureCode: "OFFLINE"},
+		"AUDIT":   {ID: 5, FeatureCode: "AUDIT"},
+	}
+	f["SYNC"].Edges.Requires = []*ent.ProductFeature{f["NET"]}
+	f["BACKUP"].Edges.Requires = []*ent.ProductFeature{f["SYNC"], {ID: 99, FeatureCode: "DELETED"}}
+	f["SYNC"].Edges.Conflicts = []*ent.ProductFeature{f["OFFLINE"]}
+	f["OFFLINE"].Edges.Conflicts = []*ent.ProductFeature{f["SYNC"]}
+	return newFeatureGraph([]*ent.ProductFeature{f["NET"], f["SYNC"], f["BACKUP"], f["OFFLINE"], f["AUDIT"]}), f
+}
+
+func TestFeatureGraphViolations(t *testing.T) {
+	g, _ := testFeatureGraph()
+
+	if got, want := g.withDependencies([]int{3, 5}), []int{1, 2, 3, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("withDependencies = %v, want %v", got, want)
+	}
+	if v := g.violations([]int{1, 2, 3, 5}); len(v) != 0 {
+		t.Errorf("violations = %+v", v)
+	}
+
+	want := []dto.FeatureViolation{
+		{Rule: dto.FeatureViolationMissing, FeatureCode: "SYNC", RelatedCode: "NET", Message: "feature SYNC requires NET"},
+		{Rule: dto.FeatureViolationConflict, FeatureCode: "SYNC", RelatedCode: "OFFLINE", Message: "feature SYNC conflicts with OFFLINE"},
+	}
+	if got := g.violations([]int{4, 2}); !reflect.DeepEqual(got, want) {
+		t.Errorf("violations = %+v, want %+v", got, want)
+	}
+}
+
+func TestFeatureGraphRelationViolations(t *testing.T) {
+	g, _ := testFeatureGraph()
+
+	v := g.relationViolations(dto.FeatureRelation{FeatureID: 1, RelatedID: 3, Relation: dto.FeatureRelationRequires})
+	if len(v) != 1 || v[0].Rule != dto.FeatureViolationCycle {
+		t.Errorf("cycle = %+v", v)
+	}
+
+	// BACKUP依赖SYNC，再依赖OFFLINE则BACKUP永远无法启用
+	v = g.relationViolations(dto.FeatureRelation{FeatureID: 3, RelatedID: 4, Relation: dto.FeatureRelationRequires})
+	want := []dto.FeatureViolation{{
+		Rule:        dto.FeatureViolationConflict,
+		FeatureCode: "SYNC",
+		RelatedCode: "OFFLINE",
+		Message:     "feature BACKUP could never be enabled: feature SYNC conflicts with OFFLINE",
+	}}
+	if !reflect.DeepEqual(v, want) {
+		t.Errorf("unsatisfiable = %+v, want %+v", v, want)
+	}
+
+	// NET与BACKUP互斥，BACKUP间接依赖NET，永远无法启用
+	v = g.relationViolations(dto.FeatureRelation{FeatureID: 1, RelatedID: 3, Relation: dto.FeatureRelationConflicts})
+	if len(v) != 1 || v[0].FeatureCode != "NET" || v[0].RelatedCode != "BACKUP" {
+		t.Errorf("conflict = %+v", v)
+	}
+
+	if v := g.relationViolations(dto.FeatureRelation{FeatureID: 5, RelatedID: 1, Relation: dto.FeatureRelationRequires}); len(v) != 0 {
+		t.Errorf("valid relation rejected: %+v", v)
+	}
+}
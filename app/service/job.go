@@ -0,0 +1,536 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/job"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultJobWorkers   = 2
+	defaultJobChunkSize = 200
+	jobQueueSize        = 1024
+	staleJobTimeout     = 10 * time.Minute // 运行中的任务超过该时间没有进度视为执行实例已退出
+)
+
+// jobQueue 待执行任务ID，队列已满时任务保持pending，由RequeueJobs定时补充
+var jobQueue = make(chan int, jobQueueSize)
+
+// jobRunner 任务执行器，条目按顺序分块处理
+type jobRunner interface {
+	// items 任务的全部条目
+	items() []string
+	// runChunk 在事务中处理一块条目，返回失败的条目和涉及的产品
+	runChunk(ctx context.Context, tx *ent.Tx, items []string) ([]dto.JobItemError, []int, error)
+	// withItems 用给定条目生成新的任务参数，用于重试
+	withItems(items []string) interface{}
+}
+
+// newJobRunner 根据任务类型解析参数并创建执行器
+func newJobRunner(j *ent.Job) (jobRunner, error) {
+	switch j.Type {
+	case job.TypeDeviceBatchAdd:
+		var param dto.DeviceBatchAdd
+		if err := jsoniter.UnmarshalFromString(j.Params, &param); err != nil {
+			return nil, err
+		}
+		return newDeviceBatchAddRunner(j.CreatedBy, j.ID, param), nil
+	case job.TypeDeviceBatchLicense:
+		var param dto.DeviceBatchUpdateLicense
+		if err := jsoniter.UnmarshalFromString(j.Params, &param); err != nil {
+			return nil, err
+		}
+		return newDeviceBatchLicenseRunner(j.CreatedBy, j.ID, param), nil
+	}
+	return nil, fmt.Errorf("unknown job type %s", j.Type)
+}
+
+// JobService 异步任务服务
+type JobService struct{}
+
+// NewJobService 创建异步任务服务实例
+func NewJobService() *JobService {
+	return &JobService{}
+}
+
+func jobWorkers() int {
+	if resource.Conf.JobConfig == nil || resource.Conf.JobConfig.Workers <= 0 {
+		return defaultJobWorkers
+	}
+	return resource.Conf.JobConfig.Workers
+}
+
+func jobChunkSize() int {
+	if resource.Conf.JobConfig == nil || resource.Conf.JobConfig.ChunkSize <= 0 {
+		return defaultJobChunkSize
+	}
+	return resource.Conf.JobConfig.ChunkSize
+}
+
+// StartJobWorkers 启动任务执行协程，并恢复服务重启前未完成的任务
+func StartJobWorkers() {
+	for i := 0; i < jobWorkers(); i++ {
+		go func() {
+			for id := range jobQueue {
+				runJob(id)
+			}
+		}()
+	}
+	RequeueJobs()
+}
+
+// RequeueJobs 将长时间没有进度的运行中任务重置为待执行，并将待执行任务加入队列，多实例部署时由认领保证只执行一次
+func RequeueJobs() {
+	ctx := viewer.SystemContext(context.Background())
+	_, err := dto.Client().Job.Update().
+		Where(job.StatusEQ(job.StatusRunning), job.UpdatedAtLT(time.Now().Add(-staleJobTimeout))).
+		SetStatus(job.StatusPending).
+		Save(ctx)
+	if err != nil {
+		logger.Error("reset stale jobs failed", zap.Error(err))
+	}
+	ids, err := dto.Client().Job.Query().
+		Where(job.StatusEQ(job.StatusPending)).
+		Order(ent.Asc(job.FieldID)).
+		IDs(ctx)
+	if err != nil {
+		logger.Error("query pending jobs failed", zap.Error(err))
+		return
+	}
+	for _, id := range ids {
+		enqueueJob(id)
+	}
+}
+
+// enqueueJob 将任务加入队列，队列已满时跳过
+func enqueueJob(id int) {
+	select {
+	case jobQueue <- id:
+	default:
+		logger.Warn("job queue is full", zap.Int("job_id", id))
+	}
+}
+
+// submitJob 创建任务并加入队列
+func submitJob(c *gin.Context, userID int, typ job.Type, params interface{}, total, retryOf int) (*dto.JobInfo, resource.RspCode) {
+	raw, err := jsoniter.MarshalToString(params)
+	if err != nil {
+		logger.Error("marshal job params failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+	create := dto.Client().Job.Create().
+		SetType(typ).
+		SetParams(raw).
+		SetTotal(total).
+		SetCreatedBy(userID)
+	if retryOf > 0 {
+		create.SetRetryOf(retryOf)
+	}
+	if grant := auth.GetTokenGrant(c); grant != nil {
+		create.SetAPITokenID(grant.TokenID)
+	}
+	j, err := create.Save(c)
+	if err != nil {
+		logger.Error("create job failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+	enqueueJob(j.ID)
+	info := toJobInfo(j, false)
+	return &info, resource.CODE_SUCCESS
+}
+
+// runJob 认领并执行任务，每块条目在一个事务中处理，事务内同时更新进度，任务被取消时当前块回滚
+func runJob(id int) {
+	// 认领任务和更新进度使用系统身份，条目以提交人的身份处理，见jobContext
+	ctx := viewer.SystemContext(context.Background())
+	now := time.Now()
+	n, err := dto.Client().Job.Update().
+		Where(job.IDEQ(id), job.StatusEQ(job.StatusPending)).
+		SetStatus(job.StatusRunning).
+		SetStartedAt(now).
+		Save(ctx)
+	if err != nil || n == 0 {
+		return
+	}
+	j, err := dto.Client().Job.Get(ctx, id)
+	if err != nil {
+		logger.Error("query job failed", zap.Error(err), zap.Int("job_id", id))
+		return
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			logger.Error("job panicked", zap.Any("panic", v), zap.Int("job_id", id))
+			finishJob(ctx, j, nil)
+		}
+	}()
+
+	runner, err := newJobRunner(j)
+	if err != nil {
+		logger.Error("prepare job failed", zap.Error(err), zap.Int("job_id", id))
+		finishJob(ctx, j, nil)
+		return
+	}
+
+	report := decodeJobReport(j.ErrorReport)
+	products := make(map[int]bool)
+	items := runner.items()
+	size := jobChunkSize()
+	for j.Processed < len(items) {
+		end := j.Processed + size
+		if end > len(items) {
+			end = len(items)
+		}
+		updated, ok := runJobChunk(ctx, j, runner, items[j.Processed:end], &report, products)
+		if !ok {
+			// 任务已取消或无法更新进度
+			return
+		}
+		j = updated
+		notifyJob(j)
+	}
+	finishJob(ctx, j, products)
+}
+
+// errJobRevoked 任务提交人已被禁用，或提交时使用的API令牌已撤销、过期
+var errJobRevoked = errors.New("job submitter is no longer authorized")
+
+// jobContext 每块条目处理前重新构建提交人的身份，执行期间被移除的产品权限和失效的API令牌对后续块立即生效
+func jobContext(ctx context.Context, j *ent.Job) (context.Context, error) {
+	u, err := dto.Client().User.Get(ctx, j.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	if !u.IsEnabled {
+		return nil, errJobRevoked
+	}
+	var grant *auth.TokenGrant
+	if j.APITokenID != 0 {
+		t, err := dto.Client().APIToken.Get(ctx, j.APITokenID)
+		if ent.IsNotFound(err) {
+			return nil, errJobRevoked
+		}
+		if err != nil {
+			return nil, err
+		}
+		if apiTokenStatus(t, time.Now()) != dto.APITokenStatusActive {
+			return nil, errJobRevoked
+		}
+		grant = &auth.TokenGrant{TokenID: t.ID, Scopes: t.Scopes, ProductIDs: t.ProductIds}
+		ctx = auth.WithTokenGrant(ctx, grant)
+	}
+	return viewer.NewContext(ctx, NewTokenViewer(j.CreatedBy, grant)), nil
+}
+
+// runJobChunk 处理一块条目，块执行失败时整块记为失败后继续
+func runJobChunk(ctx context.Context, j *ent.Job, runner jobRunner, chunk []string, report *[]dto.JobItemError, products map[int]bool) (*ent.Job, bool) {
+	tx, err := dto.Client().Tx(ctx)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err), zap.Int("job_id", j.ID))
+		return nil, false
+	}
+	failures, productIDs, err := func() (f []dto.JobItemError, p []int, err error) {
+		defer func() {
+			if v := recover(); v != nil {
+				err = fmt.Errorf("%v", v)
+			}
+		}()
+		runCtx, err := jobContext(ctx, j)
+		if errors.Is(err, errJobRevoked) {
+			return failAll(chunk, resource.ERR_NO_PERMISSION.Msg()), nil, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		return runner.runChunk(runCtx, tx, chunk)
+	}()
+	if err != nil {
+		logger.Error("run job chunk failed", zap.Error(err), zap.Int("job_id", j.ID))
+		_ = tx.Rollback()
+		failures = failAll(chunk, resource.ERR_OPERATION_FAILED.Msg())
+		productIDs = nil
+		tx = nil
+	}
+
+	merged := append(*report, failures...)
+	update := dto.Client().Job.Update()
+	if tx != nil {
+		update = tx.Job.Update()
+	}
+	update = update.
+		Where(job.IDEQ(j.ID), job.StatusEQ(job.StatusRunning)).
+		SetProcessed(j.Processed + len(chunk)).
+		AddSucceeded(len(chunk) - len(failures)).
+		AddFailed(len(failures))
+	if len(failures) > 0 {
+		raw, _ := jsoniter.MarshalToString(merged)
+		update.SetErrorReport(raw)
+	}
+	n, err := update.Save(ctx)
+	if err != nil || n == 0 {
+		if err != nil {
+			logger.Error("update job progress failed", zap.Error(err), zap.Int("job_id", j.ID))
+		}
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+		return nil, false
+	}
+	if tx != nil {
+		if err := tx.Commit(); err != nil {
+			logger.Error("commit transaction failed", zap.Error(err), zap.Int("job_id", j.ID))
+			return nil, false
+		}
+	}
+
+	*report = merged
+	for _, id := range productIDs {
+		products[id] = true
+	}
+	InvalidateProductSummary(productIDs...)
+
+	updated, err := dto.Client().Job.Get(ctx, j.ID)
+	if err != nil {
+		logger.Error("query job failed", zap.Error(err), zap.Int("job_id", j.ID))
+		return nil, false
+	}
+	return updated, true
+}
+
+// finishJob 结束任务，全部条目成功为succeeded，否则为failed；products为nil表示任务无法执行
+func finishJob(ctx context.Context, j *ent.Job, products map[int]bool) {
+	status := job.StatusSucceeded
+	if products == nil || j.Failed > 0 || j.Processed < j.Total {
+		status = job.StatusFailed
+	}
+	result := dto.JobResult{Succeeded: j.Succeeded, Failed: j.Failed, ProductIDs: []int{}}
+	for id := range products {
+		result.ProductIDs = append(result.ProductIDs, id)
+	}
+	raw, _ := jsoniter.MarshalToString(result)
+	n, err := dto.Client().Job.Update().
+		Where(job.IDEQ(j.ID), job.StatusEQ(job.StatusRunning)).
+		SetStatus(status).
+		SetResult(raw).
+		SetFinishedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		logger.Error("finish job failed", zap.Error(err), zap.Int("job_id", j.ID))
+		return
+	}
+	if n == 0 {
+		return
+	}
+	if finished, err := dto.Client().Job.Get(ctx, j.ID); err == nil {
+		notifyJob(finished)
+	}
+}
+
+// notifyJob 向任务提交人推送进度
+func notifyJob(j *ent.Job) {
+	msg := dto.JobProgressMessage{Type: dto.JobMessageProgress, Job: toJobInfo(j, false)}
+	if err := SharedHub().SendToUser(j.CreatedBy, msg); err != nil {
+		logger.Warn("push job progress failed", zap.Error(err), zap.Int("job_id", j.ID))
+	}
+}
+
+func decodeJobReport(raw string) []dto.JobItemError {
+	var report []dto.JobItemError
+	if raw != "" {
+		_ = jsoniter.UnmarshalFromString(raw, &report)
+	}
+	return report
+}
+
+// toJobInfo 转换为任务DTO，withReport为true时包含失败条目
+func toJobInfo(j *ent.Job, withReport bool) dto.JobInfo {
+	info := dto.JobInfo{
+		ID:         j.ID,
+		Type:       j.Type.String(),
+		Status:     j.Status.String(),
+		Total:      j.Total,
+		Processed:  j.Processed,
+		Succeeded:  j.Succeeded,
+		Failed:     j.Failed,
+		RetryOf:    j.RetryOf,
+		CreatedBy:  j.CreatedBy,
+		CreatedAt:  j.CreatedAt,
+		StartedAt:  j.StartedAt,
+		FinishedAt: j.FinishedAt,
+	}
+	if j.Total > 0 {
+		info.Progress = j.Processed * 100 / j.Total
+	} else if j.Status == job.StatusSucceeded {
+		info.Progress = 100
+	}
+	if j.Result != "" {
+		var result dto.JobResult
+		if err := jsoniter.UnmarshalFromString(j.Result, &result); err == nil {
+			info.Result = &result
+		}
+	}
+	if withReport {
+		info.ErrorReport = decodeJobReport(j.ErrorReport)
+	}
+	return info
+}
+
+// retryItems 重试的条目：失败的条目及未处理的条目
+func retryItems(items []string, report []dto.JobItemError, processed int) []string {
+	var retry []string
+	for _, e := range report {
+		retry = append(retry, e.Item)
+	}
+	if processed < len(items) {
+		retry = append(retry, items[processed:]...)
+	}
+	return retry
+}
+
+// getOwnJob 查询任务，普通用户只能访问自己提交的任务，API令牌需要设备读权限
+func getOwnJob(c *gin.Context, userID, id int) (*ent.Job, resource.RspCode) {
+	if !tokenPermits(c, dto.PermDeviceView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+	j, err := dto.Client().Job.Get(c, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, resource.ERR_JOB_NOT_EXIST
+		}
+		logger.Error("query job failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if j.CreatedBy != userID && !isSystemAdmin(c, userID) {
+		return nil, resource.ERR_JOB_NOT_EXIST
+	}
+	return j, resource.CODE_SUCCESS
+}
+
+// ListJobs 查询任务列表
+func (s *JobService) ListJobs(c *gin.Context, userID int, query dto.JobQuery) (*dto.PageResult, resource.RspCode) {
+	if !tokenPermits(c, dto.PermDeviceView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+	pg, err := newPaging(query.Page, query.PageSize, query.CursorParams)
+	if err != nil {
+		return nil, resource.ERR_INVALID_PARAMETER
+	}
+
+	q := dto.Client().Job.Query()
+	if !isSystemAdmin(c, userID) {
+		q = q.Where(job.CreatedByEQ(userID))
+	}
+	if query.Type != "" {
+		q = q.Where(job.TypeEQ(job.Type(query.Type)))
+	}
+	if query.Status != "" {
+		q = q.Where(job.StatusEQ(job.Status(query.Status)))
+	}
+
+	total, capped, err := pg.countTotal(c, q.Clone().Count, func(limit int) ([]int, error) {
+		return q.Clone().Limit(limit).IDs(c)
+	})
+	if err != nil {
+		logger.Error("count jobs failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if err := pg.apply(q); err != nil {
+		logger.Error("apply job paging failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	jobs, err := q.All(c)
+	if err != nil {
+		logger.Error("query jobs failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	n, hasMore := pg.trim(len(jobs))
+	jobs = jobs[:n]
+	list := make([]dto.JobInfo, 0, n)
+	for _, j := range jobs {
+		list = append(list, toJobInfo(j, false))
+	}
+	var next string
+	if hasMore {
+		next = encodeCursor(jobs[n-1].CreatedAt, jobs[n-1].ID)
+	}
+	return pg.result(list, total, capped, next), resource.CODE_SUCCESS
+}
+
+// GetJob 查询任务详情，包含失败条目
+func (s *JobService) GetJob(c *gin.Context, userID, id int) (*dto.JobInfo, resource.RspCode) {
+	j, code := getOwnJob(c, userID, id)
+	if code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	info := toJobInfo(j, true)
+	return &info, resource.CODE_SUCCESS
+}
+
+// CancelJob 取消待执行或执行中的任务，已提交的块不会回滚
+func (s *JobService) CancelJob(c *gin.Context, userID, id int) resource.RspCode {
+	if !tokenPermits(c, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
+	}
+	j, code := getOwnJob(c, userID, id)
+	if code != resource.CODE_SUCCESS {
+		return code
+	}
+	n, err := dto.Client().Job.Update().
+		Where(job.IDEQ(j.ID), job.StatusIn(job.StatusPending, job.StatusRunning)).
+		SetStatus(job.StatusCanceled).
+		SetFinishedAt(time.Now()).
+		Save(c)
+	if err != nil {
+		logger.Error("cancel job failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	if n == 0 {
+		return resource.ERR_JOB_STATUS_INVALID
+	}
+	if canceled, err := dto.Client().Job.Get(c, j.ID); err == nil {
+		notifyJob(canceled)
+	}
+	return resource.CODE_SUCCESS
+}
+
+// RetryJob 以失败和未处理的条目创建新任务，重新检查权限
+func (s *JobService) RetryJob(c *gin.Context, userID, id int) (*dto.JobInfo, resource.RspCode) {
+	j, code := getOwnJob(c, userID, id)
+	if code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	if j.Status != job.StatusFailed && j.Status != job.StatusCanceled {
+		return nil, resource.ERR_JOB_STATUS_INVALID
+	}
+
+	runner, err := newJobRunner(j)
+	if err != nil {
+		logger.Error("prepare job failed", zap.Error(err), zap.Int("job_id", j.ID))
+		return nil, resource.ERR_JOB_STATUS_INVALID
+	}
+	items := retryItems(runner.items(), decodeJobReport(j.ErrorReport), j.Processed)
+	if len(items) == 0 {
+		return nil, resource.ERR_JOB_STATUS_INVALID
+	}
+
+	switch param := runner.withItems(items).(type) {
+	case dto.DeviceBatchAdd:
+		return submitDeviceBatchAdd(c, userID, param, j.ID)
+	case dto.DeviceBatchUpdateLicense:
+		return submitDeviceBatchLicense(c, userID, param, j.ID)
+	}
+	return nil, resource.ERR_JOB_STATUS_INVALID
+}
@@ -0,0 +1,368 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/job"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/validate"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// failAll 整块条目以同一原因失败
+func failAll(items []string, reason string) []dto.JobItemError {
+	failures := make([]dto.JobItemError, len(items))
+	for i, item := range items {
+		failures[i] = dto.JobItemError{Item: item, Reason: reason}
+	}
+	return failures
+}
+
+// SubmitDeviceBatchAdd 提交异步批量添加设备任务，SN规则和重复检查在执行时逐条进行，不合规的SN记入失败条目
+func (s *JobService) SubmitDeviceBatchAdd(c *gin.Context, userID int, param dto.DeviceBatchAdd) (*dto.JobInfo, resource.RspCode) {
+	return submitDeviceBatchAdd(c, userID, param, 0)
+}
+
+func submitDeviceBatchAdd(c *gin.Context, userID int, param dto.DeviceBatchAdd, retryOf int) (*dto.JobInfo, resource.RspCode) {
+	// 1. 检查用户权限
+	if !authorize(c, userID, param.ProductID, dto.PermDeviceWrite) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 检查产品、来源订单批次和许可证类型
+	productExist, err := dto.Client().Product.Query().
+		Where(product.IDEQ(param.ProductID)).
+		Exist(c)
+	if err != nil {
+		logger.Error("check product failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if !productExist {
+		return nil, resource.ERR_PRODUCT_NOT_EXIST
+	}
+	if code := applyDeviceSource(c, &param); code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	licenseTypeExist, err := dto.Client().LicenseType.Query().
+		Where(
+			licensetype.IDEQ(param.LicenseTypeID),
+			licensetype.ProductIDEQ(param.ProductID),
+		).Exist(c)
+	if err != nil {
+		logger.Error("check license type failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if !licenseTypeExist {
+		return nil, resource.ERR_LICENSE_TYPE_NOT_EXIST
+	}
+
+	// 3. 过滤空的和重复的SN
+	seen := make(map[string]bool, len(param.SNs))
+	var validSNs []string
+	for _, sn := range param.SNs {
+		sn = strings.TrimSpace(sn)
+		if sn != "" && !seen[sn] {
+			seen[sn] = true
+			validSNs = append(validSNs, sn)
+		}
+	}
+	if len(validSNs) == 0 {
+		return nil, resource.ERR_INVALID_PARAMETER
+	}
+	param.SNs = validSNs
+
+	return submitJob(c, userID, job.TypeDeviceBatchAdd, param, len(validSNs), retryOf)
+}
+
+// deviceBatchAddRunner 批量添加设备任务
+type deviceBatchAddRunner struct {
+	userID  int
+	jobID   int
+	param   dto.DeviceBatchAdd
+	checker *snChecker
+	schema  *validate.AttributeSchema
+	loaded  bool
+}
+
+func newDeviceBatchAddRunner(userID, jobID int, param dto.DeviceBatchAdd) *deviceBatchAddRunner {
+	return &deviceBatchAddRunner{userID: userID, jobID: jobID, param: param}
+}
+
+func (r *deviceBatchAddRunner) items() []string {
+	return r.param.SNs
+}
+
+func (r *deviceBatchAddRunner) withItems(items []string) interface{} {
+	param := r.param
+	param.SNs = items
+	return param
+}
+
+func (r *deviceBatchAddRunner) runChunk(ctx context.Context, tx *ent.Tx, sns []string) ([]dto.JobItemError, []int, error) {
+	if !authorize(ctx, r.userID, r.param.ProductID, dto.PermDeviceWrite) {
+		return failAll(sns, resource.ERR_NO_PERMISSION.Msg()), nil, nil
+	}
+	if !r.loaded {
+		checker, err := loadSnChecker(ctx, r.param.ProductID)
+		if err != nil {
+			return nil, nil, err
+		}
+		schema, err := loadAttributeSchema(ctx, r.param.ProductID)
+		if err != nil {
+			return nil, nil, err
+		}
+		r.checker, r.schema, r.loaded = checker, schema, true
+	}
+
+	// 许可证类型在任务执行期间可能被删除
+	licenseTypeExist, err := tx.LicenseType.Query().
+		Where(
+			licensetype.IDEQ(r.param.LicenseTypeID),
+			licensetype.ProductIDEQ(r.param.ProductID),
+		).Exist(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !licenseTypeExist {
+		return failAll(sns, resource.ERR_LICENSE_TYPE_NOT_EXIST.Msg()), nil, nil
+	}
+
+	// SN全局唯一，需要检查所有产品的设备
+	existingSNs, err := tx.Device.Query().
+		Where(device.SnIn(sns...)).
+		Select(device.FieldSn).
+		Strings(viewer.SystemContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+	existing := make(map[string]bool, len(existingSNs))
+	for _, sn := range existingSNs {
+		existing[sn] = true
+	}
+
+	var failures []dto.JobItemError
+	var created []string
+	now := time.Now()
+	bulk := make([]*ent.DeviceCreate, 0, len(sns))
+	for _, sn := range sns {
+		if reason := r.checker.check(sn); reason != "" {
+			failures = append(failures, dto.JobItemError{Item: sn, Reason: reason})
+			continue
+		}
+		if existing[sn] {
+			failures = append(failures, dto.JobItemError{Item: sn, Reason: dto.SNReasonExist})
+			continue
+		}
+		attrs := deviceAttributes(r.param, sn)
+		if err := r.schema.Validate(attrs); err != nil {
+			failures = append(failures, dto.JobItemError{Item: sn, Reason: err.Error()})
+			continue
+		}
+		created = append(created, sn)
+		create := setDeviceAttributes(tx.Device.Create(), attrs)
+		bulk = append(bulk, setDeviceSource(create, r.param.OrderID, r.param.LotID).
+			SetSn(sn).
+			SetProductID(r.param.ProductID).
+			SetLicenseTypeID(r.param.LicenseTypeID).
+			SetOemTag(r.param.OEMTag).
+			SetRemark(r.param.Remark).
+			SetCreatedAt(now).
+			SetCreatedBy(r.userID).
+			SetUpdatedAt(now).
+			SetUpdatedBy(r.userID))
+	}
+	if len(bulk) == 0 {
+		return failures, nil, nil
+	}
+
+	if err := tx.Device.CreateBulk(bulk...).Exec(ctx); err != nil {
+		return nil, nil, err
+	}
+	err = CreateAuditLog(ctx, tx, dto.AuditLogData{
+		UserID:    r.userID,
+		Action:    dto.ActionCreate,
+		Module:    dto.ModuleDevice,
+		ProductID: r.param.ProductID,
+		DetailInfo: map[string]interface{}{
+			"count":    len(created),
+			"sns":      created,
+			"order_id": r.param.OrderID,
+			"lot_id":   r.param.LotID,
+			"job_id":   r.jobID,
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return failures, []int{r.param.ProductID}, nil
+}
+
+// SubmitDeviceBatchLicense 提交异步批量更新许可证类型任务
+func (s *JobService) SubmitDeviceBatchLicense(c *gin.Context, userID int, param dto.DeviceBatchUpdateLicense) (*dto.JobInfo, resource.RspCode) {
+	return submitDeviceBatchLicense(c, userID, param, 0)
+}
+
+func submitDeviceBatchLicense(c *gin.Context, userID int, param dto.DeviceBatchUpdateLicense, retryOf int) (*dto.JobInfo, resource.RspCode) {
+	deviceIDs, code := resolveDeviceTargets(c, param.DeviceIDs, param.GroupID)
+	if code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	deviceIDs = uniqueInts(deviceIDs)
+	if len(deviceIDs) == 0 {
+		return nil, resource.ERR_INVALID_PARAMETER
+	}
+
+	// 1. 检查设备所属产品的写权限和许可证类型
+	productIDs, err := dto.Client().Device.Query().
+		Where(device.IDIn(deviceIDs...)).
+		Unique(true).
+		Select(device.FieldProductID).
+		Ints(c)
+	if err != nil {
+		logger.Error("query device products failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if len(productIDs) == 0 {
+		return nil, resource.ERR_DEVICE_NOT_EXIST
+	}
+	for _, productID := range productIDs {
+		if !authorize(c, userID, productID, dto.PermDeviceWrite) {
+			return nil, resource.ERR_NO_PERMISSION
+		}
+		licenseTypeExist, err := dto.Client().LicenseType.Query().
+			Where(
+				licensetype.IDEQ(param.LicenseTypeID),
+				licensetype.ProductIDEQ(productID),
+			).Exist(c)
+		if err != nil {
+			logger.Error("check license type failed", zap.Error(err))
+			return nil, resource.ERR_QUERY_FAILED
+		}
+		if !licenseTypeExist {
+			return nil, resource.ERR_LICENSE_TYPE_NOT_EXIST
+		}
+	}
+
+	// 2. 分组在提交时展开为设备ID，执行期间分组成员变化不影响任务
+	param.DeviceIDs = deviceIDs
+	param.GroupID = 0
+	return submitJob(c, userID, job.TypeDeviceBatchLicense, param, len(deviceIDs), retryOf)
+}
+
+// deviceBatchLicenseRunner 批量更新许可证类型任务
+type deviceBatchLicenseRunner struct {
+	userID int
+	jobID  int
+	param  dto.DeviceBatchUpdateLicense
+}
+
+func newDeviceBatchLicenseRunner(userID, jobID int, param dto.DeviceBatchUpdateLicense) *deviceBatchLicenseRunner {
+	return &deviceBatchLicenseRunner{userID: userID, jobID: jobID, param: param}
+}
+
+func (r *deviceBatchLicenseRunner) items() []string {
+	items := make([]string, len(r.param.DeviceIDs))
+	for i, id := range r.param.DeviceIDs {
+		items[i] = strconv.Itoa(id)
+	}
+	return items
+}
+
+func (r *deviceBatchLicenseRunner) withItems(items []string) interface{} {
+	param := r.param
+	param.DeviceIDs = make([]int, 0, len(items))
+	for _, item := range items {
+		if id, err := strconv.Atoi(item); err == nil {
+			param.DeviceIDs = append(param.DeviceIDs, id)
+		}
+	}
+	return param
+}
+
+func (r *deviceBatchLicenseRunner) runChunk(ctx context.Context, tx *ent.Tx, items []string) ([]dto.JobItemError, []int, error) {
+	// 先读取许可证类型所属产品再检查权限，设备查询仍按提交人的产品范围进行
+	lt, err := tx.LicenseType.Get(viewer.SystemContext(ctx), r.param.LicenseTypeID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return failAll(items, resource.ERR_LICENSE_TYPE_NOT_EXIST.Msg()), nil, nil
+		}
+		return nil, nil, err
+	}
+	if !authorize(ctx, r.userID, lt.ProductID, dto.PermDeviceWrite) {
+		return failAll(items, resource.ERR_NO_PERMISSION.Msg()), nil, nil
+	}
+
+	ids := make([]int, 0, len(items))
+	for _, item := range items {
+		if id, err := strconv.Atoi(item); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	devices, err := tx.Device.Query().
+		Where(device.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	byID := make(map[int]*ent.Device, len(devices))
+	for _, d := range devices {
+		byID[d.ID] = d
+	}
+
+	var failures []dto.JobItemError
+	var productIDs []int
+	now := time.Now()
+	for _, item := range items {
+		id, _ := strconv.Atoi(item)
+		d, ok := byID[id]
+		if !ok {
+			failures = append(failures, dto.JobItemError{Item: item, Reason: resource.ERR_DEVICE_NOT_EXIST.Msg()})
+			continue
+		}
+		if d.ProductID != lt.ProductID {
+			failures = append(failures, dto.JobItemError{Item: item, Reason: resource.ERR_LICENSE_TYPE_NOT_EXIST.Msg()})
+			continue
+		}
+
+		oldDevice := *d
+		updatedDevice, err := tx.Device.UpdateOne(d).
+			SetLicenseTypeID(r.param.LicenseTypeID).
+			SetRemark(r.param.Remark).
+			SetUpdatedAt(now).
+			SetUpdatedBy(r.userID).
+			Save(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = CreateAuditLog(ctx, tx, dto.AuditLogData{
+			UserID:    r.userID,
+			Action:    dto.ActionUpdate,
+			Module:    dto.ModuleDevice,
+			ProductID: d.ProductID,
+			DetailInfo: map[string]interface{}{
+				"old_device": oldDevice,
+				"new_device": updatedDevice,
+				"operation":  "batch_update_license",
+				"job_id":     r.jobID,
+			},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(failures) < len(items) {
+		productIDs = append(productIDs, lt.ProductID)
+	}
+	return failures, productIDs, nil
+}
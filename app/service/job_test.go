@@ -0,0 +1,144 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/job"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestRetryItems(t *testing.T) {
+	items := []string{"A1", "A2", "A3", "A4", "A5"}
+	report := []dto.JobItemError{
+		{Item: "A2", Reason: "ERR_DEVICE_SN_EXIST"},
+	}
+
+	// 已取消的任务：失败条目加未处理条目
+	got := retryItems(items, report, 3)
+	if want := []string{"A2", "A4", "A5"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("retryItems(canceled) = %v, want %v", got, want)
+	}
+
+	// 已处理完的任务只重试失败条目
+	got = retryItems(items, report, len(items))
+	if want := []string{"A2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("retryItems(finished) = %v, want %v", got, want)
+	}
+
+	if got := retryItems(items, nil, len(items)); len(got) != 0 {
+		t.Errorf("retryItems(succeeded) = %v, want empty", got)
+	}
+}
+
+func TestDeviceBatchLicenseRunnerItems(t *testing.T) {
+	r := newDeviceBatchLicenseRunner(2, 7, dto.DeviceBatchUpdateLicense{DeviceIDs: []int{3, 5, 8}, LicenseTypeID: 1})
+	items := r.items()
+	if want := []string{"3", "5", "8"}; !reflect.DeepEqual(items, want) {
+		t.Fatalf("items() = %v, want %v", items, want)
+	}
+	param := r.withItems([]string{"5", "8"}).(dto.DeviceBatchUpdateLicense)
+	if !reflect.DeepEqual(param.DeviceIDs, []int{5, 8}) || param.LicenseTypeID != 1 {
+		t.Errorf("withItems() = %+v", param)
+	}
+}
+
+// TestRunJobReauthorizes 任务以提交人当前的权限执行，提交后被移除的管理员和受限的API令牌不能继续处理条目
+func TestRunJobReauthorizes(t *testing.T) {
+	client := testClient(t)
+	ctx := systemCtx()
+
+	// 避开超级管理员的ID
+	u := client.User.Create().SetID(dto.SuperAdminID + 340).SetEmail("job-runner@example.com").SetPassword("x").SaveX(ctx)
+	p1 := client.Product.Create().SetCode("JOB1").SetProductName("任务产品1").SaveX(ctx)
+	p2 := client.Product.Create().SetCode("JOB2").SetProductName("任务产品2").SaveX(ctx)
+	for _, p := range []*ent.Product{p1, p2} {
+		client.ProductManager.Create().SetUserID(u.ID).SetProductID(p.ID).SetRole(productmanager.RoleMain).SaveX(ctx)
+	}
+	oldType := client.LicenseType.Create().SetTypeName("旧").SetLicenseType("JOB-OLD").SetProductID(p1.ID).SaveX(ctx)
+	newType := client.LicenseType.Create().SetTypeName("新").SetLicenseType("JOB-NEW").SetProductID(p1.ID).SaveX(ctx)
+	now := time.Now()
+	d := client.Device.Create().SetSn("JOB-SN-1").SetProductID(p1.ID).SetLicenseTypeID(oldType.ID).
+		SetCreatedAt(now).SetUpdatedAt(now).SaveX(ctx)
+
+	run := func(tokenID int) *ent.Job {
+		t.Helper()
+		params, _ := jsoniter.MarshalToString(dto.DeviceBatchUpdateLicense{DeviceIDs: []int{d.ID}, LicenseTypeID: newType.ID})
+		create := client.Job.Create().SetType(job.TypeDeviceBatchLicense).SetParams(params).SetTotal(1).SetCreatedBy(u.ID)
+		if tokenID != 0 {
+			create.SetAPITokenID(tokenID)
+		}
+		j := create.SaveX(ctx)
+		runJob(j.ID)
+		return client.Job.GetX(ctx, j.ID)
+	}
+	assertDenied := func(name string, j *ent.Job) {
+		t.Helper()
+		if j.Status != job.StatusFailed || j.Failed != 1 {
+			t.Fatalf("%s: status = %s, failed = %d, want failed job", name, j.Status, j.Failed)
+		}
+		if report := decodeJobReport(j.ErrorReport); len(report) != 1 || report[0].Reason != resource.ERR_NO_PERMISSION.Msg() {
+			t.Errorf("%s: error report = %v", name, report)
+		}
+		if got := client.Device.GetX(ctx, d.ID); got.LicenseTypeID != oldType.ID {
+			t.Errorf("%s: license type changed to %d", name, got.LicenseTypeID)
+		}
+	}
+
+	// 令牌只能访问另一个产品
+	token := client.APIToken.Create().SetName("job").SetType("personal").SetTokenPrefix("act_job").SetTokenHash("x").
+		SetUserID(u.ID).SetCreatedBy(u.ID).SetScopes([]string{dto.ScopeDevicesWrite}).SetProductIds([]int{p2.ID}).SaveX(ctx)
+	assertDenied("token for other product", run(token.ID))
+
+	// 令牌在提交后被撤销
+	client.APIToken.UpdateOne(token).SetProductIds(nil).SetRevokedAt(now).SetRevokedBy(u.ID).ExecX(ctx)
+	assertDenied("revoked token", run(token.ID))
+
+	// 提交后被移出产品管理员
+	client.ProductManager.Delete().Where(productmanager.UserIDEQ(u.ID), productmanager.ProductIDEQ(p1.ID)).ExecX(ctx)
+	assertDenied("removed manager", run(0))
+
+	client.ProductManager.Create().SetUserID(u.ID).SetProductID(p1.ID).SetRole(productmanager.RoleMain).SaveX(ctx)
+	if j := run(0); j.Status != job.StatusSucceeded {
+		t.Fatalf("authorized job: status = %s, report = %s", j.Status, j.ErrorReport)
+	}
+	if got := client.Device.GetX(ctx, d.ID); got.LicenseTypeID != newType.ID {
+		t.Errorf("license type = %d, want %d", got.LicenseTypeID, newType.ID)
+	}
+}
+
+func TestJobTokenScope(t *testing.T) {
+	client := testClient(t)
+	ctx := systemCtx()
+	u := client.User.Create().SetID(dto.SuperAdminID + 350).SetEmail("job-token@example.com").SetPassword("x").SaveX(ctx)
+	j := client.Job.Create().SetType(job.TypeDeviceBatchLicense).SetParams("{}").SetTotal(1).SetCreatedBy(u.ID).SaveX(ctx)
+
+	s := NewJobService()
+	versions := &auth.TokenGrant{Scopes: []string{dto.ScopeVersionsRead}}
+	if _, code := s.ListJobs(userGinContext(u.ID, versions), u.ID, dto.JobQuery{}); code != resource.ERR_NO_PERMISSION {
+		t.Errorf("versions:read list: %v", code)
+	}
+	if _, code := s.GetJob(userGinContext(u.ID, versions), u.ID, j.ID); code != resource.ERR_NO_PERMISSION {
+		t.Errorf("versions:read get: %v", code)
+	}
+	read := &auth.TokenGrant{Scopes: []string{dto.ScopeDevicesRead}}
+	if result, code := s.ListJobs(userGinContext(u.ID, read), u.ID, dto.JobQuery{}); code != resource.CODE_SUCCESS || len(result.List.([]dto.JobInfo)) != 1 {
+		t.Errorf("devices:read list: %v", code)
+	}
+	if code := s.CancelJob(userGinContext(u.ID, read), u.ID, j.ID); code != resource.ERR_NO_PERMISSION {
+		t.Errorf("devices:read cancel: %v", code)
+	}
+	if got := client.Job.GetX(ctx, j.ID); got.Status != job.StatusPending {
+		t.Errorf("status = %s, want pending", got.Status)
+	}
+	write := &auth.TokenGrant{Scopes: []string{dto.ScopeDevicesWrite}}
+	if code := s.CancelJob(userGinContext(u.ID, write), u.ID, j.ID); code != resource.CODE_SUCCESS {
+		t.Errorf("devices:write cancel: %v", code)
+	}
+}
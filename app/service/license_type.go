@@ -3,13 +3,18 @@ package service
 import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/lot"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
-	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
 	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
 	"cambridge-hit.com/gin-base/activateserver/resource"
+	"context"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"time"
 )
 
 type LicenseTypeService struct{}
@@ -21,15 +26,7 @@ func NewLicenseTypeService() *LicenseTypeService {
 // ListLicenseTypes 获取许可证类型列表
 func (s *LicenseTypeService) ListLicenseTypes(c *gin.Context, userID, productID int, page, pageSize int) (*dto.PageResult, resource.RspCode) {
 	// 检查用户是否有权限查看该产品的许可证类型
-	_, err := dto.Client().ProductManager.Query().
-		Where(
-			productmanager.ProductIDEQ(productID),
-			productmanager.UserIDEQ(userID),
-		).Only(c)
-
-	// 超级管理员跳过权限检查
-	if err != nil && userID != 1 {
-		logger.Error("check permission failed", zap.Error(err))
+	if !authorize(c, userID, productID, dto.PermProductView) {
 		return nil, resource.ERR_NO_PERMISSION
 	}
 
@@ -77,15 +74,10 @@ func (s *LicenseTypeService) ListLicenseTypes(c *gin.Context, userID, productID
 }
 
 // AddLicenseType 添加许可证类型
-func (s *LicenseTypeService) AddLicenseType(c *gin.Context, userID int, param dto.AddLicenseType) resource.RspCode {
+func (s *LicenseTypeService) AddLicenseType(c *gin.Context, userID int, param dto.AddLicenseType) ([]dto.FeatureViolation, resource.RspCode) {
 	// 1. 检查用户权限
-	pm, err := dto.Client().ProductManager.Query().
-		Where(
-			productmanager.ProductIDEQ(param.ProductID),
-			productmanager.UserIDEQ(userID),
-		).Only(c)
-	if err != nil || (userID != 1 && pm.Permissions == productmanager.PermissionsRead) {
-		return resource.ERR_NO_PERMISSION
+	if !authorize(c, userID, param.ProductID, dto.PermProductEdit) {
+		return nil, resource.ERR_NO_PERMISSION
 	}
 
 	// 2.1. 检查类型名称是否已存在
@@ -96,10 +88,10 @@ func (s *LicenseTypeService) AddLicenseType(c *gin.Context, userID int, param dt
 		).Exist(c)
 	if err != nil {
 		logger.Error("check license type name failed", zap.Error(err))
-		return resource.ERR_QUERY_FAILED
+		return nil, resource.ERR_QUERY_FAILED
 	}
 	if exist {
-		return resource.ERR_LICENSE_TYPE_EXIST
+		return nil, resource.ERR_LICENSE_TYPE_EXIST
 	}
 
 	// 2.2. 检查许可证类型代码是否已存在
@@ -110,10 +102,16 @@ func (s *LicenseTypeService) AddLicenseType(c *gin.Context, userID int, param dt
 		).Exist(c)
 	if err != nil {
 		logger.Error("check license type code failed", zap.Error(err))
-		return resource.ERR_QUERY_FAILED
+		return nil, resource.ERR_QUERY_FAILED
 	}
 	if exist {
-		return resource.ERR_LICENSE_CODE_EXIST
+		return nil, resource.ERR_LICENSE_CODE_EXIST
+	}
+
+	// 2.3. 校验功能依赖和互斥关系
+	featureIDs, violations, code := checkLicenseTypeFeatures(c, param.ProductID, 0, param.ParentID, param.FeatureIDs, param.AutoInclude)
+	if code != resource.CODE_SUCCESS {
+		return violations, code
 	}
 
 	// 3. 开始事务
@@ -121,7 +119,7 @@ func (s *LicenseTypeService) AddLicenseType(c *gin.Context, userID int, param dt
 	tx, err := client.Tx(c.Request.Context())
 	if err != nil {
 		logger.Error("start transaction failed", zap.Error(err))
-		return resource.ERR_ADD_FAILED
+		return nil, resource.ERR_ADD_FAILED
 	}
 
 	// 定义延迟函数处理事务回滚
@@ -133,35 +131,38 @@ func (s *LicenseTypeService) AddLicenseType(c *gin.Context, userID int, param dt
 	}()
 
 	// 创建许可证类型
-	lt, err := tx.LicenseType.Create().
+	create := tx.LicenseType.Create().
 		SetProductID(param.ProductID).
 		SetTypeName(param.TypeName).
-		SetLicenseType(param.LicenseType).
-		Save(c)
+		SetLicenseType(param.LicenseType)
+	if param.ParentID > 0 {
+		create.SetParentID(param.ParentID)
+	}
+	lt, err := create.Save(c)
 	if err != nil {
 		logger.Error("create license type failed", zap.Error(err))
 		_ = tx.Rollback()
-		return resource.ERR_ADD_FAILED
+		return nil, resource.ERR_ADD_FAILED
 	}
 
 	// 添加功能关联
-	if len(param.FeatureIDs) > 0 {
+	if len(featureIDs) > 0 {
 		features, err := tx.ProductFeature.Query().
 			Where(
-				productfeature.IDIn(param.FeatureIDs...),
+				productfeature.IDIn(featureIDs...),
 				productfeature.ProductIDEQ(param.ProductID),
 			).All(c)
 		if err != nil {
 			logger.Error("query features failed", zap.Error(err))
 			_ = tx.Rollback()
-			return resource.ERR_QUERY_FAILED
+			return nil, resource.ERR_QUERY_FAILED
 		}
 
 		err = lt.Update().AddFeatures(features...).Exec(c)
 		if err != nil {
 			logger.Error("add features failed", zap.Error(err))
 			_ = tx.Rollback()
-			return resource.ERR_ADD_FAILED
+			return nil, resource.ERR_ADD_FAILED
 		}
 	}
 
@@ -170,7 +171,7 @@ func (s *LicenseTypeService) AddLicenseType(c *gin.Context, userID int, param dt
 	if err != nil {
 		logger.Error("get license type failed", zap.Error(err))
 		_ = tx.Rollback()
-		return resource.ERR_QUERY_FAILED
+		return nil, resource.ERR_QUERY_FAILED
 	}
 
 	// 创建审计日志
@@ -184,16 +185,16 @@ func (s *LicenseTypeService) AddLicenseType(c *gin.Context, userID int, param dt
 	if err != nil {
 		logger.Error("create audit log failed", zap.Error(err))
 		_ = tx.Rollback()
-		return resource.ERR_ADD_FAILED
+		return nil, resource.ERR_ADD_FAILED
 	}
 
 	// 提交事务
 	if err := tx.Commit(); err != nil {
 		logger.Error("commit transaction failed", zap.Error(err))
-		return resource.ERR_ADD_FAILED
+		return nil, resource.ERR_ADD_FAILED
 	}
 
-	return resource.CODE_SUCCESS
+	return nil, resource.CODE_SUCCESS
 }
 
 // DeleteLicenseType 删除许可证类型
@@ -206,15 +207,29 @@ func (s *LicenseTypeService) DeleteLicenseType(c *gin.Context, userID, typeID in
 	}
 
 	// 2. 检查用户权限
-	pm, err := dto.Client().ProductManager.Query().
-		Where(
-			productmanager.ProductIDEQ(lt.ProductID),
-			productmanager.UserIDEQ(userID),
-		).Only(c)
-	if err != nil || (userID != 1 && pm.Permissions == productmanager.PermissionsRead) {
+	if !authorize(c, userID, lt.ProductID, dto.PermProductEdit) {
 		return resource.ERR_NO_PERMISSION
 	}
 
+	// 被其它类型继承时不能删除
+	exist, err := dto.Client().LicenseType.Query().Where(licensetype.ParentIDEQ(lt.ID)).Exist(c)
+	if err != nil {
+		logger.Error("check license type children failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if exist {
+		return resource.ERR_LICENSE_TYPE_IS_PARENT
+	}
+	// 被设备、订单或批次使用时不能删除，否则设备会失去全部功能
+	inUse, err := licenseTypeInUse(c, dto.Client(), lt.ID)
+	if err != nil {
+		logger.Error("check license type usage failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if inUse {
+		return resource.ERR_LICENSE_TYPE_IN_USE
+	}
+
 	// 3. 开始事务
 	tx, err := dto.Client().Tx(c)
 	if err != nil {
@@ -228,18 +243,8 @@ func (s *LicenseTypeService) DeleteLicenseType(c *gin.Context, userID, typeID in
 		}
 	}()
 
-	// 4. 先清除许可证类型与功能的关联关系
-	_, err = tx.LicenseType.UpdateOneID(typeID).
-		ClearFeatures().
-		Save(c)
-	if err != nil {
-		logger.Error("failed to clear license type features", zap.Error(err))
-		_ = tx.Rollback()
-		return resource.ERR_DEL_FAILED
-	}
-
-	// 5. 删除许可证类型
-	err = tx.LicenseType.DeleteOne(lt).Exec(c)
+	// 4. 移入回收站，保留与功能的关联关系以便恢复
+	err = tx.LicenseType.UpdateOne(lt).SetDeletedAt(time.Now()).Exec(c)
 	if err != nil {
 		logger.Error("failed to delete license type", zap.Error(err))
 		_ = tx.Rollback()
@@ -261,7 +266,7 @@ func (s *LicenseTypeService) DeleteLicenseType(c *gin.Context, userID, typeID in
 		return resource.ERR_ADD_FAILED
 	}
 
-	// 6. 提交事务
+	// 5. 提交事务
 	if err := tx.Commit(); err != nil {
 		logger.Error("failed to commit transaction", zap.Error(err))
 		return resource.ERR_DEL_FAILED
@@ -271,30 +276,32 @@ func (s *LicenseTypeService) DeleteLicenseType(c *gin.Context, userID, typeID in
 }
 
 // UpdateLicenseTypeFeatures 更新许可证类型功能列表
-func (s *LicenseTypeService) UpdateLicenseTypeFeatures(c *gin.Context, userID int, param dto.UpdateLicenseTypeFeatures) resource.RspCode {
+func (s *LicenseTypeService) UpdateLicenseTypeFeatures(c *gin.Context, userID int, param dto.UpdateLicenseTypeFeatures) ([]dto.FeatureViolation, resource.RspCode) {
 	// 1. 获取许可证类型信息
 	lt, err := dto.Client().LicenseType.Query().
 		Where(licensetype.ID(param.TypeID)).
 		Only(c)
 	if err != nil {
 		logger.Error("get license type failed", zap.Error(err))
-		return resource.ERR_QUERY_FAILED
+		return nil, resource.ERR_QUERY_FAILED
 	}
 
 	// 2. 检查用户权限
-	pm, err := dto.Client().ProductManager.Query().
-		Where(
-			productmanager.ProductIDEQ(lt.ProductID),
-			productmanager.UserIDEQ(userID),
-		).Only(c)
-	if err != nil || (userID != 1 && pm.Permissions == productmanager.PermissionsRead) {
-		return resource.ERR_NO_PERMISSION
+	if !authorize(c, userID, lt.ProductID, dto.PermProductEdit) {
+		return nil, resource.ERR_NO_PERMISSION
 	}
+
+	// 校验功能依赖和互斥关系
+	featureIDs, violations, code := checkLicenseTypeFeatures(c, lt.ProductID, lt.ID, parentIDOf(lt), param.FeatureIDs, param.AutoInclude)
+	if code != resource.CODE_SUCCESS {
+		return violations, code
+	}
+
 	// 3. 开启事务
 	tx, err := dto.Client().Tx(c)
 	if err != nil {
 		logger.Error("failed to start transaction", zap.Error(err))
-		return resource.ERR_OPERATION_FAILED
+		return nil, resource.ERR_OPERATION_FAILED
 	}
 
 	// 4. 获取旧的功能列表
@@ -303,7 +310,7 @@ func (s *LicenseTypeService) UpdateLicenseTypeFeatures(c *gin.Context, userID in
 		WithFeatures().Only(c)
 	if err != nil {
 		logger.Error("query old features failed", zap.Error(err))
-		return resource.ERR_QUERY_FAILED
+		return nil, resource.ERR_QUERY_FAILED
 	}
 
 	// 5. 清除现有功能关联
@@ -311,28 +318,28 @@ func (s *LicenseTypeService) UpdateLicenseTypeFeatures(c *gin.Context, userID in
 	if err != nil {
 		logger.Error("clear features failed", zap.Error(err))
 		_ = tx.Rollback()
-		return resource.ERR_MOD_FAILED
+		return nil, resource.ERR_MOD_FAILED
 	}
 
 	// 6. 添加新的功能关联
 	var newFeatures []*ent.ProductFeature
-	if len(param.FeatureIDs) > 0 {
+	if len(featureIDs) > 0 {
 		newFeatures, err = tx.ProductFeature.Query().
 			Where(
-				productfeature.IDIn(param.FeatureIDs...),
+				productfeature.IDIn(featureIDs...),
 				productfeature.ProductIDEQ(lt.ProductID),
 			).All(c)
 		if err != nil {
 			logger.Error("query features failed", zap.Error(err))
 			_ = tx.Rollback()
-			return resource.ERR_QUERY_FAILED
+			return nil, resource.ERR_QUERY_FAILED
 		}
 
 		err = tx.LicenseType.UpdateOne(lt).AddFeatures(newFeatures...).Exec(c)
 		if err != nil {
 			logger.Error("add features failed", zap.Error(err))
 			_ = tx.Rollback()
-			return resource.ERR_MOD_FAILED
+			return nil, resource.ERR_MOD_FAILED
 		}
 	}
 
@@ -342,7 +349,7 @@ func (s *LicenseTypeService) UpdateLicenseTypeFeatures(c *gin.Context, userID in
 		WithFeatures().Only(c)
 	if err != nil {
 		logger.Error("get new license type failed", zap.Error(err))
-		return resource.ERR_QUERY_FAILED
+		return nil, resource.ERR_QUERY_FAILED
 	}
 
 	err = CreateAuditLog(c, tx, dto.AuditLogData{
@@ -358,14 +365,26 @@ func (s *LicenseTypeService) UpdateLicenseTypeFeatures(c *gin.Context, userID in
 	if err != nil {
 		logger.Error("create audit log failed", zap.Error(err))
 		_ = tx.Rollback()
-		return resource.ERR_ADD_FAILED
+		return nil, resource.ERR_ADD_FAILED
 	}
 
 	// 7. 提交事务
 	if err := tx.Commit(); err != nil {
 		logger.Error("failed to commit transaction", zap.Error(err))
-		return resource.ERR_MOD_FAILED
+		return nil, resource.ERR_MOD_FAILED
 	}
 
-	return resource.CODE_SUCCESS
+	return nil, resource.CODE_SUCCESS
+}
+
+// licenseTypeInUse 许可证类型是否被未删除的设备、订单或批次使用
+func licenseTypeInUse(ctx context.Context, client *ent.Client, typeID int) (bool, error) {
+	ctx = viewer.SystemContext(ctx)
+	if exist, err := client.Device.Query().Where(device.LicenseTypeIDEQ(typeID)).Exist(ctx); err != nil || exist {
+		return exist, err
+	}
+	if exist, err := client.Order.Query().Where(order.LicenseTypeIDEQ(typeID)).Exist(ctx); err != nil || exist {
+		return exist, err
+	}
+	return client.Lot.Query().Where(lot.LicenseTypeIDEQ(typeID)).Exist(ctx)
 }
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+)
+
+// TestDeleteLicenseTypeInUse 被设备、订单或批次使用的许可证类型不能删除，否则设备会失去全部功能
+func TestDeleteLicenseTypeInUse(t *testing.T) {
+	client := testClient(t)
+	ctx := systemCtx()
+	u := client.User.Create().SetID(dto.SuperAdminID + 510).SetEmail("lt-delete@example.com").SetPassword("x").SaveX(ctx)
+	p := client.Product.Create().SetCode("LTD1").SetProductName("许可证删除产品").SaveX(ctx)
+	client.ProductManager.Create().SetUserID(u.ID).SetProductID(p.ID).SetRole(productmanager.RoleMain).SaveX(ctx)
+	newType := func(code string) int {
+		return client.LicenseType.Create().SetTypeName(code).SetLicenseType(code).SetProductID(p.ID).SaveX(ctx).ID
+	}
+	deviceType, orderType, lotType, unusedType := newType("LTD-DEV"), newType("LTD-ORD"), newType("LTD-LOT"), newType("LTD-FREE")
+
+	now := time.Now()
+	d := client.Device.Create().SetSn("LTD-SN-1").SetProductID(p.ID).SetLicenseTypeID(deviceType).
+		SetCreatedAt(now).SetUpdatedAt(now).SaveX(ctx)
+	client.Order.Create().SetOrderNo("LTD-O1").SetProductID(p.ID).SetLicenseTypeID(orderType).SetQuantity(1).SaveX(ctx)
+	client.Lot.Create().SetLotNo("LTD-L1").SetProductID(p.ID).SetLicenseTypeID(lotType).SetQuantity(1).SaveX(ctx)
+
+	s := &LicenseTypeService{}
+	del := func(id int) resource.RspCode {
+		return s.DeleteLicenseType(userGinContext(u.ID, nil), u.ID, id)
+	}
+	for name, id := range map[string]int{"device": deviceType, "order": orderType, "lot": lotType} {
+		if code := del(id); code != resource.ERR_LICENSE_TYPE_IN_USE {
+			t.Errorf("delete type used by %s: %v", name, code)
+		}
+		if _, err := client.LicenseType.Get(ctx, id); err != nil {
+			t.Errorf("type used by %s was deleted: %v", name, err)
+		}
+	}
+	if code := del(unusedType); code != resource.CODE_SUCCESS {
+		t.Errorf("delete unused type: %v", code)
+	}
+
+	// 回收站中的设备不算使用
+	client.Device.UpdateOne(d).SetDeletedAt(now).ExecX(ctx)
+	if code := del(deviceType); code != resource.CODE_SUCCESS {
+		t.Errorf("delete type used only by deleted device: %v", code)
+	}
+}
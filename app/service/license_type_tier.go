@@ -0,0 +1,498 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	licenseTierDeviceChunk = 1000 // 计算影响报告时每次读取的设备数量
+	licenseTierReportLimit = 1000 // 影响报告中最多列出的设备数量
+	licenseTierMaxDepth    = 32   // 继承链的最大深度
+)
+
+// licenseTypeTree 许可证类型的继承关系和各自直接关联的功能，已删除的类型不在树中，
+// 父类型已删除的类型视为没有父类型
+type licenseTypeTree struct {
+	types    map[int]*ent.LicenseType
+	parent   map[int]int
+	features map[int][]int
+	byID     map[int]*ent.ProductFeature
+}
+
+// newLicenseTypeTree 由预先加载了功能的许可证类型构建
+func newLicenseTypeTree(types []*ent.LicenseType) *licenseTypeTree {
+	t := &licenseTypeTree{
+		types:    make(map[int]*ent.LicenseType, len(types)),
+		parent:   make(map[int]int),
+		features: make(map[int][]int, len(types)),
+		byID:     make(map[int]*ent.ProductFeature),
+	}
+	for _, lt := range types {
+		t.types[lt.ID] = lt
+		if lt.ParentID != nil {
+			t.parent[lt.ID] = *lt.ParentID
+		}
+		ids := make([]int, 0, len(lt.Edges.Features))
+		for _, f := range lt.Edges.Features {
+			t.byID[f.ID] = f
+			ids = append(ids, f.ID)
+		}
+		sort.Ints(ids)
+		t.features[lt.ID] = ids
+	}
+	return t
+}
+
+// loadLicenseTypeTree 查询产品的全部许可证类型及其功能
+func loadLicenseTypeTree(ctx context.Context, client *ent.Client, productIDs ...int) (*licenseTypeTree, error) {
+	types, err := client.LicenseType.Query().
+		Where(licensetype.ProductIDIn(productIDs...)).
+		WithFeatures().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newLicenseTypeTree(types), nil
+}
+
+// clone 复制继承关系和功能列表，用于计算修改后的结果
+func (t *licenseTypeTree) clone() *licenseTypeTree {
+	next := &licenseTypeTree{
+		types:    t.types,
+		parent:   make(map[int]int, len(t.parent)),
+		features: make(map[int][]int, len(t.features)),
+		byID:     t.byID,
+	}
+	for id, p := range t.parent {
+		next.parent[id] = p
+	}
+	for id, fs := range t.features {
+		next.features[id] = fs
+	}
+	return next
+}
+
+func (t *licenseTypeTree) setParent(id, parentID int) {
+	if parentID > 0 {
+		t.parent[id] = parentID
+	} else {
+		delete(t.parent, id)
+	}
+}
+
+func (t *licenseTypeTree) code(id int) string {
+	if lt, ok := t.types[id]; ok {
+		return lt.LicenseType
+	}
+	return fmt.Sprintf("#%d", id)
+}
+
+// chain 从id开始沿父类型向上的继承链，父类型不在树中时结束；存在环时返回false
+func (t *licenseTypeTree) chain(id int) ([]int, bool) {
+	result := []int{id}
+	seen := map[int]bool{id: true}
+	for {
+		p, ok := t.parent[id]
+		if !ok {
+			return result, true
+		}
+		if _, exist := t.types[p]; !exist {
+			return result, true
+		}
+		if seen[p] || len(result) >= licenseTierMaxDepth {
+			return result, false
+		}
+		seen[p] = true
+		result = append(result, p)
+		id = p
+	}
+}
+
+// effective 有效功能ID：继承链上所有类型功能的并集，按ID排序
+func (t *licenseTypeTree) effective(id int) []int {
+	chain, _ := t.chain(id)
+	seen := make(map[int]bool)
+	var ids []int
+	for _, lt := range chain {
+		for _, f := range t.features[lt] {
+			if !seen[f] {
+				seen[f] = true
+				ids = append(ids, f)
+			}
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// effectiveFeatures 有效功能，按ID排序
+func (t *licenseTypeTree) effectiveFeatures(id int) []*ent.ProductFeature {
+	ids := t.effective(id)
+	features := make([]*ent.ProductFeature, 0, len(ids))
+	for _, f := range ids {
+		if feature, ok := t.byID[f]; ok {
+			features = append(features, feature)
+		}
+	}
+	return features
+}
+
+// descendants 直接或间接继承id的类型，按ID排序
+func (t *licenseTypeTree) descendants(id int) []int {
+	var ids []int
+	for child := range t.types {
+		if child == id {
+			continue
+		}
+		chain, _ := t.chain(child)
+		for _, lt := range chain[1:] {
+			if lt == id {
+				ids = append(ids, child)
+				break
+			}
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// featureViolations 校验id及继承它的类型的有效功能是否满足功能的依赖和互斥关系
+func (t *licenseTypeTree) featureViolations(g *featureGraph, id int) []dto.FeatureViolation {
+	result := g.violations(t.effective(id))
+	for _, child := range t.descendants(id) {
+		for _, v := range g.violations(t.effective(child)) {
+			v.Message = fmt.Sprintf("inherited by license type %s: %s", t.code(child), v.Message)
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// diffFeatureCodes 两组功能ID之间增加和减少的功能编码
+func (t *licenseTypeTree) diffFeatureCodes(before, after []int) (added, removed []string) {
+	return diffCodes(t.featureCodes(before), t.featureCodes(after))
+}
+
+func (t *licenseTypeTree) featureCodes(ids []int) []string {
+	codes := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if f, ok := t.byID[id]; ok {
+			codes = append(codes, f.FeatureCode)
+		}
+	}
+	return codes
+}
+
+// diffCodes 返回after相对before增加和减少的编码，均已排序
+func diffCodes(before, after []string) (added, removed []string) {
+	old := make(map[string]bool, len(before))
+	for _, c := range before {
+		old[c] = true
+	}
+	cur := make(map[string]bool, len(after))
+	for _, c := range after {
+		cur[c] = true
+		if !old[c] {
+			added = append(added, c)
+		}
+	}
+	for _, c := range before {
+		if !cur[c] {
+			removed = append(removed, c)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+// checkLicenseTypeFeatures 校验许可证类型的功能组合（包含继承的功能，以及继承本类型的类型），
+// autoInclude时自动加入依赖且未继承的功能，返回本类型最终直接关联的功能ID；typeID为0表示新建
+func checkLicenseTypeFeatures(ctx context.Context, productID, typeID, parentID int, featureIDs []int, autoInclude bool) ([]int, []dto.FeatureViolation, resource.RspCode) {
+	tree, err := loadLicenseTypeTree(ctx, dto.Client(), productID)
+	if err != nil {
+		logger.Error("query license types failed", zap.Error(err))
+		return nil, nil, resource.ERR_QUERY_FAILED
+	}
+	g, err := loadFeatureGraph(ctx, dto.Client(), productID)
+	if err != nil {
+		logger.Error("query feature graph failed", zap.Error(err))
+		return nil, nil, resource.ERR_QUERY_FAILED
+	}
+	if _, ok := tree.types[parentID]; parentID > 0 && !ok {
+		return nil, nil, resource.ERR_LICENSE_TYPE_NOT_EXIST
+	}
+
+	next := tree.clone()
+	next.setParent(typeID, parentID)
+	if autoInclude {
+		var inherited []int
+		if parentID > 0 {
+			inherited = next.effective(parentID)
+		}
+		skip := make(map[int]bool, len(inherited))
+		for _, id := range inherited {
+			skip[id] = true
+		}
+		own := make([]int, 0, len(featureIDs))
+		for _, id := range g.withDependencies(append(append([]int(nil), featureIDs...), inherited...)) {
+			if !skip[id] {
+				own = append(own, id)
+			}
+		}
+		featureIDs = own
+	}
+	next.features[typeID] = featureIDs
+	if violations := next.featureViolations(g, typeID); len(violations) > 0 {
+		return nil, violations, resource.ERR_FEATURE_CONSTRAINT
+	}
+	return featureIDs, nil, resource.CODE_SUCCESS
+}
+
+// GetEffectiveFeatures 获取许可证类型的继承链和有效功能
+func (s *LicenseTypeService) GetEffectiveFeatures(c *gin.Context, userID, typeID int) (*dto.LicenseTypeEffectiveFeatures, resource.RspCode) {
+	lt, err := dto.Client().LicenseType.Get(c, typeID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, resource.ERR_LICENSE_TYPE_NOT_EXIST
+		}
+		logger.Error("get license type failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if !authorize(c, userID, lt.ProductID, dto.PermProductView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	tree, err := loadLicenseTypeTree(c, dto.Client(), lt.ProductID)
+	if err != nil {
+		logger.Error("query license types failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	chain, _ := tree.chain(typeID)
+	result := &dto.LicenseTypeEffectiveFeatures{
+		TypeID:   typeID,
+		Chain:    make([]dto.LicenseTypeRef, 0, len(chain)),
+		Features: []dto.EffectiveFeature{},
+	}
+	// 功能来源取继承链上最近的类型
+	source := make(map[int]int)
+	for _, id := range chain {
+		t := tree.types[id]
+		result.Chain = append(result.Chain, dto.LicenseTypeRef{ID: t.ID, LicenseType: t.LicenseType, TypeName: t.TypeName})
+		for _, f := range tree.features[id] {
+			if _, ok := source[f]; !ok {
+				source[f] = id
+			}
+		}
+	}
+	for _, f := range tree.effectiveFeatures(typeID) {
+		src := source[f.ID]
+		result.Features = append(result.Features, dto.EffectiveFeature{
+			FeatureID:    f.ID,
+			FeatureCode:  f.FeatureCode,
+			FeatureName:  f.FeatureName,
+			SourceTypeID: src,
+			SourceType:   tree.code(src),
+			Inherited:    src != typeID,
+		})
+	}
+	return result, resource.CODE_SUCCESS
+}
+
+// SetLicenseTypeParent 设置或清除许可证类型的父类型，返回本类型及继承它的类型、设备的有效功能变化
+func (s *LicenseTypeService) SetLicenseTypeParent(c *gin.Context, userID int, param dto.SetLicenseTypeParent) (*dto.LicenseTypeParentResult, []dto.FeatureViolation, resource.RspCode) {
+	lt, err := dto.Client().LicenseType.Get(c, param.TypeID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, resource.ERR_LICENSE_TYPE_NOT_EXIST
+		}
+		logger.Error("get license type failed", zap.Error(err))
+		return nil, nil, resource.ERR_QUERY_FAILED
+	}
+	if !authorize(c, userID, lt.ProductID, dto.PermProductEdit) {
+		return nil, nil, resource.ERR_NO_PERMISSION
+	}
+
+	tree, err := loadLicenseTypeTree(c, dto.Client(), lt.ProductID)
+	if err != nil {
+		logger.Error("query license types failed", zap.Error(err))
+		return nil, nil, resource.ERR_QUERY_FAILED
+	}
+	if param.ParentID > 0 {
+		// 父类型需属于同一产品
+		if _, ok := tree.types[param.ParentID]; !ok {
+			return nil, nil, resource.ERR_LICENSE_TYPE_NOT_EXIST
+		}
+	}
+	next := tree.clone()
+	next.setParent(lt.ID, param.ParentID)
+	if _, ok := next.chain(lt.ID); !ok || param.ParentID == lt.ID {
+		return nil, nil, resource.ERR_LICENSE_TYPE_CYCLE
+	}
+
+	g, err := loadFeatureGraph(c, dto.Client(), lt.ProductID)
+	if err != nil {
+		logger.Error("query feature graph failed", zap.Error(err))
+		return nil, nil, resource.ERR_QUERY_FAILED
+	}
+	if violations := next.featureViolations(g, lt.ID); len(violations) > 0 {
+		return nil, violations, resource.ERR_FEATURE_CONSTRAINT
+	}
+
+	result := &dto.LicenseTypeParentResult{
+		TypeID:       lt.ID,
+		ParentID:     param.ParentID,
+		DryRun:       param.DryRun,
+		LicenseTypes: []dto.LicenseTypeFeatureChange{},
+		Devices:      []dto.DeviceFeatureChange{},
+	}
+	if lt.ParentID != nil {
+		result.OldParentID = *lt.ParentID
+	}
+	if err := licenseTierReport(c, tree, next, lt.ID, result); err != nil {
+		logger.Error("build license type parent report failed", zap.Error(err))
+		return nil, nil, resource.ERR_QUERY_FAILED
+	}
+	if param.DryRun {
+		return result, nil, resource.CODE_SUCCESS
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return nil, nil, resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	update := tx.LicenseType.UpdateOneID(lt.ID)
+	if param.ParentID > 0 {
+		update.SetParentID(param.ParentID)
+	} else {
+		update.ClearParentID()
+	}
+	if err := update.Exec(c); err != nil {
+		logger.Error("set license type parent failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, nil, resource.ERR_MOD_FAILED
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionUpdate,
+		Module:    dto.ModuleLicenseType,
+		ProductID: lt.ProductID,
+		DetailInfo: map[string]interface{}{
+			"operation":     "set_parent",
+			"license_type":  lt.LicenseType,
+			"old_parent_id": result.OldParentID,
+			"parent_id":     param.ParentID,
+			"license_types": result.LicenseTypes,
+			"device_total":  result.DeviceTotal,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, nil, resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return nil, nil, resource.ERR_MOD_FAILED
+	}
+
+	return result, nil, resource.CODE_SUCCESS
+}
+
+// licenseTierReport 比较修改前后typeID及继承它的类型的有效功能，并逐台计算受影响设备的变化
+func licenseTierReport(ctx context.Context, before, after *licenseTypeTree, typeID int, result *dto.LicenseTypeParentResult) error {
+	changed := make(map[int]int)
+	for _, id := range append([]int{typeID}, before.descendants(typeID)...) {
+		added, removed := before.diffFeatureCodes(before.effective(id), after.effective(id))
+		if len(added) == 0 && len(removed) == 0 {
+			continue
+		}
+		changed[id] = len(result.LicenseTypes)
+		result.LicenseTypes = append(result.LicenseTypes, dto.LicenseTypeFeatureChange{
+			TypeID:      id,
+			LicenseType: before.code(id),
+			Added:       added,
+			Removed:     removed,
+		})
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	ids := make([]int, 0, len(changed))
+	for id := range changed {
+		ids = append(ids, id)
+	}
+	now := time.Now()
+	lastID := 0
+	for {
+		devices, err := dto.Client().Device.Query().
+			Where(device.LicenseTypeIDIn(ids...), device.IDGT(lastID)).
+			Order(ent.Asc(device.FieldID)).
+			Limit(licenseTierDeviceChunk).
+			WithFeatureOverrides(func(q *ent.DeviceFeatureOverrideQuery) {
+				q.WithFeature()
+			}).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, d := range devices {
+			// 设备的附加项可能抵消许可证类型的变化
+			added, removed := diffCodes(
+				activeFeatureCodes(mergeDeviceFeatures(before.effectiveFeatures(d.LicenseTypeID), d.Edges.FeatureOverrides, now)),
+				activeFeatureCodes(mergeDeviceFeatures(after.effectiveFeatures(d.LicenseTypeID), d.Edges.FeatureOverrides, now)),
+			)
+			if len(added) == 0 && len(removed) == 0 {
+				continue
+			}
+			result.LicenseTypes[changed[d.LicenseTypeID]].DeviceCount++
+			result.DeviceTotal++
+			if len(result.Devices) >= licenseTierReportLimit {
+				result.Truncated = true
+				continue
+			}
+			result.Devices = append(result.Devices, dto.DeviceFeatureChange{
+				DeviceID:    d.ID,
+				SN:          d.Sn,
+				LicenseType: before.code(d.LicenseTypeID),
+				Added:       added,
+				Removed:     removed,
+			})
+		}
+		if len(devices) < licenseTierDeviceChunk {
+			return nil
+		}
+		lastID = devices[len(devices)-1].ID
+	}
+}
+
+func parentIDOf(lt *ent.LicenseType) int {
+	if lt.ParentID == nil {
+		return 0
+	}
+	return *lt.ParentID
+}
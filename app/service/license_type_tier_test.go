@@ -0,0 +1,100 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+)
+
+func testLicenseType(id int, code string, parentID int, features ...*ent.ProductFeature) *ent.LicenseType {
+	lt := &ent.LicenseType{ID: id, LicenseType: code}
+	if parentID > 0 {
+		lt.ParentID = &parentID
+	}
+	lt.Edges.Features = features
+	return lt
+}
+
+// testLicenseTypeTree BASIC <- PRO <- ENT，LEGACY的父类型已删除
+func testLicenseTypeTree() (*licenseTypeTree, map[string]*ent.ProductFeature) {
+	_, f := testFeatureGraph()
+	return newLicenseTypeTree([]*ent.LicenseType{
+		testLicenseType(1, "BASIC", 0, f["NET"]),
+		testLicenseType(2, "PRO", 1, f["SYNC"]),
+		testLicenseType(3, "ENT", 2, f["BACKUP"], f["NET"]),
+		testLicenseType(4, "LEGACY", 99, f["AUDIT"]),
+	}), f
+}
+
+func TestLicenseTypeTree(t *testing.T) {
+	tree, _ := testLicenseTypeTree()
+
+	if chain, ok := tree.chain(3); !ok || !reflect.DeepEqual(chain, []int{3, 2, 1}) {
+		t.Errorf("chain(ENT) = %v, %v", chain, ok)
+	}
+	if chain, ok := tree.chain(4); !ok || !reflect.DeepEqual(chain, []int{4}) {
+		t.Errorf("chain(LEGACY) = %v, %v", chain, ok)
+	}
+	if got, want := tree.effective(3), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("effective(ENT) = %v, want %v", got, want)
+	}
+	if got, want := tree.descendants(1), []int{2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("descendants(BASIC) = %v, want %v", got, want)
+	}
+
+	// BASIC继承ENT形成环
+	next := tree.clone()
+	next.setParent(1, 3)
+	if _, ok := next.chain(1); ok {
+		t.Error("cycle not detected")
+	}
+	if _, ok := tree.chain(1); !ok {
+		t.Error("clone modified the original tree")
+	}
+
+	// PRO改为不继承BASIC：PRO失去NET，ENT自身包含NET不受影响
+	next = tree.clone()
+	next.setParent(2, 0)
+	added, removed := tree.diffFeatureCodes(tree.effective(2), next.effective(2))
+	if len(added) != 0 || !reflect.DeepEqual(removed, []string{"NET"}) {
+		t.Errorf("PRO diff = %v, %v", added, removed)
+	}
+	if added, removed := tree.diffFeatureCodes(tree.effective(3), next.effective(3)); len(added)+len(removed) != 0 {
+		t.Errorf("ENT diff = %v, %v", added, removed)
+	}
+}
+
+func TestLicenseTypeFeatureViolations(t *testing.T) {
+	tree, f := testLicenseTypeTree()
+	g, _ := testFeatureGraph()
+	if v := tree.featureViolations(g, 1); len(v) != 0 {
+		t.Fatalf("violations = %+v", v)
+	}
+
+	// BASIC加入OFFLINE后，继承它的PRO和ENT中SYNC与OFFLINE互斥
+	next := tree.clone()
+	next.features[1] = []int{f["NET"].ID, f["OFFLINE"].ID}
+	var messages []string
+	for _, v := range next.featureViolations(g, 1) {
+		if v.Rule != dto.FeatureViolationConflict {
+			t.Errorf("rule = %s", v.Rule)
+		}
+		messages = append(messages, v.Message)
+	}
+	want := []string{
+		"inherited by license type PRO: feature SYNC conflicts with OFFLINE",
+		"inherited by license type ENT: feature SYNC conflicts with OFFLINE",
+	}
+	if !reflect.DeepEqual(messages, want) {
+		t.Errorf("messages = %q, want %q", messages, want)
+	}
+}
+
+func TestDiffCodes(t *testing.T) {
+	added, removed := diffCodes([]string{"B", "A", "C"}, []string{"D", "A", "B"})
+	if !reflect.DeepEqual(added, []string{"D"}) || !reflect.DeepEqual(removed, []string{"C"}) {
+		t.Errorf("diffCodes = %v, %v", added, removed)
+	}
+}
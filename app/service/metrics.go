@@ -2,7 +2,6 @@ package service
 
 import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
-	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/metricevent"
 	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
 	"cambridge-hit.com/gin-base/activateserver/resource"
@@ -36,38 +35,36 @@ func (s *MetricsService) ListMetrics(c *gin.Context, query dto.MetricEventQuery)
 		q = q.Where(metricevent.TsLTE(query.EndTS))
 	}
 
-	total, err := q.Count(c)
+	pg, err := newPaging(query.Page, query.PageSize, query.CursorParams)
+	if err != nil {
+		return nil, resource.ERR_INVALID_PARAMETER
+	}
+
+	total, capped, err := pg.countTotal(c, q.Clone().Count, func(limit int) ([]int, error) {
+		return q.Clone().Limit(limit).IDs(c)
+	})
 	if err != nil {
 		logger.Error("count metrics failed", zap.Error(err))
 		return nil, resource.ERR_QUERY_FAILED
 	}
 
-	pageNum := query.Page
-	pageSize := query.PageSize
-	if pageNum <= 0 {
-		pageNum = 1
-	}
-	if pageSize <= 0 {
-		pageSize = 10
+	if err := pg.apply(q); err != nil {
+		logger.Error("apply metrics paging failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
 	}
-	offset := (pageNum - 1) * pageSize
-
-	list, err := q.
-		Limit(pageSize).
-		Offset(offset).
-		Order(ent.Desc(metricevent.FieldCreatedAt)).
-		All(c)
+	list, err := q.All(c)
 	if err != nil {
 		logger.Error("query metrics failed", zap.Error(err))
 		return nil, resource.ERR_QUERY_FAILED
 	}
+	n, hasMore := pg.trim(len(list))
+	list = list[:n]
+	var next string
+	if hasMore {
+		next = encodeCursor(list[n-1].CreatedAt, list[n-1].ID)
+	}
 
-	return &dto.PageResult{
-		Total:    int64(total),
-		Page:     pageNum,
-		PageSize: pageSize,
-		List:     list,
-	}, resource.CODE_SUCCESS
+	return pg.result(list, total, capped, next), resource.CODE_SUCCESS
 }
 
 // AddMetric 新增
@@ -0,0 +1,563 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/cache"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/oidc"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/str"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+)
+
+const (
+	// oidcStateTTL 跳转到身份提供方后完成登录的时限
+	oidcStateTTL = 10 * time.Minute
+	// defaultGroupsClaim 默认的组声明名称
+	defaultGroupsClaim = "groups"
+)
+
+// oidcState 发起登录时保存，回调时取出校验，只能使用一次
+type oidcState struct {
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+}
+
+// oidcConfig 未开启或未配置身份提供方时为nil
+func oidcConfig() *resource.OIDCConfig {
+	conf := resource.Conf.OIDCConfig
+	if conf == nil || !conf.Enabled || conf.Issuer == "" || conf.ClientID == "" {
+		return nil
+	}
+	return conf
+}
+
+// oidcProviders discovery结果按配置缓存
+var oidcProviders struct {
+	sync.Mutex
+	key      string
+	provider *oidc.Provider
+}
+
+// oidcProvider 获取身份提供方，配置热加载修改后重新discovery；失败时不缓存，下次登录重试
+func oidcProvider(ctx context.Context, conf *resource.OIDCConfig) (*oidc.Provider, error) {
+	cfg := oidc.Config{
+		Issuer:       conf.Issuer,
+		ClientID:     conf.ClientID,
+		ClientSecret: conf.ClientSecret,
+		RedirectURL:  conf.RedirectURL,
+		Scopes:       conf.Scopes,
+	}
+	key := strings.Join(append([]string{cfg.Issuer, cfg.ClientID, cfg.ClientSecret, cfg.RedirectURL}, cfg.Scopes...), "\n")
+
+	oidcProviders.Lock()
+	defer oidcProviders.Unlock()
+	if oidcProviders.provider != nil && oidcProviders.key == key {
+		return oidcProviders.provider, nil
+	}
+	p, err := oidc.Discover(ctx, cfg, nil)
+	if err != nil {
+		return nil, err
+	}
+	oidcProviders.key, oidcProviders.provider = key, p
+	return p, nil
+}
+
+// oidcEmailAllowed 邮箱域名是否在allowed-domains中，未配置时不限制
+func oidcEmailAllowed(conf *resource.OIDCConfig, email string) bool {
+	if len(conf.AllowedDomains) == 0 {
+		return true
+	}
+	_, domain, ok := strings.Cut(email, "@")
+	if !ok {
+		return false
+	}
+	for _, d := range conf.AllowedDomains {
+		if strings.EqualFold(domain, strings.TrimPrefix(d, "@")) {
+			return true
+		}
+	}
+	return false
+}
+
+// roleCovers 角色a是否拥有角色b的全部权限
+func roleCovers(a, b string) bool {
+	for _, perm := range rolePermissions[b] {
+		if !roleHasPermission(a, perm) {
+			return false
+		}
+	}
+	return true
+}
+
+// oidcGroupRoles 用户的组对应的角色：是否为系统管理员，以及产品编码对应的产品角色，
+// 同一产品匹配多个角色时依次取能覆盖当前角色的那个；角色无效或产品角色未指定产品的映射在invalid中返回
+func oidcGroupRoles(mappings []resource.OIDCGroupRole, groups []string) (admin bool, productRoles map[string]string, invalid []resource.OIDCGroupRole) {
+	member := make(map[string]bool, len(groups))
+	for _, g := range groups {
+		member[g] = true
+	}
+	productRoles = map[string]string{}
+	for _, m := range mappings {
+		if !member[m.Group] {
+			continue
+		}
+		switch m.Role {
+		case dto.RoleSystemAdmin:
+			admin = true
+		case dto.RoleViewer, dto.RoleDeviceOperator, dto.RoleReleaseManager, dto.RoleProductAdmin:
+			if m.Product == "" {
+				invalid = append(invalid, m)
+				continue
+			}
+			if current, ok := productRoles[m.Product]; !ok || roleCovers(m.Role, current) {
+				productRoles[m.Product] = m.Role
+			}
+		default:
+			invalid = append(invalid, m)
+		}
+	}
+	return admin, productRoles, invalid
+}
+
+// oidcLoginFailed 记录单点登录失败
+func oidcLoginFailed(c *gin.Context, email, reason string) {
+	CreateAuditLog(c, nil, dto.AuditLogData{
+		UserID:    dto.AnonymousID,
+		Action:    dto.ActionLogin,
+		Module:    dto.ModuleAuth,
+		ProductID: 0,
+		DetailInfo: map[string]interface{}{
+			"email":  email,
+			"status": "failed",
+			"method": "oidc",
+			"reason": reason,
+		},
+	})
+}
+
+// OIDCAuthorize 发起单点登录，返回身份提供方的授权地址，state、nonce和PKCE code_verifier保存在服务端
+func (s *BaseService) OIDCAuthorize(c *gin.Context) (*dto.OIDCAuthorization, resource.RspCode) {
+	conf := oidcConfig()
+	if conf == nil {
+		return nil, resource.ERR_OIDC_DISABLED
+	}
+	p, err := oidcProvider(c, conf)
+	if err != nil {
+		logger.Error("oidc discovery failed", zap.Error(err), zap.String("issuer", conf.Issuer))
+		return nil, resource.ERR_OIDC_PROVIDER
+	}
+
+	var values [3]string
+	for i := range values {
+		if values[i], err = oidc.NewRandom(); err != nil {
+			logger.Error("generate oidc state failed", zap.Error(err))
+			return nil, resource.ERR_OPERATION_FAILED
+		}
+	}
+	state, st := values[0], oidcState{Nonce: values[1], Verifier: values[2]}
+	data, err := jsoniter.MarshalToString(st)
+	if err != nil {
+		logger.Error("marshal oidc state failed", zap.Error(err))
+		return nil, resource.ERR_OPERATION_FAILED
+	}
+	if err := cache.MyRedis.Set(str.GetOIDCStateKey(state), data, oidcStateTTL); err != nil {
+		logger.Error("save oidc state failed", zap.Error(err))
+		return nil, resource.ERR_OPERATION_FAILED
+	}
+	return &dto.OIDCAuthorization{AuthURL: p.AuthCodeURL(state, st.Nonce, st.Verifier), State: state}, resource.CODE_SUCCESS
+}
+
+// OIDCLogin 用回调的授权码完成单点登录：校验ID令牌，按已关联的身份或邮箱找到用户，未注册时创建用户，按组授予角色，
+// 之后与密码登录相同，需要两步验证时返回临时令牌
+func (s *BaseService) OIDCLogin(c *gin.Context, param dto.OIDCCallback) (at, rt string, uai auth.UserAuthInfo, challenge *dto.TwoFactorChallenge, e resource.RspCode) {
+	conf := oidcConfig()
+	if conf == nil {
+		e = resource.ERR_OIDC_DISABLED
+		return
+	}
+	value, err := cache.MyRedis.GetDel(str.GetOIDCStateKey(param.State))
+	if errors.Is(err, redis.Nil) {
+		e = resource.ERR_OIDC_STATE_INVALID
+		return
+	}
+	if err != nil {
+		logger.Error("query oidc state failed", zap.Error(err))
+		e = resource.ERR_QUERY_FAILED
+		return
+	}
+	var st oidcState
+	if err := jsoniter.UnmarshalFromString(value, &st); err != nil {
+		e = resource.ERR_OIDC_STATE_INVALID
+		return
+	}
+
+	p, err := oidcProvider(c, conf)
+	if err != nil {
+		logger.Error("oidc discovery failed", zap.Error(err), zap.String("issuer", conf.Issuer))
+		e = resource.ERR_OIDC_PROVIDER
+		return
+	}
+	token, err := p.Exchange(c, param.Code, st.Verifier)
+	if err != nil {
+		logger.Warn("oidc token exchange failed", zap.Error(err))
+		oidcLoginFailed(c, "", "exchange_failed")
+		e = resource.ERR_OIDC_LOGIN_FAILED
+		return
+	}
+	claims, err := p.Verify(c, token.IDToken, st.Nonce)
+	if err != nil {
+		logger.Warn("oidc id token verification failed", zap.Error(err))
+		oidcLoginFailed(c, "", "invalid_id_token")
+		e = resource.ERR_OIDC_LOGIN_FAILED
+		return
+	}
+
+	email := strings.TrimSpace(claims.Email)
+	if email == "" || (!claims.EmailVerified && !conf.AllowUnverifiedEmail) {
+		oidcLoginFailed(c, email, "email_not_verified")
+		e = resource.ERR_OIDC_EMAIL_INVALID
+		return
+	}
+	if !oidcEmailAllowed(conf, email) {
+		oidcLoginFailed(c, email, "domain_not_allowed")
+		e = resource.ERR_OIDC_DOMAIN
+		return
+	}
+
+	u, e := s.oidcUser(c, conf, claims, email)
+	if e != resource.CODE_SUCCESS {
+		return
+	}
+
+	groupsClaim := conf.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = defaultGroupsClaim
+	}
+	// 角色同步失败不影响登录，用户保留已有的角色
+	if err := syncOIDCRoles(c, u, claims.Strings(groupsClaim)); err != nil {
+		logger.Error("sync oidc group roles failed", zap.Error(err), zap.Int("user_id", u.ID))
+	}
+	return s.finishLogin(c, u, "oidc")
+}
+
+// oidcUser 找到身份提供方用户对应的本地用户：已关联的按(issuer, sub)匹配，邮箱变化不影响；
+// 未关联时按邮箱关联已有用户，未注册时按配置自动创建
+func (s *BaseService) oidcUser(c *gin.Context, conf *resource.OIDCConfig, claims *oidc.Claims, email string) (*ent.User, resource.RspCode) {
+	u, err := dto.Client().User.Query().
+		Where(user.OidcIssuerEQ(conf.Issuer), user.OidcSubjectEQ(claims.Subject)).
+		Only(c)
+	if ent.IsNotFound(err) {
+		return s.linkOIDCUser(c, conf, claims, email)
+	}
+	if err != nil {
+		logger.Error("query user failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if code := checkOIDCUser(c, u, email); code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	// 身份提供方验证的邮箱与账号邮箱相同时，未验证的本地用户标记为已验证
+	if !u.EmailVerified && claims.EmailVerified && strings.EqualFold(u.Email, email) {
+		return verifyOIDCEmail(c, u)
+	}
+	return u, resource.CODE_SUCCESS
+}
+
+// linkOIDCUser 按邮箱将身份提供方用户关联到已有用户。未验证的邮箱可能由身份提供方的用户随意填写，
+// 即使开启了allow-unverified-email也不能据此关联已有用户；已关联其他身份的用户不能再关联
+func (s *BaseService) linkOIDCUser(c *gin.Context, conf *resource.OIDCConfig, claims *oidc.Claims, email string) (*ent.User, resource.RspCode) {
+	u, err := dto.Client().User.Query().
+		Where(user.EmailEqualFold(email)).
+		Only(c)
+	if ent.IsNotFound(err) {
+		if !conf.AutoProvision {
+			oidcLoginFailed(c, email, "user_not_found")
+			return nil, resource.ERR_OIDC_USER_NOT_FOUND
+		}
+		return s.provisionOIDCUser(c, conf, claims, email)
+	}
+	if err != nil {
+		logger.Error("query user failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if code := checkOIDCUser(c, u, email); code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	if !claims.EmailVerified {
+		oidcLoginFailed(c, email, "email_not_verified")
+		return nil, resource.ERR_OIDC_EMAIL_INVALID
+	}
+	if u.OidcSubject != nil {
+		oidcLoginFailed(c, email, "linked_to_other_identity")
+		return nil, resource.ERR_OIDC_ACCOUNT_LINKED
+	}
+
+	// 条件更新，并发回调时只有一个身份能关联
+	linked, err := dto.Client().User.UpdateOneID(u.ID).
+		Where(user.OidcSubjectIsNil()).
+		SetOidcIssuer(conf.Issuer).
+		SetOidcSubject(claims.Subject).
+		Save(c)
+	if ent.IsNotFound(err) || ent.IsConstraintError(err) {
+		oidcLoginFailed(c, email, "linked_to_other_identity")
+		return nil, resource.ERR_OIDC_ACCOUNT_LINKED
+	}
+	if err != nil {
+		logger.Error("link oidc identity failed", zap.Error(err))
+		return nil, resource.ERR_MOD_FAILED
+	}
+	if !linked.EmailVerified {
+		return verifyOIDCEmail(c, linked)
+	}
+	return linked, resource.CODE_SUCCESS
+}
+
+// checkOIDCUser 服务账号和已禁用的用户不能单点登录
+func checkOIDCUser(c *gin.Context, u *ent.User, email string) resource.RspCode {
+	switch {
+	case u.IsService:
+		oidcLoginFailed(c, email, "service_account")
+		return resource.ERR_SERVICE_ACCOUNT_LOGIN
+	case !u.IsEnabled:
+		oidcLoginFailed(c, email, "user_disabled")
+		return resource.ERR_NO_PERMISSION
+	}
+	return resource.CODE_SUCCESS
+}
+
+// verifyOIDCEmail 身份提供方已验证邮箱，将本地用户标记为已验证
+func verifyOIDCEmail(c *gin.Context, u *ent.User) (*ent.User, resource.RspCode) {
+	u, err := u.Update().SetEmailVerified(true).Save(c)
+	if err != nil {
+		logger.Error("update email verified failed", zap.Error(err))
+		return nil, resource.ERR_MOD_FAILED
+	}
+	// 与验证邮箱相同，发给该邮箱的副管理员邀请在验证后生效
+	completeInvitations(c, u)
+	return u, resource.CODE_SUCCESS
+}
+
+// provisionOIDCUser 自动创建单点登录的用户并关联身份，不受注册模式限制；没有密码，需要时可通过重置密码设置。
+// 身份提供方未验证邮箱时用户的邮箱也是未验证的
+func (s *BaseService) provisionOIDCUser(c *gin.Context, conf *resource.OIDCConfig, claims *oidc.Claims, email string) (*ent.User, resource.RspCode) {
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	now := time.Now()
+	newUser, err := tx.User.Create().
+		SetEmail(email).
+		SetPassword(unusablePassword).
+		SetEmailVerified(claims.EmailVerified).
+		SetOidcIssuer(conf.Issuer).
+		SetOidcSubject(claims.Subject).
+		SetCreatedAt(now).
+		SetLastLoginAt(now).
+		Save(c)
+	if err != nil {
+		_ = tx.Rollback()
+		// 同一用户并发回调时另一个请求已创建
+		if ent.IsConstraintError(err) {
+			u, err := dto.Client().User.Query().
+				Where(user.OidcIssuerEQ(conf.Issuer), user.OidcSubjectEQ(claims.Subject)).
+				Only(c)
+			if err == nil {
+				return u, resource.CODE_SUCCESS
+			}
+		}
+		logger.Error("create oidc user failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    dto.AnonymousID,
+		Action:    dto.ActionRegister,
+		Module:    dto.ModuleAuth,
+		ProductID: 0,
+		DetailInfo: map[string]interface{}{
+			"email":  email,
+			"status": "success",
+			"method": "oidc",
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	// 发给该邮箱的副管理员邀请在创建后生效，邮箱未验证时不生效
+	if newUser.EmailVerified {
+		completeInvitations(c, newUser)
+	}
+	return newUser, resource.CODE_SUCCESS
+}
+
+// syncOIDCRoles 按组授予系统管理员和产品角色，只授予或提升为覆盖当前权限的角色，不撤销已有角色，
+// 不修改主管理员；配置中不存在的产品跳过
+func syncOIDCRoles(c *gin.Context, u *ent.User, groups []string) error {
+	conf := oidcConfig()
+	if conf == nil || len(conf.GroupRoles) == 0 || len(groups) == 0 {
+		return nil
+	}
+	admin, productRoles, invalid := oidcGroupRoles(conf.GroupRoles, groups)
+	for _, m := range invalid {
+		logger.Warn("invalid oidc group role", zap.String("group", m.Group), zap.String("role", m.Role), zap.String("product", m.Product))
+	}
+
+	// 登录接口没有查询身份，以该用户的身份写入
+	c.Set(viewer.ContextKey, NewViewer(u.ID))
+	if admin && !u.IsSystemAdmin {
+		if err := grantOIDCSystemAdmin(c, u); err != nil {
+			return err
+		}
+	}
+	for code, role := range productRoles {
+		p, err := dto.Client().Product.Query().Where(product.CodeEQ(code)).Only(c)
+		if ent.IsNotFound(err) {
+			logger.Warn("oidc group role product not found", zap.String("product", code))
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := grantOIDCProductRole(c, u, p.ID, role); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func grantOIDCSystemAdmin(c *gin.Context, u *ent.User) error {
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := tx.User.UpdateOne(u).SetIsSystemAdmin(true).Exec(c); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID: u.ID,
+		Action: dto.ActionUpdate,
+		Module: dto.ModuleUser,
+		DetailInfo: map[string]interface{}{
+			"operation": "oidc_group_role",
+			"user_id":   u.ID,
+			"email":     u.Email,
+			"role":      dto.RoleSystemAdmin,
+		},
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func grantOIDCProductRole(c *gin.Context, u *ent.User, productID int, role string) error {
+	pm, err := dto.Client().ProductManager.Query().
+		Where(productmanager.ProductIDEQ(productID), productmanager.UserIDEQ(u.ID)).
+		Only(c)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	previous := ""
+	if pm != nil {
+		previous = managerRole(pm)
+		if pm.Role == productmanager.RoleMain || previous == role || !roleCovers(role, previous) {
+			return nil
+		}
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	accessRole := productmanager.AccessRole(role)
+	if pm == nil {
+		err = tx.ProductManager.Create().
+			SetUserID(u.ID).
+			SetProductID(productID).
+			SetRole(productmanager.RoleAssistant).
+			SetAccessRole(accessRole).
+			SetPermissions(legacyPermissions(accessRole)).
+			SetRemark("oidc").
+			Exec(c)
+	} else {
+		err = tx.ProductManager.UpdateOne(pm).
+			SetAccessRole(accessRole).
+			SetPermissions(legacyPermissions(accessRole)).
+			Exec(c)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := grantViewerProduct(c, productID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    u.ID,
+		Action:    dto.ActionUpdate,
+		Module:    dto.ModuleProduct,
+		ProductID: productID,
+		DetailInfo: map[string]interface{}{
+			"operation":     "oidc_group_role",
+			"user_id":       u.ID,
+			"email":         u.Email,
+			"previous_role": previous,
+			"access_role":   role,
+		},
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
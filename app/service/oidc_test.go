@@ -0,0 +1,147 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/oidc"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+)
+
+func TestRoleCovers(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{dto.RoleProductAdmin, dto.RoleViewer, true},
+		{dto.RoleProductAdmin, dto.RoleDeviceOperator, true},
+		{dto.RoleProductAdmin, dto.RoleReleaseManager, true},
+		{dto.RoleDeviceOperator, dto.RoleReleaseManager, false},
+		{dto.RoleReleaseManager, dto.RoleDeviceOperator, false},
+		{dto.RoleViewer, dto.RoleProductAdmin, false},
+		{dto.RoleViewer, "", true},
+	}
+	for _, tt := range tests {
+		if got := roleCovers(tt.a, tt.b); got != tt.want {
+			t.Errorf("roleCovers(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestOIDCGroupRoles(t *testing.T) {
+	mappings := []resource.OIDCGroupRole{
+		{Group: "ops", Role: dto.RoleSystemAdmin},
+		{Group: "firmware", Role: dto.RoleReleaseManager, Product: "P1"},
+		{Group: "support", Role: dto.RoleDeviceOperator, Product: "P1"},
+		{Group: "leads", Role: dto.RoleProductAdmin, Product: "P1"},
+		{Group: "support", Role: dto.RoleViewer, Product: "P2"},
+		{Group: "support", Role: dto.RoleOwner, Product: "P3"}, // 不能通过组授予主管理员
+		{Group: "support", Role: dto.RoleViewer},               // 产品角色必须指定产品
+	}
+
+	admin, roles, invalid := oidcGroupRoles(mappings, []string{"firmware", "support"})
+	if admin {
+		t.Error("system admin granted without the ops group")
+	}
+	if len(invalid) != 2 {
+		t.Errorf("invalid mappings = %v, want owner and viewer without product", invalid)
+	}
+	// 不可比较的角色保留先匹配的，能覆盖当前角色时提升
+	if want := map[string]string{"P1": dto.RoleReleaseManager, "P2": dto.RoleViewer}; !reflect.DeepEqual(roles, want) {
+		t.Errorf("roles = %v, want %v", roles, want)
+	}
+
+	admin, roles, _ = oidcGroupRoles(mappings, []string{"support", "leads", "ops"})
+	if !admin {
+		t.Error("system admin not granted for the ops group")
+	}
+	if want := map[string]string{"P1": dto.RoleProductAdmin, "P2": dto.RoleViewer}; !reflect.DeepEqual(roles, want) {
+		t.Errorf("roles = %v, want %v", roles, want)
+	}
+
+	if admin, roles, _ = oidcGroupRoles(mappings, nil); admin || len(roles) != 0 {
+		t.Errorf("roles without groups = %v, %v", admin, roles)
+	}
+}
+
+func TestOIDCEmailAllowed(t *testing.T) {
+	conf := &resource.OIDCConfig{}
+	if !oidcEmailAllowed(conf, "a@any.com") {
+		t.Error("empty allowed-domains should allow any domain")
+	}
+	conf.AllowedDomains = []string{"example.com", "@corp.example.com"}
+	for email, want := range map[string]bool{
+		"a@example.com":      true,
+		"a@EXAMPLE.com":      true,
+		"a@corp.example.com": true,
+		"a@evil-example.com": false,
+		"a@example.com.evil": false,
+		"example.com":        false,
+	} {
+		if got := oidcEmailAllowed(conf, email); got != want {
+			t.Errorf("oidcEmailAllowed(%q) = %v, want %v", email, got, want)
+		}
+	}
+}
+
+// TestOIDCUserLinking 已有用户只能通过已验证的邮箱关联一次，之后按(issuer, sub)登录
+func TestOIDCUserLinking(t *testing.T) {
+	client := testClient(t)
+	ctx := systemCtx()
+	conf := &resource.OIDCConfig{Issuer: "https://idp.example.com", AllowUnverifiedEmail: true, AutoProvision: true}
+	s := &BaseService{}
+	login := func(sub, email string, verified bool) (int, resource.RspCode) {
+		u, code := s.oidcUser(testGinContext(viewer.System()), conf, &oidc.Claims{Subject: sub, Email: email, EmailVerified: verified}, email)
+		if u == nil {
+			return 0, code
+		}
+		return u.ID, code
+	}
+
+	// 登录失败的审计日志记在匿名用户名下，与初始化数据一致
+	if !client.User.Query().Where(user.IDEQ(dto.AnonymousID)).ExistX(ctx) {
+		client.User.Create().SetID(dto.AnonymousID).SetEmail("anonymous@example.com").SetPassword("x").SaveX(ctx)
+	}
+	victim := client.User.Create().SetID(dto.SuperAdminID + 500).SetEmail("oidc-victim@example.com").SetPassword("x").
+		SetEmailVerified(false).SaveX(ctx)
+
+	// 未验证的邮箱不能关联已有用户，也不能将其标记为已验证
+	if _, code := login("attacker", "oidc-victim@example.com", false); code != resource.ERR_OIDC_EMAIL_INVALID {
+		t.Fatalf("unverified link: %v", code)
+	}
+	if got := client.User.GetX(ctx, victim.ID); got.EmailVerified || got.OidcSubject != nil {
+		t.Fatalf("unverified claim changed user: verified = %v, subject = %v", got.EmailVerified, got.OidcSubject)
+	}
+
+	// 已验证的邮箱关联已有用户
+	if id, code := login("victim-sub", "OIDC-Victim@example.com", true); code != resource.CODE_SUCCESS || id != victim.ID {
+		t.Fatalf("verified link = %d, %v", id, code)
+	}
+	got := client.User.GetX(ctx, victim.ID)
+	if !got.EmailVerified || got.OidcIssuer == nil || *got.OidcIssuer != conf.Issuer || got.OidcSubject == nil || *got.OidcSubject != "victim-sub" {
+		t.Fatalf("linked user = %+v", got)
+	}
+
+	// 关联后不再按邮箱匹配
+	if _, code := login("other-sub", "oidc-victim@example.com", true); code != resource.ERR_OIDC_ACCOUNT_LINKED {
+		t.Errorf("second identity: %v", code)
+	}
+	if id, code := login("victim-sub", "renamed@example.com", false); code != resource.CODE_SUCCESS || id != victim.ID {
+		t.Errorf("linked login after email change = %d, %v", id, code)
+	}
+
+	// 自动创建的用户保留身份提供方的验证状态
+	id, code := login("new-sub", "oidc-new@example.com", false)
+	if code != resource.CODE_SUCCESS {
+		t.Fatalf("provision: %v", code)
+	}
+	if u := client.User.GetX(ctx, id); u.EmailVerified || u.OidcSubject == nil || *u.OidcSubject != "new-sub" {
+		t.Errorf("provisioned user: verified = %v, subject = %v", u.EmailVerified, u.OidcSubject)
+	}
+	if again, code := login("new-sub", "oidc-new@example.com", false); code != resource.CODE_SUCCESS || again != id {
+		t.Errorf("provisioned login = %d, %v, want %d", again, code, id)
+	}
+}
@@ -0,0 +1,918 @@
+package service
+
+import (
+	"context"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/customer"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/lot"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/schema"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// OrderService 订单和生产批次服务
+type OrderService struct{}
+
+// NewOrderService 创建订单和生产批次服务实例
+func NewOrderService() *OrderService {
+	return &OrderService{}
+}
+
+// visibleProductIDs 用户拥有权限的产品范围，指定产品时检查权限；返回nil表示不限制
+func visibleProductIDs(c *gin.Context, userID, productID int, perm dto.Permission) ([]int, resource.RspCode) {
+	ids, err := authorizedProductIDs(c, userID, perm)
+	if err != nil {
+		logger.Error("query managed products failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if productID <= 0 {
+		return ids, resource.CODE_SUCCESS
+	}
+	if ids != nil {
+		found := false
+		for _, id := range ids {
+			if id == productID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, resource.ERR_NO_PERMISSION
+		}
+	}
+	return []int{productID}, resource.CODE_SUCCESS
+}
+
+// applyDeviceSource 检查设备入库来源：批次和订单必须属于该产品，指定批次时订单取批次所属订单，
+// 未指定许可证类型时依次使用批次、订单的许可证类型
+func applyDeviceSource(ctx context.Context, param *dto.DeviceBatchAdd) resource.RspCode {
+	if param.LotID > 0 {
+		l, err := dto.Client().Lot.Get(ctx, param.LotID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return resource.ERR_LOT_NOT_EXIST
+			}
+			logger.Error("query lot failed", zap.Error(err))
+			return resource.ERR_QUERY_FAILED
+		}
+		if l.ProductID != param.ProductID {
+			return resource.ERR_LOT_NOT_EXIST
+		}
+		if l.OrderID > 0 {
+			if param.OrderID > 0 && param.OrderID != l.OrderID {
+				return resource.ERR_LOT_ORDER_MISMATCH
+			}
+			param.OrderID = l.OrderID
+		} else if param.OrderID > 0 {
+			return resource.ERR_LOT_ORDER_MISMATCH
+		}
+		if param.LicenseTypeID == 0 {
+			param.LicenseTypeID = l.LicenseTypeID
+		}
+	}
+	if param.OrderID > 0 {
+		o, err := dto.Client().Order.Get(ctx, param.OrderID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return resource.ERR_ORDER_NOT_EXIST
+			}
+			logger.Error("query order failed", zap.Error(err))
+			return resource.ERR_QUERY_FAILED
+		}
+		if o.ProductID != param.ProductID {
+			return resource.ERR_ORDER_NOT_EXIST
+		}
+		if param.LicenseTypeID == 0 {
+			param.LicenseTypeID = o.LicenseTypeID
+		}
+	}
+	if param.LicenseTypeID == 0 {
+		return resource.ERR_INVALID_PARAMETER
+	}
+	return resource.CODE_SUCCESS
+}
+
+// setDeviceSource 设置设备的来源订单和批次，0表示不关联
+func setDeviceSource(create *ent.DeviceCreate, orderID, lotID int) *ent.DeviceCreate {
+	if orderID > 0 {
+		create.SetOrderID(orderID)
+	}
+	if lotID > 0 {
+		create.SetLotID(lotID)
+	}
+	return create
+}
+
+// checkOrderRefs 检查订单引用的客户和许可证类型
+func checkOrderRefs(c *gin.Context, productID, customerID, licenseTypeID int) resource.RspCode {
+	if customerID > 0 {
+		exist, err := dto.Client().Customer.Query().
+			Where(customer.IDEQ(customerID)).
+			Exist(c)
+		if err != nil {
+			logger.Error("check customer failed", zap.Error(err))
+			return resource.ERR_QUERY_FAILED
+		}
+		if !exist {
+			return resource.ERR_CUSTOMER_NOT_EXIST
+		}
+	}
+	return checkAllocatorLicenseType(c, productID, licenseTypeID)
+}
+
+// checkLotOrder 检查批次所属订单属于同一产品，0表示不指定
+func checkLotOrder(c *gin.Context, productID, orderID int) resource.RspCode {
+	if orderID <= 0 {
+		return resource.CODE_SUCCESS
+	}
+	exist, err := dto.Client().Order.Query().
+		Where(order.IDEQ(orderID), order.ProductIDEQ(productID)).
+		Exist(c)
+	if err != nil {
+		logger.Error("check order failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if !exist {
+		return resource.ERR_ORDER_NOT_EXIST
+	}
+	return resource.CODE_SUCCESS
+}
+
+// toOrderInfo 转换为订单DTO
+func toOrderInfo(o *ent.Order) dto.OrderInfo {
+	info := dto.OrderInfo{
+		ID:            o.ID,
+		OrderNo:       o.OrderNo,
+		ProductID:     o.ProductID,
+		CustomerID:    o.CustomerID,
+		LicenseTypeID: o.LicenseTypeID,
+		Quantity:      o.Quantity,
+		ShipDate:      o.ShipDate,
+		Remark:        o.Remark,
+		CreatedAt:     o.CreatedAt,
+		CreatedBy:     o.CreatedBy,
+		UpdatedAt:     o.UpdatedAt,
+		UpdatedBy:     o.UpdatedBy,
+	}
+	if o.Edges.Product != nil {
+		info.ProductName = o.Edges.Product.ProductName
+	}
+	if o.Edges.Customer != nil {
+		info.CustomerName = o.Edges.Customer.Name
+	}
+	return info
+}
+
+// toLotInfo 转换为生产批次DTO
+func toLotInfo(l *ent.Lot) dto.LotInfo {
+	return dto.LotInfo{
+		ID:             l.ID,
+		LotNo:          l.LotNo,
+		ProductID:      l.ProductID,
+		OrderID:        l.OrderID,
+		LicenseTypeID:  l.LicenseTypeID,
+		Quantity:       l.Quantity,
+		ManufacturedAt: l.ManufacturedAt,
+		ShipDate:       l.ShipDate,
+		Remark:         l.Remark,
+		CreatedAt:      l.CreatedAt,
+		CreatedBy:      l.CreatedBy,
+		UpdatedAt:      l.UpdatedAt,
+		UpdatedBy:      l.UpdatedBy,
+	}
+}
+
+// orderListQuery 构建订单列表查询
+func orderListQuery(productIDs []int, query dto.OrderQuery) *ent.OrderQuery {
+	q := dto.Client().Order.Query()
+	if productIDs != nil {
+		q = q.Where(order.ProductIDIn(productIDs...))
+	}
+	if query.CustomerID > 0 {
+		q = q.Where(order.CustomerIDEQ(query.CustomerID))
+	}
+	if query.OrderNo != "" {
+		q = q.Where(order.OrderNoContainsFold(query.OrderNo))
+	}
+	return q
+}
+
+// orderPage 一页订单及其分页信息
+type orderPage struct {
+	orders []*ent.Order
+	pg     *paging
+	total  int64
+	capped bool
+	next   string
+}
+
+// result 构建分页结果
+func (p *orderPage) result(list interface{}) *dto.PageResult {
+	return p.pg.result(list, p.total, p.capped, p.next)
+}
+
+// pageOrders 按设备列表相同的分页方式查询订单
+func pageOrders(c *gin.Context, userID int, query dto.OrderQuery) (*orderPage, resource.RspCode) {
+	productIDs, code := visibleProductIDs(c, userID, query.ProductID, dto.PermDeviceView)
+	if code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	pg, err := newPaging(query.Page, query.PageSize, query.CursorParams)
+	if err != nil {
+		return nil, resource.ERR_INVALID_PARAMETER
+	}
+
+	q := orderListQuery(productIDs, query)
+	total, capped, err := pg.countTotal(c, q.Clone().Count, func(limit int) ([]int, error) {
+		return q.Clone().Limit(limit).IDs(c)
+	})
+	if err != nil {
+		logger.Error("count orders failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if err := pg.apply(q); err != nil {
+		logger.Error("apply order paging failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	orders, err := q.WithProduct().WithCustomer().All(c)
+	if err != nil {
+		logger.Error("query orders failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	n, hasMore := pg.trim(len(orders))
+	orders = orders[:n]
+	var next string
+	if hasMore {
+		next = encodeCursor(orders[n-1].CreatedAt, orders[n-1].ID)
+	}
+	return &orderPage{orders: orders, pg: pg, total: total, capped: capped, next: next}, resource.CODE_SUCCESS
+}
+
+// ListOrders 查询订单列表
+func (s *OrderService) ListOrders(c *gin.Context, userID int, query dto.OrderQuery) (*dto.PageResult, resource.RspCode) {
+	page, code := pageOrders(c, userID, query)
+	if code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	orders := page.orders
+	list := make([]dto.OrderInfo, 0, len(orders))
+	for _, o := range orders {
+		list = append(list, toOrderInfo(o))
+	}
+	return page.result(list), resource.CODE_SUCCESS
+}
+
+// FulfilmentReport 订单履约报表，统计每个订单的订购、已入库和已激活数量
+func (s *OrderService) FulfilmentReport(c *gin.Context, userID int, query dto.OrderQuery) (*dto.PageResult, resource.RspCode) {
+	page, code := pageOrders(c, userID, query)
+	if code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	orders := page.orders
+
+	registered := make(map[int]int, len(orders))
+	activated := make(map[int]int, len(orders))
+	if len(orders) > 0 {
+		ids := make([]int, len(orders))
+		for i, o := range orders {
+			ids[i] = o.ID
+		}
+		if err := countDevicesByOrder(c, ids, false, registered); err != nil {
+			logger.Error("count order devices failed", zap.Error(err))
+			return nil, resource.ERR_QUERY_FAILED
+		}
+		if err := countDevicesByOrder(c, ids, true, activated); err != nil {
+			logger.Error("count order activated devices failed", zap.Error(err))
+			return nil, resource.ERR_QUERY_FAILED
+		}
+	}
+
+	list := make([]dto.OrderFulfilment, 0, len(orders))
+	for _, o := range orders {
+		item := dto.OrderFulfilment{
+			OrderID:    o.ID,
+			OrderNo:    o.OrderNo,
+			ProductID:  o.ProductID,
+			CustomerID: o.CustomerID,
+			ShipDate:   o.ShipDate,
+			Ordered:    o.Quantity,
+			Registered: registered[o.ID],
+			Activated:  activated[o.ID],
+		}
+		if item.Registered < item.Ordered {
+			item.Outstanding = item.Ordered - item.Registered
+		}
+		if o.Edges.Product != nil {
+			item.ProductName = o.Edges.Product.ProductName
+		}
+		if o.Edges.Customer != nil {
+			item.CustomerName = o.Edges.Customer.Name
+		}
+		list = append(list, item)
+	}
+	return page.result(list), resource.CODE_SUCCESS
+}
+
+// countDevicesByOrder 按订单分组统计设备数量，activated为true时只统计激活过的设备
+func countDevicesByOrder(ctx context.Context, orderIDs []int, activated bool, counts map[int]int) error {
+	q := dto.Client().Device.Query().Where(device.OrderIDIn(orderIDs...))
+	if activated {
+		q = q.Where(device.ActivatedAtNotNil())
+	}
+	var rows []struct {
+		OrderID int `json:"order_id"`
+		Count   int `json:"count"`
+	}
+	if err := q.GroupBy(device.FieldOrderID).Aggregate(ent.Count()).Scan(ctx, &rows); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		counts[row.OrderID] = row.Count
+	}
+	return nil
+}
+
+// AddOrder 添加订单
+func (s *OrderService) AddOrder(c *gin.Context, userID int, param dto.AddOrder) (*dto.OrderInfo, resource.RspCode) {
+	// 1. 检查用户权限
+	if !authorize(c, userID, param.ProductID, dto.PermDeviceWrite) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 检查引用和订单号
+	if code := checkOrderRefs(c, param.ProductID, param.CustomerID, param.LicenseTypeID); code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	exist, err := dto.Client().Order.Query().
+		Where(order.OrderNoEQ(param.OrderNo)).
+		Exist(c)
+	if err != nil {
+		logger.Error("check order no failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if exist {
+		return nil, resource.ERR_ORDER_EXIST
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	create := tx.Order.Create().
+		SetOrderNo(param.OrderNo).
+		SetProductID(param.ProductID).
+		SetQuantity(param.Quantity).
+		SetNillableShipDate(param.ShipDate).
+		SetRemark(param.Remark).
+		SetCreatedBy(userID).
+		SetUpdatedBy(userID)
+	if param.CustomerID > 0 {
+		create.SetCustomerID(param.CustomerID)
+	}
+	if param.LicenseTypeID > 0 {
+		create.SetLicenseTypeID(param.LicenseTypeID)
+	}
+	o, err := create.Save(c)
+	if err != nil {
+		logger.Error("create order failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	// 4. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:     userID,
+		Action:     dto.ActionCreate,
+		Module:     dto.ModuleOrder,
+		ProductID:  param.ProductID,
+		DetailInfo: o,
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 5. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	info := toOrderInfo(o)
+	return &info, resource.CODE_SUCCESS
+}
+
+// UpdateOrder 修改订单，已入库设备的许可证类型不受影响
+func (s *OrderService) UpdateOrder(c *gin.Context, userID int, param dto.ModifyOrder) resource.RspCode {
+	old, err := dto.Client().Order.Get(c, param.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_ORDER_NOT_EXIST
+		}
+		logger.Error("query order failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+
+	// 1. 检查用户权限
+	if !authorize(c, userID, old.ProductID, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 检查引用和订单号
+	if code := checkOrderRefs(c, old.ProductID, param.CustomerID, param.LicenseTypeID); code != resource.CODE_SUCCESS {
+		return code
+	}
+	if param.OrderNo != old.OrderNo {
+		exist, err := dto.Client().Order.Query().
+			Where(order.OrderNoEQ(param.OrderNo), order.IDNEQ(param.ID)).
+			Exist(c)
+		if err != nil {
+			logger.Error("check order no failed", zap.Error(err))
+			return resource.ERR_QUERY_FAILED
+		}
+		if exist {
+			return resource.ERR_ORDER_EXIST
+		}
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	update := tx.Order.UpdateOneID(param.ID).
+		SetOrderNo(param.OrderNo).
+		SetQuantity(param.Quantity).
+		SetRemark(param.Remark).
+		SetUpdatedBy(userID)
+	if param.CustomerID > 0 {
+		update.SetCustomerID(param.CustomerID)
+	} else {
+		update.ClearCustomerID()
+	}
+	if param.LicenseTypeID > 0 {
+		update.SetLicenseTypeID(param.LicenseTypeID)
+	} else {
+		update.ClearLicenseTypeID()
+	}
+	if param.ShipDate != nil {
+		update.SetShipDate(*param.ShipDate)
+	} else {
+		update.ClearShipDate()
+	}
+	updated, err := update.Save(c)
+	if err != nil {
+		logger.Error("update order failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_MOD_FAILED
+	}
+
+	// 4. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionUpdate,
+		Module:    dto.ModuleOrder,
+		ProductID: old.ProductID,
+		DetailInfo: map[string]interface{}{
+			"old_order": old,
+			"new_order": updated,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 5. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// DeleteOrder 删除订单，订单下还有批次或设备（包括回收站中的设备）时不允许删除
+func (s *OrderService) DeleteOrder(c *gin.Context, userID, id int) resource.RspCode {
+	o, err := dto.Client().Order.Get(c, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_ORDER_NOT_EXIST
+		}
+		logger.Error("query order failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if !authorize(c, userID, o.ProductID, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	hasLots, err := dto.Client().Lot.Query().
+		Where(lot.OrderIDEQ(id)).
+		Exist(c)
+	if err != nil {
+		logger.Error("check order lots failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	hasDevices, err := dto.Client().Device.Query().
+		Where(device.OrderIDEQ(id)).
+		Exist(schema.SkipSoftDelete(c))
+	if err != nil {
+		logger.Error("check order devices failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if hasLots || hasDevices {
+		return resource.ERR_ORDER_IN_USE
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_DEL_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := tx.Order.DeleteOne(o).Exec(c); err != nil {
+		logger.Error("delete order failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_DEL_FAILED
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:     userID,
+		Action:     dto.ActionDelete,
+		Module:     dto.ModuleOrder,
+		ProductID:  o.ProductID,
+		DetailInfo: o,
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_DEL_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// ListLots 查询生产批次列表
+func (s *OrderService) ListLots(c *gin.Context, userID int, query dto.LotQuery) (*dto.PageResult, resource.RspCode) {
+	productIDs, code := visibleProductIDs(c, userID, query.ProductID, dto.PermDeviceView)
+	if code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	pg, err := newPaging(query.Page, query.PageSize, query.CursorParams)
+	if err != nil {
+		return nil, resource.ERR_INVALID_PARAMETER
+	}
+
+	q := dto.Client().Lot.Query()
+	if productIDs != nil {
+		q = q.Where(lot.ProductIDIn(productIDs...))
+	}
+	if query.OrderID > 0 {
+		q = q.Where(lot.OrderIDEQ(query.OrderID))
+	}
+	if query.LotNo != "" {
+		q = q.Where(lot.LotNoContainsFold(query.LotNo))
+	}
+
+	total, capped, err := pg.countTotal(c, q.Clone().Count, func(limit int) ([]int, error) {
+		return q.Clone().Limit(limit).IDs(c)
+	})
+	if err != nil {
+		logger.Error("count lots failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if err := pg.apply(q); err != nil {
+		logger.Error("apply lot paging failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	lots, err := q.All(c)
+	if err != nil {
+		logger.Error("query lots failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	n, hasMore := pg.trim(len(lots))
+	lots = lots[:n]
+	var next string
+	if hasMore {
+		next = encodeCursor(lots[n-1].CreatedAt, lots[n-1].ID)
+	}
+	list := make([]dto.LotInfo, 0, len(lots))
+	for _, l := range lots {
+		list = append(list, toLotInfo(l))
+	}
+	return pg.result(list, total, capped, next), resource.CODE_SUCCESS
+}
+
+// AddLot 添加生产批次
+func (s *OrderService) AddLot(c *gin.Context, userID int, param dto.AddLot) (*dto.LotInfo, resource.RspCode) {
+	// 1. 检查用户权限
+	if !authorize(c, userID, param.ProductID, dto.PermDeviceWrite) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 检查引用和批次号
+	if code := checkLotOrder(c, param.ProductID, param.OrderID); code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	if code := checkAllocatorLicenseType(c, param.ProductID, param.LicenseTypeID); code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	exist, err := dto.Client().Lot.Query().
+		Where(lot.ProductIDEQ(param.ProductID), lot.LotNoEQ(param.LotNo)).
+		Exist(c)
+	if err != nil {
+		logger.Error("check lot no failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if exist {
+		return nil, resource.ERR_LOT_EXIST
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	create := tx.Lot.Create().
+		SetLotNo(param.LotNo).
+		SetProductID(param.ProductID).
+		SetQuantity(param.Quantity).
+		SetNillableManufacturedAt(param.ManufacturedAt).
+		SetNillableShipDate(param.ShipDate).
+		SetRemark(param.Remark).
+		SetCreatedBy(userID).
+		SetUpdatedBy(userID)
+	if param.OrderID > 0 {
+		create.SetOrderID(param.OrderID)
+	}
+	if param.LicenseTypeID > 0 {
+		create.SetLicenseTypeID(param.LicenseTypeID)
+	}
+	l, err := create.Save(c)
+	if err != nil {
+		logger.Error("create lot failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	// 4. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:     userID,
+		Action:     dto.ActionCreate,
+		Module:     dto.ModuleLot,
+		ProductID:  param.ProductID,
+		DetailInfo: l,
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 5. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	info := toLotInfo(l)
+	return &info, resource.CODE_SUCCESS
+}
+
+// UpdateLot 修改生产批次，批次改挂订单时批次下设备的来源订单同步修改
+func (s *OrderService) UpdateLot(c *gin.Context, userID int, param dto.ModifyLot) resource.RspCode {
+	old, err := dto.Client().Lot.Get(c, param.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_LOT_NOT_EXIST
+		}
+		logger.Error("query lot failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+
+	// 1. 检查用户权限
+	if !authorize(c, userID, old.ProductID, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 检查引用和批次号
+	if code := checkLotOrder(c, old.ProductID, param.OrderID); code != resource.CODE_SUCCESS {
+		return code
+	}
+	if code := checkAllocatorLicenseType(c, old.ProductID, param.LicenseTypeID); code != resource.CODE_SUCCESS {
+		return code
+	}
+	if param.LotNo != old.LotNo {
+		exist, err := dto.Client().Lot.Query().
+			Where(
+				lot.ProductIDEQ(old.ProductID),
+				lot.LotNoEQ(param.LotNo),
+				lot.IDNEQ(param.ID),
+			).Exist(c)
+		if err != nil {
+			logger.Error("check lot no failed", zap.Error(err))
+			return resource.ERR_QUERY_FAILED
+		}
+		if exist {
+			return resource.ERR_LOT_EXIST
+		}
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	update := tx.Lot.UpdateOneID(param.ID).
+		SetLotNo(param.LotNo).
+		SetQuantity(param.Quantity).
+		SetRemark(param.Remark).
+		SetUpdatedBy(userID)
+	if param.OrderID > 0 {
+		update.SetOrderID(param.OrderID)
+	} else {
+		update.ClearOrderID()
+	}
+	if param.LicenseTypeID > 0 {
+		update.SetLicenseTypeID(param.LicenseTypeID)
+	} else {
+		update.ClearLicenseTypeID()
+	}
+	if param.ManufacturedAt != nil {
+		update.SetManufacturedAt(*param.ManufacturedAt)
+	} else {
+		update.ClearManufacturedAt()
+	}
+	if param.ShipDate != nil {
+		update.SetShipDate(*param.ShipDate)
+	} else {
+		update.ClearShipDate()
+	}
+	updated, err := update.Save(c)
+	if err != nil {
+		logger.Error("update lot failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_MOD_FAILED
+	}
+
+	// 4. 同步批次下设备的来源订单，回收站中的设备一并修改
+	movedDevices := 0
+	if param.OrderID != old.OrderID {
+		deviceUpdate := tx.Device.Update().Where(device.LotIDEQ(param.ID))
+		if param.OrderID > 0 {
+			deviceUpdate.SetOrderID(param.OrderID)
+		} else {
+			deviceUpdate.ClearOrderID()
+		}
+		movedDevices, err = deviceUpdate.Save(schema.SkipSoftDelete(c))
+		if err != nil {
+			logger.Error("update lot devices order failed", zap.Error(err))
+			_ = tx.Rollback()
+			return resource.ERR_MOD_FAILED
+		}
+	}
+
+	// 5. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionUpdate,
+		Module:    dto.ModuleLot,
+		ProductID: old.ProductID,
+		DetailInfo: map[string]interface{}{
+			"old_lot":       old,
+			"new_lot":       updated,
+			"moved_devices": movedDevices,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 6. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// DeleteLot 删除生产批次，批次下还有设备（包括回收站中的设备）时不允许删除
+func (s *OrderService) DeleteLot(c *gin.Context, userID, id int) resource.RspCode {
+	l, err := dto.Client().Lot.Get(c, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_LOT_NOT_EXIST
+		}
+		logger.Error("query lot failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if !authorize(c, userID, l.ProductID, dto.PermDeviceWrite) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	hasDevices, err := dto.Client().Device.Query().
+		Where(device.LotIDEQ(id)).
+		Exist(schema.SkipSoftDelete(c))
+	if err != nil {
+		logger.Error("check lot devices failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if hasDevices {
+		return resource.ERR_LOT_IN_USE
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_DEL_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := tx.Lot.DeleteOne(l).Exec(c); err != nil {
+		logger.Error("delete lot failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_DEL_FAILED
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:     userID,
+		Action:     dto.ActionDelete,
+		Module:     dto.ModuleLot,
+		ProductID:  l.ProductID,
+		DetailInfo: l,
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_DEL_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
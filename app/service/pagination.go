@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/intercept"
+	"entgo.io/ent/dialect/sql"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const (
+	countCap        = 10000 // capped模式最多统计的记录数
+	defaultPageSize = 10    // 未传每页数量时的默认值
+	maxPageSize     = 100   // 每页数量上限，超过时按上限返回
+)
+
+// pageCursor 游标内容，记录上一页最后一条记录的(created_at, id)
+type pageCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        int       `json:"i"`
+}
+
+// encodeCursor 生成不透明游标
+func encodeCursor(createdAt time.Time, id int) string {
+	raw, _ := jsoniter.Marshal(pageCursor{CreatedAt: createdAt, ID: id})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeCursor 解析游标
+func decodeCursor(s string) (*pageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	var cur pageCursor
+	if err := jsoniter.Unmarshal(raw, &cur); err != nil {
+		return nil, err
+	}
+	if cur.ID <= 0 || cur.CreatedAt.IsZero() {
+		return nil, errors.New("invalid cursor")
+	}
+	return &cur, nil
+}
+
+// paging 分页方式，兼容页码分页和游标分页，两种方式都返回下一页游标，客户端可以从页码分页切换到游标分页
+type paging struct {
+	page     int
+	pageSize int
+	keyset   bool        // 是否使用游标分页
+	after    *pageCursor // 游标分页的起始位置
+	count    string      // 总数统计方式
+}
+
+// newPaging 解析分页参数，传入游标时使用游标分页并忽略页码
+func newPaging(page, pageSize int, params dto.CursorParams) (*paging, error) {
+	p := &paging{page: page, pageSize: pageSize, count: params.Count}
+	if p.page <= 0 {
+		p.page = 1
+	}
+	if p.pageSize <= 0 {
+		p.pageSize = defaultPageSize
+	}
+	if p.pageSize > maxPageSize {
+		p.pageSize = maxPageSize
+	}
+	if params.Cursor != "" {
+		cur, err := decodeCursor(params.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		p.keyset = true
+		p.page = 0
+		p.after = cur
+	}
+	switch p.count {
+	case "":
+		p.count = dto.CountExact
+		if p.keyset {
+			p.count = dto.CountNone
+		}
+	case dto.CountExact, dto.CountCapped, dto.CountNone:
+	default:
+		return nil, errors.New("invalid count mode")
+	}
+	return p, nil
+}
+
+// countTotal 按统计方式获取总数，需在apply之前调用；limitedIDs查询最多limit条记录的ID，
+// capped模式最多读取上限加一条记录的ID，不扫描超过上限的记录，结果是截断到上限的精确计数。
+// 不提供估算：列表查询总是带有产品范围和筛选条件，而表统计行数只对应整张表，
+// InnoDB的统计值误差也可能很大，带条件的估算需要EXPLAIN，ent查询无法直接生成
+func (p *paging) countTotal(ctx context.Context, count func(context.Context) (int, error), limitedIDs func(limit int) ([]int, error)) (int64, bool, error) {
+	switch p.count {
+	case dto.CountNone:
+		return -1, false, nil
+	case dto.CountCapped:
+		ids, err := limitedIDs(countCap + 1)
+		if err != nil {
+			return 0, false, err
+		}
+		if len(ids) > countCap {
+			return countCap, true, nil
+		}
+		return int64(len(ids)), false, nil
+	}
+	n, err := count(ctx)
+	return int64(n), false, err
+}
+
+// apply 为查询添加排序和分页条件，按(created_at, id)倒序，多查一条用于判断是否还有更多数据
+func (p *paging) apply(q ent.Query) error {
+	iq, err := intercept.NewQuery(q)
+	if err != nil {
+		return err
+	}
+	iq.Order(ent.Desc("created_at"), ent.Desc("id"))
+	iq.Limit(p.pageSize + 1)
+	if !p.keyset {
+		iq.Offset((p.page - 1) * p.pageSize)
+		return nil
+	}
+	after := p.after
+	iq.WhereP(func(s *sql.Selector) {
+		s.Where(sql.Or(
+			sql.LT(s.C("created_at"), after.CreatedAt),
+			sql.And(
+				sql.EQ(s.C("created_at"), after.CreatedAt),
+				sql.LT(s.C("id"), after.ID),
+			),
+		))
+	})
+	return nil
+}
+
+// trim 去掉多查的一条，返回保留的数量和是否还有更多数据
+func (p *paging) trim(n int) (int, bool) {
+	if n > p.pageSize {
+		return p.pageSize, true
+	}
+	return n, false
+}
+
+// result 构建分页结果，next为下一页游标
+func (p *paging) result(list interface{}, total int64, capped bool, next string) *dto.PageResult {
+	return &dto.PageResult{
+		Total:      total,
+		Page:       p.page,
+		PageSize:   p.pageSize,
+		List:       list,
+		NextCursor: next,
+		HasMore:    next != "",
+		Capped:     capped,
+	}
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+)
+
+func TestNewPaging(t *testing.T) {
+	created := time.Date(2024, 5, 1, 8, 30, 0, 0, time.Local)
+	cursor := encodeCursor(created, 42)
+
+	p, err := newPaging(0, 0, dto.CursorParams{})
+	if err != nil || p.keyset || p.page != 1 || p.pageSize != defaultPageSize || p.count != dto.CountExact {
+		t.Fatalf("default paging = %+v, %v", p, err)
+	}
+
+	p, err = newPaging(3, 20, dto.CursorParams{Cursor: cursor})
+	if err != nil || !p.keyset || p.page != 0 || p.count != dto.CountNone {
+		t.Fatalf("cursor paging = %+v, %v", p, err)
+	}
+	if !p.after.CreatedAt.Equal(created) || p.after.ID != 42 {
+		t.Errorf("cursor = %+v, want (%v, 42)", p.after, created)
+	}
+
+	if _, err := newPaging(1, 10, dto.CursorParams{Cursor: "not-a-cursor"}); err == nil {
+		t.Error("expected error for invalid cursor")
+	}
+	if _, err := newPaging(1, 10, dto.CursorParams{Count: "all"}); err == nil {
+		t.Error("expected error for invalid count mode")
+	}
+
+	if n, more := p.trim(21); n != 20 || !more {
+		t.Errorf("trim(21) = %d, %v", n, more)
+	}
+	if n, more := p.trim(20); n != 20 || more {
+		t.Errorf("trim(20) = %d, %v", n, more)
+	}
+
+	if p, _ := newPaging(1, 100000, dto.CursorParams{}); p.pageSize != maxPageSize {
+		t.Errorf("page size = %d, want %d", p.pageSize, maxPageSize)
+	}
+}
+
+func TestCountTotalCapped(t *testing.T) {
+	p, err := newPaging(1, 10, dto.CursorParams{Count: dto.CountCapped})
+	if err != nil {
+		t.Fatal(err)
+	}
+	noCount := func(context.Context) (int, error) {
+		t.Fatal("capped mode ran COUNT(*)")
+		return 0, nil
+	}
+	ids := func(n int) func(int) ([]int, error) {
+		return func(limit int) ([]int, error) {
+			if limit != countCap+1 {
+				t.Errorf("limit = %d, want %d", limit, countCap+1)
+			}
+			if n > limit {
+				n = limit
+			}
+			return make([]int, n), nil
+		}
+	}
+
+	if total, capped, err := p.countTotal(context.Background(), noCount, ids(25)); err != nil || total != 25 || capped {
+		t.Errorf("countTotal(25) = %d, %v, %v", total, capped, err)
+	}
+	if total, capped, err := p.countTotal(context.Background(), noCount, ids(countCap*3)); err != nil || total != countCap || !capped {
+		t.Errorf("countTotal(over cap) = %d, %v, %v", total, capped, err)
+	}
+}
@@ -2,7 +2,6 @@ package service
 
 import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
-	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/post"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/posttagrelation"
 
@@ -50,38 +49,44 @@ func (s *PostService) ListPost(c *gin.Context, userID int, query dto.PostQuery)
 	// 	q = q.Where(post.HasTagsWith(posttag.IDEQ(query.TagID)))
 	// }
 
+	pg, err := newPaging(query.Page, query.PageSize, query.CursorParams)
+	if err != nil {
+		return nil, resource.ERR_INVALID_PARAMETER
+	}
+
 	// 计算总数
-	total, err := q.Count(c)
+	total, capped, err := pg.countTotal(c, q.Clone().Count, func(limit int) ([]int, error) {
+		return q.Clone().Limit(limit).IDs(c)
+	})
 	if err != nil {
 		logger.Error("count posts failed", zap.Error(err))
 		return nil, resource.ERR_QUERY_FAILED
 	}
 
 	// 执行分页查询
-	offset := (query.Page - 1) * query.PageSize
+	if err := pg.apply(q); err != nil {
+		logger.Error("apply post paging failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
 	posts, err := q.
 		WithCategory().
 		WithTagRelations().
 		WithAuthor().
-		Limit(query.PageSize).
-		Offset(offset).
-		Order(ent.Desc(post.FieldCreatedAt)).
 		All(c)
 
 	if err != nil {
 		logger.Error("query posts failed", zap.Error(err))
 		return nil, resource.ERR_QUERY_FAILED
 	}
-
-	// 构建分页结果
-	result := &dto.PageResult{
-		Total:    int64(total),
-		Page:     query.Page,
-		PageSize: query.PageSize,
-		List:     posts,
+	n, hasMore := pg.trim(len(posts))
+	posts = posts[:n]
+	var next string
+	if hasMore {
+		next = encodeCursor(posts[n-1].CreatedAt, posts[n-1].ID)
 	}
 
-	return result, resource.CODE_SUCCESS
+	// 构建分页结果
+	return pg.result(posts, total, capped, next), resource.CODE_SUCCESS
 }
 
 // AddPost 添加文章
@@ -3,13 +3,14 @@ package service
 import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
-	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
 	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
 	"cambridge-hit.com/gin-base/activateserver/resource"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"time"
 )
 
 // 定义接口
@@ -23,8 +24,8 @@ func NewProductService() *ProductService {
 func (s *ProductService) ListProduct(c *gin.Context, userID int, page, pageSize int) (*dto.PageResult, resource.RspCode) {
 	// 构建查询
 	q := dto.Client().ProductManager.Query()
-	if userID != 1 {
-		// 用户ID不是1，列出用户作为管理员或协作者管理的产品
+	if !isSystemAdmin(c, userID) {
+		// 不是系统管理员，列出用户作为管理员或协作者管理的产品
 		q = q.Where(productmanager.UserIDEQ(userID))
 	}
 
@@ -117,11 +118,14 @@ func (s *ProductService) AddProduct(c *gin.Context, userID int, param dto.AddPro
 		}
 	}()
 
-	p, err := tx.Product.Create().
+	create := tx.Product.Create().
 		SetCode(param.Code).
 		SetProductName(param.ProductName).
-		SetProductType(param.ProductType).
-		Save(c)
+		SetProductType(param.ProductType)
+	if param.WarrantyMonths != nil {
+		create.SetWarrantyMonths(*param.WarrantyMonths)
+	}
+	p, err := create.Save(c)
 
 	if err != nil {
 		logger.Error("create product failed", zap.Any("err:", err))
@@ -130,6 +134,11 @@ func (s *ProductService) AddProduct(c *gin.Context, userID int, param dto.AddPro
 		}
 		return resource.ERR_ADD_FAILED
 	}
+	if err := grantViewerProduct(c, p.ID); err != nil {
+		logger.Error("grant viewer product failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_FAILED
+	}
 
 	// 设置创建者为产品管理员
 	_, err = tx.ProductManager.Create().
@@ -176,12 +185,10 @@ func (s *ProductService) ModifyProduct(c *gin.Context, userID int, param dto.Mod
 		}
 	}()
 
-	// 1. 权限检查
-	pm, err := tx.ProductManager.Query().Where(
-		productmanager.ProductIDEQ(param.ID),
-		productmanager.UserIDEQ(userID),
-	).Only(c)
-	if err != nil || (userID != 1 && pm.Role != productmanager.RoleMain) {
+	// 1. 权限检查：修改产品信息需要修改权限，调整管理员还需要管理员管理权限
+	changeManagers := param.ManagerMain != 0 || len(param.ManagerAssistant) > 0
+	if !authorize(c, userID, param.ID, dto.PermProductEdit) ||
+		(changeManagers && !authorize(c, userID, param.ID, dto.PermManagerManage)) {
 		tx.Rollback()
 		return resource.ERR_NO_PERMISSION
 	}
@@ -194,8 +201,8 @@ func (s *ProductService) ModifyProduct(c *gin.Context, userID int, param dto.Mod
 		return resource.ERR_QUERY_FAILED
 	}
 
-	// 3. 主管理员或超级管理员操作
-	if pm.Role == productmanager.RoleMain || userID == dto.SuperAdminID {
+	// 3. 调整主管理员和副管理员
+	if changeManagers {
 		// 查询现有主管理员
 		mainManager, err := tx.ProductManager.Query().
 			Where(
@@ -208,46 +215,15 @@ func (s *ProductService) ModifyProduct(c *gin.Context, userID int, param dto.Mod
 			return resource.ERR_QUERY_FAILED
 		}
 
-		newMainManager := mainManager
-		if param.ManagerMain != 0 {
-			// 查询新主管理员
-			newMainManager, err = tx.ProductManager.Query().
-				Where(
-					productmanager.ProductIDEQ(param.ID),
-					productmanager.UserIDEQ(param.ManagerMain),
-				).Only(c)
-			if err != nil {
-				logger.Error("query new main manager failed", zap.Error(err))
-				tx.Rollback()
-				return resource.ERR_QUERY_FAILED
-			}
-		}
-
-		// 更新主管理员角色
-		if mainManager.UserID != newMainManager.UserID {
-			if _, err = tx.ProductManager.UpdateOne(mainManager).
-				SetRole(productmanager.RoleAssistant).
-				SetPermissions(productmanager.DefaultPermissions).
-				Save(c); err != nil {
-				logger.Error("update old main failed", zap.Error(err))
-				tx.Rollback()
-				return resource.ERR_MOD_FAILED
-			}
-
-			if _, err = tx.ProductManager.UpdateOne(newMainManager).
-				SetRole(productmanager.RoleMain).
-				SetPermissions(productmanager.PermissionsFull).
-				SetRemark("").
-				Save(c); err != nil {
-				logger.Error("update new main failed", zap.Error(err))
-				tx.Rollback()
-				return resource.ERR_MOD_FAILED
-			}
+		// 主管理员只能通过转让邀请变更，由新主管理员接受
+		if param.ManagerMain != 0 && param.ManagerMain != mainManager.UserID {
+			tx.Rollback()
+			return resource.ERR_OWNER_TRANSFER_REQUIRED
 		}
 
 		// 更新副管理员权限和备注
 		for _, assistant := range param.ManagerAssistant {
-			if assistant.UserID == newMainManager.UserID {
+			if assistant.UserID == mainManager.UserID {
 				continue // 跳过主管理员
 			}
 
@@ -262,8 +238,15 @@ func (s *ProductService) ModifyProduct(c *gin.Context, userID int, param dto.Mod
 			}
 
 			update := tx.ProductManager.UpdateOne(assistantManager)
-			if assistant.Permission != "" {
-				update.SetPermissions(assistant.Permission)
+			if assistant.AccessRole != "" {
+				if productmanager.AccessRoleValidator(assistant.AccessRole) != nil {
+					tx.Rollback()
+					return resource.ERR_INVALID_PARAMETER
+				}
+				update.SetAccessRole(assistant.AccessRole).SetPermissions(legacyPermissions(assistant.AccessRole))
+			} else if assistant.Permission != "" {
+				// 只设置旧的permissions时清除角色，按permissions推导
+				update.SetPermissions(assistant.Permission).ClearAccessRole()
 			}
 			if assistant.Remark != "" {
 				update.SetRemark(assistant.Remark)
@@ -301,6 +284,10 @@ func (s *ProductService) ModifyProduct(c *gin.Context, userID int, param dto.Mod
 		update = update.SetProductType(param.ProductType)
 	}
 
+	if param.WarrantyMonths != nil && *param.WarrantyMonths != _product.WarrantyMonths {
+		update = update.SetWarrantyMonths(*param.WarrantyMonths)
+	}
+
 	if _, err := update.Save(c); err != nil {
 		logger.Error("update product failed", zap.Error(err))
 		tx.Rollback()
@@ -341,12 +328,7 @@ func (s *ProductService) ModifyProduct(c *gin.Context, userID int, param dto.Mod
 }
 
 func (s *ProductService) DeleteProduct(c *gin.Context, userID, productID int) resource.RspCode {
-	if exist, _ := dto.Client().ProductManager.Query().
-		Where( //product_id、user_id,role_main符合
-			productmanager.ProductIDEQ(productID),
-			productmanager.UserIDEQ(userID),
-			productmanager.RoleEQ(productmanager.RoleMain),
-		).Exist(c); userID != 1 && !exist {
+	if !authorize(c, userID, productID, dto.PermProductDelete) {
 		return resource.ERR_NO_PERMISSION
 	}
 
@@ -364,13 +346,21 @@ func (s *ProductService) DeleteProduct(c *gin.Context, userID, productID int) re
 		return resource.ERR_QUERY_FAILED
 	}
 
-	// 检查是否有关联的软硬件版本、许可证类型和产品功能
+	// 检查是否有关联的软硬件版本、许可证类型、产品功能和设备
 	if len(p.Edges.SoftwareVersions) > 0 ||
 		len(p.Edges.FirmwareVersions) > 0 ||
 		len(p.Edges.LicenseTypes) > 0 ||
 		len(p.Edges.Features) > 0 {
 		return resource.ERR_PRODUCT_HAS_RELATIONS
 	}
+	hasDevices, err := dto.Client().Device.Query().Where(device.ProductIDEQ(productID)).Exist(c)
+	if err != nil {
+		logger.Error("check product devices failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if hasDevices {
+		return resource.ERR_PRODUCT_HAS_RELATIONS
+	}
 
 	// 事务处理
 	tx, err := dto.Client().Tx(c)
@@ -379,16 +369,8 @@ func (s *ProductService) DeleteProduct(c *gin.Context, userID, productID int) re
 		return resource.ERR_DEL_FAILED
 	}
 
-	// 先删除产品管理员关系
-	_, err = tx.ProductManager.Delete().Where(productmanager.ProductIDEQ(productID)).Exec(c)
-	if err != nil {
-		logger.Error("delete product managers failed", zap.Error(err))
-		tx.Rollback()
-		return resource.ERR_DEL_FAILED
-	}
-
-	// 删除产品本身
-	err = tx.Product.DeleteOneID(productID).Exec(c)
+	// 移入回收站，保留产品管理员关系以便恢复，永久清理时再删除
+	err = tx.Product.UpdateOneID(productID).SetDeletedAt(time.Now()).Exec(c)
 	if err != nil {
 		logger.Error("delete product failed", zap.Error(err))
 		tx.Rollback()
@@ -415,88 +397,20 @@ func (s *ProductService) DeleteProduct(c *gin.Context, userID, productID int) re
 	return resource.CODE_SUCCESS
 }
 
-// AddManager 添加产品管理员
+// AddManager 添加产品管理员，改为向该邮箱发送副管理员邀请，被邀请人接受后加入
 // 参数：当前用户ID、添加管理员请求参数
 // 返回：响应状态码
 func (s *ProductService) AddManager(c *gin.Context, userID int, param dto.AddManager) resource.RspCode {
-	// 1. 权限检查：验证当前用户是否是产品的主管理员
-	isMainManager, err := dto.Client().ProductManager.Query().
-		Where(
-			productmanager.ProductIDEQ(param.ProductID),
-			productmanager.UserIDEQ(userID),
-			productmanager.RoleEQ(productmanager.RoleMain),
-		).Exist(c)
-
-	// 如果不是主管理员且不是超级管理员(ID=1)，则无权添加
-	if err != nil || (!isMainManager && userID != 1) {
-		logger.Error("permission check failed", zap.Error(err))
-		return resource.ERR_NO_PERMISSION
-	}
-
-	// 2. 根据邮箱查找用户
-	u, err := dto.Client().User.Query().
-		Where(user.EmailEQ(param.Email)).
-		Only(c)
-	if err != nil {
-		logger.Error("query user by email failed", zap.String("email", param.Email), zap.Error(err))
-		return resource.ERR_USER_NOT_EXIST
-	}
-
-	// 3. 检查该用户是否已经是该产品的管理员
-	exist, err := dto.Client().ProductManager.Query().
-		Where(
-			productmanager.ProductIDEQ(param.ProductID),
-			productmanager.UserIDEQ(u.ID),
-		).Exist(c)
-	if err != nil {
-		logger.Error("check existing manager failed", zap.Error(err))
-		return resource.ERR_QUERY_FAILED
-	}
-	if exist {
-		return resource.ERR_MANAGER_ALREADY_EXIST
-	}
-
-	// 4. 验证权限参数合法性
-	if param.Permissions == "" {
-		param.Permissions = productmanager.PermissionsRead // 默认只读权限
-	} else if err := productmanager.PermissionsValidator(param.Permissions); err != nil {
+	if param.AccessRole == "" && param.Permissions != "" && productmanager.PermissionsValidator(param.Permissions) != nil {
 		return resource.ERR_INVALID_PARAMETER
 	}
-
-	tx, _ := dto.Client().Tx(c)
-
-	// 5. 添加用户为产品管理员
-	_, err = tx.ProductManager.Create().
-		SetUserID(u.ID).
-		SetProductID(param.ProductID).
-		SetRole(productmanager.RoleAssistant). // 新添加的用户始终是副管理员
-		SetPermissions(param.Permissions).
-		SetRemark(param.Remark). // 设置备注
-		Save(c)
-	if err != nil {
-		logger.Error("add manager failed", zap.Error(err))
-		return resource.ERR_ADD_FAILED
-	}
-
-	err = CreateAuditLog(c, tx, dto.AuditLogData{
-		UserID:     userID,
-		Action:     dto.ActionCreate,
-		Module:     dto.ModuleProduct,
+	_, code := NewProductInvitationService().InviteManager(c, userID, dto.InviteManager{
 		ProductID:  param.ProductID,
-		DetailInfo: param,
+		Email:      param.Email,
+		AccessRole: managerInvitationRole(param.AccessRole, param.Permissions),
+		Remark:     param.Remark,
 	})
-	if err != nil {
-		logger.Error("create audit log failed", zap.Error(err))
-		tx.Rollback()
-		return resource.ERR_ADD_LOG_FAILED
-	}
-
-	// 提交事务
-	if err := tx.Commit(); err != nil {
-		logger.Error("commit transaction failed", zap.Error(err))
-		return resource.ERR_ADD_FAILED
-	}
-	return resource.CODE_SUCCESS
+	return code
 }
 
 // RemoveManager 删除产品管理员
@@ -515,19 +429,9 @@ func (s *ProductService) RemoveManager(c *gin.Context, userID int, managerId int
 		return resource.ERR_NO_PERMISSION
 	}
 
-	// 3. 权限检查：验证当前用户是否是产品的主管理员或超级管理员
-	if userID != 1 {
-		isMainManager, err := dto.Client().ProductManager.Query().
-			Where(
-				productmanager.ProductIDEQ(manager.ProductID),
-				productmanager.UserIDEQ(userID),
-				productmanager.RoleEQ(productmanager.RoleMain),
-			).Exist(c)
-
-		if err != nil || !isMainManager {
-			logger.Error("permission check failed", zap.Error(err))
-			return resource.ERR_NO_PERMISSION
-		}
+	// 3. 权限检查：验证当前用户是否有管理员管理权限
+	if !authorize(c, userID, manager.ProductID, dto.PermManagerManage) {
+		return resource.ERR_NO_PERMISSION
 	}
 
 	tx, _ := dto.Client().Tx(c)
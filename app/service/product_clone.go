@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/firmwareversion"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/softwareversion"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// CloneProduct 复制产品的功能、许可证类型及其功能关联，可选复制韧件和软件版本及其兼容关系；
+// 设备、SN规则等运行数据不复制，复制人成为新产品的主管理员
+func (s *ProductService) CloneProduct(c *gin.Context, userID int, param dto.CloneProduct) (*dto.CloneProductResult, resource.RspCode) {
+	if !authorize(c, userID, param.SourceProductID, dto.PermProductView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	src, err := dto.Client().Product.Get(c, param.SourceProductID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, resource.ERR_PRODUCT_NOT_EXIST
+		}
+		logger.Error("query product failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	exist, err := dto.Client().Product.Query().Where(product.CodeEQ(param.Code)).Exist(c)
+	if err != nil {
+		logger.Error("check product code failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if exist {
+		return nil, resource.ERR_PRODUCT_CODE_EXIST
+	}
+	exist, err = dto.Client().Product.Query().Where(product.ProductNameEQ(param.ProductName)).Exist(c)
+	if err != nil {
+		logger.Error("check product name failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if exist {
+		return nil, resource.ERR_PRODUCT_NAME_EXIST
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	result, err := cloneProduct(c, tx, src, param, userID)
+	if err != nil {
+		logger.Error("clone product failed", zap.Error(err), zap.Int("source_product_id", src.ID))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionCreate,
+		Module:    dto.ModuleProduct,
+		ProductID: result.ProductID,
+		DetailInfo: map[string]interface{}{
+			"operation":         "clone",
+			"source_product_id": src.ID,
+			"code":              param.Code,
+			"product_name":      param.ProductName,
+			"mapping":           result,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	return result, resource.CODE_SUCCESS
+}
+
+// cloneProduct 在事务中创建新产品并复制配置，返回新旧ID对应关系
+func cloneProduct(ctx context.Context, tx *ent.Tx, src *ent.Product, param dto.CloneProduct, userID int) (*dto.CloneProductResult, error) {
+	p, err := tx.Product.Create().
+		SetCode(param.Code).
+		SetProductName(param.ProductName).
+		SetProductType(src.ProductType).
+		SetWarrantyMonths(src.WarrantyMonths).
+		SetDeviceAttributeSchema(src.DeviceAttributeSchema).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := grantViewerProduct(ctx, p.ID); err != nil {
+		return nil, err
+	}
+	_, err = tx.ProductManager.Create().
+		SetUserID(userID).
+		SetProductID(p.ID).
+		SetRole(productmanager.RoleMain).
+		SetPermissions(productmanager.PermissionsFull).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.CloneProductResult{
+		SourceProductID:  src.ID,
+		ProductID:        p.ID,
+		Features:         []dto.CloneMapping{},
+		LicenseTypes:     []dto.CloneMapping{},
+		FirmwareVersions: []dto.CloneMapping{},
+		SoftwareVersions: []dto.CloneMapping{},
+	}
+
+	// 功能
+	features, err := tx.ProductFeature.Query().
+		Where(productfeature.ProductIDEQ(src.ID)).
+		WithRequires().
+		WithConflicts().
+		Order(ent.Asc(productfeature.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	featureIDs := make(map[int]int, len(features))
+	if len(features) > 0 {
+		bulk := make([]*ent.ProductFeatureCreate, len(features))
+		for i, f := range features {
+			bulk[i] = tx.ProductFeature.Create().
+				SetProductID(p.ID).
+				SetFeatureName(f.FeatureName).
+				SetFeatureCode(f.FeatureCode)
+		}
+		created, err := tx.ProductFeature.CreateBulk(bulk...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i, f := range features {
+			featureIDs[f.ID] = created[i].ID
+			result.Features = append(result.Features, dto.CloneMapping{OldID: f.ID, NewID: created[i].ID, Key: f.FeatureCode})
+		}
+	}
+
+	// 功能之间的依赖和互斥关系，互斥关系是对称的，每对只添加一次
+	for _, f := range features {
+		var conflicts []*ent.ProductFeature
+		for _, r := range f.Edges.Conflicts {
+			if r.ID > f.ID {
+				conflicts = append(conflicts, r)
+			}
+		}
+		if len(f.Edges.Requires) == 0 && len(conflicts) == 0 {
+			continue
+		}
+		err := tx.ProductFeature.UpdateOneID(featureIDs[f.ID]).
+			AddRequireIDs(mapIDs(featureIDsOf(f.Edges.Requires), featureIDs)...).
+			AddConflictIDs(mapIDs(featureIDsOf(conflicts), featureIDs)...).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 许可证类型及其功能
+	licenseTypes, err := tx.LicenseType.Query().
+		Where(licensetype.ProductIDEQ(src.ID)).
+		WithFeatures().
+		Order(ent.Asc(licensetype.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	licenseTypeIDs := make(map[int]int, len(licenseTypes))
+	for _, lt := range licenseTypes {
+		created, err := tx.LicenseType.Create().
+			SetProductID(p.ID).
+			SetTypeName(lt.TypeName).
+			SetLicenseType(lt.LicenseType).
+			AddFeatureIDs(mapIDs(featureIDsOf(lt.Edges.Features), featureIDs)...).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		licenseTypeIDs[lt.ID] = created.ID
+		result.LicenseTypes = append(result.LicenseTypes, dto.CloneMapping{OldID: lt.ID, NewID: created.ID, Key: lt.LicenseType})
+	}
+	// 父类型在全部许可证类型创建后再关联，父类型已删除的不关联
+	for _, lt := range licenseTypes {
+		if lt.ParentID == nil {
+			continue
+		}
+		if parentID, ok := licenseTypeIDs[*lt.ParentID]; ok {
+			if err := tx.LicenseType.UpdateOneID(licenseTypeIDs[lt.ID]).SetParentID(parentID).Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !param.WithVersions {
+		return result, nil
+	}
+
+	// 韧件版本
+	firmwares, err := tx.FirmwareVersion.Query().
+		Where(firmwareversion.ProductIDEQ(src.ID)).
+		Order(ent.Asc(firmwareversion.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	firmwareIDs := make(map[int]int, len(firmwares))
+	for _, fw := range firmwares {
+		created, err := tx.FirmwareVersion.Create().
+			SetProductID(p.ID).
+			SetVersion(fw.Version).
+			SetReleaseDate(fw.ReleaseDate).
+			SetRemark(fw.Remark).
+			SetCreatedBy(userID).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		firmwareIDs[fw.ID] = created.ID
+		result.FirmwareVersions = append(result.FirmwareVersions, dto.CloneMapping{OldID: fw.ID, NewID: created.ID, Key: fw.Version})
+	}
+
+	// 软件版本及其功能和兼容的韧件版本
+	softwares, err := tx.SoftwareVersion.Query().
+		Where(softwareversion.ProductIDEQ(src.ID)).
+		WithFeatures().
+		WithFirmwareVersions().
+		Order(ent.Asc(softwareversion.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, sv := range softwares {
+		created, err := tx.SoftwareVersion.Create().
+			SetProductID(p.ID).
+			SetVersion(sv.Version).
+			SetReleaseDate(sv.ReleaseDate).
+			SetUpdateLog(sv.UpdateLog).
+			SetRemark(sv.Remark).
+			SetCreatedBy(userID).
+			AddFeatureIDs(mapIDs(featureIDsOf(sv.Edges.Features), featureIDs)...).
+			AddFirmwareVersionIDs(mapIDs(firmwareIDsOf(sv.Edges.FirmwareVersions), firmwareIDs)...).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result.SoftwareVersions = append(result.SoftwareVersions, dto.CloneMapping{OldID: sv.ID, NewID: created.ID, Key: sv.Version})
+	}
+
+	return result, nil
+}
+
+// mapIDs 将源产品记录的ID转换为新产品中对应记录的ID，没有对应记录（如已删除）的忽略
+func mapIDs(oldIDs []int, mapping map[int]int) []int {
+	ids := make([]int, 0, len(oldIDs))
+	for _, old := range oldIDs {
+		if id, ok := mapping[old]; ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func featureIDsOf(features []*ent.ProductFeature) []int {
+	ids := make([]int, len(features))
+	for i, f := range features {
+		ids[i] = f.ID
+	}
+	return ids
+}
+
+func firmwareIDsOf(firmwares []*ent.FirmwareVersion) []int {
+	ids := make([]int, len(firmwares))
+	for i, fw := range firmwares {
+		ids[i] = fw.ID
+	}
+	return ids
+}
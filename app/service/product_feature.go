@@ -2,13 +2,12 @@ package service
 
 import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
-	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetypefeatures"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
-	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
 	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
 	"cambridge-hit.com/gin-base/activateserver/resource"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"time"
 )
 
 type ProductFeatureService struct{}
@@ -20,15 +19,7 @@ func NewProductFeatureService() *ProductFeatureService {
 // ListProductFeatures 获取产品功能列表
 func (s *ProductFeatureService) ListProductFeatures(c *gin.Context, userID, productID int, page, pageSize int) (*dto.PageResult, resource.RspCode) {
 	// 检查用户是否有权限查看该产品的功能
-	_, err := dto.Client().ProductManager.Query().
-		Where(
-			productmanager.ProductIDEQ(productID),
-			productmanager.UserIDEQ(userID),
-		).Only(c)
-
-	// 超级管理员跳过权限检查
-	if err != nil && userID != 1 {
-		logger.Error("check permission failed", zap.Error(err))
+	if !authorize(c, userID, productID, dto.PermProductView) {
 		return nil, resource.ERR_NO_PERMISSION
 	}
 
@@ -77,12 +68,7 @@ func (s *ProductFeatureService) ListProductFeatures(c *gin.Context, userID, prod
 // AddProductFeature 添加产品功能
 func (s *ProductFeatureService) AddProductFeature(c *gin.Context, userID int, param dto.AddProductFeature) resource.RspCode {
 	// 1. 检查用户权限
-	pm, err := dto.Client().ProductManager.Query().
-		Where(
-			productmanager.ProductIDEQ(param.ProductID),
-			productmanager.UserIDEQ(userID),
-		).Only(c)
-	if err != nil || (userID != 1 && pm.Permissions == productmanager.PermissionsRead) {
+	if !authorize(c, userID, param.ProductID, dto.PermProductEdit) {
 		return resource.ERR_NO_PERMISSION
 	}
 
@@ -158,12 +144,7 @@ func (s *ProductFeatureService) DeleteProductFeature(c *gin.Context, userID, fea
 	}
 
 	// 2. 检查用户权限
-	pm, err := dto.Client().ProductManager.Query().
-		Where(
-			productmanager.ProductIDEQ(feature.ProductID),
-			productmanager.UserIDEQ(userID),
-		).Only(c)
-	if err != nil || (userID != 1 && pm.Permissions == productmanager.PermissionsRead) {
+	if !authorize(c, userID, feature.ProductID, dto.PermProductEdit) {
 		return resource.ERR_NO_PERMISSION
 	}
 
@@ -180,23 +161,15 @@ func (s *ProductFeatureService) DeleteProductFeature(c *gin.Context, userID, fea
 		}
 	}()
 
-	// 4. Clear license type features if exists
-	_, err = tx.LicenseTypeFeatures.Delete().Where(licensetypefeatures.FeatureIDEQ(featureID)).Exec(c)
-	if err != nil {
-		logger.Error("failed to clear license type features", zap.Error(err))
-		_ = tx.Rollback()
-		return resource.ERR_DEL_FAILED
-	}
-
-	// 5. Delete feature
-	err = tx.ProductFeature.DeleteOne(feature).Exec(c)
+	// 4. Move feature to recycle bin, license type mappings are kept for restore
+	err = tx.ProductFeature.UpdateOne(feature).SetDeletedAt(time.Now()).Exec(c)
 	if err != nil {
 		logger.Error("failed to delete product feature", zap.Error(err))
 		_ = tx.Rollback()
 		return resource.ERR_DEL_FAILED
 	}
 
-	// 6. 创建审计日志
+	// 5. 创建审计日志
 	err = CreateAuditLog(c, tx, dto.AuditLogData{
 		UserID:     userID,
 		Action:     dto.ActionDelete,
@@ -0,0 +1,606 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productinvitation"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// invitationStatus 邀请状态，未处理且超过有效期的为expired
+func invitationStatus(inv *ent.ProductInvitation, now time.Time) string {
+	if inv.Status == productinvitation.StatusPending && !now.Before(inv.ExpiresAt) {
+		return dto.InvitationStatusExpired
+	}
+	return inv.Status.String()
+}
+
+// invitationExpiresAt 邀请的过期时间，未指定有效期时使用默认值
+func invitationExpiresAt(now time.Time, days int) time.Time {
+	if days <= 0 {
+		days = dto.DefaultInvitationExpireDays
+	}
+	return now.AddDate(0, 0, days)
+}
+
+// managerInvitationRole 兼容添加管理员接口：未指定角色时按permissions推导，与旧记录的推导规则一致
+func managerInvitationRole(role productmanager.AccessRole, perms productmanager.Permissions) productmanager.AccessRole {
+	if role != "" {
+		return role
+	}
+	if perms == productmanager.PermissionsFull {
+		return productmanager.AccessRoleProductAdmin
+	}
+	return productmanager.AccessRoleViewer
+}
+
+func toInvitationInfo(inv *ent.ProductInvitation, now time.Time) dto.InvitationInfo {
+	info := dto.InvitationInfo{
+		ID:          inv.ID,
+		ProductID:   inv.ProductID,
+		Type:        inv.Type.String(),
+		Email:       inv.Email,
+		Remark:      inv.Remark,
+		Status:      invitationStatus(inv, now),
+		InvitedBy:   inv.InvitedBy,
+		ExpiresAt:   inv.ExpiresAt,
+		RespondedAt: inv.RespondedAt,
+		CreatedAt:   inv.CreatedAt,
+	}
+	if inv.AccessRole != nil {
+		info.AccessRole = inv.AccessRole.String()
+	}
+	if p := inv.Edges.Product; p != nil {
+		info.ProductCode = p.Code
+		info.ProductName = p.ProductName
+	}
+	if u := inv.Edges.Inviter; u != nil {
+		info.InviterEmail = u.Email
+	}
+	return info
+}
+
+// ProductInvitationService 产品管理员邀请和主管理员转让
+type ProductInvitationService struct{}
+
+// NewProductInvitationService 创建产品邀请服务实例
+func NewProductInvitationService() *ProductInvitationService {
+	return &ProductInvitationService{}
+}
+
+// InviteManager 邀请副管理员，被邀请人接受后加入；邮箱未注册时在注册后自动加入
+func (s *ProductInvitationService) InviteManager(c *gin.Context, userID int, param dto.InviteManager) (*dto.InvitationInfo, resource.RspCode) {
+	if !authorize(c, userID, param.ProductID, dto.PermManagerManage) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+	if param.AccessRole == "" {
+		param.AccessRole = productmanager.AccessRoleViewer
+	} else if productmanager.AccessRoleValidator(param.AccessRole) != nil {
+		return nil, resource.ERR_INVALID_PARAMETER
+	}
+
+	// 已是该产品管理员的用户不再邀请
+	exist, err := dto.Client().ProductManager.Query().
+		Where(
+			productmanager.ProductIDEQ(param.ProductID),
+			productmanager.HasUserWith(user.EmailEQ(param.Email)),
+		).Exist(c)
+	if err != nil {
+		logger.Error("check existing manager failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if exist {
+		return nil, resource.ERR_MANAGER_ALREADY_EXIST
+	}
+
+	role := productinvitation.AccessRole(param.AccessRole)
+	return createInvitation(c, userID, invitationData{
+		ProductID:  param.ProductID,
+		Type:       productinvitation.TypeManager,
+		Email:      param.Email,
+		AccessRole: &role,
+		Remark:     param.Remark,
+		ExpireDays: param.ExpireDays,
+	})
+}
+
+// TransferOwnership 发起主管理员转让，只有主管理员和系统管理员可以操作，新主管理员接受后生效，
+// 原主管理员降为只读副管理员
+func (s *ProductInvitationService) TransferOwnership(c *gin.Context, userID int, param dto.TransferOwnership) (*dto.InvitationInfo, resource.RspCode) {
+	role, err := productRole(c, userID, param.ProductID)
+	if err != nil {
+		logger.Error("query product role failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if role != dto.RoleOwner && role != dto.RoleSystemAdmin {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	isOwner, err := dto.Client().ProductManager.Query().
+		Where(
+			productmanager.ProductIDEQ(param.ProductID),
+			productmanager.RoleEQ(productmanager.RoleMain),
+			productmanager.HasUserWith(user.EmailEQ(param.Email)),
+		).Exist(c)
+	if err != nil {
+		logger.Error("check product owner failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if isOwner {
+		return nil, resource.ERR_INVALID_PARAMETER
+	}
+
+	return createInvitation(c, userID, invitationData{
+		ProductID:  param.ProductID,
+		Type:       productinvitation.TypeTransfer,
+		Email:      param.Email,
+		ExpireDays: param.ExpireDays,
+	})
+}
+
+// invitationData 新建邀请的内容
+type invitationData struct {
+	ProductID  int
+	Type       productinvitation.Type
+	Email      string
+	AccessRole *productinvitation.AccessRole
+	Remark     string
+	ExpireDays int
+}
+
+// createInvitation 创建邀请，同一产品同一邮箱只能有一个未过期的待处理邀请，转让同时只能有一个
+func createInvitation(c *gin.Context, userID int, data invitationData) (*dto.InvitationInfo, resource.RspCode) {
+	now := time.Now()
+	pending := dto.Client().ProductInvitation.Query().
+		Where(
+			productinvitation.ProductIDEQ(data.ProductID),
+			productinvitation.StatusEQ(productinvitation.StatusPending),
+			productinvitation.ExpiresAtGT(now),
+		)
+	if data.Type == productinvitation.TypeTransfer {
+		pending.Where(productinvitation.TypeEQ(productinvitation.TypeTransfer))
+	} else {
+		pending.Where(productinvitation.EmailEQ(data.Email))
+	}
+	exist, err := pending.Exist(c)
+	if err != nil {
+		logger.Error("check pending invitation failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if exist {
+		return nil, resource.ERR_INVITATION_PENDING
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	inv, err := tx.ProductInvitation.Create().
+		SetProductID(data.ProductID).
+		SetType(data.Type).
+		SetEmail(data.Email).
+		SetNillableAccessRole(data.AccessRole).
+		SetRemark(data.Remark).
+		SetInvitedBy(userID).
+		SetExpiresAt(invitationExpiresAt(now, data.ExpireDays)).
+		Save(c)
+	if err != nil {
+		logger.Error("create invitation failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionCreate,
+		Module:    dto.ModuleProduct,
+		ProductID: data.ProductID,
+		DetailInfo: map[string]interface{}{
+			"operation":     "invite_" + data.Type.String(),
+			"invitation_id": inv.ID,
+			"email":         inv.Email,
+			"access_role":   data.AccessRole,
+			"expires_at":    inv.ExpiresAt,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+	info := toInvitationInfo(inv, now)
+	return &info, resource.CODE_SUCCESS
+}
+
+// ListInvitations 产品的邀请列表，需要管理员管理权限
+func (s *ProductInvitationService) ListInvitations(c *gin.Context, userID int, query dto.InvitationQuery) ([]dto.InvitationInfo, resource.RspCode) {
+	if !authorize(c, userID, query.ProductID, dto.PermManagerManage) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	now := time.Now()
+	q := dto.Client().ProductInvitation.Query().
+		Where(productinvitation.ProductIDEQ(query.ProductID)).
+		WithInviter().
+		Order(ent.Desc(productinvitation.FieldID))
+	switch query.Status {
+	case "":
+	case dto.InvitationStatusExpired:
+		q.Where(productinvitation.StatusEQ(productinvitation.StatusPending), productinvitation.ExpiresAtLTE(now))
+	case productinvitation.StatusPending.String():
+		q.Where(productinvitation.StatusEQ(productinvitation.StatusPending), productinvitation.ExpiresAtGT(now))
+	default:
+		q.Where(productinvitation.StatusEQ(productinvitation.Status(query.Status)))
+	}
+	invitations, err := q.All(c)
+	if err != nil {
+		logger.Error("query invitations failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	result := make([]dto.InvitationInfo, 0, len(invitations))
+	for _, inv := range invitations {
+		result = append(result, toInvitationInfo(inv, now))
+	}
+	return result, resource.CODE_SUCCESS
+}
+
+// ListMyInvitations 当前用户收到的待处理邀请
+func (s *ProductInvitationService) ListMyInvitations(c *gin.Context, userID int) ([]dto.InvitationInfo, resource.RspCode) {
+	u, err := dto.Client().User.Get(c, userID)
+	if err != nil {
+		logger.Error("query user failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	now := time.Now()
+	invitations, err := dto.Client().ProductInvitation.Query().
+		Where(
+			productinvitation.EmailEqualFold(u.Email),
+			productinvitation.StatusEQ(productinvitation.StatusPending),
+			productinvitation.ExpiresAtGT(now),
+			productinvitation.HasProductWith(product.DeletedAtIsNil()),
+		).
+		WithProduct().
+		WithInviter().
+		Order(ent.Desc(productinvitation.FieldID)).
+		All(c)
+	if err != nil {
+		logger.Error("query my invitations failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	result := make([]dto.InvitationInfo, 0, len(invitations))
+	for _, inv := range invitations {
+		result = append(result, toInvitationInfo(inv, now))
+	}
+	return result, resource.CODE_SUCCESS
+}
+
+// inviteeInvitation 获取当前用户收到的待处理邀请
+func inviteeInvitation(c *gin.Context, userID, id int) (*ent.ProductInvitation, resource.RspCode) {
+	inv, err := dto.Client().ProductInvitation.Get(c, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, resource.ERR_INVITATION_NOT_EXIST
+		}
+		logger.Error("query invitation failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	u, err := dto.Client().User.Get(c, userID)
+	if err != nil {
+		logger.Error("query user failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if !strings.EqualFold(inv.Email, u.Email) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+	switch invitationStatus(inv, time.Now()) {
+	case productinvitation.StatusPending.String():
+		return inv, resource.CODE_SUCCESS
+	case dto.InvitationStatusExpired:
+		return nil, resource.ERR_INVITATION_EXPIRED
+	default:
+		return nil, resource.ERR_INVITATION_NOT_PENDING
+	}
+}
+
+// AcceptInvitation 接受邀请，加入产品管理员或成为主管理员
+func (s *ProductInvitationService) AcceptInvitation(c *gin.Context, userID, id int) resource.RspCode {
+	inv, code := inviteeInvitation(c, userID, id)
+	if code != resource.CODE_SUCCESS {
+		return code
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if code := acceptInvitation(c, tx, inv, userID); code != resource.CODE_SUCCESS {
+		_ = tx.Rollback()
+		return code
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	return resource.CODE_SUCCESS
+}
+
+// acceptInvitation 在事务中执行邀请并标记为已接受，接受邀请的用户此时还不是产品管理员，
+// 需要先将产品加入查询身份的范围才能写入审计日志
+func acceptInvitation(ctx context.Context, tx *ent.Tx, inv *ent.ProductInvitation, userID int) resource.RspCode {
+	exist, err := tx.Product.Query().Where(product.IDEQ(inv.ProductID)).Exist(ctx)
+	if err != nil {
+		logger.Error("query product failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if !exist {
+		return resource.ERR_PRODUCT_NOT_EXIST
+	}
+
+	switch inv.Type {
+	case productinvitation.TypeTransfer:
+		if err := transferOwner(ctx, tx, inv.ProductID, userID); err != nil {
+			logger.Error("transfer product owner failed", zap.Error(err))
+			return resource.ERR_MOD_FAILED
+		}
+	default:
+		exist, err := tx.ProductManager.Query().
+			Where(productmanager.ProductIDEQ(inv.ProductID), productmanager.UserIDEQ(userID)).
+			Exist(ctx)
+		if err != nil {
+			logger.Error("check existing manager failed", zap.Error(err))
+			return resource.ERR_QUERY_FAILED
+		}
+		if exist {
+			return resource.ERR_MANAGER_ALREADY_EXIST
+		}
+		role := productmanager.AccessRoleViewer
+		if inv.AccessRole != nil {
+			role = productmanager.AccessRole(*inv.AccessRole)
+		}
+		err = tx.ProductManager.Create().
+			SetUserID(userID).
+			SetProductID(inv.ProductID).
+			SetRole(productmanager.RoleAssistant).
+			SetAccessRole(role).
+			SetPermissions(legacyPermissions(role)).
+			SetRemark(inv.Remark).
+			Exec(ctx)
+		if err != nil {
+			logger.Error("add manager failed", zap.Error(err))
+			return resource.ERR_ADD_FAILED
+		}
+	}
+
+	code := respondInvitation(ctx, tx.ProductInvitation, inv, userID, productinvitation.StatusAccepted)
+	if code != resource.CODE_SUCCESS {
+		return code
+	}
+	if err := grantViewerProduct(ctx, inv.ProductID); err != nil {
+		logger.Error("grant viewer product failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+
+	err = CreateAuditLog(ctx, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionUpdate,
+		Module:    dto.ModuleProduct,
+		ProductID: inv.ProductID,
+		DetailInfo: map[string]interface{}{
+			"operation":     "accept_invitation",
+			"invitation_id": inv.ID,
+			"type":          inv.Type,
+			"invited_by":    inv.InvitedBy,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		return resource.ERR_ADD_LOG_FAILED
+	}
+	return resource.CODE_SUCCESS
+}
+
+// transferOwner 将产品主管理员变更为指定用户，原主管理员降为只读副管理员
+func transferOwner(ctx context.Context, tx *ent.Tx, productID, newOwnerID int) error {
+	mainManager, err := tx.ProductManager.Query().
+		Where(
+			productmanager.ProductIDEQ(productID),
+			productmanager.RoleEQ(productmanager.RoleMain),
+		).Only(ctx)
+	if err != nil {
+		return err
+	}
+	if mainManager.UserID == newOwnerID {
+		return nil
+	}
+
+	err = tx.ProductManager.UpdateOne(mainManager).
+		SetRole(productmanager.RoleAssistant).
+		SetPermissions(productmanager.DefaultPermissions).
+		ClearAccessRole().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	pm, err := tx.ProductManager.Query().
+		Where(
+			productmanager.ProductIDEQ(productID),
+			productmanager.UserIDEQ(newOwnerID),
+		).Only(ctx)
+	if ent.IsNotFound(err) {
+		return tx.ProductManager.Create().
+			SetUserID(newOwnerID).
+			SetProductID(productID).
+			SetRole(productmanager.RoleMain).
+			SetPermissions(productmanager.PermissionsFull).
+			Exec(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	return tx.ProductManager.UpdateOne(pm).
+		SetRole(productmanager.RoleMain).
+		SetPermissions(productmanager.PermissionsFull).
+		ClearAccessRole().
+		SetRemark("").
+		Exec(ctx)
+}
+
+// respondInvitation 更新待处理邀请的状态，邀请已被并发处理时返回ERR_INVITATION_NOT_PENDING
+func respondInvitation(ctx context.Context, client *ent.ProductInvitationClient, inv *ent.ProductInvitation, userID int, status productinvitation.Status) resource.RspCode {
+	n, err := client.Update().
+		Where(
+			productinvitation.IDEQ(inv.ID),
+			productinvitation.StatusEQ(productinvitation.StatusPending),
+		).
+		SetStatus(status).
+		SetRespondedBy(userID).
+		SetRespondedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		logger.Error("update invitation failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	if n == 0 {
+		return resource.ERR_INVITATION_NOT_PENDING
+	}
+	return resource.CODE_SUCCESS
+}
+
+// DeclineInvitation 拒绝邀请
+func (s *ProductInvitationService) DeclineInvitation(c *gin.Context, userID, id int) resource.RspCode {
+	inv, code := inviteeInvitation(c, userID, id)
+	if code != resource.CODE_SUCCESS {
+		return code
+	}
+	// 被邀请人不是产品管理员，拒绝记录在邀请中，不写产品审计日志
+	return respondInvitation(c, dto.Client().ProductInvitation, inv, userID, productinvitation.StatusDeclined)
+}
+
+// RevokeInvitation 撤销待处理的邀请，需要管理员管理权限
+func (s *ProductInvitationService) RevokeInvitation(c *gin.Context, userID, id int) resource.RspCode {
+	inv, err := dto.Client().ProductInvitation.Get(c, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_INVITATION_NOT_EXIST
+		}
+		logger.Error("query invitation failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if !authorize(c, userID, inv.ProductID, dto.PermManagerManage) {
+		return resource.ERR_NO_PERMISSION
+	}
+	if inv.Status != productinvitation.StatusPending {
+		return resource.ERR_INVITATION_NOT_PENDING
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if code := respondInvitation(c, tx.ProductInvitation, inv, userID, productinvitation.StatusRevoked); code != resource.CODE_SUCCESS {
+		_ = tx.Rollback()
+		return code
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionDelete,
+		Module:    dto.ModuleProduct,
+		ProductID: inv.ProductID,
+		DetailInfo: map[string]interface{}{
+			"operation":     "revoke_invitation",
+			"invitation_id": inv.ID,
+			"type":          inv.Type,
+			"email":         inv.Email,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	return resource.CODE_SUCCESS
+}
+
+// completeInvitations 新注册的用户自动接受发给其邮箱的副管理员邀请，转让邀请仍需用户登录后接受。
+// 注册接口没有登录用户，以新用户的身份执行；单个邀请失败不影响注册
+func completeInvitations(c *gin.Context, u *ent.User) {
+	c.Set(viewer.ContextKey, NewViewer(u.ID))
+	invitations, err := dto.Client().ProductInvitation.Query().
+		Where(
+			productinvitation.EmailEqualFold(u.Email),
+			productinvitation.TypeEQ(productinvitation.TypeManager),
+			productinvitation.StatusEQ(productinvitation.StatusPending),
+			productinvitation.ExpiresAtGT(time.Now()),
+		).All(c)
+	if err != nil {
+		logger.Error("query invitations for new user failed", zap.Error(err))
+		return
+	}
+
+	for _, inv := range invitations {
+		tx, err := dto.Client().Tx(c)
+		if err != nil {
+			logger.Error("begin transaction failed", zap.Error(err))
+			return
+		}
+		if code := acceptInvitation(c, tx, inv, u.ID); code != resource.CODE_SUCCESS {
+			logger.Warn("complete invitation failed", zap.Int("invitation_id", inv.ID), zap.Int("code", int(code)))
+			_ = tx.Rollback()
+			continue
+		}
+		if err := tx.Commit(); err != nil {
+			logger.Error("commit transaction failed", zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productinvitation"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+)
+
+func TestInvitationStatus(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		status    productinvitation.Status
+		expiresAt time.Time
+		want      string
+	}{
+		{productinvitation.StatusPending, now.Add(time.Hour), "pending"},
+		{productinvitation.StatusPending, now, dto.InvitationStatusExpired},
+		{productinvitation.StatusPending, now.Add(-time.Hour), dto.InvitationStatusExpired},
+		// 已处理的邀请保持原状态
+		{productinvitation.StatusAccepted, now.Add(-time.Hour), "accepted"},
+		{productinvitation.StatusRevoked, now.Add(time.Hour), "revoked"},
+	}
+	for i, tt := range tests {
+		inv := &ent.ProductInvitation{Status: tt.status, ExpiresAt: tt.expiresAt}
+		if got := invitationStatus(inv, now); got != tt.want {
+			t.Errorf("case %d: status = %s, want %s", i, got, tt.want)
+		}
+	}
+
+	if got := invitationExpiresAt(now, 0); !got.Equal(now.AddDate(0, 0, dto.DefaultInvitationExpireDays)) {
+		t.Errorf("default expires at = %v", got)
+	}
+	if got := invitationExpiresAt(now, 1); !got.Equal(now.Add(24 * time.Hour)) {
+		t.Errorf("expires at = %v", got)
+	}
+}
+
+func TestManagerInvitationRole(t *testing.T) {
+	tests := []struct {
+		role  productmanager.AccessRole
+		perms productmanager.Permissions
+		want  productmanager.AccessRole
+	}{
+		{"", "", productmanager.AccessRoleViewer},
+		{"", productmanager.PermissionsRead, productmanager.AccessRoleViewer},
+		{"", productmanager.PermissionsFull, productmanager.AccessRoleProductAdmin},
+		{productmanager.AccessRoleReleaseManager, productmanager.PermissionsRead, productmanager.AccessRoleReleaseManager},
+	}
+	for i, tt := range tests {
+		if got := managerInvitationRole(tt.role, tt.perms); got != tt.want {
+			t.Errorf("case %d: role = %s, want %s", i, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/cache"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+)
+
+// productSummaryExpire 产品设备统计缓存时间，设备写操作会主动清除缓存，过期时间只是兜底
+const productSummaryExpire = 10 * time.Minute
+
+// productSummaryKey 产品设备统计缓存键
+func productSummaryKey(productID int) string {
+	return "Product_Summary:" + strconv.Itoa(productID)
+}
+
+// InvalidateProductSummary 清除产品设备统计缓存，在设备新增、修改、删除和恢复提交后调用
+func InvalidateProductSummary(productIDs ...int) {
+	if cache.MyRedis == nil || len(productIDs) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(productIDs))
+	for _, id := range productIDs {
+		keys = append(keys, productSummaryKey(id))
+	}
+	if err := cache.MyRedis.DelMany(keys...); err != nil {
+		logger.Error("invalidate product summary failed", zap.Error(err), zap.Ints("product_ids", productIDs))
+	}
+}
+
+// invalidateDeviceSummaries 清除设备所属产品的统计缓存
+func invalidateDeviceSummaries(devices []*ent.Device) {
+	seen := make(map[int]bool)
+	var ids []int
+	for _, d := range devices {
+		if !seen[d.ProductID] {
+			seen[d.ProductID] = true
+			ids = append(ids, d.ProductID)
+		}
+	}
+	InvalidateProductSummary(ids...)
+}
+
+// productSummaries 获取产品设备统计，优先读取缓存，未命中的产品通过分组聚合查询统计后写入缓存
+func productSummaries(ctx context.Context, products []*ent.Product) ([]dto.DeviceSummary, error) {
+	cached := cachedProductSummaries(products)
+
+	var missing []int
+	for _, p := range products {
+		if _, ok := cached[p.ID]; !ok {
+			missing = append(missing, p.ID)
+		}
+	}
+	if len(missing) > 0 {
+		computed, err := aggregateProductSummaries(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+		for id, s := range computed {
+			cached[id] = s
+			storeProductSummary(s)
+		}
+	}
+
+	summaries := make([]dto.DeviceSummary, 0, len(products))
+	for _, p := range products {
+		s := cached[p.ID]
+		s.ProductName = p.ProductName
+		summaries = append(summaries, s)
+	}
+	return summaries, nil
+}
+
+// cachedProductSummaries 批量读取缓存，缓存不可用时返回空结果
+func cachedProductSummaries(products []*ent.Product) map[int]dto.DeviceSummary {
+	result := make(map[int]dto.DeviceSummary)
+	if cache.MyRedis == nil || len(products) == 0 {
+		return result
+	}
+	keys := make([]string, len(products))
+	for i, p := range products {
+		keys[i] = productSummaryKey(p.ID)
+	}
+	values, err := cache.MyRedis.MGet(keys...)
+	if err != nil {
+		logger.Warn("read product summary cache failed", zap.Error(err))
+		return result
+	}
+	for i, v := range values {
+		raw, ok := v.(string)
+		if !ok {
+			continue
+		}
+		var s dto.DeviceSummary
+		if err := jsoniter.UnmarshalFromString(raw, &s); err != nil || s.ProductID != products[i].ID {
+			continue
+		}
+		result[s.ProductID] = s
+	}
+	return result
+}
+
+// storeProductSummary 写入缓存，产品名称不缓存，以便修改产品名称后无需清除缓存
+func storeProductSummary(s dto.DeviceSummary) {
+	if cache.MyRedis == nil {
+		return
+	}
+	s.ProductName = ""
+	raw, err := jsoniter.MarshalToString(s)
+	if err != nil {
+		return
+	}
+	if err := cache.MyRedis.Set(productSummaryKey(s.ProductID), raw, productSummaryExpire); err != nil {
+		logger.Warn("write product summary cache failed", zap.Error(err), zap.Int("product_id", s.ProductID))
+	}
+}
+
+// aggregateProductSummaries 按(产品, 许可证类型)和(产品, 状态)分组统计设备数量，共两次查询
+func aggregateProductSummaries(ctx context.Context, productIDs []int) (map[int]dto.DeviceSummary, error) {
+	result := make(map[int]dto.DeviceSummary, len(productIDs))
+	for _, id := range productIDs {
+		states := make(map[string]int, len(deviceStates))
+		for _, st := range deviceStates {
+			states[st.String()] = 0
+		}
+		result[id] = dto.DeviceSummary{ProductID: id, LicenseTypes: []dto.LicenseTypeCount{}, States: states}
+	}
+
+	var byLicense []struct {
+		ProductID     int  `json:"product_id"`
+		LicenseTypeID *int `json:"license_type_id"`
+		Count         int  `json:"count"`
+	}
+	err := dto.Client().Device.Query().
+		Where(device.ProductIDIn(productIDs...)).
+		GroupBy(device.FieldProductID, device.FieldLicenseTypeID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &byLicense)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range byLicense {
+		s := result[row.ProductID]
+		lt := dto.LicenseTypeCount{Count: row.Count}
+		if row.LicenseTypeID != nil {
+			lt.LicenseTypeID = *row.LicenseTypeID
+		}
+		s.Count += row.Count
+		s.LicenseTypes = append(s.LicenseTypes, lt)
+		result[row.ProductID] = s
+	}
+
+	var byState []struct {
+		ProductID int    `json:"product_id"`
+		State     string `json:"state"`
+		Count     int    `json:"count"`
+	}
+	err = dto.Client().Device.Query().
+		Where(device.ProductIDIn(productIDs...)).
+		GroupBy(device.FieldProductID, device.FieldState).
+		Aggregate(ent.Count()).
+		Scan(ctx, &byState)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range byState {
+		result[row.ProductID].States[row.State] += row.Count
+	}
+
+	for id, s := range result {
+		sort.Slice(s.LicenseTypes, func(i, j int) bool {
+			return s.LicenseTypes[i].LicenseTypeID < s.LicenseTypes[j].LicenseTypeID
+		})
+		result[id] = s
+	}
+	return result, nil
+}
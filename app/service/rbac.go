@@ -0,0 +1,362 @@
+package service
+
+import (
+	"context"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+var (
+	viewerPermissions = []dto.Permission{dto.PermProductView, dto.PermDeviceView, dto.PermReleaseView}
+
+	// rolePermissions 权限矩阵，system_admin拥有全部权限
+	rolePermissions = map[string][]dto.Permission{
+		dto.RoleViewer: viewerPermissions,
+		dto.RoleDeviceOperator: append(append([]dto.Permission(nil), viewerPermissions...),
+			dto.PermDeviceWrite, dto.PermDeviceDelete, dto.PermSNAllocate),
+		dto.RoleReleaseManager: append(append([]dto.Permission(nil), viewerPermissions...),
+			dto.PermReleaseWrite),
+		dto.RoleProductAdmin: append(append([]dto.Permission(nil), viewerPermissions...),
+			dto.PermProductEdit, dto.PermDeviceWrite, dto.PermDeviceDelete, dto.PermSNAllocate,
+			dto.PermReleaseWrite, dto.PermAuditView, dto.PermRecycleManage),
+		dto.RoleOwner: append(append([]dto.Permission(nil), viewerPermissions...),
+			dto.PermProductEdit, dto.PermProductDelete, dto.PermManagerManage, dto.PermDeviceWrite,
+			dto.PermDeviceDelete, dto.PermSNAllocate, dto.PermReleaseWrite, dto.PermAuditView, dto.PermRecycleManage),
+		dto.RoleSystemAdmin: allPermissions,
+	}
+
+	allPermissions = []dto.Permission{
+		dto.PermProductView, dto.PermProductEdit, dto.PermProductDelete, dto.PermManagerManage,
+		dto.PermDeviceView, dto.PermDeviceWrite, dto.PermDeviceDelete, dto.PermSNAllocate,
+		dto.PermReleaseView, dto.PermReleaseWrite, dto.PermAuditView, dto.PermRecycleManage,
+	}
+
+	// roleOrder 返回权限矩阵时的角色顺序
+	roleOrder = []string{dto.RoleViewer, dto.RoleDeviceOperator, dto.RoleReleaseManager, dto.RoleProductAdmin, dto.RoleOwner, dto.RoleSystemAdmin}
+)
+
+// roleHasPermission 角色是否拥有权限，未知角色没有任何权限
+func roleHasPermission(role string, perm dto.Permission) bool {
+	for _, p := range rolePermissions[role] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// managerRole 产品管理员的角色：主管理员为owner，否则取access_role，
+// 未设置access_role的旧记录按permissions推导
+func managerRole(pm *ent.ProductManager) string {
+	if pm.Role == productmanager.RoleMain {
+		return dto.RoleOwner
+	}
+	if pm.AccessRole != nil {
+		return pm.AccessRole.String()
+	}
+	if pm.Permissions == productmanager.PermissionsFull {
+		return dto.RoleProductAdmin
+	}
+	return dto.RoleViewer
+}
+
+// legacyPermissions 与角色对应的permissions字段，保持旧字段的含义
+func legacyPermissions(role productmanager.AccessRole) productmanager.Permissions {
+	if role == productmanager.AccessRoleViewer {
+		return productmanager.PermissionsRead
+	}
+	return productmanager.PermissionsFull
+}
+
+// accessRoleString 未设置角色时为空
+func accessRoleString(role *productmanager.AccessRole) string {
+	if role == nil {
+		return ""
+	}
+	return role.String()
+}
+
+// isSystemAdmin 超级管理员和is_system_admin的用户
+func isSystemAdmin(ctx context.Context, userID int) bool {
+	if userID == dto.SuperAdminID {
+		return true
+	}
+	if userID <= 0 {
+		return false
+	}
+	admin, err := dto.Client().User.Query().
+		Where(user.IDEQ(userID), user.IsSystemAdmin(true), user.IsEnabled(true)).
+		Exist(ctx)
+	if err != nil {
+		logger.Error("check system admin failed", zap.Error(err), zap.Int("user_id", userID))
+		return false
+	}
+	return admin
+}
+
+// productRole 用户在产品中的角色，不是产品管理员时为空
+func productRole(ctx context.Context, userID, productID int) (string, error) {
+	if isSystemAdmin(ctx, userID) {
+		return dto.RoleSystemAdmin, nil
+	}
+	pm, err := dto.Client().ProductManager.Query().
+		Where(
+			productmanager.ProductIDEQ(productID),
+			productmanager.UserIDEQ(userID),
+		).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return managerRole(pm), nil
+}
+
+// authorize 检查用户在产品中是否拥有权限，所有服务方法的产品权限检查都经过这里；
+// API令牌认证的请求还需要令牌的授权范围包含该权限且可以访问该产品
+func authorize(ctx context.Context, userID, productID int, perm dto.Permission) bool {
+	if grant := auth.GetTokenGrant(ctx); grant != nil && !(grantHasPermission(grant, perm) && grant.AllowsProduct(productID)) {
+		return false
+	}
+	role, err := productRole(ctx, userID, productID)
+	if err != nil {
+		logger.Error("query product role failed", zap.Error(err), zap.Int("user_id", userID), zap.Int("product_id", productID))
+		return false
+	}
+	return roleHasPermission(role, perm)
+}
+
+// authorizedProductIDs 用户拥有权限的产品ID，系统管理员返回nil表示不限制
+func authorizedProductIDs(ctx context.Context, userID int, perm dto.Permission) ([]int, error) {
+	grant := auth.GetTokenGrant(ctx)
+	if grant != nil && !grantHasPermission(grant, perm) {
+		return []int{}, nil
+	}
+	if isSystemAdmin(ctx, userID) {
+		if grant != nil && len(grant.ProductIDs) > 0 {
+			return append([]int(nil), grant.ProductIDs...), nil
+		}
+		return nil, nil
+	}
+	managers, err := dto.Client().ProductManager.Query().
+		Where(productmanager.UserIDEQ(userID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, 0, len(managers))
+	for _, pm := range managers {
+		if roleHasPermission(managerRole(pm), perm) && (grant == nil || grant.AllowsProduct(pm.ProductID)) {
+			ids = append(ids, pm.ProductID)
+		}
+	}
+	return ids, nil
+}
+
+// NewViewer 用户的查询身份：系统管理员不限制，其他用户限制为其管理的产品，
+// 具体操作权限仍由authorize检查
+func NewViewer(userID int) *viewer.Viewer {
+	return viewer.ForUser(userID, func(ctx context.Context) ([]int, bool, error) {
+		if isSystemAdmin(ctx, userID) {
+			return nil, true, nil
+		}
+		ids, err := dto.Client().ProductManager.Query().
+			Where(productmanager.UserIDEQ(userID)).
+			Select(productmanager.FieldProductID).
+			Ints(ctx)
+		return ids, false, err
+	})
+}
+
+// NewTokenViewer 登录请求的查询身份，grant为nil表示通过JWT登录。
+// API令牌限制了产品时只能查询其中用户可访问的产品
+func NewTokenViewer(userID int, grant *auth.TokenGrant) *viewer.Viewer {
+	if grant == nil || len(grant.ProductIDs) == 0 {
+		return NewViewer(userID)
+	}
+	return viewer.ForUser(userID, func(ctx context.Context) ([]int, bool, error) {
+		if isSystemAdmin(ctx, userID) {
+			return append([]int(nil), grant.ProductIDs...), false, nil
+		}
+		ids, err := dto.Client().ProductManager.Query().
+			Where(productmanager.UserIDEQ(userID), productmanager.ProductIDIn(grant.ProductIDs...)).
+			Select(productmanager.FieldProductID).
+			Ints(ctx)
+		return ids, false, err
+	})
+}
+
+// grantViewerProduct 将请求中新建的产品加入当前查询身份的范围，之后才能写入该产品的功能、审计日志等记录
+func grantViewerProduct(ctx context.Context, productID int) error {
+	if v := viewer.FromContext(ctx); v != nil {
+		return v.AddProduct(ctx, productID)
+	}
+	return nil
+}
+
+// RBACService 角色和权限管理
+type RBACService struct{}
+
+// NewRBACService 创建角色权限服务实例
+func NewRBACService() *RBACService {
+	return &RBACService{}
+}
+
+// GetRoleMatrix 返回全部角色及其权限
+func (s *RBACService) GetRoleMatrix() []dto.RolePermissions {
+	result := make([]dto.RolePermissions, 0, len(roleOrder))
+	for _, role := range roleOrder {
+		result = append(result, dto.RolePermissions{Role: role, Permissions: rolePermissions[role]})
+	}
+	return result
+}
+
+// GetMyPermissions 当前用户在产品中的角色和权限
+func (s *RBACService) GetMyPermissions(c *gin.Context, userID, productID int) (*dto.ProductPermissions, resource.RspCode) {
+	role, err := productRole(c, userID, productID)
+	if err != nil {
+		logger.Error("query product role failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	perms := rolePermissions[role]
+	if perms == nil {
+		perms = []dto.Permission{}
+	}
+	return &dto.ProductPermissions{ProductID: productID, Role: role, Permissions: perms}, resource.CODE_SUCCESS
+}
+
+// SetManagerRole 设置产品副管理员的角色，同时更新permissions字段
+func (s *RBACService) SetManagerRole(c *gin.Context, userID int, param dto.SetManagerRole) resource.RspCode {
+	pm, err := dto.Client().ProductManager.Get(c, param.ManagerID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_MANAGER_NOT_EXIST
+		}
+		logger.Error("query manager failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if !authorize(c, userID, pm.ProductID, dto.PermManagerManage) {
+		return resource.ERR_NO_PERMISSION
+	}
+	// 主管理员的角色固定为owner
+	if pm.Role == productmanager.RoleMain {
+		return resource.ERR_INVALID_PARAMETER
+	}
+
+	role := productmanager.AccessRole(param.AccessRole)
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	err = tx.ProductManager.UpdateOne(pm).
+		SetAccessRole(role).
+		SetPermissions(legacyPermissions(role)).
+		Exec(c)
+	if err != nil {
+		logger.Error("set manager role failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_MOD_FAILED
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionUpdate,
+		Module:    dto.ModuleProduct,
+		ProductID: pm.ProductID,
+		DetailInfo: map[string]interface{}{
+			"operation":  "set_manager_role",
+			"manager_id": pm.ID,
+			"user_id":    pm.UserID,
+			"old_role":   managerRole(pm),
+			"new_role":   param.AccessRole,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	return resource.CODE_SUCCESS
+}
+
+// SetSystemAdmin 授予或撤销系统管理员，只有超级管理员可以操作，超级管理员本身不受影响
+func (s *RBACService) SetSystemAdmin(c *gin.Context, userID int, param dto.SetSystemAdmin) resource.RspCode {
+	if userID != dto.SuperAdminID {
+		return resource.ERR_NO_PERMISSION
+	}
+	if param.UserID == dto.SuperAdminID {
+		return resource.ERR_INVALID_PARAMETER
+	}
+	u, err := dto.Client().User.Get(c, param.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_USER_NOT_EXIST
+		}
+		logger.Error("query user failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := tx.User.UpdateOne(u).SetIsSystemAdmin(param.Enabled).Exec(c); err != nil {
+		logger.Error("set system admin failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_MOD_FAILED
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID: userID,
+		Action: dto.ActionUpdate,
+		Module: dto.ModuleUser,
+		DetailInfo: map[string]interface{}{
+			"operation": "set_system_admin",
+			"user_id":   u.ID,
+			"email":     u.Email,
+			"enabled":   param.Enabled,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	return resource.CODE_SUCCESS
+}
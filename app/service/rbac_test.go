@@ -0,0 +1,308 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+)
+
+func TestRolePermissionMatrix(t *testing.T) {
+	// 每行依次为 viewer, device_operator, release_manager, product_admin, owner, system_admin
+	matrix := map[dto.Permission][6]bool{
+		dto.PermProductView:   {true, true, true, true, true, true},
+		dto.PermProductEdit:   {false, false, false, true, true, true},
+		dto.PermProductDelete: {false, false, false, false, true, true},
+		dto.PermManagerManage: {false, false, false, false, true, true},
+		dto.PermDeviceView:    {true, true, true, true, true, true},
+		dto.PermDeviceWrite:   {false, true, false, true, true, true},
+		dto.PermDeviceDelete:  {false, true, false, true, true, true},
+		dto.PermSNAllocate:    {false, true, false, true, true, true},
+		dto.PermReleaseView:   {true, true, true, true, true, true},
+		dto.PermReleaseWrite:  {false, false, true, true, true, true},
+		dto.PermAuditView:     {false, false, false, true, true, true},
+		dto.PermRecycleManage: {false, false, false, true, true, true},
+	}
+	if len(matrix) != len(allPermissions) {
+		t.Fatalf("matrix covers %d permissions, want %d", len(matrix), len(allPermissions))
+	}
+	for _, perm := range allPermissions {
+		want, ok := matrix[perm]
+		if !ok {
+			t.Errorf("permission %s missing from matrix", perm)
+			continue
+		}
+		for i, role := range roleOrder {
+			if got := roleHasPermission(role, perm); got != want[i] {
+				t.Errorf("%s %s = %v, want %v", role, perm, got, want[i])
+			}
+		}
+	}
+
+	for _, perm := range allPermissions {
+		if roleHasPermission("", perm) || roleHasPermission("unknown", perm) {
+			t.Errorf("unknown role granted %s", perm)
+		}
+	}
+}
+
+func TestManagerRole(t *testing.T) {
+	role := func(r productmanager.AccessRole) *productmanager.AccessRole { return &r }
+	tests := []struct {
+		pm   ent.ProductManager
+		want string
+	}{
+		{ent.ProductManager{Role: productmanager.RoleMain, Permissions: productmanager.PermissionsFull}, dto.RoleOwner},
+		// 主管理员忽略access_role
+		{ent.ProductManager{Role: productmanager.RoleMain, AccessRole: role(productmanager.AccessRoleViewer)}, dto.RoleOwner},
+		{ent.ProductManager{Role: productmanager.RoleAssistant, Permissions: productmanager.PermissionsRead}, dto.RoleViewer},
+		{ent.ProductManager{Role: productmanager.RoleAssistant, Permissions: productmanager.PermissionsFull}, dto.RoleProductAdmin},
+		{ent.ProductManager{Role: productmanager.RoleAssistant, Permissions: productmanager.PermissionsFull,
+			AccessRole: role(productmanager.AccessRoleReleaseManager)}, dto.RoleReleaseManager},
+		{ent.ProductManager{Role: productmanager.RoleAssistant, Permissions: productmanager.PermissionsRead,
+			AccessRole: role(productmanager.AccessRoleDeviceOperator)}, dto.RoleDeviceOperator},
+	}
+	for i, tt := range tests {
+		if got := managerRole(&tt.pm); got != tt.want {
+			t.Errorf("case %d: managerRole = %s, want %s", i, got, tt.want)
+		}
+	}
+
+	if legacyPermissions(productmanager.AccessRoleViewer) != productmanager.PermissionsRead ||
+		legacyPermissions(productmanager.AccessRoleDeviceOperator) != productmanager.PermissionsFull {
+		t.Error("legacy permissions mismatch")
+	}
+}
+
+// rbacFixture 权限测试的产品、许可证类型、设备和订单
+type rbacFixture struct {
+	client  *ent.Client
+	product *ent.Product
+	lt      *ent.LicenseType
+	device  *ent.Device
+	order   *ent.Order
+	seq     int
+}
+
+func newRBACFixture(t *testing.T, code string) *rbacFixture {
+	client := testClient(t)
+	ctx := systemCtx()
+	f := &rbacFixture{client: client}
+	f.product = client.Product.Create().SetCode(code).SetProductName(code).SaveX(ctx)
+	f.lt = f.licenseType()
+	now := time.Now()
+	f.device = client.Device.Create().SetSn(code + "-SN").SetProductID(f.product.ID).SetLicenseTypeID(f.lt.ID).
+		SetCreatedAt(now).SetUpdatedAt(now).SaveX(ctx)
+	f.order = f.newOrder()
+	return f
+}
+
+// licenseType 新建未被使用的许可证类型
+func (f *rbacFixture) licenseType() *ent.LicenseType {
+	f.seq++
+	code := f.product.Code + "-LT" + string(rune('A'+f.seq))
+	return f.client.LicenseType.Create().SetTypeName(code).SetLicenseType(code).SetProductID(f.product.ID).SaveX(systemCtx())
+}
+
+// newOrder 新建没有批次和设备的订单
+func (f *rbacFixture) newOrder() *ent.Order {
+	f.seq++
+	return f.client.Order.Create().SetOrderNo(f.product.Code + "-ORD" + string(rune('A'+f.seq))).
+		SetProductID(f.product.ID).SetQuantity(1).SaveX(systemCtx())
+}
+
+func TestServiceRoleEnforcement(t *testing.T) {
+	f := newRBACFixture(t, "RBAC1")
+	client, ctx := f.client, systemCtx()
+	role := func(r productmanager.AccessRole) *productmanager.AccessRole { return &r }
+
+	tests := []struct {
+		name   string
+		role   productmanager.Role
+		access *productmanager.AccessRole
+		admin  bool
+		member bool
+		view   bool // 设备、订单查看
+		write  bool // 设备、订单修改
+		edit   bool // 产品修改
+	}{
+		{name: "outsider"},
+		{name: dto.RoleViewer, role: productmanager.RoleAssistant, access: role(productmanager.AccessRoleViewer), member: true, view: true},
+		{name: dto.RoleDeviceOperator, role: productmanager.RoleAssistant, access: role(productmanager.AccessRoleDeviceOperator), member: true, view: true, write: true},
+		{name: dto.RoleReleaseManager, role: productmanager.RoleAssistant, access: role(productmanager.AccessRoleReleaseManager), member: true, view: true},
+		{name: dto.RoleProductAdmin, role: productmanager.RoleAssistant, access: role(productmanager.AccessRoleProductAdmin), member: true, view: true, write: true, edit: true},
+		{name: dto.RoleOwner, role: productmanager.RoleMain, member: true, view: true, write: true, edit: true},
+		{name: dto.RoleSystemAdmin, admin: true, view: true, write: true, edit: true},
+	}
+
+	var operatorJob int
+	for i, tt := range tests {
+		// 避开超级管理员的ID
+		u := client.User.Create().SetID(dto.SuperAdminID + 600 + i).SetEmail("rbac-" + tt.name + "@example.com").
+			SetPassword("x").SetIsSystemAdmin(tt.admin).SaveX(ctx)
+		if tt.member {
+			client.ProductManager.Create().SetUserID(u.ID).SetProductID(f.product.ID).SetRole(tt.role).
+				SetNillableAccessRole(tt.access).SaveX(ctx)
+		}
+		// want 期望的结果，不可见产品的设备按不存在处理
+		want := func(allowed bool, hidden resource.RspCode) resource.RspCode {
+			switch {
+			case allowed:
+				return resource.CODE_SUCCESS
+			case !tt.member && !tt.admin && hidden != 0:
+				return hidden
+			}
+			return resource.ERR_NO_PERMISSION
+		}
+		check := func(op string, got, want resource.RspCode) {
+			t.Helper()
+			if got != want {
+				t.Errorf("%s %s: code = %v, want %v", tt.name, op, got, want)
+			}
+		}
+
+		filter := dto.DeviceFilter{}
+		result, code := NewDeviceService().ListDevices(userGinContext(u.ID, nil), u.ID, filter)
+		check("list devices", code, resource.CODE_SUCCESS)
+		if code == resource.CODE_SUCCESS && !tt.admin {
+			if n := len(result.List.([]dto.DeviceInfo)); (n == 1) != tt.view || n > 1 {
+				t.Errorf("%s list devices: %d devices", tt.name, n)
+			}
+		}
+		filter.ProductID = f.product.ID
+		result, code = NewDeviceService().ListDevices(userGinContext(u.ID, nil), u.ID, filter)
+		check("list product devices", code, want(tt.view, 0))
+		if code == resource.CODE_SUCCESS && len(result.List.([]dto.DeviceInfo)) != 1 {
+			t.Errorf("%s list product devices: %d devices", tt.name, len(result.List.([]dto.DeviceInfo)))
+		}
+
+		code = NewDeviceService().UpdateDevice(userGinContext(u.ID, nil), u.ID, dto.DeviceUpdate{ID: f.device.ID, LicenseTypeID: f.lt.ID, Remark: tt.name})
+		check("update device", code, want(tt.write, resource.ERR_DEVICE_NOT_EXIST))
+		if remark := client.Device.GetX(ctx, f.device.ID).Remark; (remark == tt.name) != tt.write {
+			t.Errorf("%s update device: remark = %q", tt.name, remark)
+		}
+
+		// 不可见产品的许可证类型查询失败会记录错误日志，测试中没有初始化日志
+		if tt.member || tt.admin {
+			lt := f.licenseType()
+			check("delete license type", NewLicenseTypeService().DeleteLicenseType(userGinContext(u.ID, nil), u.ID, lt.ID), want(tt.edit, 0))
+			if _, err := client.LicenseType.Get(ctx, lt.ID); ent.IsNotFound(err) != tt.edit {
+				t.Errorf("%s delete license type: err = %v", tt.name, err)
+			}
+		}
+
+		orders, code := NewOrderService().ListOrders(userGinContext(u.ID, nil), u.ID, dto.OrderQuery{ProductID: f.product.ID, PageSize: 20})
+		check("list orders", code, want(tt.view, 0))
+		if code == resource.CODE_SUCCESS && len(orders.List.([]dto.OrderInfo)) == 0 {
+			t.Errorf("%s list orders: no orders", tt.name)
+		}
+		code = NewOrderService().UpdateOrder(userGinContext(u.ID, nil), u.ID, dto.ModifyOrder{ID: f.order.ID, OrderNo: f.order.OrderNo, Quantity: 1, Remark: tt.name})
+		check("update order", code, want(tt.write, 0))
+		if remark := client.Order.GetX(ctx, f.order.ID).Remark; (remark == tt.name) != tt.write {
+			t.Errorf("%s update order: remark = %q", tt.name, remark)
+		}
+		o := f.newOrder()
+		check("delete order", NewOrderService().DeleteOrder(userGinContext(u.ID, nil), u.ID, o.ID), want(tt.write, 0))
+
+		info, code := NewJobService().SubmitDeviceBatchLicense(userGinContext(u.ID, nil), u.ID,
+			dto.DeviceBatchUpdateLicense{DeviceIDs: []int{f.device.ID}, LicenseTypeID: f.lt.ID})
+		check("submit batch license", code, want(tt.write, resource.ERR_DEVICE_NOT_EXIST))
+		if code == resource.CODE_SUCCESS && tt.name == dto.RoleDeviceOperator {
+			operatorJob = info.ID
+		}
+		jobs, code := NewJobService().ListJobs(userGinContext(u.ID, nil), u.ID, dto.JobQuery{})
+		check("list jobs", code, resource.CODE_SUCCESS)
+		if code == resource.CODE_SUCCESS && !tt.admin {
+			if n := len(jobs.List.([]dto.JobInfo)); (n == 1) != tt.write || n > 1 {
+				t.Errorf("%s list jobs: %d jobs", tt.name, n)
+			}
+		}
+		if operatorJob != 0 && tt.name != dto.RoleDeviceOperator {
+			_, code = NewJobService().GetJob(userGinContext(u.ID, nil), u.ID, operatorJob)
+			if (code == resource.CODE_SUCCESS) != tt.admin || (!tt.admin && code != resource.ERR_JOB_NOT_EXIST) {
+				t.Errorf("%s get other's job: code = %v", tt.name, code)
+			}
+		}
+	}
+}
+
+func TestServiceTokenGrantEnforcement(t *testing.T) {
+	f := newRBACFixture(t, "RBAC2")
+	other := newRBACFixture(t, "RBAC3")
+	client, ctx := f.client, systemCtx()
+	u := client.User.Create().SetID(dto.SuperAdminID + 610).SetEmail("rbac-token@example.com").SetPassword("x").SaveX(ctx)
+	for _, p := range []*ent.Product{f.product, other.product} {
+		client.ProductManager.Create().SetUserID(u.ID).SetProductID(p.ID).SetRole(productmanager.RoleMain).SaveX(ctx)
+	}
+
+	tests := []struct {
+		name      string
+		grant     *auth.TokenGrant
+		devices   int              // 不指定产品时可见的设备数
+		write     resource.RspCode // 修改设备和提交批量任务
+		orderEdit resource.RspCode // 修改和删除订单
+		jobs      resource.RspCode // 查询任务
+	}{
+		{"jwt", nil, 2, resource.CODE_SUCCESS, resource.CODE_SUCCESS, resource.CODE_SUCCESS},
+		{"devices:read", &auth.TokenGrant{Scopes: []string{dto.ScopeDevicesRead}},
+			2, resource.ERR_NO_PERMISSION, resource.ERR_NO_PERMISSION, resource.CODE_SUCCESS},
+		{"versions:write", &auth.TokenGrant{Scopes: []string{dto.ScopeVersionsWrite}},
+			0, resource.ERR_NO_PERMISSION, resource.ERR_NO_PERMISSION, resource.ERR_NO_PERMISSION},
+		{"devices:write other product", &auth.TokenGrant{Scopes: []string{dto.ScopeDevicesWrite}, ProductIDs: []int{other.product.ID}},
+			1, resource.ERR_DEVICE_NOT_EXIST, resource.ERR_NO_PERMISSION, resource.CODE_SUCCESS},
+		{"devices:write product", &auth.TokenGrant{Scopes: []string{dto.ScopeDevicesWrite}, ProductIDs: []int{f.product.ID}},
+			1, resource.CODE_SUCCESS, resource.CODE_SUCCESS, resource.CODE_SUCCESS},
+	}
+	for _, tt := range tests {
+		check := func(op string, got, want resource.RspCode) {
+			t.Helper()
+			if got != want {
+				t.Errorf("%s %s: code = %v, want %v", tt.name, op, got, want)
+			}
+		}
+		view := resource.CODE_SUCCESS
+		if tt.devices == 0 || (tt.grant != nil && !tt.grant.AllowsProduct(f.product.ID)) {
+			view = resource.ERR_NO_PERMISSION
+		}
+
+		result, code := NewDeviceService().ListDevices(userGinContext(u.ID, tt.grant), u.ID, dto.DeviceFilter{})
+		check("list devices", code, resource.CODE_SUCCESS)
+		if code == resource.CODE_SUCCESS && len(result.List.([]dto.DeviceInfo)) != tt.devices {
+			t.Errorf("%s list devices: %d devices, want %d", tt.name, len(result.List.([]dto.DeviceInfo)), tt.devices)
+		}
+		filter := dto.DeviceFilter{}
+		filter.ProductID = f.product.ID
+		_, code = NewDeviceService().ListDevices(userGinContext(u.ID, tt.grant), u.ID, filter)
+		check("list product devices", code, view)
+
+		code = NewDeviceService().UpdateDevice(userGinContext(u.ID, tt.grant), u.ID, dto.DeviceUpdate{ID: f.device.ID, LicenseTypeID: f.lt.ID, Remark: tt.name})
+		check("update device", code, tt.write)
+		if remark := client.Device.GetX(ctx, f.device.ID).Remark; (remark == tt.name) != (tt.write == resource.CODE_SUCCESS) {
+			t.Errorf("%s update device: remark = %q", tt.name, remark)
+		}
+		_, code = NewJobService().SubmitDeviceBatchLicense(userGinContext(u.ID, tt.grant), u.ID,
+			dto.DeviceBatchUpdateLicense{DeviceIDs: []int{f.device.ID}, LicenseTypeID: f.lt.ID})
+		check("submit batch license", code, tt.write)
+		_, code = NewJobService().ListJobs(userGinContext(u.ID, tt.grant), u.ID, dto.JobQuery{})
+		check("list jobs", code, tt.jobs)
+
+		// 没有授权范围对应产品修改权限，令牌不能删除许可证类型
+		if tt.grant == nil || tt.grant.AllowsProduct(f.product.ID) {
+			want := resource.ERR_NO_PERMISSION
+			if tt.grant == nil {
+				want = resource.CODE_SUCCESS
+			}
+			check("delete license type", NewLicenseTypeService().DeleteLicenseType(userGinContext(u.ID, tt.grant), u.ID, f.licenseType().ID), want)
+		}
+
+		_, code = NewOrderService().ListOrders(userGinContext(u.ID, tt.grant), u.ID, dto.OrderQuery{ProductID: f.product.ID, PageSize: 20})
+		check("list orders", code, view)
+		code = NewOrderService().UpdateOrder(userGinContext(u.ID, tt.grant), u.ID, dto.ModifyOrder{ID: f.order.ID, OrderNo: f.order.OrderNo, Quantity: 1})
+		check("update order", code, tt.orderEdit)
+		check("delete order", NewOrderService().DeleteOrder(userGinContext(u.ID, tt.grant), u.ID, f.newOrder().ID), tt.orderEdit)
+	}
+}
@@ -0,0 +1,629 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/deviceassignment"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicefeatureoverride"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicegroup"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/deviceheartbeat"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/devicetag"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/firmwareversion"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetypefeatures"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/lot"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/order"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productinvitation"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/registrationinvitation"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snallocator"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snblock"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snrule"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/softwareversion"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/schema"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultRecycleRetentionDays = 30  // 默认回收站保留天数
+	recyclePurgeChunk           = 500 // 每个事务清理的设备数量
+)
+
+// RecycleBinService 回收站服务
+type RecycleBinService struct{}
+
+// NewRecycleBinService 创建回收站服务实例
+func NewRecycleBinService() *RecycleBinService {
+	return &RecycleBinService{}
+}
+
+// recycleRetentionDays 回收站保留天数，未配置时使用默认值
+func recycleRetentionDays() int {
+	if cfg := resource.Conf.RecycleConfig; cfg != nil && cfg.RetentionDays > 0 {
+		return cfg.RetentionDays
+	}
+	return defaultRecycleRetentionDays
+}
+
+// newRecycleItem 构建回收站记录
+func newRecycleItem(typ string, id, productID int, name string, deletedAt *time.Time) dto.RecycleItem {
+	item := dto.RecycleItem{Type: typ, ID: id, ProductID: productID, Name: name}
+	if deletedAt != nil {
+		item.DeletedAt = *deletedAt
+		item.PurgeAt = deletedAt.AddDate(0, 0, recycleRetentionDays())
+	}
+	return item
+}
+
+// checkRecyclePermission 检查回收站权限，恢复产品和设备还需要对应的删除权限
+func checkRecyclePermission(c *gin.Context, userID, productID int, typ string, write bool) bool {
+	if !authorize(c, userID, productID, dto.PermRecycleManage) {
+		return false
+	}
+	if !write {
+		return true
+	}
+	switch typ {
+	case dto.RecycleProduct:
+		return authorize(c, userID, productID, dto.PermProductDelete)
+	case dto.RecycleDevice:
+		return authorize(c, userID, productID, dto.PermDeviceDelete)
+	}
+	return true
+}
+
+// ListRecycleBin 分页获取产品回收站中的记录，按删除时间倒序
+func (s *RecycleBinService) ListRecycleBin(c *gin.Context, userID int, param dto.RecycleBinQuery) (*dto.PageResult, resource.RspCode) {
+	// 已删除的产品没有所属产品，只有系统管理员可以查看
+	if param.Type == dto.RecycleProduct {
+		if !isSystemAdmin(c, userID) {
+			return nil, resource.ERR_NO_PERMISSION
+		}
+	} else {
+		if param.ProductID <= 0 {
+			return nil, resource.ERR_INVALID_PARAMETER
+		}
+		if !checkRecyclePermission(c, userID, param.ProductID, param.Type, false) {
+			return nil, resource.ERR_NO_PERMISSION
+		}
+	}
+
+	total, items, err := listRecycled(schema.SkipSoftDelete(c), param.Type, param.ProductID, (param.Page-1)*param.PageSize, param.PageSize)
+	if err != nil {
+		logger.Error("query recycle bin failed", zap.String("type", param.Type), zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	return &dto.PageResult{
+		Total:    int64(total),
+		Page:     param.Page,
+		PageSize: param.PageSize,
+		List:     items,
+	}, resource.CODE_SUCCESS
+}
+
+// listRecycled 按类型查询已删除的记录，ctx需跳过软删除过滤
+func listRecycled(ctx context.Context, typ string, productID, offset, limit int) (int, []dto.RecycleItem, error) {
+	var items []dto.RecycleItem
+	switch typ {
+	case dto.RecycleProduct:
+		q := dto.Client().Product.Query().Where(product.DeletedAtNotNil())
+		total, err := q.Clone().Count(ctx)
+		if err != nil {
+			return 0, nil, err
+		}
+		rows, err := q.Order(ent.Desc(product.FieldDeletedAt)).Offset(offset).Limit(limit).All(ctx)
+		for _, r := range rows {
+			items = append(items, newRecycleItem(typ, r.ID, r.ID, r.Code, r.DeletedAt))
+		}
+		return total, items, err
+	case dto.RecycleDevice:
+		q := dto.Client().Device.Query().Where(device.ProductIDEQ(productID), device.DeletedAtNotNil())
+		total, err := q.Clone().Count(ctx)
+		if err != nil {
+			return 0, nil, err
+		}
+		rows, err := q.Order(ent.Desc(device.FieldDeletedAt)).Offset(offset).Limit(limit).All(ctx)
+		for _, r := range rows {
+			items = append(items, newRecycleItem(typ, r.ID, r.ProductID, r.Sn, r.DeletedAt))
+		}
+		return total, items, err
+	case dto.RecycleLicenseType:
+		q := dto.Client().LicenseType.Query().Where(licensetype.ProductIDEQ(productID), licensetype.DeletedAtNotNil())
+		total, err := q.Clone().Count(ctx)
+		if err != nil {
+			return 0, nil, err
+		}
+		rows, err := q.Order(ent.Desc(licensetype.FieldDeletedAt)).Offset(offset).Limit(limit).All(ctx)
+		for _, r := range rows {
+			items = append(items, newRecycleItem(typ, r.ID, r.ProductID, r.LicenseType, r.DeletedAt))
+		}
+		return total, items, err
+	case dto.RecycleFeature:
+		q := dto.Client().ProductFeature.Query().Where(productfeature.ProductIDEQ(productID), productfeature.DeletedAtNotNil())
+		total, err := q.Clone().Count(ctx)
+		if err != nil {
+			return 0, nil, err
+		}
+		rows, err := q.Order(ent.Desc(productfeature.FieldDeletedAt)).Offset(offset).Limit(limit).All(ctx)
+		for _, r := range rows {
+			items = append(items, newRecycleItem(typ, r.ID, r.ProductID, r.FeatureCode, r.DeletedAt))
+		}
+		return total, items, err
+	case dto.RecycleFirmwareVersion:
+		q := dto.Client().FirmwareVersion.Query().Where(firmwareversion.ProductIDEQ(productID), firmwareversion.DeletedAtNotNil())
+		total, err := q.Clone().Count(ctx)
+		if err != nil {
+			return 0, nil, err
+		}
+		rows, err := q.Order(ent.Desc(firmwareversion.FieldDeletedAt)).Offset(offset).Limit(limit).All(ctx)
+		for _, r := range rows {
+			items = append(items, newRecycleItem(typ, r.ID, r.ProductID, r.Version, r.DeletedAt))
+		}
+		return total, items, err
+	case dto.RecycleSoftwareVersion:
+		q := dto.Client().SoftwareVersion.Query().Where(softwareversion.ProductIDEQ(productID), softwareversion.DeletedAtNotNil())
+		total, err := q.Clone().Count(ctx)
+		if err != nil {
+			return 0, nil, err
+		}
+		rows, err := q.Order(ent.Desc(softwareversion.FieldDeletedAt)).Offset(offset).Limit(limit).All(ctx)
+		for _, r := range rows {
+			items = append(items, newRecycleItem(typ, r.ID, r.ProductID, r.Version, r.DeletedAt))
+		}
+		return total, items, err
+	}
+	return 0, nil, nil
+}
+
+// findRecycled 查询回收站中的记录，返回记录、所属产品ID和审计模块
+func findRecycled(ctx context.Context, typ string, id int) (interface{}, int, dto.AuditLogModule, error) {
+	client := dto.Client()
+	switch typ {
+	case dto.RecycleProduct:
+		r, err := client.Product.Query().Where(product.IDEQ(id), product.DeletedAtNotNil()).Only(ctx)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		return r, r.ID, dto.ModuleProduct, nil
+	case dto.RecycleDevice:
+		r, err := client.Device.Query().Where(device.IDEQ(id), device.DeletedAtNotNil()).Only(ctx)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		return r, r.ProductID, dto.ModuleDevice, nil
+	case dto.RecycleLicenseType:
+		r, err := client.LicenseType.Query().Where(licensetype.IDEQ(id), licensetype.DeletedAtNotNil()).Only(ctx)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		return r, r.ProductID, dto.ModuleLicenseType, nil
+	case dto.RecycleFeature:
+		r, err := client.ProductFeature.Query().Where(productfeature.IDEQ(id), productfeature.DeletedAtNotNil()).Only(ctx)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		return r, r.ProductID, dto.ModuleFeature, nil
+	case dto.RecycleFirmwareVersion:
+		r, err := client.FirmwareVersion.Query().Where(firmwareversion.IDEQ(id), firmwareversion.DeletedAtNotNil()).Only(ctx)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		return r, r.ProductID, dto.ModuleFirmwareVersion, nil
+	case dto.RecycleSoftwareVersion:
+		r, err := client.SoftwareVersion.Query().Where(softwareversion.IDEQ(id), softwareversion.DeletedAtNotNil()).Only(ctx)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		return r, r.ProductID, dto.ModuleSoftwareVersion, nil
+	}
+	return nil, 0, "", &ent.NotFoundError{}
+}
+
+// RestoreRecycled 从回收站恢复记录，所属记录已删除或标识已被重新使用时拒绝恢复
+func (s *RecycleBinService) RestoreRecycled(c *gin.Context, userID int, param dto.RecycleRestore) resource.RspCode {
+	// 1. 查询回收站中的记录
+	record, productID, module, err := findRecycled(schema.SkipSoftDelete(c), param.Type, param.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_RECYCLE_NOT_EXIST
+		}
+		logger.Error("query recycled record failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+
+	// 2. 权限检查
+	if !checkRecyclePermission(c, userID, productID, param.Type, true) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	// 4. 冲突检查并恢复
+	if code := restoreRecord(c, tx, record); code != resource.CODE_SUCCESS {
+		_ = tx.Rollback()
+		return code
+	}
+
+	// 5. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:     userID,
+		Action:     dto.ActionRestore,
+		Module:     module,
+		ProductID:  productID,
+		DetailInfo: record,
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 6. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+
+	if param.Type == dto.RecycleDevice {
+		InvalidateProductSummary(productID)
+	}
+	return resource.CODE_SUCCESS
+}
+
+// restoreCheck 恢复前的检查，查询结果与expect不一致时返回code
+type restoreCheck struct {
+	query  func() (bool, error)
+	expect bool
+	code   resource.RspCode
+}
+
+// parentCheck 所属记录必须未删除
+func parentCheck(query func() (bool, error)) restoreCheck {
+	return restoreCheck{query: query, expect: true, code: resource.ERR_RESTORE_PARENT_DELETED}
+}
+
+// conflictCheck 唯一标识不能已被其它记录使用
+func conflictCheck(code resource.RspCode, query func() (bool, error)) restoreCheck {
+	return restoreCheck{query: query, expect: false, code: code}
+}
+
+// restoreRecord 检查所属记录和唯一标识冲突后清除删除时间，检查只针对未删除的记录
+func restoreRecord(c *gin.Context, tx *ent.Tx, record interface{}) resource.RspCode {
+	var checks []restoreCheck
+	var restore func() error
+	switch r := record.(type) {
+	case *ent.Product:
+		checks = []restoreCheck{
+			conflictCheck(resource.ERR_PRODUCT_CODE_EXIST, func() (bool, error) {
+				return tx.Product.Query().Where(product.CodeEQ(r.Code)).Exist(c)
+			}),
+			conflictCheck(resource.ERR_PRODUCT_NAME_EXIST, func() (bool, error) {
+				return tx.Product.Query().Where(product.ProductNameEQ(r.ProductName)).Exist(c)
+			}),
+		}
+		restore = func() error { return tx.Product.UpdateOneID(r.ID).ClearDeletedAt().Exec(c) }
+	case *ent.Device:
+		checks = []restoreCheck{
+			parentCheck(func() (bool, error) {
+				return tx.Product.Query().Where(product.IDEQ(r.ProductID)).Exist(c)
+			}),
+			parentCheck(func() (bool, error) {
+				if r.LicenseTypeID == 0 {
+					return true, nil
+				}
+				return tx.LicenseType.Query().Where(licensetype.IDEQ(r.LicenseTypeID)).Exist(c)
+			}),
+			conflictCheck(resource.ERR_DEVICE_SN_EXIST, func() (bool, error) {
+				// SN全局唯一，可能已被其它产品的设备使用
+				return tx.Device.Query().Where(device.SnEQ(r.Sn)).Exist(viewer.SystemContext(c))
+			}),
+		}
+		restore = func() error { return tx.Device.UpdateOneID(r.ID).ClearDeletedAt().Exec(c) }
+	case *ent.LicenseType:
+		checks = []restoreCheck{
+			parentCheck(func() (bool, error) {
+				return tx.Product.Query().Where(product.IDEQ(r.ProductID)).Exist(c)
+			}),
+			conflictCheck(resource.ERR_LICENSE_TYPE_EXIST, func() (bool, error) {
+				return tx.LicenseType.Query().Where(
+					licensetype.ProductIDEQ(r.ProductID),
+					licensetype.TypeNameEQ(r.TypeName),
+				).Exist(c)
+			}),
+			conflictCheck(resource.ERR_LICENSE_CODE_EXIST, func() (bool, error) {
+				return tx.LicenseType.Query().Where(
+					licensetype.ProductIDEQ(r.ProductID),
+					licensetype.LicenseTypeEQ(r.LicenseType),
+				).Exist(c)
+			}),
+		}
+		restore = func() error { return tx.LicenseType.UpdateOneID(r.ID).ClearDeletedAt().Exec(c) }
+	case *ent.ProductFeature:
+		checks = []restoreCheck{
+			parentCheck(func() (bool, error) {
+				return tx.Product.Query().Where(product.IDEQ(r.ProductID)).Exist(c)
+			}),
+			conflictCheck(resource.ERR_FEATURE_CODE_EXIST, func() (bool, error) {
+				return tx.ProductFeature.Query().Where(
+					productfeature.ProductIDEQ(r.ProductID),
+					productfeature.FeatureCodeEQ(r.FeatureCode),
+				).Exist(c)
+			}),
+			conflictCheck(resource.ERR_FEATURE_NAME_EXIST, func() (bool, error) {
+				return tx.ProductFeature.Query().Where(
+					productfeature.ProductIDEQ(r.ProductID),
+					productfeature.FeatureNameEQ(r.FeatureName),
+				).Exist(c)
+			}),
+		}
+		restore = func() error { return tx.ProductFeature.UpdateOneID(r.ID).ClearDeletedAt().Exec(c) }
+	case *ent.FirmwareVersion:
+		checks = []restoreCheck{
+			parentCheck(func() (bool, error) {
+				return tx.Product.Query().Where(product.IDEQ(r.ProductID)).Exist(c)
+			}),
+			conflictCheck(resource.ERR_FIRMWARE_VERSION_EXIST, func() (bool, error) {
+				return tx.FirmwareVersion.Query().Where(
+					firmwareversion.ProductIDEQ(r.ProductID),
+					firmwareversion.VersionEQ(r.Version),
+				).Exist(c)
+			}),
+		}
+		restore = func() error { return tx.FirmwareVersion.UpdateOneID(r.ID).ClearDeletedAt().Exec(c) }
+	case *ent.SoftwareVersion:
+		checks = []restoreCheck{
+			parentCheck(func() (bool, error) {
+				return tx.Product.Query().Where(product.IDEQ(r.ProductID)).Exist(c)
+			}),
+			conflictCheck(resource.ERR_SOFTWARE_VERSION_EXIST, func() (bool, error) {
+				return tx.SoftwareVersion.Query().Where(
+					softwareversion.ProductIDEQ(r.ProductID),
+					softwareversion.VersionEQ(r.Version),
+				).Exist(c)
+			}),
+		}
+		restore = func() error { return tx.SoftwareVersion.UpdateOneID(r.ID).ClearDeletedAt().Exec(c) }
+	default:
+		return resource.ERR_INVALID_PARAMETER
+	}
+
+	for _, check := range checks {
+		exist, err := check.query()
+		if err != nil {
+			logger.Error("check restore conflict failed", zap.Error(err))
+			return resource.ERR_QUERY_FAILED
+		}
+		if exist != check.expect {
+			return check.code
+		}
+	}
+	if err := restore(); err != nil {
+		logger.Error("restore recycled record failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	return resource.CODE_SUCCESS
+}
+
+// purgeInTx 在事务中执行清理
+func purgeInTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := dto.Client().Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// purgeRecords 逐条永久删除记录，单条失败不影响其它记录，失败的记录留待下次清理
+func purgeRecords(ctx context.Context, typ string, ids []int, fn func(tx *ent.Tx, id int) error) {
+	if len(ids) == 0 {
+		return
+	}
+	n := 0
+	for _, id := range ids {
+		if err := purgeInTx(ctx, func(tx *ent.Tx) error { return fn(tx, id) }); err != nil {
+			logger.Warn("purge recycled record failed", zap.String("type", typ), zap.Int("id", id), zap.Error(err))
+			continue
+		}
+		n++
+	}
+	logger.Info("purge recycle bin", zap.String("type", typ), zap.Int("deleted", n))
+}
+
+// PurgeRecycleBin 永久清理超过保留期的已删除记录，由定时任务调用
+func PurgeRecycleBin() {
+	ctx := schema.SkipSoftDelete(viewer.SystemContext(context.Background()))
+	before := time.Now().AddDate(0, 0, -recycleRetentionDays())
+
+	// 先清理子记录，最后清理产品
+	purgeRecycledDevices(ctx, before)
+
+	ids, err := dto.Client().SoftwareVersion.Query().Where(softwareversion.DeletedAtLT(before)).IDs(ctx)
+	if err != nil {
+		logger.Error("query recycled software versions failed", zap.Error(err))
+	}
+	purgeRecords(ctx, dto.RecycleSoftwareVersion, ids, func(tx *ent.Tx, id int) error {
+		return tx.SoftwareVersion.DeleteOneID(id).Exec(ctx)
+	})
+
+	ids, err = dto.Client().FirmwareVersion.Query().Where(firmwareversion.DeletedAtLT(before)).IDs(ctx)
+	if err != nil {
+		logger.Error("query recycled firmware versions failed", zap.Error(err))
+	}
+	purgeRecords(ctx, dto.RecycleFirmwareVersion, ids, func(tx *ent.Tx, id int) error {
+		return tx.FirmwareVersion.DeleteOneID(id).Exec(ctx)
+	})
+
+	ids, err = dto.Client().LicenseType.Query().Where(licensetype.DeletedAtLT(before)).IDs(ctx)
+	if err != nil {
+		logger.Error("query recycled license types failed", zap.Error(err))
+	}
+	purgeRecords(ctx, dto.RecycleLicenseType, ids, func(tx *ent.Tx, id int) error {
+		if _, err := tx.LicenseTypeFeatures.Delete().Where(licensetypefeatures.LicenseTypeIDEQ(id)).Exec(ctx); err != nil {
+			return err
+		}
+		// 订单和批次保留，只解除许可证类型关联
+		if _, err := tx.Order.Update().Where(order.LicenseTypeIDEQ(id)).ClearLicenseTypeID().Save(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.Lot.Update().Where(lot.LicenseTypeIDEQ(id)).ClearLicenseTypeID().Save(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.LicenseType.Update().Where(licensetype.ParentIDEQ(id)).ClearParentID().Save(ctx); err != nil {
+			return err
+		}
+		return tx.LicenseType.DeleteOneID(id).Exec(ctx)
+	})
+
+	ids, err = dto.Client().ProductFeature.Query().Where(productfeature.DeletedAtLT(before)).IDs(ctx)
+	if err != nil {
+		logger.Error("query recycled features failed", zap.Error(err))
+	}
+	purgeRecords(ctx, dto.RecycleFeature, ids, func(tx *ent.Tx, id int) error {
+		if _, err := tx.LicenseTypeFeatures.Delete().Where(licensetypefeatures.FeatureIDEQ(id)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.DeviceFeatureOverride.Delete().Where(devicefeatureoverride.FeatureIDEQ(id)).Exec(ctx); err != nil {
+			return err
+		}
+		if err := tx.ProductFeature.UpdateOneID(id).ClearRequires().ClearRequiredBy().ClearConflicts().Exec(ctx); err != nil {
+			return err
+		}
+		return tx.ProductFeature.DeleteOneID(id).Exec(ctx)
+	})
+
+	ids, err = dto.Client().Product.Query().Where(product.DeletedAtLT(before)).IDs(ctx)
+	if err != nil {
+		logger.Error("query recycled products failed", zap.Error(err))
+	}
+	purgeRecords(ctx, dto.RecycleProduct, ids, func(tx *ent.Tx, id int) error {
+		return purgeProduct(ctx, tx, id)
+	})
+}
+
+// purgeRecycledDevices 分批永久删除设备及其原始心跳
+func purgeRecycledDevices(ctx context.Context, before time.Time) {
+	total := 0
+	for {
+		ids, err := dto.Client().Device.Query().
+			Where(device.DeletedAtLT(before)).
+			Limit(recyclePurgeChunk).
+			IDs(ctx)
+		if err != nil {
+			logger.Error("query recycled devices failed", zap.Error(err))
+			break
+		}
+		if len(ids) == 0 {
+			break
+		}
+		err = purgeInTx(ctx, func(tx *ent.Tx) error {
+			if _, err := tx.DeviceHeartbeat.Delete().Where(deviceheartbeat.DeviceIDIn(ids...)).Exec(ctx); err != nil {
+				return err
+			}
+			if _, err := tx.DeviceAssignment.Delete().Where(deviceassignment.DeviceIDIn(ids...)).Exec(ctx); err != nil {
+				return err
+			}
+			if _, err := tx.DeviceFeatureOverride.Delete().Where(devicefeatureoverride.DeviceIDIn(ids...)).Exec(ctx); err != nil {
+				return err
+			}
+			_, err := tx.Device.Delete().Where(device.IDIn(ids...)).Exec(ctx)
+			return err
+		})
+		if err != nil {
+			logger.Error("purge recycled devices failed", zap.Error(err))
+			break
+		}
+		total += len(ids)
+	}
+	if total > 0 {
+		logger.Info("purge recycle bin", zap.String("type", dto.RecycleDevice), zap.Int("deleted", total))
+	}
+}
+
+// purgeProduct 永久删除产品及其配置数据，仍有子记录（包括未到期的已删除记录）时跳过
+func purgeProduct(ctx context.Context, tx *ent.Tx, productID int) error {
+	children := []func() (bool, error){
+		func() (bool, error) {
+			return tx.Device.Query().Where(device.ProductIDEQ(productID)).Exist(ctx)
+		},
+		func() (bool, error) {
+			return tx.LicenseType.Query().Where(licensetype.ProductIDEQ(productID)).Exist(ctx)
+		},
+		func() (bool, error) {
+			return tx.ProductFeature.Query().Where(productfeature.ProductIDEQ(productID)).Exist(ctx)
+		},
+		func() (bool, error) {
+			return tx.FirmwareVersion.Query().Where(firmwareversion.ProductIDEQ(productID)).Exist(ctx)
+		},
+		func() (bool, error) {
+			return tx.SoftwareVersion.Query().Where(softwareversion.ProductIDEQ(productID)).Exist(ctx)
+		},
+	}
+	for _, exist := range children {
+		found, err := exist()
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("product %d still has child records", productID)
+		}
+	}
+
+	if _, err := tx.SnBlock.Delete().Where(snblock.ProductIDEQ(productID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.SnAllocator.Delete().Where(snallocator.ProductIDEQ(productID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.SnRule.Delete().Where(snrule.ProductIDEQ(productID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.DeviceTag.Delete().Where(devicetag.ProductIDEQ(productID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.DeviceGroup.Delete().Where(devicegroup.ProductIDEQ(productID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.Lot.Delete().Where(lot.ProductIDEQ(productID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.Order.Delete().Where(order.ProductIDEQ(productID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.ProductManager.Delete().Where(productmanager.ProductIDEQ(productID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.ProductInvitation.Delete().Where(productinvitation.ProductIDEQ(productID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.RegistrationInvitation.Delete().Where(registrationinvitation.ProductIDEQ(productID)).Exec(ctx); err != nil {
+		return err
+	}
+	return tx.Product.DeleteOneID(productID).Exec(ctx)
+}
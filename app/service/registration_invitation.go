@@ -0,0 +1,450 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/predicate"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/registrationinvitation"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/mail"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// inviteCodePrefixLen 列表中显示的邀请码前缀长度
+const inviteCodePrefixLen = 8
+
+// registrationMode 注册模式，未配置时为开放注册
+func registrationMode() string {
+	if resource.Conf.AccountConfig != nil && resource.Conf.AccountConfig.RegistrationMode == dto.RegistrationModeInvite {
+		return dto.RegistrationModeInvite
+	}
+	return dto.RegistrationModeOpen
+}
+
+// newInviteCode 生成随机邀请码
+func newInviteCode() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashInviteCode 邀请码只保存哈希，数据库泄露时无法直接使用
+func hashInviteCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// registrationInvitationStatus 邀请码状态，依次判断撤销、用完和过期
+func registrationInvitationStatus(inv *ent.RegistrationInvitation, now time.Time) string {
+	switch {
+	case inv.RevokedAt != nil:
+		return dto.RegistrationInvitationRevoked
+	case inv.UsedCount >= inv.MaxUses:
+		return dto.RegistrationInvitationUsedUp
+	case !now.Before(inv.ExpiresAt):
+		return dto.InvitationStatusExpired
+	default:
+		return dto.RegistrationInvitationActive
+	}
+}
+
+// usableRegistrationInvitation 可以注册的邀请码：未撤销、未过期且未用完
+func usableRegistrationInvitation(now time.Time) []predicate.RegistrationInvitation {
+	return []predicate.RegistrationInvitation{
+		registrationinvitation.RevokedAtIsNil(),
+		registrationinvitation.ExpiresAtGT(now),
+		func(s *sql.Selector) {
+			s.Where(sql.ColumnsLT(s.C(registrationinvitation.FieldUsedCount), s.C(registrationinvitation.FieldMaxUses)))
+		},
+	}
+}
+
+func toRegistrationInvitationInfo(inv *ent.RegistrationInvitation, now time.Time) dto.RegistrationInvitationInfo {
+	info := dto.RegistrationInvitationInfo{
+		ID:         inv.ID,
+		CodePrefix: inv.CodePrefix,
+		MaxUses:    inv.MaxUses,
+		UsedCount:  inv.UsedCount,
+		Remark:     inv.Remark,
+		Status:     registrationInvitationStatus(inv, now),
+		CreatedBy:  inv.CreatedBy,
+		ExpiresAt:  inv.ExpiresAt,
+		RevokedAt:  inv.RevokedAt,
+		CreatedAt:  inv.CreatedAt,
+	}
+	if inv.Email != nil {
+		info.Email = *inv.Email
+	}
+	if inv.ProductID != nil {
+		info.ProductID = *inv.ProductID
+	}
+	if inv.AccessRole != nil {
+		info.AccessRole = inv.AccessRole.String()
+	}
+	if p := inv.Edges.Product; p != nil {
+		info.ProductCode = p.Code
+		info.ProductName = p.ProductName
+	}
+	if u := inv.Edges.Creator; u != nil {
+		info.CreatorEmail = u.Email
+	}
+	return info
+}
+
+// canManageRegistrationInvitation 不指定产品的邀请码只有系统管理员可以管理，
+// 指定产品的邀请码需要该产品的管理员管理权限
+func canManageRegistrationInvitation(ctx context.Context, userID int, productID *int) bool {
+	if productID == nil {
+		return isSystemAdmin(ctx, userID)
+	}
+	return authorize(ctx, userID, *productID, dto.PermManagerManage)
+}
+
+// registrationInvitationAuditProduct 审计日志记录到邀请码所属产品
+func registrationInvitationAuditProduct(inv *ent.RegistrationInvitation) int {
+	if inv.ProductID == nil {
+		return 0
+	}
+	return *inv.ProductID
+}
+
+// registrationInvitationMail 绑定邮箱的邀请码发送给被邀请人
+func registrationInvitationMail(email, code string, expiresAt time.Time) mail.Message {
+	base := strings.TrimRight(accountConfig().LinkBaseURL, "/")
+	return mail.Message{
+		To:      []string{email},
+		Subject: "注册邀请 / Invitation to register",
+		Body: fmt.Sprintf("您收到了注册邀请，请在%s之前打开以下链接完成注册。\n"+
+			"You have been invited to register. Open the link below before %s to create your account.\n\n%s/register?code=%s\n",
+			expiresAt.Format(time.DateTime), expiresAt.Format(time.DateTime), base, code),
+	}
+}
+
+// RegistrationInvitationService 注册邀请码管理
+type RegistrationInvitationService struct{}
+
+// NewRegistrationInvitationService 创建注册邀请码服务实例
+func NewRegistrationInvitationService() *RegistrationInvitationService {
+	return &RegistrationInvitationService{}
+}
+
+// CreateInvitation 创建注册邀请码，邀请码只在返回结果中出现一次；绑定邮箱时同时发送到该邮箱
+func (s *RegistrationInvitationService) CreateInvitation(c *gin.Context, userID int, param dto.CreateRegistrationInvitation) (*dto.RegistrationInvitationInfo, resource.RspCode) {
+	var productID *int
+	var role *registrationinvitation.AccessRole
+	if param.ProductID > 0 {
+		productID = &param.ProductID
+		r := registrationinvitation.AccessRole(managerInvitationRole(param.AccessRole, ""))
+		role = &r
+	} else if param.AccessRole != "" {
+		return nil, resource.ERR_INVALID_PARAMETER
+	}
+	if !canManageRegistrationInvitation(c, userID, productID) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+	var email *string
+	if param.Email != "" {
+		email = &param.Email
+	}
+	if param.MaxUses <= 0 {
+		param.MaxUses = 1
+	}
+
+	code, err := newInviteCode()
+	if err != nil {
+		logger.Error("generate invite code failed", zap.Error(err))
+		return nil, resource.ERR_OPERATION_FAILED
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	now := time.Now()
+	inv, err := tx.RegistrationInvitation.Create().
+		SetCodeHash(hashInviteCode(code)).
+		SetCodePrefix(code[:inviteCodePrefixLen]).
+		SetNillableEmail(email).
+		SetMaxUses(param.MaxUses).
+		SetNillableProductID(productID).
+		SetNillableAccessRole(role).
+		SetRemark(param.Remark).
+		SetCreatedBy(userID).
+		SetExpiresAt(invitationExpiresAt(now, param.ExpireDays)).
+		Save(c)
+	if err != nil {
+		logger.Error("create registration invitation failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionCreate,
+		Module:    dto.ModuleAuth,
+		ProductID: registrationInvitationAuditProduct(inv),
+		DetailInfo: map[string]interface{}{
+			"operation":     "create_registration_invitation",
+			"invitation_id": inv.ID,
+			"code_prefix":   inv.CodePrefix,
+			"email":         email,
+			"max_uses":      inv.MaxUses,
+			"access_role":   role,
+			"expires_at":    inv.ExpiresAt,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	// 邮件发送失败不影响创建，创建人仍可将返回的邀请码转交被邀请人
+	if email != nil {
+		if err := mail.Send(c, registrationInvitationMail(*email, code, inv.ExpiresAt)); err != nil {
+			logger.Error("send registration invitation failed", zap.Error(err), zap.Int("invitation_id", inv.ID))
+		}
+	}
+
+	info := toRegistrationInvitationInfo(inv, now)
+	info.Code = code
+	return &info, resource.CODE_SUCCESS
+}
+
+// ListInvitations 注册邀请码列表，系统管理员可查看全部，产品管理员需指定有管理员管理权限的产品
+func (s *RegistrationInvitationService) ListInvitations(c *gin.Context, userID int, query dto.RegistrationInvitationQuery) ([]dto.RegistrationInvitationInfo, resource.RspCode) {
+	var productID *int
+	if query.ProductID > 0 {
+		productID = &query.ProductID
+	}
+	if !canManageRegistrationInvitation(c, userID, productID) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	now := time.Now()
+	q := dto.Client().RegistrationInvitation.Query().
+		WithProduct().
+		WithCreator().
+		Order(ent.Desc(registrationinvitation.FieldID))
+	if productID != nil {
+		q.Where(registrationinvitation.ProductIDEQ(*productID))
+	}
+	switch query.Status {
+	case "":
+	case dto.RegistrationInvitationActive:
+		q.Where(usableRegistrationInvitation(now)...)
+	case dto.RegistrationInvitationRevoked:
+		q.Where(registrationinvitation.RevokedAtNotNil())
+	case dto.RegistrationInvitationUsedUp:
+		q.Where(registrationinvitation.RevokedAtIsNil(), func(s *sql.Selector) {
+			s.Where(sql.ColumnsGTE(s.C(registrationinvitation.FieldUsedCount), s.C(registrationinvitation.FieldMaxUses)))
+		})
+	case dto.InvitationStatusExpired:
+		q.Where(registrationinvitation.RevokedAtIsNil(), registrationinvitation.ExpiresAtLTE(now), func(s *sql.Selector) {
+			s.Where(sql.ColumnsLT(s.C(registrationinvitation.FieldUsedCount), s.C(registrationinvitation.FieldMaxUses)))
+		})
+	}
+	invitations, err := q.All(c)
+	if err != nil {
+		logger.Error("query registration invitations failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	result := make([]dto.RegistrationInvitationInfo, 0, len(invitations))
+	for _, inv := range invitations {
+		result = append(result, toRegistrationInvitationInfo(inv, now))
+	}
+	return result, resource.CODE_SUCCESS
+}
+
+// RevokeInvitation 撤销注册邀请码，已注册的用户不受影响
+func (s *RegistrationInvitationService) RevokeInvitation(c *gin.Context, userID, id int) resource.RspCode {
+	inv, err := dto.Client().RegistrationInvitation.Get(c, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_INVITATION_NOT_EXIST
+		}
+		logger.Error("query registration invitation failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if !canManageRegistrationInvitation(c, userID, inv.ProductID) {
+		return resource.ERR_NO_PERMISSION
+	}
+	if inv.RevokedAt != nil {
+		return resource.ERR_INVITATION_NOT_PENDING
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	n, err := tx.RegistrationInvitation.Update().
+		Where(registrationinvitation.IDEQ(inv.ID), registrationinvitation.RevokedAtIsNil()).
+		SetRevokedBy(userID).
+		SetRevokedAt(time.Now()).
+		Save(c)
+	if err != nil {
+		logger.Error("revoke registration invitation failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_MOD_FAILED
+	}
+	if n == 0 {
+		_ = tx.Rollback()
+		return resource.ERR_INVITATION_NOT_PENDING
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionDelete,
+		Module:    dto.ModuleAuth,
+		ProductID: registrationInvitationAuditProduct(inv),
+		DetailInfo: map[string]interface{}{
+			"operation":     "revoke_registration_invitation",
+			"invitation_id": inv.ID,
+			"code_prefix":   inv.CodePrefix,
+			"used_count":    inv.UsedCount,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	return resource.CODE_SUCCESS
+}
+
+// useRegistrationInvitation 在注册事务中校验邀请码并增加使用次数，并发注册时由条件更新保证不超过使用次数
+func useRegistrationInvitation(ctx context.Context, tx *ent.Tx, code, email string) (*ent.RegistrationInvitation, resource.RspCode) {
+	inv, err := tx.RegistrationInvitation.Query().
+		Where(registrationinvitation.CodeHashEQ(hashInviteCode(code))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, resource.ERR_INVITE_CODE_INVALID
+		}
+		logger.Error("query registration invitation failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if inv.Email != nil && !strings.EqualFold(*inv.Email, email) {
+		return nil, resource.ERR_INVITE_EMAIL_MISMATCH
+	}
+	switch registrationInvitationStatus(inv, time.Now()) {
+	case dto.RegistrationInvitationRevoked:
+		return nil, resource.ERR_INVITE_CODE_INVALID
+	case dto.RegistrationInvitationUsedUp:
+		return nil, resource.ERR_INVITE_CODE_USED_UP
+	case dto.InvitationStatusExpired:
+		return nil, resource.ERR_INVITATION_EXPIRED
+	}
+
+	n, err := tx.RegistrationInvitation.Update().
+		Where(registrationinvitation.IDEQ(inv.ID)).
+		Where(usableRegistrationInvitation(time.Now())...).
+		AddUsedCount(1).
+		Save(ctx)
+	if err != nil {
+		logger.Error("update registration invitation failed", zap.Error(err))
+		return nil, resource.ERR_MOD_FAILED
+	}
+	if n == 0 {
+		return nil, resource.ERR_INVITE_CODE_USED_UP
+	}
+	return inv, resource.CODE_SUCCESS
+}
+
+// joinInvitedProduct 在注册事务中以邀请码指定的角色加入产品，产品已删除时跳过
+func joinInvitedProduct(ctx context.Context, tx *ent.Tx, inv *ent.RegistrationInvitation, userID int) resource.RspCode {
+	if inv.ProductID == nil {
+		return resource.CODE_SUCCESS
+	}
+	exist, err := tx.Product.Query().Where(product.IDEQ(*inv.ProductID)).Exist(ctx)
+	if err != nil {
+		logger.Error("query product failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if !exist {
+		logger.Warn("invited product no longer exists", zap.Int("invitation_id", inv.ID), zap.Int("product_id", *inv.ProductID))
+		return resource.CODE_SUCCESS
+	}
+
+	role := productmanager.AccessRoleViewer
+	if inv.AccessRole != nil {
+		role = productmanager.AccessRole(*inv.AccessRole)
+	}
+	err = tx.ProductManager.Create().
+		SetUserID(userID).
+		SetProductID(*inv.ProductID).
+		SetRole(productmanager.RoleAssistant).
+		SetAccessRole(role).
+		SetPermissions(legacyPermissions(role)).
+		SetRemark(inv.Remark).
+		Exec(ctx)
+	if err != nil {
+		logger.Error("add manager failed", zap.Error(err))
+		return resource.ERR_ADD_FAILED
+	}
+	if err := grantViewerProduct(ctx, *inv.ProductID); err != nil {
+		logger.Error("grant viewer product failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+
+	err = CreateAuditLog(ctx, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionUpdate,
+		Module:    dto.ModuleProduct,
+		ProductID: *inv.ProductID,
+		DetailInfo: map[string]interface{}{
+			"operation":     "join_by_registration_invitation",
+			"invitation_id": inv.ID,
+			"access_role":   role,
+			"created_by":    inv.CreatedBy,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		return resource.ERR_ADD_LOG_FAILED
+	}
+	return resource.CODE_SUCCESS
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+)
+
+func TestRegistrationInvitationStatus(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	revokedAt := now.Add(-time.Hour)
+	tests := []struct {
+		inv  ent.RegistrationInvitation
+		want string
+	}{
+		{ent.RegistrationInvitation{MaxUses: 1, ExpiresAt: now.Add(time.Hour)}, dto.RegistrationInvitationActive},
+		{ent.RegistrationInvitation{MaxUses: 3, UsedCount: 2, ExpiresAt: now.Add(time.Hour)}, dto.RegistrationInvitationActive},
+		{ent.RegistrationInvitation{MaxUses: 1, ExpiresAt: now}, dto.InvitationStatusExpired},
+		// 用完优先于过期，撤销优先于其他状态
+		{ent.RegistrationInvitation{MaxUses: 2, UsedCount: 2, ExpiresAt: now.Add(-time.Hour)}, dto.RegistrationInvitationUsedUp},
+		{ent.RegistrationInvitation{MaxUses: 1, UsedCount: 1, ExpiresAt: now.Add(time.Hour), RevokedAt: &revokedAt}, dto.RegistrationInvitationRevoked},
+	}
+	for i, tt := range tests {
+		if got := registrationInvitationStatus(&tt.inv, now); got != tt.want {
+			t.Errorf("case %d: status = %s, want %s", i, got, tt.want)
+		}
+	}
+}
+
+func TestInviteCode(t *testing.T) {
+	a, err := newInviteCode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := newInviteCode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a == b || len(a) < inviteCodePrefixLen {
+		t.Fatalf("codes = %q, %q", a, b)
+	}
+	if hashInviteCode(a) != hashInviteCode(a) || hashInviteCode(a) == hashInviteCode(b) {
+		t.Error("hash is not stable or collides")
+	}
+	if len(hashInviteCode(a)) != 64 {
+		t.Errorf("hash length = %d", len(hashInviteCode(a)))
+	}
+}
+
+func TestRegistrationMode(t *testing.T) {
+	old := resource.Conf.AccountConfig
+	defer func() { resource.Conf.AccountConfig = old }()
+
+	resource.Conf.AccountConfig = nil
+	if got := registrationMode(); got != dto.RegistrationModeOpen {
+		t.Errorf("default mode = %s", got)
+	}
+	for mode, want := range map[string]string{
+		"":                         dto.RegistrationModeOpen,
+		"unknown":                  dto.RegistrationModeOpen,
+		dto.RegistrationModeInvite: dto.RegistrationModeInvite,
+	} {
+		resource.Conf.AccountConfig = &resource.AccountConfig{RegistrationMode: mode}
+		if got := registrationMode(); got != want {
+			t.Errorf("mode %q = %s, want %s", mode, got, want)
+		}
+	}
+}
@@ -0,0 +1,646 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/licensetype"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snallocator"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snblock"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/validate"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// 预创建设备时每批插入的数量，避免单条SQL占位符过多
+const snBlockInsertChunk = 500
+
+// SN段导出格式
+const (
+	SnExportCSV = "csv"
+	SnExportZPL = "zpl"
+)
+
+// SnAllocatorService SN分配服务
+type SnAllocatorService struct{}
+
+// NewSnAllocatorService 创建SN分配服务实例
+func NewSnAllocatorService() *SnAllocatorService {
+	return &SnAllocatorService{}
+}
+
+// dateCodeTokens 日期码格式中支持的占位符，长的在前
+var dateCodeTokens = []string{"YYYY", "YY", "MM", "DD", "WW"}
+
+// validDateFormat 日期码格式只能由占位符组成
+func validDateFormat(format string) bool {
+	for _, token := range dateCodeTokens {
+		format = strings.ReplaceAll(format, token, "")
+	}
+	return format == ""
+}
+
+// formatDateCode 按格式生成日期码
+func formatDateCode(format string, t time.Time) string {
+	if format == "" {
+		return ""
+	}
+	_, week := t.ISOWeek()
+	return strings.NewReplacer(
+		"YYYY", fmt.Sprintf("%04d", t.Year()),
+		"YY", fmt.Sprintf("%02d", t.Year()%100),
+		"MM", fmt.Sprintf("%02d", int(t.Month())),
+		"DD", fmt.Sprintf("%02d", t.Day()),
+		"WW", fmt.Sprintf("%02d", week),
+	).Replace(format)
+}
+
+// buildSN 拼接单个SN，校验位按去掉前缀后的部分计算
+func buildSN(prefix, dateCode string, counter int64, width int, algorithm string) (string, error) {
+	body := dateCode + fmt.Sprintf("%0*d", width, counter)
+	if algorithm != "" && algorithm != validate.CheckNone {
+		check, err := validate.CheckDigit(algorithm, body)
+		if err != nil {
+			return "", err
+		}
+		body += string(check)
+	}
+	return prefix + body, nil
+}
+
+// blockSNs 还原分配记录中的全部SN
+func blockSNs(b *ent.SnBlock) ([]string, error) {
+	sns := make([]string, 0, b.Count)
+	for i := 0; i < b.Count; i++ {
+		sn, err := buildSN(b.Prefix, b.DateCode, b.StartCounter+int64(i), b.CounterWidth, b.CheckAlgorithm)
+		if err != nil {
+			return nil, err
+		}
+		sns = append(sns, sn)
+	}
+	return sns, nil
+}
+
+// counterLimit 计数器位数能表示的最大值
+func counterLimit(width int) int64 {
+	limit := int64(1)
+	for i := 0; i < width; i++ {
+		limit *= 10
+	}
+	return limit - 1
+}
+
+// ListSnAllocators 获取产品下的SN分配器
+func (s *SnAllocatorService) ListSnAllocators(c *gin.Context, userID, productID int) ([]*ent.SnAllocator, resource.RspCode) {
+	// 权限检查
+	if !authorize(c, userID, productID, dto.PermProductView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	allocators, err := dto.Client().SnAllocator.Query().
+		Where(snallocator.ProductIDEQ(productID)).
+		Order(ent.Asc(snallocator.FieldName)).
+		All(c)
+	if err != nil {
+		logger.Error("query sn allocators failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	return allocators, resource.CODE_SUCCESS
+}
+
+// AddSnAllocator 新增SN分配器
+func (s *SnAllocatorService) AddSnAllocator(c *gin.Context, userID int, param dto.AddSnAllocator) resource.RspCode {
+	// 1. 检查用户权限
+	if !authorize(c, userID, param.ProductID, dto.PermProductEdit) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 校验参数
+	if !validDateFormat(param.DateFormat) {
+		return resource.ERR_INVALID_PARAMETER
+	}
+	if param.CheckAlgorithm == "" {
+		param.CheckAlgorithm = snallocator.DefaultCheckAlgorithm.String()
+	}
+	if param.StartCounter <= 0 {
+		param.StartCounter = snallocator.DefaultNextCounter
+	}
+	if param.StartCounter > counterLimit(param.CounterWidth) {
+		return resource.ERR_SN_COUNTER_EXHAUSTED
+	}
+	if code := checkAllocatorLicenseType(c, param.ProductID, param.DefaultLicenseTypeID); code != resource.CODE_SUCCESS {
+		return code
+	}
+
+	exist, err := dto.Client().SnAllocator.Query().
+		Where(
+			snallocator.ProductIDEQ(param.ProductID),
+			snallocator.NameEQ(param.Name),
+		).Exist(c)
+	if err != nil {
+		logger.Error("check sn allocator name failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if exist {
+		return resource.ERR_SN_ALLOCATOR_EXIST
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_ADD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	create := tx.SnAllocator.Create().
+		SetProductID(param.ProductID).
+		SetName(param.Name).
+		SetPrefix(param.Prefix).
+		SetDateFormat(param.DateFormat).
+		SetCounterWidth(param.CounterWidth).
+		SetCheckAlgorithm(snallocator.CheckAlgorithm(param.CheckAlgorithm)).
+		SetNextCounter(param.StartCounter).
+		SetCreatedBy(userID)
+	if param.DefaultLicenseTypeID > 0 {
+		create.SetDefaultLicenseTypeID(param.DefaultLicenseTypeID)
+	}
+	allocator, err := create.Save(c)
+	if err != nil {
+		logger.Error("create sn allocator failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_FAILED
+	}
+
+	// 4. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:     userID,
+		Action:     dto.ActionCreate,
+		Module:     dto.ModuleSnAllocator,
+		ProductID:  param.ProductID,
+		DetailInfo: allocator,
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 5. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_ADD_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// ModifySnAllocator 修改SN分配器，计数器只能调大，已分配的SN不受影响
+func (s *SnAllocatorService) ModifySnAllocator(c *gin.Context, userID int, param dto.ModifySnAllocator) resource.RspCode {
+	allocator, err := dto.Client().SnAllocator.Get(c, param.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.ERR_SN_ALLOCATOR_NOT_EXIST
+		}
+		logger.Error("query sn allocator failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+
+	// 1. 检查用户权限
+	if !authorize(c, userID, allocator.ProductID, dto.PermProductEdit) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 校验参数
+	if !validDateFormat(param.DateFormat) {
+		return resource.ERR_INVALID_PARAMETER
+	}
+	if param.CheckAlgorithm == "" {
+		param.CheckAlgorithm = snallocator.DefaultCheckAlgorithm.String()
+	}
+	if code := checkAllocatorLicenseType(c, allocator.ProductID, param.DefaultLicenseTypeID); code != resource.CODE_SUCCESS {
+		return code
+	}
+	if param.Name != "" && param.Name != allocator.Name {
+		exist, err := dto.Client().SnAllocator.Query().
+			Where(
+				snallocator.ProductIDEQ(allocator.ProductID),
+				snallocator.NameEQ(param.Name),
+			).Exist(c)
+		if err != nil {
+			logger.Error("check sn allocator name failed", zap.Error(err))
+			return resource.ERR_QUERY_FAILED
+		}
+		if exist {
+			return resource.ERR_SN_ALLOCATOR_EXIST
+		}
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	update := tx.SnAllocator.UpdateOneID(allocator.ID).
+		SetPrefix(param.Prefix).
+		SetDateFormat(param.DateFormat).
+		SetCounterWidth(param.CounterWidth).
+		SetCheckAlgorithm(snallocator.CheckAlgorithm(param.CheckAlgorithm))
+	if param.Name != "" {
+		update.SetName(param.Name)
+	}
+	if param.DefaultLicenseTypeID > 0 {
+		update.SetDefaultLicenseTypeID(param.DefaultLicenseTypeID)
+	} else {
+		update.ClearDefaultLicenseTypeID()
+	}
+	if param.NextCounter > 0 {
+		// 只允许跳过计数器，防止重复分配；与并发分配竞争时以数据库中的值为准
+		update.Where(snallocator.NextCounterLTE(param.NextCounter)).
+			SetNextCounter(param.NextCounter)
+	}
+	newAllocator, err := update.Save(c)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			return resource.ERR_INVALID_PARAMETER
+		}
+		logger.Error("update sn allocator failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	if newAllocator.NextCounter > counterLimit(newAllocator.CounterWidth)+1 {
+		_ = tx.Rollback()
+		return resource.ERR_SN_COUNTER_EXHAUSTED
+	}
+
+	// 4. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionUpdate,
+		Module:    dto.ModuleSnAllocator,
+		ProductID: allocator.ProductID,
+		DetailInfo: map[string]interface{}{
+			"old_allocator": allocator,
+			"new_allocator": newAllocator,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 5. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// checkAllocatorLicenseType 检查许可证类型属于该产品，0表示不指定
+func checkAllocatorLicenseType(c *gin.Context, productID, licenseTypeID int) resource.RspCode {
+	if licenseTypeID <= 0 {
+		return resource.CODE_SUCCESS
+	}
+	exist, err := dto.Client().LicenseType.Query().
+		Where(
+			licensetype.IDEQ(licenseTypeID),
+			licensetype.ProductIDEQ(productID),
+		).Exist(c)
+	if err != nil {
+		logger.Error("check license type failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if !exist {
+		return resource.ERR_LICENSE_TYPE_NOT_EXIST
+	}
+	return resource.CODE_SUCCESS
+}
+
+// AllocateSnBlock 原子地分配一段连续SN，可选预创建设备
+// 计数器通过 UPDATE next_counter = next_counter + N 在事务内推进，行锁保证多实例下不会重复分配
+func (s *SnAllocatorService) AllocateSnBlock(c *gin.Context, userID int, param dto.AllocateSnBlock) (*dto.SnBlockInfo, resource.RspCode) {
+	allocator, err := dto.Client().SnAllocator.Get(c, param.AllocatorID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, resource.ERR_SN_ALLOCATOR_NOT_EXIST
+		}
+		logger.Error("query sn allocator failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	// 1. 检查用户权限
+	if !authorize(c, userID, allocator.ProductID, dto.PermSNAllocate) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 确定预创建设备的许可证类型
+	licenseTypeID := 0
+	if param.CreateDevices {
+		licenseTypeID = param.LicenseTypeID
+		if licenseTypeID == 0 {
+			licenseTypeID = allocator.DefaultLicenseTypeID
+		}
+		if licenseTypeID == 0 {
+			return nil, resource.ERR_LICENSE_TYPE_NOT_EXIST
+		}
+		if code := checkAllocatorLicenseType(c, allocator.ProductID, licenseTypeID); code != resource.CODE_SUCCESS {
+			return nil, code
+		}
+	}
+
+	// 3. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	// 4. 推进计数器，拿到行锁后读取的值即本次分配的终点
+	locked, err := tx.SnAllocator.UpdateOneID(allocator.ID).
+		AddNextCounter(int64(param.Count)).
+		Save(c)
+	if err != nil {
+		logger.Error("reserve sn counter failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_FAILED
+	}
+	startCounter := locked.NextCounter - int64(param.Count)
+	if locked.NextCounter-1 > counterLimit(locked.CounterWidth) {
+		_ = tx.Rollback()
+		return nil, resource.ERR_SN_COUNTER_EXHAUSTED
+	}
+
+	block := &ent.SnBlock{
+		Prefix:         locked.Prefix,
+		DateCode:       formatDateCode(locked.DateFormat, time.Now()),
+		CounterWidth:   locked.CounterWidth,
+		CheckAlgorithm: locked.CheckAlgorithm.String(),
+		StartCounter:   startCounter,
+		Count:          param.Count,
+	}
+	sns, err := blockSNs(block)
+	if err != nil {
+		logger.Error("build sn failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_SN_RULE_INVALID
+	}
+
+	// 5. 生成的SN必须符合产品的序列号规则
+	invalidSNs, err := checkSNs(c, locked.ProductID, sns)
+	if err != nil {
+		logger.Error("check sn rule failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if len(invalidSNs) > 0 {
+		_ = tx.Rollback()
+		return nil, resource.ERR_DEVICE_SN_INVALID
+	}
+
+	// 6. 预创建设备
+	if param.CreateDevices {
+		now := time.Now()
+		for i := 0; i < len(sns); i += snBlockInsertChunk {
+			end := i + snBlockInsertChunk
+			if end > len(sns) {
+				end = len(sns)
+			}
+			chunk := sns[i:end]
+
+			// SN全局唯一，需要检查所有产品的设备
+			exist, err := tx.Device.Query().Where(device.SnIn(chunk...)).Exist(viewer.SystemContext(c))
+			if err != nil {
+				logger.Error("check device sn failed", zap.Error(err))
+				_ = tx.Rollback()
+				return nil, resource.ERR_QUERY_FAILED
+			}
+			if exist {
+				_ = tx.Rollback()
+				return nil, resource.ERR_DEVICE_SN_EXIST
+			}
+
+			bulk := make([]*ent.DeviceCreate, len(chunk))
+			for j, sn := range chunk {
+				bulk[j] = tx.Device.Create().
+					SetSn(sn).
+					SetProductID(locked.ProductID).
+					SetLicenseTypeID(licenseTypeID).
+					SetRemark(param.Remark).
+					SetCreatedAt(now).
+					SetCreatedBy(userID).
+					SetUpdatedAt(now).
+					SetUpdatedBy(userID)
+			}
+			if err := tx.Device.CreateBulk(bulk...).Exec(c); err != nil {
+				logger.Error("create devices in bulk failed", zap.Error(err))
+				_ = tx.Rollback()
+				return nil, resource.ERR_ADD_FAILED
+			}
+		}
+	}
+
+	// 7. 记录分配台账
+	create := tx.SnBlock.Create().
+		SetAllocatorID(locked.ID).
+		SetProductID(locked.ProductID).
+		SetPrefix(block.Prefix).
+		SetDateCode(block.DateCode).
+		SetCounterWidth(block.CounterWidth).
+		SetCheckAlgorithm(block.CheckAlgorithm).
+		SetStartCounter(block.StartCounter).
+		SetCount(block.Count).
+		SetFirstSn(sns[0]).
+		SetLastSn(sns[len(sns)-1]).
+		SetDevicesCreated(param.CreateDevices).
+		SetRemark(param.Remark).
+		SetRequestedBy(userID)
+	if licenseTypeID > 0 {
+		create.SetLicenseTypeID(licenseTypeID)
+	}
+	saved, err := create.Save(c)
+	if err != nil {
+		logger.Error("create sn block failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	// 8. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    dto.ActionCreate,
+		Module:    dto.ModuleSnAllocator,
+		ProductID: locked.ProductID,
+		DetailInfo: map[string]interface{}{
+			"block":     saved,
+			"operation": "allocate_sn_block",
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return nil, resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 9. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return nil, resource.ERR_ADD_FAILED
+	}
+
+	InvalidateProductSummary(allocator.ProductID)
+	info := toSnBlockInfo(saved)
+	return &info, resource.CODE_SUCCESS
+}
+
+// toSnBlockInfo 转换为台账DTO
+func toSnBlockInfo(b *ent.SnBlock) dto.SnBlockInfo {
+	info := dto.SnBlockInfo{
+		ID:             b.ID,
+		AllocatorID:    b.AllocatorID,
+		ProductID:      b.ProductID,
+		StartCounter:   b.StartCounter,
+		Count:          b.Count,
+		FirstSN:        b.FirstSn,
+		LastSN:         b.LastSn,
+		DevicesCreated: b.DevicesCreated,
+		LicenseTypeID:  b.LicenseTypeID,
+		Remark:         b.Remark,
+		RequestedBy:    b.RequestedBy,
+		CreatedAt:      b.CreatedAt,
+	}
+	if b.Edges.Requester != nil {
+		info.RequestedByEmail = b.Edges.Requester.Email
+	}
+	return info
+}
+
+// ListSnBlocks 查询SN分配台账
+func (s *SnAllocatorService) ListSnBlocks(c *gin.Context, userID int, query dto.SnBlockQuery) (*dto.PageResult, resource.RspCode) {
+	// 权限检查
+	if !authorize(c, userID, query.ProductID, dto.PermProductView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	q := dto.Client().SnBlock.Query().
+		Where(snblock.ProductIDEQ(query.ProductID))
+	if query.AllocatorID > 0 {
+		q = q.Where(snblock.AllocatorIDEQ(query.AllocatorID))
+	}
+
+	total, err := q.Count(c)
+	if err != nil {
+		logger.Error("count sn blocks failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	if query.Page < 1 {
+		query.Page = 1
+	}
+	if query.PageSize < 1 {
+		query.PageSize = 10
+	}
+
+	blocks, err := q.
+		WithRequester().
+		Order(ent.Desc(snblock.FieldCreatedAt)).
+		Limit(query.PageSize).
+		Offset((query.Page - 1) * query.PageSize).
+		All(c)
+	if err != nil {
+		logger.Error("query sn blocks failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	list := make([]dto.SnBlockInfo, 0, len(blocks))
+	for _, b := range blocks {
+		list = append(list, toSnBlockInfo(b))
+	}
+
+	return &dto.PageResult{
+		Total:    int64(total),
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		List:     list,
+	}, resource.CODE_SUCCESS
+}
+
+// ExportSnBlock 导出分配记录中的SN，支持csv和zpl标签文件
+func (s *SnAllocatorService) ExportSnBlock(c *gin.Context, userID, blockID int, format string) ([]byte, string, resource.RspCode) {
+	block, err := dto.Client().SnBlock.Get(c, blockID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, "", resource.ERR_SN_BLOCK_NOT_EXIST
+		}
+		logger.Error("query sn block failed", zap.Error(err))
+		return nil, "", resource.ERR_QUERY_FAILED
+	}
+
+	// 权限检查
+	if !authorize(c, userID, block.ProductID, dto.PermProductView) {
+		return nil, "", resource.ERR_NO_PERMISSION
+	}
+
+	sns, err := blockSNs(block)
+	if err != nil {
+		logger.Error("build sn failed", zap.Error(err))
+		return nil, "", resource.ERR_OPERATION_FAILED
+	}
+
+	var buf bytes.Buffer
+	filename := "sn_block_" + strconv.Itoa(block.ID)
+	switch format {
+	case SnExportZPL:
+		// 每个SN一张标签：Code128条码 + 可读文本
+		for _, sn := range sns {
+			fmt.Fprintf(&buf, "^XA\n^FO40,30^BCN,80,Y,N,N^FD%s^FS\n^XZ\n", sn)
+		}
+		filename += ".zpl"
+	default:
+		w := csv.NewWriter(&buf)
+		_ = w.Write([]string{"sn"})
+		for _, sn := range sns {
+			_ = w.Write([]string{sn})
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			logger.Error("write csv failed", zap.Error(err))
+			return nil, "", resource.ERR_OPERATION_FAILED
+		}
+		filename += ".csv"
+	}
+
+	return buf.Bytes(), filename, resource.CODE_SUCCESS
+}
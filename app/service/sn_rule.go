@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/snrule"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/validate"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// SnRuleService 序列号规则服务
+type SnRuleService struct{}
+
+// NewSnRuleService 创建序列号规则服务实例
+func NewSnRuleService() *SnRuleService {
+	return &SnRuleService{}
+}
+
+// snChecker 编译后的序列号规则，nil表示产品未配置规则
+type snChecker struct {
+	rule *ent.SnRule
+	re   *regexp.Regexp
+}
+
+// newSnChecker 编译序列号规则
+func newSnChecker(rule *ent.SnRule) (*snChecker, error) {
+	sc := &snChecker{rule: rule}
+	if rule.Pattern != "" {
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		sc.re = re
+	}
+	return sc, nil
+}
+
+// check 校验单个SN，合规返回空字符串，否则返回失败原因
+func (sc *snChecker) check(sn string) string {
+	if sc == nil {
+		return ""
+	}
+	r := sc.rule
+	if (r.MinLength > 0 && len(sn) < r.MinLength) || (r.MaxLength > 0 && len(sn) > r.MaxLength) {
+		return dto.SNReasonLength
+	}
+	if !strings.HasPrefix(sn, r.Prefix) {
+		return dto.SNReasonPrefix
+	}
+	if sc.re != nil && !sc.re.MatchString(sn) {
+		return dto.SNReasonPattern
+	}
+	if !validate.VerifyCheckDigit(r.CheckAlgorithm.String(), strings.TrimPrefix(sn, r.Prefix)) {
+		return dto.SNReasonChecksum
+	}
+	return ""
+}
+
+// loadSnChecker 加载产品的序列号规则，产品未配置规则时返回nil
+func loadSnChecker(ctx context.Context, productID int) (*snChecker, error) {
+	rule, err := dto.Client().SnRule.Query().
+		Where(snrule.ProductIDEQ(productID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return newSnChecker(rule)
+}
+
+// checkSNs 按产品规则校验SN，只返回不合规的SN
+func checkSNs(ctx context.Context, productID int, sns []string) ([]dto.SNCheckResult, error) {
+	sc, err := loadSnChecker(ctx, productID)
+	if err != nil || sc == nil {
+		return nil, err
+	}
+	var invalid []dto.SNCheckResult
+	for _, sn := range sns {
+		if reason := sc.check(sn); reason != "" {
+			invalid = append(invalid, dto.SNCheckResult{SN: sn, Reason: reason})
+		}
+	}
+	return invalid, nil
+}
+
+// GetSnRule 获取产品的序列号规则，未配置时返回nil
+func (s *SnRuleService) GetSnRule(c *gin.Context, userID, productID int) (*ent.SnRule, resource.RspCode) {
+	// 权限检查
+	if !authorize(c, userID, productID, dto.PermProductView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	rule, err := dto.Client().SnRule.Query().
+		Where(snrule.ProductIDEQ(productID)).
+		Only(c)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, resource.CODE_SUCCESS
+		}
+		logger.Error("query sn rule failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	return rule, resource.CODE_SUCCESS
+}
+
+// SaveSnRule 新增或更新产品的序列号规则
+func (s *SnRuleService) SaveSnRule(c *gin.Context, userID int, param dto.SaveSnRule) resource.RspCode {
+	// 1. 检查用户权限
+	if !authorize(c, userID, param.ProductID, dto.PermProductEdit) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	// 2. 检查产品是否存在
+	productExist, err := dto.Client().Product.Query().
+		Where(product.IDEQ(param.ProductID)).
+		Exist(c)
+	if err != nil {
+		logger.Error("check product failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if !productExist {
+		return resource.ERR_PRODUCT_NOT_EXIST
+	}
+
+	// 3. 校验规则本身
+	if param.CheckAlgorithm == "" {
+		param.CheckAlgorithm = snrule.DefaultCheckAlgorithm.String()
+	}
+	if param.MaxLength > 0 && param.MinLength > param.MaxLength {
+		return resource.ERR_SN_RULE_INVALID
+	}
+	if _, err := regexp.Compile(param.Pattern); err != nil {
+		return resource.ERR_SN_RULE_INVALID
+	}
+
+	// 4. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	oldRule, err := tx.SnRule.Query().
+		Where(snrule.ProductIDEQ(param.ProductID)).
+		Only(c)
+	if err != nil && !ent.IsNotFound(err) {
+		logger.Error("query sn rule failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_QUERY_FAILED
+	}
+
+	var newRule *ent.SnRule
+	if oldRule == nil {
+		newRule, err = tx.SnRule.Create().
+			SetProductID(param.ProductID).
+			SetPrefix(param.Prefix).
+			SetPattern(param.Pattern).
+			SetMinLength(param.MinLength).
+			SetMaxLength(param.MaxLength).
+			SetCheckAlgorithm(snrule.CheckAlgorithm(param.CheckAlgorithm)).
+			SetUpdatedBy(userID).
+			Save(c)
+	} else {
+		newRule, err = tx.SnRule.UpdateOne(oldRule).
+			SetPrefix(param.Prefix).
+			SetPattern(param.Pattern).
+			SetMinLength(param.MinLength).
+			SetMaxLength(param.MaxLength).
+			SetCheckAlgorithm(snrule.CheckAlgorithm(param.CheckAlgorithm)).
+			SetUpdatedBy(userID).
+			Save(c)
+	}
+	if err != nil {
+		logger.Error("save sn rule failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_MOD_FAILED
+	}
+
+	// 5. 创建审计日志
+	action := dto.ActionUpdate
+	if oldRule == nil {
+		action = dto.ActionCreate
+	}
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:    userID,
+		Action:    action,
+		Module:    dto.ModuleSnRule,
+		ProductID: param.ProductID,
+		DetailInfo: map[string]interface{}{
+			"old_rule": oldRule,
+			"new_rule": newRule,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 6. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// DeleteSnRule 删除产品的序列号规则
+func (s *SnRuleService) DeleteSnRule(c *gin.Context, userID, productID int) resource.RspCode {
+	// 1. 检查用户权限
+	if !authorize(c, userID, productID, dto.PermProductEdit) {
+		return resource.ERR_NO_PERMISSION
+	}
+
+	rule, err := dto.Client().SnRule.Query().
+		Where(snrule.ProductIDEQ(productID)).
+		Only(c)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return resource.CODE_SUCCESS
+		}
+		logger.Error("query sn rule failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+
+	// 2. 开启事务
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_DEL_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := tx.SnRule.DeleteOne(rule).Exec(c); err != nil {
+		logger.Error("delete sn rule failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_DEL_FAILED
+	}
+
+	// 3. 创建审计日志
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID:     userID,
+		Action:     dto.ActionDelete,
+		Module:     dto.ModuleSnRule,
+		ProductID:  productID,
+		DetailInfo: rule,
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	// 4. 提交事务
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_DEL_FAILED
+	}
+
+	return resource.CODE_SUCCESS
+}
+
+// ValidateSNs 按产品规则预校验SN，同时检查SN是否已存在或在请求中重复
+func (s *SnRuleService) ValidateSNs(c *gin.Context, userID int, param dto.ValidateSNs) ([]dto.SNCheckResult, resource.RspCode) {
+	// 权限检查
+	if !authorize(c, userID, param.ProductID, dto.PermDeviceView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
+
+	sc, err := loadSnChecker(c, param.ProductID)
+	if err != nil {
+		logger.Error("load sn rule failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+
+	sns := make([]string, 0, len(param.SNs))
+	for _, sn := range param.SNs {
+		sns = append(sns, strings.TrimSpace(sn))
+	}
+
+	// SN全局唯一，已被其它产品使用的SN同样不可用
+	existingSNs, err := dto.Client().Device.Query().
+		Where(device.SnIn(sns...)).
+		Select(device.FieldSn).
+		Strings(viewer.SystemContext(c))
+	if err != nil {
+		logger.Error("check device sn failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	existing := make(map[string]bool, len(existingSNs))
+	for _, sn := range existingSNs {
+		existing[sn] = true
+	}
+
+	seen := make(map[string]bool, len(sns))
+	results := make([]dto.SNCheckResult, 0, len(sns))
+	for _, sn := range sns {
+		result := dto.SNCheckResult{SN: sn, Valid: true}
+		switch {
+		case sn == "":
+			result.Reason = dto.SNReasonLength
+		case seen[sn]:
+			result.Reason = dto.SNReasonDuplicate
+		case existing[sn]:
+			result.Reason = dto.SNReasonExist
+		default:
+			result.Reason = sc.check(sn)
+		}
+		seen[sn] = true
+		result.Valid = result.Reason == ""
+		results = append(results, result)
+	}
+
+	return results, resource.CODE_SUCCESS
+}
@@ -0,0 +1,634 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/twofactorpolicy"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/cache"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/str"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/totp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+const (
+	preAuthTTL          = 5 * time.Minute  // 登录第二步的临时令牌有效期
+	twoFactorEnrollTTL  = 10 * time.Minute // 开启两步验证时密钥的确认期限
+	maxPreAuthFailures  = 5                // 临时令牌允许的验证失败次数，超过后需重新登录
+	totpSkew            = 1                // 允许前后一个时间步的时钟误差
+	recoveryCodeCount   = 10
+	twoFactorQRCodeSize = 256
+)
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// encryptTOTPSecret 密钥使用账号令牌的签名密钥加密保存，修改签名密钥后需要重置所有用户的两步验证
+func encryptTOTPSecret(secret string) (string, error) {
+	return str.Encrypt(secret, accountConfig().TokenSecret)
+}
+
+func decryptTOTPSecret(u *ent.User) (string, error) {
+	if u.TotpSecret == nil {
+		return "", errors.New("totp secret not set")
+	}
+	return str.Decrypt(*u.TotpSecret, accountConfig().TokenSecret)
+}
+
+// newRecoveryCodes 生成恢复码，返回给用户的明文和保存的哈希
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	buf := make([]byte, 5)
+	for i := 0; i < recoveryCodeCount; i++ {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(recoveryEncoding.EncodeToString(buf))
+		code = code[:4] + "-" + code[4:]
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode 忽略大小写、空格和分隔符
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.NewReplacer("-", "", " ", "").Replace(code)
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// matchRecoveryCode 在未使用的恢复码中查找，返回去掉该恢复码后的列表
+func matchRecoveryCode(hashes []string, code string) ([]string, string, bool) {
+	h := hashRecoveryCode(code)
+	for i, v := range hashes {
+		if v == h {
+			rest := append(append([]string{}, hashes[:i]...), hashes[i+1:]...)
+			return rest, h, true
+		}
+	}
+	return hashes, "", false
+}
+
+// userRoles 用户在所有产品中的角色，系统管理员额外包含system_admin
+func userRoles(ctx context.Context, userID int) ([]string, error) {
+	var roles []string
+	if isSystemAdmin(ctx, userID) {
+		roles = append(roles, dto.RoleSystemAdmin)
+	}
+	managers, err := dto.Client().ProductManager.Query().
+		Where(productmanager.UserIDEQ(userID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, pm := range managers {
+		roles = append(roles, managerRole(pm))
+	}
+	return roles, nil
+}
+
+// twoFactorRequired 用户的任一角色在策略中时必须开启两步验证
+func twoFactorRequired(ctx context.Context, userID int) (bool, error) {
+	required, err := dto.Client().TwoFactorPolicy.Query().
+		Select(twofactorpolicy.FieldRole).
+		Strings(ctx)
+	if err != nil || len(required) == 0 {
+		return false, err
+	}
+	roles, err := userRoles(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	for _, r := range roles {
+		for _, want := range required {
+			if r == want {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// twoFactorAudit 记录两步验证事件
+func twoFactorAudit(c *gin.Context, operatorID, userID int, operation, status string) {
+	CreateAuditLog(c, nil, dto.AuditLogData{
+		UserID: operatorID,
+		Action: dto.ActionTwoFactor,
+		Module: dto.ModuleAuth,
+		DetailInfo: map[string]interface{}{
+			"operation": operation,
+			"user_id":   userID,
+			"status":    status,
+		},
+	})
+}
+
+// checkTOTP 校验验证码并记录时间步，同一验证码只能使用一次
+func checkTOTP(ctx context.Context, u *ent.User, code string) (bool, error) {
+	secret, err := decryptTOTPSecret(u)
+	if err != nil {
+		return false, err
+	}
+	step, ok := totp.Validate(secret, code, time.Now(), totpSkew)
+	if !ok || step <= u.TotpLastStep {
+		return false, nil
+	}
+	n, err := dto.Client().User.Update().
+		Where(user.IDEQ(u.ID), user.TotpLastStepLT(step)).
+		SetTotpLastStep(step).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// useRecoveryCode 校验并作废恢复码。事务中锁定用户行后重新读取恢复码再写回，
+// 并发使用不同恢复码时不会互相覆盖，同一恢复码只有一次成功
+func useRecoveryCode(ctx context.Context, u *ent.User, code string) (bool, error) {
+	if _, _, ok := matchRecoveryCode(u.TotpRecoveryCodes, code); !ok {
+		return false, nil
+	}
+	tx, err := dto.Client().Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+	current, err := tx.User.Query().
+		Where(user.IDEQ(u.ID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+	rest, _, ok := matchRecoveryCode(current.TotpRecoveryCodes, code)
+	if !ok {
+		_ = tx.Rollback()
+		return false, nil
+	}
+	if err := tx.User.UpdateOneID(u.ID).SetTotpRecoveryCodes(rest).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// verifySecondFactor 校验验证码或恢复码，返回使用的方式
+func verifySecondFactor(ctx context.Context, u *ent.User, code, recoveryCode string) (string, resource.RspCode) {
+	if !u.TotpEnabled {
+		return "", resource.ERR_TWO_FACTOR_NOT_ENABLED
+	}
+	method := "totp"
+	var ok bool
+	var err error
+	if code != "" {
+		ok, err = checkTOTP(ctx, u, code)
+	} else {
+		method = "recovery_code"
+		ok, err = useRecoveryCode(ctx, u, recoveryCode)
+	}
+	if err != nil {
+		logger.Error("verify two factor failed", zap.Error(err), zap.Int("user_id", u.ID))
+		return "", resource.ERR_OPERATION_FAILED
+	}
+	if !ok {
+		return "", resource.ERR_TWO_FACTOR_CODE_INVALID
+	}
+	return method, resource.CODE_SUCCESS
+}
+
+// issuePreAuth 密码校验通过后生成登录第二步的临时令牌
+func issuePreAuth(u *ent.User, required bool) (*dto.TwoFactorChallenge, error) {
+	token, err := issueAccountToken(tokenPurposePreAuth, u.ID, preAuthTTL)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.TwoFactorChallenge{
+		TwoFactorRequired:  true,
+		EnrollmentRequired: required && !u.TotpEnabled,
+		PreAuthToken:       token,
+		ExpiresIn:          int(preAuthTTL.Seconds()),
+	}, nil
+}
+
+// preAuthUser 获取临时令牌对应的用户，校验成功前不作废令牌
+func preAuthUser(c *gin.Context, token string) (*ent.User, resource.RspCode) {
+	nonce, ok := parseAccountToken(accountConfig().TokenSecret, tokenPurposePreAuth, token)
+	if !ok {
+		return nil, resource.ERR_PRE_AUTH_TOKEN_INVALID
+	}
+	value, err := cache.MyRedis.Get(accountTokenKey(tokenPurposePreAuth, nonce))
+	if errors.Is(err, redis.Nil) {
+		return nil, resource.ERR_PRE_AUTH_TOKEN_INVALID
+	}
+	if err != nil {
+		logger.Error("query pre-auth token failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	s, _ := value.(string)
+	userID, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, resource.ERR_PRE_AUTH_TOKEN_INVALID
+	}
+	u, err := dto.Client().User.Get(c, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, resource.ERR_PRE_AUTH_TOKEN_INVALID
+		}
+		logger.Error("query user failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	if !u.IsEnabled {
+		return nil, resource.ERR_PRE_AUTH_TOKEN_INVALID
+	}
+	return u, resource.CODE_SUCCESS
+}
+
+// preAuthFailed 记录临时令牌的验证失败，达到次数后作废令牌
+func preAuthFailed(token string) {
+	nonce, _ := parseAccountToken(accountConfig().TokenSecret, tokenPurposePreAuth, token)
+	n, err := cache.MyRedis.Incr(str.GetPreAuthFailKey(nonce), preAuthTTL)
+	if err != nil {
+		logger.Error("count pre-auth failure failed", zap.Error(err))
+		return
+	}
+	if n >= maxPreAuthFailures {
+		_ = cache.MyRedis.DelMany(accountTokenKey(tokenPurposePreAuth, nonce), str.GetPreAuthFailKey(nonce))
+	}
+}
+
+// finishPreAuth 作废临时令牌并完成登录，令牌已被并发使用时返回ERR_PRE_AUTH_TOKEN_INVALID
+func (s *BaseService) finishPreAuth(c *gin.Context, token string, u *ent.User, method string) (at, rt string, uai auth.UserAuthInfo, e resource.RspCode) {
+	userID, err := consumeAccountToken(tokenPurposePreAuth, token)
+	if err != nil {
+		logger.Error("consume pre-auth token failed", zap.Error(err))
+		e = resource.ERR_QUERY_FAILED
+		return
+	}
+	if userID != u.ID {
+		e = resource.ERR_PRE_AUTH_TOKEN_INVALID
+		return
+	}
+	return s.completeLogin(c, u, method)
+}
+
+// enrollTwoFactor 生成待确认的密钥，确认前不影响登录
+func enrollTwoFactor(c *gin.Context, u *ent.User) (*dto.TwoFactorEnrollment, resource.RspCode) {
+	if u.TotpEnabled {
+		return nil, resource.ERR_TWO_FACTOR_ENABLED
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		logger.Error("generate totp secret failed", zap.Error(err))
+		return nil, resource.ERR_OPERATION_FAILED
+	}
+	if err := cache.MyRedis.Set(str.GetTwoFactorEnrollKey(u.ID), secret, twoFactorEnrollTTL); err != nil {
+		logger.Error("save totp secret failed", zap.Error(err))
+		return nil, resource.ERR_OPERATION_FAILED
+	}
+
+	issuer := ""
+	if resource.Conf.App != nil {
+		issuer = resource.Conf.App.ServiceName
+	}
+	uri := totp.URI(issuer, u.Email, secret)
+	qr, err := totp.QRCode(uri, twoFactorQRCodeSize)
+	if err != nil {
+		logger.Error("generate qr code failed", zap.Error(err))
+		return nil, resource.ERR_OPERATION_FAILED
+	}
+	twoFactorAudit(c, u.ID, u.ID, "enroll", "success")
+	return &dto.TwoFactorEnrollment{Secret: secret, URI: uri, QRCode: qr}, resource.CODE_SUCCESS
+}
+
+// activateTwoFactor 使用待确认密钥的验证码开启两步验证，返回恢复码
+func activateTwoFactor(c *gin.Context, u *ent.User, code string) ([]string, resource.RspCode) {
+	if u.TotpEnabled {
+		return nil, resource.ERR_TWO_FACTOR_ENABLED
+	}
+	value, err := cache.MyRedis.Get(str.GetTwoFactorEnrollKey(u.ID))
+	if errors.Is(err, redis.Nil) {
+		return nil, resource.ERR_TWO_FACTOR_NOT_ENROLLED
+	}
+	if err != nil {
+		logger.Error("query totp secret failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	secret, _ := value.(string)
+	step, ok := totp.Validate(secret, code, time.Now(), totpSkew)
+	if !ok {
+		twoFactorAudit(c, u.ID, u.ID, "enable", "failed")
+		return nil, resource.ERR_TWO_FACTOR_CODE_INVALID
+	}
+
+	encrypted, err := encryptTOTPSecret(secret)
+	if err != nil {
+		logger.Error("encrypt totp secret failed", zap.Error(err))
+		return nil, resource.ERR_OPERATION_FAILED
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		logger.Error("generate recovery codes failed", zap.Error(err))
+		return nil, resource.ERR_OPERATION_FAILED
+	}
+	n, err := dto.Client().User.Update().
+		Where(user.IDEQ(u.ID), user.TotpEnabled(false)).
+		SetTotpEnabled(true).
+		SetTotpSecret(encrypted).
+		SetTotpLastStep(step).
+		SetTotpRecoveryCodes(hashes).
+		Save(c)
+	if err != nil {
+		logger.Error("enable two factor failed", zap.Error(err))
+		return nil, resource.ERR_MOD_FAILED
+	}
+	if n == 0 {
+		return nil, resource.ERR_TWO_FACTOR_ENABLED
+	}
+	_ = cache.MyRedis.Del(str.GetTwoFactorEnrollKey(u.ID))
+	twoFactorAudit(c, u.ID, u.ID, "enable", "success")
+	return codes, resource.CODE_SUCCESS
+}
+
+// VerifyTwoFactor 登录第二步，校验验证码或恢复码后签发令牌
+func (s *BaseService) VerifyTwoFactor(c *gin.Context, param dto.TwoFactorLogin) (at, rt string, uai auth.UserAuthInfo, e resource.RspCode) {
+	u, e := preAuthUser(c, param.Token)
+	if e != resource.CODE_SUCCESS {
+		return
+	}
+	method, e := verifySecondFactor(c, u, param.Code, param.RecoveryCode)
+	if e != resource.CODE_SUCCESS {
+		if e == resource.ERR_TWO_FACTOR_CODE_INVALID {
+			preAuthFailed(param.Token)
+			twoFactorAudit(c, u.ID, u.ID, "verify", "failed")
+		}
+		return
+	}
+	if method == "recovery_code" {
+		twoFactorAudit(c, u.ID, u.ID, "use_recovery_code", "success")
+	}
+	return s.finishPreAuth(c, param.Token, u, method)
+}
+
+// EnrollTwoFactor 角色要求两步验证但未开启的用户在登录时使用临时令牌获取密钥
+func (s *BaseService) EnrollTwoFactor(c *gin.Context, token string) (*dto.TwoFactorEnrollment, resource.RspCode) {
+	u, e := preAuthUser(c, token)
+	if e != resource.CODE_SUCCESS {
+		return nil, e
+	}
+	return enrollTwoFactor(c, u)
+}
+
+// ActivateTwoFactor 使用临时令牌确认开启两步验证，开启后直接完成登录
+func (s *BaseService) ActivateTwoFactor(c *gin.Context, param dto.TwoFactorActivateByToken) (at, rt string, uai auth.UserAuthInfo, codes []string, e resource.RspCode) {
+	u, e := preAuthUser(c, param.Token)
+	if e != resource.CODE_SUCCESS {
+		return
+	}
+	codes, e = activateTwoFactor(c, u, param.Code)
+	if e != resource.CODE_SUCCESS {
+		if e == resource.ERR_TWO_FACTOR_CODE_INVALID {
+			preAuthFailed(param.Token)
+		}
+		return
+	}
+	at, rt, uai, e = s.finishPreAuth(c, param.Token, u, "totp_enroll")
+	return
+}
+
+// TwoFactorService 当前用户的两步验证管理和管理员策略
+type TwoFactorService struct{}
+
+// NewTwoFactorService 创建两步验证服务实例
+func NewTwoFactorService() *TwoFactorService {
+	return &TwoFactorService{}
+}
+
+// getUser 获取当前用户
+func (s *TwoFactorService) getUser(c *gin.Context, userID int) (*ent.User, resource.RspCode) {
+	u, err := dto.Client().User.Get(c, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, resource.ERR_USER_NOT_EXIST
+		}
+		logger.Error("query user failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	return u, resource.CODE_SUCCESS
+}
+
+// Status 当前用户的两步验证状态
+func (s *TwoFactorService) Status(c *gin.Context, userID int) (*dto.TwoFactorStatus, resource.RspCode) {
+	u, code := s.getUser(c, userID)
+	if code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	required, err := twoFactorRequired(c, userID)
+	if err != nil {
+		logger.Error("check two factor policy failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	return &dto.TwoFactorStatus{
+		Enabled:           u.TotpEnabled,
+		Required:          required,
+		RecoveryCodesLeft: len(u.TotpRecoveryCodes),
+	}, resource.CODE_SUCCESS
+}
+
+// Enroll 获取开启两步验证的密钥和二维码
+func (s *TwoFactorService) Enroll(c *gin.Context, userID int) (*dto.TwoFactorEnrollment, resource.RspCode) {
+	u, code := s.getUser(c, userID)
+	if code != resource.CODE_SUCCESS {
+		return nil, code
+	}
+	return enrollTwoFactor(c, u)
+}
+
+// Activate 确认开启两步验证，返回恢复码
+func (s *TwoFactorService) Activate(c *gin.Context, userID int, code string) (*dto.TwoFactorRecoveryCodes, resource.RspCode) {
+	u, rc := s.getUser(c, userID)
+	if rc != resource.CODE_SUCCESS {
+		return nil, rc
+	}
+	codes, rc := activateTwoFactor(c, u, code)
+	if rc != resource.CODE_SUCCESS {
+		return nil, rc
+	}
+	return &dto.TwoFactorRecoveryCodes{RecoveryCodes: codes}, resource.CODE_SUCCESS
+}
+
+// Disable 关闭两步验证，角色要求两步验证时不能关闭
+func (s *TwoFactorService) Disable(c *gin.Context, userID int, param dto.TwoFactorDisable) resource.RspCode {
+	u, code := s.getUser(c, userID)
+	if code != resource.CODE_SUCCESS {
+		return code
+	}
+	required, err := twoFactorRequired(c, userID)
+	if err != nil {
+		logger.Error("check two factor policy failed", zap.Error(err))
+		return resource.ERR_QUERY_FAILED
+	}
+	if required {
+		return resource.ERR_TWO_FACTOR_REQUIRED
+	}
+	if _, code := verifySecondFactor(c, u, param.Code, param.RecoveryCode); code != resource.CODE_SUCCESS {
+		if code == resource.ERR_TWO_FACTOR_CODE_INVALID {
+			twoFactorAudit(c, userID, userID, "disable", "failed")
+		}
+		return code
+	}
+	if err := clearTwoFactor(c, userID); err != nil {
+		logger.Error("disable two factor failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	twoFactorAudit(c, userID, userID, "disable", "success")
+	return resource.CODE_SUCCESS
+}
+
+// RegenerateRecoveryCodes 重新生成恢复码，原恢复码全部失效
+func (s *TwoFactorService) RegenerateRecoveryCodes(c *gin.Context, userID int, code string) (*dto.TwoFactorRecoveryCodes, resource.RspCode) {
+	u, rc := s.getUser(c, userID)
+	if rc != resource.CODE_SUCCESS {
+		return nil, rc
+	}
+	if _, rc := verifySecondFactor(c, u, code, ""); rc != resource.CODE_SUCCESS {
+		if rc == resource.ERR_TWO_FACTOR_CODE_INVALID {
+			twoFactorAudit(c, userID, userID, "regenerate_recovery_codes", "failed")
+		}
+		return nil, rc
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		logger.Error("generate recovery codes failed", zap.Error(err))
+		return nil, resource.ERR_OPERATION_FAILED
+	}
+	if err := dto.Client().User.UpdateOneID(userID).SetTotpRecoveryCodes(hashes).Exec(c); err != nil {
+		logger.Error("save recovery codes failed", zap.Error(err))
+		return nil, resource.ERR_MOD_FAILED
+	}
+	twoFactorAudit(c, userID, userID, "regenerate_recovery_codes", "success")
+	return &dto.TwoFactorRecoveryCodes{RecoveryCodes: codes}, resource.CODE_SUCCESS
+}
+
+// clearTwoFactor 清除用户的两步验证设置
+func clearTwoFactor(ctx context.Context, userID int) error {
+	return dto.Client().User.UpdateOneID(userID).
+		SetTotpEnabled(false).
+		ClearTotpSecret().
+		ClearTotpRecoveryCodes().
+		Exec(ctx)
+}
+
+// Reset 系统管理员重置用户的两步验证，用于用户丢失设备和恢复码的情况；
+// 角色要求两步验证的用户下次登录时需要重新开启
+func (s *TwoFactorService) Reset(c *gin.Context, userID, targetID int) resource.RspCode {
+	if !isSystemAdmin(c, userID) {
+		return resource.ERR_NO_PERMISSION
+	}
+	if _, code := s.getUser(c, targetID); code != resource.CODE_SUCCESS {
+		return code
+	}
+	if err := clearTwoFactor(c, targetID); err != nil {
+		logger.Error("reset two factor failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	_ = cache.MyRedis.Del(str.GetTwoFactorEnrollKey(targetID))
+	twoFactorAudit(c, userID, targetID, "reset", "success")
+	return resource.CODE_SUCCESS
+}
+
+// GetPolicy 必须开启两步验证的角色
+func (s *TwoFactorService) GetPolicy(c *gin.Context) (*dto.TwoFactorPolicy, resource.RspCode) {
+	roles, err := dto.Client().TwoFactorPolicy.Query().
+		Order(ent.Asc(twofactorpolicy.FieldID)).
+		Select(twofactorpolicy.FieldRole).
+		Strings(c)
+	if err != nil {
+		logger.Error("query two factor policy failed", zap.Error(err))
+		return nil, resource.ERR_QUERY_FAILED
+	}
+	return &dto.TwoFactorPolicy{Roles: roles}, resource.CODE_SUCCESS
+}
+
+// SetPolicy 系统管理员设置必须开启两步验证的角色，整体替换原设置
+func (s *TwoFactorService) SetPolicy(c *gin.Context, userID int, param dto.TwoFactorPolicy) resource.RspCode {
+	if !isSystemAdmin(c, userID) {
+		return resource.ERR_NO_PERMISSION
+	}
+	old, code := s.GetPolicy(c)
+	if code != resource.CODE_SUCCESS {
+		return code
+	}
+
+	tx, err := dto.Client().Tx(c)
+	if err != nil {
+		logger.Error("begin transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if _, err := tx.TwoFactorPolicy.Delete().Exec(c); err != nil {
+		logger.Error("clear two factor policy failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_MOD_FAILED
+	}
+	seen := make(map[string]bool, len(param.Roles))
+	roles := make([]string, 0, len(param.Roles))
+	for _, role := range param.Roles {
+		if seen[role] {
+			continue
+		}
+		seen[role] = true
+		roles = append(roles, role)
+		if err := tx.TwoFactorPolicy.Create().SetRole(role).SetCreatedBy(userID).Exec(c); err != nil {
+			logger.Error("save two factor policy failed", zap.Error(err))
+			_ = tx.Rollback()
+			return resource.ERR_MOD_FAILED
+		}
+	}
+
+	err = CreateAuditLog(c, tx, dto.AuditLogData{
+		UserID: userID,
+		Action: dto.ActionTwoFactor,
+		Module: dto.ModuleAuth,
+		DetailInfo: map[string]interface{}{
+			"operation": "update_policy",
+			"before":    old.Roles,
+			"after":     roles,
+		},
+	})
+	if err != nil {
+		logger.Error("create audit log failed", zap.Error(err))
+		_ = tx.Rollback()
+		return resource.ERR_ADD_LOG_FAILED
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction failed", zap.Error(err))
+		return resource.ERR_MOD_FAILED
+	}
+	return resource.CODE_SUCCESS
+}
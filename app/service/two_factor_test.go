@@ -0,0 +1,73 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+)
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != recoveryCodeCount || len(hashes) != recoveryCodeCount {
+		t.Fatalf("got %d codes, %d hashes", len(codes), len(hashes))
+	}
+	seen := map[string]bool{}
+	for i, code := range codes {
+		if len(code) != 9 || code[4] != '-' || seen[code] {
+			t.Errorf("code %q", code)
+		}
+		seen[code] = true
+		if hashes[i] == code || hashes[i] != hashRecoveryCode(code) {
+			t.Errorf("hash of %q", code)
+		}
+	}
+
+	// 忽略大小写、空格和分隔符，使用后从列表中移除
+	input := " " + strings.ToUpper(strings.ReplaceAll(codes[3], "-", "")) + " "
+	rest, h, ok := matchRecoveryCode(hashes, input)
+	if !ok || h != hashes[3] || len(rest) != recoveryCodeCount-1 {
+		t.Fatalf("match = %v, %d left", ok, len(rest))
+	}
+	for _, v := range rest {
+		if v == hashes[3] {
+			t.Error("used code still present")
+		}
+	}
+	if len(hashes) != recoveryCodeCount || hashes[3] != h {
+		t.Error("original list modified")
+	}
+	if _, _, ok := matchRecoveryCode(rest, codes[3]); ok {
+		t.Error("used code matched again")
+	}
+}
+
+func TestTOTPSecretEncryption(t *testing.T) {
+	old := resource.Conf.AccountConfig
+	defer func() { resource.Conf.AccountConfig = old }()
+	resource.Conf.AccountConfig = &resource.AccountConfig{TokenSecret: "secret"}
+
+	encrypted, err := encryptTOTPSecret("JBSWY3DPEHPK3PXP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if encrypted == "JBSWY3DPEHPK3PXP" {
+		t.Fatal("secret stored in plain text")
+	}
+	got, err := decryptTOTPSecret(&ent.User{TotpSecret: &encrypted})
+	if err != nil || got != "JBSWY3DPEHPK3PXP" {
+		t.Errorf("decrypt = %q, %v", got, err)
+	}
+	if _, err := decryptTOTPSecret(&ent.User{}); err == nil {
+		t.Error("missing secret decrypted")
+	}
+
+	resource.Conf.AccountConfig = &resource.AccountConfig{TokenSecret: "other"}
+	if _, err := decryptTOTPSecret(&ent.User{TotpSecret: &encrypted}); err == nil {
+		t.Error("decrypted with another key")
+	}
+}
@@ -6,15 +6,14 @@ import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/firmwareversion"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productfeature"
-	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/productmanager"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/softwareversion"
-	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
 	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
 	"cambridge-hit.com/gin-base/activateserver/pkg/util/mytime"
 	"cambridge-hit.com/gin-base/activateserver/resource"
 	"context"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"time"
 )
 
 // VersionService 版本管理服务
@@ -65,33 +64,6 @@ func (s *VersionService) checkSoftwareVersionExists(ctx context.Context, product
 	return count > 0, nil
 }
 
-// 检查产品权限
-func (s *VersionService) checkProductPermission(ctx context.Context, userID int, productID int) (bool, error) {
-	client := dto.Client()
-
-	// 超级管理员有全部权限
-	if userID == dto.SuperAdminID {
-		return true, nil
-	}
-
-	// 检查是否是产品管理员
-	count, err := client.ProductManager.Query().
-		Where(
-			productmanager.ProductIDEQ(productID),
-		).
-		QueryUser().
-		Where(
-			user.ID(userID),
-		).
-		Count(ctx)
-
-	if err != nil {
-		return false, err
-	}
-
-	return count > 0, nil
-}
-
 // ListFirmwareVersions 获取韧件版本列表
 func (s *VersionService) ListFirmwareVersions(c *gin.Context, userID int, productID int, page, pageSize int) (*dto.PageResult, resource.RspCode) {
 	client := dto.Client()
@@ -106,6 +78,9 @@ func (s *VersionService) ListFirmwareVersions(c *gin.Context, userID int, produc
 	if !productExists {
 		return nil, resource.ERR_INVALID_PARAMETER
 	}
+	if !authorize(ctx, userID, productID, dto.PermReleaseView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
 
 	// 查询总数
 	total, err := client.FirmwareVersion.Query().
@@ -208,12 +183,7 @@ func (s *VersionService) AddFirmwareVersion(c *gin.Context, userID int, param dt
 	}
 
 	// 检查是否有权限
-	hasPermission, err := s.checkProductPermission(ctx, userID, param.ProductID)
-	if err != nil {
-		logger.Error("err:", zap.Error(err))
-		return resource.ERR_ADD_FAILED
-	}
-	if !hasPermission {
+	if !authorize(ctx, userID, param.ProductID, dto.PermReleaseWrite) {
 		return resource.ERR_NO_PERMISSION
 	}
 
@@ -284,12 +254,7 @@ func (s *VersionService) ModifyFirmwareVersion(c *gin.Context, userID int, param
 	}
 
 	// 检查是否有权限
-	hasPermission, err := s.checkProductPermission(ctx, userID, fw.ProductID)
-	if err != nil {
-		logger.Error("err:", zap.Error(err))
-		return resource.ERR_MOD_FAILED
-	}
-	if !hasPermission {
+	if !authorize(ctx, userID, fw.ProductID, dto.PermReleaseWrite) {
 		return resource.ERR_NO_PERMISSION
 	}
 
@@ -389,12 +354,7 @@ func (s *VersionService) DeleteFirmwareVersion(c *gin.Context, userID int, firmw
 	}
 
 	// 检查权限
-	hasPermission, err := s.checkProductPermission(ctx, userID, firmwareVersion.ProductID)
-	if err != nil {
-		logger.Error("err:", zap.Error(err))
-		return resource.ERR_QUERY_FAILED
-	}
-	if !hasPermission {
+	if !authorize(ctx, userID, firmwareVersion.ProductID, dto.PermReleaseWrite) {
 		return resource.ERR_NO_PERMISSION
 	}
 
@@ -411,8 +371,8 @@ func (s *VersionService) DeleteFirmwareVersion(c *gin.Context, userID int, firmw
 		}
 	}()
 
-	// 删除韧件版本
-	err = tx.FirmwareVersion.DeleteOne(firmwareVersion).Exec(ctx)
+	// 移入回收站
+	err = tx.FirmwareVersion.UpdateOne(firmwareVersion).SetDeletedAt(time.Now()).Exec(ctx)
 	if err != nil {
 		logger.Error("err:", zap.Error(err))
 		_ = tx.Rollback()
@@ -457,6 +417,9 @@ func (s *VersionService) ListSoftwareVersions(c *gin.Context, userID int, produc
 	if !productExists {
 		return nil, resource.ERR_INVALID_PARAMETER
 	}
+	if !authorize(ctx, userID, productID, dto.PermReleaseView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
 
 	// 查询总数
 	total, err := client.SoftwareVersion.Query().
@@ -563,12 +526,7 @@ func (s *VersionService) AddSoftwareVersion(c *gin.Context, userID int, param dt
 	}
 
 	// 检查权限
-	hasPermission, err := s.checkProductPermission(ctx, userID, param.ProductID)
-	if err != nil {
-		logger.Error("err:", zap.Error(err))
-		return resource.ERR_QUERY_FAILED
-	}
-	if !hasPermission {
+	if !authorize(ctx, userID, param.ProductID, dto.PermReleaseWrite) {
 		return resource.ERR_NO_PERMISSION
 	}
 
@@ -717,12 +675,7 @@ func (s *VersionService) ModifySoftwareVersion(c *gin.Context, userID int, param
 	}
 
 	// 检查权限
-	hasPermission, err := s.checkProductPermission(ctx, userID, softwareVersion.ProductID)
-	if err != nil {
-		logger.Error("err:", zap.Error(err))
-		return resource.ERR_QUERY_FAILED
-	}
-	if !hasPermission {
+	if !authorize(ctx, userID, softwareVersion.ProductID, dto.PermReleaseWrite) {
 		return resource.ERR_NO_PERMISSION
 	}
 	// 检查版本是否已存在
@@ -953,12 +906,7 @@ func (s *VersionService) DeleteSoftwareVersion(c *gin.Context, userID int, softw
 	}
 
 	// 检查权限
-	hasPermission, err := s.checkProductPermission(ctx, userID, softwareVersion.ProductID)
-	if err != nil {
-		logger.Error("err:", zap.Error(err))
-		return resource.ERR_QUERY_FAILED
-	}
-	if !hasPermission {
+	if !authorize(ctx, userID, softwareVersion.ProductID, dto.PermReleaseWrite) {
 		return resource.ERR_NO_PERMISSION
 	}
 
@@ -975,8 +923,8 @@ func (s *VersionService) DeleteSoftwareVersion(c *gin.Context, userID int, softw
 		}
 	}()
 
-	// 删除软件版本
-	err = tx.SoftwareVersion.DeleteOne(softwareVersion).Exec(ctx)
+	// 移入回收站
+	err = tx.SoftwareVersion.UpdateOne(softwareVersion).SetDeletedAt(time.Now()).Exec(ctx)
 	if err != nil {
 		logger.Error("err:", zap.Error(err))
 		_ = tx.Rollback()
@@ -1008,7 +956,7 @@ func (s *VersionService) DeleteSoftwareVersion(c *gin.Context, userID int, softw
 }
 
 // GetProductFirmwareVersions 获取产品的所有韧件版本
-func (s *VersionService) GetProductFirmwareVersions(c *gin.Context, productID int) ([]dto.FirmwareInfo, resource.RspCode) {
+func (s *VersionService) GetProductFirmwareVersions(c *gin.Context, userID, productID int) ([]dto.FirmwareInfo, resource.RspCode) {
 	client := dto.Client()
 	ctx := c.Request.Context()
 
@@ -1021,6 +969,9 @@ func (s *VersionService) GetProductFirmwareVersions(c *gin.Context, productID in
 	if !productExists {
 		return nil, resource.ERR_INVALID_PARAMETER
 	}
+	if !authorize(ctx, userID, productID, dto.PermReleaseView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
 
 	// 获取韧件版本
 	firmwares, err := client.FirmwareVersion.Query().
@@ -1046,7 +997,7 @@ func (s *VersionService) GetProductFirmwareVersions(c *gin.Context, productID in
 }
 
 // GetProductFeatures 获取产品的所有功能
-func (s *VersionService) GetProductFeatures(c *gin.Context, productID int) ([]dto.FeatureInfo, resource.RspCode) {
+func (s *VersionService) GetProductFeatures(c *gin.Context, userID, productID int) ([]dto.FeatureInfo, resource.RspCode) {
 	client := dto.Client()
 	ctx := c.Request.Context()
 
@@ -1059,6 +1010,9 @@ func (s *VersionService) GetProductFeatures(c *gin.Context, productID int) ([]dt
 	if !productExists {
 		return nil, resource.ERR_INVALID_PARAMETER
 	}
+	if !authorize(ctx, userID, productID, dto.PermReleaseView) {
+		return nil, resource.ERR_NO_PERMISSION
+	}
 
 	// 获取功能列表
 	features, err := client.ProductFeature.Query().
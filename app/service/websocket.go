@@ -222,12 +222,24 @@ type WebSocketService struct {
 	Hub *Hub
 }
 
+var (
+	sharedHub     *Hub
+	sharedHubOnce sync.Once
+)
+
+// SharedHub 获取进程内共享的 Hub，WebSocket 连接和后台任务推送使用同一个 Hub
+func SharedHub() *Hub {
+	sharedHubOnce.Do(func() {
+		sharedHub = NewHub()
+		go sharedHub.Run()
+	})
+	return sharedHub
+}
+
 // NewWebSocketService 创建新的 WebSocket 服务
 func NewWebSocketService() *WebSocketService {
-	hub := NewHub()
-	go hub.Run()
 	return &WebSocketService{
-		Hub: hub,
+		Hub: SharedHub(),
 	}
 }
 
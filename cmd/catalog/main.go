@@ -0,0 +1,135 @@
+// catalog 产品目录命令行工具，用于在环境之间迁移产品定义（功能、许可证类型、管理员），
+// 使用与服务相同的配置文件连接数据库，不迁移表结构，export和plan使用只读连接：
+//
+//	catalog -f staging.yml -product P100,P200 -o catalog.yaml export
+//	catalog -f prod.yml -i catalog.yaml plan
+//	catalog -f prod.yml -i catalog.yaml -user ci@example.com apply
+//
+// plan有待执行的变更时退出码为2，文档有错误时退出码为1
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/initializer"
+)
+
+var (
+	productCodes = flag.String("product", "", "export: comma separated product codes")
+	outFile      = flag.String("o", "", "export: output file, default stdout")
+	inFile       = flag.String("i", "", "plan/apply: catalog file")
+	userEmail    = flag.String("user", "", "apply: operator email recorded in audit logs, default the super admin")
+)
+
+func main() {
+	// 配置初始化时解析命令行参数，只有apply可以写数据库
+	initializer.InitCLI(func() bool { return flag.Arg(0) != "apply" })
+	// 命令行工具直接操作数据库，不限制产品范围
+	ctx := viewer.SystemContext(context.Background())
+
+	var err error
+	switch flag.Arg(0) {
+	case "export":
+		err = export(ctx)
+	case "plan":
+		err = plan(ctx, false)
+	case "apply":
+		err = plan(ctx, true)
+	default:
+		fmt.Fprintln(os.Stderr, "usage: catalog [-f config] [flags] export|plan|apply")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func export(ctx context.Context) error {
+	var codes []string
+	for _, code := range strings.Split(*productCodes, ",") {
+		if code = strings.TrimSpace(code); code != "" {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		return fmt.Errorf("-product is required")
+	}
+	products, err := dto.Client().Product.Query().
+		Where(product.CodeIn(codes...)).
+		Order(product.ByCode()).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	if len(products) != len(codes) {
+		return fmt.Errorf("found %d of %d products", len(products), len(codes))
+	}
+
+	doc, err := service.BuildCatalog(ctx, products)
+	if err != nil {
+		return err
+	}
+	data, err := service.MarshalCatalog(doc)
+	if err != nil {
+		return err
+	}
+	if *outFile == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(*outFile, data, 0644)
+}
+
+func plan(ctx context.Context, apply bool) error {
+	if *inFile == "" {
+		return fmt.Errorf("-i is required")
+	}
+	data, err := os.ReadFile(*inFile)
+	if err != nil {
+		return err
+	}
+	doc, err := service.ParseCatalog(data)
+	if err != nil {
+		return err
+	}
+
+	var result *dto.CatalogPlan
+	if apply {
+		userID := dto.SuperAdminID
+		if *userEmail != "" {
+			u, err := dto.Client().User.Query().Where(user.EmailEQ(*userEmail)).Only(ctx)
+			if err != nil {
+				return fmt.Errorf("user %s: %w", *userEmail, err)
+			}
+			userID = u.ID
+		}
+		result, err = service.ApplyCatalog(ctx, doc, userID)
+	} else {
+		result, err = service.PlanCatalog(ctx, doc)
+	}
+	if err != nil {
+		return err
+	}
+
+	out, _ := json.MarshalIndent(result, "", "  ")
+	fmt.Println(string(out))
+	if len(result.Errors) > 0 {
+		os.Exit(1)
+	}
+	if !apply && len(result.Changes) > 0 {
+		os.Exit(2)
+	}
+	return nil
+}
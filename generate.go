@@ -4,7 +4,7 @@ package main
 //go:generate go run -mod=mod resource/generate_code.go -input ./resource/code.go -output ./resource/code_name.go -translationDir ./resource/embed/locales
 
 // 生成ent
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --target ./app/entity/ent --header "// Code generated by ent, DO NOT EDIT." ./app/entity/schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature intercept,privacy,sql/lock --target ./app/entity/ent --header "// Code generated by ent, DO NOT EDIT." ./app/entity/schema
 
 // 生成swagger文档
 //go:generate swag init
@@ -12,6 +12,18 @@ func InitAll() {
 	str.SnowflakeInit(resource.Conf.App.MachineID)
 	loggerInit()
 	cacheInit()
+	mailInit()
 	dbInit()
+	jobInit()
 	//ossInit("aliyun")
 }
+
+// InitCLI 命令行工具只初始化配置、日志、缓存和数据库，不启动定时任务和任务执行协程；
+// 不迁移数据库，readOnly返回true时数据库连接只读，readOnly在解析命令行参数后调用
+func InitCLI(readOnly func() bool) {
+	resource.ConfigInit()
+	str.SnowflakeInit(resource.Conf.App.MachineID)
+	loggerInit()
+	cacheInit()
+	cliDBInit(readOnly())
+}
@@ -4,13 +4,21 @@ import (
 	"cambridge-hit.com/gin-base/activateserver/app/entity/dto"
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/user"
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"golang.org/x/crypto/bcrypt"
 	"log"
+	"strings"
 
 	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/migrate"
+	_ "cambridge-hit.com/gin-base/activateserver/app/entity/ent/runtime" // 默认值、钩子、拦截器和隐私策略
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
 	"cambridge-hit.com/gin-base/activateserver/resource"
 	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
 
 	"cambridge-hit.com/gin-base/activateserver/pkg/util/cache"
 	"cambridge-hit.com/gin-base/activateserver/pkg/util/objstorage"
@@ -78,19 +86,30 @@ func createUser(client *ent.Client, id int, email, password string, is_enabled b
 
 }
 
-func dbInit() {
+// openDB 按配置连接MySQL
+func openDB() *entsql.Driver {
 	cfg := resource.Conf.MysqlConfig
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local",
 		cfg.User, cfg.Password, cfg.Host, cfg.Port, cfg.DBName)
 
-	client, err := ent.Open(dialect.MySQL, dsn)
+	drv, err := entsql.Open(dialect.MySQL, dsn)
 	if err != nil {
 		log.Fatalf("数据库连接失败: %v", err)
+	}
+	return drv
+}
+
+func dbInit() {
+	drv := openDB()
+	client := ent.NewClient(ent.Driver(drv))
+
+	if err := backfillDeletedID(context.Background(), drv.DB()); err != nil {
+		log.Fatalf("回填删除标记失败: %v", err)
 		return
 	}
 
-	// 运行数据库迁移（自动创建表）
-	if err := client.Schema.Create(context.Background()); err != nil {
+	// 运行数据库迁移（自动创建表，删除不再使用的索引，如软删除前的唯一索引）
+	if err := client.Schema.Create(viewer.SystemContext(context.Background()), migrate.WithDropIndex(true)); err != nil {
 		log.Fatalf("数据库迁移失败: %v", err)
 		return
 	}
@@ -100,3 +119,92 @@ func dbInit() {
 	// 创建默认管理员用户
 	createDefaultAdminUser(client)
 }
+
+// cliDBInit 命令行工具连接数据库，不迁移表结构也不创建默认用户，数据库需已由服务迁移；
+// readOnly时拒绝一切写操作
+func cliDBInit(readOnly bool) {
+	var drv dialect.Driver = openDB()
+	if readOnly {
+		drv = readOnlyDriver{drv}
+	}
+	dto.SetClient(ent.NewClient(ent.Driver(drv)))
+}
+
+// errReadOnly 只读连接上执行了写操作
+var errReadOnly = errors.New("database connection is read-only")
+
+// readOnlyDriver 拒绝执行写语句的驱动。ent的写操作通过Exec执行，支持RETURNING的数据库上插入也可能通过Query执行
+type readOnlyDriver struct {
+	dialect.Driver
+}
+
+func (readOnlyDriver) Exec(context.Context, string, any, any) error {
+	return errReadOnly
+}
+
+func (d readOnlyDriver) Query(ctx context.Context, query string, args, v any) error {
+	if !isSelect(query) {
+		return errReadOnly
+	}
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func (d readOnlyDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return readOnlyTx{tx}, nil
+}
+
+// readOnlyTx 只读连接上的事务
+type readOnlyTx struct {
+	dialect.Tx
+}
+
+func (readOnlyTx) Exec(context.Context, string, any, any) error {
+	return errReadOnly
+}
+
+func (tx readOnlyTx) Query(ctx context.Context, query string, args, v any) error {
+	if !isSelect(query) {
+		return errReadOnly
+	}
+	return tx.Tx.Query(ctx, query, args, v)
+}
+
+// isSelect 是否为查询语句
+func isSelect(query string) bool {
+	return strings.HasPrefix(strings.ToUpper(strings.TrimSpace(query)), "SELECT")
+}
+
+// softDeleteTables 使用SoftDeleteMixin的表
+var softDeleteTables = []*schema.Table{
+	migrate.ProductsTable,
+	migrate.DevicesTable,
+	migrate.LicenseTypesTable,
+	migrate.ProductFeaturesTable,
+	migrate.FirmwareVersionsTable,
+	migrate.SoftwareVersionsTable,
+}
+
+// backfillDeletedID 在迁移前为回收站中的记录写入deleted_id。
+// 唯一索引由(..., deleted_at)改为(..., deleted_id)，回收站中的记录可能与正常记录重复，
+// 如果由迁移直接添加默认为0的列，创建唯一索引会失败
+func backfillDeletedID(ctx context.Context, db *sql.DB) error {
+	for _, t := range softDeleteTables {
+		// 表还不存在或还没有软删除，由迁移直接创建
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("SELECT `deleted_at` FROM `%s` LIMIT 0", t.Name)); err != nil {
+			continue
+		}
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("SELECT `deleted_id` FROM `%s` LIMIT 0", t.Name)); err != nil {
+			if _, err := db.ExecContext(ctx, fmt.Sprintf("ALTER TABLE `%s` ADD COLUMN `deleted_id` bigint NOT NULL DEFAULT 0", t.Name)); err != nil {
+				return fmt.Errorf("%s: %w", t.Name, err)
+			}
+		}
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("UPDATE `%s` SET `deleted_id` = `id` WHERE `deleted_at` IS NOT NULL AND `deleted_id` = 0", t.Name)); err != nil {
+			return fmt.Errorf("%s: %w", t.Name, err)
+		}
+	}
+	return nil
+}
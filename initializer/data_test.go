@@ -0,0 +1,128 @@
+package initializer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/product"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/schema"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3" // SQLite驱动
+)
+
+func openTestDB(t *testing.T) (*ent.Client, *entsql.Driver) {
+	drv, err := entsql.Open(dialect.SQLite, "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { _ = client.Close() })
+	return client, drv
+}
+
+func TestSoftDeleteUniqueIndex(t *testing.T) {
+	ctx := viewer.SystemContext(context.Background())
+	client, _ := openTestDB(t)
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	p := client.Product.Create().SetCode("P1").SetProductName("产品1").SaveX(ctx)
+	newDevice := func() (*ent.Device, error) {
+		now := time.Now()
+		return client.Device.Create().SetSn("SN001").SetProductID(p.ID).SetCreatedAt(now).SetUpdatedAt(now).Save(ctx)
+	}
+
+	first, err := newDevice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newDevice(); !ent.IsConstraintError(err) {
+		t.Fatalf("duplicate live SN: err = %v, want constraint error", err)
+	}
+	if _, err := client.Product.Create().SetCode("P1").SetProductName("产品2").Save(ctx); !ent.IsConstraintError(err) {
+		t.Fatalf("duplicate live product code: err = %v, want constraint error", err)
+	}
+
+	// 移入回收站后SN可以重新使用，但原记录不能再恢复
+	client.Device.UpdateOne(first).SetDeletedAt(time.Now()).ExecX(ctx)
+	deleted := client.Device.Query().Where(device.IDEQ(first.ID)).OnlyX(schema.SkipSoftDelete(ctx))
+	if deleted.DeletedID != first.ID {
+		t.Fatalf("deleted_id = %d, want %d", deleted.DeletedID, first.ID)
+	}
+	if _, err := newDevice(); err != nil {
+		t.Fatalf("reuse SN in recycle bin: %v", err)
+	}
+	if err := client.Device.UpdateOneID(first.ID).ClearDeletedAt().Exec(schema.SkipSoftDelete(ctx)); !ent.IsConstraintError(err) {
+		t.Fatalf("restore over live SN: err = %v, want constraint error", err)
+	}
+
+	// deleted_id只能逐条写入
+	if err := client.Device.Update().SetDeletedAt(time.Now()).Exec(ctx); err == nil {
+		t.Fatal("bulk soft delete accepted")
+	}
+}
+
+func TestBackfillDeletedID(t *testing.T) {
+	ctx := viewer.SystemContext(context.Background())
+	client, drv := openTestDB(t)
+	// 新库还没有表时什么都不做
+	if err := backfillDeletedID(ctx, drv.DB()); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	p := client.Product.Create().SetCode("P1").SetProductName("产品1").SaveX(ctx)
+	now := time.Now()
+	d := client.Device.Create().SetSn("SN001").SetProductID(p.ID).SetCreatedAt(now).SetUpdatedAt(now).SaveX(ctx)
+
+	// 模拟升级前已在回收站中的记录
+	if _, err := drv.DB().ExecContext(ctx, "UPDATE `devices` SET `deleted_at` = ?, `deleted_id` = 0 WHERE `id` = ?", now, d.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := backfillDeletedID(ctx, drv.DB()); err != nil {
+		t.Fatal(err)
+	}
+	got := client.Device.Query().Where(device.IDEQ(d.ID)).OnlyX(schema.SkipSoftDelete(ctx))
+	if got.DeletedID != d.ID {
+		t.Fatalf("deleted_id = %d, want %d", got.DeletedID, d.ID)
+	}
+	if got := client.Product.GetX(ctx, p.ID); got.DeletedID != 0 {
+		t.Fatalf("live product deleted_id = %d, want 0", got.DeletedID)
+	}
+}
+
+// TestReadOnlyDriver 命令行工具的export和plan不能写数据库
+func TestReadOnlyDriver(t *testing.T) {
+	ctx := viewer.SystemContext(context.Background())
+	client, drv := openTestDB(t)
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	client.Product.Create().SetCode("P1").SetProductName("产品1").SaveX(ctx)
+
+	ro := ent.NewClient(ent.Driver(readOnlyDriver{drv}))
+	if n, err := ro.Product.Query().Count(ctx); err != nil || n != 1 {
+		t.Fatalf("read: %d, %v", n, err)
+	}
+	if _, err := ro.Product.Create().SetCode("P2").SetProductName("产品2").Save(ctx); !errors.Is(err, errReadOnly) {
+		t.Errorf("create: err = %v, want read-only", err)
+	}
+	tx, err := ro.Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback()
+	if err := tx.Product.Update().SetProductName("改名").Exec(ctx); !errors.Is(err, errReadOnly) {
+		t.Errorf("update in tx: err = %v, want read-only", err)
+	}
+	if n := client.Product.Query().Where(product.ProductNameEQ("产品1")).CountX(ctx); n != 1 {
+		t.Errorf("product changed through read-only client")
+	}
+}
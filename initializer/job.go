@@ -0,0 +1,25 @@
+package initializer
+
+import (
+	"log"
+
+	"cambridge-hit.com/gin-base/activateserver/app/service"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util"
+)
+
+// jobInit 注册定时任务，多实例部署时各实例都会执行，任务需保证幂等
+func jobInit() {
+	// 每天03:30清理过期的原始心跳
+	if err := util.AddCronJob("0 30 3 * * *", service.PurgeDeviceHeartbeats); err != nil {
+		log.Fatalf("注册定时任务失败: %v", err)
+	}
+	// 每天04:00永久清理超过保留期的回收站记录
+	if err := util.AddCronJob("0 0 4 * * *", service.PurgeRecycleBin); err != nil {
+		log.Fatalf("注册定时任务失败: %v", err)
+	}
+	// 启动异步任务执行协程，每分钟恢复中断的任务并补充队列
+	service.StartJobWorkers()
+	if err := util.AddCronJob("0 * * * * *", service.RequeueJobs); err != nil {
+		log.Fatalf("注册定时任务失败: %v", err)
+	}
+}
@@ -0,0 +1,17 @@
+package initializer
+
+import (
+	"log"
+
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/mail"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+)
+
+// mailInit 按配置选择邮件发送方式，未配置时写日志
+func mailInit() {
+	transport, err := mail.NewTransport(resource.Conf.MailConfig)
+	if err != nil {
+		log.Fatalf("邮件配置错误: %v", err)
+	}
+	mail.Default = transport
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"cambridge-hit.com/gin-base/activateserver/app/router"
+	"cambridge-hit.com/gin-base/activateserver/app/service"
 	_ "cambridge-hit.com/gin-base/activateserver/docs" // swagger
 	"cambridge-hit.com/gin-base/activateserver/initializer"
 	"cambridge-hit.com/gin-base/activateserver/pkg/middleware"
@@ -59,9 +60,15 @@ func setMiddleware(r *gin.Engine) {
 	// 局域网项目跨域
 	r.Use(middleware.Cors())
 
+	// API令牌认证，通过后JWT认证不再检查
+	r.Use(middleware.APITokenAuth(resource.Conf.App.ApiPrefix, service.AuthenticateAPIToken))
+
 	// 注册JWT认证中间件
 	r.Use(middleware.JwtAuth(resource.Conf.App.ApiPrefix))
 
+	// 设置查询身份，按用户可访问的产品过滤数据
+	r.Use(middleware.SetViewer(service.NewTokenViewer))
+
 	// 注册防抖
 	r.Use(middleware.ThrottleMiddleware())
 }
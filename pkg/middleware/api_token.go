@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/req-resp/resp"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"github.com/gin-gonic/gin"
+)
+
+// apiTokenDeniedRouters API令牌不能访问的账号管理类接口，令牌泄露时不能用于创建令牌、修改两步验证或角色
+var apiTokenDeniedRouters = []string{
+	"/api-token",
+	"/two-factor",
+	"/registration-invitation",
+	"/invitation",
+	"/rbac",
+	"/recycle-bin",
+}
+
+// APITokenAuthenticator 校验API令牌，返回令牌代表的用户和授权范围
+type APITokenAuthenticator func(ctx context.Context, token, ip string) (*auth.UserAuthInfo, *auth.TokenGrant, resource.RspCode)
+
+// APITokenAuth API令牌认证，Authorization为act_开头的令牌时设置用户信息和授权范围，在JWT认证之前注册
+func APITokenAuth(prefix string, authenticate APITokenAuthenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := auth.BearerToken(c)
+		if !auth.IsAPIToken(token) {
+			c.Next()
+			return
+		}
+
+		path, _ := strings.CutPrefix(c.Request.URL.Path, prefix)
+		for _, v := range apiTokenDeniedRouters {
+			if strings.HasPrefix(path, v) {
+				resp.Error(c, resource.ERR_API_TOKEN_FORBIDDEN)
+				c.Abort()
+				return
+			}
+		}
+
+		uai, grant, code := authenticate(c, token, c.ClientIP())
+		if code != resource.CODE_SUCCESS {
+			resp.Error(c, code)
+			c.Abort()
+			return
+		}
+		c.Set("userInfo", *uai)
+		auth.SetTokenGrant(c, grant)
+		c.Next()
+	}
+}
@@ -15,7 +15,8 @@ import (
 var noAuthRouters = []string{
 	"/base",
 	"/device/activation-file",
-	"/ws", // WebSocket 连接端点（可选认证）
+	"/device/heartbeat", // 设备心跳使用设备签名认证
+	"/ws",               // WebSocket 连接端点（可选认证）
 }
 
 // JwtAuth jwt认证
@@ -38,6 +39,12 @@ func JwtAuth(prefix string) gin.HandlerFunc {
 			}
 		}
 
+		// 已通过API令牌认证
+		if _, exist := context.Get("userInfo"); exist {
+			context.Next()
+			return
+		}
+
 		// 从 Authorization 请求头中获取 Bearer Token
 		authHeader := context.GetHeader("Authorization")
 		if authHeader == "" {
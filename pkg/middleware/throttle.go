@@ -15,22 +15,33 @@ var (
 	mu       sync.Mutex
 )
 
-// needThrottle 需要节流的路由及其限制规则
+// needThrottle 需要节流的路由及其限制规则，路由不含接口前缀
 var needThrottle = map[string]struct {
 	seconds  int // 秒数
 	requests int // 每秒请求次数
 	burst    int // 突发大小
 }{
-	"/dental/base/sendActivationEmail": {20, 1, 1}, // 每60秒最多1次请求, 突发大小为1(即2次)
+	"/base/sendActivationEmail":     {20, 1, 1}, // 每20秒最多1次请求, 突发大小为1
+	"/base/sendResetPasswordEmail":  {20, 1, 1},
+	"/base/validateActivationEmail": {10, 1, 3},
+	"/base/resetPassword":           {10, 1, 3},
+	"/base/registerByInvitation":    {10, 1, 3},
+	"/base/verifyTwoFactor":         {10, 1, 5},
+	"/base/enrollTwoFactor":         {10, 1, 3},
+	"/base/activateTwoFactor":       {10, 1, 5},
+	"/base/oidc/authorize":          {10, 1, 5},
+	"/base/oidc/login":              {10, 1, 5},
+	"/two-factor/activate":          {10, 1, 5},
+	"/two-factor/disable":           {10, 1, 5},
+	"/two-factor/recovery-codes":    {10, 1, 5},
 }
 
 func ThrottleMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		apiPrefix := "/dental"
 		ip := c.ClientIP()
-		path := c.Request.URL.Path
 		// 前端页面不管
-		if !strings.HasPrefix(path, apiPrefix) {
+		path, ok := strings.CutPrefix(c.Request.URL.Path, resource.Conf.App.ApiPrefix)
+		if !ok {
 			c.Next()
 			return
 		}
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// ViewerFactory 为登录用户构造查询身份，grant不为nil表示通过API令牌认证
+type ViewerFactory func(userID int, grant *auth.TokenGrant) *viewer.Viewer
+
+// SetViewer 为已登录的请求设置查询身份，ent查询自动按其可访问的产品过滤，在JWT认证之后注册。
+// 未登录的请求不设置查询身份，按产品隔离的查询由隐私策略拒绝
+func SetViewer(newViewer ViewerFactory) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if uai := auth.GetUserAuthInfo(c); uai.UserID > 0 {
+			bindViewer(c, newViewer(uai.UserID, auth.GetTokenGrant(c)))
+		}
+		c.Next()
+	}
+}
+
+// SystemViewer 设备签名认证的公开接口没有登录用户，使用系统身份，只注册在这些路由上
+func SystemViewer() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		bindViewer(c, viewer.System())
+		c.Next()
+	}
+}
+
+// bindViewer 同时写入gin.Context和请求上下文，服务中两种上下文都会用于查询
+func bindViewer(c *gin.Context, v *viewer.Viewer) {
+	c.Set(viewer.ContextKey, v)
+	c.Request = c.Request.WithContext(viewer.NewContext(c.Request.Context(), v))
+}
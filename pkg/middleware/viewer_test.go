@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/device"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/enttest"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/ent/privacy"
+	"cambridge-hit.com/gin-base/activateserver/app/entity/viewer"
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/auth"
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3" // SQLite驱动
+)
+
+func TestSetViewer(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	client := enttest.Open(t, dialect.SQLite, "file:middleware?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	ctx := viewer.SystemContext(context.Background())
+	now := time.Now()
+	var products []*ent.Product
+	for _, code := range []string{"1", "2"} {
+		p := client.Product.Create().SetCode("P" + code).SetProductName("P" + code).SaveX(ctx)
+		client.Device.Create().SetSn("SN" + code).SetProductID(p.ID).SetCreatedAt(now).SetUpdatedAt(now).SaveX(ctx)
+		products = append(products, p)
+	}
+
+	// 登录用户只能访问第一个产品
+	var grants []*auth.TokenGrant
+	newViewer := func(userID int, grant *auth.TokenGrant) *viewer.Viewer {
+		grants = append(grants, grant)
+		return viewer.ForUser(userID, func(context.Context) ([]int, bool, error) {
+			return []int{products[0].ID}, false, nil
+		})
+	}
+	listDevices := func(c *gin.Context) {
+		sns, err := client.Device.Query().Select(device.FieldSn).Strings(c)
+		switch {
+		case errors.Is(err, privacy.Deny):
+			c.String(http.StatusForbidden, err.Error())
+		case err != nil:
+			c.String(http.StatusInternalServerError, err.Error())
+		default:
+			sort.Strings(sns)
+			c.String(http.StatusOK, strings.Join(sns, ","))
+		}
+	}
+
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		// 模拟JWT认证
+		if c.GetHeader("Authorization") != "" {
+			c.Set("userInfo", auth.UserAuthInfo{UserID: 7})
+		}
+	}, SetViewer(newViewer))
+	r.GET("/devices", listDevices)
+	r.GET("/public", SystemViewer(), listDevices)
+
+	get := func(path string, login bool) (int, string) {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if login {
+			req.Header.Set("Authorization", "Bearer test")
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code, w.Body.String()
+	}
+
+	// 未登录的请求查询按产品隔离的表被拒绝，而不是返回空结果
+	if code, body := get("/devices", false); code != http.StatusForbidden {
+		t.Errorf("anonymous query: %d %q, want denied", code, body)
+	}
+	if code, body := get("/devices", true); code != http.StatusOK || body != "SN1" {
+		t.Errorf("user query: %d %q, want SN1", code, body)
+	}
+	if len(grants) != 1 || grants[0] != nil {
+		t.Errorf("viewer factory called with %v, want one JWT login", grants)
+	}
+	// 公开的设备接口显式使用系统身份
+	if code, body := get("/public", false); code != http.StatusOK || body != "SN1,SN2" {
+		t.Errorf("system query: %d %q, want SN1,SN2", code, body)
+	}
+}
@@ -44,11 +44,55 @@ func (c *RedisCache) Get(key string) (interface{}, error) {
 	return c.client.Get(c.ctx, key).Result()
 }
 
+// MGet 批量获取多个键的值，不存在的键对应nil
+func (c *RedisCache) MGet(keys ...string) ([]interface{}, error) {
+	return c.client.MGet(c.ctx, keys...).Result()
+}
+
+// SetNX 键不存在时设置，返回是否设置成功，用于限制操作频率
+func (c *RedisCache) SetNX(key string, value interface{}, expire time.Duration) (bool, error) {
+	return c.client.SetNX(c.ctx, key, value, expire).Result()
+}
+
+// GetDel 在一个事务中获取并删除键，用于一次性令牌，键不存在时返回redis.Nil
+func (c *RedisCache) GetDel(key string) (string, error) {
+	var get *redis.StringCmd
+	_, err := c.client.TxPipelined(c.ctx, func(p redis.Pipeliner) error {
+		get = p.Get(c.ctx, key)
+		p.Del(c.ctx, key)
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return "", err
+	}
+	return get.Result()
+}
+
+// Incr 计数加一并返回新值，第一次计数时设置过期时间，用于限制失败次数
+func (c *RedisCache) Incr(key string, expire time.Duration) (int64, error) {
+	n, err := c.client.Incr(c.ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if n == 1 {
+		err = c.client.Expire(c.ctx, key, expire).Err()
+	}
+	return n, err
+}
+
 // Del 实现 Cache 接口中的 Del 方法
 func (c *RedisCache) Del(key string) error {
 	return c.client.Del(c.ctx, key).Err()
 }
 
+// DelMany 删除多个键
+func (c *RedisCache) DelMany(keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return c.client.Del(c.ctx, keys...).Err()
+}
+
 // DelByPrefix 实现 Cache 接口中的 DelByPrefix 方法
 func (c *RedisCache) DelByPrefix(prefix string) error {
 	keys, err := c.client.Keys(c.ctx, prefix+"*").Result()
@@ -12,3 +12,9 @@ func init() {
 
 	context.Start()
 }
+
+// AddCronJob 注册定时任务，spec 包含秒字段，如 "0 30 3 * * *"
+func AddCronJob(spec string, cmd func()) error {
+	_, err := context.AddFunc(spec, cmd)
+	return err
+}
@@ -0,0 +1,192 @@
+// Package mail 邮件发送，通过Transport接口支持SMTP、写文件和写日志，开发和测试环境无需邮件服务器
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/pkg/util/logger"
+	"cambridge-hit.com/gin-base/activateserver/resource"
+	"go.uber.org/zap"
+)
+
+// Message 邮件内容，正文为纯文本
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Transport 邮件发送方式
+type Transport interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Default 默认发送方式，由initializer按配置设置
+var Default Transport = LogTransport{}
+
+// Send 使用默认发送方式发送邮件
+func Send(ctx context.Context, msg Message) error {
+	return Default.Send(ctx, msg)
+}
+
+// NewTransport 按配置创建发送方式，未配置时写日志
+func NewTransport(conf *resource.MailConfig) (Transport, error) {
+	if conf == nil {
+		return LogTransport{}, nil
+	}
+	switch conf.Transport {
+	case "", "log":
+		return LogTransport{}, nil
+	case "file":
+		if conf.Dir == "" {
+			return nil, fmt.Errorf("mail: dir is required for file transport")
+		}
+		return FileTransport{Dir: conf.Dir, From: conf.From}, nil
+	case "smtp":
+		if conf.Host == "" || conf.From == "" {
+			return nil, fmt.Errorf("mail: host and from are required for smtp transport")
+		}
+		return &SMTPTransport{
+			Host:     conf.Host,
+			Port:     conf.Port,
+			Username: conf.Username,
+			Password: conf.Password,
+			From:     conf.From,
+		}, nil
+	default:
+		return nil, fmt.Errorf("mail: unknown transport %q", conf.Transport)
+	}
+}
+
+// Build 生成RFC 5322格式的邮件
+func Build(from string, msg Message, date time.Time) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(msg.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n"))
+	return b.Bytes()
+}
+
+// SMTPTransport 通过SMTP服务器发送，465端口使用TLS连接，其他端口在服务器支持时使用STARTTLS
+type SMTPTransport struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	Timeout  time.Duration // 连接超时，默认10秒
+}
+
+// Send 发送邮件
+func (t *SMTPTransport) Send(ctx context.Context, msg Message) error {
+	port := t.Port
+	if port == 0 {
+		port = 587
+	}
+	timeout := t.Timeout
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	addr := net.JoinHostPort(t.Host, strconv.Itoa(port))
+	dialer := &net.Dialer{Timeout: timeout}
+
+	var conn net.Conn
+	var err error
+	if port == 465 {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: t.Host}}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	} else {
+		_ = conn.SetDeadline(time.Now().Add(timeout * 3))
+	}
+
+	c, err := smtp.NewClient(conn, t.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if port != 465 {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(&tls.Config{ServerName: t.Host}); err != nil {
+				return err
+			}
+		}
+	}
+	if t.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", t.Username, t.Password, t.Host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(t.From); err != nil {
+		return err
+	}
+	for _, to := range msg.To {
+		if err := c.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(Build(t.From, msg, time.Now())); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// FileTransport 将邮件保存为目录中的.eml文件，用于开发和测试
+type FileTransport struct {
+	Dir  string
+	From string
+}
+
+// Send 保存邮件
+func (t FileTransport) Send(_ context.Context, msg Message) error {
+	if err := os.MkdirAll(t.Dir, 0755); err != nil {
+		return err
+	}
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+	now := time.Now()
+	name := fmt.Sprintf("%s-%s.eml", now.Format("20060102T150405.000000000"), hex.EncodeToString(suffix))
+	return os.WriteFile(filepath.Join(t.Dir, name), Build(t.From, msg, now), 0600)
+}
+
+// LogTransport 将邮件写入日志，不实际发送
+type LogTransport struct{}
+
+// Send 记录邮件
+func (LogTransport) Send(_ context.Context, msg Message) error {
+	logger.Info("mail", zap.Strings("to", msg.To), zap.String("subject", msg.Subject), zap.String("body", msg.Body))
+	return nil
+}
@@ -0,0 +1,136 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"cambridge-hit.com/gin-base/activateserver/resource"
+)
+
+func TestBuild(t *testing.T) {
+	date := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+	raw := string(Build("noreply@example.com", Message{
+		To:      []string{"a@example.com", "b@example.com"},
+		Subject: "验证邮箱",
+		Body:    "line1\nline2",
+	}, date))
+	for _, want := range []string{
+		"From: noreply@example.com\r\n",
+		"To: a@example.com, b@example.com\r\n",
+		"Subject: =?utf-8?q?",
+		"Date: Wed, 01 May 2024 08:00:00 +0000\r\n",
+		"\r\n\r\nline1\r\nline2",
+	} {
+		if !strings.Contains(raw, want) {
+			t.Errorf("message missing %q:\n%s", want, raw)
+		}
+	}
+}
+
+func TestNewTransport(t *testing.T) {
+	if tr, err := NewTransport(nil); err != nil || tr != (LogTransport{}) {
+		t.Errorf("nil config = %v, %v", tr, err)
+	}
+	if _, err := NewTransport(&resource.MailConfig{Transport: "file"}); err == nil {
+		t.Error("file transport without dir accepted")
+	}
+	if _, err := NewTransport(&resource.MailConfig{Transport: "smtp", Host: "localhost"}); err == nil {
+		t.Error("smtp transport without from accepted")
+	}
+	if _, err := NewTransport(&resource.MailConfig{Transport: "pigeon"}); err == nil {
+		t.Error("unknown transport accepted")
+	}
+}
+
+func TestFileTransport(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mail")
+	tr := FileTransport{Dir: dir, From: "noreply@example.com"}
+	if err := tr.Send(context.Background(), Message{To: []string{"a@example.com"}, Subject: "hi", Body: "token=abc"}); err != nil {
+		t.Fatal(err)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*.eml"))
+	if len(files) != 1 {
+		t.Fatalf("files = %v", files)
+	}
+	data, _ := os.ReadFile(files[0])
+	if !strings.Contains(string(data), "token=abc") {
+		t.Errorf("unexpected file content:\n%s", data)
+	}
+}
+
+// fakeSMTP 最小的SMTP服务端，记录收到的邮件内容
+func fakeSMTP(t *testing.T) (addr string, received chan string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	received = make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		reply := func(s string) { conn.Write([]byte(s + "\r\n")) }
+		reply("220 fake ESMTP")
+		var data strings.Builder
+		inData := false
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			if inData {
+				if line == ".\r\n" {
+					inData = false
+					received <- data.String()
+					reply("250 queued")
+					continue
+				}
+				data.WriteString(line)
+				continue
+			}
+			switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 fake")
+			case cmd == "DATA":
+				inData = true
+				reply("354 go ahead")
+			case cmd == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+	return ln.Addr().String(), received
+}
+
+func TestSMTPTransport(t *testing.T) {
+	addr, received := fakeSMTP(t)
+	host, port, _ := net.SplitHostPort(addr)
+	p, _ := strconv.Atoi(port)
+	tr := &SMTPTransport{Host: host, Port: p, From: "noreply@example.com", Timeout: time.Second}
+	err := tr.Send(context.Background(), Message{To: []string{"a@example.com"}, Subject: "reset", Body: "token=xyz"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case data := <-received:
+		if !strings.Contains(data, "token=xyz") || !strings.Contains(data, "To: a@example.com") {
+			t.Errorf("unexpected data:\n%s", data)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no message received")
+	}
+}
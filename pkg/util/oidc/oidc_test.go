@@ -0,0 +1,283 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// mockIssuer 本地的身份提供方，授权码只能使用一次且必须提供匹配的code_verifier
+type mockIssuer struct {
+	t      *testing.T
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	kid    string
+
+	mu         sync.Mutex
+	codes      map[string]mockGrant
+	keyFetches int
+}
+
+type mockGrant struct {
+	challenge string
+	claims    jwt.MapClaims
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &mockIssuer{t: t, key: key, kid: "k1", codes: map[string]mockGrant{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"issuer":                           m.server.URL,
+			"authorization_endpoint":           m.server.URL + "/authorize",
+			"token_endpoint":                   m.server.URL + "/token",
+			"jwks_uri":                         m.server.URL + "/jwks",
+			"code_challenge_methods_supported": []string{"S256"},
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		m.keyFetches++
+		kid, pub := m.kid, m.key.PublicKey
+		m.mu.Unlock()
+		writeJSON(w, http.StatusOK, map[string]interface{}{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "client" || secret != "secret" {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+			return
+		}
+		m.mu.Lock()
+		grant, ok := m.codes[r.PostFormValue("code")]
+		delete(m.codes, r.PostFormValue("code"))
+		m.mu.Unlock()
+		if !ok || r.PostFormValue("grant_type") != "authorization_code" || S256Challenge(r.PostFormValue("code_verifier")) != grant.challenge {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"access_token": "at",
+			"token_type":   "Bearer",
+			"id_token":     m.sign(grant.claims),
+		})
+	})
+	m.server = httptest.NewServer(mux)
+	t.Cleanup(m.server.Close)
+	return m
+}
+
+// authorize 模拟用户在身份提供方登录，返回授权码
+func (m *mockIssuer) authorize(authURL string, claims jwt.MapClaims) (code, state string) {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	q := u.Query()
+	if q.Get("code_challenge_method") != "S256" || q.Get("client_id") != "client" || !strings.Contains(q.Get("scope"), "openid") {
+		m.t.Fatalf("unexpected authorization request %s", authURL)
+	}
+	base := jwt.MapClaims{
+		"iss":   m.server.URL,
+		"aud":   "client",
+		"sub":   "user-1",
+		"nonce": q.Get("nonce"),
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Minute).Unix(),
+	}
+	for k, v := range claims {
+		base[k] = v
+	}
+	m.mu.Lock()
+	code = "code-" + q.Get("state")
+	m.codes[code] = mockGrant{challenge: q.Get("code_challenge"), claims: base}
+	m.mu.Unlock()
+	return code, q.Get("state")
+}
+
+func (m *mockIssuer) sign(claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	m.mu.Lock()
+	token.Header["kid"] = m.kid
+	key := m.key
+	m.mu.Unlock()
+	s, err := token.SignedString(key)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	return s
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func newTestProvider(t *testing.T, m *mockIssuer) *Provider {
+	p, err := Discover(context.Background(), Config{
+		Issuer:       m.server.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "http://app.local/callback",
+		Scopes:       []string{"email", "groups"},
+	}, m.server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// login 完整的授权码流程，返回校验后的声明
+func login(t *testing.T, m *mockIssuer, p *Provider, claims jwt.MapClaims) (*Claims, error) {
+	state, _ := NewRandom()
+	nonce, _ := NewRandom()
+	verifier, _ := NewRandom()
+	code, gotState := m.authorize(p.AuthCodeURL(state, nonce, verifier), claims)
+	if gotState != state {
+		t.Fatalf("state = %q, want %q", gotState, state)
+	}
+	token, err := p.Exchange(context.Background(), code, verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p.Verify(context.Background(), token.IDToken, nonce)
+}
+
+func TestLogin(t *testing.T) {
+	m := newMockIssuer(t)
+	p := newTestProvider(t, m)
+
+	claims, err := login(t, m, p, jwt.MapClaims{
+		"email":          "alice@example.com",
+		"email_verified": "true",
+		"groups":         []string{"admins", "firmware"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.Subject != "user-1" || claims.Email != "alice@example.com" || !claims.EmailVerified {
+		t.Errorf("unexpected claims %+v", claims)
+	}
+	if got := claims.Strings("groups"); len(got) != 2 || got[0] != "admins" || got[1] != "firmware" {
+		t.Errorf("groups = %v", got)
+	}
+	if got := claims.Strings("missing"); got != nil {
+		t.Errorf("missing claim = %v", got)
+	}
+}
+
+func TestLoginRejected(t *testing.T) {
+	m := newMockIssuer(t)
+	p := newTestProvider(t, m)
+
+	tests := map[string]jwt.MapClaims{
+		"issuer":   {"iss": "https://evil.example.com"},
+		"audience": {"aud": "other"},
+		"azp":      {"aud": []string{"client", "other"}, "azp": "other"},
+		"expired":  {"exp": time.Now().Add(-2 * clockSkew).Unix()},
+		"future":   {"iat": time.Now().Add(2 * clockSkew).Unix()},
+		"nonce":    {"nonce": "replayed"},
+		"subject":  {"sub": ""},
+	}
+	for name, claims := range tests {
+		if _, err := login(t, m, p, claims); err == nil {
+			t.Errorf("%s: id token accepted", name)
+		}
+	}
+
+	// 多个audience且authorized party是本客户端时接受
+	if _, err := login(t, m, p, jwt.MapClaims{"aud": []string{"client", "other"}, "azp": "client"}); err != nil {
+		t.Errorf("azp: %v", err)
+	}
+}
+
+func TestExchangeRequiresVerifier(t *testing.T) {
+	m := newMockIssuer(t)
+	p := newTestProvider(t, m)
+
+	verifier, _ := NewRandom()
+	code, _ := m.authorize(p.AuthCodeURL("s", "n", verifier), nil)
+	if _, err := p.Exchange(context.Background(), code, verifier+"x"); err == nil || !strings.Contains(err.Error(), "invalid_grant") {
+		t.Errorf("exchange with wrong verifier: %v", err)
+	}
+	// 授权码只能使用一次
+	if _, err := p.Exchange(context.Background(), code, verifier); err == nil {
+		t.Error("authorization code reused")
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	m := newMockIssuer(t)
+	p := newTestProvider(t, m)
+	if _, err := login(t, m, p, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// 新公钥在刷新间隔内不会重新获取，超过间隔后按新的kid获取
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.mu.Lock()
+	m.key, m.kid = key, "k2"
+	m.mu.Unlock()
+	if _, err := login(t, m, p, nil); err == nil {
+		t.Error("unknown key accepted before refresh interval")
+	}
+	p.mu.Lock()
+	p.keysAt = time.Now().Add(-keysRefreshInterval)
+	p.mu.Unlock()
+	if _, err := login(t, m, p, nil); err != nil {
+		t.Errorf("rotated key: %v", err)
+	}
+	if m.keyFetches != 2 {
+		t.Errorf("keys fetched %d times, want 2", m.keyFetches)
+	}
+}
+
+func TestDiscoverIssuerMismatch(t *testing.T) {
+	m := newMockIssuer(t)
+	// 返回其他issuer的discovery文档
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{
+			"issuer":                 m.server.URL,
+			"authorization_endpoint": m.server.URL + "/authorize",
+			"token_endpoint":         m.server.URL + "/token",
+			"jwks_uri":               m.server.URL + "/jwks",
+		})
+	}))
+	defer other.Close()
+	if _, err := Discover(context.Background(), Config{Issuer: other.URL, ClientID: "client"}, nil); err == nil {
+		t.Error("discovery document of another issuer accepted")
+	}
+}
+
+func TestS256Challenge(t *testing.T) {
+	// RFC 7636 附录B的示例
+	if got := S256Challenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"); got != "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM" {
+		t.Errorf("S256Challenge = %s", got)
+	}
+}
@@ -11,8 +11,6 @@ import (
 	"strings"
 )
 
-const InvitationCryptKey = "iEA8wIl8J6vA5OVWrSt6UNiXVLaLOykH5YQKNI"
-
 // generateKey 生成一个基于密码和盐的 AES 密钥
 func generateKey(salt string) []byte {
 	key := sha256.Sum256([]byte(salt))
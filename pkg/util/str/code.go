@@ -2,6 +2,8 @@ package str
 
 import (
 	"math/rand"
+	"strconv"
+	"strings"
 	"time"
 
 	"cambridge-hit.com/gin-base/activateserver/pkg/util/cache"
@@ -58,3 +60,38 @@ func SetEmailActivateSuccess(code string, email string) {
 func GetEmailActivateSuccessKey(code string) string {
 	return "Email_Activated_" + code
 }
+
+// GetPasswordResetKey 密码重置令牌的key，值为用户ID
+func GetPasswordResetKey(code string) string {
+	return "Password_Reset:" + code
+}
+
+// GetAccountTokenUserKey 用户当前有效的邮箱验证或密码重置令牌，重新发送时使旧令牌失效
+func GetAccountTokenUserKey(purpose string, userID int) string {
+	return "Account_Token_User:" + purpose + ":" + strconv.Itoa(userID)
+}
+
+// GetPreAuthKey 登录两步验证的临时令牌的key，值为用户ID
+func GetPreAuthKey(code string) string {
+	return "Pre_Auth:" + code
+}
+
+// GetPreAuthFailKey 临时令牌的验证失败次数
+func GetPreAuthFailKey(code string) string {
+	return "Pre_Auth_Fail:" + code
+}
+
+// GetTwoFactorEnrollKey 开启两步验证时待确认的密钥
+func GetTwoFactorEnrollKey(userID int) string {
+	return "Two_Factor_Enroll:" + strconv.Itoa(userID)
+}
+
+// GetMailThrottleKey 同一邮箱发送邮件的频率限制
+func GetMailThrottleKey(purpose, email string) string {
+	return "Mail_Throttle:" + purpose + ":" + strings.ToLower(email)
+}
+
+// GetOIDCStateKey 单点登录的state，值为nonce和PKCE code_verifier
+func GetOIDCStateKey(state string) string {
+	return "OIDC_State:" + state
+}
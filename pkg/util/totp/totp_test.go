@@ -0,0 +1,109 @@
+package totp
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image/png"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret RFC 6238附录B中SHA1测试用的密钥"12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestHOTPVectors(t *testing.T) {
+	key := []byte("12345678901234567890")
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+	for _, tt := range tests {
+		if got := hotp(key, uint64(tt.unix/Period), 8); got != tt.want {
+			t.Errorf("T=%d: got %s, want %s", tt.unix, got, tt.want)
+		}
+		got, err := Code(rfcSecret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want[2:] {
+			t.Errorf("T=%d: 6-digit code %s, want %s", tt.unix, got, tt.want[2:])
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	code, _ := Code(rfcSecret, now.Add(-Period*time.Second))
+
+	if step, ok := Validate(rfcSecret, code, now, 1); !ok || step != Step(now)-1 {
+		t.Errorf("previous step: step = %d, ok = %v", step, ok)
+	}
+	if _, ok := Validate(rfcSecret, code, now, 0); ok {
+		t.Error("previous step accepted without skew")
+	}
+	// 密钥大小写和空格不影响校验
+	if _, ok := Validate(strings.ToLower(rfcSecret[:8])+" "+rfcSecret[8:], code, now, 1); !ok {
+		t.Error("normalized secret rejected")
+	}
+	for _, bad := range []string{"", "12345", "1234567", "abcdef"} {
+		if _, ok := Validate(rfcSecret, bad, now, 1); ok {
+			t.Errorf("code %q accepted", bad)
+		}
+	}
+	if _, ok := Validate("not base32!", code, now, 1); ok {
+		t.Error("invalid secret accepted")
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	a, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := GenerateSecret()
+	if a == b || len(a) != 32 {
+		t.Errorf("secrets = %q, %q", a, b)
+	}
+	if _, err := Code(a, time.Now()); err != nil {
+		t.Errorf("generated secret not decodable: %v", err)
+	}
+}
+
+func TestURIAndQRCode(t *testing.T) {
+	uri := URI("Activate Server", "a@example.com", rfcSecret)
+	u, err := url.Parse(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/Activate Server:a@example.com" {
+		t.Errorf("uri = %s", uri)
+	}
+	if q := u.Query(); q.Get("secret") != rfcSecret || q.Get("issuer") != "Activate Server" || q.Get("digits") != "6" {
+		t.Errorf("query = %v", q)
+	}
+
+	img, err := QRCode(uri, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, ok := strings.CutPrefix(img, "data:image/png;base64,")
+	if !ok {
+		t.Fatalf("image = %.40s", img)
+	}
+	raw, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := png.Decode(bytes.NewReader(raw)); err != nil {
+		t.Errorf("not a png: %v", err)
+	}
+}
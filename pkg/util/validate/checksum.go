@@ -0,0 +1,86 @@
+package validate
+
+import (
+	"errors"
+	"strings"
+)
+
+// 校验位算法名称，与序列号规则中的check_algorithm取值一致
+const (
+	CheckNone  = "none"
+	CheckLuhn  = "luhn"
+	CheckMod37 = "mod37"
+)
+
+// mod37Charset ISO/IEC 7064 MOD 37-2 使用的字符集，下标即字符的数值
+const mod37Charset = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ*"
+
+var ErrUnsupportedChar = errors.New("unsupported character for check digit")
+
+// LuhnCheckDigit 计算payload的Luhn校验位，payload只能包含数字
+func LuhnCheckDigit(payload string) (byte, error) {
+	if payload == "" {
+		return 0, ErrUnsupportedChar
+	}
+	sum := 0
+	double := true // 从右往左，紧挨校验位的数字需要乘2
+	for i := len(payload) - 1; i >= 0; i-- {
+		ch := payload[i]
+		if ch < '0' || ch > '9' {
+			return 0, ErrUnsupportedChar
+		}
+		d := int(ch - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return byte('0' + (10-sum%10)%10), nil
+}
+
+// Mod37CheckChar 计算payload的ISO/IEC 7064 MOD 37-2校验字符，payload只能包含数字和字母
+func Mod37CheckChar(payload string) (byte, error) {
+	if payload == "" {
+		return 0, ErrUnsupportedChar
+	}
+	p := 0
+	for _, ch := range strings.ToUpper(payload) {
+		v := strings.IndexRune(mod37Charset[:36], ch)
+		if v < 0 {
+			return 0, ErrUnsupportedChar
+		}
+		p = ((p + v) * 2) % 37
+	}
+	return mod37Charset[(38-p)%37], nil
+}
+
+// CheckDigit 按算法计算payload的校验位
+func CheckDigit(algorithm, payload string) (byte, error) {
+	switch algorithm {
+	case CheckLuhn:
+		return LuhnCheckDigit(payload)
+	case CheckMod37:
+		return Mod37CheckChar(payload)
+	}
+	return 0, errors.New("unknown check algorithm: " + algorithm)
+}
+
+// VerifyCheckDigit 校验s的最后一位是否为其余部分按algorithm算出的校验位
+// algorithm为空或none时不做校验
+func VerifyCheckDigit(algorithm, s string) bool {
+	if algorithm == "" || algorithm == CheckNone {
+		return true
+	}
+	if len(s) < 2 {
+		return false
+	}
+	want, err := CheckDigit(algorithm, s[:len(s)-1])
+	if err != nil {
+		return false
+	}
+	return strings.ToUpper(s[len(s)-1:])[0] == want
+}
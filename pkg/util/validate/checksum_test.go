@@ -0,0 +1,53 @@
+package validate
+
+import "testing"
+
+func TestLuhnCheckDigit(t *testing.T) {
+	cases := map[string]byte{
+		"7992739871":      '3',
+		"411111111111111": '1',
+		"0":               '0',
+	}
+	for payload, want := range cases {
+		got, err := LuhnCheckDigit(payload)
+		if err != nil || got != want {
+			t.Errorf("LuhnCheckDigit(%q) = %q, %v; want %q", payload, got, err, want)
+		}
+	}
+	if _, err := LuhnCheckDigit("12A4"); err == nil {
+		t.Error("LuhnCheckDigit should reject non-digit payload")
+	}
+}
+
+func TestMod37CheckChar(t *testing.T) {
+	// ISO/IEC 7064 附录中的示例
+	got, err := Mod37CheckChar("G123489654321")
+	if err != nil || got != 'Y' {
+		t.Errorf("Mod37CheckChar = %q, %v; want 'Y'", got, err)
+	}
+	if _, err := Mod37CheckChar("AB-12"); err == nil {
+		t.Error("Mod37CheckChar should reject '-'")
+	}
+}
+
+func TestVerifyCheckDigit(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		s         string
+		want      bool
+	}{
+		{CheckNone, "anything", true},
+		{"", "anything", true},
+		{CheckLuhn, "79927398713", true},
+		{CheckLuhn, "79927398710", false},
+		{CheckMod37, "G123489654321Y", true},
+		{CheckMod37, "g123489654321y", true},
+		{CheckMod37, "G123489654321X", false},
+		{CheckLuhn, "7", false},
+	}
+	for _, tt := range tests {
+		if got := VerifyCheckDigit(tt.algorithm, tt.s); got != tt.want {
+			t.Errorf("VerifyCheckDigit(%q, %q) = %v; want %v", tt.algorithm, tt.s, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,312 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/mail"
+	"regexp"
+	"sort"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// 属性Schema支持的类型，属性值只能是标量，便于按属性筛选和导出
+const (
+	SchemaTypeString  = "string"
+	SchemaTypeInteger = "integer"
+	SchemaTypeNumber  = "number"
+	SchemaTypeBoolean = "boolean"
+)
+
+// attrNamePattern 属性名只允许字母、数字和下划线，可以直接用作JSON路径和导出列名
+var attrNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,63}$`)
+
+// AttributeSchema JSON Schema的子集：根节点为object，properties中的属性为标量，
+// 支持required、additionalProperties、enum、minLength、maxLength、pattern、format、
+// minimum、maximum、exclusiveMinimum、exclusiveMaximum，其它关键字忽略
+type AttributeSchema struct {
+	Type                 string                        `json:"type"`
+	Properties           map[string]*AttributeProperty `json:"properties"`
+	Required             []string                      `json:"required"`
+	AdditionalProperties *bool                         `json:"additionalProperties"`
+}
+
+// AttributeProperty 单个属性的约束
+type AttributeProperty struct {
+	Type             string        `json:"type"`
+	Title            string        `json:"title"`
+	Enum             []interface{} `json:"enum"`
+	MinLength        *int          `json:"minLength"`
+	MaxLength        *int          `json:"maxLength"`
+	Pattern          string        `json:"pattern"`
+	Format           string        `json:"format"` // 支持ipv4、ipv6、email、date、date-time、mac，其它格式忽略
+	Minimum          *float64      `json:"minimum"`
+	Maximum          *float64      `json:"maximum"`
+	ExclusiveMinimum *float64      `json:"exclusiveMinimum"`
+	ExclusiveMaximum *float64      `json:"exclusiveMaximum"`
+
+	pattern *regexp.Regexp
+}
+
+// OpenAttributeSchema 未定义Schema的产品使用的默认约束：任意合法名称的标量属性
+func OpenAttributeSchema() *AttributeSchema {
+	return &AttributeSchema{Type: "object"}
+}
+
+// ParseAttributeSchema 解析并检查属性Schema
+func ParseAttributeSchema(raw []byte) (*AttributeSchema, error) {
+	var s AttributeSchema
+	if err := jsoniter.Unmarshal(raw, &s); err != nil {
+		return nil, err
+	}
+	if s.Type != "object" {
+		return nil, errors.New(`schema type must be "object"`)
+	}
+	for name, p := range s.Properties {
+		if !attrNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("invalid property name %q", name)
+		}
+		if p == nil {
+			return nil, fmt.Errorf("property %q: empty definition", name)
+		}
+		switch p.Type {
+		case SchemaTypeString, SchemaTypeInteger, SchemaTypeNumber, SchemaTypeBoolean:
+		default:
+			return nil, fmt.Errorf("property %q: unsupported type %q", name, p.Type)
+		}
+		if p.Pattern != "" {
+			re, err := regexp.Compile(p.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("property %q: invalid pattern: %v", name, err)
+			}
+			p.pattern = re
+		}
+		for _, v := range p.Enum {
+			if err := p.checkType(v); err != nil {
+				return nil, fmt.Errorf("property %q: invalid enum value: %v", name, err)
+			}
+		}
+	}
+	for _, name := range s.Required {
+		if _, ok := s.Properties[name]; !ok {
+			return nil, fmt.Errorf("required property %q is not defined", name)
+		}
+	}
+	return &s, nil
+}
+
+// Names 按名称排序的属性列表
+func (s *AttributeSchema) Names() []string {
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate 校验设备属性，返回第一个不满足约束的错误
+func (s *AttributeSchema) Validate(attrs map[string]interface{}) error {
+	for _, name := range s.Required {
+		if v, ok := attrs[name]; !ok || v == nil {
+			return fmt.Errorf("%s: required", name)
+		}
+	}
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p, ok := s.Properties[name]
+		if !ok {
+			if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				return fmt.Errorf("%s: not allowed", name)
+			}
+			if !attrNamePattern.MatchString(name) {
+				return fmt.Errorf("%s: invalid attribute name", name)
+			}
+			if !isScalar(attrs[name]) {
+				return fmt.Errorf("%s: must be a scalar value", name)
+			}
+			continue
+		}
+		if attrs[name] == nil && !contains(s.Required, name) {
+			continue
+		}
+		if err := p.validate(attrs[name]); err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// Coerce 将导入文件中的文本按属性类型转换，未定义的属性保持文本
+func (s *AttributeSchema) Coerce(name, text string) (interface{}, error) {
+	p, ok := s.Properties[name]
+	if !ok {
+		return text, nil
+	}
+	var v interface{}
+	switch p.Type {
+	case SchemaTypeString:
+		return text, nil
+	case SchemaTypeBoolean:
+		var b bool
+		if err := jsoniter.UnmarshalFromString(text, &b); err != nil {
+			return nil, fmt.Errorf("%s: must be a boolean", name)
+		}
+		v = b
+	default:
+		var f float64
+		if err := jsoniter.UnmarshalFromString(text, &f); err != nil {
+			return nil, fmt.Errorf("%s: must be a number", name)
+		}
+		v = f
+	}
+	return v, nil
+}
+
+func (p *AttributeProperty) checkType(v interface{}) error {
+	switch p.Type {
+	case SchemaTypeString:
+		if _, ok := v.(string); !ok {
+			return errors.New("must be a string")
+		}
+	case SchemaTypeBoolean:
+		if _, ok := v.(bool); !ok {
+			return errors.New("must be a boolean")
+		}
+	case SchemaTypeInteger, SchemaTypeNumber:
+		f, ok := toFloat(v)
+		if !ok {
+			return errors.New("must be a number")
+		}
+		if p.Type == SchemaTypeInteger && f != math.Trunc(f) {
+			return errors.New("must be an integer")
+		}
+	}
+	return nil
+}
+
+func (p *AttributeProperty) validate(v interface{}) error {
+	if err := p.checkType(v); err != nil {
+		return err
+	}
+	if len(p.Enum) > 0 {
+		found := false
+		for _, e := range p.Enum {
+			if equalScalar(e, v) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.New("not in enum")
+		}
+	}
+	switch p.Type {
+	case SchemaTypeString:
+		s := v.(string)
+		n := len([]rune(s))
+		if p.MinLength != nil && n < *p.MinLength {
+			return fmt.Errorf("shorter than %d", *p.MinLength)
+		}
+		if p.MaxLength != nil && n > *p.MaxLength {
+			return fmt.Errorf("longer than %d", *p.MaxLength)
+		}
+		if p.pattern != nil && !p.pattern.MatchString(s) {
+			return errors.New("does not match pattern")
+		}
+		if !checkFormat(p.Format, s) {
+			return fmt.Errorf("invalid %s", p.Format)
+		}
+	case SchemaTypeInteger, SchemaTypeNumber:
+		f, _ := toFloat(v)
+		if p.Minimum != nil && f < *p.Minimum {
+			return fmt.Errorf("less than %v", *p.Minimum)
+		}
+		if p.Maximum != nil && f > *p.Maximum {
+			return fmt.Errorf("greater than %v", *p.Maximum)
+		}
+		if p.ExclusiveMinimum != nil && f <= *p.ExclusiveMinimum {
+			return fmt.Errorf("not greater than %v", *p.ExclusiveMinimum)
+		}
+		if p.ExclusiveMaximum != nil && f >= *p.ExclusiveMaximum {
+			return fmt.Errorf("not less than %v", *p.ExclusiveMaximum)
+		}
+	}
+	return nil
+}
+
+// checkFormat 检查字符串格式，未知格式视为通过
+func checkFormat(format, s string) bool {
+	switch format {
+	case "ipv4":
+		ip := net.ParseIP(s)
+		return ip != nil && ip.To4() != nil
+	case "ipv6":
+		ip := net.ParseIP(s)
+		return ip != nil && ip.To4() == nil
+	case "email":
+		_, err := mail.ParseAddress(s)
+		return err == nil
+	case "date":
+		_, err := time.Parse("2006-01-02", s)
+		return err == nil
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, s)
+		return err == nil
+	case "mac":
+		_, err := net.ParseMAC(s)
+		return err == nil
+	}
+	return true
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case jsoniter.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func isScalar(v interface{}) bool {
+	switch v.(type) {
+	case nil, string, bool:
+		return true
+	}
+	_, ok := toFloat(v)
+	return ok
+}
+
+func equalScalar(a, b interface{}) bool {
+	fa, okA := toFloat(a)
+	fb, okB := toFloat(b)
+	if okA && okB {
+		return fa == fb
+	}
+	return a == b
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
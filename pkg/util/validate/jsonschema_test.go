@@ -0,0 +1,88 @@
+package validate
+
+import "testing"
+
+const testAttributeSchema = `{
+	"type": "object",
+	"properties": {
+		"mac": {"type": "string", "format": "mac"},
+		"board_rev": {"type": "integer", "minimum": 1, "maximum": 9},
+		"region": {"type": "string", "enum": ["cn", "eu", "us"]},
+		"calibrated": {"type": "boolean"}
+	},
+	"required": ["mac"],
+	"additionalProperties": false
+}`
+
+func TestParseAttributeSchema(t *testing.T) {
+	if _, err := ParseAttributeSchema([]byte(testAttributeSchema)); err != nil {
+		t.Fatalf("ParseAttributeSchema: %v", err)
+	}
+	invalid := []string{
+		`{"type": "array"}`,
+		`{"type": "object", "properties": {"a": {"type": "object"}}}`,
+		`{"type": "object", "properties": {"a-b": {"type": "string"}}}`,
+		`{"type": "object", "properties": {"a": {"type": "string", "pattern": "("}}}`,
+		`{"type": "object", "required": ["a"]}`,
+		`{"type": "object", "properties": {"a": {"type": "integer", "enum": ["x"]}}}`,
+	}
+	for _, raw := range invalid {
+		if _, err := ParseAttributeSchema([]byte(raw)); err == nil {
+			t.Errorf("ParseAttributeSchema(%s) should fail", raw)
+		}
+	}
+}
+
+func TestAttributeSchemaValidate(t *testing.T) {
+	s, err := ParseAttributeSchema([]byte(testAttributeSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		attrs map[string]interface{}
+		ok    bool
+	}{
+		{map[string]interface{}{"mac": "00:11:22:33:44:55", "board_rev": float64(3), "region": "eu", "calibrated": true}, true},
+		{map[string]interface{}{"board_rev": float64(3)}, false},
+		{map[string]interface{}{"mac": "not-a-mac"}, false},
+		{map[string]interface{}{"mac": "00:11:22:33:44:55", "board_rev": 3.5}, false},
+		{map[string]interface{}{"mac": "00:11:22:33:44:55", "board_rev": float64(10)}, false},
+		{map[string]interface{}{"mac": "00:11:22:33:44:55", "region": "jp"}, false},
+		{map[string]interface{}{"mac": "00:11:22:33:44:55", "calibrated": "yes"}, false},
+		{map[string]interface{}{"mac": "00:11:22:33:44:55", "color": "red"}, false},
+	}
+	for i, tc := range cases {
+		if err := s.Validate(tc.attrs); (err == nil) != tc.ok {
+			t.Errorf("case %d: Validate = %v, want ok=%v", i, err, tc.ok)
+		}
+	}
+}
+
+func TestAttributeSchemaCoerce(t *testing.T) {
+	s, err := ParseAttributeSchema([]byte(testAttributeSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := s.Coerce("board_rev", "3"); err != nil || v != float64(3) {
+		t.Errorf("Coerce(board_rev) = %v, %v", v, err)
+	}
+	if v, err := s.Coerce("calibrated", "true"); err != nil || v != true {
+		t.Errorf("Coerce(calibrated) = %v, %v", v, err)
+	}
+	if _, err := s.Coerce("board_rev", "abc"); err == nil {
+		t.Error("Coerce(board_rev, abc) should fail")
+	}
+	open := OpenAttributeSchema()
+	if err := open.Validate(map[string]interface{}{"any": "x", "n": float64(1)}); err != nil {
+		t.Errorf("open schema Validate = %v", err)
+	}
+	if err := open.Validate(map[string]interface{}{"a-b": "x"}); err == nil {
+		t.Error("open schema should reject invalid attribute name")
+	}
+	if err := open.Validate(map[string]interface{}{"list": []interface{}{1}}); err == nil {
+		t.Error("open schema should reject non-scalar value")
+	}
+	if v, _ := s.Coerce("other", "x"); v != "x" {
+		t.Errorf("Coerce(other) = %v", v)
+	}
+}
@@ -15,40 +15,112 @@ var files = []string{"en.json", "zh-hans.json"}
 
 // 用于自动生成翻译，勿删
 var translation = map[RspCode]string{
-	CODE_SUCCESS:               "Success|成功",
-	ERR_SERVER_BUSY:            "Server is busy|系统繁忙",
-	ERR_OPERATION_FAILED:       "Operation failed|操作失败",
-	ERR_INVALID_PARAMETER:      "Invalid parameter|参数错误",
-	ERR_QUERY_FAILED:           "Query failed|查询失败",
-	ERR_ADD_FAILED:             "Add failed|新增失败",
-	ERR_DEL_FAILED:             "Delete failed|删除失败",
-	ERR_MOD_FAILED:             "Modify failed|修改失败",
-	ERR_TOKEN_EXPIRED:          "Token expired|Token已过期",
-	ERR_NO_PERMISSION:          "No permission|没有权限",
-	ERR_CAPTCHA_INCORRECT:      "Captcha incorrect|验证码错误",
-	ERR_CAPTCHA_EXPIRED:        "Captcha expired|验证码已过期",
-	ERR_EMAIL_EXIST:            "Email already exists|邮箱已存在",
-	ERR_LOGIN_FAILED:           "Login failed|登录失败",
-	ERR_INCORRECT_PASSWORD:     "Incorrect password|密码错误",
-	ERR_USER_EXIST:             "User already exists|用户已存在",
-	ERR_USER_NOT_EXIST:         "User does not exist|用户不存在",
-	ERR_PRODUCT_CODE_EXIST:     "Product code exists|产品代号已存在",
-	ERR_PRODUCT_NAME_EXIST:     "Product name exists|产品名称已存在",
-	ERR_MANAGER_ALREADY_EXIST:  "Manager already exists|管理员已存在",
-	ERR_LICENSE_TYPE_EXIST:     "License type already exists|许可证类型已存在",
-	ERR_FEATURE_CODE_EXIST:     "Feature code already exists|功能编码已存在",
-	ERR_FIRMWARE_VERSION_EXIST: "Firmware version already exists|韧件版本已存在",
-	ERR_SOFTWARE_VERSION_EXIST: "Software version already exists|软件版本已存在",
-	ERR_FIRMWARE_NOT_EXIST:     "Firmware version does not exist|韧件版本不存在",
-	ERR_SOFTWARE_NOT_EXIST:     "Software version does not exist|软件版本不存在",
-	ERR_LICENSE_CODE_EXIST:     "License code already exists|许可证类型已存在",
-	ERR_FEATURE_NAME_EXIST:     "Feature name already exists|功能名称已存在",
-	ERR_PRODUCT_HAS_RELATIONS:  "Product has associated data. Please delete all versions, license types and features first.|产品存在关联数据，请先删除所有软硬件版本、许可证类型和功能",
-	ERR_ADD_LOG_FAILED:         "Add log failed|新增日志失败",
-	ERR_PRODUCT_NOT_EXIST:      "Product does not exist|产品不存在",
-	ERR_LICENSE_TYPE_NOT_EXIST: "License type does not exist|许可证类型不存在",
-	ERR_DEVICE_SN_EXIST:        "Device SN already exists|设备序列号已存在",
-	ERR_DEVICE_NOT_EXIST:       "Device does not exist|设备不存在",
+	CODE_SUCCESS:                 "Success|成功",
+	ERR_SERVER_BUSY:              "Server is busy|系统繁忙",
+	ERR_OPERATION_FAILED:         "Operation failed|操作失败",
+	ERR_INVALID_PARAMETER:        "Invalid parameter|参数错误",
+	ERR_QUERY_FAILED:             "Query failed|查询失败",
+	ERR_ADD_FAILED:               "Add failed|新增失败",
+	ERR_DEL_FAILED:               "Delete failed|删除失败",
+	ERR_MOD_FAILED:               "Modify failed|修改失败",
+	ERR_TOKEN_EXPIRED:            "Token expired|Token已过期",
+	ERR_NO_PERMISSION:            "No permission|没有权限",
+	ERR_CAPTCHA_INCORRECT:        "Captcha incorrect|验证码错误",
+	ERR_CAPTCHA_EXPIRED:          "Captcha expired|验证码已过期",
+	ERR_EMAIL_EXIST:              "Email already exists|邮箱已存在",
+	ERR_LOGIN_FAILED:             "Login failed|登录失败",
+	ERR_INCORRECT_PASSWORD:       "Incorrect password|密码错误",
+	ERR_USER_EXIST:               "User already exists|用户已存在",
+	ERR_USER_NOT_EXIST:           "User does not exist|用户不存在",
+	ERR_PRODUCT_CODE_EXIST:       "Product code exists|产品代号已存在",
+	ERR_PRODUCT_NAME_EXIST:       "Product name exists|产品名称已存在",
+	ERR_MANAGER_ALREADY_EXIST:    "Manager already exists|管理员已存在",
+	ERR_LICENSE_TYPE_EXIST:       "License type already exists|许可证类型已存在",
+	ERR_FEATURE_CODE_EXIST:       "Feature code already exists|功能编码已存在",
+	ERR_FIRMWARE_VERSION_EXIST:   "Firmware version already exists|韧件版本已存在",
+	ERR_SOFTWARE_VERSION_EXIST:   "Software version already exists|软件版本已存在",
+	ERR_FIRMWARE_NOT_EXIST:       "Firmware version does not exist|韧件版本不存在",
+	ERR_SOFTWARE_NOT_EXIST:       "Software version does not exist|软件版本不存在",
+	ERR_LICENSE_CODE_EXIST:       "License code already exists|许可证类型已存在",
+	ERR_FEATURE_NAME_EXIST:       "Feature name already exists|功能名称已存在",
+	ERR_PRODUCT_HAS_RELATIONS:    "Product has associated data. Please delete all versions, license types, features and devices first.|产品存在关联数据，请先删除所有软硬件版本、许可证类型、功能和设备",
+	ERR_ADD_LOG_FAILED:           "Add log failed|新增日志失败",
+	ERR_PRODUCT_NOT_EXIST:        "Product does not exist|产品不存在",
+	ERR_LICENSE_TYPE_NOT_EXIST:   "License type does not exist|许可证类型不存在",
+	ERR_DEVICE_SN_EXIST:          "Device SN already exists|设备序列号已存在",
+	ERR_DEVICE_NOT_EXIST:         "Device does not exist|设备不存在",
+	ERR_DEVICE_SN_INVALID:        "Device SN does not match the product rule|设备序列号不符合产品规则",
+	ERR_SN_RULE_INVALID:          "Invalid SN rule|序列号规则无效",
+	ERR_SN_ALLOCATOR_EXIST:       "SN allocator already exists|SN分配器已存在",
+	ERR_SN_ALLOCATOR_NOT_EXIST:   "SN allocator does not exist|SN分配器不存在",
+	ERR_SN_COUNTER_EXHAUSTED:     "SN counter exhausted|SN计数器已用尽",
+	ERR_SN_BLOCK_NOT_EXIST:       "SN block does not exist|SN分配记录不存在",
+	ERR_DEVICE_STATE_TRANSITION:  "Device state transition not allowed|设备状态不允许此变更",
+	ERR_DEVICE_STATE_INACTIVE:    "Device is suspended or scrapped|设备已停用或报废",
+	ERR_DEVICE_TAG_EXIST:         "Device tag already exists|设备标签已存在",
+	ERR_DEVICE_TAG_NOT_EXIST:     "Device tag does not exist|设备标签不存在",
+	ERR_DEVICE_GROUP_EXIST:       "Device group already exists|设备分组已存在",
+	ERR_DEVICE_GROUP_NOT_EXIST:   "Device group does not exist|设备分组不存在",
+	ERR_DEVICE_FILTER_EXIST:      "Device filter already exists|设备筛选器已存在",
+	ERR_DEVICE_FILTER_NOT_EXIST:  "Device filter does not exist|设备筛选器不存在",
+	ERR_DEVICE_SIGN_INVALID:      "Device signature invalid|设备签名无效",
+	ERR_RECYCLE_NOT_EXIST:        "Record not found in recycle bin|回收站中不存在该记录",
+	ERR_RESTORE_PARENT_DELETED:   "The owning record is deleted, restore it first|所属记录已删除，请先恢复",
+	ERR_JOB_NOT_EXIST:            "Job does not exist|任务不存在",
+	ERR_JOB_STATUS_INVALID:       "Job status does not allow this operation|任务状态不允许此操作",
+	ERR_CUSTOMER_EXIST:           "Customer already exists|客户已存在",
+	ERR_CUSTOMER_NOT_EXIST:       "Customer does not exist|客户不存在",
+	ERR_CUSTOMER_HAS_DEVICES:     "Customer still has devices|客户名下还有设备",
+	ERR_ORDER_EXIST:              "Order number already exists|订单号已存在",
+	ERR_ORDER_NOT_EXIST:          "Order does not exist|订单不存在",
+	ERR_ORDER_IN_USE:             "Order still has lots or devices|订单下还有批次或设备",
+	ERR_LOT_EXIST:                "Lot number already exists|批次号已存在",
+	ERR_LOT_NOT_EXIST:            "Lot does not exist|批次不存在",
+	ERR_LOT_IN_USE:               "Lot still has devices|批次下还有设备",
+	ERR_LOT_ORDER_MISMATCH:       "Lot does not belong to the order|批次不属于该订单",
+	ERR_DEVICE_ATTRIBUTE_INVALID: "Device attributes do not match the product schema|设备属性不符合产品属性定义",
+	ERR_ATTRIBUTE_SCHEMA_INVALID: "Invalid device attribute schema|设备属性定义无效",
+	ERR_EXPORT_TOO_MANY:          "Too many records to export, please narrow the filter|导出记录过多，请缩小筛选范围",
+	ERR_FEATURE_NOT_EXIST:        "Feature does not exist|功能不存在",
+	ERR_FEATURE_ADDON_NOT_EXIST:  "Device feature add-on does not exist|设备功能附加项不存在",
+	ERR_CATALOG_INVALID:          "Invalid catalog document|产品目录文档有误",
+	ERR_FEATURE_CONSTRAINT:       "Feature dependency or conflict check failed|功能依赖或互斥校验失败",
+	ERR_LICENSE_TYPE_CYCLE:       "License type inheritance would form a cycle|许可证类型继承关系形成环",
+	ERR_LICENSE_TYPE_IS_PARENT:   "License type is inherited by other license types|许可证类型被其它类型继承",
+	ERR_MANAGER_NOT_EXIST:        "Manager does not exist|管理员不存在",
+	ERR_INVITATION_NOT_EXIST:     "Invitation does not exist|邀请不存在",
+	ERR_INVITATION_EXPIRED:       "Invitation has expired|邀请已过期",
+	ERR_INVITATION_NOT_PENDING:   "Invitation has already been handled|邀请已处理",
+	ERR_INVITATION_PENDING:       "A pending invitation already exists|已有待处理的邀请",
+	ERR_OWNER_TRANSFER_REQUIRED:  "Changing the owner requires an ownership transfer accepted by the new owner|变更主管理员需要发起转让并由新主管理员接受",
+	ERR_EMAIL_NOT_VERIFIED:       "Email address has not been verified|邮箱尚未验证",
+	ERR_ACCOUNT_TOKEN_INVALID:    "Link is invalid or has expired|链接无效或已过期",
+	ERR_MAIL_SEND_FAILED:         "Failed to send email|邮件发送失败",
+	ERR_REGISTRATION_CLOSED:      "Registration requires an invitation code|注册需要邀请码",
+	ERR_INVITE_CODE_INVALID:      "Invitation code is invalid or has been revoked|邀请码无效或已撤销",
+	ERR_INVITE_CODE_USED_UP:      "Invitation code has been used up|邀请码已用完",
+	ERR_INVITE_EMAIL_MISMATCH:    "Invitation code is bound to another email|邀请码已绑定其他邮箱",
+	ERR_TWO_FACTOR_CODE_INVALID:  "Verification code is incorrect|动态验证码错误",
+	ERR_TWO_FACTOR_NOT_ENABLED:   "Two-factor authentication is not enabled|未开启两步验证",
+	ERR_TWO_FACTOR_ENABLED:       "Two-factor authentication is already enabled|已开启两步验证",
+	ERR_TWO_FACTOR_NOT_ENROLLED:  "Start two-factor enrolment first|请先获取两步验证二维码",
+	ERR_TWO_FACTOR_REQUIRED:      "Two-factor authentication is required for your role|你的角色必须开启两步验证",
+	ERR_PRE_AUTH_TOKEN_INVALID:   "Login verification has expired, please log in again|登录验证已过期，请重新登录",
+	ERR_API_TOKEN_INVALID:        "API token is invalid, expired or revoked|API令牌无效、已过期或已撤销",
+	ERR_API_TOKEN_NOT_EXIST:      "API token does not exist|API令牌不存在",
+	ERR_API_TOKEN_REVOKED:        "API token has already been revoked|API令牌已撤销",
+	ERR_API_TOKEN_FORBIDDEN:      "API tokens cannot access this endpoint|API令牌不能访问该接口",
+	ERR_API_TOKEN_SCOPE:          "Invalid API token scope|API令牌授权范围无效",
+	ERR_SERVICE_ACCOUNT_LOGIN:    "Service accounts cannot log in|服务账号不能登录",
+	ERR_OIDC_DISABLED:            "Single sign-on is not enabled|未开启单点登录",
+	ERR_OIDC_PROVIDER:            "Identity provider is unavailable|身份提供方不可用",
+	ERR_OIDC_STATE_INVALID:       "Single sign-on has expired, please try again|单点登录已过期，请重试",
+	ERR_OIDC_LOGIN_FAILED:        "Identity provider verification failed|身份提供方验证失败",
+	ERR_OIDC_EMAIL_INVALID:       "The identity provider did not return a verified email|身份提供方未返回已验证的邮箱",
+	ERR_OIDC_DOMAIN:              "This email domain is not allowed to sign in|该邮箱域名不允许登录",
+	ERR_OIDC_USER_NOT_FOUND:      "No account for this email, please contact the administrator|该邮箱没有账号，请联系管理员",
+	ERR_OIDC_ACCOUNT_LINKED:      "This account is linked to another single sign-on identity|该账号已关联其他单点登录身份",
+	ERR_LICENSE_TYPE_IN_USE:      "License type is used by devices, orders or lots|许可证类型正在被设备、订单或批次使用",
 }
 
 // 系统级错误返回码，RspCode不变
@@ -70,29 +142,101 @@ const (
 
 // 用户错误 格式为201*** 具体数值不重要，以返回的消息为准
 const (
-	ERR_TOKEN_EXPIRED          RspCode = 201000 + iota // token过期
-	ERR_NO_PERMISSION                                  // 用户无权访问
-	ERR_CAPTCHA_INCORRECT                              // 验证码错误
-	ERR_CAPTCHA_EXPIRED                                // 验证码过期
-	ERR_EMAIL_EXIST                                    // 邮箱重复
-	ERR_LOGIN_FAILED                                   // 登录失败
-	ERR_INCORRECT_PASSWORD                             // 密码错误
-	ERR_USER_EXIST                                     // 用户已存在
-	ERR_PRODUCT_CODE_EXIST                             // 产品编号已存在
-	ERR_PRODUCT_NAME_EXIST                             // 产品名已存在
-	ERR_MANAGER_ALREADY_EXIST                          // 管理员已存在
-	ERR_USER_NOT_EXIST                                 // 用户不存在
-	ERR_FIRMWARE_VERSION_EXIST                         // 韧件版本已存在
-	ERR_SOFTWARE_VERSION_EXIST                         // 软件版本已存在
-	ERR_FIRMWARE_NOT_EXIST                             // 韧件版本不存在
-	ERR_SOFTWARE_NOT_EXIST                             // 软件版本不存在
-	ERR_LICENSE_TYPE_EXIST                             // 许可证类型已存在
-	ERR_LICENSE_CODE_EXIST                             // 许可证类型已存在
-	ERR_FEATURE_NAME_EXIST                             // 功能编码已存在
-	ERR_FEATURE_CODE_EXIST                             // 功能编码已存在
-	ERR_PRODUCT_HAS_RELATIONS                          // 产品存在关联数据
-	ERR_PRODUCT_NOT_EXIST                              // 产品不存在
-	ERR_LICENSE_TYPE_NOT_EXIST                         // 许可证类型不存在
-	ERR_DEVICE_SN_EXIST                                // 设备序列号已存在
-	ERR_DEVICE_NOT_EXIST                               // 设备不存在
+	ERR_TOKEN_EXPIRED            RspCode = 201000 + iota // token过期
+	ERR_NO_PERMISSION                                    // 用户无权访问
+	ERR_CAPTCHA_INCORRECT                                // 验证码错误
+	ERR_CAPTCHA_EXPIRED                                  // 验证码过期
+	ERR_EMAIL_EXIST                                      // 邮箱重复
+	ERR_LOGIN_FAILED                                     // 登录失败
+	ERR_INCORRECT_PASSWORD                               // 密码错误
+	ERR_USER_EXIST                                       // 用户已存在
+	ERR_PRODUCT_CODE_EXIST                               // 产品编号已存在
+	ERR_PRODUCT_NAME_EXIST                               // 产品名已存在
+	ERR_MANAGER_ALREADY_EXIST                            // 管理员已存在
+	ERR_USER_NOT_EXIST                                   // 用户不存在
+	ERR_FIRMWARE_VERSION_EXIST                           // 韧件版本已存在
+	ERR_SOFTWARE_VERSION_EXIST                           // 软件版本已存在
+	ERR_FIRMWARE_NOT_EXIST                               // 韧件版本不存在
+	ERR_SOFTWARE_NOT_EXIST                               // 软件版本不存在
+	ERR_LICENSE_TYPE_EXIST                               // 许可证类型已存在
+	ERR_LICENSE_CODE_EXIST                               // 许可证类型已存在
+	ERR_FEATURE_NAME_EXIST                               // 功能编码已存在
+	ERR_FEATURE_CODE_EXIST                               // 功能编码已存在
+	ERR_PRODUCT_HAS_RELATIONS                            // 产品存在关联数据
+	ERR_PRODUCT_NOT_EXIST                                // 产品不存在
+	ERR_LICENSE_TYPE_NOT_EXIST                           // 许可证类型不存在
+	ERR_DEVICE_SN_EXIST                                  // 设备序列号已存在
+	ERR_DEVICE_NOT_EXIST                                 // 设备不存在
+	ERR_DEVICE_SN_INVALID                                // 设备序列号不符合规则
+	ERR_SN_RULE_INVALID                                  // 序列号规则无效
+	ERR_SN_ALLOCATOR_EXIST                               // SN分配器已存在
+	ERR_SN_ALLOCATOR_NOT_EXIST                           // SN分配器不存在
+	ERR_SN_COUNTER_EXHAUSTED                             // SN计数器已用尽
+	ERR_SN_BLOCK_NOT_EXIST                               // SN分配记录不存在
+	ERR_DEVICE_STATE_TRANSITION                          // 设备状态不允许此变更
+	ERR_DEVICE_STATE_INACTIVE                            // 设备已停用或报废
+	ERR_DEVICE_TAG_EXIST                                 // 设备标签已存在
+	ERR_DEVICE_TAG_NOT_EXIST                             // 设备标签不存在
+	ERR_DEVICE_GROUP_EXIST                               // 设备分组已存在
+	ERR_DEVICE_GROUP_NOT_EXIST                           // 设备分组不存在
+	ERR_DEVICE_FILTER_EXIST                              // 设备筛选器已存在
+	ERR_DEVICE_FILTER_NOT_EXIST                          // 设备筛选器不存在
+	ERR_DEVICE_SIGN_INVALID                              // 设备签名无效
+	ERR_RECYCLE_NOT_EXIST                                // 回收站中不存在该记录
+	ERR_RESTORE_PARENT_DELETED                           // 所属记录已删除
+	ERR_JOB_NOT_EXIST                                    // 任务不存在
+	ERR_JOB_STATUS_INVALID                               // 任务状态不允许此操作
+	ERR_CUSTOMER_EXIST                                   // 客户已存在
+	ERR_CUSTOMER_NOT_EXIST                               // 客户不存在
+	ERR_CUSTOMER_HAS_DEVICES                             // 客户名下还有设备，不能删除
+	ERR_ORDER_EXIST                                      // 订单号已存在
+	ERR_ORDER_NOT_EXIST                                  // 订单不存在
+	ERR_ORDER_IN_USE                                     // 订单下还有批次或设备，不能删除
+	ERR_LOT_EXIST                                        // 批次号已存在
+	ERR_LOT_NOT_EXIST                                    // 批次不存在
+	ERR_LOT_IN_USE                                       // 批次下还有设备，不能删除
+	ERR_LOT_ORDER_MISMATCH                               // 批次不属于该订单
+	ERR_DEVICE_ATTRIBUTE_INVALID                         // 设备属性不符合产品属性定义
+	ERR_ATTRIBUTE_SCHEMA_INVALID                         // 设备属性定义无效
+	ERR_EXPORT_TOO_MANY                                  // 导出记录过多
+	ERR_FEATURE_NOT_EXIST                                // 功能不存在
+	ERR_FEATURE_ADDON_NOT_EXIST                          // 设备功能附加项不存在
+	ERR_CATALOG_INVALID                                  // 产品目录文档有误
+	ERR_FEATURE_CONSTRAINT                               // 功能组合不满足依赖/互斥关系
+	ERR_LICENSE_TYPE_CYCLE                               // 父类型链出现环
+	ERR_LICENSE_TYPE_IS_PARENT                           // 存在子类型时不能删除
+	ERR_MANAGER_NOT_EXIST                                // 管理员不存在
+	ERR_INVITATION_NOT_EXIST                             // 邀请不存在
+	ERR_INVITATION_EXPIRED                               // 邀请已过期
+	ERR_INVITATION_NOT_PENDING                           // 邀请已被接受、拒绝或撤销
+	ERR_INVITATION_PENDING                               // 同一邮箱已有待处理的邀请
+	ERR_OWNER_TRANSFER_REQUIRED                          // 主管理员只能通过转让邀请变更
+	ERR_EMAIL_NOT_VERIFIED                               // 邮箱未验证，不能登录
+	ERR_ACCOUNT_TOKEN_INVALID                            // 邮箱验证或密码重置令牌无效、已使用或已过期
+	ERR_MAIL_SEND_FAILED                                 // 邮件发送失败
+	ERR_REGISTRATION_CLOSED                              // 仅邀请注册模式下直接注册
+	ERR_INVITE_CODE_INVALID                              // 邀请码不存在或已撤销
+	ERR_INVITE_CODE_USED_UP                              // 邀请码已达到使用次数
+	ERR_INVITE_EMAIL_MISMATCH                            // 注册邮箱与邀请码绑定的邮箱不一致
+	ERR_TWO_FACTOR_CODE_INVALID                          // 两步验证码或恢复码错误
+	ERR_TWO_FACTOR_NOT_ENABLED                           // 未开启两步验证
+	ERR_TWO_FACTOR_ENABLED                               // 重复开启两步验证
+	ERR_TWO_FACTOR_NOT_ENROLLED                          // 未获取密钥或密钥已过期
+	ERR_TWO_FACTOR_REQUIRED                              // 角色要求两步验证时不能关闭
+	ERR_PRE_AUTH_TOKEN_INVALID                           // 两步验证的临时令牌无效或已过期
+	ERR_API_TOKEN_INVALID                                // API令牌无效、已过期或已撤销
+	ERR_API_TOKEN_NOT_EXIST                              // API令牌不存在
+	ERR_API_TOKEN_REVOKED                                // API令牌已撤销
+	ERR_API_TOKEN_FORBIDDEN                              // API令牌不能访问账号管理类接口
+	ERR_API_TOKEN_SCOPE                                  // API令牌授权范围为空或包含未知的范围
+	ERR_SERVICE_ACCOUNT_LOGIN                            // 服务账号只能通过服务令牌访问
+	ERR_OIDC_DISABLED                                    // 未开启或未配置OIDC单点登录
+	ERR_OIDC_PROVIDER                                    // OIDC discovery或公钥获取失败
+	ERR_OIDC_STATE_INVALID                               // OIDC登录的state无效、已使用或已过期
+	ERR_OIDC_LOGIN_FAILED                                // 授权码换取令牌或ID令牌校验失败
+	ERR_OIDC_EMAIL_INVALID                               // ID令牌没有邮箱或邮箱未验证
+	ERR_OIDC_DOMAIN                                      // 邮箱域名不在allowed-domains中
+	ERR_OIDC_USER_NOT_FOUND                              // 邮箱未注册且未开启自动创建用户
+	ERR_OIDC_ACCOUNT_LINKED                              // 邮箱对应的账号已关联身份提供方的其他用户
+	ERR_LICENSE_TYPE_IN_USE                              // 许可证类型被设备、订单或批次使用时不能删除
 )
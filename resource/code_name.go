@@ -37,6 +37,78 @@ var codeToName = map[RspCode]string{
 	ERR_LICENSE_TYPE_NOT_EXIST: "ERR_LICENSE_TYPE_NOT_EXIST",
 	ERR_DEVICE_SN_EXIST: "ERR_DEVICE_SN_EXIST",
 	ERR_DEVICE_NOT_EXIST: "ERR_DEVICE_NOT_EXIST",
+	ERR_DEVICE_SN_INVALID: "ERR_DEVICE_SN_INVALID",
+	ERR_SN_RULE_INVALID: "ERR_SN_RULE_INVALID",
+	ERR_SN_ALLOCATOR_EXIST: "ERR_SN_ALLOCATOR_EXIST",
+	ERR_SN_ALLOCATOR_NOT_EXIST: "ERR_SN_ALLOCATOR_NOT_EXIST",
+	ERR_SN_COUNTER_EXHAUSTED: "ERR_SN_COUNTER_EXHAUSTED",
+	ERR_SN_BLOCK_NOT_EXIST: "ERR_SN_BLOCK_NOT_EXIST",
+	ERR_DEVICE_STATE_TRANSITION: "ERR_DEVICE_STATE_TRANSITION",
+	ERR_DEVICE_STATE_INACTIVE: "ERR_DEVICE_STATE_INACTIVE",
+	ERR_DEVICE_TAG_EXIST: "ERR_DEVICE_TAG_EXIST",
+	ERR_DEVICE_TAG_NOT_EXIST: "ERR_DEVICE_TAG_NOT_EXIST",
+	ERR_DEVICE_GROUP_EXIST: "ERR_DEVICE_GROUP_EXIST",
+	ERR_DEVICE_GROUP_NOT_EXIST: "ERR_DEVICE_GROUP_NOT_EXIST",
+	ERR_DEVICE_FILTER_EXIST: "ERR_DEVICE_FILTER_EXIST",
+	ERR_DEVICE_FILTER_NOT_EXIST: "ERR_DEVICE_FILTER_NOT_EXIST",
+	ERR_DEVICE_SIGN_INVALID: "ERR_DEVICE_SIGN_INVALID",
+	ERR_RECYCLE_NOT_EXIST: "ERR_RECYCLE_NOT_EXIST",
+	ERR_RESTORE_PARENT_DELETED: "ERR_RESTORE_PARENT_DELETED",
+	ERR_JOB_NOT_EXIST: "ERR_JOB_NOT_EXIST",
+	ERR_JOB_STATUS_INVALID: "ERR_JOB_STATUS_INVALID",
+	ERR_CUSTOMER_EXIST: "ERR_CUSTOMER_EXIST",
+	ERR_CUSTOMER_NOT_EXIST: "ERR_CUSTOMER_NOT_EXIST",
+	ERR_CUSTOMER_HAS_DEVICES: "ERR_CUSTOMER_HAS_DEVICES",
+	ERR_ORDER_EXIST: "ERR_ORDER_EXIST",
+	ERR_ORDER_NOT_EXIST: "ERR_ORDER_NOT_EXIST",
+	ERR_ORDER_IN_USE: "ERR_ORDER_IN_USE",
+	ERR_LOT_EXIST: "ERR_LOT_EXIST",
+	ERR_LOT_NOT_EXIST: "ERR_LOT_NOT_EXIST",
+	ERR_LOT_IN_USE: "ERR_LOT_IN_USE",
+	ERR_LOT_ORDER_MISMATCH: "ERR_LOT_ORDER_MISMATCH",
+	ERR_DEVICE_ATTRIBUTE_INVALID: "ERR_DEVICE_ATTRIBUTE_INVALID",
+	ERR_ATTRIBUTE_SCHEMA_INVALID: "ERR_ATTRIBUTE_SCHEMA_INVALID",
+	ERR_EXPORT_TOO_MANY: "ERR_EXPORT_TOO_MANY",
+	ERR_FEATURE_NOT_EXIST: "ERR_FEATURE_NOT_EXIST",
+	ERR_FEATURE_ADDON_NOT_EXIST: "ERR_FEATURE_ADDON_NOT_EXIST",
+	ERR_CATALOG_INVALID: "ERR_CATALOG_INVALID",
+	ERR_FEATURE_CONSTRAINT: "ERR_FEATURE_CONSTRAINT",
+	ERR_LICENSE_TYPE_CYCLE: "ERR_LICENSE_TYPE_CYCLE",
+	ERR_LICENSE_TYPE_IS_PARENT: "ERR_LICENSE_TYPE_IS_PARENT",
+	ERR_MANAGER_NOT_EXIST: "ERR_MANAGER_NOT_EXIST",
+	ERR_INVITATION_NOT_EXIST: "ERR_INVITATION_NOT_EXIST",
+	ERR_INVITATION_EXPIRED: "ERR_INVITATION_EXPIRED",
+	ERR_INVITATION_NOT_PENDING: "ERR_INVITATION_NOT_PENDING",
+	ERR_INVITATION_PENDING: "ERR_INVITATION_PENDING",
+	ERR_OWNER_TRANSFER_REQUIRED: "ERR_OWNER_TRANSFER_REQUIRED",
+	ERR_EMAIL_NOT_VERIFIED: "ERR_EMAIL_NOT_VERIFIED",
+	ERR_ACCOUNT_TOKEN_INVALID: "ERR_ACCOUNT_TOKEN_INVALID",
+	ERR_MAIL_SEND_FAILED: "ERR_MAIL_SEND_FAILED",
+	ERR_REGISTRATION_CLOSED: "ERR_REGISTRATION_CLOSED",
+	ERR_INVITE_CODE_INVALID: "ERR_INVITE_CODE_INVALID",
+	ERR_INVITE_CODE_USED_UP: "ERR_INVITE_CODE_USED_UP",
+	ERR_INVITE_EMAIL_MISMATCH: "ERR_INVITE_EMAIL_MISMATCH",
+	ERR_TWO_FACTOR_CODE_INVALID: "ERR_TWO_FACTOR_CODE_INVALID",
+	ERR_TWO_FACTOR_NOT_ENABLED: "ERR_TWO_FACTOR_NOT_ENABLED",
+	ERR_TWO_FACTOR_ENABLED: "ERR_TWO_FACTOR_ENABLED",
+	ERR_TWO_FACTOR_NOT_ENROLLED: "ERR_TWO_FACTOR_NOT_ENROLLED",
+	ERR_TWO_FACTOR_REQUIRED: "ERR_TWO_FACTOR_REQUIRED",
+	ERR_PRE_AUTH_TOKEN_INVALID: "ERR_PRE_AUTH_TOKEN_INVALID",
+	ERR_API_TOKEN_INVALID: "ERR_API_TOKEN_INVALID",
+	ERR_API_TOKEN_NOT_EXIST: "ERR_API_TOKEN_NOT_EXIST",
+	ERR_API_TOKEN_REVOKED: "ERR_API_TOKEN_REVOKED",
+	ERR_API_TOKEN_FORBIDDEN: "ERR_API_TOKEN_FORBIDDEN",
+	ERR_API_TOKEN_SCOPE: "ERR_API_TOKEN_SCOPE",
+	ERR_SERVICE_ACCOUNT_LOGIN: "ERR_SERVICE_ACCOUNT_LOGIN",
+	ERR_OIDC_DISABLED: "ERR_OIDC_DISABLED",
+	ERR_OIDC_PROVIDER: "ERR_OIDC_PROVIDER",
+	ERR_OIDC_STATE_INVALID: "ERR_OIDC_STATE_INVALID",
+	ERR_OIDC_LOGIN_FAILED: "ERR_OIDC_LOGIN_FAILED",
+	ERR_OIDC_EMAIL_INVALID: "ERR_OIDC_EMAIL_INVALID",
+	ERR_OIDC_DOMAIN: "ERR_OIDC_DOMAIN",
+	ERR_OIDC_USER_NOT_FOUND: "ERR_OIDC_USER_NOT_FOUND",
+	ERR_OIDC_ACCOUNT_LINKED: "ERR_OIDC_ACCOUNT_LINKED",
+	ERR_LICENSE_TYPE_IN_USE: "ERR_LICENSE_TYPE_IN_USE",
 }
 
 // Msg 获取错误码对应的常量名
@@ -27,6 +27,12 @@ type ProfileInfo struct {
 	*JwtConfig        `mapstructure:"jwt"`
 	*DllConfig        `mapstructure:"dll"`
 	*ObjStorageConfig `mapstructure:"obj-storage"`
+	*DeviceConfig     `mapstructure:"device"`
+	*RecycleConfig    `mapstructure:"recycle"`
+	*JobConfig        `mapstructure:"job"`
+	*MailConfig       `mapstructure:"mail"`
+	*AccountConfig    `mapstructure:"account"`
+	*OIDCConfig       `mapstructure:"oidc"`
 }
 
 // 系统配置
@@ -92,6 +98,67 @@ type ObjStorageConfig struct {
 	RoleArn      string `mapstructure:"role-arn" yaml:"role-arn"`
 }
 
+// DeviceConfig 设备接入配置
+type DeviceConfig struct {
+	OnlineWindow           int    `mapstructure:"online-window" yaml:"online-window"`                       // 最后心跳在该秒数内视为在线
+	HeartbeatRetentionDays int    `mapstructure:"heartbeat-retention-days" yaml:"heartbeat-retention-days"` // 原始心跳保留天数
+}
+
+// RecycleConfig 回收站配置
+type RecycleConfig struct {
+	RetentionDays int `mapstructure:"retention-days" yaml:"retention-days"` // 已删除记录保留天数，超过后永久清理
+}
+
+// JobConfig 异步任务配置
+type JobConfig struct {
+	Workers   int `mapstructure:"workers" yaml:"workers"`       // 每个实例的任务执行协程数
+	ChunkSize int `mapstructure:"chunk-size" yaml:"chunk-size"` // 每个事务处理的条目数
+}
+
+// MailConfig 邮件发送配置
+type MailConfig struct {
+	Transport string `mapstructure:"transport" yaml:"transport"` // smtp、file、log，默认log
+	Host      string `mapstructure:"host" yaml:"host"`           // SMTP服务器
+	Port      int    `mapstructure:"port" yaml:"port"`           // SMTP端口，465使用TLS连接，其他端口支持时使用STARTTLS
+	Username  string `mapstructure:"username" yaml:"username"`
+	Password  string `mapstructure:"password" yaml:"password"`
+	From      string `mapstructure:"from" yaml:"from"` // 发件人地址
+	Dir       string `mapstructure:"dir" yaml:"dir"`   // file方式保存邮件的目录
+}
+
+// AccountConfig 账号配置
+type AccountConfig struct {
+	RegistrationMode         string `mapstructure:"registration-mode" yaml:"registration-mode"`                   // open: 开放注册，invite: 只能使用邀请码注册
+	RequireEmailVerification bool   `mapstructure:"require-email-verification" yaml:"require-email-verification"` // 新注册用户验证邮箱后才能登录
+	TokenSecret              string `mapstructure:"token-secret" yaml:"token-secret"`                             // 一次性令牌的签名密钥和两步验证密钥的加密密钥，为空时使用jwt.asecret，修改后需重置两步验证
+	VerifyTokenMinutes       int    `mapstructure:"verify-token-minutes" yaml:"verify-token-minutes"`             // 邮箱验证令牌有效期（分钟）
+	ResetTokenMinutes        int    `mapstructure:"reset-token-minutes" yaml:"reset-token-minutes"`               // 密码重置令牌有效期（分钟）
+	MailIntervalSeconds      int    `mapstructure:"mail-interval-seconds" yaml:"mail-interval-seconds"`           // 同一邮箱两次发送的最小间隔（秒）
+	LinkBaseURL              string `mapstructure:"link-base-url" yaml:"link-base-url"`                           // 邮件中链接指向的前端地址
+}
+
+// OIDCConfig OpenID Connect单点登录配置
+type OIDCConfig struct {
+	Enabled              bool            `mapstructure:"enabled" yaml:"enabled"`
+	Issuer               string          `mapstructure:"issuer" yaml:"issuer"`                                 // 身份提供方地址，通过discovery获取端点和公钥
+	ClientID             string          `mapstructure:"client-id" yaml:"client-id"`                           // 客户端ID
+	ClientSecret         string          `mapstructure:"client-secret" yaml:"client-secret"`                   // 客户端密钥，公开客户端为空
+	RedirectURL          string          `mapstructure:"redirect-url" yaml:"redirect-url"`                     // 前端回调页面地址，需在身份提供方登记
+	Scopes               []string        `mapstructure:"scopes" yaml:"scopes"`                                 // 为空时使用openid email profile
+	AutoProvision        bool            `mapstructure:"auto-provision" yaml:"auto-provision"`                 // 邮箱未注册时自动创建用户，不受注册模式限制
+	AllowedDomains       []string        `mapstructure:"allowed-domains" yaml:"allowed-domains"`               // 允许登录的邮箱域名，为空时不限制
+	AllowUnverifiedEmail bool            `mapstructure:"allow-unverified-email" yaml:"allow-unverified-email"` // 接受email_verified不为true的邮箱，身份提供方不返回该声明时需要开启；未验证的邮箱只能登录已关联或新建的用户，不会关联已有用户
+	GroupsClaim          string          `mapstructure:"groups-claim" yaml:"groups-claim"`                     // 组声明名称，默认groups
+	GroupRoles           []OIDCGroupRole `mapstructure:"group-roles" yaml:"group-roles"`                       // 每次登录时按组授予角色，只授予或提升，不撤销
+}
+
+// OIDCGroupRole 身份提供方的组对应的角色
+type OIDCGroupRole struct {
+	Group   string `mapstructure:"group" yaml:"group"`
+	Role    string `mapstructure:"role" yaml:"role"`       // system_admin，或产品角色viewer、device_operator、release_manager、product_admin
+	Product string `mapstructure:"product" yaml:"product"` // 产品编码，产品角色必填
+}
+
 // ConfigInit 初始化配置
 // 将配置文件的信息反序列化到结构体中
 func ConfigInit() {